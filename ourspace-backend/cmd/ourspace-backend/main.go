@@ -18,11 +18,21 @@ import (
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
 
+	"github.com/cfhn/our-space/ourspace-backend/internal/applications"
 	"github.com/cfhn/our-space/ourspace-backend/internal/auth"
+	"github.com/cfhn/our-space/ourspace-backend/internal/briefings"
 	"github.com/cfhn/our-space/ourspace-backend/internal/cards"
 	"github.com/cfhn/our-space/ourspace-backend/internal/config"
+	"github.com/cfhn/our-space/ourspace-backend/internal/events"
+	"github.com/cfhn/our-space/ourspace-backend/internal/export"
+	"github.com/cfhn/our-space/ourspace-backend/internal/fees"
+	"github.com/cfhn/our-space/ourspace-backend/internal/lending"
+	"github.com/cfhn/our-space/ourspace-backend/internal/machines"
 	"github.com/cfhn/our-space/ourspace-backend/internal/members"
+	"github.com/cfhn/our-space/ourspace-backend/internal/notes"
 	"github.com/cfhn/our-space/ourspace-backend/internal/presence"
+	"github.com/cfhn/our-space/ourspace-backend/internal/reports"
+	"github.com/cfhn/our-space/ourspace-backend/internal/selfservice"
 	pb "github.com/cfhn/our-space/ourspace-backend/proto"
 	"github.com/cfhn/our-space/pkg/database"
 	"github.com/cfhn/our-space/pkg/log"
@@ -81,11 +91,59 @@ func run(logger *slog.Logger) error {
 	authRepo := auth.NewPostgresRepo(db)
 	authService := auth.NewAuthService(authRepo, &signingKey, &publicKeys)
 	membersRepo := members.NewPostgresRepo(db)
-	memberService := members.NewService(membersRepo)
+	memberService := members.NewService(membersRepo, cfg.Members.RequireGuardian)
 	cardsRepo := cards.NewPostgresRepo(db)
 	cardsService := cards.NewService(cardsRepo, memberService)
 	presenceRepo := presence.NewPostgresRepo(db)
-	presenceService := presence.NewService(presenceRepo)
+	presenceService := presence.NewService(presenceRepo, memberService, cardsService)
+
+	briefingsRepo := briefings.NewPostgresRepo(db)
+	briefingsService := briefings.NewService(briefingsRepo)
+
+	eventsRepo := events.NewPostgresRepo(db)
+	eventsService := events.NewService(eventsRepo, memberService, briefingsService)
+
+	lendingRepo := lending.NewPostgresRepo(db)
+	lendingService := lending.NewService(
+		lendingRepo, memberService, cardsService, briefingsService, cfg.Lending.Period,
+	)
+
+	machinesRepo := machines.NewPostgresRepo(db)
+	machinesService := machines.NewService(
+		machinesRepo, memberService, cardsService, briefingsService, cfg.Machines.SessionTimeout,
+	)
+	accessService := machines.NewAccessService(machinesRepo, memberService, cardsService, briefingsService)
+
+	feesRepo := fees.NewPostgresRepo(db)
+	feesService := fees.NewService(feesRepo, fees.Creditor{
+		Name: cfg.Sepa.CreditorName,
+		IBAN: cfg.Sepa.CreditorIBAN,
+		BIC:  cfg.Sepa.CreditorBIC,
+		ID:   cfg.Sepa.CreditorID,
+	})
+
+	selfServiceRepo := selfservice.NewPostgresRepo(db)
+	selfService := selfservice.NewService(
+		selfServiceRepo, memberService, presenceService, cardsService, briefingsService,
+		selfservice.Paths{Fields: cfg.SelfService.Fields, Attributes: cfg.SelfService.Attributes},
+		selfservice.Paths{Fields: cfg.SelfService.ApprovalFields, Attributes: cfg.SelfService.ApprovalAttributes},
+	)
+
+	applicationsRepo := applications.NewPostgresRepo(db)
+	applicationsService := applications.NewService(
+		applicationsRepo, memberService, cfg.Applications.RateLimit, cfg.Applications.RateLimitPeriod,
+	)
+
+	notesRepo := notes.NewPostgresRepo(db)
+	notesService := notes.NewService(notesRepo, cardsService, briefingsService)
+
+	reportsRepo := reports.NewPostgresRepo(db)
+	reportsService := reports.NewService(reportsRepo)
+
+	autoCheckout, err := newAutoCheckout(cfg.Presence, presenceRepo, logger)
+	if err != nil {
+		return err
+	}
 
 	server := setup.Server{
 		HTTPPort: cfg.HTTPPort,
@@ -96,6 +154,16 @@ func run(logger *slog.Logger) error {
 			pb.RegisterCardServiceServer(server, cardsService)
 			pb.RegisterAuthServiceServer(server, authService)
 			pb.RegisterPresenceServiceServer(server, presenceService)
+			pb.RegisterReportServiceServer(server, reportsService)
+			pb.RegisterEventServiceServer(server, eventsService)
+			pb.RegisterLendingServiceServer(server, lendingService)
+			pb.RegisterBriefingServiceServer(server, briefingsService)
+			pb.RegisterMachineServiceServer(server, machinesService)
+			pb.RegisterAccessServiceServer(server, accessService)
+			pb.RegisterFeeServiceServer(server, feesService)
+			pb.RegisterSelfServiceServer(server, selfService)
+			pb.RegisterApplicationServiceServer(server, applicationsService)
+			pb.RegisterMemberNoteServiceServer(server, notesService)
 
 			err := pb.RegisterMemberServiceHandlerClient(context.Background(), mux, pb.NewMemberServiceClient(client))
 			if err != nil {
@@ -117,6 +185,60 @@ func run(logger *slog.Logger) error {
 				return err
 			}
 
+			err = pb.RegisterReportServiceHandlerClient(context.Background(), mux, pb.NewReportServiceClient(client))
+			if err != nil {
+				return err
+			}
+
+			err = pb.RegisterEventServiceHandlerClient(context.Background(), mux, pb.NewEventServiceClient(client))
+			if err != nil {
+				return err
+			}
+
+			err = pb.RegisterLendingServiceHandlerClient(context.Background(), mux, pb.NewLendingServiceClient(client))
+			if err != nil {
+				return err
+			}
+
+			err = pb.RegisterBriefingServiceHandlerClient(context.Background(), mux, pb.NewBriefingServiceClient(client))
+			if err != nil {
+				return err
+			}
+
+			err = pb.RegisterMachineServiceHandlerClient(context.Background(), mux, pb.NewMachineServiceClient(client))
+			if err != nil {
+				return err
+			}
+
+			err = pb.RegisterAccessServiceHandlerClient(context.Background(), mux, pb.NewAccessServiceClient(client))
+			if err != nil {
+				return err
+			}
+
+			err = pb.RegisterFeeServiceHandlerClient(context.Background(), mux, pb.NewFeeServiceClient(client))
+			if err != nil {
+				return err
+			}
+
+			err = pb.RegisterSelfServiceHandlerClient(context.Background(), mux, pb.NewSelfServiceClient(client))
+			if err != nil {
+				return err
+			}
+
+			err = pb.RegisterApplicationServiceHandlerClient(
+				context.Background(), mux, pb.NewApplicationServiceClient(client),
+			)
+			if err != nil {
+				return err
+			}
+
+			err = pb.RegisterMemberNoteServiceHandlerClient(
+				context.Background(), mux, pb.NewMemberNoteServiceClient(client),
+			)
+			if err != nil {
+				return err
+			}
+
 			return nil
 		},
 		Jobs: []setup.JobSpec{
@@ -136,10 +258,41 @@ func run(logger *slog.Logger) error {
 					return nil
 				}),
 			},
+			{
+				Name:     "auto_checkout_presences",
+				Interval: cfg.Presence.AutoCheckoutPeriod,
+				Job:      autoCheckout,
+			},
+			{
+				Name:     "mark_event_attendance",
+				Interval: 15 * time.Minute,
+				Job:      setup.JobFunc(eventsRepo.MarkAttendanceFromPresences),
+			},
+			{
+				Name:     "stop_timed_out_usage_sessions",
+				Interval: cfg.Machines.SessionTimeoutCheck,
+				Job:      setup.JobFunc(machinesRepo.StopTimedOutSessions),
+			},
+			{
+				Name:      "update_age_categories",
+				Immediate: true,
+				Interval:  24 * time.Hour,
+				Job:       members.NewAgeCategoryUpdate(membersRepo, logger.With("job", "update_age_categories")),
+			},
+			{
+				Name:     "generate_invoices",
+				Interval: 24 * time.Hour,
+				Job: setup.JobFunc(func(ctx context.Context) error {
+					_, err := feesRepo.GenerateInvoices(ctx, time.Now())
+
+					return err
+				}),
+			},
 		},
 		ServeMuxOptions: []runtime.ServeMuxOption{
 			runtime.WithForwardResponseOption(auth.CookieRewriter),
 			runtime.WithMetadata(auth.CookieForwarder),
+			runtime.WithMarshalerOption(runtime.MIMEWildcard, export.NewMarshaler()),
 		},
 		KeyFunc: func(kid string) *ecdsa.PublicKey {
 			keyMap := *publicKeys.Load()
@@ -150,6 +303,24 @@ func run(logger *slog.Logger) error {
 	return server.Run()
 }
 
+func newAutoCheckout(cfg config.Presence, repo *presence.Postgres, logger *slog.Logger) (*presence.AutoCheckout, error) {
+	closingTimes, err := presence.ParseClosingTimes(cfg.ClosingTimes)
+	if err != nil {
+		return nil, err
+	}
+
+	location, err := time.LoadLocation(cfg.TimeZone)
+	if err != nil {
+		return nil, err
+	}
+
+	return presence.NewAutoCheckout(repo, presence.AutoCheckoutRules{
+		ClosingTimes: closingTimes,
+		MaxDuration:  cfg.MaxDuration,
+		Location:     location,
+	}, logger.With("job", "auto_checkout_presences")), nil
+}
+
 func loadKeys(privateKeyPath, publicKeysPath string) (*ecdsa.PrivateKey, map[string]*ecdsa.PublicKey, error) {
 	pemContent, err := os.ReadFile(privateKeyPath)
 	if err != nil {
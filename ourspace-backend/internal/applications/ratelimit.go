@@ -0,0 +1,85 @@
+package applications
+
+import (
+	"context"
+	"net"
+	"strings"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+)
+
+// rateLimiter allows a number of calls per client in fixed windows. It is kept in memory, so the limit applies per
+// backend instance.
+type rateLimiter struct {
+	mu        sync.Mutex
+	limit     int
+	period    time.Duration
+	windows   map[string]*rateWindow
+	lastPrune time.Time
+}
+
+type rateWindow struct {
+	start time.Time
+	calls int
+}
+
+func newRateLimiter(limit int, period time.Duration) *rateLimiter {
+	return &rateLimiter{limit: limit, period: period, windows: map[string]*rateWindow{}}
+}
+
+// allow counts the call of the client and reports whether it is within the limit.
+func (r *rateLimiter) allow(client string, now time.Time) bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if now.Sub(r.lastPrune) > r.period {
+		for key, window := range r.windows {
+			if now.Sub(window.start) > r.period {
+				delete(r.windows, key)
+			}
+		}
+
+		r.lastPrune = now
+	}
+
+	window, ok := r.windows[client]
+	if !ok || now.Sub(window.start) > r.period {
+		window = &rateWindow{start: now}
+		r.windows[client] = window
+	}
+
+	window.calls++
+
+	return window.calls <= r.limit
+}
+
+// clientAddress returns the address of the client. For calls through the in-process gateway, which connects over
+// loopback, it is the last entry of X-Forwarded-For, as the gateway appends the address it received the request from to
+// the header sent by the client. The header is ignored for other peers, as direct gRPC clients can set it to anything.
+func clientAddress(ctx context.Context) string {
+	p, ok := peer.FromContext(ctx)
+	if !ok {
+		return ""
+	}
+
+	host, _, err := net.SplitHostPort(p.Addr.String())
+	if err != nil {
+		host = p.Addr.String()
+	}
+
+	if ip := net.ParseIP(host); ip == nil || !ip.IsLoopback() {
+		return host
+	}
+
+	md, _ := metadata.FromIncomingContext(ctx)
+	if forwardedFor := md.Get("x-forwarded-for"); len(forwardedFor) != 0 {
+		addresses := strings.Split(forwardedFor[len(forwardedFor)-1], ",")
+
+		return strings.TrimSpace(addresses[len(addresses)-1])
+	}
+
+	return host
+}
@@ -0,0 +1,180 @@
+package applications
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	pb "github.com/cfhn/our-space/ourspace-backend/proto"
+)
+
+var (
+	ErrNotFound   = errors.New("application not found")
+	ErrNotPending = errors.New("application was already decided")
+)
+
+const selectApplication = `
+	select id, name, email, phone, birth_date, message, state, create_time, decide_time, decided_by, comment, member_id
+	from applications
+`
+
+type Postgres struct {
+	db *sql.DB
+}
+
+func NewPostgresRepo(db *sql.DB) *Postgres {
+	return &Postgres{db: db}
+}
+
+func (p *Postgres) CreateApplication(ctx context.Context, application *pb.Application) error {
+	var birthDate sql.Null[string]
+	if application.BirthDate != nil {
+		birthDate = sql.Null[string]{V: *application.BirthDate, Valid: true}
+	}
+
+	_, err := p.db.ExecContext(ctx, `
+		insert into applications (id, name, email, phone, birth_date, message)
+		values ($1, $2, $3, $4, $5::date, $6)
+	`, application.Id, application.Name, application.Email, application.Phone, birthDate, application.Message)
+
+	return err
+}
+
+func (p *Postgres) GetApplication(ctx context.Context, id string) (*pb.Application, error) {
+	application, err := scanApplication(p.db.QueryRowContext(ctx, selectApplication+`where id = $1`, id))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrNotFound
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return application, nil
+}
+
+func (p *Postgres) ListApplications(
+	ctx context.Context, pageSize int32, pageToken *pb.ApplicationPageToken, state pb.Application_State,
+) ([]*pb.Application, error) {
+	rows, err := p.db.QueryContext(ctx, selectApplication+`
+		where state = $1
+		order by create_time, id
+		limit $2
+		offset $3
+	`, state.String(), pageSize, pageToken.Offset)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var applications []*pb.Application
+
+	for rows.Next() {
+		application, err := scanApplication(rows)
+		if err != nil {
+			return nil, err
+		}
+
+		applications = append(applications, application)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return applications, nil
+}
+
+// DecideApplication accepts or rejects the pending application, ErrNotPending is returned if it was decided before.
+func (p *Postgres) DecideApplication(
+	ctx context.Context, id string, state pb.Application_State, memberID *string, decidedBy, comment string,
+) (*pb.Application, error) {
+	var member sql.Null[string]
+	if memberID != nil {
+		member = sql.Null[string]{V: *memberID, Valid: true}
+	}
+
+	application, err := scanApplication(p.db.QueryRowContext(ctx, `
+		update applications
+		set state = $2, decide_time = now(), decided_by = $3, comment = $4, member_id = $5
+		where id = $1 and state = 'STATE_PENDING'
+		returning id, name, email, phone, birth_date, message, state, create_time, decide_time, decided_by, comment,
+		          member_id
+	`, id, state.String(), decidedBy, comment, member))
+	if !errors.Is(err, sql.ErrNoRows) {
+		return application, err
+	}
+
+	_, err = p.GetApplication(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return nil, ErrNotPending
+}
+
+// ReopenApplication makes an accepted application pending again, it is used when creating the member failed.
+func (p *Postgres) ReopenApplication(ctx context.Context, id string) error {
+	_, err := p.db.ExecContext(ctx, `
+		update applications
+		set state = 'STATE_PENDING', decide_time = null, decided_by = '', comment = ''
+		where id = $1 and state = 'STATE_ACCEPTED' and member_id is null
+	`, id)
+
+	return err
+}
+
+// SetApplicationMember records the member created for the accepted application.
+func (p *Postgres) SetApplicationMember(ctx context.Context, id, memberID string) (*pb.Application, error) {
+	return scanApplication(p.db.QueryRowContext(ctx, `
+		update applications
+		set member_id = $2
+		where id = $1
+		returning id, name, email, phone, birth_date, message, state, create_time, decide_time, decided_by, comment,
+		          member_id
+	`, id, memberID))
+}
+
+type scanner interface {
+	Scan(values ...any) error
+}
+
+func scanApplication(in scanner) (*pb.Application, error) {
+	var (
+		application = &pb.Application{}
+		birthDate   sql.Null[time.Time]
+		state       string
+		createTime  time.Time
+		decideTime  sql.Null[time.Time]
+		memberID    sql.Null[string]
+	)
+
+	err := in.Scan(
+		&application.Id, &application.Name, &application.Email, &application.Phone, &birthDate, &application.Message,
+		&state, &createTime, &decideTime, &application.DecidedBy, &application.Comment, &memberID,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	application.State = pb.Application_State(pb.Application_State_value[state])
+	application.CreateTime = timestamppb.New(createTime)
+
+	if birthDate.Valid {
+		application.BirthDate = proto.String(birthDate.V.Format(time.DateOnly))
+	}
+
+	if decideTime.Valid {
+		application.DecideTime = timestamppb.New(decideTime.V)
+	}
+
+	if memberID.Valid {
+		application.MemberId = &memberID.V
+	}
+
+	return application, nil
+}
@@ -0,0 +1,294 @@
+package applications
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"net/mail"
+	"regexp"
+	"time"
+
+	"github.com/google/uuid"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	pb "github.com/cfhn/our-space/ourspace-backend/proto"
+	"github.com/cfhn/our-space/pkg/setup"
+	"github.com/cfhn/our-space/pkg/status"
+)
+
+//nolint:gochecknoglobals // static pattern
+var phonePattern = regexp.MustCompile(`^\+?[0-9(][0-9 ()/-]*[0-9]$`)
+
+type MemberService interface {
+	CreateMember(ctx context.Context, request *pb.CreateMemberRequest) (*pb.Member, error)
+}
+
+type Service struct {
+	repo          *Postgres
+	memberService MemberService
+	limiter       *rateLimiter
+	pb.UnimplementedApplicationServiceServer
+}
+
+// NewService creates the service, each client can submit rateLimit applications per ratePeriod.
+func NewService(repo *Postgres, memberService MemberService, rateLimit int, ratePeriod time.Duration) *Service {
+	return &Service{
+		repo:          repo,
+		memberService: memberService,
+		limiter:       newRateLimiter(rateLimit, ratePeriod),
+	}
+}
+
+// SubmitApplication is called without authentication. It never returns data, as calls without claims have full access
+// to other services.
+func (s *Service) SubmitApplication(
+	ctx context.Context, request *pb.SubmitApplicationRequest,
+) (*emptypb.Empty, error) {
+	if !s.limiter.allow(clientAddress(ctx), time.Now()) {
+		return nil, status.ResourceExhausted("too many applications, try again later")
+	}
+
+	if request.Website != "" {
+		// only bots fill the honeypot, they are not told that the application was dropped
+		return &emptypb.Empty{}, nil
+	}
+
+	fieldViolations := validateSubmitApplication(request, time.Now())
+	if len(fieldViolations) != 0 {
+		return nil, status.FieldViolations(fieldViolations)
+	}
+
+	err := s.repo.CreateApplication(ctx, &pb.Application{
+		Id:        uuid.New().String(),
+		Name:      request.Name,
+		Email:     request.Email,
+		Phone:     request.Phone,
+		BirthDate: request.BirthDate,
+		Message:   request.Message,
+	})
+	if err != nil {
+		return nil, status.Internal(err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func validateSubmitApplication(
+	request *pb.SubmitApplicationRequest, now time.Time,
+) []*errdetails.BadRequest_FieldViolation {
+	var fieldViolations []*errdetails.BadRequest_FieldViolation
+
+	if request.Name == "" {
+		fieldViolations = append(fieldViolations, &errdetails.BadRequest_FieldViolation{
+			Field:       "name",
+			Description: "name must not be empty",
+			Reason:      "FIELD_EMPTY",
+		})
+	}
+
+	if len(request.Name) > 1024 {
+		fieldViolations = append(fieldViolations, &errdetails.BadRequest_FieldViolation{
+			Field:       "name",
+			Description: "name must not be over 1KB",
+			Reason:      "FIELD_TOO_LARGE",
+		})
+	}
+
+	if address, err := mail.ParseAddress(request.Email); err != nil || address.Address != request.Email {
+		fieldViolations = append(fieldViolations, &errdetails.BadRequest_FieldViolation{
+			Field:       "email",
+			Description: "email must be an email address",
+			Reason:      "FIELD_INVALID",
+		})
+	}
+
+	if request.Phone != "" && (!phonePattern.MatchString(request.Phone) || len(request.Phone) > 64) {
+		fieldViolations = append(fieldViolations, &errdetails.BadRequest_FieldViolation{
+			Field:       "phone",
+			Description: "phone must be a phone number",
+			Reason:      "FIELD_INVALID",
+		})
+	}
+
+	if request.BirthDate != nil {
+		birthDate, err := time.Parse(time.DateOnly, *request.BirthDate)
+		if err != nil || birthDate.Year() < 1900 || birthDate.After(now) {
+			fieldViolations = append(fieldViolations, &errdetails.BadRequest_FieldViolation{
+				Field:       "birth_date",
+				Description: "birth_date must be a date (YYYY-MM-DD) after 1900 and not in the future",
+				Reason:      "FIELD_INVALID",
+			})
+		}
+	}
+
+	if len(request.Message) > 4096 {
+		fieldViolations = append(fieldViolations, &errdetails.BadRequest_FieldViolation{
+			Field:       "message",
+			Description: "message must be smaller than 4KB",
+			Reason:      "FIELD_TOO_LARGE",
+		})
+	}
+
+	return fieldViolations
+}
+
+func (s *Service) ListApplications(
+	ctx context.Context, request *pb.ListApplicationsRequest,
+) (*pb.ListApplicationsResponse, error) {
+	pageTokenBytes, err := base64.RawStdEncoding.DecodeString(request.PageToken)
+	if err != nil {
+		return nil, err
+	}
+
+	pageToken := &pb.ApplicationPageToken{}
+
+	err = proto.Unmarshal(pageTokenBytes, pageToken)
+	if err != nil {
+		return nil, err
+	}
+
+	pageSize := request.PageSize
+	if pageSize == 0 {
+		pageSize = 50
+	}
+
+	state := request.State
+	if state == pb.Application_STATE_UNKNOWN {
+		state = pb.Application_STATE_PENDING
+	}
+
+	applications, err := s.repo.ListApplications(ctx, pageSize+1, pageToken, state)
+	if err != nil {
+		return nil, status.Internal(err)
+	}
+
+	var nextPageToken string
+
+	if len(applications) > int(pageSize) {
+		applications = applications[:pageSize]
+
+		nextPageTokenBytes, err := proto.Marshal(&pb.ApplicationPageToken{Offset: pageToken.Offset + pageSize})
+		if err != nil {
+			return nil, err
+		}
+
+		nextPageToken = base64.RawStdEncoding.EncodeToString(nextPageTokenBytes)
+	}
+
+	return &pb.ListApplicationsResponse{
+		Applications:  applications,
+		NextPageToken: nextPageToken,
+	}, nil
+}
+
+// AcceptApplication creates the member through the member service, so that it is validated like any other member. The
+// application is accepted before the member is created, so that a concurrent rejection can't leave a member behind a
+// rejected application. It is pending again if the member can't be created. The member gets the id of the
+// application.
+func (s *Service) AcceptApplication(
+	ctx context.Context, request *pb.AcceptApplicationRequest,
+) (*pb.Application, error) {
+	application, err := s.pendingApplication(ctx, request.Id, request.Comment)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = s.decideApplication(ctx, request.Id, pb.Application_STATE_ACCEPTED, nil, request.Comment)
+	if err != nil {
+		return nil, err
+	}
+
+	member, err := s.memberService.CreateMember(ctx, &pb.CreateMemberRequest{
+		MemberId: application.Id,
+		Member: &pb.Member{
+			Name:                 application.Name,
+			MembershipStart:      request.MembershipStart,
+			AgeCategory:          request.AgeCategory,
+			Tags:                 request.Tags,
+			AdditionalAttributes: request.AdditionalAttributes,
+			BirthDate:            application.BirthDate,
+		},
+		Relationships: request.Relationships,
+	})
+	if err != nil {
+		// reopened even if the caller went away, otherwise the application would stay accepted without a member
+		reopenErr := s.repo.ReopenApplication(context.WithoutCancel(ctx), request.Id)
+		if reopenErr != nil {
+			return nil, status.Internal(errors.Join(err, reopenErr))
+		}
+
+		return nil, err
+	}
+
+	accepted, err := s.repo.SetApplicationMember(ctx, request.Id, member.Id)
+	if err != nil {
+		return nil, status.Internal(err)
+	}
+
+	return accepted, nil
+}
+
+func (s *Service) RejectApplication(
+	ctx context.Context, request *pb.RejectApplicationRequest,
+) (*pb.Application, error) {
+	_, err := s.pendingApplication(ctx, request.Id, request.Comment)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.decideApplication(ctx, request.Id, pb.Application_STATE_REJECTED, nil, request.Comment)
+}
+
+// pendingApplication returns the application if it can be decided, otherwise a gRPC error.
+func (s *Service) pendingApplication(ctx context.Context, id, comment string) (*pb.Application, error) {
+	if _, err := uuid.Parse(id); err != nil {
+		return nil, status.NotFound()
+	}
+
+	if len(comment) > 4096 {
+		return nil, status.FieldViolations([]*errdetails.BadRequest_FieldViolation{{
+			Field:       "comment",
+			Description: "comment must be smaller than 4KB",
+			Reason:      "FIELD_TOO_LARGE",
+		}})
+	}
+
+	application, err := s.repo.GetApplication(ctx, id)
+	if errors.Is(err, ErrNotFound) {
+		return nil, status.NotFound()
+	}
+
+	if err != nil {
+		return nil, status.Internal(err)
+	}
+
+	if application.State != pb.Application_STATE_PENDING {
+		return nil, status.FailedPrecondition("application was already decided")
+	}
+
+	return application, nil
+}
+
+func (s *Service) decideApplication(
+	ctx context.Context, id string, state pb.Application_State, memberID *string, comment string,
+) (*pb.Application, error) {
+	var decidedBy string
+	if claims, ok := setup.GetAccessTokenClaims(ctx); ok {
+		decidedBy = claims.Subject
+	}
+
+	application, err := s.repo.DecideApplication(ctx, id, state, memberID, decidedBy, comment)
+
+	switch {
+	case errors.Is(err, ErrNotFound):
+		return nil, status.NotFound()
+	case errors.Is(err, ErrNotPending):
+		return nil, status.FailedPrecondition("application was already decided")
+	case err != nil:
+		return nil, status.Internal(err)
+	}
+
+	return application, nil
+}
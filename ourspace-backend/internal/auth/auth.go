@@ -37,6 +37,7 @@ type LoginDetails struct {
 	ID           string
 	Username     string
 	PasswordHash string
+	Roles        []string
 
 	FullName string
 }
@@ -107,8 +108,7 @@ func (s *Service) passwordLogin(ctx context.Context, credentials *pb.LoginPasswo
 	}
 
 	accessToken, accessTokenExpiry, refreshToken, refreshTokenExpiry, err := s.generateTokens(
-		credentials.Username,
-		loginDetails.FullName,
+		tokenSubject{ID: credentials.Username, FullName: loginDetails.FullName, Roles: loginDetails.Roles},
 		time.Now(),
 		accessTokenValidity,
 	)
@@ -139,8 +139,7 @@ func (s *Service) apiKeyLogin(ctx context.Context, credentials *pb.LoginApiKey)
 	}
 
 	accessToken, accessTokenExpiry, _, _, err := s.generateTokens(
-		apiKeyDetails.ID,
-		"",
+		tokenSubject{ID: apiKeyDetails.ID, APIKey: true},
 		time.Now(),
 		apiKeyAccessTokenValidity,
 	)
@@ -190,8 +189,7 @@ func (s *Service) Refresh(ctx context.Context, request *pb.RefreshRequest) (*pb.
 	}
 
 	accessToken, accessTokenExpiry, refreshToken, refreshTokenExpiry, err := s.generateTokens(
-		refreshTokenClaims.Subject,
-		loginDetail.FullName,
+		tokenSubject{ID: refreshTokenClaims.Subject, FullName: loginDetail.FullName, Roles: loginDetail.Roles},
 		refreshTokenClaims.LoginTime.Time,
 		accessTokenValidity,
 	)
@@ -15,14 +15,22 @@ import (
 
 var ErrSessionExceedsLifetime = errors.New("session max length exceeds lifetime")
 
+// tokenSubject is who the tokens are issued for, the username of a user or the id of an API key.
+type tokenSubject struct {
+	ID       string
+	FullName string
+	Roles    []string
+	APIKey   bool
+}
+
 func (s *Service) generateTokens(
-	username, name string, loginTime time.Time, accessTokenValidity time.Duration,
+	subject tokenSubject, loginTime time.Time, accessTokenValidity time.Duration,
 ) (string, time.Time, string, time.Time, error) {
 	now := time.Now()
 	accessToken := jwt.NewWithClaims(jwt.SigningMethodES256, setup.AccessTokenClaims{
 		RegisteredClaims: jwt.RegisteredClaims{
 			Issuer:    s.issuer,
-			Subject:   username,
+			Subject:   subject.ID,
 			Audience:  nil,
 			ExpiresAt: jwt.NewNumericDate(now.Add(accessTokenValidity)),
 			NotBefore: jwt.NewNumericDate(now.Add(-15 * time.Second)),
@@ -30,7 +38,9 @@ func (s *Service) generateTokens(
 			ID:        uuid.NewString(),
 		},
 		Type:     "access",
-		FullName: name,
+		FullName: subject.FullName,
+		Roles:    subject.Roles,
+		APIKey:   subject.APIKey,
 	})
 
 	signignKey := s.signingKey.Load()
@@ -55,7 +65,7 @@ func (s *Service) generateTokens(
 	refreshToken := jwt.NewWithClaims(jwt.SigningMethodES256, setup.RefreshTokenClaims{
 		RegisteredClaims: jwt.RegisteredClaims{
 			Issuer:    s.issuer,
-			Subject:   username,
+			Subject:   subject.ID,
 			Audience:  nil,
 			ExpiresAt: jwt.NewNumericDate(now.Add(refreshTokenValidity)),
 			NotBefore: jwt.NewNumericDate(now.Add(-15 * time.Second)),
@@ -5,6 +5,8 @@ import (
 	"database/sql"
 	"errors"
 	"fmt"
+
+	"github.com/jackc/pgx/v5/pgtype"
 )
 
 var (
@@ -23,7 +25,10 @@ func NewPostgresRepo(db *sql.DB) *PostgresRepository {
 }
 
 func (r *PostgresRepository) FindUserLoginDetails(ctx context.Context, username string) (*LoginDetails, error) {
-	var result LoginDetails
+	var (
+		result LoginDetails
+		roles  pgtype.FlatArray[string]
+	)
 
 	err := r.db.QueryRowContext(
 		ctx, `
@@ -31,6 +36,7 @@ func (r *PostgresRepository) FindUserLoginDetails(ctx context.Context, username
 			members.id,
 			members_auth.username,
 			members_auth.password_hash,
+			members_auth.roles,
 			members.name
 		from members_auth
 		inner join members on members.id = members_auth.id
@@ -40,6 +46,7 @@ func (r *PostgresRepository) FindUserLoginDetails(ctx context.Context, username
 		&result.ID,
 		&result.Username,
 		&result.PasswordHash,
+		&roles,
 		&result.FullName,
 	)
 
@@ -51,6 +58,8 @@ func (r *PostgresRepository) FindUserLoginDetails(ctx context.Context, username
 		return nil, err
 	}
 
+	result.Roles = roles
+
 	return &result, nil
 }
 
@@ -0,0 +1,416 @@
+package briefings
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgtype"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	pb "github.com/cfhn/our-space/ourspace-backend/proto"
+)
+
+const foreignKeyViolation = "23503"
+
+var (
+	ErrNotFound          = errors.New("not found")
+	ErrReferenceNotFound = errors.New("referenced member or briefing type not found")
+)
+
+const selectBriefingType = `
+	select id, display_name, description, coalesce(extract(epoch from expires_after), 0)::float8
+	from briefing_types
+`
+
+// selectBriefing derives the expiry time from the briefing type, briefings of types without expiry have none.
+const selectBriefing = `
+	select
+		briefings.id, briefing_type_id, member_id, briefing_time,
+		briefing_time + nullif(briefing_types.expires_after, interval '0')
+	from briefings
+	inner join briefing_types on briefing_types.id = briefings.briefing_type_id
+`
+
+type Filters struct {
+	MemberID     string
+	BriefingType string
+	ValidOnly    bool
+}
+
+type Postgres struct {
+	db *sql.DB
+}
+
+func NewPostgresRepo(db *sql.DB) *Postgres {
+	return &Postgres{db: db}
+}
+
+func (p *Postgres) CreateBriefingType(ctx context.Context, briefingType *pb.BriefingType) (*pb.BriefingType, error) {
+	_, err := p.db.ExecContext(ctx, `
+		insert into briefing_types (id, display_name, description, expires_after)
+		values ($1, $2, $3, $4 * interval '1 second');
+	`, briefingType.Id, briefingType.DisplayName, briefingType.Description, expiresAfter(briefingType.ExpiresAfter))
+	if err != nil {
+		return nil, err
+	}
+
+	return p.GetBriefingType(ctx, briefingType.Id)
+}
+
+func (p *Postgres) GetBriefingType(ctx context.Context, id string) (*pb.BriefingType, error) {
+	row := p.db.QueryRowContext(ctx, selectBriefingType+`where id = $1`, id)
+
+	briefingType, err := scanBriefingType(row)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrNotFound
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return briefingType, nil
+}
+
+func (p *Postgres) ListBriefingTypes(
+	ctx context.Context, pageSize int32, token *pb.BriefingPageToken,
+) ([]*pb.BriefingType, error) {
+	lastID := sql.Null[string]{V: token.LastId, Valid: token.LastId != ""}
+
+	rows, err := p.db.QueryContext(ctx, selectBriefingType+`
+		where ($2::uuid is null OR id > $2)
+		order by id
+		limit $1
+	`, pageSize, lastID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	briefingTypes := make([]*pb.BriefingType, 0, pageSize)
+
+	for rows.Next() {
+		briefingType, err := scanBriefingType(rows)
+		if err != nil {
+			return nil, err
+		}
+
+		briefingTypes = append(briefingTypes, briefingType)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return briefingTypes, nil
+}
+
+func (p *Postgres) UpdateBriefingType(
+	ctx context.Context, briefingType *pb.BriefingType, fieldMask *fieldmaskpb.FieldMask,
+) (*pb.BriefingType, error) {
+	var (
+		displayName        sql.Null[string]
+		description        sql.Null[string]
+		changeExpiresAfter bool
+	)
+
+	for _, path := range fieldMask.Paths {
+		switch path {
+		case "display_name":
+			displayName = sql.Null[string]{V: briefingType.DisplayName, Valid: true}
+		case "description":
+			description = sql.Null[string]{V: briefingType.Description, Valid: true}
+		case "expires_after":
+			changeExpiresAfter = true
+		}
+	}
+
+	result, err := p.db.ExecContext(ctx, `
+		update briefing_types
+		set
+			display_name = coalesce($2, display_name),
+			description = coalesce($3, description),
+			expires_after = case when $4 then $5 * interval '1 second' else expires_after end
+		where id = $1
+	`,
+		briefingType.Id, displayName, description, changeExpiresAfter, expiresAfter(briefingType.ExpiresAfter),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return nil, err
+	}
+
+	if affected == 0 {
+		return nil, ErrNotFound
+	}
+
+	return p.GetBriefingType(ctx, briefingType.Id)
+}
+
+func (p *Postgres) DeleteBriefingType(ctx context.Context, id string) error {
+	return p.delete(ctx, `delete from briefing_types where id = $1`, id)
+}
+
+func (p *Postgres) CreateBriefing(ctx context.Context, briefing *pb.Briefing) (*pb.Briefing, error) {
+	_, err := p.db.ExecContext(ctx, `
+		insert into briefings (id, briefing_type_id, member_id, briefing_time)
+		values ($1, $2, $3, $4);
+	`, briefing.Id, briefing.BriefingType, briefing.MemberId, briefing.BriefingTime.AsTime())
+	if err != nil {
+		return nil, mapReferenceError(err)
+	}
+
+	return p.GetBriefing(ctx, briefing.Id)
+}
+
+func (p *Postgres) GetBriefing(ctx context.Context, id string) (*pb.Briefing, error) {
+	row := p.db.QueryRowContext(ctx, selectBriefing+`where briefings.id = $1`, id)
+
+	briefing, err := scanBriefing(row)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrNotFound
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return briefing, nil
+}
+
+func (p *Postgres) ListBriefings(
+	ctx context.Context, pageSize int32, token *pb.BriefingPageToken, filters *Filters,
+) ([]*pb.Briefing, error) {
+	var (
+		lastID       = sql.Null[string]{V: token.LastId, Valid: token.LastId != ""}
+		memberID     = sql.Null[string]{V: filters.MemberID, Valid: filters.MemberID != ""}
+		briefingType = sql.Null[string]{V: filters.BriefingType, Valid: filters.BriefingType != ""}
+	)
+
+	rows, err := p.db.QueryContext(ctx, selectBriefing+`
+		where ($2::uuid is null OR briefings.id > $2)
+		and ($3::uuid is null OR member_id = $3)
+		and ($4::uuid is null OR briefing_type_id = $4)
+		and (
+			$5 is false
+			OR briefing_types.expires_after is null
+			OR briefing_types.expires_after = interval '0'
+			OR briefing_time + briefing_types.expires_after > now()
+		)
+		order by briefings.id
+		limit $1
+	`, pageSize, lastID, memberID, briefingType, filters.ValidOnly)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	briefings := make([]*pb.Briefing, 0, pageSize)
+
+	for rows.Next() {
+		briefing, err := scanBriefing(rows)
+		if err != nil {
+			return nil, err
+		}
+
+		briefings = append(briefings, briefing)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return briefings, nil
+}
+
+func (p *Postgres) UpdateBriefing(
+	ctx context.Context, briefing *pb.Briefing, fieldMask *fieldmaskpb.FieldMask,
+) (*pb.Briefing, error) {
+	var (
+		briefingType sql.Null[string]
+		memberID     sql.Null[string]
+		briefingTime sql.Null[time.Time]
+	)
+
+	for _, path := range fieldMask.Paths {
+		switch path {
+		case "briefing_type":
+			briefingType = sql.Null[string]{V: briefing.BriefingType, Valid: true}
+		case "member_id":
+			memberID = sql.Null[string]{V: briefing.MemberId, Valid: true}
+		case "briefing_time":
+			briefingTime = sql.Null[time.Time]{V: briefing.BriefingTime.AsTime(), Valid: true}
+		}
+	}
+
+	result, err := p.db.ExecContext(ctx, `
+		update briefings
+		set
+			briefing_type_id = coalesce($2, briefing_type_id),
+			member_id = coalesce($3, member_id),
+			briefing_time = coalesce($4, briefing_time)
+		where id = $1
+	`, briefing.Id, briefingType, memberID, briefingTime)
+	if err != nil {
+		return nil, mapReferenceError(err)
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return nil, err
+	}
+
+	if affected == 0 {
+		return nil, ErrNotFound
+	}
+
+	return p.GetBriefing(ctx, briefing.Id)
+}
+
+func (p *Postgres) DeleteBriefing(ctx context.Context, id string) error {
+	return p.delete(ctx, `delete from briefings where id = $1`, id)
+}
+
+func (p *Postgres) delete(ctx context.Context, query, id string) error {
+	result, err := p.db.ExecContext(ctx, query, id)
+	if err != nil {
+		return err
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if affected == 0 {
+		return ErrNotFound
+	}
+
+	return nil
+}
+
+// MissingBriefingTypes returns the briefing types out of the given ones, for which the member has no briefing that
+// is valid at the given time.
+func (p *Postgres) MissingBriefingTypes(
+	ctx context.Context, memberID string, briefingTypes []string, at time.Time,
+) ([]string, error) {
+	rows, err := p.db.QueryContext(ctx, `
+		select required.briefing_type
+		from unnest($2::text[]) with ordinality as required(briefing_type, position)
+		where not exists (
+			select 1 from briefings
+			inner join briefing_types on briefing_types.id = briefings.briefing_type_id
+			where briefings.member_id = $1
+			and briefing_types.id::text = required.briefing_type
+			and briefings.briefing_time <= $3
+			and (
+				briefing_types.expires_after is null
+				OR briefing_types.expires_after = interval '0'
+				OR briefings.briefing_time + briefing_types.expires_after > $3
+			)
+		)
+		order by required.position
+	`, memberID, pgtype.FlatArray[string](briefingTypes), at)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var missing []string
+
+	for rows.Next() {
+		var briefingType string
+
+		err := rows.Scan(&briefingType)
+		if err != nil {
+			return nil, err
+		}
+
+		missing = append(missing, briefingType)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return missing, nil
+}
+
+func mapReferenceError(err error) error {
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && pgErr.Code == foreignKeyViolation {
+		return ErrReferenceNotFound
+	}
+
+	return err
+}
+
+type scanner interface {
+	Scan(values ...any) error
+}
+
+func scanBriefingType(in scanner) (*pb.BriefingType, error) {
+	var (
+		briefingType        = &pb.BriefingType{}
+		expiresAfterSeconds float64
+	)
+
+	err := in.Scan(
+		&briefingType.Id,
+		&briefingType.DisplayName,
+		&briefingType.Description,
+		&expiresAfterSeconds,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	briefingType.ExpiresAfter = durationpb.New(time.Duration(expiresAfterSeconds * float64(time.Second)))
+
+	return briefingType, nil
+}
+
+func scanBriefing(in scanner) (*pb.Briefing, error) {
+	var (
+		briefing     = &pb.Briefing{}
+		briefingTime time.Time
+		expiryTime   sql.Null[time.Time]
+	)
+
+	err := in.Scan(
+		&briefing.Id,
+		&briefing.BriefingType,
+		&briefing.MemberId,
+		&briefingTime,
+		&expiryTime,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	briefing.BriefingTime = timestamppb.New(briefingTime)
+
+	if expiryTime.Valid {
+		briefing.ExpiryTime = timestamppb.New(expiryTime.V)
+	}
+
+	return briefing, nil
+}
+
+// expiresAfter converts the duration to seconds, briefing types without expiry are stored as null.
+func expiresAfter(duration *durationpb.Duration) sql.Null[float64] {
+	if duration.AsDuration() <= 0 {
+		return sql.Null[float64]{}
+	}
+
+	return sql.Null[float64]{V: duration.AsDuration().Seconds(), Valid: true}
+}
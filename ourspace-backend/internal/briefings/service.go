@@ -0,0 +1,500 @@
+package briefings
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	pb "github.com/cfhn/our-space/ourspace-backend/proto"
+	"github.com/cfhn/our-space/pkg/status"
+)
+
+type Service struct {
+	repo *Postgres
+	pb.UnimplementedBriefingServiceServer
+}
+
+func NewService(repo *Postgres) *Service {
+	return &Service{repo: repo}
+}
+
+// MissingBriefingTypes returns the briefing types out of the given ones, for which the member has no currently valid
+// briefing. It is used by other services to gate events, items and machines behind briefings.
+func (s *Service) MissingBriefingTypes(ctx context.Context, memberID string, briefingTypes []string) ([]string, error) {
+	if len(briefingTypes) == 0 {
+		return nil, nil
+	}
+
+	return s.repo.MissingBriefingTypes(ctx, memberID, briefingTypes, time.Now())
+}
+
+func (s *Service) CreateBriefingType(
+	ctx context.Context, request *pb.CreateBriefingTypeRequest,
+) (*pb.BriefingType, error) {
+	fieldViolations := validateCreateBriefingType(request)
+	if len(fieldViolations) != 0 {
+		return nil, status.FieldViolations(fieldViolations)
+	}
+
+	if request.BriefingTypeId != "" {
+		request.BriefingType.Id = request.BriefingTypeId
+	} else {
+		request.BriefingType.Id = uuid.New().String()
+	}
+
+	briefingType, err := s.repo.CreateBriefingType(ctx, request.BriefingType)
+	if err != nil {
+		return nil, status.Internal(err)
+	}
+
+	return briefingType, nil
+}
+
+func validateCreateBriefingType(request *pb.CreateBriefingTypeRequest) []*errdetails.BadRequest_FieldViolation {
+	if request.BriefingType == nil {
+		return []*errdetails.BadRequest_FieldViolation{{
+			Field:       "briefing_type",
+			Description: "briefing_type field must not be empty",
+			Reason:      "FIELD_EMPTY",
+		}}
+	}
+
+	var fieldViolations []*errdetails.BadRequest_FieldViolation
+
+	if request.BriefingTypeId != "" {
+		if _, err := uuid.Parse(request.BriefingTypeId); err != nil {
+			fieldViolations = append(fieldViolations, &errdetails.BadRequest_FieldViolation{
+				Field:       "briefing_type_id",
+				Description: "briefing_type_id must be a valid UUID",
+				Reason:      "FIELD_INVALID",
+			})
+		}
+	}
+
+	fieldViolations = append(fieldViolations, validateDisplayName(request.BriefingType.DisplayName)...)
+	fieldViolations = append(fieldViolations, validateExpiresAfter(request.BriefingType)...)
+
+	return fieldViolations
+}
+
+func validateDisplayName(displayName string) []*errdetails.BadRequest_FieldViolation {
+	if displayName == "" {
+		return []*errdetails.BadRequest_FieldViolation{{
+			Field:       "briefing_type.display_name",
+			Description: "display_name must not be empty",
+			Reason:      "FIELD_EMPTY",
+		}}
+	}
+
+	if len(displayName) > 256 {
+		return []*errdetails.BadRequest_FieldViolation{{
+			Field:       "briefing_type.display_name",
+			Description: "display_name must be shorter than 256 characters, use description for longer texts",
+			Reason:      "FIELD_TOO_LARGE",
+		}}
+	}
+
+	return nil
+}
+
+func validateExpiresAfter(briefingType *pb.BriefingType) []*errdetails.BadRequest_FieldViolation {
+	if briefingType.ExpiresAfter.AsDuration() < 0 {
+		return []*errdetails.BadRequest_FieldViolation{{
+			Field:       "briefing_type.expires_after",
+			Description: "expires_after must not be negative, use 0 for briefings that never expire",
+			Reason:      "FIELD_INVALID",
+		}}
+	}
+
+	return nil
+}
+
+func (s *Service) GetBriefingType(ctx context.Context, request *pb.GetBriefingTypeRequest) (*pb.BriefingType, error) {
+	briefingType, err := s.repo.GetBriefingType(ctx, request.Id)
+	if errors.Is(err, ErrNotFound) {
+		return nil, status.NotFound()
+	}
+
+	if err != nil {
+		return nil, status.Internal(err)
+	}
+
+	return briefingType, nil
+}
+
+func (s *Service) ListBriefingTypes(
+	ctx context.Context, request *pb.ListBriefingTypesRequest,
+) (*pb.ListBriefingTypesResponse, error) {
+	pageToken, err := decodePageToken(request.PageToken)
+	if err != nil {
+		return nil, err
+	}
+
+	pageSize := request.PageSize
+	if pageSize == 0 {
+		pageSize = 50
+	}
+
+	briefingTypes, err := s.repo.ListBriefingTypes(ctx, pageSize+1, pageToken)
+	if err != nil {
+		return nil, status.Internal(err)
+	}
+
+	var nextPageToken string
+
+	if len(briefingTypes) > int(pageSize) {
+		briefingTypes = briefingTypes[:pageSize]
+
+		nextPageToken, err = encodePageToken(briefingTypes[pageSize-1].Id)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &pb.ListBriefingTypesResponse{
+		BriefingTypes: briefingTypes,
+		NextPageToken: nextPageToken,
+	}, nil
+}
+
+func (s *Service) UpdateBriefingType(
+	ctx context.Context, request *pb.UpdateBriefingTypeRequest,
+) (*pb.BriefingType, error) {
+	fieldViolations := validateUpdateBriefingType(request)
+	if len(fieldViolations) != 0 {
+		return nil, status.FieldViolations(fieldViolations)
+	}
+
+	briefingType, err := s.repo.UpdateBriefingType(ctx, request.BriefingType, request.FieldMask)
+	if errors.Is(err, ErrNotFound) {
+		return nil, status.NotFound()
+	}
+
+	if err != nil {
+		return nil, status.Internal(err)
+	}
+
+	return briefingType, nil
+}
+
+func validateUpdateBriefingType(request *pb.UpdateBriefingTypeRequest) []*errdetails.BadRequest_FieldViolation {
+	if request.BriefingType == nil {
+		return []*errdetails.BadRequest_FieldViolation{{
+			Field:       "briefing_type",
+			Description: "briefing_type field must not be empty",
+			Reason:      "FIELD_EMPTY",
+		}}
+	}
+
+	if !request.FieldMask.IsValid(&pb.BriefingType{}) {
+		return []*errdetails.BadRequest_FieldViolation{{
+			Field:       "field_mask",
+			Description: "invalid field_mask",
+			Reason:      "FIELD_INVALID",
+		}}
+	}
+
+	fieldViolations := make([]*errdetails.BadRequest_FieldViolation, 0)
+
+	for _, path := range request.FieldMask.Paths {
+		switch path {
+		case "display_name":
+			fieldViolations = append(fieldViolations, validateDisplayName(request.BriefingType.DisplayName)...)
+		case "expires_after":
+			fieldViolations = append(fieldViolations, validateExpiresAfter(request.BriefingType)...)
+		case "description":
+		default:
+			fieldViolations = append(fieldViolations, &errdetails.BadRequest_FieldViolation{
+				Field:       "field_mask",
+				Description: path + " can not be updated",
+				Reason:      "FIELD_INVALID",
+			})
+		}
+	}
+
+	return fieldViolations
+}
+
+func (s *Service) DeleteBriefingType(
+	ctx context.Context, request *pb.DeleteBriefingTypeRequest,
+) (*emptypb.Empty, error) {
+	err := s.repo.DeleteBriefingType(ctx, request.Id)
+	if errors.Is(err, ErrNotFound) {
+		return nil, status.NotFound()
+	}
+
+	if err != nil {
+		return nil, status.Internal(err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s *Service) CreateBriefing(ctx context.Context, request *pb.CreateBriefingRequest) (*pb.Briefing, error) {
+	fieldViolations := validateCreateBriefing(request)
+	if len(fieldViolations) != 0 {
+		return nil, status.FieldViolations(fieldViolations)
+	}
+
+	if request.BriefingId != "" {
+		request.Briefing.Id = request.BriefingId
+	} else {
+		request.Briefing.Id = uuid.New().String()
+	}
+
+	briefing, err := s.repo.CreateBriefing(ctx, request.Briefing)
+	if errors.Is(err, ErrReferenceNotFound) {
+		return nil, status.FieldViolations(referenceViolations())
+	}
+
+	if err != nil {
+		return nil, status.Internal(err)
+	}
+
+	return briefing, nil
+}
+
+func validateCreateBriefing(request *pb.CreateBriefingRequest) []*errdetails.BadRequest_FieldViolation {
+	if request.Briefing == nil {
+		return []*errdetails.BadRequest_FieldViolation{{
+			Field:       "briefing",
+			Description: "briefing field must not be empty",
+			Reason:      "FIELD_EMPTY",
+		}}
+	}
+
+	var fieldViolations []*errdetails.BadRequest_FieldViolation
+
+	if request.BriefingId != "" {
+		if _, err := uuid.Parse(request.BriefingId); err != nil {
+			fieldViolations = append(fieldViolations, &errdetails.BadRequest_FieldViolation{
+				Field:       "briefing_id",
+				Description: "briefing_id must be a valid UUID",
+				Reason:      "FIELD_INVALID",
+			})
+		}
+	}
+
+	fieldViolations = append(fieldViolations, validateBriefingType(request.Briefing.BriefingType)...)
+	fieldViolations = append(fieldViolations, validateMemberID(request.Briefing.MemberId)...)
+	fieldViolations = append(fieldViolations, validateBriefingTime(request.Briefing)...)
+
+	return fieldViolations
+}
+
+func validateBriefingType(briefingType string) []*errdetails.BadRequest_FieldViolation {
+	if _, err := uuid.Parse(briefingType); err != nil {
+		return []*errdetails.BadRequest_FieldViolation{{
+			Field:       "briefing.briefing_type",
+			Description: "briefing_type must be a valid UUID",
+			Reason:      "FIELD_INVALID",
+		}}
+	}
+
+	return nil
+}
+
+func validateMemberID(memberID string) []*errdetails.BadRequest_FieldViolation {
+	if _, err := uuid.Parse(memberID); err != nil {
+		return []*errdetails.BadRequest_FieldViolation{{
+			Field:       "briefing.member_id",
+			Description: "member_id must be a valid UUID",
+			Reason:      "FIELD_INVALID",
+		}}
+	}
+
+	return nil
+}
+
+func validateBriefingTime(briefing *pb.Briefing) []*errdetails.BadRequest_FieldViolation {
+	const briefingTimeLeeway = 15 * time.Minute
+
+	switch {
+	case briefing.BriefingTime == nil:
+		return []*errdetails.BadRequest_FieldViolation{{
+			Field:       "briefing.briefing_time",
+			Description: "briefing_time must be set",
+			Reason:      "FIELD_EMPTY",
+		}}
+	case briefing.BriefingTime.AsTime().After(time.Now().Add(briefingTimeLeeway)):
+		return []*errdetails.BadRequest_FieldViolation{{
+			Field:       "briefing.briefing_time",
+			Description: "briefing_time must not be in the future",
+			Reason:      "FIELD_INVALID",
+		}}
+	default:
+		return nil
+	}
+}
+
+func referenceViolations() []*errdetails.BadRequest_FieldViolation {
+	return []*errdetails.BadRequest_FieldViolation{{
+		Field:       "briefing.briefing_type",
+		Description: "briefing_type and member_id must refer to existing entries",
+		Reason:      "FIELD_INVALID",
+	}, {
+		Field:       "briefing.member_id",
+		Description: "briefing_type and member_id must refer to existing entries",
+		Reason:      "FIELD_INVALID",
+	}}
+}
+
+func (s *Service) GetBriefing(ctx context.Context, request *pb.GetBriefingRequest) (*pb.Briefing, error) {
+	briefing, err := s.repo.GetBriefing(ctx, request.Id)
+	if errors.Is(err, ErrNotFound) {
+		return nil, status.NotFound()
+	}
+
+	if err != nil {
+		return nil, status.Internal(err)
+	}
+
+	return briefing, nil
+}
+
+func (s *Service) ListBriefings(
+	ctx context.Context, request *pb.ListBriefingsRequest,
+) (*pb.ListBriefingsResponse, error) {
+	pageToken, err := decodePageToken(request.PageToken)
+	if err != nil {
+		return nil, err
+	}
+
+	filters := &Filters{ValidOnly: request.ValidOnly}
+	if request.MemberId != nil {
+		filters.MemberID = *request.MemberId
+	}
+
+	if request.BriefingType != nil {
+		filters.BriefingType = *request.BriefingType
+	}
+
+	pageSize := request.PageSize
+	if pageSize == 0 {
+		pageSize = 50
+	}
+
+	briefings, err := s.repo.ListBriefings(ctx, pageSize+1, pageToken, filters)
+	if err != nil {
+		return nil, status.Internal(err)
+	}
+
+	var nextPageToken string
+
+	if len(briefings) > int(pageSize) {
+		briefings = briefings[:pageSize]
+
+		nextPageToken, err = encodePageToken(briefings[pageSize-1].Id)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &pb.ListBriefingsResponse{
+		Briefings:     briefings,
+		NextPageToken: nextPageToken,
+	}, nil
+}
+
+func (s *Service) UpdateBriefing(ctx context.Context, request *pb.UpdateBriefingRequest) (*pb.Briefing, error) {
+	fieldViolations := validateUpdateBriefing(request)
+	if len(fieldViolations) != 0 {
+		return nil, status.FieldViolations(fieldViolations)
+	}
+
+	briefing, err := s.repo.UpdateBriefing(ctx, request.Briefing, request.FieldMask)
+
+	switch {
+	case errors.Is(err, ErrNotFound):
+		return nil, status.NotFound()
+	case errors.Is(err, ErrReferenceNotFound):
+		return nil, status.FieldViolations(referenceViolations())
+	case err != nil:
+		return nil, status.Internal(err)
+	}
+
+	return briefing, nil
+}
+
+func validateUpdateBriefing(request *pb.UpdateBriefingRequest) []*errdetails.BadRequest_FieldViolation {
+	if request.Briefing == nil {
+		return []*errdetails.BadRequest_FieldViolation{{
+			Field:       "briefing",
+			Description: "briefing field must not be empty",
+			Reason:      "FIELD_EMPTY",
+		}}
+	}
+
+	if !request.FieldMask.IsValid(&pb.Briefing{}) {
+		return []*errdetails.BadRequest_FieldViolation{{
+			Field:       "field_mask",
+			Description: "invalid field_mask",
+			Reason:      "FIELD_INVALID",
+		}}
+	}
+
+	fieldViolations := make([]*errdetails.BadRequest_FieldViolation, 0)
+
+	for _, path := range request.FieldMask.Paths {
+		switch path {
+		case "briefing_type":
+			fieldViolations = append(fieldViolations, validateBriefingType(request.Briefing.BriefingType)...)
+		case "member_id":
+			fieldViolations = append(fieldViolations, validateMemberID(request.Briefing.MemberId)...)
+		case "briefing_time":
+			fieldViolations = append(fieldViolations, validateBriefingTime(request.Briefing)...)
+		default:
+			fieldViolations = append(fieldViolations, &errdetails.BadRequest_FieldViolation{
+				Field:       "field_mask",
+				Description: path + " can not be updated",
+				Reason:      "FIELD_INVALID",
+			})
+		}
+	}
+
+	return fieldViolations
+}
+
+func (s *Service) DeleteBriefing(ctx context.Context, request *pb.DeleteBriefingRequest) (*emptypb.Empty, error) {
+	err := s.repo.DeleteBriefing(ctx, request.Id)
+	if errors.Is(err, ErrNotFound) {
+		return nil, status.NotFound()
+	}
+
+	if err != nil {
+		return nil, status.Internal(err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func decodePageToken(pageToken string) (*pb.BriefingPageToken, error) {
+	pageTokenBytes, err := base64.RawStdEncoding.DecodeString(pageToken)
+	if err != nil {
+		return nil, err
+	}
+
+	token := &pb.BriefingPageToken{}
+
+	err = proto.Unmarshal(pageTokenBytes, token)
+	if err != nil {
+		return nil, err
+	}
+
+	return token, nil
+}
+
+func encodePageToken(lastID string) (string, error) {
+	pageTokenBytes, err := proto.Marshal(&pb.BriefingPageToken{LastId: lastID})
+	if err != nil {
+		return "", err
+	}
+
+	return base64.RawStdEncoding.EncodeToString(pageTokenBytes), nil
+}
@@ -0,0 +1,77 @@
+package cards
+
+import (
+	"encoding/hex"
+
+	"google.golang.org/genproto/googleapis/api/httpbody"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/cfhn/our-space/ourspace-backend/internal/export"
+	pb "github.com/cfhn/our-space/ourspace-backend/proto"
+	"github.com/cfhn/our-space/pkg/status"
+)
+
+// ExportCards writes all cards matching the filter into a file, the RFID values are hex encoded.
+func (s *Service) ExportCards(request *pb.ExportCardsRequest, stream grpc.ServerStreamingServer[httpbody.HttpBody]) error {
+	ctx := stream.Context()
+
+	filter := &pb.ListCardsRequest{}
+	if request.Filter != nil {
+		filter = proto.Clone(request.Filter).(*pb.ListCardsRequest) //nolint:forcetypeassert // clone has same type
+	}
+
+	filter.PageSize = export.PageSize
+	filter.PageToken = ""
+
+	writer, err := export.NewWriter(stream, request.Format, "Cards")
+	if err != nil {
+		return status.Internal(err)
+	}
+
+	err = writer.Write([]string{"id", "member_id", "member_name", "rfid_value", "valid_from", "valid_to"})
+	if err != nil {
+		return status.Internal(err)
+	}
+
+	memberNames := export.NewMemberNames(s.memberService)
+
+	for {
+		response, err := s.ListCards(ctx, filter)
+		if err != nil {
+			return err
+		}
+
+		for _, card := range response.Cards {
+			memberName, err := memberNames.Name(ctx, card.MemberId)
+			if err != nil {
+				return err
+			}
+
+			err = writer.Write([]string{
+				card.Id,
+				card.MemberId,
+				memberName,
+				hex.EncodeToString(card.RfidValue),
+				export.FormatTimestamp(card.ValidFrom),
+				export.FormatTimestamp(card.ValidTo),
+			})
+			if err != nil {
+				return status.Internal(err)
+			}
+		}
+
+		if response.NextPageToken == "" {
+			break
+		}
+
+		filter.PageToken = response.NextPageToken
+	}
+
+	err = writer.Close()
+	if err != nil {
+		return status.Internal(err)
+	}
+
+	return nil
+}
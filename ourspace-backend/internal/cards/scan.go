@@ -0,0 +1,61 @@
+package cards
+
+import (
+	"context"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	pb "github.com/cfhn/our-space/ourspace-backend/proto"
+	"github.com/cfhn/our-space/pkg/status"
+)
+
+type CardLister interface {
+	ListCards(ctx context.Context, request *pb.ListCardsRequest) (*pb.ListCardsResponse, error)
+}
+
+// ResolveActiveMember returns the member a presented card belongs to. This is the common first step of all scan flows
+// on terminals and readers. Only cards that are currently valid are accepted and the membership of the member has to
+// be active. The returned errors are gRPC status errors.
+func ResolveActiveMember(
+	ctx context.Context, cardLister CardLister, memberService MemberService, rfidValue []byte,
+) (*pb.Member, error) {
+	now := time.Now()
+
+	cards, err := cardLister.ListCards(ctx, &pb.ListCardsRequest{
+		PageSize:  1,
+		ValidOn:   timestamppb.New(now),
+		RfidValue: rfidValue,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if len(cards.Cards) == 0 {
+		return nil, status.NotFound()
+	}
+
+	member, err := memberService.GetMember(ctx, &pb.GetMemberRequest{Id: cards.Cards[0].MemberId})
+	if status.FromError(err).Code() == codes.NotFound {
+		return nil, status.NotFound()
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if !isMembershipActive(member, now) {
+		return nil, status.FailedPrecondition("membership is not active")
+	}
+
+	return member, nil
+}
+
+func isMembershipActive(member *pb.Member, now time.Time) bool {
+	if member.MembershipStart == nil || member.MembershipStart.AsTime().After(now) {
+		return false
+	}
+
+	return member.MembershipEnd == nil || member.MembershipEnd.AsTime().After(now)
+}
@@ -1,12 +1,23 @@
 package config
 
-import "github.com/caarlos0/env/v11"
+import (
+	"time"
+
+	"github.com/caarlos0/env/v11"
+)
 
 type Config struct {
-	HTTPPort int `env:"OURSPACE_BACKEND_HTTP_PORT" envDefault:"8080"`
-	GRPCPort int `env:"OURSPACE_BACKEND_GRPC_PORT" envDefault:"50051"`
-	Database Database
-	Auth     Auth
+	HTTPPort     int `env:"OURSPACE_BACKEND_HTTP_PORT" envDefault:"8080"`
+	GRPCPort     int `env:"OURSPACE_BACKEND_GRPC_PORT" envDefault:"50051"`
+	Database     Database
+	Auth         Auth
+	Members      Members
+	Presence     Presence
+	Lending      Lending
+	Machines     Machines
+	Sepa         Sepa
+	SelfService  SelfService
+	Applications Applications
 }
 
 type Database struct {
@@ -19,6 +30,58 @@ type Auth struct {
 	VerificationKeysPath string `env:"OURSPACE_BACKEND_VERIFICATION_KEY_PATH" envDefault:"verification_key.pem"`
 }
 
+type Members struct {
+	// RequireGuardian rejects underage members without a guardian.
+	RequireGuardian bool `env:"OURSPACE_BACKEND_MEMBERS_REQUIRE_GUARDIAN" envDefault:"false"`
+}
+
+type Presence struct {
+	// ClosingTimes per weekday, e.g. "mon=22:00,tue=22:00,sat=18:00". Open presences are closed automatically at the
+	// next closing time.
+	ClosingTimes       map[string]string `env:"OURSPACE_BACKEND_PRESENCE_CLOSING_TIMES" envKeyValSeparator:"="`
+	MaxDuration        time.Duration     `env:"OURSPACE_BACKEND_PRESENCE_MAX_DURATION" envDefault:"16h"`
+	TimeZone           string            `env:"OURSPACE_BACKEND_PRESENCE_TIME_ZONE" envDefault:"Local"`
+	AutoCheckoutPeriod time.Duration     `env:"OURSPACE_BACKEND_PRESENCE_AUTO_CHECKOUT_PERIOD" envDefault:"5m"`
+}
+
+type Lending struct {
+	// Period is the default time until a lent item is expected back.
+	Period time.Duration `env:"OURSPACE_BACKEND_LENDING_PERIOD" envDefault:"336h"`
+}
+
+type Machines struct {
+	// SessionTimeout ends usage sessions that were not stopped, for machines without their own timeout.
+	SessionTimeout      time.Duration `env:"OURSPACE_BACKEND_MACHINES_SESSION_TIMEOUT" envDefault:"4h"`
+	SessionTimeoutCheck time.Duration `env:"OURSPACE_BACKEND_MACHINES_SESSION_TIMEOUT_CHECK" envDefault:"1m"`
+}
+
+// Sepa describes the creditor, i.e. the organization collecting membership fees by SEPA direct debit.
+type Sepa struct {
+	CreditorName string `env:"OURSPACE_BACKEND_SEPA_CREDITOR_NAME"`
+	CreditorIBAN string `env:"OURSPACE_BACKEND_SEPA_CREDITOR_IBAN"`
+	CreditorBIC  string `env:"OURSPACE_BACKEND_SEPA_CREDITOR_BIC"`
+	// CreditorID is the SEPA creditor identifier, e.g. DE98ZZZ09999999999.
+	CreditorID string `env:"OURSPACE_BACKEND_SEPA_CREDITOR_ID"`
+}
+
+// SelfService configures what members can change about themselves. Nothing can be changed by default.
+type SelfService struct {
+	// Fields are the paths of member fields members can update, e.g. "name,birth_date".
+	Fields []string `env:"OURSPACE_BACKEND_SELF_SERVICE_FIELDS"`
+	// Attributes are the technical names of additional attributes members can update.
+	Attributes []string `env:"OURSPACE_BACKEND_SELF_SERVICE_ATTRIBUTES"`
+	// ApprovalFields and ApprovalAttributes can be changed by members, but staff have to approve the change.
+	ApprovalFields     []string `env:"OURSPACE_BACKEND_SELF_SERVICE_APPROVAL_FIELDS"`
+	ApprovalAttributes []string `env:"OURSPACE_BACKEND_SELF_SERVICE_APPROVAL_ATTRIBUTES"`
+}
+
+// Applications limits the online membership applications per client address. Behind a reverse proxy, all clients
+// share the address of the proxy.
+type Applications struct {
+	RateLimit       int           `env:"OURSPACE_BACKEND_APPLICATIONS_RATE_LIMIT" envDefault:"3"`
+	RateLimitPeriod time.Duration `env:"OURSPACE_BACKEND_APPLICATIONS_RATE_LIMIT_PERIOD" envDefault:"1h"`
+}
+
 func Get() (*Config, error) {
 	cfg, err := env.ParseAs[Config]()
 	if err != nil {
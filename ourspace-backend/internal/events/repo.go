@@ -0,0 +1,661 @@
+package events
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgtype"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	pb "github.com/cfhn/our-space/ourspace-backend/proto"
+)
+
+const uniqueViolation = "23505"
+
+var (
+	ErrNotFound          = errors.New("event not found")
+	ErrAlreadyRegistered = errors.New("member is already registered for the event")
+)
+
+//nolint:gochecknoglobals // constant field lookup
+var eventFields = map[pb.EventField]string{
+	pb.EventField_EVENT_FIELD_ID:         "events.id",
+	pb.EventField_EVENT_FIELD_TITLE:      "events.title",
+	pb.EventField_EVENT_FIELD_START_TIME: "events.start_time",
+	pb.EventField_EVENT_FIELD_END_TIME:   "events.end_time",
+}
+
+const selectEvent = `
+	select
+		events.id, title, description, start_time, end_time, location, capacity, required_briefing_types,
+		allowed_age_categories,
+		(
+			select count(*) from event_registrations
+			where event_id = events.id and status = 'EVENT_REGISTRATION_STATUS_REGISTERED'
+		),
+		(
+			select count(*) from event_registrations
+			where event_id = events.id and status = 'EVENT_REGISTRATION_STATUS_WAITLISTED'
+		)
+	from events
+`
+
+const selectRegistration = `
+	select id, event_id, member_id, status, registration_time, cancellation_time, attended
+	from event_registrations
+`
+
+type Filters struct {
+	StartTimeAfter  time.Time
+	StartTimeBefore time.Time
+}
+
+type RegistrationFilters struct {
+	Status   pb.EventRegistrationStatus
+	MemberID string
+}
+
+type Postgres struct {
+	db *sql.DB
+}
+
+func NewPostgresRepo(db *sql.DB) *Postgres {
+	return &Postgres{db: db}
+}
+
+func (p *Postgres) CreateEvent(ctx context.Context, event *pb.Event) (*pb.Event, error) {
+	_, err := p.db.ExecContext(ctx, `
+		insert into events (
+			id, title, description, start_time, end_time, location, capacity, required_briefing_types,
+			allowed_age_categories
+		)
+		values ($1, $2, $3, $4, $5, $6, $7, $8, $9);
+	`,
+		event.Id, event.Title, event.Description, event.StartTime.AsTime(), event.EndTime.AsTime(), event.Location,
+		event.Capacity, briefingTypes(event.RequiredBriefingTypes), ageCategories(event.AllowedAgeCategories),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return p.GetEvent(ctx, event.Id)
+}
+
+func (p *Postgres) GetEvent(ctx context.Context, id string) (*pb.Event, error) {
+	row := p.db.QueryRowContext(ctx, selectEvent+`where events.id = $1`, id)
+
+	event, err := scanEvent(row)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrNotFound
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return event, nil
+}
+
+func (p *Postgres) ListEvents(
+	ctx context.Context, pageSize int32, token *pb.EventPageToken, sortField pb.EventField,
+	sortDirection pb.SortDirection, filters *Filters,
+) ([]*pb.Event, error) {
+	var (
+		startTimeAfter  = sql.Null[time.Time]{V: filters.StartTimeAfter, Valid: !filters.StartTimeAfter.IsZero()}
+		startTimeBefore = sql.Null[time.Time]{V: filters.StartTimeBefore, Valid: !filters.StartTimeBefore.IsZero()}
+	)
+
+	values := append(
+		make([]any, 0, 5),
+		pageSize,
+		startTimeAfter,
+		startTimeBefore,
+	)
+
+	paginationCondition, paginationValues := generatePaginationQuery(token, len(values)+1)
+
+	values = append(values, paginationValues...)
+
+	//nolint:gosec // manual concatenation is fine here, uses bound placeholders
+	rows, err := p.db.QueryContext(ctx, selectEvent+`
+		where
+		    ($2::timestamptz is null OR start_time > $2)
+		and ($3::timestamptz is null OR start_time < $3)
+		`+paginationCondition+`
+		order by `+getSort(sortField, sortDirection, token)+`
+		limit $1
+	`, values...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	events := make([]*pb.Event, 0, pageSize)
+
+	for rows.Next() {
+		event, err := scanEvent(rows)
+		if err != nil {
+			return nil, err
+		}
+
+		events = append(events, event)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return events, nil
+}
+
+func generatePaginationQuery(token *pb.EventPageToken, offset int) (string, []any) {
+	fields := make([]string, 0, 2)
+	values := make([]any, 0, 2)
+	placeholders := make([]string, 0, 2)
+
+	fieldName, ok := eventFields[token.Field]
+	if !ok {
+		return "", nil
+	}
+
+	fields = append(fields, fieldName)
+	values = append(values, token.LastValue)
+
+	if token.Field != pb.EventField_EVENT_FIELD_ID {
+		fields = append(fields, "events.id")
+		values = append(values, token.LastId)
+	}
+
+	for i := range len(fields) {
+		placeholders = append(placeholders, fmt.Sprintf("$%d", i+offset))
+	}
+
+	sort := ">"
+	if token.Direction == pb.SortDirection_SORT_DIRECTION_DESCENDING {
+		sort = "<"
+	}
+
+	return "and (" + strings.Join(fields, ",") + ")" +
+		sort + "(" + strings.Join(placeholders, ",") + ")", values
+}
+
+func getSort(sortField pb.EventField, direction pb.SortDirection, token *pb.EventPageToken) string {
+	if token.Field != pb.EventField_EVENT_FIELD_UNKNOWN {
+		sortField = token.Field
+		direction = token.Direction
+	}
+
+	fieldName, ok := eventFields[sortField]
+	if !ok {
+		return "events.id"
+	}
+
+	order := " ASC"
+	if direction == pb.SortDirection_SORT_DIRECTION_DESCENDING {
+		order = " DESC"
+	}
+
+	return fieldName + order + ", events.id" + order
+}
+
+// UpdateEvent updates the fields in the field mask. If the capacity changes, members are moved up from the waitlist
+// until the event is full again.
+func (p *Postgres) UpdateEvent(
+	ctx context.Context, event *pb.Event, fieldMask *fieldmaskpb.FieldMask,
+) (*pb.Event, error) {
+	var (
+		title                 sql.Null[string]
+		description           sql.Null[string]
+		startTime             sql.Null[time.Time]
+		endTime               sql.Null[time.Time]
+		location              sql.Null[string]
+		capacity              sql.Null[int32]
+		changeBriefingTypes   bool
+		changeAgeCategories   bool
+		requiredBriefingTypes = pgtype.FlatArray[string]{}
+		allowedAgeCategories  = pgtype.FlatArray[string]{}
+	)
+
+	for _, path := range fieldMask.Paths {
+		switch path {
+		case "title":
+			title = sql.Null[string]{V: event.Title, Valid: true}
+		case "description":
+			description = sql.Null[string]{V: event.Description, Valid: true}
+		case "start_time":
+			startTime = sql.Null[time.Time]{V: event.StartTime.AsTime(), Valid: true}
+		case "end_time":
+			endTime = sql.Null[time.Time]{V: event.EndTime.AsTime(), Valid: true}
+		case "location":
+			location = sql.Null[string]{V: event.Location, Valid: true}
+		case "capacity":
+			capacity = sql.Null[int32]{V: event.Capacity, Valid: true}
+		case "required_briefing_types":
+			changeBriefingTypes = true
+			requiredBriefingTypes = briefingTypes(event.RequiredBriefingTypes)
+		case "allowed_age_categories":
+			changeAgeCategories = true
+			allowedAgeCategories = ageCategories(event.AllowedAgeCategories)
+		}
+	}
+
+	tx, err := p.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback() //nolint:errcheck // rollback after commit is a no-op
+
+	result, err := tx.ExecContext(ctx, `
+		update events
+		set
+			title = coalesce($2, title),
+			description = coalesce($3, description),
+			start_time = coalesce($4, start_time),
+			end_time = coalesce($5, end_time),
+			location = coalesce($6, location),
+			capacity = coalesce($7, capacity),
+			required_briefing_types = case when $8 then $9 else required_briefing_types end,
+			allowed_age_categories = case when $10 then $11 else allowed_age_categories end
+		where id = $1
+	`,
+		event.Id, title, description, startTime, endTime, location, capacity,
+		changeBriefingTypes, requiredBriefingTypes, changeAgeCategories, allowedAgeCategories,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return nil, err
+	}
+
+	if affected == 0 {
+		return nil, ErrNotFound
+	}
+
+	if capacity.Valid {
+		err = promoteWaitlist(ctx, tx, event.Id)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, err
+	}
+
+	return p.GetEvent(ctx, event.Id)
+}
+
+func (p *Postgres) DeleteEvent(ctx context.Context, id string) error {
+	result, err := p.db.ExecContext(ctx, `delete from events where id = $1`, id)
+	if err != nil {
+		return err
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if affected == 0 {
+		return ErrNotFound
+	}
+
+	return nil
+}
+
+// Register registers the member for the event. If the event is already full, the member is put on the waitlist.
+func (p *Postgres) Register(ctx context.Context, eventID, memberID string) (*pb.EventRegistration, error) {
+	tx, err := p.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback() //nolint:errcheck // rollback after commit is a no-op
+
+	freePlaces, err := lockEvent(ctx, tx, eventID)
+	if err != nil {
+		return nil, err
+	}
+
+	registrationStatus := pb.EventRegistrationStatus_EVENT_REGISTRATION_STATUS_REGISTERED
+	if freePlaces.Valid && freePlaces.V <= 0 {
+		registrationStatus = pb.EventRegistrationStatus_EVENT_REGISTRATION_STATUS_WAITLISTED
+	}
+
+	var registrationID string
+
+	err = tx.QueryRowContext(ctx, `
+		insert into event_registrations (event_id, member_id, status, registration_time)
+		values ($1, $2, $3, $4)
+		returning id
+	`, eventID, memberID, registrationStatus.String(), time.Now()).Scan(&registrationID)
+
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && pgErr.Code == uniqueViolation &&
+		pgErr.ConstraintName == "single_active_event_registration" {
+		return nil, ErrAlreadyRegistered
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, err
+	}
+
+	return p.GetRegistration(ctx, eventID, registrationID)
+}
+
+// CancelRegistration cancels the registration and gives the freed place to the waitlist. Cancelling a registration
+// twice is not an error.
+func (p *Postgres) CancelRegistration(ctx context.Context, eventID, id string) (*pb.EventRegistration, error) {
+	tx, err := p.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback() //nolint:errcheck // rollback after commit is a no-op
+
+	_, err = lockEvent(ctx, tx, eventID)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := tx.ExecContext(ctx, `
+		update event_registrations
+		set
+			status = 'EVENT_REGISTRATION_STATUS_CANCELLED',
+			cancellation_time = $3
+		where id = $1 and event_id = $2 and status <> 'EVENT_REGISTRATION_STATUS_CANCELLED'
+	`, id, eventID, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return nil, err
+	}
+
+	if affected != 0 {
+		err = promoteWaitlist(ctx, tx, eventID)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, err
+	}
+
+	return p.GetRegistration(ctx, eventID, id)
+}
+
+// lockEvent locks the event against concurrent registration changes and returns the number of free places. The
+// result is not valid if the capacity of the event is unlimited.
+func lockEvent(ctx context.Context, tx *sql.Tx, eventID string) (sql.Null[int32], error) {
+	var capacity int32
+
+	err := tx.QueryRowContext(ctx, `select capacity from events where id = $1 for update`, eventID).Scan(&capacity)
+	if errors.Is(err, sql.ErrNoRows) {
+		return sql.Null[int32]{}, ErrNotFound
+	}
+
+	if err != nil {
+		return sql.Null[int32]{}, err
+	}
+
+	if capacity == 0 {
+		return sql.Null[int32]{}, nil
+	}
+
+	var registered int32
+
+	err = tx.QueryRowContext(ctx, `
+		select count(*) from event_registrations
+		where event_id = $1 and status = 'EVENT_REGISTRATION_STATUS_REGISTERED'
+	`, eventID).Scan(&registered)
+	if err != nil {
+		return sql.Null[int32]{}, err
+	}
+
+	return sql.Null[int32]{V: capacity - registered, Valid: true}, nil
+}
+
+// promoteWaitlist moves members from the waitlist to the registered members in the order they registered, as long
+// as there are free places. The event has to be locked by the transaction.
+func promoteWaitlist(ctx context.Context, tx *sql.Tx, eventID string) error {
+	freePlaces, err := lockEvent(ctx, tx, eventID)
+	if err != nil {
+		return err
+	}
+
+	if freePlaces.Valid && freePlaces.V <= 0 {
+		return nil
+	}
+
+	limit := sql.Null[int32]{V: freePlaces.V, Valid: freePlaces.Valid}
+
+	_, err = tx.ExecContext(ctx, `
+		update event_registrations
+		set status = 'EVENT_REGISTRATION_STATUS_REGISTERED'
+		where id in (
+			select id from event_registrations
+			where event_id = $1 and status = 'EVENT_REGISTRATION_STATUS_WAITLISTED'
+			order by registration_time, id
+			limit $2
+		)
+	`, eventID, limit)
+
+	return err
+}
+
+func (p *Postgres) GetRegistration(ctx context.Context, eventID, id string) (*pb.EventRegistration, error) {
+	row := p.db.QueryRowContext(ctx, selectRegistration+`where id = $1 and event_id = $2`, id, eventID)
+
+	registration, err := scanRegistration(row)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrNotFound
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return registration, nil
+}
+
+func (p *Postgres) ListRegistrations(
+	ctx context.Context, eventID string, pageSize int32, token *pb.EventRegistrationPageToken,
+	filters *RegistrationFilters,
+) ([]*pb.EventRegistration, error) {
+	var (
+		registrationStatus = sql.Null[string]{
+			V:     filters.Status.String(),
+			Valid: filters.Status != pb.EventRegistrationStatus_EVENT_REGISTRATION_STATUS_UNKNOWN,
+		}
+		memberID             = sql.Null[string]{V: filters.MemberID, Valid: filters.MemberID != ""}
+		lastRegistrationTime = sql.Null[time.Time]{
+			V:     token.LastRegistrationTime.AsTime(),
+			Valid: token.LastRegistrationTime != nil,
+		}
+	)
+
+	rows, err := p.db.QueryContext(ctx, selectRegistration+`
+		where event_id = $1
+		and ($3::text is null OR status = $3)
+		and ($4::uuid is null OR member_id = $4)
+		and ($5::timestamptz is null OR (registration_time, id) > ($5, $6::uuid))
+		order by registration_time, id
+		limit $2
+	`, eventID, pageSize, registrationStatus, memberID, lastRegistrationTime, sql.Null[string]{
+		V:     token.LastId,
+		Valid: lastRegistrationTime.Valid,
+	})
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	registrations := make([]*pb.EventRegistration, 0, pageSize)
+
+	for rows.Next() {
+		registration, err := scanRegistration(rows)
+		if err != nil {
+			return nil, err
+		}
+
+		registrations = append(registrations, registration)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return registrations, nil
+}
+
+// SetAttendance marks whether the member of an active registration attended the event.
+func (p *Postgres) SetAttendance(ctx context.Context, eventID, id string, attended bool) (*pb.EventRegistration, error) {
+	result, err := p.db.ExecContext(ctx, `
+		update event_registrations
+		set attended = $3
+		where id = $1 and event_id = $2 and status = 'EVENT_REGISTRATION_STATUS_REGISTERED'
+	`, id, eventID, attended)
+	if err != nil {
+		return nil, err
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return nil, err
+	}
+
+	if affected == 0 {
+		return nil, ErrNotFound
+	}
+
+	return p.GetRegistration(ctx, eventID, id)
+}
+
+// MarkAttendanceFromPresences marks registered members as attended, if they were checked in while the event took
+// place. Only events that started within the last week are considered, older events are left to manual corrections.
+func (p *Postgres) MarkAttendanceFromPresences(ctx context.Context) error {
+	_, err := p.db.ExecContext(ctx, `
+		update event_registrations
+		set attended = true
+		from events
+		where events.id = event_registrations.event_id
+		and event_registrations.status = 'EVENT_REGISTRATION_STATUS_REGISTERED'
+		and not event_registrations.attended
+		and events.start_time between now() - interval '7 days' and now()
+		and exists (
+			select 1 from presences
+			where presences.member_id = event_registrations.member_id
+			and presences.checkin_time < events.end_time
+			and coalesce(presences.checkout_time, now()) > events.start_time
+		)
+	`)
+
+	return err
+}
+
+type scanner interface {
+	Scan(values ...any) error
+}
+
+func scanEvent(in scanner) (*pb.Event, error) {
+	var (
+		event                 = &pb.Event{}
+		startTime, endTime    time.Time
+		requiredBriefingTypes pgtype.FlatArray[string]
+		allowedAgeCategories  pgtype.FlatArray[string]
+	)
+
+	err := in.Scan(
+		&event.Id,
+		&event.Title,
+		&event.Description,
+		&startTime,
+		&endTime,
+		&event.Location,
+		&event.Capacity,
+		&requiredBriefingTypes,
+		&allowedAgeCategories,
+		&event.RegisteredCount,
+		&event.WaitlistCount,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	event.StartTime = timestamppb.New(startTime)
+	event.EndTime = timestamppb.New(endTime)
+	event.RequiredBriefingTypes = requiredBriefingTypes
+
+	event.AllowedAgeCategories = make([]pb.AgeCategory, 0, len(allowedAgeCategories))
+	for _, ageCategory := range allowedAgeCategories {
+		event.AllowedAgeCategories = append(event.AllowedAgeCategories, pb.AgeCategory(pb.AgeCategory_value[ageCategory]))
+	}
+
+	return event, nil
+}
+
+func scanRegistration(in scanner) (*pb.EventRegistration, error) {
+	var (
+		registration       = &pb.EventRegistration{}
+		registrationStatus string
+		registrationTime   time.Time
+		cancellationTime   sql.Null[time.Time]
+	)
+
+	err := in.Scan(
+		&registration.Id,
+		&registration.EventId,
+		&registration.MemberId,
+		&registrationStatus,
+		&registrationTime,
+		&cancellationTime,
+		&registration.Attended,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	registration.Status = pb.EventRegistrationStatus(pb.EventRegistrationStatus_value[registrationStatus])
+	registration.RegistrationTime = timestamppb.New(registrationTime)
+
+	if cancellationTime.Valid {
+		registration.CancellationTime = timestamppb.New(cancellationTime.V)
+	}
+
+	return registration, nil
+}
+
+func briefingTypes(briefingTypes []string) pgtype.FlatArray[string] {
+	if briefingTypes == nil {
+		return pgtype.FlatArray[string]{}
+	}
+
+	return briefingTypes
+}
+
+func ageCategories(ageCategories []pb.AgeCategory) pgtype.FlatArray[string] {
+	names := make(pgtype.FlatArray[string], 0, len(ageCategories))
+	for _, ageCategory := range ageCategories {
+		names = append(names, ageCategory.String())
+	}
+
+	return names
+}
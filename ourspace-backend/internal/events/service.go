@@ -0,0 +1,566 @@
+package events
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	pb "github.com/cfhn/our-space/ourspace-backend/proto"
+	"github.com/cfhn/our-space/pkg/status"
+)
+
+var ErrFieldUnknown = errors.New("unknown field")
+
+type MemberService interface {
+	GetMember(ctx context.Context, request *pb.GetMemberRequest) (*pb.Member, error)
+}
+
+type BriefingChecker interface {
+	MissingBriefingTypes(ctx context.Context, memberID string, briefingTypes []string) ([]string, error)
+}
+
+type Service struct {
+	repo            *Postgres
+	memberService   MemberService
+	briefingChecker BriefingChecker
+	pb.UnimplementedEventServiceServer
+}
+
+func NewService(repo *Postgres, memberService MemberService, briefingChecker BriefingChecker) *Service {
+	return &Service{repo: repo, memberService: memberService, briefingChecker: briefingChecker}
+}
+
+func (s *Service) CreateEvent(ctx context.Context, request *pb.CreateEventRequest) (*pb.Event, error) {
+	fieldViolations := validateCreateEvent(request)
+	if len(fieldViolations) != 0 {
+		return nil, status.FieldViolations(fieldViolations)
+	}
+
+	if request.EventId != "" {
+		request.Event.Id = request.EventId
+	} else {
+		request.Event.Id = uuid.New().String()
+	}
+
+	event, err := s.repo.CreateEvent(ctx, request.Event)
+	if err != nil {
+		return nil, status.Internal(err)
+	}
+
+	return event, nil
+}
+
+func validateCreateEvent(request *pb.CreateEventRequest) []*errdetails.BadRequest_FieldViolation {
+	if request.Event == nil {
+		return []*errdetails.BadRequest_FieldViolation{{
+			Field:       "event",
+			Description: "event field must not be empty",
+			Reason:      "FIELD_EMPTY",
+		}}
+	}
+
+	var fieldViolations []*errdetails.BadRequest_FieldViolation
+
+	if request.EventId != "" {
+		if _, err := uuid.Parse(request.EventId); err != nil {
+			fieldViolations = append(fieldViolations, &errdetails.BadRequest_FieldViolation{
+				Field:       "event_id",
+				Description: "event_id must be a valid UUID",
+				Reason:      "FIELD_INVALID",
+			})
+		}
+	}
+
+	fieldViolations = append(fieldViolations, validateTitle(request.Event.Title)...)
+	fieldViolations = append(fieldViolations, validateTimes(request.Event)...)
+	fieldViolations = append(fieldViolations, validateCapacity(request.Event.Capacity)...)
+	fieldViolations = append(fieldViolations, validateBriefingTypes(request.Event.RequiredBriefingTypes)...)
+	fieldViolations = append(fieldViolations, validateAgeCategories(request.Event.AllowedAgeCategories)...)
+
+	return fieldViolations
+}
+
+func validateTitle(title string) []*errdetails.BadRequest_FieldViolation {
+	if title == "" {
+		return []*errdetails.BadRequest_FieldViolation{{
+			Field:       "event.title",
+			Description: "title must not be empty",
+			Reason:      "FIELD_EMPTY",
+		}}
+	}
+
+	if len(title) > 1024 {
+		return []*errdetails.BadRequest_FieldViolation{{
+			Field:       "event.title",
+			Description: "title must not be over 1KB",
+			Reason:      "FIELD_TOO_LARGE",
+		}}
+	}
+
+	return nil
+}
+
+func validateTimes(event *pb.Event) []*errdetails.BadRequest_FieldViolation {
+	var fieldViolations []*errdetails.BadRequest_FieldViolation
+
+	if event.StartTime == nil {
+		fieldViolations = append(fieldViolations, &errdetails.BadRequest_FieldViolation{
+			Field:       "event.start_time",
+			Description: "start_time must be set",
+			Reason:      "FIELD_EMPTY",
+		})
+	}
+
+	if event.EndTime == nil {
+		fieldViolations = append(fieldViolations, &errdetails.BadRequest_FieldViolation{
+			Field:       "event.end_time",
+			Description: "end_time must be set",
+			Reason:      "FIELD_EMPTY",
+		})
+	}
+
+	if len(fieldViolations) != 0 {
+		return fieldViolations
+	}
+
+	if !event.EndTime.AsTime().After(event.StartTime.AsTime()) {
+		return []*errdetails.BadRequest_FieldViolation{{
+			Field:       "event.end_time",
+			Description: "end_time must be after start_time",
+			Reason:      "FIELD_INVALID",
+		}}
+	}
+
+	return nil
+}
+
+func validateCapacity(capacity int32) []*errdetails.BadRequest_FieldViolation {
+	if capacity < 0 {
+		return []*errdetails.BadRequest_FieldViolation{{
+			Field:       "event.capacity",
+			Description: "capacity must not be negative, use 0 for unlimited",
+			Reason:      "FIELD_INVALID",
+		}}
+	}
+
+	return nil
+}
+
+func validateBriefingTypes(briefingTypes []string) []*errdetails.BadRequest_FieldViolation {
+	for _, briefingType := range briefingTypes {
+		if briefingType == "" {
+			return []*errdetails.BadRequest_FieldViolation{{
+				Field:       "event.required_briefing_types",
+				Description: "required_briefing_types must not contain empty values",
+				Reason:      "FIELD_INVALID",
+			}}
+		}
+	}
+
+	return nil
+}
+
+func validateAgeCategories(ageCategories []pb.AgeCategory) []*errdetails.BadRequest_FieldViolation {
+	for _, ageCategory := range ageCategories {
+		if _, ok := pb.AgeCategory_name[int32(ageCategory)]; !ok || ageCategory == pb.AgeCategory_AGE_CATEGORY_UNKNOWN {
+			return []*errdetails.BadRequest_FieldViolation{{
+				Field:       "event.allowed_age_categories",
+				Description: "allowed_age_categories must only contain known age categories",
+				Reason:      "FIELD_INVALID",
+			}}
+		}
+	}
+
+	return nil
+}
+
+func (s *Service) GetEvent(ctx context.Context, request *pb.GetEventRequest) (*pb.Event, error) {
+	event, err := s.repo.GetEvent(ctx, request.Id)
+	if errors.Is(err, ErrNotFound) {
+		return nil, status.NotFound()
+	}
+
+	if err != nil {
+		return nil, status.Internal(err)
+	}
+
+	return event, nil
+}
+
+func (s *Service) ListEvents(ctx context.Context, request *pb.ListEventsRequest) (*pb.ListEventsResponse, error) {
+	pageTokenBytes, err := base64.RawStdEncoding.DecodeString(request.PageToken)
+	if err != nil {
+		return nil, err
+	}
+
+	pageToken := &pb.EventPageToken{}
+
+	err = proto.Unmarshal(pageTokenBytes, pageToken)
+	if err != nil {
+		return nil, err
+	}
+
+	filters := &Filters{}
+	if request.StartTimeAfter != nil {
+		filters.StartTimeAfter = request.StartTimeAfter.AsTime()
+	}
+
+	if request.StartTimeBefore != nil {
+		filters.StartTimeBefore = request.StartTimeBefore.AsTime()
+	}
+
+	pageSize := request.PageSize
+	if pageSize == 0 {
+		pageSize = 50
+	}
+
+	events, err := s.repo.ListEvents(ctx, pageSize+1, pageToken, request.SortBy, request.SortDirection, filters)
+	if err != nil {
+		return nil, status.Internal(err)
+	}
+
+	var nextPageToken string
+
+	if len(events) > int(pageSize) {
+		events = events[:pageSize]
+
+		field := pb.EventField_EVENT_FIELD_ID
+		if pageToken.Field != pb.EventField_EVENT_FIELD_UNKNOWN {
+			field = pageToken.Field
+		} else if request.SortBy != pb.EventField_EVENT_FIELD_UNKNOWN {
+			field = request.SortBy
+		}
+
+		direction := pb.SortDirection_SORT_DIRECTION_ASCENDING
+		if pageToken.Direction != pb.SortDirection_SORT_DIRECTION_DEFAULT {
+			direction = pageToken.Direction
+		} else if request.SortDirection != pb.SortDirection_SORT_DIRECTION_DEFAULT {
+			direction = request.SortDirection
+		}
+
+		lastValue, err := getFieldValue(events[pageSize-1], field)
+		if err != nil {
+			return nil, err
+		}
+
+		pbNextPageToken := &pb.EventPageToken{
+			Field:     field,
+			LastValue: lastValue,
+			Direction: direction,
+			LastId:    events[pageSize-1].Id,
+		}
+
+		nextPageTokenBytes, err := proto.Marshal(pbNextPageToken)
+		if err != nil {
+			return nil, err
+		}
+
+		nextPageToken = base64.RawStdEncoding.EncodeToString(nextPageTokenBytes)
+	}
+
+	return &pb.ListEventsResponse{
+		Events:        events,
+		NextPageToken: nextPageToken,
+	}, nil
+}
+
+func getFieldValue(event *pb.Event, field pb.EventField) (string, error) {
+	switch field {
+	case pb.EventField_EVENT_FIELD_ID:
+		return event.Id, nil
+	case pb.EventField_EVENT_FIELD_TITLE:
+		return event.Title, nil
+	case pb.EventField_EVENT_FIELD_START_TIME:
+		return event.StartTime.AsTime().Format(time.RFC3339Nano), nil
+	case pb.EventField_EVENT_FIELD_END_TIME:
+		return event.EndTime.AsTime().Format(time.RFC3339Nano), nil
+	default:
+		return "", ErrFieldUnknown
+	}
+}
+
+func (s *Service) UpdateEvent(ctx context.Context, request *pb.UpdateEventRequest) (*pb.Event, error) {
+	fieldViolations, err := s.validateUpdateEvent(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(fieldViolations) != 0 {
+		return nil, status.FieldViolations(fieldViolations)
+	}
+
+	event, err := s.repo.UpdateEvent(ctx, request.Event, request.FieldMask)
+	if errors.Is(err, ErrNotFound) {
+		return nil, status.NotFound()
+	}
+
+	if err != nil {
+		return nil, status.Internal(err)
+	}
+
+	return event, nil
+}
+
+func (s *Service) validateUpdateEvent(
+	ctx context.Context, request *pb.UpdateEventRequest,
+) ([]*errdetails.BadRequest_FieldViolation, error) {
+	if request.Event == nil {
+		return []*errdetails.BadRequest_FieldViolation{{
+			Field:       "event",
+			Description: "event field must not be empty",
+			Reason:      "FIELD_EMPTY",
+		}}, nil
+	}
+
+	if !request.FieldMask.IsValid(&pb.Event{}) {
+		return []*errdetails.BadRequest_FieldViolation{{
+			Field:       "field_mask",
+			Description: "invalid field_mask",
+			Reason:      "FIELD_INVALID",
+		}}, nil
+	}
+
+	fieldViolations := make([]*errdetails.BadRequest_FieldViolation, 0)
+	changesTimes := false
+
+	for _, path := range request.FieldMask.Paths {
+		switch path {
+		case "title":
+			fieldViolations = append(fieldViolations, validateTitle(request.Event.Title)...)
+		case "start_time", "end_time":
+			changesTimes = true
+		case "capacity":
+			fieldViolations = append(fieldViolations, validateCapacity(request.Event.Capacity)...)
+		case "required_briefing_types":
+			fieldViolations = append(fieldViolations, validateBriefingTypes(request.Event.RequiredBriefingTypes)...)
+		case "allowed_age_categories":
+			fieldViolations = append(fieldViolations, validateAgeCategories(request.Event.AllowedAgeCategories)...)
+		case "description", "location":
+		default:
+			fieldViolations = append(fieldViolations, &errdetails.BadRequest_FieldViolation{
+				Field:       "field_mask",
+				Description: path + " can not be updated",
+				Reason:      "FIELD_INVALID",
+			})
+		}
+	}
+
+	if !changesTimes {
+		return fieldViolations, nil
+	}
+
+	// Start and end have to be validated together, so fill in the stored value for the one that is not updated.
+	current, err := s.repo.GetEvent(ctx, request.Event.Id)
+	if errors.Is(err, ErrNotFound) {
+		return nil, status.NotFound()
+	}
+
+	if err != nil {
+		return nil, status.Internal(err)
+	}
+
+	merged := &pb.Event{StartTime: current.StartTime, EndTime: current.EndTime}
+	if slices.Contains(request.FieldMask.Paths, "start_time") {
+		merged.StartTime = request.Event.StartTime
+	}
+
+	if slices.Contains(request.FieldMask.Paths, "end_time") {
+		merged.EndTime = request.Event.EndTime
+	}
+
+	return append(fieldViolations, validateTimes(merged)...), nil
+}
+
+func (s *Service) DeleteEvent(ctx context.Context, request *pb.DeleteEventRequest) (*emptypb.Empty, error) {
+	err := s.repo.DeleteEvent(ctx, request.Id)
+	if errors.Is(err, ErrNotFound) {
+		return nil, status.NotFound()
+	}
+
+	if err != nil {
+		return nil, status.Internal(err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// RegisterForEvent registers a member for an event that has not ended yet. The age category of the member has to be
+// allowed for the event and the member needs a valid briefing for every briefing type the event requires.
+func (s *Service) RegisterForEvent(
+	ctx context.Context, request *pb.RegisterForEventRequest,
+) (*pb.EventRegistration, error) {
+	fieldViolations := validateRegisterForEvent(request)
+	if len(fieldViolations) != 0 {
+		return nil, status.FieldViolations(fieldViolations)
+	}
+
+	event, err := s.repo.GetEvent(ctx, request.EventId)
+	if errors.Is(err, ErrNotFound) {
+		return nil, status.NotFound()
+	}
+
+	if err != nil {
+		return nil, status.Internal(err)
+	}
+
+	member, err := s.memberService.GetMember(ctx, &pb.GetMemberRequest{Id: request.MemberId})
+	if status.FromError(err).Code() == codes.NotFound {
+		return nil, status.FieldViolations([]*errdetails.BadRequest_FieldViolation{{
+			Field:       "member_id",
+			Description: "member does not exist",
+			Reason:      "FIELD_INVALID",
+		}})
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if !event.EndTime.AsTime().After(time.Now()) {
+		return nil, status.FailedPrecondition("event has already ended")
+	}
+
+	if len(event.AllowedAgeCategories) != 0 && !slices.Contains(event.AllowedAgeCategories, member.AgeCategory) {
+		return nil, status.FailedPrecondition("age category of the member is not allowed for this event")
+	}
+
+	missing, err := s.briefingChecker.MissingBriefingTypes(ctx, member.Id, event.RequiredBriefingTypes)
+	if err != nil {
+		return nil, status.Internal(err)
+	}
+
+	if len(missing) != 0 {
+		return nil, status.FailedPrecondition("member is missing required briefings")
+	}
+
+	registration, err := s.repo.Register(ctx, request.EventId, request.MemberId)
+
+	switch {
+	case errors.Is(err, ErrNotFound):
+		return nil, status.NotFound()
+	case errors.Is(err, ErrAlreadyRegistered):
+		return nil, status.AlreadyExists()
+	case err != nil:
+		return nil, status.Internal(err)
+	}
+
+	return registration, nil
+}
+
+func validateRegisterForEvent(request *pb.RegisterForEventRequest) []*errdetails.BadRequest_FieldViolation {
+	var fieldViolations []*errdetails.BadRequest_FieldViolation
+
+	if _, err := uuid.Parse(request.EventId); err != nil {
+		fieldViolations = append(fieldViolations, &errdetails.BadRequest_FieldViolation{
+			Field:       "event_id",
+			Description: "event_id must be a valid UUID",
+			Reason:      "FIELD_INVALID",
+		})
+	}
+
+	if _, err := uuid.Parse(request.MemberId); err != nil {
+		fieldViolations = append(fieldViolations, &errdetails.BadRequest_FieldViolation{
+			Field:       "member_id",
+			Description: "member_id must be a valid UUID",
+			Reason:      "FIELD_INVALID",
+		})
+	}
+
+	return fieldViolations
+}
+
+func (s *Service) CancelEventRegistration(
+	ctx context.Context, request *pb.CancelEventRegistrationRequest,
+) (*pb.EventRegistration, error) {
+	registration, err := s.repo.CancelRegistration(ctx, request.EventId, request.Id)
+	if errors.Is(err, ErrNotFound) {
+		return nil, status.NotFound()
+	}
+
+	if err != nil {
+		return nil, status.Internal(err)
+	}
+
+	return registration, nil
+}
+
+func (s *Service) ListEventRegistrations(
+	ctx context.Context, request *pb.ListEventRegistrationsRequest,
+) (*pb.ListEventRegistrationsResponse, error) {
+	pageTokenBytes, err := base64.RawStdEncoding.DecodeString(request.PageToken)
+	if err != nil {
+		return nil, err
+	}
+
+	pageToken := &pb.EventRegistrationPageToken{}
+
+	err = proto.Unmarshal(pageTokenBytes, pageToken)
+	if err != nil {
+		return nil, err
+	}
+
+	filters := &RegistrationFilters{}
+	if request.Status != nil {
+		filters.Status = *request.Status
+	}
+
+	if request.MemberId != nil {
+		filters.MemberID = *request.MemberId
+	}
+
+	pageSize := request.PageSize
+	if pageSize == 0 {
+		pageSize = 50
+	}
+
+	registrations, err := s.repo.ListRegistrations(ctx, request.EventId, pageSize+1, pageToken, filters)
+	if err != nil {
+		return nil, status.Internal(err)
+	}
+
+	var nextPageToken string
+
+	if len(registrations) > int(pageSize) {
+		registrations = registrations[:pageSize]
+
+		nextPageTokenBytes, err := proto.Marshal(&pb.EventRegistrationPageToken{
+			LastRegistrationTime: registrations[pageSize-1].RegistrationTime,
+			LastId:               registrations[pageSize-1].Id,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		nextPageToken = base64.RawStdEncoding.EncodeToString(nextPageTokenBytes)
+	}
+
+	return &pb.ListEventRegistrationsResponse{
+		Registrations: registrations,
+		NextPageToken: nextPageToken,
+	}, nil
+}
+
+// MarkEventAttendance overrides the attendance of a registered member, e.g. if they forgot to check in. Members that
+// were checked in during the event are marked automatically.
+func (s *Service) MarkEventAttendance(
+	ctx context.Context, request *pb.MarkEventAttendanceRequest,
+) (*pb.EventRegistration, error) {
+	registration, err := s.repo.SetAttendance(ctx, request.EventId, request.Id, request.Attended)
+	if errors.Is(err, ErrNotFound) {
+		return nil, status.NotFound()
+	}
+
+	if err != nil {
+		return nil, status.Internal(err)
+	}
+
+	return registration, nil
+}
@@ -0,0 +1,193 @@
+package export
+
+import (
+	"bufio"
+	"context"
+	"encoding/csv"
+	"strings"
+	"time"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/genproto/googleapis/api/httpbody"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	pb "github.com/cfhn/our-space/ourspace-backend/proto"
+	"github.com/cfhn/our-space/pkg/status"
+)
+
+const (
+	// PageSize is the number of entries fetched at once while exporting.
+	PageSize = 500
+	// chunkSize is the size of the messages the file is streamed in.
+	chunkSize = 32 * 1024
+
+	csvContentType  = "text/csv"
+	xlsxContentType = "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"
+
+	// formulaPrefixes are the characters that make spreadsheet applications interpret a cell as a formula.
+	formulaPrefixes = "=+-@\t\r"
+)
+
+// Writer writes a table row by row into a file that is streamed to the client. Close must be called to send the end
+// of the file. Values are escaped with EscapeCell.
+type Writer interface {
+	Write(row []string) error
+	Close() error
+}
+
+// NewWriter creates a writer for the format, sheetName is only used for XLSX files.
+func NewWriter(
+	stream grpc.ServerStreamingServer[httpbody.HttpBody], format pb.ExportFormat, sheetName string,
+) (Writer, error) {
+	if format == pb.ExportFormat_EXPORT_FORMAT_XLSX {
+		writer, err := newXLSXWriter(newChunkWriter(stream, xlsxContentType), sheetName)
+		if err != nil {
+			return nil, err
+		}
+
+		return writer, nil
+	}
+
+	return newCSVWriter(newChunkWriter(stream, csvContentType)), nil
+}
+
+// FormatTimestamp formats timestamps for exported files, timestamps that are not set are empty.
+func FormatTimestamp(timestamp *timestamppb.Timestamp) string {
+	if timestamp == nil {
+		return ""
+	}
+
+	return timestamp.AsTime().Format(time.RFC3339)
+}
+
+// EscapeCell prefixes values that spreadsheet applications would interpret as formulas with an apostrophe, so that
+// they are shown as text.
+func EscapeCell(value string) string {
+	if value != "" && strings.ContainsRune(formulaPrefixes, rune(value[0])) {
+		return "'" + value
+	}
+
+	return value
+}
+
+// UnescapeCell removes the apostrophe added by EscapeCell, so that exported files can be imported again.
+func UnescapeCell(value string) string {
+	if len(value) > 1 && value[0] == '\'' && strings.ContainsRune(formulaPrefixes, rune(value[1])) {
+		return value[1:]
+	}
+
+	return value
+}
+
+// chunkWriter sends everything written to it as HttpBody messages of at most chunkSize bytes.
+type chunkWriter struct {
+	*bufio.Writer
+}
+
+func newChunkWriter(stream grpc.ServerStreamingServer[httpbody.HttpBody], contentType string) *chunkWriter {
+	return &chunkWriter{Writer: bufio.NewWriterSize(streamWriter{stream: stream, contentType: contentType}, chunkSize)}
+}
+
+type streamWriter struct {
+	stream      grpc.ServerStreamingServer[httpbody.HttpBody]
+	contentType string
+}
+
+func (s streamWriter) Write(p []byte) (int, error) {
+	err := s.stream.Send(&httpbody.HttpBody{
+		ContentType: s.contentType,
+		Data:        append([]byte(nil), p...),
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return len(p), nil
+}
+
+type csvWriter struct {
+	chunks *chunkWriter
+	csv    *csv.Writer
+}
+
+func newCSVWriter(chunks *chunkWriter) *csvWriter {
+	return &csvWriter{chunks: chunks, csv: csv.NewWriter(chunks)}
+}
+
+func (w *csvWriter) Write(row []string) error {
+	escaped := make([]string, len(row))
+	for i, value := range row {
+		escaped[i] = EscapeCell(value)
+	}
+
+	return w.csv.Write(escaped)
+}
+
+func (w *csvWriter) Close() error {
+	w.csv.Flush()
+
+	if err := w.csv.Error(); err != nil {
+		return err
+	}
+
+	return w.chunks.Flush()
+}
+
+// Marshaler is the default marshaler of the gateway, except that it does not add delimiters between the messages of
+// a stream. Exports stream chunks of a file, which must be passed on unchanged.
+type Marshaler struct {
+	runtime.HTTPBodyMarshaler
+}
+
+func NewMarshaler() *Marshaler {
+	return &Marshaler{
+		HTTPBodyMarshaler: runtime.HTTPBodyMarshaler{
+			Marshaler: &runtime.JSONPb{
+				MarshalOptions:   protojson.MarshalOptions{EmitUnpopulated: true},
+				UnmarshalOptions: protojson.UnmarshalOptions{DiscardUnknown: true},
+			},
+		},
+	}
+}
+
+func (*Marshaler) Delimiter() []byte {
+	return nil
+}
+
+type MemberService interface {
+	GetMember(ctx context.Context, request *pb.GetMemberRequest) (*pb.Member, error)
+}
+
+// MemberNames resolves the names of members for exports that reference members by ID. Members usually appear in many
+// rows, so the names are cached for the duration of the export.
+type MemberNames struct {
+	memberService MemberService
+	names         map[string]string
+}
+
+func NewMemberNames(memberService MemberService) *MemberNames {
+	return &MemberNames{memberService: memberService, names: map[string]string{}}
+}
+
+// Name returns the name of the member, or an empty name if the member does not exist anymore.
+func (m *MemberNames) Name(ctx context.Context, memberID string) (string, error) {
+	if name, ok := m.names[memberID]; ok {
+		return name, nil
+	}
+
+	member, err := m.memberService.GetMember(ctx, &pb.GetMemberRequest{Id: memberID})
+
+	switch status.FromError(err).Code() {
+	case codes.OK:
+		m.names[memberID] = member.Name
+	case codes.NotFound:
+		m.names[memberID] = ""
+	default:
+		return "", err
+	}
+
+	return m.names[memberID], nil
+}
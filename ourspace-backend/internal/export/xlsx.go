@@ -0,0 +1,158 @@
+package export
+
+import (
+	"archive/zip"
+	"encoding/xml"
+	"io"
+	"strings"
+)
+
+// The static parts of a workbook with a single sheet. The sheet itself only uses inline strings, so no shared strings
+// or styles are needed.
+const (
+	xlsxContentTypes = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<Types xmlns="http://schemas.openxmlformats.org/package/2006/content-types">` +
+		`<Default Extension="rels" ContentType="application/vnd.openxmlformats-package.relationships+xml"/>` +
+		`<Default Extension="xml" ContentType="application/xml"/>` +
+		`<Override PartName="/xl/workbook.xml" ` +
+		`ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.sheet.main+xml"/>` +
+		`<Override PartName="/xl/worksheets/sheet1.xml" ` +
+		`ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.worksheet+xml"/>` +
+		`</Types>`
+	xlsxRelationships = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">` +
+		`<Relationship Id="rId1" ` +
+		`Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/officeDocument" ` +
+		`Target="xl/workbook.xml"/>` +
+		`</Relationships>`
+	xlsxWorkbookRelationships = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">` +
+		`<Relationship Id="rId1" ` +
+		`Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/worksheet" ` +
+		`Target="worksheets/sheet1.xml"/>` +
+		`</Relationships>`
+	xlsxWorkbookStart = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<workbook xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main" ` +
+		`xmlns:r="http://schemas.openxmlformats.org/officeDocument/2006/relationships"><sheets><sheet name="`
+	xlsxWorkbookEnd = `" sheetId="1" r:id="rId1"/></sheets></workbook>`
+	xlsxSheetStart  = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<worksheet xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main"><sheetData>`
+	xlsxSheetEnd = `</sheetData></worksheet>`
+
+	// maxSheetNameLength is the limit of Excel for sheet names.
+	maxSheetNameLength = 31
+)
+
+// xlsxWriter streams a workbook. The rows of the sheet are written directly into the last file of the zip archive,
+// so the file never has to be kept in memory.
+type xlsxWriter struct {
+	chunks *chunkWriter
+	zip    *zip.Writer
+	sheet  io.Writer
+}
+
+func newXLSXWriter(chunks *chunkWriter, sheetName string) (*xlsxWriter, error) {
+	writer := &xlsxWriter{chunks: chunks, zip: zip.NewWriter(chunks)}
+
+	var workbook strings.Builder
+
+	workbook.WriteString(xlsxWorkbookStart)
+
+	err := xml.EscapeText(&workbook, []byte(sanitizeSheetName(sheetName)))
+	if err != nil {
+		return nil, err
+	}
+
+	workbook.WriteString(xlsxWorkbookEnd)
+
+	for _, file := range []struct {
+		name    string
+		content string
+	}{
+		{name: "[Content_Types].xml", content: xlsxContentTypes},
+		{name: "_rels/.rels", content: xlsxRelationships},
+		{name: "xl/workbook.xml", content: workbook.String()},
+		{name: "xl/_rels/workbook.xml.rels", content: xlsxWorkbookRelationships},
+	} {
+		fileWriter, err := writer.zip.Create(file.name)
+		if err != nil {
+			return nil, err
+		}
+
+		_, err = io.WriteString(fileWriter, file.content)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	writer.sheet, err = writer.zip.Create("xl/worksheets/sheet1.xml")
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = io.WriteString(writer.sheet, xlsxSheetStart)
+	if err != nil {
+		return nil, err
+	}
+
+	return writer, nil
+}
+
+// Write adds a row of text cells.
+func (w *xlsxWriter) Write(row []string) error {
+	var buf strings.Builder
+
+	buf.WriteString("<row>")
+
+	for _, value := range row {
+		buf.WriteString(`<c t="inlineStr"><is><t xml:space="preserve">`)
+
+		err := xml.EscapeText(&buf, []byte(EscapeCell(value)))
+		if err != nil {
+			return err
+		}
+
+		buf.WriteString("</t></is></c>")
+	}
+
+	buf.WriteString("</row>")
+
+	_, err := io.WriteString(w.sheet, buf.String())
+
+	return err
+}
+
+func (w *xlsxWriter) Close() error {
+	_, err := io.WriteString(w.sheet, xlsxSheetEnd)
+	if err != nil {
+		return err
+	}
+
+	err = w.zip.Close()
+	if err != nil {
+		return err
+	}
+
+	return w.chunks.Flush()
+}
+
+// sanitizeSheetName removes the characters Excel does not allow in sheet names.
+func sanitizeSheetName(name string) string {
+	name = strings.Map(func(r rune) rune {
+		if strings.ContainsRune(`[]:*?/\`, r) {
+			return -1
+		}
+
+		return r
+	}, name)
+
+	if runes := []rune(name); len(runes) > maxSheetNameLength {
+		name = string(runes[:maxSheetNameLength])
+	}
+
+	if name == "" {
+		return "Export"
+	}
+
+	return name
+}
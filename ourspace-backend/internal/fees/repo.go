@@ -0,0 +1,1078 @@
+package fees
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgtype"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	pb "github.com/cfhn/our-space/ourspace-backend/proto"
+)
+
+const (
+	uniqueViolation     = "23505"
+	foreignKeyViolation = "23503"
+	checkViolation      = "23514"
+	exclusionViolation  = "23P01"
+)
+
+var (
+	ErrNotFound          = errors.New("not found")
+	ErrReferenceNotFound = errors.New("referenced entry not found")
+	ErrPlanInUse         = errors.New("membership plan is still in use")
+	ErrOverlap           = errors.New("plan assignment overlaps with another assignment of the member")
+	ErrEndBeforeStart    = errors.New("end time is not after start time")
+	ErrInvoiceMismatch   = errors.New("invoice does not belong to the member")
+	ErrDuplicateMandate  = errors.New("another member has a mandate with the same reference")
+	ErrAlreadyExported   = errors.New("invoice was exported already")
+)
+
+const selectPlan = `
+	select id, display_name, description, price_cents, reduced_price_cents, billing_interval
+	from membership_plans
+`
+
+const selectAssignment = `
+	select id, member_id, plan_id, start_time, end_time, billed_until
+	from plan_assignments
+`
+
+// selectInvoice derives the paid amount and status from the recorded payments. The derived table allows filtering on
+// the status.
+const selectInvoice = `
+	select
+		id, member_id, plan_assignment_id, plan_id, period_start, period_end, amount_cents, paid_cents, issue_time,
+		status
+	from (
+		select
+			invoices.*,
+			coalesce(paid.paid_cents, 0)::bigint as paid_cents,
+			case
+				when invoices.cancelled then 'INVOICE_STATUS_CANCELLED'
+				when coalesce(paid.paid_cents, 0) >= invoices.amount_cents then 'INVOICE_STATUS_PAID'
+				else 'INVOICE_STATUS_OPEN'
+			end as status
+		from invoices
+		left join lateral (
+			select sum(payments.amount_cents) as paid_cents from payments where payments.invoice_id = invoices.id
+		) as paid on true
+	) as invoices
+`
+
+const selectMandate = `
+	select member_id, account_holder, iban, bic, mandate_reference, mandate_date
+	from sepa_mandates
+`
+
+const selectPayment = `
+	select id, member_id, invoice_id, amount_cents, payment_time, reference
+	from payments
+`
+
+type AssignmentFilters struct {
+	MemberID sql.Null[string]
+	PlanID   sql.Null[string]
+}
+
+type InvoiceFilters struct {
+	MemberID sql.Null[string]
+	Status   sql.Null[string]
+}
+
+type PaymentFilters struct {
+	MemberID  sql.Null[string]
+	InvoiceID sql.Null[string]
+}
+
+type BalanceFilters struct {
+	MemberID        sql.Null[string]
+	OutstandingOnly bool
+}
+
+// Collectable is an open invoice together with everything needed to collect it by direct debit.
+type Collectable struct {
+	InvoiceID        string
+	MemberID         string
+	PeriodStart      time.Time
+	PeriodEnd        time.Time
+	OutstandingCents int64
+	PlanName         string
+	// Mandate is nil if the member has no SEPA mandate.
+	Mandate *pb.SepaMandate
+}
+
+type Postgres struct {
+	db *sql.DB
+}
+
+func NewPostgresRepo(db *sql.DB) *Postgres {
+	return &Postgres{db: db}
+}
+
+func (p *Postgres) CreatePlan(ctx context.Context, plan *pb.MembershipPlan) (*pb.MembershipPlan, error) {
+	_, err := p.db.ExecContext(ctx, `
+		insert into membership_plans (
+			id, display_name, description, price_cents, reduced_price_cents, billing_interval
+		)
+		values ($1, $2, $3, $4, $5, $6);
+	`,
+		plan.Id, plan.DisplayName, plan.Description, plan.PriceCents, plan.ReducedPriceCents,
+		plan.BillingInterval.String(),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return p.GetPlan(ctx, plan.Id)
+}
+
+func (p *Postgres) GetPlan(ctx context.Context, id string) (*pb.MembershipPlan, error) {
+	row := p.db.QueryRowContext(ctx, selectPlan+`where id = $1`, id)
+
+	plan, err := scanPlan(row)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrNotFound
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return plan, nil
+}
+
+func (p *Postgres) ListPlans(
+	ctx context.Context, pageSize int32, token *pb.MembershipPlanPageToken,
+) ([]*pb.MembershipPlan, error) {
+	var (
+		lastDisplayName = sql.Null[string]{V: token.LastDisplayName, Valid: token.LastId != ""}
+		lastID          = sql.Null[string]{V: token.LastId, Valid: token.LastId != ""}
+	)
+
+	rows, err := p.db.QueryContext(ctx, selectPlan+`
+		where ($3::uuid is null OR (display_name, id) > ($2, $3))
+		order by display_name, id
+		limit $1
+	`, pageSize, lastDisplayName, lastID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	plans := make([]*pb.MembershipPlan, 0, pageSize)
+
+	for rows.Next() {
+		plan, err := scanPlan(rows)
+		if err != nil {
+			return nil, err
+		}
+
+		plans = append(plans, plan)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return plans, nil
+}
+
+func (p *Postgres) UpdatePlan(
+	ctx context.Context, plan *pb.MembershipPlan, fieldMask *fieldmaskpb.FieldMask,
+) (*pb.MembershipPlan, error) {
+	var (
+		displayName        sql.Null[string]
+		description        sql.Null[string]
+		priceCents         sql.Null[int64]
+		changeReducedPrice bool
+		billingInterval    sql.Null[string]
+	)
+
+	for _, path := range fieldMask.Paths {
+		switch path {
+		case "display_name":
+			displayName = sql.Null[string]{V: plan.DisplayName, Valid: true}
+		case "description":
+			description = sql.Null[string]{V: plan.Description, Valid: true}
+		case "price_cents":
+			priceCents = sql.Null[int64]{V: plan.PriceCents, Valid: true}
+		case "reduced_price_cents":
+			changeReducedPrice = true
+		case "billing_interval":
+			billingInterval = sql.Null[string]{V: plan.BillingInterval.String(), Valid: true}
+		}
+	}
+
+	result, err := p.db.ExecContext(ctx, `
+		update membership_plans
+		set
+			display_name = coalesce($2, display_name),
+			description = coalesce($3, description),
+			price_cents = coalesce($4, price_cents),
+			reduced_price_cents = case when $5 then $6 else reduced_price_cents end,
+			billing_interval = coalesce($7, billing_interval)
+		where id = $1
+	`, plan.Id, displayName, description, priceCents, changeReducedPrice, plan.ReducedPriceCents, billingInterval)
+	if err != nil {
+		return nil, err
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return nil, err
+	}
+
+	if affected == 0 {
+		return nil, ErrNotFound
+	}
+
+	return p.GetPlan(ctx, plan.Id)
+}
+
+func (p *Postgres) DeletePlan(ctx context.Context, id string) error {
+	result, err := p.db.ExecContext(ctx, `delete from membership_plans where id = $1`, id)
+
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && pgErr.Code == foreignKeyViolation {
+		return ErrPlanInUse
+	}
+
+	if err != nil {
+		return err
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if affected == 0 {
+		return ErrNotFound
+	}
+
+	return nil
+}
+
+// CreateAssignment assigns a plan to a member. Billing starts at the start time of the assignment.
+func (p *Postgres) CreateAssignment(ctx context.Context, assignment *pb.PlanAssignment) (*pb.PlanAssignment, error) {
+	var endTime sql.Null[time.Time]
+	if assignment.EndTime != nil {
+		endTime = sql.Null[time.Time]{V: assignment.EndTime.AsTime(), Valid: true}
+	}
+
+	_, err := p.db.ExecContext(ctx, `
+		insert into plan_assignments (id, member_id, plan_id, start_time, end_time, billed_until)
+		values ($1, $2, $3, $4, $5, $4);
+	`, assignment.Id, assignment.MemberId, assignment.PlanId, assignment.StartTime.AsTime(), endTime)
+	if err != nil {
+		return nil, mapConstraintError(err)
+	}
+
+	return p.GetAssignment(ctx, assignment.Id)
+}
+
+func (p *Postgres) GetAssignment(ctx context.Context, id string) (*pb.PlanAssignment, error) {
+	row := p.db.QueryRowContext(ctx, selectAssignment+`where id = $1`, id)
+
+	assignment, err := scanAssignment(row)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrNotFound
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return assignment, nil
+}
+
+func (p *Postgres) ListAssignments(
+	ctx context.Context, pageSize int32, token *pb.PlanAssignmentPageToken, filters *AssignmentFilters,
+) ([]*pb.PlanAssignment, error) {
+	var (
+		lastStartTime = sql.Null[time.Time]{V: token.LastStartTime.AsTime(), Valid: token.LastId != ""}
+		lastID        = sql.Null[string]{V: token.LastId, Valid: token.LastId != ""}
+	)
+
+	rows, err := p.db.QueryContext(ctx, selectAssignment+`
+		where ($3::uuid is null OR (start_time, id) < ($2, $3))
+		and ($4::uuid is null OR member_id = $4)
+		and ($5::uuid is null OR plan_id = $5)
+		order by start_time desc, id desc
+		limit $1
+	`, pageSize, lastStartTime, lastID, filters.MemberID, filters.PlanID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	assignments := make([]*pb.PlanAssignment, 0, pageSize)
+
+	for rows.Next() {
+		assignment, err := scanAssignment(rows)
+		if err != nil {
+			return nil, err
+		}
+
+		assignments = append(assignments, assignment)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return assignments, nil
+}
+
+func (p *Postgres) EndAssignment(ctx context.Context, id string, endTime time.Time) (*pb.PlanAssignment, error) {
+	result, err := p.db.ExecContext(ctx, `update plan_assignments set end_time = $2 where id = $1`, id, endTime)
+	if err != nil {
+		return nil, mapConstraintError(err)
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return nil, err
+	}
+
+	if affected == 0 {
+		return nil, ErrNotFound
+	}
+
+	return p.GetAssignment(ctx, id)
+}
+
+type billableAssignment struct {
+	id                string
+	memberID          string
+	planID            string
+	endTime           sql.Null[time.Time]
+	billedUntil       time.Time
+	priceCents        int64
+	reducedPriceCents sql.Null[int64]
+	billingInterval   pb.BillingInterval
+	ageCategory       pb.AgeCategory
+}
+
+// GenerateInvoices creates an invoice for every billing period that starts before until and was not billed yet. The
+// amount depends on the age category of the member at the time the invoice is generated.
+func (p *Postgres) GenerateInvoices(ctx context.Context, until time.Time) ([]*pb.Invoice, error) {
+	tx, err := p.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback() //nolint:errcheck // rollback after commit is a no-op
+
+	assignments, err := listBillableAssignments(ctx, tx, until)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		issueTime = time.Now()
+		invoices  []*pb.Invoice
+	)
+
+	for _, assignment := range assignments {
+		amount := assignment.priceCents
+		if assignment.ageCategory == pb.AgeCategory_AGE_CATEGORY_UNDERAGE && assignment.reducedPriceCents.Valid {
+			amount = assignment.reducedPriceCents.V
+		}
+
+		periodStart := assignment.billedUntil
+
+		for periodStart.Before(until) && (!assignment.endTime.Valid || periodStart.Before(assignment.endTime.V)) {
+			periodEnd := NextPeriod(periodStart, assignment.billingInterval)
+
+			var id string
+
+			err := tx.QueryRowContext(ctx, `
+				insert into invoices (
+					member_id, plan_assignment_id, plan_id, period_start, period_end, amount_cents, issue_time
+				)
+				values ($1, $2, $3, $4, $5, $6, $7)
+				returning id
+			`,
+				assignment.memberID, assignment.id, assignment.planID, periodStart, periodEnd, amount, issueTime,
+			).Scan(&id)
+			if err != nil {
+				return nil, err
+			}
+
+			invoices = append(invoices, &pb.Invoice{
+				Id:               id,
+				MemberId:         assignment.memberID,
+				PlanAssignmentId: assignment.id,
+				PlanId:           assignment.planID,
+				PeriodStart:      timestamppb.New(periodStart),
+				PeriodEnd:        timestamppb.New(periodEnd),
+				AmountCents:      amount,
+				IssueTime:        timestamppb.New(issueTime),
+				Status:           pb.InvoiceStatus_INVOICE_STATUS_OPEN,
+			})
+
+			periodStart = periodEnd
+		}
+
+		_, err := tx.ExecContext(ctx, `update plan_assignments set billed_until = $2 where id = $1`,
+			assignment.id, periodStart)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, err
+	}
+
+	return invoices, nil
+}
+
+// listBillableAssignments locks and returns all assignments with a billing period starting before until.
+func listBillableAssignments(ctx context.Context, tx *sql.Tx, until time.Time) ([]*billableAssignment, error) {
+	rows, err := tx.QueryContext(ctx, `
+		select
+			plan_assignments.id, plan_assignments.member_id, plan_assignments.plan_id, plan_assignments.end_time,
+			plan_assignments.billed_until, membership_plans.price_cents, membership_plans.reduced_price_cents,
+			membership_plans.billing_interval, members.age_category
+		from plan_assignments
+		inner join membership_plans on membership_plans.id = plan_assignments.plan_id
+		inner join members on members.id = plan_assignments.member_id
+		where plan_assignments.billed_until < $1
+		and (plan_assignments.end_time is null OR plan_assignments.billed_until < plan_assignments.end_time)
+		order by plan_assignments.id
+		for update of plan_assignments
+	`, until)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var assignments []*billableAssignment
+
+	for rows.Next() {
+		var (
+			assignment      = &billableAssignment{}
+			billingInterval string
+			ageCategory     string
+		)
+
+		err := rows.Scan(
+			&assignment.id,
+			&assignment.memberID,
+			&assignment.planID,
+			&assignment.endTime,
+			&assignment.billedUntil,
+			&assignment.priceCents,
+			&assignment.reducedPriceCents,
+			&billingInterval,
+			&ageCategory,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		assignment.billingInterval = pb.BillingInterval(pb.BillingInterval_value[billingInterval])
+		assignment.ageCategory = pb.AgeCategory(pb.AgeCategory_value[ageCategory])
+
+		assignments = append(assignments, assignment)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return assignments, nil
+}
+
+// NextPeriod returns the start of the billing period following the one starting at periodStart.
+func NextPeriod(periodStart time.Time, interval pb.BillingInterval) time.Time {
+	switch interval {
+	case pb.BillingInterval_BILLING_INTERVAL_QUARTERLY:
+		return periodStart.AddDate(0, 3, 0)
+	case pb.BillingInterval_BILLING_INTERVAL_YEARLY:
+		return periodStart.AddDate(1, 0, 0)
+	default:
+		return periodStart.AddDate(0, 1, 0)
+	}
+}
+
+func (p *Postgres) GetInvoice(ctx context.Context, id string) (*pb.Invoice, error) {
+	row := p.db.QueryRowContext(ctx, selectInvoice+`where id = $1`, id)
+
+	invoice, err := scanInvoice(row)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrNotFound
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return invoice, nil
+}
+
+func (p *Postgres) ListInvoices(
+	ctx context.Context, pageSize int32, token *pb.InvoicePageToken, filters *InvoiceFilters,
+) ([]*pb.Invoice, error) {
+	var (
+		lastPeriodStart = sql.Null[time.Time]{V: token.LastPeriodStart.AsTime(), Valid: token.LastId != ""}
+		lastID          = sql.Null[string]{V: token.LastId, Valid: token.LastId != ""}
+	)
+
+	rows, err := p.db.QueryContext(ctx, selectInvoice+`
+		where ($3::uuid is null OR (period_start, id) < ($2, $3))
+		and ($4::uuid is null OR member_id = $4)
+		and ($5::text is null OR status = $5)
+		order by period_start desc, id desc
+		limit $1
+	`, pageSize, lastPeriodStart, lastID, filters.MemberID, filters.Status)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	invoices := make([]*pb.Invoice, 0, pageSize)
+
+	for rows.Next() {
+		invoice, err := scanInvoice(rows)
+		if err != nil {
+			return nil, err
+		}
+
+		invoices = append(invoices, invoice)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return invoices, nil
+}
+
+func (p *Postgres) CancelInvoice(ctx context.Context, id string) (*pb.Invoice, error) {
+	result, err := p.db.ExecContext(ctx, `update invoices set cancelled = true where id = $1`, id)
+	if err != nil {
+		return nil, err
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return nil, err
+	}
+
+	if affected == 0 {
+		return nil, ErrNotFound
+	}
+
+	return p.GetInvoice(ctx, id)
+}
+
+// CreatePayment records the payment. If the payment is made for an invoice, the invoice has to belong to the same
+// member.
+func (p *Postgres) CreatePayment(ctx context.Context, payment *pb.Payment) (*pb.Payment, error) {
+	result, err := p.db.ExecContext(ctx, `
+		insert into payments (id, member_id, invoice_id, amount_cents, payment_time, reference)
+		select $1::uuid, $2::uuid, $3::uuid, $4::bigint, $5::timestamptz, $6::text
+		where $3::uuid is null OR exists (select 1 from invoices where id = $3 and member_id = $2)
+	`,
+		payment.Id, payment.MemberId, payment.InvoiceId, payment.AmountCents, payment.PaymentTime.AsTime(),
+		payment.Reference,
+	)
+	if err != nil {
+		return nil, mapConstraintError(err)
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return nil, err
+	}
+
+	if affected == 0 {
+		return nil, ErrInvoiceMismatch
+	}
+
+	return p.GetPayment(ctx, payment.Id)
+}
+
+func (p *Postgres) GetPayment(ctx context.Context, id string) (*pb.Payment, error) {
+	row := p.db.QueryRowContext(ctx, selectPayment+`where id = $1`, id)
+
+	payment, err := scanPayment(row)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrNotFound
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return payment, nil
+}
+
+func (p *Postgres) ListPayments(
+	ctx context.Context, pageSize int32, token *pb.PaymentPageToken, filters *PaymentFilters,
+) ([]*pb.Payment, error) {
+	var (
+		lastPaymentTime = sql.Null[time.Time]{V: token.LastPaymentTime.AsTime(), Valid: token.LastId != ""}
+		lastID          = sql.Null[string]{V: token.LastId, Valid: token.LastId != ""}
+	)
+
+	rows, err := p.db.QueryContext(ctx, selectPayment+`
+		where ($3::uuid is null OR (payment_time, id) < ($2, $3))
+		and ($4::uuid is null OR member_id = $4)
+		and ($5::uuid is null OR invoice_id = $5)
+		order by payment_time desc, id desc
+		limit $1
+	`, pageSize, lastPaymentTime, lastID, filters.MemberID, filters.InvoiceID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	payments := make([]*pb.Payment, 0, pageSize)
+
+	for rows.Next() {
+		payment, err := scanPayment(rows)
+		if err != nil {
+			return nil, err
+		}
+
+		payments = append(payments, payment)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return payments, nil
+}
+
+func (p *Postgres) DeletePayment(ctx context.Context, id string) error {
+	result, err := p.db.ExecContext(ctx, `delete from payments where id = $1`, id)
+	if err != nil {
+		return err
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if affected == 0 {
+		return ErrNotFound
+	}
+
+	return nil
+}
+
+// ListBalances returns the balance of all members that have invoices or payments, ordered by member.
+func (p *Postgres) ListBalances(
+	ctx context.Context, pageSize int32, token *pb.BalancePageToken, filters *BalanceFilters,
+) ([]*pb.Balance, error) {
+	lastMemberID := sql.Null[string]{V: token.LastMemberId, Valid: token.LastMemberId != ""}
+
+	rows, err := p.db.QueryContext(ctx, `
+		select member_id, invoiced_cents, paid_cents, invoiced_cents - paid_cents
+		from (
+			select
+				members.id as member_id,
+				coalesce(invoiced.total, 0)::bigint as invoiced_cents,
+				coalesce(paid.total, 0)::bigint as paid_cents
+			from members
+			left join (
+				select member_id, sum(amount_cents) as total from invoices where not cancelled group by member_id
+			) as invoiced on invoiced.member_id = members.id
+			left join (
+				select member_id, sum(amount_cents) as total from payments group by member_id
+			) as paid on paid.member_id = members.id
+			where invoiced.total is not null OR paid.total is not null
+		) as balances
+		where ($2::uuid is null OR member_id > $2)
+		and ($3::uuid is null OR member_id = $3)
+		and ($4 is false OR invoiced_cents > paid_cents)
+		order by member_id
+		limit $1
+	`, pageSize, lastMemberID, filters.MemberID, filters.OutstandingOnly)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	balances := make([]*pb.Balance, 0, pageSize)
+
+	for rows.Next() {
+		balance := &pb.Balance{}
+
+		err := rows.Scan(&balance.MemberId, &balance.InvoicedCents, &balance.PaidCents, &balance.OutstandingCents)
+		if err != nil {
+			return nil, err
+		}
+
+		balances = append(balances, balance)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return balances, nil
+}
+
+// SetMandate stores the mandate of the member, replacing an existing one.
+func (p *Postgres) SetMandate(ctx context.Context, mandate *pb.SepaMandate) (*pb.SepaMandate, error) {
+	_, err := p.db.ExecContext(ctx, `
+		insert into sepa_mandates (member_id, account_holder, iban, bic, mandate_reference, mandate_date)
+		values ($1, $2, $3, $4, $5, $6)
+		on conflict (member_id) do update set
+			account_holder = excluded.account_holder,
+			iban = excluded.iban,
+			bic = excluded.bic,
+			mandate_reference = excluded.mandate_reference,
+			mandate_date = excluded.mandate_date
+	`,
+		mandate.MemberId, mandate.AccountHolder, mandate.Iban, mandate.Bic, mandate.MandateReference,
+		mandate.MandateDate.AsTime().Format(time.DateOnly),
+	)
+
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && pgErr.Code == uniqueViolation {
+		return nil, ErrDuplicateMandate
+	}
+
+	if err != nil {
+		return nil, mapConstraintError(err)
+	}
+
+	return p.GetMandate(ctx, mandate.MemberId)
+}
+
+func (p *Postgres) GetMandate(ctx context.Context, memberID string) (*pb.SepaMandate, error) {
+	row := p.db.QueryRowContext(ctx, selectMandate+`where member_id = $1`, memberID)
+
+	mandate, err := scanMandate(row)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrNotFound
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return mandate, nil
+}
+
+func (p *Postgres) DeleteMandate(ctx context.Context, memberID string) error {
+	result, err := p.db.ExecContext(ctx, `delete from sepa_mandates where member_id = $1`, memberID)
+	if err != nil {
+		return err
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if affected == 0 {
+		return ErrNotFound
+	}
+
+	return nil
+}
+
+// ListCollectables returns all open invoices with a billing period starting in [start, end), ordered by member.
+// Invoices of earlier SEPA exports are only returned if includeExported is set.
+func (p *Postgres) ListCollectables(
+	ctx context.Context, start, end time.Time, includeExported bool,
+) ([]*Collectable, error) {
+	rows, err := p.db.QueryContext(ctx, `
+		select
+			invoices.id, invoices.member_id, invoices.period_start, invoices.period_end,
+			invoices.amount_cents - invoices.paid_cents, membership_plans.display_name,
+			sepa_mandates.member_id, sepa_mandates.account_holder, sepa_mandates.iban, sepa_mandates.bic,
+			sepa_mandates.mandate_reference, sepa_mandates.mandate_date
+		from (`+selectInvoice+`) as invoices
+		inner join membership_plans on membership_plans.id = invoices.plan_id
+		left join sepa_mandates on sepa_mandates.member_id = invoices.member_id
+		where invoices.status = 'INVOICE_STATUS_OPEN'
+		and invoices.period_start >= $1 and invoices.period_start < $2
+		and ($3 or not exists (select 1 from sepa_export_invoices where invoice_id = invoices.id))
+		order by invoices.member_id, invoices.period_start, invoices.id
+	`, start, end, includeExported)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var collectables []*Collectable
+
+	for rows.Next() {
+		var (
+			collectable      = &Collectable{}
+			mandateMemberID  sql.Null[string]
+			accountHolder    sql.Null[string]
+			iban             sql.Null[string]
+			bic              sql.Null[string]
+			mandateReference sql.Null[string]
+			mandateDate      sql.Null[time.Time]
+		)
+
+		err := rows.Scan(
+			&collectable.InvoiceID,
+			&collectable.MemberID,
+			&collectable.PeriodStart,
+			&collectable.PeriodEnd,
+			&collectable.OutstandingCents,
+			&collectable.PlanName,
+			&mandateMemberID,
+			&accountHolder,
+			&iban,
+			&bic,
+			&mandateReference,
+			&mandateDate,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		if mandateMemberID.Valid {
+			collectable.Mandate = &pb.SepaMandate{
+				MemberId:         mandateMemberID.V,
+				AccountHolder:    accountHolder.V,
+				Iban:             iban.V,
+				Bic:              bic.V,
+				MandateReference: mandateReference.V,
+				MandateDate:      timestamppb.New(mandateDate.V),
+			}
+		}
+
+		collectables = append(collectables, collectable)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return collectables, nil
+}
+
+// RecordSepaExport records which invoices were collected by the export. ErrAlreadyExported is returned if one of them
+// was exported in the meantime, unless reexport is set.
+func (p *Postgres) RecordSepaExport(
+	ctx context.Context, id, messageID string, collectionDate time.Time, invoiceIDs []string, reexport bool,
+) error {
+	tx, err := p.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback() //nolint:errcheck // rollback after commit is a no-op
+
+	// concurrent exports would both see the invoices as not exported
+	_, err = tx.ExecContext(ctx, `lock table sepa_export_invoices in share row exclusive mode`)
+	if err != nil {
+		return err
+	}
+
+	if !reexport {
+		var exported bool
+
+		err = tx.QueryRowContext(ctx, `
+			select exists (select 1 from sepa_export_invoices where invoice_id = any($1))
+		`, pgtype.FlatArray[string](invoiceIDs)).Scan(&exported)
+		if err != nil {
+			return err
+		}
+
+		if exported {
+			return ErrAlreadyExported
+		}
+	}
+
+	_, err = tx.ExecContext(ctx, `
+		insert into sepa_exports (id, message_id, collection_date)
+		values ($1, $2, $3::date)
+	`, id, messageID, collectionDate.Format(time.DateOnly))
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.ExecContext(ctx, `
+		insert into sepa_export_invoices (export_id, invoice_id)
+		select $1, unnest($2::uuid[])
+	`, id, pgtype.FlatArray[string](invoiceIDs))
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func mapConstraintError(err error) error {
+	var pgErr *pgconn.PgError
+	if !errors.As(err, &pgErr) {
+		return err
+	}
+
+	switch pgErr.Code {
+	case foreignKeyViolation:
+		return ErrReferenceNotFound
+	case exclusionViolation:
+		return ErrOverlap
+	case checkViolation:
+		return ErrEndBeforeStart
+	default:
+		return err
+	}
+}
+
+type scanner interface {
+	Scan(values ...any) error
+}
+
+func scanPlan(in scanner) (*pb.MembershipPlan, error) {
+	var (
+		plan              = &pb.MembershipPlan{}
+		reducedPriceCents sql.Null[int64]
+		billingInterval   string
+	)
+
+	err := in.Scan(
+		&plan.Id,
+		&plan.DisplayName,
+		&plan.Description,
+		&plan.PriceCents,
+		&reducedPriceCents,
+		&billingInterval,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	plan.BillingInterval = pb.BillingInterval(pb.BillingInterval_value[billingInterval])
+
+	if reducedPriceCents.Valid {
+		plan.ReducedPriceCents = &reducedPriceCents.V
+	}
+
+	return plan, nil
+}
+
+func scanAssignment(in scanner) (*pb.PlanAssignment, error) {
+	var (
+		assignment  = &pb.PlanAssignment{}
+		startTime   time.Time
+		endTime     sql.Null[time.Time]
+		billedUntil time.Time
+	)
+
+	err := in.Scan(
+		&assignment.Id,
+		&assignment.MemberId,
+		&assignment.PlanId,
+		&startTime,
+		&endTime,
+		&billedUntil,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	assignment.StartTime = timestamppb.New(startTime)
+	assignment.BilledUntil = timestamppb.New(billedUntil)
+
+	if endTime.Valid {
+		assignment.EndTime = timestamppb.New(endTime.V)
+	}
+
+	return assignment, nil
+}
+
+func scanInvoice(in scanner) (*pb.Invoice, error) {
+	var (
+		invoice     = &pb.Invoice{}
+		periodStart time.Time
+		periodEnd   time.Time
+		issueTime   time.Time
+		status      string
+	)
+
+	err := in.Scan(
+		&invoice.Id,
+		&invoice.MemberId,
+		&invoice.PlanAssignmentId,
+		&invoice.PlanId,
+		&periodStart,
+		&periodEnd,
+		&invoice.AmountCents,
+		&invoice.PaidCents,
+		&issueTime,
+		&status,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	invoice.PeriodStart = timestamppb.New(periodStart)
+	invoice.PeriodEnd = timestamppb.New(periodEnd)
+	invoice.IssueTime = timestamppb.New(issueTime)
+	invoice.Status = pb.InvoiceStatus(pb.InvoiceStatus_value[status])
+
+	return invoice, nil
+}
+
+func scanMandate(in scanner) (*pb.SepaMandate, error) {
+	var (
+		mandate     = &pb.SepaMandate{}
+		mandateDate time.Time
+	)
+
+	err := in.Scan(
+		&mandate.MemberId,
+		&mandate.AccountHolder,
+		&mandate.Iban,
+		&mandate.Bic,
+		&mandate.MandateReference,
+		&mandateDate,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	mandate.MandateDate = timestamppb.New(mandateDate)
+
+	return mandate, nil
+}
+
+func scanPayment(in scanner) (*pb.Payment, error) {
+	var (
+		payment     = &pb.Payment{}
+		invoiceID   sql.Null[string]
+		paymentTime time.Time
+	)
+
+	err := in.Scan(
+		&payment.Id,
+		&payment.MemberId,
+		&invoiceID,
+		&payment.AmountCents,
+		&paymentTime,
+		&payment.Reference,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	payment.PaymentTime = timestamppb.New(paymentTime)
+
+	if invoiceID.Valid {
+		payment.InvoiceId = &invoiceID.V
+	}
+
+	return payment, nil
+}
@@ -0,0 +1,543 @@
+package fees
+
+import (
+	"context"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	pb "github.com/cfhn/our-space/ourspace-backend/proto"
+	"github.com/cfhn/our-space/pkg/status"
+)
+
+const (
+	painNamespace = "urn:iso:std:iso:20022:tech:xsd:pain.008.001.08"
+	maxNameLength = 70
+	// maxRemittanceLength is the maximum length of the unstructured remittance information.
+	maxRemittanceLength = 140
+	notProvided         = "NOTPROVIDED"
+)
+
+//nolint:gochecknoglobals // static patterns
+var (
+	bicPattern              = regexp.MustCompile(`^[A-Z]{4}[A-Z]{2}[A-Z0-9]{2}([A-Z0-9]{3})?$`)
+	mandateReferencePattern = regexp.MustCompile(`^[A-Za-z0-9+?/\-:().,']{1,35}$`)
+	creditorIDPattern       = regexp.MustCompile(`^[A-Z]{2}[0-9]{2}[A-Z0-9]{3}[A-Z0-9]{1,28}$`)
+)
+
+// ibanLengths contains the IBAN length of the countries taking part in SEPA.
+//
+//nolint:gochecknoglobals // static lookup map
+var ibanLengths = map[string]int{
+	"AD": 24, "AT": 20, "BE": 16, "BG": 22, "CH": 21, "CY": 28, "CZ": 24, "DE": 22, "DK": 18, "EE": 20, "ES": 24,
+	"FI": 18, "FR": 27, "GB": 22, "GI": 23, "GR": 27, "HR": 21, "HU": 28, "IE": 22, "IS": 26, "IT": 27, "LI": 21,
+	"LT": 20, "LU": 20, "LV": 21, "MC": 27, "MT": 31, "NL": 18, "NO": 15, "PL": 28, "PT": 25, "RO": 24, "SE": 24,
+	"SI": 19, "SK": 24, "SM": 27, "VA": 22,
+}
+
+// Creditor is the organization collecting the membership fees.
+type Creditor struct {
+	Name string
+	IBAN string
+	BIC  string
+	// ID is the SEPA creditor identifier.
+	ID string
+}
+
+func (c *Creditor) valid() bool {
+	return c.Name != "" &&
+		validIBAN(normalizeIBAN(c.IBAN)) &&
+		(c.BIC == "" || bicPattern.MatchString(c.BIC)) &&
+		creditorIDPattern.MatchString(c.ID)
+}
+
+func normalizeIBAN(iban string) string {
+	return strings.ToUpper(strings.ReplaceAll(iban, " ", ""))
+}
+
+// validIBAN checks the country specific length and the check digits of a normalized IBAN.
+func validIBAN(iban string) bool {
+	if len(iban) < 5 {
+		return false
+	}
+
+	length, ok := ibanLengths[iban[:2]]
+	if !ok || len(iban) != length {
+		return false
+	}
+
+	remainder := 0
+
+	for _, char := range iban[4:] + iban[:4] {
+		switch {
+		case char >= '0' && char <= '9':
+			remainder = (remainder*10 + int(char-'0')) % 97
+		case char >= 'A' && char <= 'Z':
+			remainder = (remainder*100 + int(char-'A') + 10) % 97
+		default:
+			return false
+		}
+	}
+
+	return remainder == 1
+}
+
+func (s *Service) SetSepaMandate(ctx context.Context, request *pb.SetSepaMandateRequest) (*pb.SepaMandate, error) {
+	if request.SepaMandate == nil {
+		return nil, status.FieldViolations([]*errdetails.BadRequest_FieldViolation{{
+			Field:       "sepa_mandate",
+			Description: "sepa_mandate field must not be empty",
+			Reason:      "FIELD_EMPTY",
+		}})
+	}
+
+	mandate := request.SepaMandate
+	mandate.Iban = normalizeIBAN(mandate.Iban)
+	mandate.Bic = strings.ToUpper(strings.TrimSpace(mandate.Bic))
+	mandate.AccountHolder = strings.TrimSpace(mandate.AccountHolder)
+
+	fieldViolations := validateMandate(mandate, time.Now())
+	if _, err := uuid.Parse(mandate.MemberId); err != nil {
+		fieldViolations = append(fieldViolations, &errdetails.BadRequest_FieldViolation{
+			Field:       "sepa_mandate.member_id",
+			Description: "member_id must be a valid UUID",
+			Reason:      "FIELD_INVALID",
+		})
+	}
+
+	if len(fieldViolations) != 0 {
+		return nil, status.FieldViolations(fieldViolations)
+	}
+
+	mandate, err := s.repo.SetMandate(ctx, mandate)
+
+	switch {
+	case errors.Is(err, ErrReferenceNotFound):
+		return nil, status.NotFound()
+	case errors.Is(err, ErrDuplicateMandate):
+		return nil, status.FieldViolations([]*errdetails.BadRequest_FieldViolation{{
+			Field:       "sepa_mandate.mandate_reference",
+			Description: "mandate_reference is already used by another member",
+			Reason:      "FIELD_INVALID",
+		}})
+	case err != nil:
+		return nil, status.Internal(err)
+	}
+
+	return mandate, nil
+}
+
+// validateMandate checks a normalized mandate. The mandate must have been signed at signedBefore at the latest.
+func validateMandate(mandate *pb.SepaMandate, signedBefore time.Time) []*errdetails.BadRequest_FieldViolation {
+	var fieldViolations []*errdetails.BadRequest_FieldViolation
+
+	switch {
+	case mandate.AccountHolder == "":
+		fieldViolations = append(fieldViolations, &errdetails.BadRequest_FieldViolation{
+			Field:       "sepa_mandate.account_holder",
+			Description: "account_holder must not be empty",
+			Reason:      "FIELD_EMPTY",
+		})
+	case utf8.RuneCountInString(mandate.AccountHolder) > maxNameLength:
+		fieldViolations = append(fieldViolations, &errdetails.BadRequest_FieldViolation{
+			Field:       "sepa_mandate.account_holder",
+			Description: "account_holder must not be longer than 70 characters",
+			Reason:      "FIELD_TOO_LARGE",
+		})
+	}
+
+	if !validIBAN(mandate.Iban) {
+		fieldViolations = append(fieldViolations, &errdetails.BadRequest_FieldViolation{
+			Field:       "sepa_mandate.iban",
+			Description: "iban must be a valid IBAN of a SEPA country",
+			Reason:      "INVALID_FORMAT",
+		})
+	}
+
+	if mandate.Bic != "" && !bicPattern.MatchString(mandate.Bic) {
+		fieldViolations = append(fieldViolations, &errdetails.BadRequest_FieldViolation{
+			Field:       "sepa_mandate.bic",
+			Description: "bic must be a valid BIC with 8 or 11 characters",
+			Reason:      "INVALID_FORMAT",
+		})
+	}
+
+	if !mandateReferencePattern.MatchString(mandate.MandateReference) {
+		fieldViolations = append(fieldViolations, &errdetails.BadRequest_FieldViolation{
+			Field:       "sepa_mandate.mandate_reference",
+			Description: "mandate_reference must have 1 to 35 characters out of A-Z, a-z, 0-9 and +?/-:().,'",
+			Reason:      "INVALID_FORMAT",
+		})
+	}
+
+	switch {
+	case mandate.MandateDate == nil:
+		fieldViolations = append(fieldViolations, &errdetails.BadRequest_FieldViolation{
+			Field:       "sepa_mandate.mandate_date",
+			Description: "mandate_date must be set",
+			Reason:      "FIELD_EMPTY",
+		})
+	case mandate.MandateDate.AsTime().After(signedBefore):
+		fieldViolations = append(fieldViolations, &errdetails.BadRequest_FieldViolation{
+			Field:       "sepa_mandate.mandate_date",
+			Description: "mandate_date must not be in the future",
+			Reason:      "FIELD_INVALID",
+		})
+	}
+
+	return fieldViolations
+}
+
+func (s *Service) GetSepaMandate(ctx context.Context, request *pb.GetSepaMandateRequest) (*pb.SepaMandate, error) {
+	if _, err := uuid.Parse(request.MemberId); err != nil {
+		return nil, status.NotFound()
+	}
+
+	mandate, err := s.repo.GetMandate(ctx, request.MemberId)
+	if errors.Is(err, ErrNotFound) {
+		return nil, status.NotFound()
+	}
+
+	if err != nil {
+		return nil, status.Internal(err)
+	}
+
+	return mandate, nil
+}
+
+func (s *Service) DeleteSepaMandate(
+	ctx context.Context, request *pb.DeleteSepaMandateRequest,
+) (*emptypb.Empty, error) {
+	if _, err := uuid.Parse(request.MemberId); err != nil {
+		return nil, status.NotFound()
+	}
+
+	err := s.repo.DeleteMandate(ctx, request.MemberId)
+	if errors.Is(err, ErrNotFound) {
+		return nil, status.NotFound()
+	}
+
+	if err != nil {
+		return nil, status.Internal(err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// ExportSepaDirectDebit generates a pain.008 file for all open invoices of the period. Invoices of members without a
+// usable mandate are left out and reported as issues, so that the file can be submitted to the bank right away. The
+// collected invoices are recorded, so that the next export doesn't collect them again.
+func (s *Service) ExportSepaDirectDebit(
+	ctx context.Context, request *pb.ExportSepaDirectDebitRequest,
+) (*pb.SepaDirectDebitExport, error) {
+	fieldViolations := validateExportSepaDirectDebit(request)
+	if len(fieldViolations) != 0 {
+		return nil, status.FieldViolations(fieldViolations)
+	}
+
+	if !s.creditor.valid() {
+		return nil, status.FailedPrecondition("SEPA creditor is not configured")
+	}
+
+	collectables, err := s.repo.ListCollectables(
+		ctx, request.PeriodStart.AsTime(), request.PeriodEnd.AsTime(), request.IncludeExported,
+	)
+	if err != nil {
+		return nil, status.Internal(err)
+	}
+
+	var (
+		collectionDate = request.CollectionDate.AsTime()
+		export         = &pb.SepaDirectDebitExport{}
+		transactions   []painTransaction
+		invoiceIDs     []string
+	)
+
+	for _, memberCollectables := range groupByMember(collectables) {
+		issues := memberIssues(memberCollectables, collectionDate)
+		if len(issues) != 0 {
+			export.Issues = append(export.Issues, issues...)
+
+			continue
+		}
+
+		for _, collectable := range memberCollectables {
+			transactions = append(transactions, newPainTransaction(collectable))
+			invoiceIDs = append(invoiceIDs, collectable.InvoiceID)
+			export.TotalCents += collectable.OutstandingCents
+		}
+	}
+
+	export.Transactions = int64(len(transactions))
+
+	if len(transactions) == 0 {
+		return export, nil
+	}
+
+	messageID := sepaID()
+
+	document, err := xml.MarshalIndent(
+		s.newPainDocument(messageID, collectionDate, transactions, export.TotalCents), "", "  ",
+	)
+	if err != nil {
+		return nil, status.Internal(err)
+	}
+
+	export.ExportId = uuid.New().String()
+
+	err = s.repo.RecordSepaExport(
+		ctx, export.ExportId, messageID, collectionDate, invoiceIDs, request.IncludeExported,
+	)
+	if errors.Is(err, ErrAlreadyExported) {
+		return nil, status.FailedPrecondition("invoices were exported at the same time, export again")
+	}
+
+	if err != nil {
+		return nil, status.Internal(err)
+	}
+
+	export.Document = xml.Header + string(document)
+
+	return export, nil
+}
+
+func validateExportSepaDirectDebit(
+	request *pb.ExportSepaDirectDebitRequest,
+) []*errdetails.BadRequest_FieldViolation {
+	var fieldViolations []*errdetails.BadRequest_FieldViolation
+
+	for _, timestamp := range []struct {
+		field string
+		unset bool
+	}{
+		{field: "period_start", unset: request.PeriodStart == nil},
+		{field: "period_end", unset: request.PeriodEnd == nil},
+		{field: "collection_date", unset: request.CollectionDate == nil},
+	} {
+		if timestamp.unset {
+			fieldViolations = append(fieldViolations, &errdetails.BadRequest_FieldViolation{
+				Field:       timestamp.field,
+				Description: timestamp.field + " must be set",
+				Reason:      "FIELD_EMPTY",
+			})
+		}
+	}
+
+	if len(fieldViolations) != 0 {
+		return fieldViolations
+	}
+
+	if !request.PeriodEnd.AsTime().After(request.PeriodStart.AsTime()) {
+		return []*errdetails.BadRequest_FieldViolation{{
+			Field:       "period_end",
+			Description: "period_end must be after period_start",
+			Reason:      "FIELD_INVALID",
+		}}
+	}
+
+	return nil
+}
+
+// groupByMember splits collectables that are ordered by member into one group per member.
+func groupByMember(collectables []*Collectable) [][]*Collectable {
+	var groups [][]*Collectable
+
+	for i, collectable := range collectables {
+		if i == 0 || collectables[i-1].MemberID != collectable.MemberID {
+			groups = append(groups, nil)
+		}
+
+		groups[len(groups)-1] = append(groups[len(groups)-1], collectable)
+	}
+
+	return groups
+}
+
+// memberIssues reports why the invoices of a member can not be collected, if they can't.
+func memberIssues(collectables []*Collectable, collectionDate time.Time) []*pb.SepaExportIssue {
+	invoiceIDs := make([]string, 0, len(collectables))
+	for _, collectable := range collectables {
+		invoiceIDs = append(invoiceIDs, collectable.InvoiceID)
+	}
+
+	mandate := collectables[0].Mandate
+	if mandate == nil {
+		return []*pb.SepaExportIssue{{
+			MemberId:    collectables[0].MemberID,
+			InvoiceIds:  invoiceIDs,
+			Field:       "sepa_mandate",
+			Description: "member has no SEPA mandate",
+		}}
+	}
+
+	var issues []*pb.SepaExportIssue
+
+	for _, violation := range validateMandate(mandate, collectionDate) {
+		issues = append(issues, &pb.SepaExportIssue{
+			MemberId:    mandate.MemberId,
+			InvoiceIds:  invoiceIDs,
+			Field:       violation.Field,
+			Description: violation.Description,
+		})
+	}
+
+	return issues
+}
+
+type painDocument struct {
+	XMLName    xml.Name       `xml:"Document"`
+	Namespace  string         `xml:"xmlns,attr"`
+	Initiation painInitiation `xml:"CstmrDrctDbtInitn"`
+}
+
+type painInitiation struct {
+	GroupHeader painGroupHeader `xml:"GrpHdr"`
+	PaymentInfo painPaymentInfo `xml:"PmtInf"`
+}
+
+type painGroupHeader struct {
+	MessageID       string    `xml:"MsgId"`
+	CreationTime    string    `xml:"CreDtTm"`
+	NumberOfTxs     int       `xml:"NbOfTxs"`
+	ControlSum      string    `xml:"CtrlSum"`
+	InitiatingParty painParty `xml:"InitgPty"`
+}
+
+type painPaymentInfo struct {
+	PaymentInfoID      string            `xml:"PmtInfId"`
+	PaymentMethod      string            `xml:"PmtMtd"`
+	NumberOfTxs        int               `xml:"NbOfTxs"`
+	ControlSum         string            `xml:"CtrlSum"`
+	ServiceLevel       string            `xml:"PmtTpInf>SvcLvl>Cd"`
+	LocalInstrument    string            `xml:"PmtTpInf>LclInstrm>Cd"`
+	SequenceType       string            `xml:"PmtTpInf>SeqTp"`
+	CollectionDate     string            `xml:"ReqdColltnDt"`
+	Creditor           painParty         `xml:"Cdtr"`
+	CreditorAccount    painAccount       `xml:"CdtrAcct"`
+	CreditorAgent      painAgent         `xml:"CdtrAgt"`
+	ChargeBearer       string            `xml:"ChrgBr"`
+	CreditorSchemeID   string            `xml:"CdtrSchmeId>Id>PrvtId>Othr>Id"`
+	CreditorSchemeName string            `xml:"CdtrSchmeId>Id>PrvtId>Othr>SchmeNm>Prtry"`
+	Transactions       []painTransaction `xml:"DrctDbtTxInf"`
+}
+
+type painParty struct {
+	Name string `xml:"Nm"`
+}
+
+type painAccount struct {
+	IBAN string `xml:"Id>IBAN"`
+}
+
+// painAgent identifies a bank by BIC, or NOTPROVIDED if the BIC is unknown.
+type painAgent struct {
+	BIC   string `xml:"FinInstnId>BICFI,omitempty"`
+	Other string `xml:"FinInstnId>Othr>Id,omitempty"`
+}
+
+type painAmount struct {
+	Currency string `xml:"Ccy,attr"`
+	Value    string `xml:",chardata"`
+}
+
+type painTransaction struct {
+	EndToEndID     string      `xml:"PmtId>EndToEndId"`
+	Amount         painAmount  `xml:"InstdAmt"`
+	MandateID      string      `xml:"DrctDbtTx>MndtRltdInf>MndtId"`
+	MandateDate    string      `xml:"DrctDbtTx>MndtRltdInf>DtOfSgntr"`
+	DebtorAgent    painAgent   `xml:"DbtrAgt"`
+	Debtor         painParty   `xml:"Dbtr"`
+	DebtorAccount  painAccount `xml:"DbtrAcct"`
+	RemittanceInfo string      `xml:"RmtInf>Ustrd"`
+}
+
+// newPainDocument creates a single recurring CORE direct debit batch. Since the 2016 SEPA rulebook, recurring
+// collections do not need to be marked as first collection anymore.
+func (s *Service) newPainDocument(
+	messageID string, collectionDate time.Time, transactions []painTransaction, totalCents int64,
+) *painDocument {
+	return &painDocument{
+		Namespace: painNamespace,
+		Initiation: painInitiation{
+			GroupHeader: painGroupHeader{
+				MessageID:       messageID,
+				CreationTime:    time.Now().UTC().Format("2006-01-02T15:04:05Z"),
+				NumberOfTxs:     len(transactions),
+				ControlSum:      formatAmount(totalCents),
+				InitiatingParty: painParty{Name: truncate(s.creditor.Name, maxNameLength)},
+			},
+			PaymentInfo: painPaymentInfo{
+				PaymentInfoID:      sepaID(),
+				PaymentMethod:      "DD",
+				NumberOfTxs:        len(transactions),
+				ControlSum:         formatAmount(totalCents),
+				ServiceLevel:       "SEPA",
+				LocalInstrument:    "CORE",
+				SequenceType:       "RCUR",
+				CollectionDate:     collectionDate.Format(time.DateOnly),
+				Creditor:           painParty{Name: truncate(s.creditor.Name, maxNameLength)},
+				CreditorAccount:    painAccount{IBAN: normalizeIBAN(s.creditor.IBAN)},
+				CreditorAgent:      newPainAgent(s.creditor.BIC),
+				ChargeBearer:       "SLEV",
+				CreditorSchemeID:   s.creditor.ID,
+				CreditorSchemeName: "SEPA",
+				Transactions:       transactions,
+			},
+		},
+	}
+}
+
+func newPainTransaction(collectable *Collectable) painTransaction {
+	mandate := collectable.Mandate
+
+	return painTransaction{
+		EndToEndID:  strings.ReplaceAll(collectable.InvoiceID, "-", ""),
+		Amount:      painAmount{Currency: "EUR", Value: formatAmount(collectable.OutstandingCents)},
+		MandateID:   mandate.MandateReference,
+		MandateDate: mandate.MandateDate.AsTime().Format(time.DateOnly),
+		DebtorAgent: newPainAgent(mandate.Bic),
+		Debtor:      painParty{Name: truncate(mandate.AccountHolder, maxNameLength)},
+		DebtorAccount: painAccount{
+			IBAN: mandate.Iban,
+		},
+		RemittanceInfo: truncate(fmt.Sprintf(
+			"%s %s - %s",
+			collectable.PlanName,
+			collectable.PeriodStart.Format(time.DateOnly),
+			collectable.PeriodEnd.AddDate(0, 0, -1).Format(time.DateOnly),
+		), maxRemittanceLength),
+	}
+}
+
+func newPainAgent(bic string) painAgent {
+	if bic == "" {
+		return painAgent{Other: notProvided}
+	}
+
+	return painAgent{BIC: bic}
+}
+
+// sepaID returns a unique identifier that fits into the 35 characters allowed for SEPA identifiers.
+func sepaID() string {
+	return strings.ReplaceAll(uuid.New().String(), "-", "")
+}
+
+func formatAmount(cents int64) string {
+	return fmt.Sprintf("%d.%02d", cents/100, cents%100)
+}
+
+func truncate(value string, length int) string {
+	if utf8.RuneCountInString(value) <= length {
+		return value
+	}
+
+	return string([]rune(value)[:length])
+}
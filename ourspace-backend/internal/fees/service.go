@@ -0,0 +1,796 @@
+package fees
+
+import (
+	"context"
+	"database/sql"
+	"encoding/base64"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	pb "github.com/cfhn/our-space/ourspace-backend/proto"
+	"github.com/cfhn/our-space/pkg/status"
+)
+
+// maxBillingAhead limits how far in advance invoices can be generated.
+const maxBillingAhead = 366 * 24 * time.Hour
+
+type Service struct {
+	repo     *Postgres
+	creditor Creditor
+	pb.UnimplementedFeeServiceServer
+}
+
+func NewService(repo *Postgres, creditor Creditor) *Service {
+	return &Service{repo: repo, creditor: creditor}
+}
+
+func (s *Service) CreateMembershipPlan(
+	ctx context.Context, request *pb.CreateMembershipPlanRequest,
+) (*pb.MembershipPlan, error) {
+	fieldViolations := validateCreateMembershipPlan(request)
+	if len(fieldViolations) != 0 {
+		return nil, status.FieldViolations(fieldViolations)
+	}
+
+	if request.MembershipPlanId != "" {
+		request.MembershipPlan.Id = request.MembershipPlanId
+	} else {
+		request.MembershipPlan.Id = uuid.New().String()
+	}
+
+	plan, err := s.repo.CreatePlan(ctx, request.MembershipPlan)
+	if err != nil {
+		return nil, status.Internal(err)
+	}
+
+	return plan, nil
+}
+
+func validateCreateMembershipPlan(request *pb.CreateMembershipPlanRequest) []*errdetails.BadRequest_FieldViolation {
+	if request.MembershipPlan == nil {
+		return []*errdetails.BadRequest_FieldViolation{{
+			Field:       "membership_plan",
+			Description: "membership_plan field must not be empty",
+			Reason:      "FIELD_EMPTY",
+		}}
+	}
+
+	var fieldViolations []*errdetails.BadRequest_FieldViolation
+
+	if request.MembershipPlanId != "" {
+		if _, err := uuid.Parse(request.MembershipPlanId); err != nil {
+			fieldViolations = append(fieldViolations, &errdetails.BadRequest_FieldViolation{
+				Field:       "membership_plan_id",
+				Description: "membership_plan_id must be a valid UUID",
+				Reason:      "FIELD_INVALID",
+			})
+		}
+	}
+
+	plan := request.MembershipPlan
+
+	fieldViolations = append(fieldViolations, validateDisplayName(plan.DisplayName)...)
+	fieldViolations = append(fieldViolations, validatePrice("membership_plan.price_cents", plan.PriceCents)...)
+	fieldViolations = append(fieldViolations, validateReducedPrice(plan.ReducedPriceCents)...)
+	fieldViolations = append(fieldViolations, validateBillingInterval(plan.BillingInterval)...)
+
+	return fieldViolations
+}
+
+func validateDisplayName(displayName string) []*errdetails.BadRequest_FieldViolation {
+	if displayName == "" {
+		return []*errdetails.BadRequest_FieldViolation{{
+			Field:       "membership_plan.display_name",
+			Description: "display_name must not be empty",
+			Reason:      "FIELD_EMPTY",
+		}}
+	}
+
+	if len(displayName) > 256 {
+		return []*errdetails.BadRequest_FieldViolation{{
+			Field:       "membership_plan.display_name",
+			Description: "display_name must be shorter than 256 characters, use description for longer texts",
+			Reason:      "FIELD_TOO_LARGE",
+		}}
+	}
+
+	return nil
+}
+
+func validatePrice(field string, priceCents int64) []*errdetails.BadRequest_FieldViolation {
+	if priceCents < 0 {
+		return []*errdetails.BadRequest_FieldViolation{{
+			Field:       field,
+			Description: "price must not be negative",
+			Reason:      "FIELD_INVALID",
+		}}
+	}
+
+	return nil
+}
+
+func validateReducedPrice(reducedPriceCents *int64) []*errdetails.BadRequest_FieldViolation {
+	if reducedPriceCents == nil {
+		return nil
+	}
+
+	return validatePrice("membership_plan.reduced_price_cents", *reducedPriceCents)
+}
+
+func validateBillingInterval(interval pb.BillingInterval) []*errdetails.BadRequest_FieldViolation {
+	if _, ok := pb.BillingInterval_name[int32(interval)]; !ok || interval == pb.BillingInterval_BILLING_INTERVAL_UNKNOWN {
+		return []*errdetails.BadRequest_FieldViolation{{
+			Field:       "membership_plan.billing_interval",
+			Description: "billing_interval must be a supported interval",
+			Reason:      "FIELD_INVALID",
+		}}
+	}
+
+	return nil
+}
+
+func (s *Service) GetMembershipPlan(
+	ctx context.Context, request *pb.GetMembershipPlanRequest,
+) (*pb.MembershipPlan, error) {
+	if _, err := uuid.Parse(request.Id); err != nil {
+		return nil, status.NotFound()
+	}
+
+	plan, err := s.repo.GetPlan(ctx, request.Id)
+	if errors.Is(err, ErrNotFound) {
+		return nil, status.NotFound()
+	}
+
+	if err != nil {
+		return nil, status.Internal(err)
+	}
+
+	return plan, nil
+}
+
+func (s *Service) ListMembershipPlans(
+	ctx context.Context, request *pb.ListMembershipPlansRequest,
+) (*pb.ListMembershipPlansResponse, error) {
+	pageToken := &pb.MembershipPlanPageToken{}
+
+	err := decodePageToken(request.PageToken, pageToken)
+	if err != nil {
+		return nil, err
+	}
+
+	pageSize := defaultPageSize(request.PageSize)
+
+	plans, err := s.repo.ListPlans(ctx, pageSize+1, pageToken)
+	if err != nil {
+		return nil, status.Internal(err)
+	}
+
+	var nextPageToken string
+
+	if len(plans) > int(pageSize) {
+		plans = plans[:pageSize]
+		lastPlan := plans[pageSize-1]
+
+		nextPageToken, err = encodePageToken(&pb.MembershipPlanPageToken{
+			LastDisplayName: lastPlan.DisplayName,
+			LastId:          lastPlan.Id,
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &pb.ListMembershipPlansResponse{
+		MembershipPlans: plans,
+		NextPageToken:   nextPageToken,
+	}, nil
+}
+
+func (s *Service) UpdateMembershipPlan(
+	ctx context.Context, request *pb.UpdateMembershipPlanRequest,
+) (*pb.MembershipPlan, error) {
+	fieldViolations := validateUpdateMembershipPlan(request)
+	if len(fieldViolations) != 0 {
+		return nil, status.FieldViolations(fieldViolations)
+	}
+
+	plan, err := s.repo.UpdatePlan(ctx, request.MembershipPlan, request.FieldMask)
+	if errors.Is(err, ErrNotFound) {
+		return nil, status.NotFound()
+	}
+
+	if err != nil {
+		return nil, status.Internal(err)
+	}
+
+	return plan, nil
+}
+
+func validateUpdateMembershipPlan(request *pb.UpdateMembershipPlanRequest) []*errdetails.BadRequest_FieldViolation {
+	if request.MembershipPlan == nil {
+		return []*errdetails.BadRequest_FieldViolation{{
+			Field:       "membership_plan",
+			Description: "membership_plan field must not be empty",
+			Reason:      "FIELD_EMPTY",
+		}}
+	}
+
+	if _, err := uuid.Parse(request.MembershipPlan.Id); err != nil {
+		return []*errdetails.BadRequest_FieldViolation{{
+			Field:       "membership_plan.id",
+			Description: "id must be a valid UUID",
+			Reason:      "FIELD_INVALID",
+		}}
+	}
+
+	if !request.FieldMask.IsValid(&pb.MembershipPlan{}) {
+		return []*errdetails.BadRequest_FieldViolation{{
+			Field:       "field_mask",
+			Description: "invalid field_mask",
+			Reason:      "FIELD_INVALID",
+		}}
+	}
+
+	plan := request.MembershipPlan
+	fieldViolations := make([]*errdetails.BadRequest_FieldViolation, 0)
+
+	for _, path := range request.FieldMask.Paths {
+		switch path {
+		case "display_name":
+			fieldViolations = append(fieldViolations, validateDisplayName(plan.DisplayName)...)
+		case "price_cents":
+			fieldViolations = append(fieldViolations, validatePrice("membership_plan.price_cents", plan.PriceCents)...)
+		case "reduced_price_cents":
+			fieldViolations = append(fieldViolations, validateReducedPrice(plan.ReducedPriceCents)...)
+		case "billing_interval":
+			fieldViolations = append(fieldViolations, validateBillingInterval(plan.BillingInterval)...)
+		case "description":
+		default:
+			fieldViolations = append(fieldViolations, &errdetails.BadRequest_FieldViolation{
+				Field:       "field_mask",
+				Description: path + " can not be updated",
+				Reason:      "FIELD_INVALID",
+			})
+		}
+	}
+
+	return fieldViolations
+}
+
+func (s *Service) DeleteMembershipPlan(
+	ctx context.Context, request *pb.DeleteMembershipPlanRequest,
+) (*emptypb.Empty, error) {
+	if _, err := uuid.Parse(request.Id); err != nil {
+		return nil, status.NotFound()
+	}
+
+	err := s.repo.DeletePlan(ctx, request.Id)
+
+	switch {
+	case errors.Is(err, ErrNotFound):
+		return nil, status.NotFound()
+	case errors.Is(err, ErrPlanInUse):
+		return nil, status.FailedPrecondition("membership plan is still assigned or invoiced")
+	case err != nil:
+		return nil, status.Internal(err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s *Service) AssignMembershipPlan(
+	ctx context.Context, request *pb.AssignMembershipPlanRequest,
+) (*pb.PlanAssignment, error) {
+	fieldViolations := validateAssignMembershipPlan(request)
+	if len(fieldViolations) != 0 {
+		return nil, status.FieldViolations(fieldViolations)
+	}
+
+	request.PlanAssignment.Id = uuid.New().String()
+
+	assignment, err := s.repo.CreateAssignment(ctx, request.PlanAssignment)
+
+	switch {
+	case errors.Is(err, ErrReferenceNotFound):
+		return nil, status.FieldViolations([]*errdetails.BadRequest_FieldViolation{{
+			Field:       "plan_assignment",
+			Description: "member_id and plan_id must refer to existing entries",
+			Reason:      "FIELD_INVALID",
+		}})
+	case errors.Is(err, ErrOverlap):
+		return nil, status.FailedPrecondition("plan assignment overlaps with another assignment of the member")
+	case err != nil:
+		return nil, status.Internal(err)
+	}
+
+	return assignment, nil
+}
+
+func validateAssignMembershipPlan(request *pb.AssignMembershipPlanRequest) []*errdetails.BadRequest_FieldViolation {
+	if request.PlanAssignment == nil {
+		return []*errdetails.BadRequest_FieldViolation{{
+			Field:       "plan_assignment",
+			Description: "plan_assignment field must not be empty",
+			Reason:      "FIELD_EMPTY",
+		}}
+	}
+
+	var (
+		assignment      = request.PlanAssignment
+		fieldViolations []*errdetails.BadRequest_FieldViolation
+	)
+
+	if _, err := uuid.Parse(assignment.MemberId); err != nil {
+		fieldViolations = append(fieldViolations, &errdetails.BadRequest_FieldViolation{
+			Field:       "plan_assignment.member_id",
+			Description: "member_id must be a valid UUID",
+			Reason:      "FIELD_INVALID",
+		})
+	}
+
+	if _, err := uuid.Parse(assignment.PlanId); err != nil {
+		fieldViolations = append(fieldViolations, &errdetails.BadRequest_FieldViolation{
+			Field:       "plan_assignment.plan_id",
+			Description: "plan_id must be a valid UUID",
+			Reason:      "FIELD_INVALID",
+		})
+	}
+
+	if assignment.StartTime == nil {
+		return append(fieldViolations, &errdetails.BadRequest_FieldViolation{
+			Field:       "plan_assignment.start_time",
+			Description: "start_time must be set",
+			Reason:      "FIELD_EMPTY",
+		})
+	}
+
+	if assignment.EndTime != nil && !assignment.EndTime.AsTime().After(assignment.StartTime.AsTime()) {
+		fieldViolations = append(fieldViolations, &errdetails.BadRequest_FieldViolation{
+			Field:       "plan_assignment.end_time",
+			Description: "end_time must be after start_time",
+			Reason:      "FIELD_INVALID",
+		})
+	}
+
+	return fieldViolations
+}
+
+func (s *Service) ListPlanAssignments(
+	ctx context.Context, request *pb.ListPlanAssignmentsRequest,
+) (*pb.ListPlanAssignmentsResponse, error) {
+	fieldViolations := validateIDFilters(map[string]*string{
+		"member_id": request.MemberId,
+		"plan_id":   request.PlanId,
+	})
+	if len(fieldViolations) != 0 {
+		return nil, status.FieldViolations(fieldViolations)
+	}
+
+	pageToken := &pb.PlanAssignmentPageToken{}
+
+	err := decodePageToken(request.PageToken, pageToken)
+	if err != nil {
+		return nil, err
+	}
+
+	pageSize := defaultPageSize(request.PageSize)
+
+	assignments, err := s.repo.ListAssignments(ctx, pageSize+1, pageToken, &AssignmentFilters{
+		MemberID: nullString(request.MemberId),
+		PlanID:   nullString(request.PlanId),
+	})
+	if err != nil {
+		return nil, status.Internal(err)
+	}
+
+	var nextPageToken string
+
+	if len(assignments) > int(pageSize) {
+		assignments = assignments[:pageSize]
+		lastAssignment := assignments[pageSize-1]
+
+		nextPageToken, err = encodePageToken(&pb.PlanAssignmentPageToken{
+			LastStartTime: lastAssignment.StartTime,
+			LastId:        lastAssignment.Id,
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &pb.ListPlanAssignmentsResponse{
+		PlanAssignments: assignments,
+		NextPageToken:   nextPageToken,
+	}, nil
+}
+
+func (s *Service) EndPlanAssignment(
+	ctx context.Context, request *pb.EndPlanAssignmentRequest,
+) (*pb.PlanAssignment, error) {
+	if _, err := uuid.Parse(request.Id); err != nil {
+		return nil, status.NotFound()
+	}
+
+	if request.EndTime == nil {
+		return nil, status.FieldViolations([]*errdetails.BadRequest_FieldViolation{{
+			Field:       "end_time",
+			Description: "end_time must be set",
+			Reason:      "FIELD_EMPTY",
+		}})
+	}
+
+	assignment, err := s.repo.EndAssignment(ctx, request.Id, request.EndTime.AsTime())
+
+	switch {
+	case errors.Is(err, ErrNotFound):
+		return nil, status.NotFound()
+	case errors.Is(err, ErrEndBeforeStart):
+		return nil, status.FieldViolations([]*errdetails.BadRequest_FieldViolation{{
+			Field:       "end_time",
+			Description: "end_time must be after the start_time of the assignment",
+			Reason:      "FIELD_INVALID",
+		}})
+	case errors.Is(err, ErrOverlap):
+		return nil, status.FailedPrecondition("plan assignment overlaps with another assignment of the member")
+	case err != nil:
+		return nil, status.Internal(err)
+	}
+
+	return assignment, nil
+}
+
+func (s *Service) GenerateInvoices(
+	ctx context.Context, request *pb.GenerateInvoicesRequest,
+) (*pb.GenerateInvoicesResponse, error) {
+	until := time.Now()
+	if request.Until != nil {
+		until = request.Until.AsTime()
+	}
+
+	if until.After(time.Now().Add(maxBillingAhead)) {
+		return nil, status.FieldViolations([]*errdetails.BadRequest_FieldViolation{{
+			Field:       "until",
+			Description: "invoices can only be generated up to one year in advance",
+			Reason:      "FIELD_INVALID",
+		}})
+	}
+
+	invoices, err := s.repo.GenerateInvoices(ctx, until)
+	if err != nil {
+		return nil, status.Internal(err)
+	}
+
+	return &pb.GenerateInvoicesResponse{Invoices: invoices}, nil
+}
+
+func (s *Service) ListInvoices(
+	ctx context.Context, request *pb.ListInvoicesRequest,
+) (*pb.ListInvoicesResponse, error) {
+	fieldViolations := validateIDFilters(map[string]*string{"member_id": request.MemberId})
+	if len(fieldViolations) != 0 {
+		return nil, status.FieldViolations(fieldViolations)
+	}
+
+	pageToken := &pb.InvoicePageToken{}
+
+	err := decodePageToken(request.PageToken, pageToken)
+	if err != nil {
+		return nil, err
+	}
+
+	filters := &InvoiceFilters{MemberID: nullString(request.MemberId)}
+	if request.Status != nil {
+		filters.Status = sql.Null[string]{V: request.Status.String(), Valid: true}
+	}
+
+	pageSize := defaultPageSize(request.PageSize)
+
+	invoices, err := s.repo.ListInvoices(ctx, pageSize+1, pageToken, filters)
+	if err != nil {
+		return nil, status.Internal(err)
+	}
+
+	var nextPageToken string
+
+	if len(invoices) > int(pageSize) {
+		invoices = invoices[:pageSize]
+		lastInvoice := invoices[pageSize-1]
+
+		nextPageToken, err = encodePageToken(&pb.InvoicePageToken{
+			LastPeriodStart: lastInvoice.PeriodStart,
+			LastId:          lastInvoice.Id,
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &pb.ListInvoicesResponse{
+		Invoices:      invoices,
+		NextPageToken: nextPageToken,
+	}, nil
+}
+
+func (s *Service) CancelInvoice(ctx context.Context, request *pb.CancelInvoiceRequest) (*pb.Invoice, error) {
+	if _, err := uuid.Parse(request.Id); err != nil {
+		return nil, status.NotFound()
+	}
+
+	invoice, err := s.repo.CancelInvoice(ctx, request.Id)
+	if errors.Is(err, ErrNotFound) {
+		return nil, status.NotFound()
+	}
+
+	if err != nil {
+		return nil, status.Internal(err)
+	}
+
+	return invoice, nil
+}
+
+func (s *Service) RecordPayment(ctx context.Context, request *pb.RecordPaymentRequest) (*pb.Payment, error) {
+	fieldViolations := validateRecordPayment(request)
+	if len(fieldViolations) != 0 {
+		return nil, status.FieldViolations(fieldViolations)
+	}
+
+	if request.PaymentId != "" {
+		request.Payment.Id = request.PaymentId
+	} else {
+		request.Payment.Id = uuid.New().String()
+	}
+
+	if request.Payment.PaymentTime == nil {
+		request.Payment.PaymentTime = timestamppb.Now()
+	}
+
+	payment, err := s.repo.CreatePayment(ctx, request.Payment)
+
+	switch {
+	case errors.Is(err, ErrReferenceNotFound):
+		return nil, status.FieldViolations([]*errdetails.BadRequest_FieldViolation{{
+			Field:       "payment.member_id",
+			Description: "member does not exist",
+			Reason:      "FIELD_INVALID",
+		}})
+	case errors.Is(err, ErrInvoiceMismatch):
+		return nil, status.FieldViolations([]*errdetails.BadRequest_FieldViolation{{
+			Field:       "payment.invoice_id",
+			Description: "invoice does not exist or belongs to another member",
+			Reason:      "FIELD_INVALID",
+		}})
+	case err != nil:
+		return nil, status.Internal(err)
+	}
+
+	return payment, nil
+}
+
+func validateRecordPayment(request *pb.RecordPaymentRequest) []*errdetails.BadRequest_FieldViolation {
+	if request.Payment == nil {
+		return []*errdetails.BadRequest_FieldViolation{{
+			Field:       "payment",
+			Description: "payment field must not be empty",
+			Reason:      "FIELD_EMPTY",
+		}}
+	}
+
+	var (
+		payment         = request.Payment
+		fieldViolations []*errdetails.BadRequest_FieldViolation
+	)
+
+	if request.PaymentId != "" {
+		if _, err := uuid.Parse(request.PaymentId); err != nil {
+			fieldViolations = append(fieldViolations, &errdetails.BadRequest_FieldViolation{
+				Field:       "payment_id",
+				Description: "payment_id must be a valid UUID",
+				Reason:      "FIELD_INVALID",
+			})
+		}
+	}
+
+	if _, err := uuid.Parse(payment.MemberId); err != nil {
+		fieldViolations = append(fieldViolations, &errdetails.BadRequest_FieldViolation{
+			Field:       "payment.member_id",
+			Description: "member_id must be a valid UUID",
+			Reason:      "FIELD_INVALID",
+		})
+	}
+
+	if payment.InvoiceId != nil {
+		if _, err := uuid.Parse(*payment.InvoiceId); err != nil {
+			fieldViolations = append(fieldViolations, &errdetails.BadRequest_FieldViolation{
+				Field:       "payment.invoice_id",
+				Description: "invoice_id must be a valid UUID",
+				Reason:      "FIELD_INVALID",
+			})
+		}
+	}
+
+	if payment.AmountCents <= 0 {
+		fieldViolations = append(fieldViolations, &errdetails.BadRequest_FieldViolation{
+			Field:       "payment.amount_cents",
+			Description: "amount_cents must be positive",
+			Reason:      "FIELD_INVALID",
+		})
+	}
+
+	if len(payment.Reference) > 1024 {
+		fieldViolations = append(fieldViolations, &errdetails.BadRequest_FieldViolation{
+			Field:       "payment.reference",
+			Description: "reference must be shorter than 1024 characters",
+			Reason:      "FIELD_TOO_LARGE",
+		})
+	}
+
+	return fieldViolations
+}
+
+func (s *Service) ListPayments(
+	ctx context.Context, request *pb.ListPaymentsRequest,
+) (*pb.ListPaymentsResponse, error) {
+	fieldViolations := validateIDFilters(map[string]*string{
+		"member_id":  request.MemberId,
+		"invoice_id": request.InvoiceId,
+	})
+	if len(fieldViolations) != 0 {
+		return nil, status.FieldViolations(fieldViolations)
+	}
+
+	pageToken := &pb.PaymentPageToken{}
+
+	err := decodePageToken(request.PageToken, pageToken)
+	if err != nil {
+		return nil, err
+	}
+
+	pageSize := defaultPageSize(request.PageSize)
+
+	payments, err := s.repo.ListPayments(ctx, pageSize+1, pageToken, &PaymentFilters{
+		MemberID:  nullString(request.MemberId),
+		InvoiceID: nullString(request.InvoiceId),
+	})
+	if err != nil {
+		return nil, status.Internal(err)
+	}
+
+	var nextPageToken string
+
+	if len(payments) > int(pageSize) {
+		payments = payments[:pageSize]
+		lastPayment := payments[pageSize-1]
+
+		nextPageToken, err = encodePageToken(&pb.PaymentPageToken{
+			LastPaymentTime: lastPayment.PaymentTime,
+			LastId:          lastPayment.Id,
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &pb.ListPaymentsResponse{
+		Payments:      payments,
+		NextPageToken: nextPageToken,
+	}, nil
+}
+
+func (s *Service) DeletePayment(ctx context.Context, request *pb.DeletePaymentRequest) (*emptypb.Empty, error) {
+	if _, err := uuid.Parse(request.Id); err != nil {
+		return nil, status.NotFound()
+	}
+
+	err := s.repo.DeletePayment(ctx, request.Id)
+	if errors.Is(err, ErrNotFound) {
+		return nil, status.NotFound()
+	}
+
+	if err != nil {
+		return nil, status.Internal(err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s *Service) ListBalances(
+	ctx context.Context, request *pb.ListBalancesRequest,
+) (*pb.ListBalancesResponse, error) {
+	fieldViolations := validateIDFilters(map[string]*string{"member_id": request.MemberId})
+	if len(fieldViolations) != 0 {
+		return nil, status.FieldViolations(fieldViolations)
+	}
+
+	pageToken := &pb.BalancePageToken{}
+
+	err := decodePageToken(request.PageToken, pageToken)
+	if err != nil {
+		return nil, err
+	}
+
+	pageSize := defaultPageSize(request.PageSize)
+
+	balances, err := s.repo.ListBalances(ctx, pageSize+1, pageToken, &BalanceFilters{
+		MemberID:        nullString(request.MemberId),
+		OutstandingOnly: request.OutstandingOnly,
+	})
+	if err != nil {
+		return nil, status.Internal(err)
+	}
+
+	var nextPageToken string
+
+	if len(balances) > int(pageSize) {
+		balances = balances[:pageSize]
+
+		nextPageToken, err = encodePageToken(&pb.BalancePageToken{LastMemberId: balances[pageSize-1].MemberId})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &pb.ListBalancesResponse{
+		Balances:      balances,
+		NextPageToken: nextPageToken,
+	}, nil
+}
+
+func validateIDFilters(filters map[string]*string) []*errdetails.BadRequest_FieldViolation {
+	var fieldViolations []*errdetails.BadRequest_FieldViolation
+
+	for field, value := range filters {
+		if value == nil {
+			continue
+		}
+
+		if _, err := uuid.Parse(*value); err != nil {
+			fieldViolations = append(fieldViolations, &errdetails.BadRequest_FieldViolation{
+				Field:       field,
+				Description: field + " must be a valid UUID",
+				Reason:      "FIELD_INVALID",
+			})
+		}
+	}
+
+	return fieldViolations
+}
+
+func nullString(value *string) sql.Null[string] {
+	if value == nil {
+		return sql.Null[string]{}
+	}
+
+	return sql.Null[string]{V: *value, Valid: true}
+}
+
+func defaultPageSize(pageSize int32) int32 {
+	if pageSize == 0 {
+		return 50
+	}
+
+	return pageSize
+}
+
+func decodePageToken(pageToken string, token proto.Message) error {
+	pageTokenBytes, err := base64.RawStdEncoding.DecodeString(pageToken)
+	if err != nil {
+		return err
+	}
+
+	return proto.Unmarshal(pageTokenBytes, token)
+}
+
+func encodePageToken(token proto.Message) (string, error) {
+	pageTokenBytes, err := proto.Marshal(token)
+	if err != nil {
+		return "", err
+	}
+
+	return base64.RawStdEncoding.EncodeToString(pageTokenBytes), nil
+}
@@ -0,0 +1,508 @@
+package lending
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgtype"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	pb "github.com/cfhn/our-space/ourspace-backend/proto"
+)
+
+const uniqueViolation = "23505"
+
+var (
+	ErrNotFound     = errors.New("not found")
+	ErrDuplicateTag = errors.New("another item has the same tag")
+	ErrAlreadyLent  = errors.New("item is already lent")
+)
+
+//nolint:gochecknoglobals // constant field lookup
+var itemFields = map[pb.ItemField]string{
+	pb.ItemField_ITEM_FIELD_ID:   "items.id",
+	pb.ItemField_ITEM_FIELD_NAME: "items.name",
+}
+
+const selectItem = `
+	select
+		items.id, name, description, rfid_value, barcode, condition_notes, required_briefing_types,
+		not exists (select 1 from loans where loans.item_id = items.id and loans.return_time is null)
+	from items
+`
+
+const selectLoan = `
+	select
+		id, item_id, member_id, lend_time, expected_return_time, return_time, return_condition_notes, terminal_id,
+		return_time is null and expected_return_time < now()
+	from loans
+`
+
+type ItemFilters struct {
+	Available sql.Null[bool]
+}
+
+type LoanFilters struct {
+	ItemID   string
+	MemberID string
+	Open     bool
+	Overdue  bool
+}
+
+type Postgres struct {
+	db *sql.DB
+}
+
+func NewPostgresRepo(db *sql.DB) *Postgres {
+	return &Postgres{db: db}
+}
+
+func (p *Postgres) CreateItem(ctx context.Context, item *pb.Item) (*pb.Item, error) {
+	_, err := p.db.ExecContext(ctx, `
+		insert into items (id, name, description, rfid_value, barcode, condition_notes, required_briefing_types)
+		values ($1, $2, $3, $4, $5, $6, $7);
+	`,
+		item.Id, item.Name, item.Description, rfidValue(item.RfidValue), barcode(item.Barcode), item.ConditionNotes,
+		briefingTypes(item.RequiredBriefingTypes),
+	)
+	if err != nil {
+		return nil, mapItemError(err)
+	}
+
+	return p.GetItem(ctx, item.Id)
+}
+
+func (p *Postgres) GetItem(ctx context.Context, id string) (*pb.Item, error) {
+	return p.getItem(ctx, `where items.id = $1`, id)
+}
+
+// GetItemByTag returns the item with the scanned tag. Exactly one of rfidValue and barcode is expected to be set.
+func (p *Postgres) GetItemByTag(ctx context.Context, rfid []byte, code string) (*pb.Item, error) {
+	return p.getItem(ctx, `where items.rfid_value = $1 or items.barcode = $2`, rfidValue(rfid), barcode(code))
+}
+
+func (p *Postgres) getItem(ctx context.Context, condition string, values ...any) (*pb.Item, error) {
+	row := p.db.QueryRowContext(ctx, selectItem+condition, values...)
+
+	item, err := scanItem(row)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrNotFound
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return item, nil
+}
+
+func (p *Postgres) ListItems(
+	ctx context.Context, pageSize int32, token *pb.ItemPageToken, sortField pb.ItemField,
+	sortDirection pb.SortDirection, filters *ItemFilters,
+) ([]*pb.Item, error) {
+	values := append(
+		make([]any, 0, 4),
+		pageSize,
+		filters.Available,
+	)
+
+	paginationCondition, paginationValues := generatePaginationQuery(token, len(values)+1)
+
+	values = append(values, paginationValues...)
+
+	//nolint:gosec // manual concatenation is fine here, uses bound placeholders
+	rows, err := p.db.QueryContext(ctx, `
+		select * from (`+selectItem+`) as items (
+			id, name, description, rfid_value, barcode, condition_notes, required_briefing_types, available
+		)
+		where ($2::boolean is null OR available = $2)
+		`+paginationCondition+`
+		order by `+getSort(sortField, sortDirection, token)+`
+		limit $1
+	`, values...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	items := make([]*pb.Item, 0, pageSize)
+
+	for rows.Next() {
+		item, err := scanItem(rows)
+		if err != nil {
+			return nil, err
+		}
+
+		items = append(items, item)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return items, nil
+}
+
+func generatePaginationQuery(token *pb.ItemPageToken, offset int) (string, []any) {
+	fields := make([]string, 0, 2)
+	values := make([]any, 0, 2)
+	placeholders := make([]string, 0, 2)
+
+	fieldName, ok := itemFields[token.Field]
+	if !ok {
+		return "", nil
+	}
+
+	fields = append(fields, fieldName)
+	values = append(values, token.LastValue)
+
+	if token.Field != pb.ItemField_ITEM_FIELD_ID {
+		fields = append(fields, "items.id")
+		values = append(values, token.LastId)
+	}
+
+	for i := range len(fields) {
+		placeholders = append(placeholders, fmt.Sprintf("$%d", i+offset))
+	}
+
+	sort := ">"
+	if token.Direction == pb.SortDirection_SORT_DIRECTION_DESCENDING {
+		sort = "<"
+	}
+
+	return "and (" + strings.Join(fields, ",") + ")" +
+		sort + "(" + strings.Join(placeholders, ",") + ")", values
+}
+
+func getSort(sortField pb.ItemField, direction pb.SortDirection, token *pb.ItemPageToken) string {
+	if token.Field != pb.ItemField_ITEM_FIELD_UNKNOWN {
+		sortField = token.Field
+		direction = token.Direction
+	}
+
+	fieldName, ok := itemFields[sortField]
+	if !ok {
+		return "items.id"
+	}
+
+	order := " ASC"
+	if direction == pb.SortDirection_SORT_DIRECTION_DESCENDING {
+		order = " DESC"
+	}
+
+	return fieldName + order + ", items.id" + order
+}
+
+func (p *Postgres) UpdateItem(ctx context.Context, item *pb.Item, fieldMask *fieldmaskpb.FieldMask) (*pb.Item, error) {
+	var (
+		name                  sql.Null[string]
+		description           sql.Null[string]
+		conditionNotes        sql.Null[string]
+		changeRfidValue       bool
+		changeBarcode         bool
+		changeBriefingTypes   bool
+		requiredBriefingTypes = pgtype.FlatArray[string]{}
+	)
+
+	for _, path := range fieldMask.Paths {
+		switch path {
+		case "name":
+			name = sql.Null[string]{V: item.Name, Valid: true}
+		case "description":
+			description = sql.Null[string]{V: item.Description, Valid: true}
+		case "condition_notes":
+			conditionNotes = sql.Null[string]{V: item.ConditionNotes, Valid: true}
+		case "rfid_value":
+			changeRfidValue = true
+		case "barcode":
+			changeBarcode = true
+		case "required_briefing_types":
+			changeBriefingTypes = true
+			requiredBriefingTypes = briefingTypes(item.RequiredBriefingTypes)
+		}
+	}
+
+	result, err := p.db.ExecContext(ctx, `
+		update items
+		set
+			name = coalesce($2, name),
+			description = coalesce($3, description),
+			condition_notes = coalesce($4, condition_notes),
+			rfid_value = case when $5 then $6 else rfid_value end,
+			barcode = case when $7 then $8 else barcode end,
+			required_briefing_types = case when $9 then $10 else required_briefing_types end
+		where id = $1
+	`,
+		item.Id, name, description, conditionNotes, changeRfidValue, rfidValue(item.RfidValue), changeBarcode,
+		barcode(item.Barcode), changeBriefingTypes, requiredBriefingTypes,
+	)
+	if err != nil {
+		return nil, mapItemError(err)
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return nil, err
+	}
+
+	if affected == 0 {
+		return nil, ErrNotFound
+	}
+
+	return p.GetItem(ctx, item.Id)
+}
+
+func (p *Postgres) DeleteItem(ctx context.Context, id string) error {
+	result, err := p.db.ExecContext(ctx, `delete from items where id = $1`, id)
+	if err != nil {
+		return err
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if affected == 0 {
+		return ErrNotFound
+	}
+
+	return nil
+}
+
+func mapItemError(err error) error {
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && pgErr.Code == uniqueViolation {
+		return ErrDuplicateTag
+	}
+
+	return err
+}
+
+// CreateLoan records that the item was lent to the member. It returns ErrAlreadyLent if the item has not been
+// returned yet.
+func (p *Postgres) CreateLoan(
+	ctx context.Context, itemID, memberID string, expectedReturnTime time.Time, terminalID string,
+) (*pb.Loan, error) {
+	var loanID string
+
+	err := p.db.QueryRowContext(ctx, `
+		insert into loans (item_id, member_id, lend_time, expected_return_time, terminal_id)
+		values ($1, $2, $3, $4, $5)
+		returning id
+	`,
+		itemID, memberID, time.Now(), expectedReturnTime, sql.Null[string]{V: terminalID, Valid: terminalID != ""},
+	).Scan(&loanID)
+
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && pgErr.Code == uniqueViolation && pgErr.ConstraintName == "single_open_loan" {
+		return nil, ErrAlreadyLent
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return p.GetLoan(ctx, loanID)
+}
+
+// ReturnLoan closes the loan. The condition notes are stored with the loan and replace the condition notes of the
+// item, unless they are empty. It returns ErrNotFound if there is no open loan with the ID.
+func (p *Postgres) ReturnLoan(ctx context.Context, id, conditionNotes string) (*pb.Loan, error) {
+	tx, err := p.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback() //nolint:errcheck // rollback after commit is a no-op
+
+	var itemID string
+
+	err = tx.QueryRowContext(ctx, `
+		update loans
+		set
+			return_time = $2,
+			return_condition_notes = $3
+		where id = $1 and return_time is null
+		returning item_id
+	`, id, time.Now(), conditionNotes).Scan(&itemID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrNotFound
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if conditionNotes != "" {
+		_, err = tx.ExecContext(ctx, `update items set condition_notes = $2 where id = $1`, itemID, conditionNotes)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, err
+	}
+
+	return p.GetLoan(ctx, id)
+}
+
+// GetOpenLoan returns the loan of the item that has not been returned yet.
+func (p *Postgres) GetOpenLoan(ctx context.Context, itemID string) (*pb.Loan, error) {
+	return p.getLoan(ctx, `where item_id = $1 and return_time is null`, itemID)
+}
+
+func (p *Postgres) GetLoan(ctx context.Context, id string) (*pb.Loan, error) {
+	return p.getLoan(ctx, `where id = $1`, id)
+}
+
+func (p *Postgres) getLoan(ctx context.Context, condition string, values ...any) (*pb.Loan, error) {
+	row := p.db.QueryRowContext(ctx, selectLoan+condition, values...)
+
+	loan, err := scanLoan(row)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrNotFound
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return loan, nil
+}
+
+// ListLoans lists the lending ledger, the most recent loans first.
+func (p *Postgres) ListLoans(
+	ctx context.Context, pageSize int32, token *pb.LoanPageToken, filters *LoanFilters,
+) ([]*pb.Loan, error) {
+	var (
+		itemID       = sql.Null[string]{V: filters.ItemID, Valid: filters.ItemID != ""}
+		memberID     = sql.Null[string]{V: filters.MemberID, Valid: filters.MemberID != ""}
+		lastLendTime = sql.Null[time.Time]{V: token.LastLendTime.AsTime(), Valid: token.LastLendTime != nil}
+		lastID       = sql.Null[string]{V: token.LastId, Valid: token.LastLendTime != nil}
+	)
+
+	rows, err := p.db.QueryContext(ctx, selectLoan+`
+		where ($2::uuid is null OR item_id = $2)
+		and ($3::uuid is null OR member_id = $3)
+		and ($4 is false OR return_time is null)
+		and ($5 is false OR (return_time is null and expected_return_time < now()))
+		and ($6::timestamptz is null OR (lend_time, id) < ($6, $7::uuid))
+		order by lend_time desc, id desc
+		limit $1
+	`, pageSize, itemID, memberID, filters.Open, filters.Overdue, lastLendTime, lastID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	loans := make([]*pb.Loan, 0, pageSize)
+
+	for rows.Next() {
+		loan, err := scanLoan(rows)
+		if err != nil {
+			return nil, err
+		}
+
+		loans = append(loans, loan)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return loans, nil
+}
+
+type scanner interface {
+	Scan(values ...any) error
+}
+
+func scanItem(in scanner) (*pb.Item, error) {
+	var (
+		item                  = &pb.Item{}
+		itemBarcode           sql.Null[string]
+		requiredBriefingTypes pgtype.FlatArray[string]
+	)
+
+	err := in.Scan(
+		&item.Id,
+		&item.Name,
+		&item.Description,
+		&item.RfidValue,
+		&itemBarcode,
+		&item.ConditionNotes,
+		&requiredBriefingTypes,
+		&item.Available,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	item.Barcode = itemBarcode.V
+	item.RequiredBriefingTypes = requiredBriefingTypes
+
+	return item, nil
+}
+
+func scanLoan(in scanner) (*pb.Loan, error) {
+	var (
+		loan               = &pb.Loan{}
+		lendTime           time.Time
+		expectedReturnTime time.Time
+		returnTime         sql.Null[time.Time]
+		terminalID         sql.Null[string]
+	)
+
+	err := in.Scan(
+		&loan.Id,
+		&loan.ItemId,
+		&loan.MemberId,
+		&lendTime,
+		&expectedReturnTime,
+		&returnTime,
+		&loan.ReturnConditionNotes,
+		&terminalID,
+		&loan.Overdue,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	loan.LendTime = timestamppb.New(lendTime)
+	loan.ExpectedReturnTime = timestamppb.New(expectedReturnTime)
+
+	if returnTime.Valid {
+		loan.ReturnTime = timestamppb.New(returnTime.V)
+	}
+
+	if terminalID.Valid {
+		loan.TerminalId = &terminalID.V
+	}
+
+	return loan, nil
+}
+
+// rfidValue and barcode store empty tags as null, so that the unique indexes only apply to items that have a tag.
+func rfidValue(value []byte) sql.Null[[]byte] {
+	return sql.Null[[]byte]{V: value, Valid: len(value) != 0}
+}
+
+func barcode(value string) sql.Null[string] {
+	return sql.Null[string]{V: value, Valid: value != ""}
+}
+
+func briefingTypes(briefingTypes []string) pgtype.FlatArray[string] {
+	if briefingTypes == nil {
+		return pgtype.FlatArray[string]{}
+	}
+
+	return briefingTypes
+}
@@ -0,0 +1,587 @@
+package lending
+
+import (
+	"context"
+	"database/sql"
+	"encoding/base64"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/cfhn/our-space/ourspace-backend/internal/cards"
+	pb "github.com/cfhn/our-space/ourspace-backend/proto"
+	"github.com/cfhn/our-space/pkg/status"
+)
+
+var ErrFieldUnknown = errors.New("unknown field")
+
+type MemberService interface {
+	GetMember(ctx context.Context, request *pb.GetMemberRequest) (*pb.Member, error)
+}
+
+type BriefingChecker interface {
+	MissingBriefingTypes(ctx context.Context, memberID string, briefingTypes []string) ([]string, error)
+}
+
+type Service struct {
+	repo            *Postgres
+	memberService   MemberService
+	cardService     cards.CardLister
+	briefingChecker BriefingChecker
+	lendingPeriod   time.Duration
+	pb.UnimplementedLendingServiceServer
+}
+
+// NewService creates the lending service. The lending period is used as expected return time, if a loan does not
+// specify one.
+func NewService(
+	repo *Postgres, memberService MemberService, cardService cards.CardLister, briefingChecker BriefingChecker,
+	lendingPeriod time.Duration,
+) *Service {
+	return &Service{
+		repo:            repo,
+		memberService:   memberService,
+		cardService:     cardService,
+		briefingChecker: briefingChecker,
+		lendingPeriod:   lendingPeriod,
+	}
+}
+
+func (s *Service) CreateItem(ctx context.Context, request *pb.CreateItemRequest) (*pb.Item, error) {
+	fieldViolations := validateCreateItem(request)
+	if len(fieldViolations) != 0 {
+		return nil, status.FieldViolations(fieldViolations)
+	}
+
+	if request.ItemId != "" {
+		request.Item.Id = request.ItemId
+	} else {
+		request.Item.Id = uuid.New().String()
+	}
+
+	item, err := s.repo.CreateItem(ctx, request.Item)
+	if errors.Is(err, ErrDuplicateTag) {
+		return nil, status.FieldViolations(duplicateTagViolations())
+	}
+
+	if err != nil {
+		return nil, status.Internal(err)
+	}
+
+	return item, nil
+}
+
+func validateCreateItem(request *pb.CreateItemRequest) []*errdetails.BadRequest_FieldViolation {
+	if request.Item == nil {
+		return []*errdetails.BadRequest_FieldViolation{{
+			Field:       "item",
+			Description: "item field must not be empty",
+			Reason:      "FIELD_EMPTY",
+		}}
+	}
+
+	var fieldViolations []*errdetails.BadRequest_FieldViolation
+
+	if request.ItemId != "" {
+		if _, err := uuid.Parse(request.ItemId); err != nil {
+			fieldViolations = append(fieldViolations, &errdetails.BadRequest_FieldViolation{
+				Field:       "item_id",
+				Description: "item_id must be a valid UUID",
+				Reason:      "FIELD_INVALID",
+			})
+		}
+	}
+
+	fieldViolations = append(fieldViolations, validateName(request.Item.Name)...)
+	fieldViolations = append(fieldViolations, validateTag(request.Item)...)
+
+	return fieldViolations
+}
+
+func validateName(name string) []*errdetails.BadRequest_FieldViolation {
+	if name == "" {
+		return []*errdetails.BadRequest_FieldViolation{{
+			Field:       "item.name",
+			Description: "name must not be empty",
+			Reason:      "FIELD_EMPTY",
+		}}
+	}
+
+	if len(name) > 1024 {
+		return []*errdetails.BadRequest_FieldViolation{{
+			Field:       "item.name",
+			Description: "name must not be over 1KB",
+			Reason:      "FIELD_TOO_LARGE",
+		}}
+	}
+
+	return nil
+}
+
+func validateTag(item *pb.Item) []*errdetails.BadRequest_FieldViolation {
+	var fieldViolations []*errdetails.BadRequest_FieldViolation
+
+	if len(item.RfidValue) > 1024 {
+		fieldViolations = append(fieldViolations, &errdetails.BadRequest_FieldViolation{
+			Field:       "item.rfid_value",
+			Description: "rfid_value must not be over 1KB",
+			Reason:      "FIELD_TOO_BIG",
+		})
+	}
+
+	if len(item.Barcode) > 1024 {
+		fieldViolations = append(fieldViolations, &errdetails.BadRequest_FieldViolation{
+			Field:       "item.barcode",
+			Description: "barcode must not be over 1KB",
+			Reason:      "FIELD_TOO_BIG",
+		})
+	}
+
+	return fieldViolations
+}
+
+func duplicateTagViolations() []*errdetails.BadRequest_FieldViolation {
+	return []*errdetails.BadRequest_FieldViolation{{
+		Field:       "item.rfid_value",
+		Description: "rfid_value and barcode must not be used by another item",
+		Reason:      "FIELD_INVALID",
+	}, {
+		Field:       "item.barcode",
+		Description: "rfid_value and barcode must not be used by another item",
+		Reason:      "FIELD_INVALID",
+	}}
+}
+
+func (s *Service) GetItem(ctx context.Context, request *pb.GetItemRequest) (*pb.Item, error) {
+	item, err := s.repo.GetItem(ctx, request.Id)
+	if errors.Is(err, ErrNotFound) {
+		return nil, status.NotFound()
+	}
+
+	if err != nil {
+		return nil, status.Internal(err)
+	}
+
+	return item, nil
+}
+
+func (s *Service) ListItems(ctx context.Context, request *pb.ListItemsRequest) (*pb.ListItemsResponse, error) {
+	pageTokenBytes, err := base64.RawStdEncoding.DecodeString(request.PageToken)
+	if err != nil {
+		return nil, err
+	}
+
+	pageToken := &pb.ItemPageToken{}
+
+	err = proto.Unmarshal(pageTokenBytes, pageToken)
+	if err != nil {
+		return nil, err
+	}
+
+	filters := &ItemFilters{}
+	if request.Available != nil {
+		filters.Available = sql.Null[bool]{V: *request.Available, Valid: true}
+	}
+
+	pageSize := request.PageSize
+	if pageSize == 0 {
+		pageSize = 50
+	}
+
+	items, err := s.repo.ListItems(ctx, pageSize+1, pageToken, request.SortBy, request.SortDirection, filters)
+	if err != nil {
+		return nil, status.Internal(err)
+	}
+
+	var nextPageToken string
+
+	if len(items) > int(pageSize) {
+		items = items[:pageSize]
+
+		field := pb.ItemField_ITEM_FIELD_ID
+		if pageToken.Field != pb.ItemField_ITEM_FIELD_UNKNOWN {
+			field = pageToken.Field
+		} else if request.SortBy != pb.ItemField_ITEM_FIELD_UNKNOWN {
+			field = request.SortBy
+		}
+
+		direction := pb.SortDirection_SORT_DIRECTION_ASCENDING
+		if pageToken.Direction != pb.SortDirection_SORT_DIRECTION_DEFAULT {
+			direction = pageToken.Direction
+		} else if request.SortDirection != pb.SortDirection_SORT_DIRECTION_DEFAULT {
+			direction = request.SortDirection
+		}
+
+		lastValue, err := getFieldValue(items[pageSize-1], field)
+		if err != nil {
+			return nil, err
+		}
+
+		pbNextPageToken := &pb.ItemPageToken{
+			Field:     field,
+			LastValue: lastValue,
+			Direction: direction,
+			LastId:    items[pageSize-1].Id,
+		}
+
+		nextPageTokenBytes, err := proto.Marshal(pbNextPageToken)
+		if err != nil {
+			return nil, err
+		}
+
+		nextPageToken = base64.RawStdEncoding.EncodeToString(nextPageTokenBytes)
+	}
+
+	return &pb.ListItemsResponse{
+		Items:         items,
+		NextPageToken: nextPageToken,
+	}, nil
+}
+
+func getFieldValue(item *pb.Item, field pb.ItemField) (string, error) {
+	switch field {
+	case pb.ItemField_ITEM_FIELD_ID:
+		return item.Id, nil
+	case pb.ItemField_ITEM_FIELD_NAME:
+		return item.Name, nil
+	default:
+		return "", ErrFieldUnknown
+	}
+}
+
+func (s *Service) UpdateItem(ctx context.Context, request *pb.UpdateItemRequest) (*pb.Item, error) {
+	fieldViolations := validateUpdateItem(request)
+	if len(fieldViolations) != 0 {
+		return nil, status.FieldViolations(fieldViolations)
+	}
+
+	item, err := s.repo.UpdateItem(ctx, request.Item, request.FieldMask)
+
+	switch {
+	case errors.Is(err, ErrNotFound):
+		return nil, status.NotFound()
+	case errors.Is(err, ErrDuplicateTag):
+		return nil, status.FieldViolations(duplicateTagViolations())
+	case err != nil:
+		return nil, status.Internal(err)
+	}
+
+	return item, nil
+}
+
+func validateUpdateItem(request *pb.UpdateItemRequest) []*errdetails.BadRequest_FieldViolation {
+	if request.Item == nil {
+		return []*errdetails.BadRequest_FieldViolation{{
+			Field:       "item",
+			Description: "item field must not be empty",
+			Reason:      "FIELD_EMPTY",
+		}}
+	}
+
+	if !request.FieldMask.IsValid(&pb.Item{}) {
+		return []*errdetails.BadRequest_FieldViolation{{
+			Field:       "field_mask",
+			Description: "invalid field_mask",
+			Reason:      "FIELD_INVALID",
+		}}
+	}
+
+	fieldViolations := make([]*errdetails.BadRequest_FieldViolation, 0)
+
+	for _, path := range request.FieldMask.Paths {
+		switch path {
+		case "name":
+			fieldViolations = append(fieldViolations, validateName(request.Item.Name)...)
+		case "rfid_value", "barcode":
+			fieldViolations = append(fieldViolations, validateTag(request.Item)...)
+		case "description", "condition_notes", "required_briefing_types":
+		default:
+			fieldViolations = append(fieldViolations, &errdetails.BadRequest_FieldViolation{
+				Field:       "field_mask",
+				Description: path + " can not be updated",
+				Reason:      "FIELD_INVALID",
+			})
+		}
+	}
+
+	return fieldViolations
+}
+
+func (s *Service) DeleteItem(ctx context.Context, request *pb.DeleteItemRequest) (*emptypb.Empty, error) {
+	err := s.repo.DeleteItem(ctx, request.Id)
+	if errors.Is(err, ErrNotFound) {
+		return nil, status.NotFound()
+	}
+
+	if err != nil {
+		return nil, status.Internal(err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s *Service) LendItem(ctx context.Context, request *pb.LendItemRequest) (*pb.Loan, error) {
+	fieldViolations := validateLendItem(request)
+	if len(fieldViolations) != 0 {
+		return nil, status.FieldViolations(fieldViolations)
+	}
+
+	_, err := s.memberService.GetMember(ctx, &pb.GetMemberRequest{Id: request.MemberId})
+	if status.FromError(err).Code() == codes.NotFound {
+		return nil, status.FieldViolations([]*errdetails.BadRequest_FieldViolation{{
+			Field:       "member_id",
+			Description: "member does not exist",
+			Reason:      "FIELD_INVALID",
+		}})
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	item, err := s.repo.GetItem(ctx, request.ItemId)
+	if errors.Is(err, ErrNotFound) {
+		return nil, status.NotFound()
+	}
+
+	if err != nil {
+		return nil, status.Internal(err)
+	}
+
+	return s.lend(ctx, item, request.MemberId, request.ExpectedReturnTime, "")
+}
+
+func validateLendItem(request *pb.LendItemRequest) []*errdetails.BadRequest_FieldViolation {
+	var fieldViolations []*errdetails.BadRequest_FieldViolation
+
+	if _, err := uuid.Parse(request.ItemId); err != nil {
+		fieldViolations = append(fieldViolations, &errdetails.BadRequest_FieldViolation{
+			Field:       "item_id",
+			Description: "item_id must be a valid UUID",
+			Reason:      "FIELD_INVALID",
+		})
+	}
+
+	if _, err := uuid.Parse(request.MemberId); err != nil {
+		fieldViolations = append(fieldViolations, &errdetails.BadRequest_FieldViolation{
+			Field:       "member_id",
+			Description: "member_id must be a valid UUID",
+			Reason:      "FIELD_INVALID",
+		})
+	}
+
+	return append(fieldViolations, validateExpectedReturnTime(request.ExpectedReturnTime)...)
+}
+
+func validateExpectedReturnTime(expectedReturnTime *timestamppb.Timestamp) []*errdetails.BadRequest_FieldViolation {
+	if expectedReturnTime != nil && !expectedReturnTime.AsTime().After(time.Now()) {
+		return []*errdetails.BadRequest_FieldViolation{{
+			Field:       "expected_return_time",
+			Description: "expected_return_time must be in the future",
+			Reason:      "FIELD_INVALID",
+		}}
+	}
+
+	return nil
+}
+
+// LendItemByScan lends an item on a terminal. The member is resolved from the scanned card the same way as for
+// checkins, the item from its RFID tag or barcode.
+func (s *Service) LendItemByScan(ctx context.Context, request *pb.LendItemByScanRequest) (*pb.Loan, error) {
+	fieldViolations := validateLendItemByScan(request)
+	if len(fieldViolations) != 0 {
+		return nil, status.FieldViolations(fieldViolations)
+	}
+
+	member, err := cards.ResolveActiveMember(ctx, s.cardService, s.memberService, request.MemberRfidValue)
+	if err != nil {
+		return nil, err
+	}
+
+	item, err := s.repo.GetItemByTag(ctx, request.GetItemRfidValue(), request.GetItemBarcode())
+	if errors.Is(err, ErrNotFound) {
+		return nil, status.NotFound()
+	}
+
+	if err != nil {
+		return nil, status.Internal(err)
+	}
+
+	return s.lend(ctx, item, member.Id, request.ExpectedReturnTime, request.TerminalId)
+}
+
+func validateLendItemByScan(request *pb.LendItemByScanRequest) []*errdetails.BadRequest_FieldViolation {
+	var fieldViolations []*errdetails.BadRequest_FieldViolation
+
+	if len(request.MemberRfidValue) == 0 {
+		fieldViolations = append(fieldViolations, &errdetails.BadRequest_FieldViolation{
+			Field:       "member_rfid_value",
+			Description: "member_rfid_value field must not be empty",
+			Reason:      "FIELD_EMPTY",
+		})
+	}
+
+	if len(request.GetItemRfidValue()) == 0 && request.GetItemBarcode() == "" {
+		fieldViolations = append(fieldViolations, &errdetails.BadRequest_FieldViolation{
+			Field:       "item_tag",
+			Description: "either item_rfid_value or item_barcode must be set",
+			Reason:      "FIELD_EMPTY",
+		})
+	}
+
+	return append(fieldViolations, validateExpectedReturnTime(request.ExpectedReturnTime)...)
+}
+
+// lend records the loan, if the member has a valid briefing for every briefing type the item requires.
+func (s *Service) lend(
+	ctx context.Context, item *pb.Item, memberID string, expectedReturnTime *timestamppb.Timestamp, terminalID string,
+) (*pb.Loan, error) {
+	missing, err := s.briefingChecker.MissingBriefingTypes(ctx, memberID, item.RequiredBriefingTypes)
+	if err != nil {
+		return nil, status.Internal(err)
+	}
+
+	if len(missing) != 0 {
+		return nil, status.FailedPrecondition("member is missing required briefings")
+	}
+
+	returnTime := time.Now().Add(s.lendingPeriod)
+	if expectedReturnTime != nil {
+		returnTime = expectedReturnTime.AsTime()
+	}
+
+	loan, err := s.repo.CreateLoan(ctx, item.Id, memberID, returnTime, terminalID)
+	if errors.Is(err, ErrAlreadyLent) {
+		return nil, status.FailedPrecondition("item is already lent")
+	}
+
+	if err != nil {
+		return nil, status.Internal(err)
+	}
+
+	return loan, nil
+}
+
+func (s *Service) ReturnItem(ctx context.Context, request *pb.ReturnItemRequest) (*pb.Loan, error) {
+	return s.returnLoan(ctx, request.Id, request.ConditionNotes)
+}
+
+func (s *Service) ReturnItemByScan(ctx context.Context, request *pb.ReturnItemByScanRequest) (*pb.Loan, error) {
+	if len(request.GetItemRfidValue()) == 0 && request.GetItemBarcode() == "" {
+		return nil, status.FieldViolations([]*errdetails.BadRequest_FieldViolation{{
+			Field:       "item_tag",
+			Description: "either item_rfid_value or item_barcode must be set",
+			Reason:      "FIELD_EMPTY",
+		}})
+	}
+
+	item, err := s.repo.GetItemByTag(ctx, request.GetItemRfidValue(), request.GetItemBarcode())
+	if errors.Is(err, ErrNotFound) {
+		return nil, status.NotFound()
+	}
+
+	if err != nil {
+		return nil, status.Internal(err)
+	}
+
+	loan, err := s.repo.GetOpenLoan(ctx, item.Id)
+	if errors.Is(err, ErrNotFound) {
+		return nil, status.FailedPrecondition("item is not lent")
+	}
+
+	if err != nil {
+		return nil, status.Internal(err)
+	}
+
+	return s.returnLoan(ctx, loan.Id, request.ConditionNotes)
+}
+
+func (s *Service) returnLoan(ctx context.Context, id, conditionNotes string) (*pb.Loan, error) {
+	loan, err := s.repo.ReturnLoan(ctx, id, conditionNotes)
+	if errors.Is(err, ErrNotFound) {
+		return nil, status.FailedPrecondition("loan does not exist or was already returned")
+	}
+
+	if err != nil {
+		return nil, status.Internal(err)
+	}
+
+	return loan, nil
+}
+
+func (s *Service) GetLoan(ctx context.Context, request *pb.GetLoanRequest) (*pb.Loan, error) {
+	loan, err := s.repo.GetLoan(ctx, request.Id)
+	if errors.Is(err, ErrNotFound) {
+		return nil, status.NotFound()
+	}
+
+	if err != nil {
+		return nil, status.Internal(err)
+	}
+
+	return loan, nil
+}
+
+func (s *Service) ListLoans(ctx context.Context, request *pb.ListLoansRequest) (*pb.ListLoansResponse, error) {
+	pageTokenBytes, err := base64.RawStdEncoding.DecodeString(request.PageToken)
+	if err != nil {
+		return nil, err
+	}
+
+	pageToken := &pb.LoanPageToken{}
+
+	err = proto.Unmarshal(pageTokenBytes, pageToken)
+	if err != nil {
+		return nil, err
+	}
+
+	filters := &LoanFilters{
+		Open:    request.Open,
+		Overdue: request.Overdue,
+	}
+
+	if request.ItemId != nil {
+		filters.ItemID = *request.ItemId
+	}
+
+	if request.MemberId != nil {
+		filters.MemberID = *request.MemberId
+	}
+
+	pageSize := request.PageSize
+	if pageSize == 0 {
+		pageSize = 50
+	}
+
+	loans, err := s.repo.ListLoans(ctx, pageSize+1, pageToken, filters)
+	if err != nil {
+		return nil, status.Internal(err)
+	}
+
+	var nextPageToken string
+
+	if len(loans) > int(pageSize) {
+		loans = loans[:pageSize]
+
+		nextPageTokenBytes, err := proto.Marshal(&pb.LoanPageToken{
+			LastLendTime: loans[pageSize-1].LendTime,
+			LastId:       loans[pageSize-1].Id,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		nextPageToken = base64.RawStdEncoding.EncodeToString(nextPageTokenBytes)
+	}
+
+	return &pb.ListLoansResponse{
+		Loans:         loans,
+		NextPageToken: nextPageToken,
+	}, nil
+}
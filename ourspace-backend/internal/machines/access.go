@@ -0,0 +1,219 @@
+package machines
+
+import (
+	"context"
+	"database/sql"
+	"encoding/base64"
+	"errors"
+
+	"github.com/google/uuid"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/cfhn/our-space/ourspace-backend/internal/cards"
+	pb "github.com/cfhn/our-space/ourspace-backend/proto"
+	"github.com/cfhn/our-space/pkg/status"
+)
+
+type MemberService interface {
+	GetMember(ctx context.Context, request *pb.GetMemberRequest) (*pb.Member, error)
+}
+
+type BriefingChecker interface {
+	MissingBriefingTypes(ctx context.Context, memberID string, briefingTypes []string) ([]string, error)
+}
+
+type AccessService struct {
+	repo            *Postgres
+	memberService   MemberService
+	cardService     cards.CardLister
+	briefingChecker BriefingChecker
+	pb.UnimplementedAccessServiceServer
+}
+
+func NewAccessService(
+	repo *Postgres, memberService MemberService, cardService cards.CardLister, briefingChecker BriefingChecker,
+) *AccessService {
+	return &AccessService{
+		repo:            repo,
+		memberService:   memberService,
+		cardService:     cardService,
+		briefingChecker: briefingChecker,
+	}
+}
+
+// CheckAccess decides whether the presented card may unlock the machine. The card has to belong to a member with an
+// active membership, who has a valid briefing for every briefing type the machine requires. Denials are not errors,
+// the reason is part of the decision. Every decision is logged.
+func (s *AccessService) CheckAccess(ctx context.Context, request *pb.CheckAccessRequest) (*pb.AccessDecision, error) {
+	fieldViolations := validateCheckAccess(request)
+	if len(fieldViolations) != 0 {
+		return nil, status.FieldViolations(fieldViolations)
+	}
+
+	decision, err := s.decide(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	decision.Id = uuid.New().String()
+	decision.DecisionTime = timestamppb.Now()
+	decision.MachineId = request.MachineId
+	decision.Allowed = decision.Reason == pb.AccessReason_ACCESS_REASON_GRANTED
+
+	err = s.repo.LogDecision(ctx, decision, request.RfidValue)
+	if err != nil {
+		return nil, status.Internal(err)
+	}
+
+	return decision, nil
+}
+
+func validateCheckAccess(request *pb.CheckAccessRequest) []*errdetails.BadRequest_FieldViolation {
+	var fieldViolations []*errdetails.BadRequest_FieldViolation
+
+	if len(request.RfidValue) == 0 {
+		fieldViolations = append(fieldViolations, &errdetails.BadRequest_FieldViolation{
+			Field:       "rfid_value",
+			Description: "rfid_value must not be empty",
+			Reason:      "FIELD_EMPTY",
+		})
+	}
+
+	if request.MachineId == "" {
+		fieldViolations = append(fieldViolations, &errdetails.BadRequest_FieldViolation{
+			Field:       "machine_id",
+			Description: "machine_id must not be empty",
+			Reason:      "FIELD_EMPTY",
+		})
+	}
+
+	return fieldViolations
+}
+
+func (s *AccessService) decide(ctx context.Context, request *pb.CheckAccessRequest) (*pb.AccessDecision, error) {
+	member, err := cards.ResolveActiveMember(ctx, s.cardService, s.memberService, request.RfidValue)
+
+	switch status.FromError(err).Code() {
+	case codes.OK:
+	case codes.NotFound:
+		return &pb.AccessDecision{Reason: pb.AccessReason_ACCESS_REASON_UNKNOWN_CARD}, nil
+	case codes.FailedPrecondition:
+		return &pb.AccessDecision{Reason: pb.AccessReason_ACCESS_REASON_MEMBERSHIP_INACTIVE}, nil
+	default:
+		return nil, err
+	}
+
+	decision := &pb.AccessDecision{MemberId: &member.Id}
+
+	if _, err := uuid.Parse(request.MachineId); err != nil {
+		decision.Reason = pb.AccessReason_ACCESS_REASON_UNKNOWN_MACHINE
+
+		return decision, nil
+	}
+
+	machine, err := s.repo.GetMachine(ctx, request.MachineId)
+	if errors.Is(err, ErrNotFound) {
+		decision.Reason = pb.AccessReason_ACCESS_REASON_UNKNOWN_MACHINE
+
+		return decision, nil
+	}
+
+	if err != nil {
+		return nil, status.Internal(err)
+	}
+
+	missing, err := s.briefingChecker.MissingBriefingTypes(ctx, member.Id, machine.RequiredBriefingTypes)
+	if err != nil {
+		return nil, status.Internal(err)
+	}
+
+	if len(missing) != 0 {
+		decision.Reason = pb.AccessReason_ACCESS_REASON_MISSING_BRIEFING
+		decision.MissingBriefingTypes = missing
+
+		return decision, nil
+	}
+
+	decision.Reason = pb.AccessReason_ACCESS_REASON_GRANTED
+
+	return decision, nil
+}
+
+func (s *AccessService) ListAccessDecisions(
+	ctx context.Context, request *pb.ListAccessDecisionsRequest,
+) (*pb.ListAccessDecisionsResponse, error) {
+	pageToken, err := decodeDecisionPageToken(request.PageToken)
+	if err != nil {
+		return nil, err
+	}
+
+	filters := &DecisionFilters{}
+	if request.MachineId != nil {
+		filters.MachineID = sql.Null[string]{V: *request.MachineId, Valid: true}
+	}
+
+	if request.MemberId != nil {
+		filters.MemberID = sql.Null[string]{V: *request.MemberId, Valid: true}
+	}
+
+	if request.Allowed != nil {
+		filters.Allowed = sql.Null[bool]{V: *request.Allowed, Valid: true}
+	}
+
+	pageSize := request.PageSize
+	if pageSize == 0 {
+		pageSize = 50
+	}
+
+	decisions, err := s.repo.ListDecisions(ctx, pageSize+1, pageToken, filters)
+	if err != nil {
+		return nil, status.Internal(err)
+	}
+
+	var nextPageToken string
+
+	if len(decisions) > int(pageSize) {
+		decisions = decisions[:pageSize]
+
+		nextPageToken, err = encodeDecisionPageToken(decisions[pageSize-1])
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &pb.ListAccessDecisionsResponse{
+		Decisions:     decisions,
+		NextPageToken: nextPageToken,
+	}, nil
+}
+
+func decodeDecisionPageToken(pageToken string) (*pb.AccessDecisionPageToken, error) {
+	pageTokenBytes, err := base64.RawStdEncoding.DecodeString(pageToken)
+	if err != nil {
+		return nil, err
+	}
+
+	token := &pb.AccessDecisionPageToken{}
+
+	err = proto.Unmarshal(pageTokenBytes, token)
+	if err != nil {
+		return nil, err
+	}
+
+	return token, nil
+}
+
+func encodeDecisionPageToken(lastDecision *pb.AccessDecision) (string, error) {
+	pageTokenBytes, err := proto.Marshal(&pb.AccessDecisionPageToken{
+		LastDecisionTime: lastDecision.DecisionTime,
+		LastId:           lastDecision.Id,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return base64.RawStdEncoding.EncodeToString(pageTokenBytes), nil
+}
@@ -0,0 +1,522 @@
+package machines
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgtype"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	pb "github.com/cfhn/our-space/ourspace-backend/proto"
+)
+
+const (
+	uniqueViolation     = "23505"
+	foreignKeyViolation = "23503"
+)
+
+var (
+	ErrNotFound       = errors.New("not found")
+	ErrMachineInUse   = errors.New("machine is already in use")
+	ErrNotRunning     = errors.New("machine has no running usage session")
+	ErrMemberNotFound = errors.New("member not found")
+)
+
+const selectMachine = `
+	select
+		id, name, description, location, required_briefing_types, price_per_minute_cents,
+		coalesce(extract(epoch from session_timeout), 0)::float8
+	from machines
+`
+
+// selectUsageSession calculates the price from every started minute, running sessions are priced up to now.
+const selectUsageSession = `
+	select
+		id, machine_id, member_id, start_time, end_time, auto_stopped, price_per_minute_cents,
+		ceil(extract(epoch from coalesce(end_time, now()) - start_time) / 60)::bigint * price_per_minute_cents
+	from usage_sessions
+`
+
+const selectAccessDecision = `
+	select id, decision_time, machine_id, member_id, allowed, reason, missing_briefing_types
+	from access_decisions
+`
+
+type DecisionFilters struct {
+	MachineID sql.Null[string]
+	MemberID  sql.Null[string]
+	Allowed   sql.Null[bool]
+}
+
+type SessionFilters struct {
+	MachineID   sql.Null[string]
+	MemberID    sql.Null[string]
+	RunningOnly bool
+}
+
+type Postgres struct {
+	db *sql.DB
+}
+
+func NewPostgresRepo(db *sql.DB) *Postgres {
+	return &Postgres{db: db}
+}
+
+func (p *Postgres) CreateMachine(ctx context.Context, machine *pb.Machine) (*pb.Machine, error) {
+	_, err := p.db.ExecContext(ctx, `
+		insert into machines (
+			id, name, description, location, required_briefing_types, price_per_minute_cents, session_timeout
+		)
+		values ($1, $2, $3, $4, $5, $6, $7 * interval '1 second');
+	`,
+		machine.Id, machine.Name, machine.Description, machine.Location, briefingTypes(machine.RequiredBriefingTypes),
+		machine.PricePerMinuteCents, sessionTimeout(machine.SessionTimeout),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return p.GetMachine(ctx, machine.Id)
+}
+
+func (p *Postgres) GetMachine(ctx context.Context, id string) (*pb.Machine, error) {
+	row := p.db.QueryRowContext(ctx, selectMachine+`where id = $1`, id)
+
+	machine, err := scanMachine(row)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrNotFound
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return machine, nil
+}
+
+func (p *Postgres) ListMachines(
+	ctx context.Context, pageSize int32, token *pb.MachinePageToken,
+) ([]*pb.Machine, error) {
+	var (
+		lastName = sql.Null[string]{V: token.LastName, Valid: token.LastId != ""}
+		lastID   = sql.Null[string]{V: token.LastId, Valid: token.LastId != ""}
+	)
+
+	rows, err := p.db.QueryContext(ctx, selectMachine+`
+		where ($3::uuid is null OR (name, id) > ($2, $3))
+		order by name, id
+		limit $1
+	`, pageSize, lastName, lastID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	machines := make([]*pb.Machine, 0, pageSize)
+
+	for rows.Next() {
+		machine, err := scanMachine(rows)
+		if err != nil {
+			return nil, err
+		}
+
+		machines = append(machines, machine)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return machines, nil
+}
+
+func (p *Postgres) UpdateMachine(
+	ctx context.Context, machine *pb.Machine, fieldMask *fieldmaskpb.FieldMask,
+) (*pb.Machine, error) {
+	var (
+		name                  sql.Null[string]
+		description           sql.Null[string]
+		location              sql.Null[string]
+		requiredBriefingTypes sql.Null[pgtype.FlatArray[string]]
+		pricePerMinuteCents   sql.Null[int64]
+		changeSessionTimeout  bool
+	)
+
+	for _, path := range fieldMask.Paths {
+		switch path {
+		case "name":
+			name = sql.Null[string]{V: machine.Name, Valid: true}
+		case "description":
+			description = sql.Null[string]{V: machine.Description, Valid: true}
+		case "location":
+			location = sql.Null[string]{V: machine.Location, Valid: true}
+		case "required_briefing_types":
+			requiredBriefingTypes = sql.Null[pgtype.FlatArray[string]]{
+				V:     briefingTypes(machine.RequiredBriefingTypes),
+				Valid: true,
+			}
+		case "price_per_minute_cents":
+			pricePerMinuteCents = sql.Null[int64]{V: machine.PricePerMinuteCents, Valid: true}
+		case "session_timeout":
+			changeSessionTimeout = true
+		}
+	}
+
+	result, err := p.db.ExecContext(ctx, `
+		update machines
+		set
+			name = coalesce($2, name),
+			description = coalesce($3, description),
+			location = coalesce($4, location),
+			required_briefing_types = coalesce($5, required_briefing_types),
+			price_per_minute_cents = coalesce($6, price_per_minute_cents),
+			session_timeout = case when $7 then $8 * interval '1 second' else session_timeout end
+		where id = $1
+	`,
+		machine.Id, name, description, location, requiredBriefingTypes, pricePerMinuteCents, changeSessionTimeout,
+		sessionTimeout(machine.SessionTimeout),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return nil, err
+	}
+
+	if affected == 0 {
+		return nil, ErrNotFound
+	}
+
+	return p.GetMachine(ctx, machine.Id)
+}
+
+func (p *Postgres) DeleteMachine(ctx context.Context, id string) error {
+	result, err := p.db.ExecContext(ctx, `delete from machines where id = $1`, id)
+	if err != nil {
+		return err
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if affected == 0 {
+		return ErrNotFound
+	}
+
+	return nil
+}
+
+// LogDecision stores the access decision, including the presented card, so that denied checks can be audited later.
+func (p *Postgres) LogDecision(ctx context.Context, decision *pb.AccessDecision, rfidValue []byte) error {
+	_, err := p.db.ExecContext(ctx, `
+		insert into access_decisions (
+			id, decision_time, machine_id, member_id, rfid_value, allowed, reason, missing_briefing_types
+		)
+		values ($1, $2, $3, $4, $5, $6, $7, $8);
+	`,
+		decision.Id, decision.DecisionTime.AsTime(), decision.MachineId, decision.MemberId, rfidValue,
+		decision.Allowed, decision.Reason.String(), briefingTypes(decision.MissingBriefingTypes),
+	)
+
+	return err
+}
+
+// ListDecisions returns the logged access decisions, newest first.
+func (p *Postgres) ListDecisions(
+	ctx context.Context, pageSize int32, token *pb.AccessDecisionPageToken, filters *DecisionFilters,
+) ([]*pb.AccessDecision, error) {
+	var (
+		lastDecisionTime = sql.Null[time.Time]{V: token.LastDecisionTime.AsTime(), Valid: token.LastId != ""}
+		lastID           = sql.Null[string]{V: token.LastId, Valid: token.LastId != ""}
+	)
+
+	rows, err := p.db.QueryContext(ctx, selectAccessDecision+`
+		where ($3::uuid is null OR (decision_time, id) < ($2, $3))
+		and ($4::text is null OR machine_id = $4)
+		and ($5::uuid is null OR member_id = $5)
+		and ($6::boolean is null OR allowed = $6)
+		order by decision_time desc, id desc
+		limit $1
+	`, pageSize, lastDecisionTime, lastID, filters.MachineID, filters.MemberID, filters.Allowed)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	decisions := make([]*pb.AccessDecision, 0, pageSize)
+
+	for rows.Next() {
+		decision, err := scanAccessDecision(rows)
+		if err != nil {
+			return nil, err
+		}
+
+		decisions = append(decisions, decision)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return decisions, nil
+}
+
+// StartSession starts a usage session of the machine. The price and session timeout of the machine are recorded with
+// the session, defaultTimeout is used for machines without their own timeout.
+func (p *Postgres) StartSession(
+	ctx context.Context, session *pb.UsageSession, defaultTimeout time.Duration,
+) (*pb.UsageSession, error) {
+	result, err := p.db.ExecContext(ctx, `
+		insert into usage_sessions (
+			id, machine_id, member_id, start_time, price_per_minute_cents, session_timeout
+		)
+		select
+			$1::uuid, id, $3::uuid, $4::timestamptz, price_per_minute_cents,
+			coalesce(session_timeout, $5::float8 * interval '1 second')
+		from machines
+		where id = $2
+	`, session.Id, session.MachineId, session.MemberId, session.StartTime.AsTime(), defaultTimeout.Seconds())
+	if err != nil {
+		return nil, mapSessionError(err)
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return nil, err
+	}
+
+	if affected == 0 {
+		return nil, ErrNotFound
+	}
+
+	return p.GetSession(ctx, session.Id)
+}
+
+// StopSession stops the running usage session of the machine. Sessions that already exceeded their timeout end at
+// the timeout, as if the timeout job had already stopped them.
+func (p *Postgres) StopSession(ctx context.Context, machineID string) (*pb.UsageSession, error) {
+	var id string
+
+	err := p.db.QueryRowContext(ctx, `
+		update usage_sessions
+		set
+			end_time = least(now(), start_time + session_timeout),
+			auto_stopped = start_time + session_timeout < now()
+		where machine_id = $1 and end_time is null
+		returning id
+	`, machineID).Scan(&id)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrNotRunning
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return p.GetSession(ctx, id)
+}
+
+// StopTimedOutSessions ends all running sessions that exceeded their session timeout. It is meant to be run
+// periodically as a job.
+func (p *Postgres) StopTimedOutSessions(ctx context.Context) error {
+	_, err := p.db.ExecContext(ctx, `
+		update usage_sessions
+		set end_time = start_time + session_timeout, auto_stopped = true
+		where end_time is null and start_time + session_timeout <= now()
+	`)
+
+	return err
+}
+
+func (p *Postgres) GetSession(ctx context.Context, id string) (*pb.UsageSession, error) {
+	row := p.db.QueryRowContext(ctx, selectUsageSession+`where id = $1`, id)
+
+	session, err := scanUsageSession(row)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrNotFound
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return session, nil
+}
+
+// ListSessions returns usage sessions, the most recently started first.
+func (p *Postgres) ListSessions(
+	ctx context.Context, pageSize int32, token *pb.UsageSessionPageToken, filters *SessionFilters,
+) ([]*pb.UsageSession, error) {
+	var (
+		lastStartTime = sql.Null[time.Time]{V: token.LastStartTime.AsTime(), Valid: token.LastId != ""}
+		lastID        = sql.Null[string]{V: token.LastId, Valid: token.LastId != ""}
+	)
+
+	rows, err := p.db.QueryContext(ctx, selectUsageSession+`
+		where ($3::uuid is null OR (start_time, id) < ($2, $3))
+		and ($4::uuid is null OR machine_id = $4)
+		and ($5::uuid is null OR member_id = $5)
+		and ($6 is false OR end_time is null)
+		order by start_time desc, id desc
+		limit $1
+	`, pageSize, lastStartTime, lastID, filters.MachineID, filters.MemberID, filters.RunningOnly)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	sessions := make([]*pb.UsageSession, 0, pageSize)
+
+	for rows.Next() {
+		session, err := scanUsageSession(rows)
+		if err != nil {
+			return nil, err
+		}
+
+		sessions = append(sessions, session)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return sessions, nil
+}
+
+func mapSessionError(err error) error {
+	var pgErr *pgconn.PgError
+	if !errors.As(err, &pgErr) {
+		return err
+	}
+
+	switch pgErr.Code {
+	case uniqueViolation:
+		return ErrMachineInUse
+	case foreignKeyViolation:
+		return ErrMemberNotFound
+	default:
+		return err
+	}
+}
+
+type scanner interface {
+	Scan(values ...any) error
+}
+
+func scanMachine(in scanner) (*pb.Machine, error) {
+	var (
+		machine               = &pb.Machine{}
+		requiredBriefingTypes pgtype.FlatArray[string]
+		sessionTimeoutSeconds float64
+	)
+
+	err := in.Scan(
+		&machine.Id,
+		&machine.Name,
+		&machine.Description,
+		&machine.Location,
+		&requiredBriefingTypes,
+		&machine.PricePerMinuteCents,
+		&sessionTimeoutSeconds,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	machine.RequiredBriefingTypes = requiredBriefingTypes
+
+	if sessionTimeoutSeconds > 0 {
+		machine.SessionTimeout = durationpb.New(time.Duration(sessionTimeoutSeconds * float64(time.Second)))
+	}
+
+	return machine, nil
+}
+
+func scanAccessDecision(in scanner) (*pb.AccessDecision, error) {
+	var (
+		decision             = &pb.AccessDecision{}
+		decisionTime         time.Time
+		memberID             sql.Null[string]
+		reason               string
+		missingBriefingTypes pgtype.FlatArray[string]
+	)
+
+	err := in.Scan(
+		&decision.Id,
+		&decisionTime,
+		&decision.MachineId,
+		&memberID,
+		&decision.Allowed,
+		&reason,
+		&missingBriefingTypes,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	decision.DecisionTime = timestamppb.New(decisionTime)
+	decision.Reason = pb.AccessReason(pb.AccessReason_value[reason])
+	decision.MissingBriefingTypes = missingBriefingTypes
+
+	if memberID.Valid {
+		decision.MemberId = &memberID.V
+	}
+
+	return decision, nil
+}
+
+func scanUsageSession(in scanner) (*pb.UsageSession, error) {
+	var (
+		session   = &pb.UsageSession{}
+		startTime time.Time
+		endTime   sql.Null[time.Time]
+	)
+
+	err := in.Scan(
+		&session.Id,
+		&session.MachineId,
+		&session.MemberId,
+		&startTime,
+		&endTime,
+		&session.AutoStopped,
+		&session.PricePerMinuteCents,
+		&session.PriceCents,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	session.StartTime = timestamppb.New(startTime)
+
+	if endTime.Valid {
+		session.EndTime = timestamppb.New(endTime.V)
+	}
+
+	return session, nil
+}
+
+// sessionTimeout converts the duration to seconds, machines without their own timeout are stored as null.
+func sessionTimeout(duration *durationpb.Duration) sql.Null[float64] {
+	if duration.AsDuration() <= 0 {
+		return sql.Null[float64]{}
+	}
+
+	return sql.Null[float64]{V: duration.AsDuration().Seconds(), Valid: true}
+}
+
+func briefingTypes(briefingTypes []string) pgtype.FlatArray[string] {
+	if briefingTypes == nil {
+		return pgtype.FlatArray[string]{}
+	}
+
+	return briefingTypes
+}
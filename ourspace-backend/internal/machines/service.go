@@ -0,0 +1,305 @@
+package machines
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/cfhn/our-space/ourspace-backend/internal/cards"
+	pb "github.com/cfhn/our-space/ourspace-backend/proto"
+	"github.com/cfhn/our-space/pkg/status"
+)
+
+type Service struct {
+	repo            *Postgres
+	memberService   MemberService
+	cardService     cards.CardLister
+	briefingChecker BriefingChecker
+	sessionTimeout  time.Duration
+	pb.UnimplementedMachineServiceServer
+}
+
+// NewService creates the machine service. The session timeout is used for usage sessions of machines without their
+// own timeout.
+func NewService(
+	repo *Postgres, memberService MemberService, cardService cards.CardLister, briefingChecker BriefingChecker,
+	sessionTimeout time.Duration,
+) *Service {
+	return &Service{
+		repo:            repo,
+		memberService:   memberService,
+		cardService:     cardService,
+		briefingChecker: briefingChecker,
+		sessionTimeout:  sessionTimeout,
+	}
+}
+
+func (s *Service) CreateMachine(ctx context.Context, request *pb.CreateMachineRequest) (*pb.Machine, error) {
+	fieldViolations := validateCreateMachine(request)
+	if len(fieldViolations) != 0 {
+		return nil, status.FieldViolations(fieldViolations)
+	}
+
+	if request.MachineId != "" {
+		request.Machine.Id = request.MachineId
+	} else {
+		request.Machine.Id = uuid.New().String()
+	}
+
+	machine, err := s.repo.CreateMachine(ctx, request.Machine)
+	if err != nil {
+		return nil, status.Internal(err)
+	}
+
+	return machine, nil
+}
+
+func validateCreateMachine(request *pb.CreateMachineRequest) []*errdetails.BadRequest_FieldViolation {
+	if request.Machine == nil {
+		return []*errdetails.BadRequest_FieldViolation{{
+			Field:       "machine",
+			Description: "machine field must not be empty",
+			Reason:      "FIELD_EMPTY",
+		}}
+	}
+
+	var fieldViolations []*errdetails.BadRequest_FieldViolation
+
+	if request.MachineId != "" {
+		if _, err := uuid.Parse(request.MachineId); err != nil {
+			fieldViolations = append(fieldViolations, &errdetails.BadRequest_FieldViolation{
+				Field:       "machine_id",
+				Description: "machine_id must be a valid UUID",
+				Reason:      "FIELD_INVALID",
+			})
+		}
+	}
+
+	fieldViolations = append(fieldViolations, validateName(request.Machine.Name)...)
+	fieldViolations = append(fieldViolations, validateBriefingTypes(request.Machine.RequiredBriefingTypes)...)
+	fieldViolations = append(fieldViolations, validatePricePerMinute(request.Machine.PricePerMinuteCents)...)
+	fieldViolations = append(fieldViolations, validateSessionTimeout(request.Machine)...)
+
+	return fieldViolations
+}
+
+func validateName(name string) []*errdetails.BadRequest_FieldViolation {
+	if name == "" {
+		return []*errdetails.BadRequest_FieldViolation{{
+			Field:       "machine.name",
+			Description: "name must not be empty",
+			Reason:      "FIELD_EMPTY",
+		}}
+	}
+
+	if len(name) > 256 {
+		return []*errdetails.BadRequest_FieldViolation{{
+			Field:       "machine.name",
+			Description: "name must be shorter than 256 characters, use description for longer texts",
+			Reason:      "FIELD_TOO_LARGE",
+		}}
+	}
+
+	return nil
+}
+
+func validateBriefingTypes(briefingTypes []string) []*errdetails.BadRequest_FieldViolation {
+	for _, briefingType := range briefingTypes {
+		if _, err := uuid.Parse(briefingType); err != nil {
+			return []*errdetails.BadRequest_FieldViolation{{
+				Field:       "machine.required_briefing_types",
+				Description: "required_briefing_types must only contain valid briefing type IDs",
+				Reason:      "FIELD_INVALID",
+			}}
+		}
+	}
+
+	return nil
+}
+
+func validatePricePerMinute(pricePerMinuteCents int64) []*errdetails.BadRequest_FieldViolation {
+	if pricePerMinuteCents < 0 {
+		return []*errdetails.BadRequest_FieldViolation{{
+			Field:       "machine.price_per_minute_cents",
+			Description: "price_per_minute_cents must not be negative",
+			Reason:      "FIELD_INVALID",
+		}}
+	}
+
+	return nil
+}
+
+func validateSessionTimeout(machine *pb.Machine) []*errdetails.BadRequest_FieldViolation {
+	if machine.SessionTimeout.AsDuration() < 0 {
+		return []*errdetails.BadRequest_FieldViolation{{
+			Field:       "machine.session_timeout",
+			Description: "session_timeout must not be negative, leave it empty to use the default timeout",
+			Reason:      "FIELD_INVALID",
+		}}
+	}
+
+	return nil
+}
+
+func (s *Service) GetMachine(ctx context.Context, request *pb.GetMachineRequest) (*pb.Machine, error) {
+	if _, err := uuid.Parse(request.Id); err != nil {
+		return nil, status.NotFound()
+	}
+
+	machine, err := s.repo.GetMachine(ctx, request.Id)
+	if errors.Is(err, ErrNotFound) {
+		return nil, status.NotFound()
+	}
+
+	if err != nil {
+		return nil, status.Internal(err)
+	}
+
+	return machine, nil
+}
+
+func (s *Service) ListMachines(
+	ctx context.Context, request *pb.ListMachinesRequest,
+) (*pb.ListMachinesResponse, error) {
+	pageToken, err := decodePageToken(request.PageToken)
+	if err != nil {
+		return nil, err
+	}
+
+	pageSize := request.PageSize
+	if pageSize == 0 {
+		pageSize = 50
+	}
+
+	machines, err := s.repo.ListMachines(ctx, pageSize+1, pageToken)
+	if err != nil {
+		return nil, status.Internal(err)
+	}
+
+	var nextPageToken string
+
+	if len(machines) > int(pageSize) {
+		machines = machines[:pageSize]
+
+		nextPageToken, err = encodePageToken(machines[pageSize-1])
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &pb.ListMachinesResponse{
+		Machines:      machines,
+		NextPageToken: nextPageToken,
+	}, nil
+}
+
+func (s *Service) UpdateMachine(ctx context.Context, request *pb.UpdateMachineRequest) (*pb.Machine, error) {
+	fieldViolations := validateUpdateMachine(request)
+	if len(fieldViolations) != 0 {
+		return nil, status.FieldViolations(fieldViolations)
+	}
+
+	machine, err := s.repo.UpdateMachine(ctx, request.Machine, request.FieldMask)
+	if errors.Is(err, ErrNotFound) {
+		return nil, status.NotFound()
+	}
+
+	if err != nil {
+		return nil, status.Internal(err)
+	}
+
+	return machine, nil
+}
+
+func validateUpdateMachine(request *pb.UpdateMachineRequest) []*errdetails.BadRequest_FieldViolation {
+	if request.Machine == nil {
+		return []*errdetails.BadRequest_FieldViolation{{
+			Field:       "machine",
+			Description: "machine field must not be empty",
+			Reason:      "FIELD_EMPTY",
+		}}
+	}
+
+	if !request.FieldMask.IsValid(&pb.Machine{}) {
+		return []*errdetails.BadRequest_FieldViolation{{
+			Field:       "field_mask",
+			Description: "invalid field_mask",
+			Reason:      "FIELD_INVALID",
+		}}
+	}
+
+	fieldViolations := make([]*errdetails.BadRequest_FieldViolation, 0)
+
+	for _, path := range request.FieldMask.Paths {
+		switch path {
+		case "name":
+			fieldViolations = append(fieldViolations, validateName(request.Machine.Name)...)
+		case "required_briefing_types":
+			fieldViolations = append(fieldViolations, validateBriefingTypes(request.Machine.RequiredBriefingTypes)...)
+		case "price_per_minute_cents":
+			fieldViolations = append(fieldViolations, validatePricePerMinute(request.Machine.PricePerMinuteCents)...)
+		case "session_timeout":
+			fieldViolations = append(fieldViolations, validateSessionTimeout(request.Machine)...)
+		case "description", "location":
+		default:
+			fieldViolations = append(fieldViolations, &errdetails.BadRequest_FieldViolation{
+				Field:       "field_mask",
+				Description: path + " can not be updated",
+				Reason:      "FIELD_INVALID",
+			})
+		}
+	}
+
+	return fieldViolations
+}
+
+func (s *Service) DeleteMachine(ctx context.Context, request *pb.DeleteMachineRequest) (*emptypb.Empty, error) {
+	if _, err := uuid.Parse(request.Id); err != nil {
+		return nil, status.NotFound()
+	}
+
+	err := s.repo.DeleteMachine(ctx, request.Id)
+	if errors.Is(err, ErrNotFound) {
+		return nil, status.NotFound()
+	}
+
+	if err != nil {
+		return nil, status.Internal(err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func decodePageToken(pageToken string) (*pb.MachinePageToken, error) {
+	pageTokenBytes, err := base64.RawStdEncoding.DecodeString(pageToken)
+	if err != nil {
+		return nil, err
+	}
+
+	token := &pb.MachinePageToken{}
+
+	err = proto.Unmarshal(pageTokenBytes, token)
+	if err != nil {
+		return nil, err
+	}
+
+	return token, nil
+}
+
+func encodePageToken(lastMachine *pb.Machine) (string, error) {
+	pageTokenBytes, err := proto.Marshal(&pb.MachinePageToken{
+		LastName: lastMachine.Name,
+		LastId:   lastMachine.Id,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return base64.RawStdEncoding.EncodeToString(pageTokenBytes), nil
+}
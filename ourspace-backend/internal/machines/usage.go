@@ -0,0 +1,259 @@
+package machines
+
+import (
+	"context"
+	"database/sql"
+	"encoding/base64"
+	"errors"
+
+	"github.com/google/uuid"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/cfhn/our-space/ourspace-backend/internal/cards"
+	pb "github.com/cfhn/our-space/ourspace-backend/proto"
+	"github.com/cfhn/our-space/pkg/status"
+)
+
+// StartUsageSession starts using a machine. Members identified by card need an active membership, all members need
+// a valid briefing for every briefing type the machine requires.
+func (s *Service) StartUsageSession(
+	ctx context.Context, request *pb.StartUsageSessionRequest,
+) (*pb.UsageSession, error) {
+	fieldViolations := validateStartUsageSession(request)
+	if len(fieldViolations) != 0 {
+		return nil, status.FieldViolations(fieldViolations)
+	}
+
+	machine, err := s.GetMachine(ctx, &pb.GetMachineRequest{Id: request.MachineId})
+	if err != nil {
+		return nil, err
+	}
+
+	memberID, err := s.resolveMember(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	missing, err := s.briefingChecker.MissingBriefingTypes(ctx, memberID, machine.RequiredBriefingTypes)
+	if err != nil {
+		return nil, status.Internal(err)
+	}
+
+	if len(missing) != 0 {
+		return nil, status.FailedPrecondition("member is missing required briefings")
+	}
+
+	session, err := s.repo.StartSession(ctx, &pb.UsageSession{
+		Id:        uuid.New().String(),
+		MachineId: machine.Id,
+		MemberId:  memberID,
+		StartTime: timestamppb.Now(),
+	}, s.sessionTimeout)
+
+	switch {
+	case errors.Is(err, ErrNotFound):
+		return nil, status.NotFound()
+	case errors.Is(err, ErrMachineInUse):
+		return nil, status.FailedPrecondition("machine is already in use")
+	case errors.Is(err, ErrMemberNotFound):
+		return nil, status.FieldViolations(memberNotFoundViolations())
+	case err != nil:
+		return nil, status.Internal(err)
+	}
+
+	return session, nil
+}
+
+func validateStartUsageSession(request *pb.StartUsageSessionRequest) []*errdetails.BadRequest_FieldViolation {
+	var fieldViolations []*errdetails.BadRequest_FieldViolation
+
+	if request.MachineId == "" {
+		fieldViolations = append(fieldViolations, &errdetails.BadRequest_FieldViolation{
+			Field:       "machine_id",
+			Description: "machine_id must not be empty",
+			Reason:      "FIELD_EMPTY",
+		})
+	}
+
+	switch member := request.Member.(type) {
+	case *pb.StartUsageSessionRequest_MemberId:
+		if _, err := uuid.Parse(member.MemberId); err != nil {
+			fieldViolations = append(fieldViolations, &errdetails.BadRequest_FieldViolation{
+				Field:       "member_id",
+				Description: "member_id must be a valid UUID",
+				Reason:      "FIELD_INVALID",
+			})
+		}
+	case *pb.StartUsageSessionRequest_RfidValue:
+		if len(member.RfidValue) == 0 {
+			fieldViolations = append(fieldViolations, &errdetails.BadRequest_FieldViolation{
+				Field:       "rfid_value",
+				Description: "rfid_value must not be empty",
+				Reason:      "FIELD_EMPTY",
+			})
+		}
+	default:
+		fieldViolations = append(fieldViolations, &errdetails.BadRequest_FieldViolation{
+			Field:       "member",
+			Description: "either member_id or rfid_value must be set",
+			Reason:      "FIELD_EMPTY",
+		})
+	}
+
+	return fieldViolations
+}
+
+func (s *Service) resolveMember(ctx context.Context, request *pb.StartUsageSessionRequest) (string, error) {
+	if len(request.GetRfidValue()) != 0 {
+		member, err := cards.ResolveActiveMember(ctx, s.cardService, s.memberService, request.GetRfidValue())
+		if err != nil {
+			return "", err
+		}
+
+		return member.Id, nil
+	}
+
+	member, err := s.memberService.GetMember(ctx, &pb.GetMemberRequest{Id: request.GetMemberId()})
+	if status.FromError(err).Code() == codes.NotFound {
+		return "", status.FieldViolations(memberNotFoundViolations())
+	}
+
+	if err != nil {
+		return "", err
+	}
+
+	return member.Id, nil
+}
+
+func memberNotFoundViolations() []*errdetails.BadRequest_FieldViolation {
+	return []*errdetails.BadRequest_FieldViolation{{
+		Field:       "member_id",
+		Description: "member does not exist",
+		Reason:      "FIELD_INVALID",
+	}}
+}
+
+func (s *Service) StopUsageSession(
+	ctx context.Context, request *pb.StopUsageSessionRequest,
+) (*pb.UsageSession, error) {
+	if _, err := uuid.Parse(request.MachineId); err != nil {
+		return nil, status.NotFound()
+	}
+
+	session, err := s.repo.StopSession(ctx, request.MachineId)
+	if errors.Is(err, ErrNotRunning) {
+		return nil, status.FailedPrecondition("machine is not in use")
+	}
+
+	if err != nil {
+		return nil, status.Internal(err)
+	}
+
+	return session, nil
+}
+
+func (s *Service) ListUsageSessions(
+	ctx context.Context, request *pb.ListUsageSessionsRequest,
+) (*pb.ListUsageSessionsResponse, error) {
+	fieldViolations := validateListUsageSessions(request)
+	if len(fieldViolations) != 0 {
+		return nil, status.FieldViolations(fieldViolations)
+	}
+
+	pageToken, err := decodeSessionPageToken(request.PageToken)
+	if err != nil {
+		return nil, err
+	}
+
+	filters := &SessionFilters{RunningOnly: request.RunningOnly}
+	if request.MachineId != nil {
+		filters.MachineID = sql.Null[string]{V: *request.MachineId, Valid: true}
+	}
+
+	if request.MemberId != nil {
+		filters.MemberID = sql.Null[string]{V: *request.MemberId, Valid: true}
+	}
+
+	pageSize := request.PageSize
+	if pageSize == 0 {
+		pageSize = 50
+	}
+
+	sessions, err := s.repo.ListSessions(ctx, pageSize+1, pageToken, filters)
+	if err != nil {
+		return nil, status.Internal(err)
+	}
+
+	var nextPageToken string
+
+	if len(sessions) > int(pageSize) {
+		sessions = sessions[:pageSize]
+
+		nextPageToken, err = encodeSessionPageToken(sessions[pageSize-1])
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &pb.ListUsageSessionsResponse{
+		Sessions:      sessions,
+		NextPageToken: nextPageToken,
+	}, nil
+}
+
+func validateListUsageSessions(request *pb.ListUsageSessionsRequest) []*errdetails.BadRequest_FieldViolation {
+	var fieldViolations []*errdetails.BadRequest_FieldViolation
+
+	if request.MachineId != nil {
+		if _, err := uuid.Parse(*request.MachineId); err != nil {
+			fieldViolations = append(fieldViolations, &errdetails.BadRequest_FieldViolation{
+				Field:       "machine_id",
+				Description: "machine_id must be a valid UUID",
+				Reason:      "FIELD_INVALID",
+			})
+		}
+	}
+
+	if request.MemberId != nil {
+		if _, err := uuid.Parse(*request.MemberId); err != nil {
+			fieldViolations = append(fieldViolations, &errdetails.BadRequest_FieldViolation{
+				Field:       "member_id",
+				Description: "member_id must be a valid UUID",
+				Reason:      "FIELD_INVALID",
+			})
+		}
+	}
+
+	return fieldViolations
+}
+
+func decodeSessionPageToken(pageToken string) (*pb.UsageSessionPageToken, error) {
+	pageTokenBytes, err := base64.RawStdEncoding.DecodeString(pageToken)
+	if err != nil {
+		return nil, err
+	}
+
+	token := &pb.UsageSessionPageToken{}
+
+	err = proto.Unmarshal(pageTokenBytes, token)
+	if err != nil {
+		return nil, err
+	}
+
+	return token, nil
+}
+
+func encodeSessionPageToken(lastSession *pb.UsageSession) (string, error) {
+	pageTokenBytes, err := proto.Marshal(&pb.UsageSessionPageToken{
+		LastStartTime: lastSession.StartTime,
+		LastId:        lastSession.Id,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return base64.RawStdEncoding.EncodeToString(pageTokenBytes), nil
+}
@@ -0,0 +1,191 @@
+package members
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+
+	pb "github.com/cfhn/our-space/ourspace-backend/proto"
+	"github.com/cfhn/our-space/pkg/setup"
+)
+
+const (
+	// adminRole can read and write all attributes and change the roles of logins.
+	adminRole = "admin"
+	// approverRole can list, approve and reject change requests of members.
+	approverRole = "approver"
+)
+
+//nolint:gochecknoglobals // constant lookup slice
+var validSensitivities = []pb.MemberAttribute_Sensitivity{
+	pb.MemberAttribute_SENSITIVITY_PUBLIC,
+	pb.MemberAttribute_SENSITIVITY_INTERNAL,
+	pb.MemberAttribute_SENSITIVITY_RESTRICTED,
+}
+
+// accessor is the caller of a request, it decides which attributes can be read and written.
+type accessor struct {
+	claims *setup.AccessTokenClaims
+}
+
+// accessorFromContext returns the caller of the request. Requests without claims are calls from within the backend and
+// have full access.
+func accessorFromContext(ctx context.Context) accessor {
+	claims, _ := setup.GetAccessTokenClaims(ctx)
+
+	return accessor{claims: claims}
+}
+
+func (a accessor) isAdmin() bool {
+	return a.claims == nil || (!a.claims.APIKey && slices.Contains(a.claims.Roles, adminRole))
+}
+
+func (a accessor) canDecideChangeRequests() bool {
+	return a.isAdmin() || (!a.claims.APIKey && slices.Contains(a.claims.Roles, approverRole))
+}
+
+// requestedChange reports whether the caller requested the change request, they can't approve it themselves.
+func (a accessor) requestedChange(changeRequest *pb.MemberChangeRequest) bool {
+	return a.claims != nil && changeRequest.RequestedBy == a.claims.Subject
+}
+
+func (a accessor) hasAnyRole(roles []string) bool {
+	return slices.ContainsFunc(roles, func(role string) bool { return slices.Contains(a.claims.Roles, role) })
+}
+
+// canRead reports whether the caller can read values of the attribute. API keys are used by terminals, they only get
+// public attributes.
+func (a accessor) canRead(attribute *pb.MemberAttribute) bool {
+	switch {
+	case a.isAdmin():
+		return true
+	case a.claims.APIKey:
+		return isPublicAttribute(attribute)
+	case attribute.Sensitivity == pb.MemberAttribute_SENSITIVITY_RESTRICTED:
+		return a.hasAnyRole(attribute.ReadRoles) || a.hasAnyRole(attribute.WriteRoles)
+	default:
+		return true
+	}
+}
+
+func (a accessor) canWrite(attribute *pb.MemberAttribute) bool {
+	switch {
+	case a.isAdmin():
+		return true
+	case a.claims.APIKey:
+		return isPublicAttribute(attribute)
+	case attribute.Sensitivity == pb.MemberAttribute_SENSITIVITY_RESTRICTED:
+		return a.hasAnyRole(attribute.WriteRoles)
+	default:
+		return true
+	}
+}
+
+func isPublicAttribute(attribute *pb.MemberAttribute) bool {
+	return attribute.Sensitivity == pb.MemberAttribute_SENSITIVITY_UNKNOWN ||
+		attribute.Sensitivity == pb.MemberAttribute_SENSITIVITY_PUBLIC
+}
+
+// readableAttributes returns the attributes the caller can read, the others are treated as if they didn't exist.
+func (a accessor) readableAttributes(attributes map[string]*pb.MemberAttribute) map[string]*pb.MemberAttribute {
+	if a.isAdmin() {
+		return attributes
+	}
+
+	readable := make(map[string]*pb.MemberAttribute, len(attributes))
+
+	for name, attribute := range attributes {
+		if a.canRead(attribute) {
+			readable[name] = attribute
+		}
+	}
+
+	return readable
+}
+
+// stripUnreadable removes the values the caller can't read from the members, including the birth date. Values of
+// deleted attributes are kept for users, but never sent to API keys.
+func (a accessor) stripUnreadable(attributes map[string]*pb.MemberAttribute, members ...*pb.Member) {
+	if a.isAdmin() {
+		return
+	}
+
+	for _, member := range members {
+		// the birth date is sensitive, only admins and the member themselves can read it
+		if a.claims.APIKey || member.GetMemberLogin().GetUsername() != a.claims.Subject {
+			member.BirthDate = nil
+		}
+
+		maps.DeleteFunc(member.AdditionalAttributes, func(name, _ string) bool {
+			attribute, ok := attributes[name]
+			if !ok {
+				return a.claims.APIKey
+			}
+
+			return !a.canRead(attribute)
+		})
+	}
+}
+
+// canWriteAttributes reports whether the caller can write all named attributes. Unknown attributes are left to the
+// validation.
+func (a accessor) canWriteAttributes(attributes map[string]*pb.MemberAttribute, names []string) bool {
+	return !slices.ContainsFunc(names, func(name string) bool {
+		attribute, ok := attributes[name]
+
+		return ok && !a.canWrite(attribute)
+	})
+}
+
+// stripUnreadableAttributes removes the values the caller of the request can't read from the members.
+func (s Service) stripUnreadableAttributes(ctx context.Context, members ...*pb.Member) error {
+	access := accessorFromContext(ctx)
+	if access.isAdmin() {
+		return nil
+	}
+
+	memberAttributes, err := s.listAllMemberAttributes(ctx)
+	if err != nil {
+		return err
+	}
+
+	access.stripUnreadable(memberAttributes, members...)
+
+	return nil
+}
+
+func validateRoles(field string, roles []string) []*errdetails.BadRequest_FieldViolation {
+	var fieldViolations []*errdetails.BadRequest_FieldViolation
+
+	for i, role := range roles {
+		if !validTechnicalName.MatchString(role) {
+			fieldViolations = append(fieldViolations, &errdetails.BadRequest_FieldViolation{
+				Field:       fmt.Sprintf("%s[%d]", field, i),
+				Description: "role must be lower_camel_case and not start or end with an underscore",
+				Reason:      "FIELD_INVALID",
+			})
+		}
+	}
+
+	return fieldViolations
+}
+
+func validateAttributeSensitivity(attribute *pb.MemberAttribute) []*errdetails.BadRequest_FieldViolation {
+	var fieldViolations []*errdetails.BadRequest_FieldViolation
+
+	if !slices.Contains(validSensitivities, attribute.Sensitivity) {
+		fieldViolations = append(fieldViolations, &errdetails.BadRequest_FieldViolation{
+			Field:       "attribute.sensitivity",
+			Description: "must be a supported sensitivity",
+			Reason:      "FIELD_INVALID",
+		})
+	}
+
+	fieldViolations = append(fieldViolations, validateRoles("attribute.read_roles", attribute.ReadRoles)...)
+	fieldViolations = append(fieldViolations, validateRoles("attribute.write_roles", attribute.WriteRoles)...)
+
+	return fieldViolations
+}
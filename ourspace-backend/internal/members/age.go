@@ -0,0 +1,117 @@
+package members
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+
+	pb "github.com/cfhn/our-space/ourspace-backend/proto"
+)
+
+// adultAge is the age in years at which members become adults.
+const adultAge = 18
+
+// adultBirthDateLimit returns the latest birth date of adults on the given day. Members born on February 29th become
+// adults on March 1st in years that are not leap years.
+func adultBirthDateLimit(today time.Time) time.Time {
+	limit := time.Date(today.Year()-adultAge, today.Month(), today.Day(), 0, 0, 0, 0, time.UTC)
+	if limit.Month() != today.Month() {
+		// today is February 29th, but the limit year has no such day
+		limit = limit.AddDate(0, 0, -limit.Day())
+	}
+
+	return limit
+}
+
+// ageCategoryOn derives the age category from the birth date on the given day.
+func ageCategoryOn(birthDate, today time.Time) pb.AgeCategory {
+	if birthDate.After(adultBirthDateLimit(today)) {
+		return pb.AgeCategory_AGE_CATEGORY_UNDERAGE
+	}
+
+	return pb.AgeCategory_AGE_CATEGORY_ADULT
+}
+
+// deriveAgeCategory sets the age category of the member from its birth date, if the date is known and valid.
+func deriveAgeCategory(member *pb.Member) {
+	if member.BirthDate == nil {
+		return
+	}
+
+	birthDate, err := time.Parse(time.DateOnly, *member.BirthDate)
+	if err != nil {
+		return
+	}
+
+	member.AgeCategory = ageCategoryOn(birthDate, time.Now())
+}
+
+func validateBirthDate(birthDate *string) []*errdetails.BadRequest_FieldViolation {
+	if birthDate == nil {
+		return nil
+	}
+
+	date, err := time.Parse(time.DateOnly, *birthDate)
+
+	switch {
+	case err != nil:
+		return []*errdetails.BadRequest_FieldViolation{{
+			Field:       "member.birth_date",
+			Description: "birth_date must be a date (YYYY-MM-DD)",
+			Reason:      "FIELD_INVALID",
+		}}
+	case date.Year() < 1900:
+		return []*errdetails.BadRequest_FieldViolation{{
+			Field:       "member.birth_date",
+			Description: "birth_date must be after the year 1900",
+			Reason:      "FIELD_INVALID",
+		}}
+	case date.After(time.Now()):
+		return []*errdetails.BadRequest_FieldViolation{{
+			Field:       "member.birth_date",
+			Description: "birth_date must not be in the future",
+			Reason:      "FIELD_INVALID",
+		}}
+	default:
+		return nil
+	}
+}
+
+// AgeCategoryUpdate derives the age category of all members with a known birth date and reports members who became
+// adults, as underage rules decide which machines members may use. It is meant to be run daily as a job.
+type AgeCategoryUpdate struct {
+	repo   *Postgres
+	logger *slog.Logger
+}
+
+func NewAgeCategoryUpdate(repo *Postgres, logger *slog.Logger) *AgeCategoryUpdate {
+	return &AgeCategoryUpdate{repo: repo, logger: logger}
+}
+
+func (a *AgeCategoryUpdate) Run(ctx context.Context) error {
+	changed, err := a.repo.UpdateAgeCategories(ctx, adultBirthDateLimit(time.Now()))
+	if err != nil {
+		return err
+	}
+
+	for _, member := range changed {
+		if member.AgeCategory == pb.AgeCategory_AGE_CATEGORY_ADULT {
+			a.logger.InfoContext(ctx, "member became adult",
+				slog.String("member_id", member.Id),
+				slog.String("name", member.Name),
+				slog.String("birth_date", member.GetBirthDate()),
+			)
+
+			continue
+		}
+
+		a.logger.InfoContext(ctx, "updated age category",
+			slog.String("member_id", member.Id),
+			slog.String("age_category", member.AgeCategory.String()),
+		)
+	}
+
+	return nil
+}
@@ -0,0 +1,382 @@
+package members
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"math/big"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+
+	pb "github.com/cfhn/our-space/ourspace-backend/proto"
+)
+
+const (
+	maxAttributeValueSize   = 4096
+	maxAttributeOptions     = 256
+	maxAttributeOptionSize  = 256
+	maxAttributePatternSize = 1024
+)
+
+//nolint:gochecknoglobals // constant lookup slices and patterns
+var (
+	selectAttributeTypes = []pb.MemberAttribute_Type{
+		pb.MemberAttribute_TYPE_SELECT,
+		pb.MemberAttribute_TYPE_MULTI_SELECT,
+	}
+	// lengthAttributeTypes can be restricted by a pattern, their min and max limit the length of the value.
+	lengthAttributeTypes = []pb.MemberAttribute_Type{
+		pb.MemberAttribute_TYPE_TEXT_SINGLE_LINE,
+		pb.MemberAttribute_TYPE_TEXT_MULI_LINE,
+		pb.MemberAttribute_TYPE_EMAIL,
+		pb.MemberAttribute_TYPE_PHONE,
+		pb.MemberAttribute_TYPE_URL,
+	}
+	integerPattern = regexp.MustCompile(`^-?[0-9]+$`)
+	decimalPattern = regexp.MustCompile(`^-?[0-9]+(\.[0-9]+)?$`)
+	phonePattern   = regexp.MustCompile(`^\+?[0-9(][0-9 ()/-]*[0-9]$`)
+)
+
+// validateAttributeValue checks a value against the type and the constraints of the attribute. Empty values are
+// treated as not set.
+func validateAttributeValue(
+	field string, attribute *pb.MemberAttribute, value string,
+) []*errdetails.BadRequest_FieldViolation {
+	if value == "" {
+		if !attribute.Required {
+			return nil
+		}
+
+		return []*errdetails.BadRequest_FieldViolation{{
+			Field:       field,
+			Description: "required attribute must not be empty",
+			Reason:      "FIELD_EMPTY",
+		}}
+	}
+
+	if len(value) > maxAttributeValueSize {
+		return []*errdetails.BadRequest_FieldViolation{{
+			Field:       field,
+			Description: "field can't be longer than 4KB",
+			Reason:      "FIELD_TOO_LARGE",
+		}}
+	}
+
+	description := attributeTypeError(attribute, value)
+	if description == "" {
+		description = attributeConstraintError(attribute, value)
+	}
+
+	if description == "" {
+		return nil
+	}
+
+	return []*errdetails.BadRequest_FieldViolation{{
+		Field:       field,
+		Description: description,
+		Reason:      "FIELD_INVALID",
+	}}
+}
+
+// attributeTypeError describes why the value is not valid for the type of the attribute, it is empty for valid values.
+func attributeTypeError(attribute *pb.MemberAttribute, value string) string {
+	switch attribute.Type {
+	case pb.MemberAttribute_TYPE_UNKNOWN:
+		return "unknown field type configured"
+	case pb.MemberAttribute_TYPE_TEXT_SINGLE_LINE:
+		if strings.ContainsAny(value, "\r\n") {
+			return "single line field can't contain line breaks"
+		}
+	case pb.MemberAttribute_TYPE_TEXT_MULI_LINE:
+		// no additional validations
+	case pb.MemberAttribute_TYPE_NUMBER:
+		if !integerPattern.MatchString(value) {
+			return "number must be an integer"
+		}
+	case pb.MemberAttribute_TYPE_DECIMAL:
+		if !decimalPattern.MatchString(value) {
+			return "decimal must be a number with a dot as decimal separator"
+		}
+	case pb.MemberAttribute_TYPE_DATE:
+		_, err := time.Parse(time.DateOnly, value)
+		if err != nil {
+			return fmt.Sprintf("invalid date: %v", err.Error())
+		}
+	case pb.MemberAttribute_TYPE_DATETIME:
+		_, err := time.Parse(time.RFC3339, value)
+		if err != nil {
+			return fmt.Sprintf("invalid date-time: %v", err.Error())
+		}
+	case pb.MemberAttribute_TYPE_SELECT:
+		if !slices.Contains(attribute.Options, value) {
+			return fmt.Sprintf("value must be one of %q", attribute.Options)
+		}
+	case pb.MemberAttribute_TYPE_MULTI_SELECT:
+		selected := multiSelectValues(value)
+
+		for i, option := range selected {
+			if !slices.Contains(attribute.Options, option) {
+				return fmt.Sprintf("%q is not one of %q", option, attribute.Options)
+			}
+
+			if slices.Contains(selected[:i], option) {
+				return fmt.Sprintf("%q is selected more than once", option)
+			}
+		}
+	case pb.MemberAttribute_TYPE_BOOLEAN:
+		if value != "true" && value != "false" {
+			return `boolean must be "true" or "false"`
+		}
+	case pb.MemberAttribute_TYPE_EMAIL:
+		address, err := mail.ParseAddress(value)
+		if err != nil || address.Address != value {
+			return "invalid email address"
+		}
+	case pb.MemberAttribute_TYPE_PHONE:
+		if !phonePattern.MatchString(value) {
+			return "phone number may only contain digits, spaces, parentheses, slashes, dashes and a leading +"
+		}
+	case pb.MemberAttribute_TYPE_URL:
+		parsed, err := url.Parse(value)
+		if err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Host == "" {
+			return "URL must be an absolute http or https URL"
+		}
+	}
+
+	return ""
+}
+
+// attributeConstraintError describes which of the pattern, min or max constraints the value violates, it is empty
+// for valid values. The value must be valid for the type of the attribute.
+func attributeConstraintError(attribute *pb.MemberAttribute, value string) string {
+	if attribute.Pattern != "" {
+		pattern, err := compileAttributePattern(attribute.Pattern)
+		if err == nil && !pattern.MatchString(value) {
+			return fmt.Sprintf("value must match the pattern %q", attribute.Pattern)
+		}
+	}
+
+	measure, ok := attributeMeasure(attribute, value)
+	if !ok {
+		return ""
+	}
+
+	if attribute.Min != nil {
+		limit, ok := parseAttributeLimit(attribute.Type, *attribute.Min)
+		if ok && measure.Cmp(limit) < 0 {
+			return fmt.Sprintf("value must be at least %s%s", *attribute.Min, attributeLimitUnit(attribute.Type))
+		}
+	}
+
+	if attribute.Max != nil {
+		limit, ok := parseAttributeLimit(attribute.Type, *attribute.Max)
+		if ok && measure.Cmp(limit) > 0 {
+			return fmt.Sprintf("value must be at most %s%s", *attribute.Max, attributeLimitUnit(attribute.Type))
+		}
+	}
+
+	return ""
+}
+
+// compileAttributePattern anchors the pattern, so that it has to match the whole value.
+func compileAttributePattern(pattern string) (*regexp.Regexp, error) {
+	return regexp.Compile(`^(?:` + pattern + `)$`)
+}
+
+// attributeMeasure returns the number that is compared with min and max of the attribute.
+func attributeMeasure(attribute *pb.MemberAttribute, value string) (*big.Rat, bool) {
+	switch {
+	case attribute.Type == pb.MemberAttribute_TYPE_MULTI_SELECT:
+		return big.NewRat(int64(len(multiSelectValues(value))), 1), true
+	case slices.Contains(lengthAttributeTypes, attribute.Type):
+		return big.NewRat(int64(utf8.RuneCountInString(value)), 1), true
+	default:
+		return parseAttributeLimit(attribute.Type, value)
+	}
+}
+
+// parseAttributeLimit parses min and max of attributes. Numbers, decimals, dates and date-times are limited by their
+// value, multi-selects and the lengthAttributeTypes by a non-negative integer. Other types can't be limited.
+func parseAttributeLimit(attributeType pb.MemberAttribute_Type, limit string) (*big.Rat, bool) {
+	switch attributeType {
+	case pb.MemberAttribute_TYPE_NUMBER, pb.MemberAttribute_TYPE_DECIMAL:
+		if !decimalPattern.MatchString(limit) {
+			return nil, false
+		}
+
+		return new(big.Rat).SetString(limit)
+	case pb.MemberAttribute_TYPE_DATE:
+		date, err := time.Parse(time.DateOnly, limit)
+		if err != nil {
+			return nil, false
+		}
+
+		return big.NewRat(date.Unix(), 1), true
+	case pb.MemberAttribute_TYPE_DATETIME:
+		timestamp, err := time.Parse(time.RFC3339, limit)
+		if err != nil {
+			return nil, false
+		}
+
+		return new(big.Rat).Add(
+			big.NewRat(timestamp.Unix(), 1), big.NewRat(int64(timestamp.Nanosecond()), int64(time.Second)),
+		), true
+	default:
+		if attributeType != pb.MemberAttribute_TYPE_MULTI_SELECT && !slices.Contains(lengthAttributeTypes, attributeType) {
+			return nil, false
+		}
+
+		if !integerPattern.MatchString(limit) || strings.HasPrefix(limit, "-") {
+			return nil, false
+		}
+
+		return new(big.Rat).SetString(limit)
+	}
+}
+
+func attributeLimitUnit(attributeType pb.MemberAttribute_Type) string {
+	switch {
+	case attributeType == pb.MemberAttribute_TYPE_MULTI_SELECT:
+		return " selected options"
+	case slices.Contains(lengthAttributeTypes, attributeType):
+		return " characters"
+	default:
+		return ""
+	}
+}
+
+// multiSelectValues splits the value of a multi-select attribute into the selected options.
+func multiSelectValues(value string) []string {
+	if value == "" {
+		return nil
+	}
+
+	return strings.Split(value, "\n")
+}
+
+// validateRequiredAttributes reports the required attributes that are missing from a new member.
+func validateRequiredAttributes(
+	values map[string]string, additionalAttributes map[string]*pb.MemberAttribute,
+) []*errdetails.BadRequest_FieldViolation {
+	var fieldViolations []*errdetails.BadRequest_FieldViolation
+
+	for _, name := range slices.Sorted(maps.Keys(additionalAttributes)) {
+		if _, ok := values[name]; ok || !additionalAttributes[name].Required {
+			continue
+		}
+
+		fieldViolations = append(fieldViolations, &errdetails.BadRequest_FieldViolation{
+			Field:       fmt.Sprintf("additional_attributes.%s", name),
+			Description: "required attribute must be set",
+			Reason:      "FIELD_EMPTY",
+		})
+	}
+
+	return fieldViolations
+}
+
+// validateUniqueAttributes checks that no other member has the same value for a unique attribute.
+func (s Service) validateUniqueAttributes(
+	ctx context.Context, memberID string, values map[string]string,
+	additionalAttributes map[string]*pb.MemberAttribute,
+) ([]*errdetails.BadRequest_FieldViolation, error) {
+	var fieldViolations []*errdetails.BadRequest_FieldViolation
+
+	for _, name := range slices.Sorted(maps.Keys(values)) {
+		attribute, ok := additionalAttributes[name]
+		if !ok || !attribute.Unique || values[name] == "" {
+			continue
+		}
+
+		existing, err := s.repo.ExistingAttributeValues(ctx, name, []string{values[name]}, memberID)
+		if err != nil {
+			return nil, err
+		}
+
+		if len(existing) != 0 {
+			fieldViolations = append(fieldViolations, &errdetails.BadRequest_FieldViolation{
+				Field:       fmt.Sprintf("additional_attributes.%s", name),
+				Description: "value is already used by another member",
+				Reason:      "FIELD_INVALID",
+			})
+		}
+	}
+
+	return fieldViolations, nil
+}
+
+// validateAttributeConstraints checks the options, pattern, min and max of an attribute definition.
+func validateAttributeConstraints(attribute *pb.MemberAttribute) []*errdetails.BadRequest_FieldViolation {
+	var violations []*errdetails.BadRequest_FieldViolation
+
+	violation := func(field, description string) {
+		violations = append(violations, &errdetails.BadRequest_FieldViolation{
+			Field:       "attribute." + field,
+			Description: description,
+			Reason:      "FIELD_INVALID",
+		})
+	}
+
+	switch {
+	case !slices.Contains(selectAttributeTypes, attribute.Type) && len(attribute.Options) != 0:
+		violation("options", "options are only supported for select and multi-select attributes")
+	case slices.Contains(selectAttributeTypes, attribute.Type) && len(attribute.Options) == 0:
+		violation("options", "select attributes must have at least one option")
+	case len(attribute.Options) > maxAttributeOptions:
+		violation("options", fmt.Sprintf("must not have more than %d options", maxAttributeOptions))
+	}
+
+	for i, option := range attribute.Options {
+		switch {
+		case strings.TrimSpace(option) == "":
+			violation(fmt.Sprintf("options[%d]", i), "option must not be empty")
+		case len(option) > maxAttributeOptionSize:
+			violation(fmt.Sprintf("options[%d]", i), "option must not be longer than 256 bytes")
+		case strings.ContainsAny(option, "\r\n"):
+			violation(fmt.Sprintf("options[%d]", i), "option can't contain line breaks")
+		case slices.Contains(attribute.Options[:i], option):
+			violation(fmt.Sprintf("options[%d]", i), fmt.Sprintf("option %q is not unique", option))
+		}
+	}
+
+	if attribute.Pattern != "" {
+		_, err := regexp.Compile(attribute.Pattern)
+
+		switch {
+		case !slices.Contains(lengthAttributeTypes, attribute.Type):
+			violation("pattern", "pattern is only supported for text, email, phone and URL attributes")
+		case len(attribute.Pattern) > maxAttributePatternSize:
+			violation("pattern", "pattern must not be longer than 1KB")
+		case err != nil:
+			violation("pattern", fmt.Sprintf("invalid regular expression: %v", err))
+		}
+	}
+
+	limit := func(field string, value *string) *big.Rat {
+		if value == nil {
+			return nil
+		}
+
+		rat, ok := parseAttributeLimit(attribute.Type, *value)
+		if !ok {
+			violation(field, fmt.Sprintf("%s is not a valid limit for attributes of type %s", field, attribute.Type))
+		}
+
+		return rat
+	}
+
+	minLimit, maxLimit := limit("min", attribute.Min), limit("max", attribute.Max)
+
+	if minLimit != nil && maxLimit != nil && minLimit.Cmp(maxLimit) > 0 {
+		violation("min", "min must not be greater than max")
+	}
+
+	return violations
+}
@@ -0,0 +1,256 @@
+package members
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"strings"
+
+	"github.com/google/uuid"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	pb "github.com/cfhn/our-space/ourspace-backend/proto"
+	"github.com/cfhn/our-space/pkg/setup"
+	"github.com/cfhn/our-space/pkg/status"
+)
+
+// SubmitChangeRequest stores a change members requested to their own data, so that staff can approve it later. The
+// change is validated right away, so that members learn about mistakes before staff look at it.
+func (s Service) SubmitChangeRequest(
+	ctx context.Context, memberID string, member *pb.Member, fieldMask *fieldmaskpb.FieldMask,
+) (*pb.MemberChangeRequest, error) {
+	memberAttributes, err := s.listAllMemberAttributes(ctx)
+	if err != nil {
+		return nil, status.Internal(err)
+	}
+
+	request := &pb.UpdateMemberRequest{Member: member, FieldMask: fieldMask}
+
+	fieldViolations := validateUpdateMember(request, memberAttributes)
+
+	for _, path := range fieldMask.Paths {
+		// passwords would be stored in plain text until the change is approved
+		if path == "member_login" || strings.HasPrefix(path, "member_login.") {
+			fieldViolations = append(fieldViolations, &errdetails.BadRequest_FieldViolation{
+				Field:       "field_mask",
+				Description: "member_login can't be changed by a change request",
+				Reason:      "FIELD_INVALID",
+			})
+		}
+	}
+
+	if len(fieldViolations) != 0 {
+		return nil, status.FieldViolations(fieldViolations)
+	}
+
+	member.Id = memberID
+
+	changeRequest, err := s.repo.CreateChangeRequest(ctx, &pb.MemberChangeRequest{
+		Id:          uuid.New().String(),
+		MemberId:    memberID,
+		Member:      member,
+		FieldMask:   fieldMask,
+		RequestedBy: subjectFromContext(ctx),
+	})
+	if errors.Is(err, ErrReferenceNotFound) {
+		return nil, status.NotFound()
+	}
+
+	if err != nil {
+		return nil, status.Internal(err)
+	}
+
+	return changeRequest, nil
+}
+
+func (s Service) ListMemberChangeRequests(
+	ctx context.Context, request *pb.ListMemberChangeRequestsRequest,
+) (*pb.ListMemberChangeRequestsResponse, error) {
+	if !accessorFromContext(ctx).canDecideChangeRequests() {
+		return nil, status.PermissionDenied()
+	}
+
+	state := request.State
+	if state == pb.MemberChangeRequest_STATE_UNKNOWN {
+		state = pb.MemberChangeRequest_STATE_PENDING
+	}
+
+	return s.listChangeRequests(ctx, request.PageSize, request.PageToken, state, request.GetMemberId(), false)
+}
+
+// ListChangeRequestsOfMember lists the change requests of the member in all states, the latest first.
+func (s Service) ListChangeRequestsOfMember(
+	ctx context.Context, memberID string, pageSize int32, pageToken string,
+) (*pb.ListMemberChangeRequestsResponse, error) {
+	return s.listChangeRequests(ctx, pageSize, pageToken, pb.MemberChangeRequest_STATE_UNKNOWN, memberID, true)
+}
+
+func (s Service) listChangeRequests(
+	ctx context.Context, pageSize int32, pageToken string, state pb.MemberChangeRequest_State, memberID string,
+	newestFirst bool,
+) (*pb.ListMemberChangeRequestsResponse, error) {
+	if memberID != "" {
+		if _, err := uuid.Parse(memberID); err != nil {
+			return nil, status.FieldViolations([]*errdetails.BadRequest_FieldViolation{{
+				Field:       "member_id",
+				Description: "member_id must be a valid UUID",
+				Reason:      "FIELD_INVALID",
+			}})
+		}
+	}
+
+	pageTokenBytes, err := base64.RawStdEncoding.DecodeString(pageToken)
+	if err != nil {
+		return nil, err
+	}
+
+	token := &pb.MemberChangeRequestPageToken{}
+
+	err = proto.Unmarshal(pageTokenBytes, token)
+	if err != nil {
+		return nil, err
+	}
+
+	if pageSize == 0 {
+		pageSize = 50
+	}
+
+	changeRequests, err := s.repo.ListChangeRequests(ctx, pageSize+1, token, state, memberID, newestFirst)
+	if err != nil {
+		return nil, status.Internal(err)
+	}
+
+	var nextPageToken string
+
+	if len(changeRequests) > int(pageSize) {
+		changeRequests = changeRequests[:pageSize]
+
+		nextPageTokenBytes, err := proto.Marshal(&pb.MemberChangeRequestPageToken{Offset: token.Offset + pageSize})
+		if err != nil {
+			return nil, err
+		}
+
+		nextPageToken = base64.RawStdEncoding.EncodeToString(nextPageTokenBytes)
+	}
+
+	return &pb.ListMemberChangeRequestsResponse{
+		ChangeRequests: changeRequests,
+		NextPageToken:  nextPageToken,
+	}, nil
+}
+
+// ApproveMemberChangeRequest applies the change through UpdateMember, so that it is validated against the current
+// attributes and the permissions of the approving staff member. The request is pending again if the update fails. Staff
+// can't approve changes they requested themselves.
+func (s Service) ApproveMemberChangeRequest(
+	ctx context.Context, request *pb.DecideMemberChangeRequestRequest,
+) (*pb.MemberChangeRequest, error) {
+	access := accessorFromContext(ctx)
+
+	err := validateDecideChangeRequest(access, request)
+	if err != nil {
+		return nil, err
+	}
+
+	changeRequest, err := s.repo.GetChangeRequest(ctx, request.Id)
+	if errors.Is(err, ErrNotFound) {
+		return nil, status.NotFound()
+	}
+
+	if err != nil {
+		return nil, status.Internal(err)
+	}
+
+	if changeRequest.State != pb.MemberChangeRequest_STATE_PENDING {
+		return nil, status.FailedPrecondition("change request was already decided")
+	}
+
+	if access.requestedChange(changeRequest) {
+		return nil, status.PermissionDenied()
+	}
+
+	// the request is approved before the member is updated, so that a concurrent decision can't win after the change
+	// was applied
+	approved, err := s.decideChangeRequest(ctx, request, pb.MemberChangeRequest_STATE_APPROVED)
+	if err != nil {
+		return nil, err
+	}
+
+	changeRequest.Member.Id = changeRequest.MemberId
+
+	_, err = s.UpdateMember(ctx, &pb.UpdateMemberRequest{
+		Member:    changeRequest.Member,
+		FieldMask: changeRequest.FieldMask,
+	})
+	if err != nil {
+		// reopened even if the caller went away, otherwise the request would stay approved without being applied
+		reopenErr := s.repo.ReopenChangeRequest(context.WithoutCancel(ctx), request.Id)
+		if reopenErr != nil {
+			return nil, status.Internal(errors.Join(err, reopenErr))
+		}
+
+		return nil, err
+	}
+
+	return approved, nil
+}
+
+func (s Service) RejectMemberChangeRequest(
+	ctx context.Context, request *pb.DecideMemberChangeRequestRequest,
+) (*pb.MemberChangeRequest, error) {
+	err := validateDecideChangeRequest(accessorFromContext(ctx), request)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.decideChangeRequest(ctx, request, pb.MemberChangeRequest_STATE_REJECTED)
+}
+
+func (s Service) decideChangeRequest(
+	ctx context.Context, request *pb.DecideMemberChangeRequestRequest, state pb.MemberChangeRequest_State,
+) (*pb.MemberChangeRequest, error) {
+	changeRequest, err := s.repo.DecideChangeRequest(ctx, request.Id, state, subjectFromContext(ctx), request.Comment)
+
+	switch {
+	case errors.Is(err, ErrNotFound):
+		return nil, status.NotFound()
+	case errors.Is(err, ErrNotPending):
+		return nil, status.FailedPrecondition("change request was already decided")
+	case err != nil:
+		return nil, status.Internal(err)
+	}
+
+	return changeRequest, nil
+}
+
+func validateDecideChangeRequest(access accessor, request *pb.DecideMemberChangeRequestRequest) error {
+	if !access.canDecideChangeRequests() {
+		return status.PermissionDenied()
+	}
+
+	if _, err := uuid.Parse(request.Id); err != nil {
+		return status.NotFound()
+	}
+
+	if len(request.Comment) > 4096 {
+		return status.FieldViolations([]*errdetails.BadRequest_FieldViolation{{
+			Field:       "comment",
+			Description: "comment must be smaller than 4KB",
+			Reason:      "FIELD_TOO_LARGE",
+		}})
+	}
+
+	return nil
+}
+
+// subjectFromContext returns the username of the caller, calls from within the backend have none.
+func subjectFromContext(ctx context.Context) string {
+	claims, ok := setup.GetAccessTokenClaims(ctx)
+	if !ok {
+		return ""
+	}
+
+	return claims.Subject
+}
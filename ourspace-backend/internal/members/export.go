@@ -0,0 +1,120 @@
+package members
+
+import (
+	"slices"
+	"strings"
+
+	"google.golang.org/genproto/googleapis/api/httpbody"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/cfhn/our-space/ourspace-backend/internal/export"
+	pb "github.com/cfhn/our-space/ourspace-backend/proto"
+	"github.com/cfhn/our-space/pkg/status"
+)
+
+// ExportMembers writes all members matching the filter into a file with one column per additional attribute. The
+// column names match the fields accepted by ImportMembers.
+func (s Service) ExportMembers(
+	request *pb.ExportMembersRequest, stream grpc.ServerStreamingServer[httpbody.HttpBody],
+) error {
+	ctx := stream.Context()
+
+	memberAttributes, err := s.listAllMemberAttributes(ctx)
+	if err != nil {
+		return status.Internal(err)
+	}
+
+	memberAttributes = accessorFromContext(ctx).readableAttributes(memberAttributes)
+
+	attributeNames := make([]string, 0, len(memberAttributes))
+	for name := range memberAttributes {
+		attributeNames = append(attributeNames, name)
+	}
+
+	slices.Sort(attributeNames)
+
+	filter := &pb.ListMembersRequest{}
+	if request.Filter != nil {
+		filter = proto.Clone(request.Filter).(*pb.ListMembersRequest) //nolint:forcetypeassert // clone has same type
+	}
+
+	filter.PageSize = export.PageSize
+	filter.PageToken = ""
+
+	writer, err := export.NewWriter(stream, request.Format, "Members")
+	if err != nil {
+		return status.Internal(err)
+	}
+
+	header := []string{
+		"id", "name", "membership_start", "membership_end", "age_category", "birth_date", "tags", "username",
+	}
+	for _, name := range attributeNames {
+		header = append(header, "additional_attributes."+name)
+	}
+
+	err = writer.Write(header)
+	if err != nil {
+		return status.Internal(err)
+	}
+
+	for {
+		response, err := s.ListMembers(ctx, filter)
+		if err != nil {
+			return err
+		}
+
+		for _, member := range response.Members {
+			err = writer.Write(memberRow(member, attributeNames, memberAttributes))
+			if err != nil {
+				return status.Internal(err)
+			}
+		}
+
+		if response.NextPageToken == "" {
+			break
+		}
+
+		filter.PageToken = response.NextPageToken
+	}
+
+	err = writer.Close()
+	if err != nil {
+		return status.Internal(err)
+	}
+
+	return nil
+}
+
+func memberRow(
+	member *pb.Member, attributeNames []string, memberAttributes map[string]*pb.MemberAttribute,
+) []string {
+	var username string
+	if member.MemberLogin != nil {
+		username = member.MemberLogin.Username
+	}
+
+	row := []string{
+		member.Id,
+		member.Name,
+		export.FormatTimestamp(member.MembershipStart),
+		export.FormatTimestamp(member.MembershipEnd),
+		member.AgeCategory.String(),
+		member.GetBirthDate(),
+		strings.Join(member.Tags, ";"),
+		username,
+	}
+
+	for _, name := range attributeNames {
+		value := member.AdditionalAttributes[name]
+		if memberAttributes[name].Type == pb.MemberAttribute_TYPE_MULTI_SELECT {
+			// separated like tags, so that the file can be imported again
+			value = strings.Join(multiSelectValues(value), ";")
+		}
+
+		row = append(row, value)
+	}
+
+	return row
+}
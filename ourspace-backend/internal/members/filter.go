@@ -0,0 +1,165 @@
+package members
+
+import (
+	"fmt"
+	"maps"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+
+	pb "github.com/cfhn/our-space/ourspace-backend/proto"
+)
+
+//nolint:gochecknoglobals // constant lookup slices and patterns
+var (
+	textAttributeTypes = []pb.MemberAttribute_Type{
+		pb.MemberAttribute_TYPE_TEXT_SINGLE_LINE,
+		pb.MemberAttribute_TYPE_TEXT_MULI_LINE,
+		pb.MemberAttribute_TYPE_EMAIL,
+		pb.MemberAttribute_TYPE_PHONE,
+		pb.MemberAttribute_TYPE_URL,
+	}
+	rangeAttributeTypes = []pb.MemberAttribute_Type{
+		pb.MemberAttribute_TYPE_NUMBER,
+		pb.MemberAttribute_TYPE_DECIMAL,
+		pb.MemberAttribute_TYPE_DATE,
+		pb.MemberAttribute_TYPE_DATETIME,
+	}
+	// numberPattern matches the decimal numbers that are valid numeric values in Postgres.
+	numberPattern = regexp.MustCompile(`^[+-]?([0-9]+(\.[0-9]*)?|\.[0-9]+)([eE][+-]?[0-9]+)?$`)
+)
+
+func hasAttributeFilters(request *pb.ListMembersRequest) bool {
+	return len(request.AttributeEquals) != 0 || len(request.AttributeContains) != 0 ||
+		len(request.AttributeMin) != 0 || len(request.AttributeMax) != 0 || request.SortByAttribute != ""
+}
+
+func validateAttributeFilters(
+	request *pb.ListMembersRequest, additionalAttributes map[string]*pb.MemberAttribute,
+) []*errdetails.BadRequest_FieldViolation {
+	var fieldViolations []*errdetails.BadRequest_FieldViolation
+
+	for _, filter := range []struct {
+		field      string
+		values     map[string]string
+		validTypes []pb.MemberAttribute_Type
+	}{
+		{field: "attribute_equals", values: request.AttributeEquals},
+		{field: "attribute_contains", values: request.AttributeContains, validTypes: textAttributeTypes},
+		{field: "attribute_min", values: request.AttributeMin, validTypes: rangeAttributeTypes},
+		{field: "attribute_max", values: request.AttributeMax, validTypes: rangeAttributeTypes},
+	} {
+		for _, name := range slices.Sorted(maps.Keys(filter.values)) {
+			field := fmt.Sprintf("%s.%s", filter.field, name)
+
+			attribute, ok := additionalAttributes[name]
+			if !ok {
+				fieldViolations = append(fieldViolations, &errdetails.BadRequest_FieldViolation{
+					Field:       field,
+					Description: fmt.Sprintf("unknown additional attribute %q", name),
+					Reason:      "FIELD_INVALID",
+				})
+
+				continue
+			}
+
+			if filter.validTypes != nil && !slices.Contains(filter.validTypes, attribute.Type) {
+				fieldViolations = append(fieldViolations, &errdetails.BadRequest_FieldViolation{
+					Field:       field,
+					Description: fmt.Sprintf("filter can't be used for attributes of type %s", attribute.Type),
+					Reason:      "FIELD_INVALID",
+				})
+
+				continue
+			}
+
+			if filter.field != "attribute_contains" && !validFilterValue(attribute.Type, filter.values[name]) {
+				fieldViolations = append(fieldViolations, &errdetails.BadRequest_FieldViolation{
+					Field:       field,
+					Description: fmt.Sprintf("value is not a valid %s", attribute.Type),
+					Reason:      "FIELD_INVALID",
+				})
+			}
+		}
+	}
+
+	if request.SortByAttribute != "" && additionalAttributes[request.SortByAttribute] == nil {
+		fieldViolations = append(fieldViolations, &errdetails.BadRequest_FieldViolation{
+			Field:       "sort_by_attribute",
+			Description: fmt.Sprintf("unknown additional attribute %q", request.SortByAttribute),
+			Reason:      "FIELD_INVALID",
+		})
+	}
+
+	return fieldViolations
+}
+
+// validFilterValue checks that the value can be compared with attribute values of the type.
+func validFilterValue(attributeType pb.MemberAttribute_Type, value string) bool {
+	switch attributeType {
+	case pb.MemberAttribute_TYPE_NUMBER, pb.MemberAttribute_TYPE_DECIMAL:
+		return numberPattern.MatchString(value)
+	case pb.MemberAttribute_TYPE_BOOLEAN:
+		return value == "true" || value == "false"
+	case pb.MemberAttribute_TYPE_DATE:
+		_, err := time.Parse(time.DateOnly, value)
+
+		return err == nil
+	case pb.MemberAttribute_TYPE_DATETIME:
+		_, err := time.Parse(time.RFC3339, value)
+
+		return err == nil
+	default:
+		return true
+	}
+}
+
+func attributeFilters(
+	request *pb.ListMembersRequest, additionalAttributes map[string]*pb.MemberAttribute,
+) []AttributeFilter {
+	filters := map[string]*AttributeFilter{}
+
+	filter := func(name string) *AttributeFilter {
+		if filters[name] == nil {
+			filters[name] = &AttributeFilter{Attribute: additionalAttributes[name]}
+		}
+
+		return filters[name]
+	}
+
+	for name, value := range request.AttributeEquals {
+		filter(name).Equals = &value
+	}
+
+	for name, value := range request.AttributeContains {
+		filter(name).Contains = &value
+	}
+
+	for name, value := range request.AttributeMin {
+		filter(name).Min = &value
+	}
+
+	for name, value := range request.AttributeMax {
+		filter(name).Max = &value
+	}
+
+	result := make([]AttributeFilter, 0, len(filters))
+	for _, name := range slices.Sorted(maps.Keys(filters)) {
+		result = append(result, *filters[name])
+	}
+
+	return result
+}
+
+// getAttributeSortValue returns the value the member is sorted by, it has to match attributeSortExpression.
+func getAttributeSortValue(member *pb.Member, attribute *pb.MemberAttribute) string {
+	value, ok := member.AdditionalAttributes[attribute.TechnicalName]
+	if ok && validFilterValue(attribute.Type, value) {
+		return value
+	}
+
+	return strings.Trim(attributeSortDefault(attribute.Type), "'")
+}
@@ -0,0 +1,519 @@
+package members
+
+import (
+	"cmp"
+	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"maps"
+	"slices"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/cfhn/our-space/ourspace-backend/internal/export"
+	pb "github.com/cfhn/our-space/ourspace-backend/proto"
+	"github.com/cfhn/our-space/pkg/status"
+)
+
+const maxImportRows = 10000
+
+//nolint:gochecknoglobals // constant lookup slices
+var (
+	importFields         = []string{"name", "membership_start", "membership_end", "age_category", "birth_date", "tags"}
+	requiredImportFields = []string{"name", "membership_start", "age_category"}
+)
+
+// ImportMembers creates members from CSV rows. All rows are validated like CreateMember requests and the members are
+// only created if every row is valid.
+func (s Service) ImportMembers(
+	ctx context.Context, request *pb.ImportMembersRequest,
+) (*pb.ImportMembersResponse, error) {
+	memberAttributes, err := s.listAllMemberAttributes(ctx)
+	if err != nil {
+		return nil, status.Internal(err)
+	}
+
+	fieldViolations := validateImportMembers(request, memberAttributes)
+	if len(fieldViolations) != 0 {
+		return nil, status.FieldViolations(fieldViolations)
+	}
+
+	delimiter, _ := utf8.DecodeRuneInString(request.Delimiter)
+	if request.Delimiter == "" {
+		delimiter = ','
+	}
+
+	tagSeparator := request.TagSeparator
+	if tagSeparator == "" {
+		tagSeparator = ";"
+	}
+
+	reader := csv.NewReader(strings.NewReader(strings.TrimPrefix(request.Csv, "\ufeff")))
+	reader.Comma = delimiter
+	reader.FieldsPerRecord = -1
+
+	header, err := reader.Read()
+	if errors.Is(err, io.EOF) {
+		return nil, status.FieldViolations([]*errdetails.BadRequest_FieldViolation{{
+			Field:       "csv",
+			Description: "csv must contain a header row",
+			Reason:      "FIELD_EMPTY",
+		}})
+	}
+
+	if err != nil {
+		return nil, csvViolation(err)
+	}
+
+	fieldViolations = validateImportHeader(header, request.ColumnMapping)
+	if len(fieldViolations) != 0 {
+		return nil, status.FieldViolations(fieldViolations)
+	}
+
+	var (
+		response = &pb.ImportMembersResponse{}
+		members  []*pb.Member
+		rows     []int32
+	)
+
+	for {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+
+		if err != nil {
+			return nil, csvViolation(err)
+		}
+
+		if len(members) == maxImportRows {
+			return nil, status.FieldViolations([]*errdetails.BadRequest_FieldViolation{{
+				Field:       "csv",
+				Description: fmt.Sprintf("csv must not contain more than %d rows", maxImportRows),
+				Reason:      "FIELD_TOO_LARGE",
+			}})
+		}
+
+		for i, value := range record {
+			record[i] = export.UnescapeCell(value)
+		}
+
+		line, _ := reader.FieldPos(0)
+		row := int32(line) //nolint:gosec // bounded by maxImportRows and the message size
+
+		member, violations := parseImportRow(header, record, request.ColumnMapping, tagSeparator)
+		if len(violations) == 0 {
+			member.Tags = appendMissing(member.Tags, request.Tags...)
+			splitImportMultiSelects(member, memberAttributes, tagSeparator)
+			violations = validateCreateMember(&pb.CreateMemberRequest{Member: member}, memberAttributes)
+
+			// guardians can't be imported, underage members have to be created one by one if they are required
+			deriveAgeCategory(member)
+			violations = append(violations, s.guardianViolations(member, nil)...)
+		}
+
+		for _, violation := range violations {
+			response.Violations = append(response.Violations, &pb.MemberImportViolation{
+				Row:         row,
+				Column:      importColumn(request.ColumnMapping, violation.Field),
+				Field:       violation.Field,
+				Description: violation.Description,
+			})
+		}
+
+		members = append(members, member)
+		rows = append(rows, row)
+	}
+
+	access := accessorFromContext(ctx)
+
+	for _, member := range members {
+		// members of rows that could not be parsed are nil, their rows are reported as violations
+		if !access.canWriteAttributes(memberAttributes, slices.Collect(maps.Keys(member.GetAdditionalAttributes()))) {
+			return nil, status.PermissionDenied()
+		}
+	}
+
+	if len(response.Violations) == 0 {
+		response.Violations, err = s.validateImportUniqueAttributes(
+			ctx, members, rows, request.ColumnMapping, memberAttributes,
+		)
+		if err != nil {
+			return nil, status.Internal(err)
+		}
+	}
+
+	if len(response.Violations) != 0 {
+		return response, nil
+	}
+
+	response.MemberCount = int32(len(members)) //nolint:gosec // bounded by maxImportRows
+
+	if request.DryRun {
+		return response, nil
+	}
+
+	for _, member := range members {
+		member.Id = uuid.New().String()
+	}
+
+	err = s.repo.CreateMembers(ctx, members)
+	if err != nil {
+		return nil, status.Internal(err)
+	}
+
+	return response, nil
+}
+
+func validateImportMembers(
+	request *pb.ImportMembersRequest, additionalAttributes map[string]*pb.MemberAttribute,
+) []*errdetails.BadRequest_FieldViolation {
+	var fieldViolations []*errdetails.BadRequest_FieldViolation
+
+	if request.Csv == "" {
+		fieldViolations = append(fieldViolations, &errdetails.BadRequest_FieldViolation{
+			Field:       "csv",
+			Description: "csv must not be empty",
+			Reason:      "FIELD_EMPTY",
+		})
+	}
+
+	mappedFields := make(map[string]string, len(request.ColumnMapping))
+
+	for column, field := range request.ColumnMapping {
+		attribute, isAttribute := strings.CutPrefix(field, "additional_attributes.")
+
+		switch {
+		case isAttribute && additionalAttributes[attribute] == nil:
+			fieldViolations = append(fieldViolations, &errdetails.BadRequest_FieldViolation{
+				Field:       fmt.Sprintf("column_mapping.%s", column),
+				Description: fmt.Sprintf("unknown additional attribute %q", attribute),
+				Reason:      "FIELD_INVALID",
+			})
+		case !isAttribute && !slices.Contains(importFields, field):
+			fieldViolations = append(fieldViolations, &errdetails.BadRequest_FieldViolation{
+				Field:       fmt.Sprintf("column_mapping.%s", column),
+				Description: fmt.Sprintf("field must be one of %v or additional_attributes.<technical_name>", importFields),
+				Reason:      "FIELD_INVALID",
+			})
+		case mappedFields[field] != "":
+			fieldViolations = append(fieldViolations, &errdetails.BadRequest_FieldViolation{
+				Field:       fmt.Sprintf("column_mapping.%s", column),
+				Description: fmt.Sprintf("field %q is already mapped to column %q", field, mappedFields[field]),
+				Reason:      "FIELD_INVALID",
+			})
+		}
+
+		mappedFields[field] = column
+	}
+
+	for _, field := range requiredImportFields {
+		// the age category is derived from the birth date if it is known
+		if field == "age_category" && mappedFields["birth_date"] != "" {
+			continue
+		}
+
+		if mappedFields[field] == "" {
+			fieldViolations = append(fieldViolations, &errdetails.BadRequest_FieldViolation{
+				Field:       "column_mapping",
+				Description: fmt.Sprintf("a column must be mapped to %s", field),
+				Reason:      "FIELD_EMPTY",
+			})
+		}
+	}
+
+	if delimiter, size := utf8.DecodeRuneInString(request.Delimiter); request.Delimiter != "" &&
+		(size != len(request.Delimiter) || strings.ContainsRune("\"\r\n", delimiter) || delimiter == utf8.RuneError) {
+		fieldViolations = append(fieldViolations, &errdetails.BadRequest_FieldViolation{
+			Field:       "delimiter",
+			Description: "delimiter must be a single character other than quotes and line breaks",
+			Reason:      "FIELD_INVALID",
+		})
+	}
+
+	for i, tag := range request.Tags {
+		if tag == "" {
+			fieldViolations = append(fieldViolations, &errdetails.BadRequest_FieldViolation{
+				Field:       fmt.Sprintf("tags[%d]", i),
+				Description: "tag must not be empty",
+				Reason:      "FIELD_INVALID",
+			})
+		}
+	}
+
+	return fieldViolations
+}
+
+func validateImportHeader(header []string, columnMapping map[string]string) []*errdetails.BadRequest_FieldViolation {
+	var fieldViolations []*errdetails.BadRequest_FieldViolation
+
+	for column := range columnMapping {
+		switch count := countColumn(header, column); {
+		case count == 0:
+			fieldViolations = append(fieldViolations, &errdetails.BadRequest_FieldViolation{
+				Field:       fmt.Sprintf("column_mapping.%s", column),
+				Description: fmt.Sprintf("csv has no column %q", column),
+				Reason:      "FIELD_INVALID",
+			})
+		case count > 1:
+			fieldViolations = append(fieldViolations, &errdetails.BadRequest_FieldViolation{
+				Field:       fmt.Sprintf("column_mapping.%s", column),
+				Description: fmt.Sprintf("csv has multiple columns named %q", column),
+				Reason:      "FIELD_INVALID",
+			})
+		}
+	}
+
+	return fieldViolations
+}
+
+func countColumn(header []string, column string) int {
+	count := 0
+
+	for _, name := range header {
+		if strings.TrimSpace(name) == column {
+			count++
+		}
+	}
+
+	return count
+}
+
+// parseImportRow converts a CSV row into a member. It only reports values that can't be parsed, the member itself is
+// validated afterward.
+func parseImportRow(
+	header, record []string, columnMapping map[string]string, tagSeparator string,
+) (*pb.Member, []*errdetails.BadRequest_FieldViolation) {
+	if len(record) != len(header) {
+		return nil, []*errdetails.BadRequest_FieldViolation{{
+			Description: fmt.Sprintf("row has %d columns, the header has %d", len(record), len(header)),
+			Reason:      "FIELD_INVALID",
+		}}
+	}
+
+	var (
+		member          = &pb.Member{AdditionalAttributes: map[string]string{}}
+		fieldViolations []*errdetails.BadRequest_FieldViolation
+	)
+
+	for i, column := range header {
+		field, ok := columnMapping[strings.TrimSpace(column)]
+		if !ok {
+			continue
+		}
+
+		value := strings.TrimSpace(record[i])
+
+		switch field {
+		case "name":
+			member.Name = value
+		case "membership_start", "membership_end":
+			timestamp, err := parseImportTime(value)
+			if err != nil {
+				fieldViolations = append(fieldViolations, &errdetails.BadRequest_FieldViolation{
+					Field:       "member." + field,
+					Description: field + " must be a date (YYYY-MM-DD) or a RFC 3339 date-time",
+					Reason:      "FIELD_INVALID",
+				})
+
+				continue
+			}
+
+			if field == "membership_start" {
+				member.MembershipStart = timestamp
+			} else {
+				member.MembershipEnd = timestamp
+			}
+		case "age_category":
+			ageCategory, ok := parseImportAgeCategory(value)
+			if !ok {
+				fieldViolations = append(fieldViolations, &errdetails.BadRequest_FieldViolation{
+					Field:       "member.age_category",
+					Description: fmt.Sprintf("age_category must be in %v", validAgeCategories),
+					Reason:      "FIELD_INVALID",
+				})
+			}
+
+			member.AgeCategory = ageCategory
+		case "birth_date":
+			if value != "" {
+				member.BirthDate = &value
+			}
+		case "tags":
+			for _, tag := range strings.Split(value, tagSeparator) {
+				if tag = strings.TrimSpace(tag); tag != "" {
+					member.Tags = appendMissing(member.Tags, tag)
+				}
+			}
+		default:
+			if value != "" {
+				member.AdditionalAttributes[strings.TrimPrefix(field, "additional_attributes.")] = value
+			}
+		}
+	}
+
+	return member, fieldViolations
+}
+
+// parseImportTime accepts dates, which start at midnight in the local time zone, and RFC 3339 date-times. Empty values
+// are not set.
+func parseImportTime(value string) (*timestamppb.Timestamp, error) {
+	if value == "" {
+		return nil, nil //nolint:nilnil // an empty value is valid and not set
+	}
+
+	timestamp, err := time.Parse(time.RFC3339, value)
+	if err == nil {
+		return timestamppb.New(timestamp), nil
+	}
+
+	timestamp, err = time.ParseInLocation(time.DateOnly, value, time.Local)
+	if err != nil {
+		return nil, err
+	}
+
+	return timestamppb.New(timestamp), nil
+}
+
+// parseImportAgeCategory accepts the enum names with or without prefix in any case, e.g. "adult".
+func parseImportAgeCategory(value string) (pb.AgeCategory, bool) {
+	if value == "" {
+		return pb.AgeCategory_AGE_CATEGORY_UNKNOWN, true
+	}
+
+	name := strings.ToUpper(value)
+	if !strings.HasPrefix(name, "AGE_CATEGORY_") {
+		name = "AGE_CATEGORY_" + name
+	}
+
+	ageCategory, ok := pb.AgeCategory_value[name]
+
+	return pb.AgeCategory(ageCategory), ok
+}
+
+// splitImportMultiSelects converts multi-select values, which are separated like tags in the file, into the line
+// separated options stored for members.
+func splitImportMultiSelects(
+	member *pb.Member, additionalAttributes map[string]*pb.MemberAttribute, separator string,
+) {
+	for name, value := range member.AdditionalAttributes {
+		attribute, ok := additionalAttributes[name]
+		if !ok || attribute.Type != pb.MemberAttribute_TYPE_MULTI_SELECT {
+			continue
+		}
+
+		var options []string
+
+		for _, option := range strings.Split(value, separator) {
+			if option = strings.TrimSpace(option); option != "" {
+				options = append(options, option)
+			}
+		}
+
+		member.AdditionalAttributes[name] = strings.Join(options, "\n")
+	}
+}
+
+// validateImportUniqueAttributes checks that the values of unique attributes are neither used twice in the file nor
+// by existing members. rows contains the row of each member in the file.
+func (s Service) validateImportUniqueAttributes(
+	ctx context.Context, members []*pb.Member, rows []int32, columnMapping map[string]string,
+	additionalAttributes map[string]*pb.MemberAttribute,
+) ([]*pb.MemberImportViolation, error) {
+	var violations []*pb.MemberImportViolation
+
+	for _, name := range slices.Sorted(maps.Keys(additionalAttributes)) {
+		if !additionalAttributes[name].Unique {
+			continue
+		}
+
+		var (
+			field     = "additional_attributes." + name
+			firstRows = map[string]int32{}
+			values    []string
+		)
+
+		for i, member := range members {
+			value := member.AdditionalAttributes[name]
+			if value == "" {
+				continue
+			}
+
+			if firstRow, ok := firstRows[value]; ok {
+				violations = append(violations, &pb.MemberImportViolation{
+					Row:         rows[i],
+					Column:      importColumn(columnMapping, field),
+					Field:       field,
+					Description: fmt.Sprintf("value is already used in row %d", firstRow),
+				})
+
+				continue
+			}
+
+			firstRows[value] = rows[i]
+			values = append(values, value)
+		}
+
+		if len(values) == 0 {
+			continue
+		}
+
+		existing, err := s.repo.ExistingAttributeValues(ctx, name, values, "")
+		if err != nil {
+			return nil, err
+		}
+
+		for _, value := range existing {
+			violations = append(violations, &pb.MemberImportViolation{
+				Row:         firstRows[value],
+				Column:      importColumn(columnMapping, field),
+				Field:       field,
+				Description: "value is already used by another member",
+			})
+		}
+	}
+
+	slices.SortStableFunc(violations, func(a, b *pb.MemberImportViolation) int {
+		return cmp.Compare(a.Row, b.Row)
+	})
+
+	return violations, nil
+}
+
+// importColumn finds the column a violation of a member field was caused by.
+func importColumn(columnMapping map[string]string, field string) string {
+	field, _, _ = strings.Cut(strings.TrimPrefix(field, "member."), "[")
+
+	for column, mappedField := range columnMapping {
+		if mappedField == field {
+			return column
+		}
+	}
+
+	return ""
+}
+
+func appendMissing(values []string, additional ...string) []string {
+	for _, value := range additional {
+		if !slices.Contains(values, value) {
+			values = append(values, value)
+		}
+	}
+
+	return values
+}
+
+func csvViolation(err error) error {
+	return status.FieldViolations([]*errdetails.BadRequest_FieldViolation{{
+		Field:       "csv",
+		Description: err.Error(),
+		Reason:      "FIELD_INVALID",
+	}})
+}
@@ -0,0 +1,214 @@
+package members
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/protobuf/proto"
+
+	pb "github.com/cfhn/our-space/ourspace-backend/proto"
+	"github.com/cfhn/our-space/pkg/status"
+)
+
+// MigrateMemberAttribute renames an attribute or changes its type. The values of all members are converted in the
+// same transaction, so either every value is migrated or none.
+func (s Service) MigrateMemberAttribute(
+	ctx context.Context, request *pb.MigrateMemberAttributeRequest,
+) (*pb.MigrateMemberAttributeResponse, error) {
+	existing, err := s.repo.GetMemberAttribute(ctx, request.Id)
+	if errors.Is(err, ErrNotFound) {
+		return nil, status.NotFound()
+	}
+
+	if err != nil {
+		return nil, status.Internal(err)
+	}
+
+	// The migration rewrites and reports the values, so it needs the same access as changing them.
+	if !accessorFromContext(ctx).canWrite(existing) {
+		return nil, status.PermissionDenied()
+	}
+
+	memberAttributes, err := s.listAllMemberAttributes(ctx)
+	if err != nil {
+		return nil, status.Internal(err)
+	}
+
+	target := migratedAttribute(existing, request)
+
+	fieldViolations := validateMigrateMemberAttribute(request, existing, target, memberAttributes)
+	if len(fieldViolations) != 0 {
+		return nil, status.FieldViolations(fieldViolations)
+	}
+
+	response, err := s.repo.MigrateMemberAttribute(ctx, existing, target, request.DropInvalidValues, request.DryRun)
+	if errors.Is(err, ErrAttributeChanged) {
+		return nil, status.FailedPrecondition("attribute was changed during the migration")
+	}
+
+	if err != nil {
+		return nil, status.Internal(err)
+	}
+
+	return response, nil
+}
+
+// migratedAttribute returns the attribute as it is after the migration.
+func migratedAttribute(existing *pb.MemberAttribute, request *pb.MigrateMemberAttributeRequest) *pb.MemberAttribute {
+	target := proto.Clone(existing).(*pb.MemberAttribute) //nolint:forcetypeassert // clone has same type
+
+	if request.TechnicalName != "" {
+		target.TechnicalName = request.TechnicalName
+	}
+
+	if request.Type != pb.MemberAttribute_TYPE_UNKNOWN && request.Type != existing.Type {
+		target.Type = request.Type
+		target.Pattern = ""
+		target.Min = nil
+		target.Max = nil
+	}
+
+	switch {
+	case len(request.Options) != 0:
+		target.Options = request.Options
+	case !slices.Contains(selectAttributeTypes, target.Type):
+		target.Options = nil
+	}
+
+	return target
+}
+
+func validateMigrateMemberAttribute(
+	request *pb.MigrateMemberAttributeRequest, existing, target *pb.MemberAttribute,
+	additionalAttributes map[string]*pb.MemberAttribute,
+) []*errdetails.BadRequest_FieldViolation {
+	var violations []*errdetails.BadRequest_FieldViolation
+
+	if request.TechnicalName != "" {
+		switch {
+		case !validTechnicalName.MatchString(request.TechnicalName):
+			violations = append(violations, &errdetails.BadRequest_FieldViolation{
+				Field:       "technical_name",
+				Description: "must be lower_camel_case and not start or end with an underscore",
+				Reason:      "FIELD_INVALID",
+			})
+		case request.TechnicalName != existing.TechnicalName && additionalAttributes[request.TechnicalName] != nil:
+			violations = append(violations, &errdetails.BadRequest_FieldViolation{
+				Field:       "technical_name",
+				Description: fmt.Sprintf("attribute %q already exists", request.TechnicalName),
+				Reason:      "FIELD_INVALID",
+			})
+		}
+	}
+
+	if request.Type != pb.MemberAttribute_TYPE_UNKNOWN && !slices.Contains(validAttributeTypes, request.Type) {
+		violations = append(violations, &errdetails.BadRequest_FieldViolation{
+			Field:       "type",
+			Description: "must be a supported type",
+			Reason:      "FIELD_INVALID",
+		})
+	}
+
+	if target.TechnicalName == existing.TechnicalName && target.Type == existing.Type &&
+		slices.Equal(target.Options, existing.Options) {
+		violations = append(violations, &errdetails.BadRequest_FieldViolation{
+			Field:       "technical_name",
+			Description: "at least one of technical_name, type or options must be changed",
+			Reason:      "FIELD_EMPTY",
+		})
+	}
+
+	for _, violation := range validateAttributeConstraints(target) {
+		violation.Field = strings.TrimPrefix(violation.Field, "attribute.")
+		violations = append(violations, violation)
+	}
+
+	return violations
+}
+
+// convertAttributeValue converts the value of a member from the old to the new definition of the attribute. The
+// description is set if the value can't be converted.
+func convertAttributeValue(from, to *pb.MemberAttribute, value string) (string, string) {
+	converted := value
+	if from.Type != to.Type {
+		converted = convertAttributeType(from.Type, to.Type, value)
+	}
+
+	if converted == "" {
+		return "", ""
+	}
+
+	if description := attributeTypeError(to, converted); description != "" {
+		return "", description
+	}
+
+	return converted, ""
+}
+
+// convertAttributeType converts values into the format of the new type where there is an obvious conversion, e.g.
+// yes and no into booleans. Whether the result is valid is checked by the caller.
+func convertAttributeType(from, to pb.MemberAttribute_Type, value string) string {
+	if to == pb.MemberAttribute_TYPE_TEXT_MULI_LINE {
+		return value
+	}
+
+	trimmed := strings.TrimSpace(value)
+
+	switch to {
+	case pb.MemberAttribute_TYPE_TEXT_SINGLE_LINE:
+		if from == pb.MemberAttribute_TYPE_MULTI_SELECT {
+			return strings.Join(multiSelectValues(value), ", ")
+		}
+
+		return trimmed
+	case pb.MemberAttribute_TYPE_MULTI_SELECT:
+		separators := "\r\n"
+		if from == pb.MemberAttribute_TYPE_TEXT_SINGLE_LINE {
+			separators = ","
+		}
+
+		var options []string
+
+		for _, option := range strings.FieldsFunc(value, func(r rune) bool { return strings.ContainsRune(separators, r) }) {
+			if option = strings.TrimSpace(option); option != "" {
+				options = append(options, option)
+			}
+		}
+
+		return strings.Join(options, "\n")
+	case pb.MemberAttribute_TYPE_NUMBER:
+		if whole, fraction, ok := strings.Cut(trimmed, "."); ok && strings.Trim(fraction, "0") == "" {
+			return whole
+		}
+
+		return trimmed
+	case pb.MemberAttribute_TYPE_DATE:
+		if timestamp, err := time.Parse(time.RFC3339, trimmed); err == nil {
+			return timestamp.Format(time.DateOnly)
+		}
+
+		return trimmed
+	case pb.MemberAttribute_TYPE_DATETIME:
+		if date, err := time.ParseInLocation(time.DateOnly, trimmed, time.Local); err == nil {
+			return date.Format(time.RFC3339)
+		}
+
+		return trimmed
+	case pb.MemberAttribute_TYPE_BOOLEAN:
+		switch strings.ToLower(trimmed) {
+		case "true", "yes", "y", "1", "on":
+			return "true"
+		case "false", "no", "n", "0", "off":
+			return "false"
+		default:
+			return trimmed
+		}
+	default:
+		return trimmed
+	}
+}
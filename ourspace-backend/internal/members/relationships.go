@@ -0,0 +1,555 @@
+package members
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/mail"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	pb "github.com/cfhn/our-space/ourspace-backend/proto"
+	"github.com/cfhn/our-space/pkg/status"
+)
+
+//nolint:gochecknoglobals // constant lookup slice
+var validRelationshipTypes = []pb.MemberRelationship_Type{
+	pb.MemberRelationship_TYPE_GUARDIAN,
+	pb.MemberRelationship_TYPE_EMERGENCY_CONTACT,
+	pb.MemberRelationship_TYPE_FAMILY,
+}
+
+func (s Service) CreateMemberRelationship(
+	ctx context.Context, request *pb.CreateMemberRelationshipRequest,
+) (*pb.MemberRelationship, error) {
+	if request.Relationship == nil {
+		return nil, status.FieldViolations([]*errdetails.BadRequest_FieldViolation{{
+			Field:       "relationship",
+			Description: "relationship field must not be empty",
+			Reason:      "FIELD_EMPTY",
+		}})
+	}
+
+	if _, err := uuid.Parse(request.Relationship.MemberId); err != nil {
+		return nil, status.NotFound()
+	}
+
+	relationship := request.Relationship
+	relationship.Id = uuid.New().String()
+
+	fieldViolations := validateRelationship("relationship", relationship)
+	if len(fieldViolations) != 0 {
+		return nil, status.FieldViolations(fieldViolations)
+	}
+
+	fieldViolations, err := s.validateRelatedMember(ctx, "relationship", relationship)
+	if err != nil {
+		return nil, status.Internal(err)
+	}
+
+	if len(fieldViolations) != 0 {
+		return nil, status.FieldViolations(fieldViolations)
+	}
+
+	relationship, err = s.repo.CreateRelationship(ctx, relationship)
+	if errors.Is(err, ErrReferenceNotFound) {
+		return nil, status.NotFound()
+	}
+
+	if err != nil {
+		return nil, status.Internal(err)
+	}
+
+	return relationship, nil
+}
+
+func (s Service) ListMemberRelationships(
+	ctx context.Context, request *pb.ListMemberRelationshipsRequest,
+) (*pb.ListMemberRelationshipsResponse, error) {
+	if _, err := uuid.Parse(request.MemberId); err != nil {
+		return nil, status.NotFound()
+	}
+
+	_, err := s.repo.GetMember(ctx, request.MemberId)
+	if errors.Is(err, ErrNotFound) {
+		return nil, status.NotFound()
+	}
+
+	if err != nil {
+		return nil, status.Internal(err)
+	}
+
+	relationships, err := s.repo.ListRelationships(ctx, request.MemberId)
+	if err != nil {
+		return nil, status.Internal(err)
+	}
+
+	return &pb.ListMemberRelationshipsResponse{Relationships: relationships}, nil
+}
+
+func (s Service) UpdateMemberRelationship(
+	ctx context.Context, request *pb.UpdateMemberRelationshipRequest,
+) (*pb.MemberRelationship, error) {
+	if request.Relationship == nil {
+		return nil, status.FieldViolations([]*errdetails.BadRequest_FieldViolation{{
+			Field:       "relationship",
+			Description: "relationship field must not be empty",
+			Reason:      "FIELD_EMPTY",
+		}})
+	}
+
+	if _, err := uuid.Parse(request.Relationship.MemberId); err != nil {
+		return nil, status.NotFound()
+	}
+
+	if _, err := uuid.Parse(request.Relationship.Id); err != nil {
+		return nil, status.NotFound()
+	}
+
+	existing, err := s.repo.GetRelationship(ctx, request.Relationship.MemberId, request.Relationship.Id)
+	if errors.Is(err, ErrNotFound) {
+		return nil, status.NotFound()
+	}
+
+	if err != nil {
+		return nil, status.Internal(err)
+	}
+
+	updated, fieldViolations := updatedRelationship(request, existing)
+	if len(fieldViolations) != 0 {
+		return nil, status.FieldViolations(fieldViolations)
+	}
+
+	fieldViolations, err = s.validateRelatedMember(ctx, "relationship", updated)
+	if err != nil {
+		return nil, status.Internal(err)
+	}
+
+	if len(fieldViolations) != 0 {
+		return nil, status.FieldViolations(fieldViolations)
+	}
+
+	if existing.Type == pb.MemberRelationship_TYPE_GUARDIAN && updated.Type != pb.MemberRelationship_TYPE_GUARDIAN {
+		err = s.checkGuardianRemovable(ctx, existing)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	relationship, err := s.repo.UpdateRelationship(ctx, updated, request.FieldMask)
+
+	switch {
+	case errors.Is(err, ErrNotFound), errors.Is(err, ErrReferenceNotFound):
+		return nil, status.NotFound()
+	case err != nil:
+		return nil, status.Internal(err)
+	}
+
+	return relationship, nil
+}
+
+// updatedRelationship applies the field mask of the request to the existing relationship and validates the result.
+func updatedRelationship(
+	request *pb.UpdateMemberRelationshipRequest, existing *pb.MemberRelationship,
+) (*pb.MemberRelationship, []*errdetails.BadRequest_FieldViolation) {
+	if !request.FieldMask.IsValid(&pb.MemberRelationship{}) {
+		return nil, []*errdetails.BadRequest_FieldViolation{{
+			Field:       "field_mask",
+			Description: "unknown fields in field mask",
+			Reason:      "FIELD_INVALID",
+		}}
+	}
+
+	updated := proto.Clone(existing).(*pb.MemberRelationship) //nolint:forcetypeassert // clone has same type
+
+	for _, path := range request.FieldMask.Paths {
+		switch path {
+		case "type":
+			updated.Type = request.Relationship.Type
+		case "related_member_id":
+			updated.RelatedMemberId = request.Relationship.RelatedMemberId
+			if !slices.Contains(request.FieldMask.Paths, "name") {
+				// the name of the previously related member is not kept for the external contact
+				updated.Name = ""
+				request.FieldMask.Paths = append(request.FieldMask.Paths, "name")
+			}
+		case "name":
+			updated.Name = request.Relationship.Name
+		case "email":
+			updated.Email = request.Relationship.Email
+		case "phone":
+			updated.Phone = request.Relationship.Phone
+		case "description":
+			updated.Description = request.Relationship.Description
+		default:
+			return nil, []*errdetails.BadRequest_FieldViolation{{
+				Field:       "field_mask",
+				Description: "non-updatable field in field mask",
+				Reason:      "FIELD_INVALID",
+			}}
+		}
+	}
+
+	return updated, validateRelationship("relationship", updated)
+}
+
+func (s Service) DeleteMemberRelationship(
+	ctx context.Context, request *pb.DeleteMemberRelationshipRequest,
+) (*emptypb.Empty, error) {
+	if _, err := uuid.Parse(request.MemberId); err != nil {
+		return nil, status.NotFound()
+	}
+
+	if _, err := uuid.Parse(request.Id); err != nil {
+		return nil, status.NotFound()
+	}
+
+	existing, err := s.repo.GetRelationship(ctx, request.MemberId, request.Id)
+	if errors.Is(err, ErrNotFound) {
+		return nil, status.NotFound()
+	}
+
+	if err != nil {
+		return nil, status.Internal(err)
+	}
+
+	if existing.Type == pb.MemberRelationship_TYPE_GUARDIAN {
+		err = s.checkGuardianRemovable(ctx, existing)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	err = s.repo.DeleteRelationship(ctx, request.MemberId, request.Id)
+	if errors.Is(err, ErrNotFound) {
+		return nil, status.NotFound()
+	}
+
+	if err != nil {
+		return nil, status.Internal(err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// checkGuardianRemovable returns a gRPC error if the guardian is the last one of an underage member and guardians are
+// required.
+func (s Service) checkGuardianRemovable(ctx context.Context, guardian *pb.MemberRelationship) error {
+	if !s.requireGuardian {
+		return nil
+	}
+
+	member, err := s.repo.GetMember(ctx, guardian.MemberId)
+	if errors.Is(err, ErrNotFound) {
+		return status.NotFound()
+	}
+
+	if err != nil {
+		return status.Internal(err)
+	}
+
+	if member.AgeCategory != pb.AgeCategory_AGE_CATEGORY_UNDERAGE {
+		return nil
+	}
+
+	relationships, err := s.repo.ListRelationships(ctx, guardian.MemberId)
+	if err != nil {
+		return status.Internal(err)
+	}
+
+	relationships = slices.DeleteFunc(relationships, func(relationship *pb.MemberRelationship) bool {
+		return relationship.Id == guardian.Id
+	})
+
+	if !hasGuardian(relationships) {
+		return status.FailedPrecondition("underage members need at least one guardian")
+	}
+
+	return nil
+}
+
+func hasGuardian(relationships []*pb.MemberRelationship) bool {
+	return slices.ContainsFunc(relationships, func(relationship *pb.MemberRelationship) bool {
+		return relationship.Type == pb.MemberRelationship_TYPE_GUARDIAN
+	})
+}
+
+// guardianViolations returns a violation if guardians are required, but the underage member has none.
+func (s Service) guardianViolations(
+	member *pb.Member, relationships []*pb.MemberRelationship,
+) []*errdetails.BadRequest_FieldViolation {
+	if !s.requireGuardian || member.AgeCategory != pb.AgeCategory_AGE_CATEGORY_UNDERAGE || hasGuardian(relationships) {
+		return nil
+	}
+
+	return []*errdetails.BadRequest_FieldViolation{{
+		Field:       "relationships",
+		Description: "underage members need at least one guardian",
+		Reason:      "FIELD_EMPTY",
+	}}
+}
+
+// validateRelationship checks the fields of the relationship that don't need the database. The name of related
+// members is cleared, as it is taken from the member.
+func validateRelationship(field string, relationship *pb.MemberRelationship) []*errdetails.BadRequest_FieldViolation {
+	var fieldViolations []*errdetails.BadRequest_FieldViolation
+
+	if !slices.Contains(validRelationshipTypes, relationship.Type) {
+		fieldViolations = append(fieldViolations, &errdetails.BadRequest_FieldViolation{
+			Field:       field + ".type",
+			Description: fmt.Sprintf("type must be in %v", validRelationshipTypes),
+			Reason:      "FIELD_INVALID",
+		})
+	}
+
+	if relationship.RelatedMemberId != nil {
+		relationship.Name = ""
+
+		switch _, err := uuid.Parse(*relationship.RelatedMemberId); {
+		case err != nil:
+			fieldViolations = append(fieldViolations, &errdetails.BadRequest_FieldViolation{
+				Field:       field + ".related_member_id",
+				Description: "related_member_id must be a valid UUID",
+				Reason:      "FIELD_INVALID",
+			})
+		case *relationship.RelatedMemberId == relationship.MemberId:
+			fieldViolations = append(fieldViolations, &errdetails.BadRequest_FieldViolation{
+				Field:       field + ".related_member_id",
+				Description: "members can't be related to themselves",
+				Reason:      "FIELD_INVALID",
+			})
+		}
+	} else if relationship.Name == "" {
+		fieldViolations = append(fieldViolations, &errdetails.BadRequest_FieldViolation{
+			Field:       field + ".name",
+			Description: "name must be set for contacts who are not members",
+			Reason:      "FIELD_EMPTY",
+		})
+	}
+
+	if len(relationship.Name) > 1024 {
+		fieldViolations = append(fieldViolations, &errdetails.BadRequest_FieldViolation{
+			Field:       field + ".name",
+			Description: "name must not be over 1KB",
+			Reason:      "FIELD_TOO_LARGE",
+		})
+	}
+
+	if relationship.Email != "" {
+		if address, err := mail.ParseAddress(relationship.Email); err != nil || address.Address != relationship.Email {
+			fieldViolations = append(fieldViolations, &errdetails.BadRequest_FieldViolation{
+				Field:       field + ".email",
+				Description: "email must be an email address",
+				Reason:      "FIELD_INVALID",
+			})
+		}
+	}
+
+	if relationship.Phone != "" && !phonePattern.MatchString(relationship.Phone) {
+		fieldViolations = append(fieldViolations, &errdetails.BadRequest_FieldViolation{
+			Field:       field + ".phone",
+			Description: "phone must be a phone number",
+			Reason:      "FIELD_INVALID",
+		})
+	}
+
+	if len(relationship.Description) > 4096 {
+		fieldViolations = append(fieldViolations, &errdetails.BadRequest_FieldViolation{
+			Field:       field + ".description",
+			Description: "description must be smaller than 4KB",
+			Reason:      "FIELD_TOO_LARGE",
+		})
+	}
+
+	return fieldViolations
+}
+
+// validateRelatedMember checks that the related member exists.
+func (s Service) validateRelatedMember(
+	ctx context.Context, field string, relationship *pb.MemberRelationship,
+) ([]*errdetails.BadRequest_FieldViolation, error) {
+	if relationship.RelatedMemberId == nil {
+		return nil, nil
+	}
+
+	_, err := s.repo.GetMember(ctx, *relationship.RelatedMemberId)
+	if errors.Is(err, ErrNotFound) {
+		return []*errdetails.BadRequest_FieldViolation{{
+			Field:       field + ".related_member_id",
+			Description: "related member does not exist",
+			Reason:      "FIELD_INVALID",
+		}}, nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+func (s Service) CreateMemberConsent(
+	ctx context.Context, request *pb.CreateMemberConsentRequest,
+) (*pb.MemberConsent, error) {
+	if request.Consent == nil {
+		return nil, status.FieldViolations([]*errdetails.BadRequest_FieldViolation{{
+			Field:       "consent",
+			Description: "consent field must not be empty",
+			Reason:      "FIELD_EMPTY",
+		}})
+	}
+
+	if _, err := uuid.Parse(request.Consent.MemberId); err != nil {
+		return nil, status.NotFound()
+	}
+
+	consent := request.Consent
+	consent.Id = uuid.New().String()
+
+	if consent.ConsentTime == nil {
+		consent.ConsentTime = timestamppb.Now()
+	}
+
+	fieldViolations := validateConsent(consent)
+	if len(fieldViolations) != 0 {
+		return nil, status.FieldViolations(fieldViolations)
+	}
+
+	_, err := s.repo.GetMember(ctx, consent.MemberId)
+	if errors.Is(err, ErrNotFound) {
+		return nil, status.NotFound()
+	}
+
+	if err != nil {
+		return nil, status.Internal(err)
+	}
+
+	if consent.RelationshipId != nil {
+		relationship, err := s.repo.GetRelationship(ctx, consent.MemberId, *consent.RelationshipId)
+
+		switch {
+		case errors.Is(err, ErrNotFound):
+			return nil, status.FieldViolations([]*errdetails.BadRequest_FieldViolation{{
+				Field:       "consent.relationship_id",
+				Description: "relationship does not exist",
+				Reason:      "FIELD_INVALID",
+			}})
+		case err != nil:
+			return nil, status.Internal(err)
+		case relationship.Type != pb.MemberRelationship_TYPE_GUARDIAN:
+			return nil, status.FieldViolations([]*errdetails.BadRequest_FieldViolation{{
+				Field:       "consent.relationship_id",
+				Description: "only guardians can consent for a member",
+				Reason:      "FIELD_INVALID",
+			}})
+		}
+	}
+
+	consent, err = s.repo.CreateConsent(ctx, consent)
+	if errors.Is(err, ErrReferenceNotFound) {
+		return nil, status.NotFound()
+	}
+
+	if err != nil {
+		return nil, status.Internal(err)
+	}
+
+	return consent, nil
+}
+
+func validateConsent(consent *pb.MemberConsent) []*errdetails.BadRequest_FieldViolation {
+	const consentTimeLeeway = 15 * time.Minute
+
+	var fieldViolations []*errdetails.BadRequest_FieldViolation
+
+	if consent.Purpose == "" {
+		fieldViolations = append(fieldViolations, &errdetails.BadRequest_FieldViolation{
+			Field:       "consent.purpose",
+			Description: "purpose must not be empty",
+			Reason:      "FIELD_EMPTY",
+		})
+	}
+
+	if len(consent.Purpose) > 1024 {
+		fieldViolations = append(fieldViolations, &errdetails.BadRequest_FieldViolation{
+			Field:       "consent.purpose",
+			Description: "purpose must not be over 1KB",
+			Reason:      "FIELD_TOO_LARGE",
+		})
+	}
+
+	if consent.RelationshipId != nil {
+		if _, err := uuid.Parse(*consent.RelationshipId); err != nil {
+			fieldViolations = append(fieldViolations, &errdetails.BadRequest_FieldViolation{
+				Field:       "consent.relationship_id",
+				Description: "relationship_id must be a valid UUID",
+				Reason:      "FIELD_INVALID",
+			})
+		}
+	}
+
+	if consent.ConsentTime.AsTime().After(time.Now().Add(consentTimeLeeway)) {
+		fieldViolations = append(fieldViolations, &errdetails.BadRequest_FieldViolation{
+			Field:       "consent.consent_time",
+			Description: "consent_time must not be in the future",
+			Reason:      "FIELD_INVALID",
+		})
+	}
+
+	return fieldViolations
+}
+
+func (s Service) ListMemberConsents(
+	ctx context.Context, request *pb.ListMemberConsentsRequest,
+) (*pb.ListMemberConsentsResponse, error) {
+	if _, err := uuid.Parse(request.MemberId); err != nil {
+		return nil, status.NotFound()
+	}
+
+	_, err := s.repo.GetMember(ctx, request.MemberId)
+	if errors.Is(err, ErrNotFound) {
+		return nil, status.NotFound()
+	}
+
+	if err != nil {
+		return nil, status.Internal(err)
+	}
+
+	consents, err := s.repo.ListConsents(ctx, request.MemberId)
+	if err != nil {
+		return nil, status.Internal(err)
+	}
+
+	return &pb.ListMemberConsentsResponse{Consents: consents}, nil
+}
+
+func (s Service) RevokeMemberConsent(
+	ctx context.Context, request *pb.RevokeMemberConsentRequest,
+) (*pb.MemberConsent, error) {
+	if _, err := uuid.Parse(request.MemberId); err != nil {
+		return nil, status.NotFound()
+	}
+
+	if _, err := uuid.Parse(request.Id); err != nil {
+		return nil, status.NotFound()
+	}
+
+	consent, err := s.repo.RevokeConsent(ctx, request.MemberId, request.Id, time.Now())
+
+	switch {
+	case errors.Is(err, ErrNotFound):
+		return nil, status.NotFound()
+	case errors.Is(err, ErrConsentRevoked):
+		return nil, status.FailedPrecondition("consent was already revoked")
+	case err != nil:
+		return nil, status.Internal(err)
+	}
+
+	return consent, nil
+}
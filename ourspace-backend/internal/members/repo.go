@@ -9,14 +9,30 @@ import (
 	"strings"
 	"time"
 
+	"github.com/jackc/pgx/v5/pgconn"
 	"github.com/jackc/pgx/v5/pgtype"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/fieldmaskpb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
 	pb "github.com/cfhn/our-space/ourspace-backend/proto"
 )
 
-var ErrNotFound = errors.New("member not found")
+const (
+	uniqueViolation     = "23505"
+	foreignKeyViolation = "23503"
+)
+
+var (
+	ErrNotFound          = errors.New("member not found")
+	ErrAttributeChanged  = errors.New("member attribute was changed")
+	ErrReferenceNotFound = errors.New("referenced member not found")
+	ErrConsentRevoked    = errors.New("consent was already revoked")
+	ErrNotPending        = errors.New("change request was already decided")
+	ErrTagNotFound       = errors.New("member tag not found")
+	ErrTagExists         = errors.New("member tag already exists")
+)
 
 //nolint:gochecknoglobals // constant lookup maps
 var (
@@ -42,6 +58,17 @@ type Filters struct {
 	MembershipEndBefore   time.Time
 	AgeCategoryEquals     pb.AgeCategory
 	TagsContain           []string
+	Attributes            []AttributeFilter
+}
+
+// AttributeFilter restricts members by the value of an additional attribute. The values are compared according to
+// the type of the attribute, nil values are not filtered by.
+type AttributeFilter struct {
+	Attribute *pb.MemberAttribute
+	Equals    *string
+	Contains  *string
+	Min       *string
+	Max       *string
 }
 
 type Postgres struct {
@@ -52,7 +79,62 @@ func NewPostgresRepo(db *sql.DB) *Postgres {
 	return &Postgres{db: db}
 }
 
-func (p *Postgres) CreateMember(ctx context.Context, member *pb.Member) (*pb.Member, error) {
+type execer interface {
+	ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error)
+}
+
+// CreateMember creates the member together with its relationships, the member_id of the relationships is set to the
+// id of the member.
+func (p *Postgres) CreateMember(
+	ctx context.Context, member *pb.Member, relationships []*pb.MemberRelationship,
+) (*pb.Member, error) {
+	tx, err := p.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback() //nolint:errcheck // rollback after commit is a no-op
+
+	err = insertMember(ctx, tx, member)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, relationship := range relationships {
+		relationship.MemberId = member.Id
+
+		err = insertRelationship(ctx, tx, relationship)
+		if err != nil {
+			return nil, mapConstraintError(err)
+		}
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, err
+	}
+
+	return p.GetMember(ctx, member.Id)
+}
+
+// CreateMembers creates all members in a single transaction, either all of them are created or none.
+func (p *Postgres) CreateMembers(ctx context.Context, members []*pb.Member) error {
+	tx, err := p.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback() //nolint:errcheck // rollback after commit is a no-op
+
+	for _, member := range members {
+		err = insertMember(ctx, tx, member)
+		if err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+func insertMember(ctx context.Context, db execer, member *pb.Member) error {
 	var membershipEnd sql.Null[time.Time]
 
 	if member.MembershipEnd != nil {
@@ -71,33 +153,38 @@ func (p *Postgres) CreateMember(ctx context.Context, member *pb.Member) (*pb.Mem
 
 	additionalAttributesJSON, err := json.Marshal(additionalAttributes)
 	if err != nil {
-		return nil, err
+		return err
 	}
 
-	_, err = p.db.ExecContext(ctx, `
-		insert into members (id, name, membership_start, membership_end, age_category, tags, additional_attributes)
-		values ($1, $2, $3, $4, $5, $6, $7::jsonb);
-	`, member.Id, member.Name, member.MembershipStart.AsTime(), membershipEnd, member.AgeCategory.String(), tags, additionalAttributesJSON)
+	_, err = db.ExecContext(ctx, `
+		insert into members (
+			id, name, membership_start, membership_end, age_category, tags, additional_attributes, birth_date
+		)
+		values ($1, $2, $3, $4, $5, $6, $7::jsonb, $8);
+	`, member.Id, member.Name, member.MembershipStart.AsTime(), membershipEnd, member.AgeCategory.String(), tags,
+		additionalAttributesJSON, nullString(member.BirthDate))
 	if err != nil {
-		return nil, err
+		return err
 	}
 
 	if member.MemberLogin != nil {
-		_, err = p.db.ExecContext(ctx, `
-			insert into members_auth (id, username, password_hash)
-			values ($1, $2, $3)
-		`, member.Id, member.MemberLogin.Username, member.MemberLogin.Password)
+		_, err = db.ExecContext(ctx, `
+			insert into members_auth (id, username, password_hash, roles)
+			values ($1, $2, $3, coalesce($4::text[], '{}'))
+		`, member.Id, member.MemberLogin.Username, member.MemberLogin.Password,
+			pgtype.FlatArray[string](member.MemberLogin.Roles))
 		if err != nil {
-			return nil, err
+			return err
 		}
 	}
 
-	return p.GetMember(ctx, member.Id)
+	return nil
 }
 
 func (p *Postgres) GetMember(ctx context.Context, id string) (*pb.Member, error) {
 	row := p.db.QueryRowContext(ctx, `
-		select members.id, name, membership_start, membership_end, age_category, tags, additional_attributes, members_auth.username
+		select members.id, name, membership_start, membership_end, age_category, tags, additional_attributes,
+		       members_auth.username, members_auth.roles, birth_date
 		from members
 		left join members_auth on members.id = members_auth.id
 		where members.id = $1`, id,
@@ -115,9 +202,11 @@ func (p *Postgres) GetMember(ctx context.Context, id string) (*pb.Member, error)
 	return member, nil
 }
 
+// ListMembers lists the members matching the filters. If sortAttribute is set, the members are sorted by the value
+// of that additional attribute instead of sortField.
 func (p *Postgres) ListMembers(
 	ctx context.Context, pageSize int32, token *pb.MemberPageToken, sortField pb.MemberField,
-	sortDirection pb.SortDirection, filters *Filters,
+	sortDirection pb.SortDirection, sortAttribute *pb.MemberAttribute, filters *Filters,
 ) ([]*pb.Member, error) {
 	var (
 		nameContains          = wrapIlike(filters.NameContains)
@@ -140,13 +229,28 @@ func (p *Postgres) ListMembers(
 		pageSize,
 	)
 
-	paginationCondition, paginationValues := generatePaginationQuery(token, len(values)+1)
+	var sortExpression string
+
+	if sortAttribute != nil {
+		values = append(values, sortAttribute.TechnicalName)
+		sortExpression = attributeSortExpression(fmt.Sprintf("$%d", len(values)), sortAttribute.Type)
+	}
+
+	paginationCondition, paginationValues := generatePaginationQuery(token, sortExpression, len(values)+1)
 
 	values = append(values, paginationValues...)
 
+	attributeCondition, attributeValues, err := generateAttributeFilterQuery(filters.Attributes, len(values)+1)
+	if err != nil {
+		return nil, err
+	}
+
+	values = append(values, attributeValues...)
+
 	//nolint:gosec // manual concatenation is fine here, uses bound placeholders
 	rows, err := p.db.QueryContext(ctx, `
-		select members.id, name, membership_start, membership_end, age_category, tags, additional_attributes, members_auth.username
+		select members.id, name, membership_start, membership_end, age_category, tags, additional_attributes,
+		       members_auth.username, members_auth.roles, birth_date
 		from members
 		left join members_auth on members.id = members_auth.id
 		where
@@ -158,7 +262,8 @@ func (p *Postgres) ListMembers(
 		and ($6::text is null OR age_category = $6)
 		and ($7::text[] is null OR cardinality($7::text[]) = 0 OR tags && $7)
 		`+paginationCondition+`
-		order by `+getSort(sortField, sortDirection, token)+`
+		`+attributeCondition+`
+		order by `+getSort(sortField, sortDirection, token, sortExpression)+`
 		limit $8
 	`, values...)
 	if err != nil {
@@ -184,12 +289,125 @@ func (p *Postgres) ListMembers(
 	return members, nil
 }
 
-func generatePaginationQuery(token *pb.MemberPageToken, offset int) (string, []any) {
+// SearchMembers finds members whose name, username, tags or additional attributes contain the query or are similar
+// to it. The attributes in excludedAttributes are not searched. Every field is normalized with member_search_text and
+// has its own trigram index, the union of the fields only collects the candidates from these indexes.
+func (p *Postgres) SearchMembers(
+	ctx context.Context, query string, excludedAttributes []string, limit, offset int32,
+) ([]*pb.MemberSearchResult, error) {
+	rows, err := p.db.QueryContext(ctx, `
+		with candidates as (
+			select id from members
+			where member_search_text(name) %> member_search_text($1::text)
+			   or member_search_text(name) like '%' || member_search_text($2::text) || '%'
+			union
+			select id from members_auth
+			where member_search_text(username) %> member_search_text($1::text)
+			   or member_search_text(username) like '%' || member_search_text($2::text) || '%'
+			union
+			select id from members
+			where member_search_text(tags) %> member_search_text($1::text)
+			   or member_search_text(tags) like '%' || member_search_text($2::text) || '%'
+			union
+			select id from members
+			where member_search_text(additional_attributes) %> member_search_text($1::text)
+			   or member_search_text(additional_attributes) like '%' || member_search_text($2::text) || '%'
+		), documents as (
+			select members.id,
+			       member_search_text(members.name) as name,
+			       coalesce(member_search_text(members_auth.username), '') as username,
+			       member_search_text(members.tags) as tags,
+			       member_search_text(members.additional_attributes - $3::text[]) as attributes
+			from members
+			join candidates on candidates.id = members.id
+			left join members_auth on members_auth.id = members.id
+		), scores as (
+			select id,
+			       word_similarity(member_search_text($1::text), name) as name_score,
+			       word_similarity(member_search_text($1::text), username) as username_score,
+			       word_similarity(member_search_text($1::text), tags) as tags_score,
+			       word_similarity(member_search_text($1::text), attributes) as attributes_score,
+			       name %> member_search_text($1::text)
+			           or name like '%' || member_search_text($2::text) || '%' as name_matched,
+			       username %> member_search_text($1::text)
+			           or username like '%' || member_search_text($2::text) || '%' as username_matched,
+			       tags %> member_search_text($1::text)
+			           or tags like '%' || member_search_text($2::text) || '%' as tags_matched,
+			       attributes %> member_search_text($1::text)
+			           or attributes like '%' || member_search_text($2::text) || '%' as attributes_matched
+			from documents
+		)
+		select members.id, name, membership_start, membership_end, age_category, tags, additional_attributes,
+		       members_auth.username, members_auth.roles, birth_date,
+		       greatest(name_score, username_score, 0.9 * tags_score, 0.8 * attributes_score)::float8 as score,
+		       name_matched, username_matched, tags_matched, attributes_matched
+		from scores
+		join members on members.id = scores.id
+		left join members_auth on members_auth.id = members.id
+		where name_matched or username_matched or tags_matched or attributes_matched
+		order by score desc, members.name, members.id
+		limit $4
+		offset $5
+	`, query, escapeLike(query), pgtype.FlatArray[string](excludedAttributes), limit, offset)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	results := make([]*pb.MemberSearchResult, 0, limit)
+
+	for rows.Next() {
+		var (
+			result                                                       = &pb.MemberSearchResult{}
+			nameMatched, usernameMatched, tagsMatched, attributesMatched bool
+		)
+
+		result.Member, err = scanMember(
+			rows, &result.Score, &nameMatched, &usernameMatched, &tagsMatched, &attributesMatched,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, field := range []struct {
+			name    string
+			matched bool
+		}{
+			{name: "name", matched: nameMatched},
+			{name: "username", matched: usernameMatched},
+			{name: "tags", matched: tagsMatched},
+			{name: "additional_attributes", matched: attributesMatched},
+		} {
+			if field.matched {
+				result.MatchedFields = append(result.MatchedFields, field.name)
+			}
+		}
+
+		results = append(results, result)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return results, nil
+}
+
+// escapeLike escapes the wildcards of like patterns.
+func escapeLike(value string) string {
+	return strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`).Replace(value)
+}
+
+func generatePaginationQuery(token *pb.MemberPageToken, sortExpression string, offset int) (string, []any) {
 	fields := make([]string, 0, 2)
 	values := make([]any, 0, 2)
 	placeholders := make([]string, 0, 2)
 
 	fieldName, ok := membershipFields[token.Field]
+	if token.Attribute != "" && sortExpression != "" {
+		fieldName, ok = sortExpression, true
+	}
+
 	if !ok {
 		return "", nil
 	}
@@ -215,13 +433,19 @@ func generatePaginationQuery(token *pb.MemberPageToken, offset int) (string, []a
 		sort + "(" + strings.Join(placeholders, ",") + ")", values
 }
 
-func getSort(sortField pb.MemberField, direction pb.SortDirection, token *pb.MemberPageToken) string {
-	if token.Field != pb.MemberField_MEMBER_FIELD_UNKNOWN {
+func getSort(
+	sortField pb.MemberField, direction pb.SortDirection, token *pb.MemberPageToken, sortExpression string,
+) string {
+	if token.Field != pb.MemberField_MEMBER_FIELD_UNKNOWN || token.Attribute != "" {
 		sortField = token.Field
 		direction = token.Direction
 	}
 
 	fieldName, ok := membershipFields[sortField]
+	if sortExpression != "" {
+		fieldName, ok = sortExpression, true
+	}
+
 	if !ok {
 		return "id"
 	}
@@ -234,6 +458,108 @@ func getSort(sortField pb.MemberField, direction pb.SortDirection, token *pb.Mem
 	return fieldName + order + ", id" + order
 }
 
+// attributeExpression converts the value of the attribute, whose name is bound to placeholder, according to its type.
+func attributeExpression(placeholder string, attributeType pb.MemberAttribute_Type) string {
+	value := "(members.additional_attributes ->> " + placeholder + "::text)"
+
+	switch attributeType {
+	case pb.MemberAttribute_TYPE_NUMBER, pb.MemberAttribute_TYPE_DECIMAL:
+		return "member_attribute_numeric(" + value + ")"
+	case pb.MemberAttribute_TYPE_DATE:
+		return "member_attribute_date(" + value + ")"
+	case pb.MemberAttribute_TYPE_DATETIME:
+		return "member_attribute_timestamptz(" + value + ")"
+	default:
+		return value
+	}
+}
+
+// attributeSortExpression replaces missing attribute values with the lowest value of the type, so that keyset
+// pagination works for members without a value.
+func attributeSortExpression(placeholder string, attributeType pb.MemberAttribute_Type) string {
+	return "coalesce(" + attributeExpression(placeholder, attributeType) + ", " +
+		attributeSortDefault(attributeType) + ")"
+}
+
+func attributeSortDefault(attributeType pb.MemberAttribute_Type) string {
+	switch attributeType {
+	case pb.MemberAttribute_TYPE_NUMBER, pb.MemberAttribute_TYPE_DECIMAL:
+		return "'-Infinity'"
+	case pb.MemberAttribute_TYPE_DATE, pb.MemberAttribute_TYPE_DATETIME:
+		return "'-infinity'"
+	default:
+		return "''"
+	}
+}
+
+// generateAttributeFilterQuery creates the conditions for the attribute filters. Exact matches of text attributes
+// are combined into a single containment check, which can use the GIN index on additional_attributes. Multi-selects
+// match if the value is one of the selected options.
+func generateAttributeFilterQuery(filters []AttributeFilter, offset int) (string, []any, error) {
+	var (
+		conditions []string
+		values     []any
+		textEquals = map[string]string{}
+	)
+
+	placeholder := func(value any) string {
+		values = append(values, value)
+
+		return fmt.Sprintf("$%d", offset+len(values)-1)
+	}
+
+	for _, filter := range filters {
+		var (
+			name          = filter.Attribute.TechnicalName
+			attributeType = filter.Attribute.Type
+		)
+
+		if filter.Equals != nil {
+			switch attributeType {
+			case pb.MemberAttribute_TYPE_NUMBER, pb.MemberAttribute_TYPE_DECIMAL, pb.MemberAttribute_TYPE_DATE,
+				pb.MemberAttribute_TYPE_DATETIME:
+				conditions = append(conditions,
+					attributeExpression(placeholder(name), attributeType)+" = "+placeholder(*filter.Equals))
+			case pb.MemberAttribute_TYPE_MULTI_SELECT:
+				conditions = append(conditions, placeholder(*filter.Equals)+"::text = any(string_to_array("+
+					attributeExpression(placeholder(name), attributeType)+", E'\\n'))")
+			default:
+				textEquals[name] = *filter.Equals
+			}
+		}
+
+		if filter.Contains != nil {
+			conditions = append(conditions, attributeExpression(placeholder(name), attributeType)+
+				" ilike "+placeholder("%"+escapeLike(*filter.Contains)+"%"))
+		}
+
+		if filter.Min != nil {
+			conditions = append(conditions,
+				attributeExpression(placeholder(name), attributeType)+" >= "+placeholder(*filter.Min))
+		}
+
+		if filter.Max != nil {
+			conditions = append(conditions,
+				attributeExpression(placeholder(name), attributeType)+" <= "+placeholder(*filter.Max))
+		}
+	}
+
+	if len(textEquals) != 0 {
+		textEqualsJSON, err := json.Marshal(textEquals)
+		if err != nil {
+			return "", nil, err
+		}
+
+		conditions = append(conditions, "members.additional_attributes @> "+placeholder(textEqualsJSON)+"::jsonb")
+	}
+
+	if len(conditions) == 0 {
+		return "", nil, nil
+	}
+
+	return "and " + strings.Join(conditions, "\n\t\tand "), values, nil
+}
+
 func wrapIlike(filter string) sql.Null[string] {
 	if filter == "" {
 		return sql.Null[string]{Valid: false}
@@ -254,6 +580,8 @@ func (p *Postgres) UpdateMember(
 		updateTags                bool
 		tags                      pgtype.FlatArray[string]
 		updateMemberLogin         bool
+		updateRoles               bool
+		updateBirthDate           bool
 		additionalPropertyUpdates = map[string]*string{}
 	)
 
@@ -282,6 +610,10 @@ func (p *Postgres) UpdateMember(
 			}
 		case "member_login":
 			updateMemberLogin = true
+		case "member_login.roles":
+			updateRoles = true
+		case "birth_date":
+			updateBirthDate = true
 		}
 
 		if field, ok := strings.CutPrefix(path, "additional_attributes."); ok {
@@ -295,7 +627,7 @@ func (p *Postgres) UpdateMember(
 	}
 
 	values := append(
-		make([]any, 0, 10),
+		make([]any, 0, 12),
 		member.Id,
 		name,
 		membershipStart,
@@ -304,10 +636,12 @@ func (p *Postgres) UpdateMember(
 		ageCategory,
 		updateTags,
 		tags,
+		updateBirthDate,
+		nullString(member.BirthDate),
 	)
 
 	var (
-		addPropSQL    string
+		addPropSQL    = "additional_attributes = additional_attributes"
 		addPropValues []any
 	)
 
@@ -326,6 +660,7 @@ func (p *Postgres) UpdateMember(
 			membership_end = case when $4 then $5 else membership_end end,
 			age_category = coalesce($6, age_category),
 			tags = case when $7 then $8 else tags end,
+			birth_date = case when $9 then $10::date else birth_date end,
 			`+addPropSQL+`
 		where id = $1
 	`, values...)
@@ -354,6 +689,17 @@ func (p *Postgres) UpdateMember(
 		}
 	}
 
+	if updateRoles {
+		_, err = p.db.ExecContext(ctx, `
+			update members_auth
+			set roles = coalesce($2::text[], '{}')
+			where id = $1
+		`, member.Id, pgtype.FlatArray[string](member.GetMemberLogin().GetRoles()))
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	return p.GetMember(ctx, member.Id)
 }
 
@@ -398,27 +744,43 @@ func (p *Postgres) DeleteMember(ctx context.Context, id string) error {
 	return nil
 }
 
+// memberTagUsage counts the members of each tag, it is joined with the definitions to include tags without them.
+const memberTagUsage = `
+	with usage as (
+		select tag, count(distinct members.id) as member_count
+		from members, unnest(members.tags) tag
+		group by tag
+	)
+	select coalesce(t.name, usage.tag), coalesce(t.description, ''), coalesce(t.color, ''),
+	       coalesce(usage.member_count, 0)
+	from member_tags t
+	full outer join usage on usage.tag = t.name
+`
+
+type querier interface {
+	QueryRowContext(ctx context.Context, query string, args ...any) *sql.Row
+}
+
+// ListMemberTags lists defined tags and tags used by members, ordered by name.
 func (p *Postgres) ListMemberTags(
 	ctx context.Context, pageSize int32, pageToken *pb.MemberTagsPageToken,
-) ([]string, error) {
-	rows, err := p.db.QueryContext(ctx, `
-		select distinct unnest(tags)
-		from members
+) ([]*pb.MemberTag, error) {
+	rows, err := p.db.QueryContext(ctx, memberTagUsage+`
 		order by 1
 		limit $1
-		offset $2;
-`, pageSize, pageToken.Offset)
+		offset $2
+	`, pageSize, pageToken.Offset)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
 
-	tags := make([]string, 0, pageSize)
+	tags := make([]*pb.MemberTag, 0, pageSize)
 
 	for rows.Next() {
-		var tag string
+		tag := &pb.MemberTag{}
 
-		err = rows.Scan(&tag)
+		err = rows.Scan(&tag.Name, &tag.Description, &tag.Color, &tag.MemberCount)
 		if err != nil {
 			return nil, err
 		}
@@ -433,158 +795,409 @@ func (p *Postgres) ListMemberTags(
 	return tags, nil
 }
 
-type scanner interface {
-	Scan(values ...any) error
+// GetMemberTag returns the tag if it is defined or used by members, otherwise ErrTagNotFound.
+func (p *Postgres) GetMemberTag(ctx context.Context, name string) (*pb.MemberTag, error) {
+	return getMemberTag(ctx, p.db, name)
 }
 
-func scanMember(in scanner) (*pb.Member, error) {
-	var (
-		member               = &pb.Member{}
-		membershipStart      time.Time
-		membershipEnd        sql.Null[time.Time]
-		ageCategory          string
-		username             sql.Null[string]
-		additionalProperties string
-	)
+func getMemberTag(ctx context.Context, q querier, name string) (*pb.MemberTag, error) {
+	tag := &pb.MemberTag{}
 
-	m := pgtype.NewMap()
+	err := q.QueryRowContext(ctx, memberTagUsage+`where coalesce(t.name, usage.tag) = $1`, name).
+		Scan(&tag.Name, &tag.Description, &tag.Color, &tag.MemberCount)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrTagNotFound
+	}
 
-	err := in.Scan(
-		&member.Id,
-		&member.Name,
-		&membershipStart,
-		&membershipEnd,
-		&ageCategory,
-		m.SQLScanner(&member.Tags),
-		&additionalProperties,
-		&username,
-	)
 	if err != nil {
 		return nil, err
 	}
 
-	member.MembershipStart = timestamppb.New(membershipStart)
-	member.AgeCategory = pb.AgeCategory(pb.AgeCategory_value[ageCategory])
+	return tag, nil
+}
 
-	if membershipEnd.Valid {
-		member.MembershipEnd = timestamppb.New(membershipEnd.V)
-	}
+// CreateMemberTag defines the tag, ErrTagExists is returned if it is defined already.
+func (p *Postgres) CreateMemberTag(ctx context.Context, tag *pb.MemberTag) (*pb.MemberTag, error) {
+	_, err := p.db.ExecContext(ctx, `
+		insert into member_tags (name, description, color)
+		values ($1, $2, $3)
+	`, tag.Name, tag.Description, tag.Color)
 
-	if username.Valid {
-		member.MemberLogin = &pb.MemberLogin{
-			Username: username.V,
-		}
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && pgErr.Code == uniqueViolation {
+		return nil, ErrTagExists
 	}
 
-	var additionalPropertiesMap map[string]string
-
-	err = json.Unmarshal([]byte(additionalProperties), &additionalPropertiesMap)
 	if err != nil {
 		return nil, err
 	}
 
-	member.AdditionalAttributes = additionalPropertiesMap
-
-	return member, nil
+	return p.GetMemberTag(ctx, tag.Name)
 }
 
-func (p *Postgres) CreateMemberAttribute(
-	ctx context.Context, attribute *pb.MemberAttribute,
-) (*pb.MemberAttribute, error) {
+// SaveMemberTag stores the definition of the tag, tags that are only used by members get one.
+func (p *Postgres) SaveMemberTag(ctx context.Context, tag *pb.MemberTag) (*pb.MemberTag, error) {
 	_, err := p.db.ExecContext(ctx, `
-		insert into member_attributes (id, technical_name, display_name, type, description)
-		values ($1, $2, $3, $4, $5);
-	`, attribute.GetId(), attribute.GetTechnicalName(), attribute.GetDisplayName(), attribute.GetType().String(), attribute.GetDescription())
+		insert into member_tags (name, description, color)
+		values ($1, $2, $3)
+		on conflict (name) do update set description = excluded.description, color = excluded.color
+	`, tag.Name, tag.Description, tag.Color)
 	if err != nil {
 		return nil, err
 	}
 
-	return p.GetMemberAttribute(ctx, attribute.Id)
-}
-
-func (p *Postgres) GetMemberAttribute(ctx context.Context, id string) (*pb.MemberAttribute, error) {
-	return scanMemberAttribute(p.db.QueryRowContext(
-		ctx,
-		`
-		select id, technical_name, display_name, type, description
-		from member_attributes
-		where id = $1`,
-		id,
-	))
+	return p.GetMemberTag(ctx, tag.Name)
 }
 
-func (p *Postgres) ListMemberAttributes(
-	ctx context.Context, pageSize int32, token *pb.MemberAttributePageToken, sortField pb.MemberAttributeField,
-	sortDirection pb.SortDirection,
-) ([]*pb.MemberAttribute, error) {
-	values := append(
-		make([]any, 0, 3),
-		pageSize,
-	)
-
-	paginationCondition, paginationValues := generateMemberAttributePaginationQuery(token, len(values)+1)
+// DeleteMemberTag deletes the definition of the tag and, if removeFromMembers is set, removes the tag from all
+// members. ErrTagNotFound is returned if nothing was deleted.
+func (p *Postgres) DeleteMemberTag(ctx context.Context, name string, removeFromMembers bool) error {
+	tx, err := p.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback() //nolint:errcheck // rollback after commit is a no-op
 
-	values = append(values, paginationValues...)
+	result, err := tx.ExecContext(ctx, `delete from member_tags where name = $1`, name)
+	if err != nil {
+		return err
+	}
 
-	//nolint:gosec // manual concatenation is fine here, uses bound placeholders
-	rows, err := p.db.QueryContext(ctx, `
-		select id, technical_name, display_name, type, description
-		from member_attributes
-		where 1=1 `+paginationCondition+`
-		order by `+getMemberAttributeSort(sortField, sortDirection, token)+`
-		limit $1
-	`, values...)
+	deleted, err := result.RowsAffected()
 	if err != nil {
-		return nil, err
+		return err
 	}
-	defer rows.Close()
 
-	memberAttributes := make([]*pb.MemberAttribute, 0, pageSize)
+	if removeFromMembers {
+		result, err = tx.ExecContext(ctx, `
+			update members
+			set tags = array_remove(tags, $1)
+			where tags @> array[$1]
+		`, name)
+		if err != nil {
+			return err
+		}
 
-	for rows.Next() {
-		attribute, err := scanMemberAttribute(rows)
+		removed, err := result.RowsAffected()
 		if err != nil {
-			return nil, err
+			return err
 		}
 
-		memberAttributes = append(memberAttributes, attribute)
+		deleted += removed
 	}
 
-	if err := rows.Err(); err != nil {
-		return nil, err
+	if deleted == 0 {
+		return ErrTagNotFound
 	}
 
-	return memberAttributes, nil
+	return tx.Commit()
 }
 
-func generateMemberAttributePaginationQuery(token *pb.MemberAttributePageToken, offset int) (string, []any) {
-	fields := make([]string, 0, 2)
-	values := make([]any, 0, 2)
-	placeholders := make([]string, 0, 2)
-
-	fieldName, ok := membershipAttributeFields[token.Field]
-	if !ok {
-		return "", nil
+// RenameMemberTag renames the tag on all members and its definition at once. ErrTagExists is returned if the new name
+// is defined or used already, as the tags would be merged then.
+func (p *Postgres) RenameMemberTag(ctx context.Context, name, newName string) (*pb.MemberTag, error) {
+	tx, err := p.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
 	}
+	defer tx.Rollback() //nolint:errcheck // rollback after commit is a no-op
 
-	fields = append(fields, fieldName)
-	values = append(values, token.LastValue)
+	err = lockMemberTags(ctx, tx)
+	if err != nil {
+		return nil, err
+	}
 
-	if token.Field != pb.MemberAttributeField_MEMBER_ATTRIBUTE_FIELD_ID {
-		fields = append(fields, membershipAttributeFields[pb.MemberAttributeField_MEMBER_ATTRIBUTE_FIELD_ID])
-		values = append(values, token.LastId)
+	_, err = getMemberTag(ctx, tx, newName)
+	if err == nil {
+		return nil, ErrTagExists
 	}
 
-	for i := range len(fields) {
-		placeholders = append(placeholders, fmt.Sprintf("$%d", i+offset))
+	if !errors.Is(err, ErrTagNotFound) {
+		return nil, err
 	}
 
-	sort := ">"
-	if token.Direction == pb.SortDirection_SORT_DIRECTION_DESCENDING {
-		sort = "<"
+	_, err = getMemberTag(ctx, tx, name)
+	if err != nil {
+		return nil, err
 	}
 
-	sqlCondition := fmt.Sprintf("and (%s) %s (%s)", strings.Join(fields, ","), sort, strings.Join(placeholders, ","))
+	_, err = tx.ExecContext(ctx, `update member_tags set name = $2 where name = $1`, name, newName)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = tx.ExecContext(ctx, `
+		update members
+		set tags = array_replace(tags, $1, $2)
+		where tags @> array[$1]
+	`, name, newName)
+	if err != nil {
+		return nil, err
+	}
+
+	tag, err := getMemberTag(ctx, tx, newName)
+	if err != nil {
+		return nil, err
+	}
+
+	return tag, tx.Commit()
+}
+
+// MergeMemberTags replaces the source tags by the tag on all members at once, members keep the tag only once at its
+// first position. The tag takes the definition of the first defined source tag if it has none, the definitions of the
+// source tags are deleted. ErrTagNotFound is returned if a source tag is neither defined nor used.
+func (p *Postgres) MergeMemberTags(ctx context.Context, name string, sourceNames []string) (*pb.MemberTag, error) {
+	tx, err := p.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback() //nolint:errcheck // rollback after commit is a no-op
+
+	err = lockMemberTags(ctx, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, sourceName := range sourceNames {
+		_, err = getMemberTag(ctx, tx, sourceName)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	_, err = tx.ExecContext(ctx, `
+		update members
+		set tags = array(
+			select tag
+			from (
+				select case when t.tag = any($2) then $1 else t.tag end as tag, t.position
+				from unnest(members.tags) with ordinality t(tag, position)
+			) replaced
+			group by tag
+			order by min(position)
+		)
+		where tags && $2
+	`, name, pgtype.FlatArray[string](sourceNames))
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = tx.ExecContext(ctx, `
+		insert into member_tags (name, description, color)
+		select $1::text, description, color
+		from member_tags
+		where name = any($2::text[])
+		order by array_position($2::text[], name)
+		limit 1
+		on conflict (name) do nothing
+	`, name, pgtype.FlatArray[string](sourceNames))
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = tx.ExecContext(ctx, `delete from member_tags where name = any($1)`, pgtype.FlatArray[string](sourceNames))
+	if err != nil {
+		return nil, err
+	}
+
+	tag, err := getMemberTag(ctx, tx, name)
+	if err != nil {
+		return nil, err
+	}
+
+	return tag, tx.Commit()
+}
+
+// lockMemberTags serializes renames and merges, so that two of them can't both take the same new name.
+func lockMemberTags(ctx context.Context, tx *sql.Tx) error {
+	_, err := tx.ExecContext(ctx, `lock table member_tags in share row exclusive mode`)
+
+	return err
+}
+
+type scanner interface {
+	Scan(values ...any) error
+}
+
+// scanMember scans the member columns, extra receives additional columns selected after them.
+func scanMember(in scanner, extra ...any) (*pb.Member, error) {
+	var (
+		member               = &pb.Member{}
+		membershipStart      time.Time
+		membershipEnd        sql.Null[time.Time]
+		ageCategory          string
+		username             sql.Null[string]
+		roles                pgtype.FlatArray[string]
+		birthDate            sql.Null[time.Time]
+		additionalProperties string
+	)
+
+	m := pgtype.NewMap()
+
+	err := in.Scan(append([]any{
+		&member.Id,
+		&member.Name,
+		&membershipStart,
+		&membershipEnd,
+		&ageCategory,
+		m.SQLScanner(&member.Tags),
+		&additionalProperties,
+		&username,
+		&roles,
+		&birthDate,
+	}, extra...)...)
+	if err != nil {
+		return nil, err
+	}
+
+	member.MembershipStart = timestamppb.New(membershipStart)
+	member.AgeCategory = pb.AgeCategory(pb.AgeCategory_value[ageCategory])
+
+	if membershipEnd.Valid {
+		member.MembershipEnd = timestamppb.New(membershipEnd.V)
+	}
+
+	if birthDate.Valid {
+		member.BirthDate = proto.String(birthDate.V.Format(time.DateOnly))
+	}
+
+	if username.Valid {
+		member.MemberLogin = &pb.MemberLogin{
+			Username: username.V,
+			Roles:    roles,
+		}
+	}
+
+	var additionalPropertiesMap map[string]string
+
+	err = json.Unmarshal([]byte(additionalProperties), &additionalPropertiesMap)
+	if err != nil {
+		return nil, err
+	}
+
+	member.AdditionalAttributes = additionalPropertiesMap
+
+	return member, nil
+}
+
+func (p *Postgres) CreateMemberAttribute(
+	ctx context.Context, attribute *pb.MemberAttribute,
+) (*pb.MemberAttribute, error) {
+	_, err := p.db.ExecContext(ctx, `
+		insert into member_attributes (
+			id, technical_name, display_name, type, description, options, is_required, is_unique, pattern, min_value,
+			max_value, sensitivity, read_roles, write_roles
+		)
+		values (
+			$1, $2, $3, $4, $5, coalesce($6::text[], '{}'), $7, $8, $9, $10, $11, $12, coalesce($13::text[], '{}'),
+			coalesce($14::text[], '{}')
+		);
+	`, attribute.GetId(), attribute.GetTechnicalName(), attribute.GetDisplayName(), attribute.GetType().String(),
+		attribute.GetDescription(), pgtype.FlatArray[string](attribute.GetOptions()), attribute.GetRequired(),
+		attribute.GetUnique(), attribute.GetPattern(), nullString(attribute.Min), nullString(attribute.Max),
+		attribute.GetSensitivity().String(), pgtype.FlatArray[string](attribute.GetReadRoles()),
+		pgtype.FlatArray[string](attribute.GetWriteRoles()))
+	if err != nil {
+		return nil, err
+	}
+
+	return p.GetMemberAttribute(ctx, attribute.Id)
+}
+
+func (p *Postgres) GetMemberAttribute(ctx context.Context, id string) (*pb.MemberAttribute, error) {
+	attribute, err := scanMemberAttribute(p.db.QueryRowContext(
+		ctx,
+		`
+		select id, technical_name, display_name, type, description, options, is_required, is_unique, pattern,
+		       min_value, max_value, sensitivity, read_roles, write_roles
+		from member_attributes
+		where id = $1`,
+		id,
+	))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrNotFound
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return attribute, nil
+}
+
+func (p *Postgres) ListMemberAttributes(
+	ctx context.Context, pageSize int32, token *pb.MemberAttributePageToken, sortField pb.MemberAttributeField,
+	sortDirection pb.SortDirection,
+) ([]*pb.MemberAttribute, error) {
+	values := append(
+		make([]any, 0, 3),
+		pageSize,
+	)
+
+	paginationCondition, paginationValues := generateMemberAttributePaginationQuery(token, len(values)+1)
+
+	values = append(values, paginationValues...)
+
+	//nolint:gosec // manual concatenation is fine here, uses bound placeholders
+	rows, err := p.db.QueryContext(ctx, `
+		select id, technical_name, display_name, type, description, options, is_required, is_unique, pattern,
+		       min_value, max_value, sensitivity, read_roles, write_roles
+		from member_attributes
+		where 1=1 `+paginationCondition+`
+		order by `+getMemberAttributeSort(sortField, sortDirection, token)+`
+		limit $1
+	`, values...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	memberAttributes := make([]*pb.MemberAttribute, 0, pageSize)
+
+	for rows.Next() {
+		attribute, err := scanMemberAttribute(rows)
+		if err != nil {
+			return nil, err
+		}
+
+		memberAttributes = append(memberAttributes, attribute)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return memberAttributes, nil
+}
+
+func generateMemberAttributePaginationQuery(token *pb.MemberAttributePageToken, offset int) (string, []any) {
+	fields := make([]string, 0, 2)
+	values := make([]any, 0, 2)
+	placeholders := make([]string, 0, 2)
+
+	fieldName, ok := membershipAttributeFields[token.Field]
+	if !ok {
+		return "", nil
+	}
+
+	fields = append(fields, fieldName)
+	values = append(values, token.LastValue)
+
+	if token.Field != pb.MemberAttributeField_MEMBER_ATTRIBUTE_FIELD_ID {
+		fields = append(fields, membershipAttributeFields[pb.MemberAttributeField_MEMBER_ATTRIBUTE_FIELD_ID])
+		values = append(values, token.LastId)
+	}
+
+	for i := range len(fields) {
+		placeholders = append(placeholders, fmt.Sprintf("$%d", i+offset))
+	}
+
+	sort := ">"
+	if token.Direction == pb.SortDirection_SORT_DIRECTION_DESCENDING {
+		sort = "<"
+	}
+
+	sqlCondition := fmt.Sprintf("and (%s) %s (%s)", strings.Join(fields, ","), sort, strings.Join(placeholders, ","))
 
 	return sqlCondition, values
 }
@@ -614,6 +1227,12 @@ func scanMemberAttribute(in scanner) (*pb.MemberAttribute, error) {
 	var (
 		attribute     = &pb.MemberAttribute{}
 		attributeType string
+		options       pgtype.FlatArray[string]
+		minValue      sql.Null[string]
+		maxValue      sql.Null[string]
+		sensitivity   string
+		readRoles     pgtype.FlatArray[string]
+		writeRoles    pgtype.FlatArray[string]
 	)
 
 	err := in.Scan(
@@ -622,12 +1241,33 @@ func scanMemberAttribute(in scanner) (*pb.MemberAttribute, error) {
 		&attribute.DisplayName,
 		&attributeType,
 		&attribute.Description,
+		&options,
+		&attribute.Required,
+		&attribute.Unique,
+		&attribute.Pattern,
+		&minValue,
+		&maxValue,
+		&sensitivity,
+		&readRoles,
+		&writeRoles,
 	)
 	if err != nil {
 		return nil, err
 	}
 
 	attribute.Type = pb.MemberAttribute_Type(pb.MemberAttribute_Type_value[attributeType])
+	attribute.Options = options
+	attribute.Sensitivity = pb.MemberAttribute_Sensitivity(pb.MemberAttribute_Sensitivity_value[sensitivity])
+	attribute.ReadRoles = readRoles
+	attribute.WriteRoles = writeRoles
+
+	if minValue.Valid {
+		attribute.Min = &minValue.V
+	}
+
+	if maxValue.Valid {
+		attribute.Max = &maxValue.V
+	}
 
 	return attribute, nil
 }
@@ -636,8 +1276,18 @@ func (p *Postgres) UpdateMemberAttribute(
 	ctx context.Context, attribute *pb.MemberAttribute, fieldMask *fieldmaskpb.FieldMask,
 ) (*pb.MemberAttribute, error) {
 	var (
-		displayName sql.Null[string]
-		description sql.Null[string]
+		displayName   sql.Null[string]
+		description   sql.Null[string]
+		updateOptions bool
+		options       pgtype.FlatArray[string]
+		required      sql.Null[bool]
+		unique        sql.Null[bool]
+		pattern       sql.Null[string]
+		updateMin     bool
+		updateMax     bool
+		sensitivity   sql.Null[string]
+		readRoles     pgtype.FlatArray[string]
+		writeRoles    pgtype.FlatArray[string]
 	)
 
 	for _, path := range fieldMask.Paths {
@@ -646,6 +1296,35 @@ func (p *Postgres) UpdateMemberAttribute(
 			displayName = sql.Null[string]{V: attribute.DisplayName, Valid: true}
 		case "description":
 			description = sql.Null[string]{V: attribute.Description, Valid: attribute.Description != ""}
+		case "options":
+			updateOptions = true
+
+			options = attribute.Options
+			if options == nil {
+				options = pgtype.FlatArray[string]{}
+			}
+		case "required":
+			required = sql.Null[bool]{V: attribute.Required, Valid: true}
+		case "unique":
+			unique = sql.Null[bool]{V: attribute.Unique, Valid: true}
+		case "pattern":
+			pattern = sql.Null[string]{V: attribute.Pattern, Valid: true}
+		case "min":
+			updateMin = true
+		case "max":
+			updateMax = true
+		case "sensitivity":
+			sensitivity = sql.Null[string]{V: attribute.Sensitivity.String(), Valid: true}
+		case "read_roles":
+			readRoles = attribute.ReadRoles
+			if readRoles == nil {
+				readRoles = pgtype.FlatArray[string]{}
+			}
+		case "write_roles":
+			writeRoles = attribute.WriteRoles
+			if writeRoles == nil {
+				writeRoles = pgtype.FlatArray[string]{}
+			}
 		}
 	}
 
@@ -653,9 +1332,19 @@ func (p *Postgres) UpdateMemberAttribute(
 		update member_attributes
 		set
 			display_name = coalesce($1, display_name),
-			description = coalesce($2, description)
+			description = coalesce($2, description),
+			options = case when $4 then $5 else options end,
+			is_required = coalesce($6, is_required),
+			is_unique = coalesce($7, is_unique),
+			pattern = coalesce($8, pattern),
+			min_value = case when $9 then $10 else min_value end,
+			max_value = case when $11 then $12 else max_value end,
+			sensitivity = coalesce($13, sensitivity),
+			read_roles = coalesce($14, read_roles),
+			write_roles = coalesce($15, write_roles)
 		where id = $3
-	`, displayName, description, attribute.Id)
+	`, displayName, description, attribute.Id, updateOptions, options, required, unique, pattern,
+		updateMin, nullString(attribute.Min), updateMax, nullString(attribute.Max), sensitivity, readRoles, writeRoles)
 	if err != nil {
 		return nil, err
 	}
@@ -663,7 +1352,738 @@ func (p *Postgres) UpdateMemberAttribute(
 	return p.GetMemberAttribute(ctx, attribute.Id)
 }
 
-func (p *Postgres) DeleteMemberAttribute(ctx context.Context, id string) error {
-	_, err := p.db.ExecContext(ctx, `delete from member_attributes where id = $1`, id)
-	return err
+// DeleteMemberAttribute deletes the attribute. If purgeValues is set, the values of the attribute are removed from all
+// members as well.
+func (p *Postgres) DeleteMemberAttribute(ctx context.Context, id string, purgeValues bool) error {
+	tx, err := p.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback() //nolint:errcheck // rollback after commit is a no-op
+
+	var technicalName string
+
+	err = tx.QueryRowContext(ctx, `
+		delete from member_attributes
+		where id = $1
+		returning technical_name
+	`, id).Scan(&technicalName)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil
+	}
+
+	if err != nil {
+		return err
+	}
+
+	if purgeValues {
+		_, err = tx.ExecContext(ctx, `
+			update members
+			set additional_attributes = additional_attributes - $1::text
+			where additional_attributes ? $1::text
+		`, technicalName)
+		if err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+// MigrateMemberAttribute replaces the definition of the attribute with target and converts the values of all members.
+// The migration is only applied if every value can be converted or dropInvalid is set, and never in a dry run.
+// existing must be the current definition, ErrAttributeChanged is returned if it was changed in the meantime.
+func (p *Postgres) MigrateMemberAttribute(
+	ctx context.Context, existing, target *pb.MemberAttribute, dropInvalid, dryRun bool,
+) (*pb.MigrateMemberAttributeResponse, error) {
+	tx, err := p.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback() //nolint:errcheck // rollback after commit is a no-op
+
+	var locked bool
+
+	err = tx.QueryRowContext(ctx, `
+		select true
+		from member_attributes
+		where id = $1 and technical_name = $2 and type = $3 and options = coalesce($4::text[], '{}')
+		for update
+	`, existing.Id, existing.TechnicalName, existing.Type.String(),
+		pgtype.FlatArray[string](existing.Options),
+	).Scan(&locked)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrAttributeChanged
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := tx.QueryContext(ctx, `
+		select id, name, additional_attributes ->> $1::text
+		from members
+		where additional_attributes ? $1::text
+		order by name, id
+		for update
+	`, existing.TechnicalName)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var (
+		response = &pb.MigrateMemberAttributeResponse{Attribute: target}
+		ids      []string
+		values   []string
+		keep     []bool
+		seen     = map[string]bool{}
+	)
+
+	for rows.Next() {
+		var memberID, memberName, value string
+
+		err := rows.Scan(&memberID, &memberName, &value)
+		if err != nil {
+			return nil, err
+		}
+
+		converted, description := convertAttributeValue(existing, target, value)
+		if description == "" && target.Unique && converted != "" {
+			if seen[converted] {
+				description = fmt.Sprintf("%q is not unique after the conversion", converted)
+			}
+
+			seen[converted] = true
+		}
+
+		if description != "" {
+			response.InvalidValues = append(response.InvalidValues, &pb.MemberAttributeMigrationIssue{
+				MemberId:    memberID,
+				MemberName:  memberName,
+				Value:       value,
+				Description: description,
+			})
+		}
+
+		ids = append(ids, memberID)
+		values = append(values, converted)
+		keep = append(keep, description == "")
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	response.MemberCount = int32(len(ids)) //nolint:gosec // bounded by the number of members
+
+	if dryRun || (len(response.InvalidValues) != 0 && !dropInvalid) {
+		return response, nil
+	}
+
+	_, err = tx.ExecContext(ctx, `
+		update member_attributes
+		set
+			technical_name = $2,
+			type = $3,
+			options = coalesce($4::text[], '{}'),
+			pattern = $5,
+			min_value = $6,
+			max_value = $7
+		where id = $1
+	`, target.Id, target.TechnicalName, target.Type.String(), pgtype.FlatArray[string](target.Options),
+		target.Pattern, nullString(target.Min), nullString(target.Max))
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = tx.ExecContext(ctx, `
+		update members
+		set additional_attributes = (members.additional_attributes - $1::text) ||
+			case when converted.keep then jsonb_build_object($2::text, converted.value) else '{}'::jsonb end
+		from unnest($3::text[], $4::text[], $5::boolean[]) as converted(id, value, keep)
+		where members.id = converted.id::uuid
+	`, existing.TechnicalName, target.TechnicalName, pgtype.FlatArray[string](ids),
+		pgtype.FlatArray[string](values), pgtype.FlatArray[bool](keep))
+	if err != nil {
+		return nil, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, err
+	}
+
+	response.Applied = true
+
+	return response, nil
+}
+
+// ExistingAttributeValues returns the values that members other than excludedMemberID already have for the attribute.
+func (p *Postgres) ExistingAttributeValues(
+	ctx context.Context, attribute string, values []string, excludedMemberID string,
+) ([]string, error) {
+	rows, err := p.db.QueryContext(ctx, `
+		select distinct additional_attributes ->> $1::text
+		from members
+		where additional_attributes ->> $1::text = any($2::text[])
+		  and id::text <> $3
+	`, attribute, pgtype.FlatArray[string](values), excludedMemberID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var existing []string
+
+	for rows.Next() {
+		var value string
+
+		err := rows.Scan(&value)
+		if err != nil {
+			return nil, err
+		}
+
+		existing = append(existing, value)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return existing, nil
+}
+
+// HasDuplicateAttributeValues checks whether two members have the same non-empty value for the attribute.
+func (p *Postgres) HasDuplicateAttributeValues(ctx context.Context, attribute string) (bool, error) {
+	var duplicates bool
+
+	err := p.db.QueryRowContext(ctx, `
+		select exists(
+			select 1
+			from members
+			where additional_attributes ->> $1::text <> ''
+			group by additional_attributes ->> $1::text
+			having count(*) > 1
+		)
+	`, attribute).Scan(&duplicates)
+	if err != nil {
+		return false, err
+	}
+
+	return duplicates, nil
+}
+
+func nullString(value *string) sql.Null[string] {
+	if value == nil {
+		return sql.Null[string]{}
+	}
+
+	return sql.Null[string]{V: *value, Valid: true}
+}
+
+// UpdateAgeCategories derives the age category of all members with a known birth date, members born on or before
+// adultBirthDate are adults. It returns the members whose category changed.
+func (p *Postgres) UpdateAgeCategories(ctx context.Context, adultBirthDate time.Time) ([]*pb.Member, error) {
+	rows, err := p.db.QueryContext(ctx, `
+		with derived as (
+			select id,
+			       case when birth_date <= $1::date
+			           then 'AGE_CATEGORY_ADULT'
+			           else 'AGE_CATEGORY_UNDERAGE'
+			       end as age_category
+			from members
+			where birth_date is not null
+		)
+		update members
+		set age_category = derived.age_category
+		from derived
+		where members.id = derived.id and members.age_category <> derived.age_category
+		returning members.id, members.name, members.age_category, members.birth_date
+	`, adultBirthDate.Format(time.DateOnly))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var changed []*pb.Member
+
+	for rows.Next() {
+		var (
+			member      = &pb.Member{}
+			ageCategory string
+			birthDate   time.Time
+		)
+
+		err = rows.Scan(&member.Id, &member.Name, &ageCategory, &birthDate)
+		if err != nil {
+			return nil, err
+		}
+
+		member.AgeCategory = pb.AgeCategory(pb.AgeCategory_value[ageCategory])
+		member.BirthDate = proto.String(birthDate.Format(time.DateOnly))
+
+		changed = append(changed, member)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return changed, nil
+}
+
+func mapConstraintError(err error) error {
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && pgErr.Code == foreignKeyViolation {
+		return ErrReferenceNotFound
+	}
+
+	return err
+}
+
+// selectRelationship takes the name of related members from the members table, so that it stays up to date.
+const selectRelationship = `
+	select r.id, r.member_id, r.type, r.related_member_id, coalesce(related.name, r.name), r.email, r.phone,
+	       r.description
+	from member_relationships r
+	left join members related on related.id = r.related_member_id
+`
+
+func insertRelationship(ctx context.Context, db execer, relationship *pb.MemberRelationship) error {
+	_, err := db.ExecContext(ctx, `
+		insert into member_relationships (id, member_id, type, related_member_id, name, email, phone, description)
+		values ($1, $2, $3, $4, $5, $6, $7, $8)
+	`, relationship.Id, relationship.MemberId, relationship.Type.String(), nullString(relationship.RelatedMemberId),
+		relationship.Name, relationship.Email, relationship.Phone, relationship.Description)
+
+	return err
+}
+
+func (p *Postgres) CreateRelationship(
+	ctx context.Context, relationship *pb.MemberRelationship,
+) (*pb.MemberRelationship, error) {
+	err := insertRelationship(ctx, p.db, relationship)
+	if err != nil {
+		return nil, mapConstraintError(err)
+	}
+
+	return p.GetRelationship(ctx, relationship.MemberId, relationship.Id)
+}
+
+func (p *Postgres) GetRelationship(ctx context.Context, memberID, id string) (*pb.MemberRelationship, error) {
+	row := p.db.QueryRowContext(ctx, selectRelationship+`where r.member_id = $1 and r.id = $2`, memberID, id)
+
+	relationship, err := scanRelationship(row)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrNotFound
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return relationship, nil
+}
+
+func (p *Postgres) ListRelationships(ctx context.Context, memberID string) ([]*pb.MemberRelationship, error) {
+	rows, err := p.db.QueryContext(ctx, selectRelationship+`where r.member_id = $1 order by r.type, 5`, memberID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var relationships []*pb.MemberRelationship
+
+	for rows.Next() {
+		relationship, err := scanRelationship(rows)
+		if err != nil {
+			return nil, err
+		}
+
+		relationships = append(relationships, relationship)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return relationships, nil
+}
+
+func (p *Postgres) UpdateRelationship(
+	ctx context.Context, relationship *pb.MemberRelationship, fieldMask *fieldmaskpb.FieldMask,
+) (*pb.MemberRelationship, error) {
+	var (
+		relationshipType    sql.Null[string]
+		updateRelatedMember bool
+		name                sql.Null[string]
+		email               sql.Null[string]
+		phone               sql.Null[string]
+		description         sql.Null[string]
+	)
+
+	for _, path := range fieldMask.Paths {
+		switch path {
+		case "type":
+			relationshipType = sql.Null[string]{V: relationship.Type.String(), Valid: true}
+		case "related_member_id":
+			updateRelatedMember = true
+		case "name":
+			name = sql.Null[string]{V: relationship.Name, Valid: true}
+		case "email":
+			email = sql.Null[string]{V: relationship.Email, Valid: true}
+		case "phone":
+			phone = sql.Null[string]{V: relationship.Phone, Valid: true}
+		case "description":
+			description = sql.Null[string]{V: relationship.Description, Valid: true}
+		}
+	}
+
+	result, err := p.db.ExecContext(ctx, `
+		update member_relationships
+		set
+			type = coalesce($3, type),
+			related_member_id = case when $4 then $5::uuid else related_member_id end,
+			name = coalesce($6, name),
+			email = coalesce($7, email),
+			phone = coalesce($8, phone),
+			description = coalesce($9, description)
+		where member_id = $1 and id = $2
+	`, relationship.MemberId, relationship.Id, relationshipType, updateRelatedMember,
+		nullString(relationship.RelatedMemberId), name, email, phone, description)
+	if err != nil {
+		return nil, mapConstraintError(err)
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return nil, err
+	}
+
+	if affected == 0 {
+		return nil, ErrNotFound
+	}
+
+	return p.GetRelationship(ctx, relationship.MemberId, relationship.Id)
+}
+
+func (p *Postgres) DeleteRelationship(ctx context.Context, memberID, id string) error {
+	result, err := p.db.ExecContext(ctx, `
+		delete from member_relationships
+		where member_id = $1 and id = $2
+	`, memberID, id)
+	if err != nil {
+		return err
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if affected == 0 {
+		return ErrNotFound
+	}
+
+	return nil
+}
+
+func scanRelationship(in scanner) (*pb.MemberRelationship, error) {
+	var (
+		relationship     = &pb.MemberRelationship{}
+		relationshipType string
+		relatedMemberID  sql.Null[string]
+	)
+
+	err := in.Scan(
+		&relationship.Id, &relationship.MemberId, &relationshipType, &relatedMemberID, &relationship.Name,
+		&relationship.Email, &relationship.Phone, &relationship.Description,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	relationship.Type = pb.MemberRelationship_Type(pb.MemberRelationship_Type_value[relationshipType])
+
+	if relatedMemberID.Valid {
+		relationship.RelatedMemberId = &relatedMemberID.V
+	}
+
+	return relationship, nil
+}
+
+const selectConsent = `
+	select id, member_id, purpose, relationship_id, given_by, consent_time, revoke_time
+	from member_consents
+`
+
+// CreateConsent records the consent, given_by is taken from the guardian or the member at the time of the consent.
+func (p *Postgres) CreateConsent(ctx context.Context, consent *pb.MemberConsent) (*pb.MemberConsent, error) {
+	result, err := p.db.ExecContext(ctx, `
+		insert into member_consents (id, member_id, purpose, relationship_id, given_by, consent_time)
+		select $1::uuid, members.id, $3::text, r.id, coalesce(related.name, r.name, members.name), $5::timestamptz
+		from members
+		left join member_relationships r on r.id = $4 and r.member_id = members.id
+		left join members related on related.id = r.related_member_id
+		where members.id = $2 and ($4::uuid is null or r.id is not null)
+	`, consent.Id, consent.MemberId, consent.Purpose, nullString(consent.RelationshipId),
+		consent.ConsentTime.AsTime())
+	if err != nil {
+		return nil, err
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return nil, err
+	}
+
+	if affected == 0 {
+		return nil, ErrReferenceNotFound
+	}
+
+	return p.GetConsent(ctx, consent.MemberId, consent.Id)
+}
+
+func (p *Postgres) GetConsent(ctx context.Context, memberID, id string) (*pb.MemberConsent, error) {
+	row := p.db.QueryRowContext(ctx, selectConsent+`where member_id = $1 and id = $2`, memberID, id)
+
+	consent, err := scanConsent(row)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrNotFound
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return consent, nil
+}
+
+func (p *Postgres) ListConsents(ctx context.Context, memberID string) ([]*pb.MemberConsent, error) {
+	rows, err := p.db.QueryContext(ctx, selectConsent+`where member_id = $1 order by consent_time desc, id`, memberID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var consents []*pb.MemberConsent
+
+	for rows.Next() {
+		consent, err := scanConsent(rows)
+		if err != nil {
+			return nil, err
+		}
+
+		consents = append(consents, consent)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return consents, nil
+}
+
+// RevokeConsent sets the revoke time of the consent, ErrConsentRevoked is returned if it was revoked before.
+func (p *Postgres) RevokeConsent(
+	ctx context.Context, memberID, id string, revokeTime time.Time,
+) (*pb.MemberConsent, error) {
+	consent, err := scanConsent(p.db.QueryRowContext(ctx, `
+		update member_consents
+		set revoke_time = $3
+		where member_id = $1 and id = $2 and revoke_time is null
+		returning id, member_id, purpose, relationship_id, given_by, consent_time, revoke_time
+	`, memberID, id, revokeTime))
+	if !errors.Is(err, sql.ErrNoRows) {
+		return consent, err
+	}
+
+	_, err = p.GetConsent(ctx, memberID, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return nil, ErrConsentRevoked
+}
+
+func scanConsent(in scanner) (*pb.MemberConsent, error) {
+	var (
+		consent        = &pb.MemberConsent{}
+		relationshipID sql.Null[string]
+		consentTime    time.Time
+		revokeTime     sql.Null[time.Time]
+	)
+
+	err := in.Scan(
+		&consent.Id, &consent.MemberId, &consent.Purpose, &relationshipID, &consent.GivenBy, &consentTime, &revokeTime,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	consent.ConsentTime = timestamppb.New(consentTime)
+
+	if relationshipID.Valid {
+		consent.RelationshipId = &relationshipID.V
+	}
+
+	if revokeTime.Valid {
+		consent.RevokeTime = timestamppb.New(revokeTime.V)
+	}
+
+	return consent, nil
+}
+
+const selectChangeRequest = `
+	select id, member_id, member, field_mask, state, create_time, requested_by, decide_time, decided_by, comment
+	from member_change_requests
+`
+
+// CreateChangeRequest stores the requested change as pending.
+func (p *Postgres) CreateChangeRequest(
+	ctx context.Context, changeRequest *pb.MemberChangeRequest,
+) (*pb.MemberChangeRequest, error) {
+	member, err := protojson.Marshal(changeRequest.Member)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = p.db.ExecContext(ctx, `
+		insert into member_change_requests (id, member_id, member, field_mask, requested_by)
+		values ($1, $2, $3::jsonb, $4, $5)
+	`, changeRequest.Id, changeRequest.MemberId, member, pgtype.FlatArray[string](changeRequest.FieldMask.Paths),
+		changeRequest.RequestedBy)
+	if err != nil {
+		return nil, mapConstraintError(err)
+	}
+
+	return p.GetChangeRequest(ctx, changeRequest.Id)
+}
+
+func (p *Postgres) GetChangeRequest(ctx context.Context, id string) (*pb.MemberChangeRequest, error) {
+	changeRequest, err := scanChangeRequest(p.db.QueryRowContext(ctx, selectChangeRequest+`where id = $1`, id))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrNotFound
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return changeRequest, nil
+}
+
+// ListChangeRequests lists the change requests in the state, or in all states if it is unknown. They are ordered by
+// their creation, the latest first if newestFirst is set.
+func (p *Postgres) ListChangeRequests(
+	ctx context.Context, pageSize int32, pageToken *pb.MemberChangeRequestPageToken,
+	state pb.MemberChangeRequest_State, memberID string, newestFirst bool,
+) ([]*pb.MemberChangeRequest, error) {
+	var (
+		stateEquals    = sql.Null[string]{V: state.String(), Valid: state != pb.MemberChangeRequest_STATE_UNKNOWN}
+		memberIDEquals = sql.Null[string]{V: memberID, Valid: memberID != ""}
+		direction      = "asc"
+	)
+
+	if newestFirst {
+		direction = "desc"
+	}
+
+	//nolint:gosec // manual concatenation is fine here, uses bound placeholders
+	rows, err := p.db.QueryContext(ctx, selectChangeRequest+`
+		where ($1::text is null or state = $1) and ($2::uuid is null or member_id = $2)
+		order by create_time `+direction+`, id `+direction+`
+		limit $3
+		offset $4
+	`, stateEquals, memberIDEquals, pageSize, pageToken.Offset)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var changeRequests []*pb.MemberChangeRequest
+
+	for rows.Next() {
+		changeRequest, err := scanChangeRequest(rows)
+		if err != nil {
+			return nil, err
+		}
+
+		changeRequests = append(changeRequests, changeRequest)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return changeRequests, nil
+}
+
+// DecideChangeRequest approves or rejects the pending change request, ErrNotPending is returned if it was decided
+// before.
+func (p *Postgres) DecideChangeRequest(
+	ctx context.Context, id string, state pb.MemberChangeRequest_State, decidedBy, comment string,
+) (*pb.MemberChangeRequest, error) {
+	changeRequest, err := scanChangeRequest(p.db.QueryRowContext(ctx, `
+		update member_change_requests
+		set state = $2, decide_time = now(), decided_by = $3, comment = $4
+		where id = $1 and state = 'STATE_PENDING'
+		returning id, member_id, member, field_mask, state, create_time, requested_by, decide_time, decided_by, comment
+	`, id, state.String(), decidedBy, comment))
+	if !errors.Is(err, sql.ErrNoRows) {
+		return changeRequest, err
+	}
+
+	_, err = p.GetChangeRequest(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return nil, ErrNotPending
+}
+
+// ReopenChangeRequest makes an approved change request pending again, it is used when applying the change failed.
+func (p *Postgres) ReopenChangeRequest(ctx context.Context, id string) error {
+	_, err := p.db.ExecContext(ctx, `
+		update member_change_requests
+		set state = 'STATE_PENDING', decide_time = null, decided_by = '', comment = ''
+		where id = $1 and state = 'STATE_APPROVED'
+	`, id)
+
+	return err
+}
+
+func scanChangeRequest(in scanner) (*pb.MemberChangeRequest, error) {
+	var (
+		changeRequest = &pb.MemberChangeRequest{Member: &pb.Member{}}
+		member        []byte
+		fieldMask     pgtype.FlatArray[string]
+		state         string
+		createTime    time.Time
+		decideTime    sql.Null[time.Time]
+	)
+
+	err := in.Scan(
+		&changeRequest.Id, &changeRequest.MemberId, &member, &fieldMask, &state, &createTime,
+		&changeRequest.RequestedBy, &decideTime, &changeRequest.DecidedBy, &changeRequest.Comment,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	err = protojson.Unmarshal(member, changeRequest.Member)
+	if err != nil {
+		return nil, err
+	}
+
+	changeRequest.FieldMask = &fieldmaskpb.FieldMask{Paths: fieldMask}
+	changeRequest.State = pb.MemberChangeRequest_State(pb.MemberChangeRequest_State_value[state])
+	changeRequest.CreateTime = timestamppb.New(createTime)
+
+	if decideTime.Valid {
+		changeRequest.DecideTime = timestamppb.New(decideTime.V)
+	}
+
+	return changeRequest, nil
 }
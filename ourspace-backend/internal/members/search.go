@@ -0,0 +1,116 @@
+package members
+
+import (
+	"context"
+	"encoding/base64"
+	"slices"
+	"strings"
+	"unicode/utf8"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/protobuf/proto"
+
+	pb "github.com/cfhn/our-space/ourspace-backend/proto"
+	"github.com/cfhn/our-space/pkg/status"
+)
+
+const maxSearchQueryLength = 256
+
+//nolint:gochecknoglobals // constant lookup slice
+var searchableAttributeTypes = []pb.MemberAttribute_Type{
+	pb.MemberAttribute_TYPE_TEXT_SINGLE_LINE,
+	pb.MemberAttribute_TYPE_TEXT_MULI_LINE,
+	pb.MemberAttribute_TYPE_SELECT,
+	pb.MemberAttribute_TYPE_MULTI_SELECT,
+	pb.MemberAttribute_TYPE_EMAIL,
+	pb.MemberAttribute_TYPE_PHONE,
+	pb.MemberAttribute_TYPE_URL,
+}
+
+// SearchMembers returns the members matching the query, the best matches first. Only text-like attributes are searched.
+func (s Service) SearchMembers(
+	ctx context.Context, request *pb.SearchMembersRequest,
+) (*pb.SearchMembersResponse, error) {
+	query := strings.TrimSpace(request.Query)
+
+	switch {
+	case query == "":
+		return nil, status.FieldViolations([]*errdetails.BadRequest_FieldViolation{{
+			Field:       "query",
+			Description: "query must not be empty",
+			Reason:      "FIELD_EMPTY",
+		}})
+	case utf8.RuneCountInString(query) > maxSearchQueryLength:
+		return nil, status.FieldViolations([]*errdetails.BadRequest_FieldViolation{{
+			Field:       "query",
+			Description: "query must not be longer than 256 characters",
+			Reason:      "FIELD_TOO_LARGE",
+		}})
+	}
+
+	pageTokenBytes, err := base64.RawStdEncoding.DecodeString(request.PageToken)
+	if err != nil {
+		return nil, status.FieldViolations([]*errdetails.BadRequest_FieldViolation{{
+			Field:       "page_token",
+			Description: "invalid token",
+		}})
+	}
+
+	pageToken := &pb.MemberSearchPageToken{}
+
+	err = proto.Unmarshal(pageTokenBytes, pageToken)
+	if err != nil {
+		return nil, status.FieldViolations([]*errdetails.BadRequest_FieldViolation{{
+			Field:       "page_token",
+			Description: "invalid token",
+		}})
+	}
+
+	memberAttributes, err := s.listAllMemberAttributes(ctx)
+	if err != nil {
+		return nil, status.Internal(err)
+	}
+
+	var (
+		access             = accessorFromContext(ctx)
+		excludedAttributes []string
+	)
+
+	for name, attribute := range memberAttributes {
+		if !slices.Contains(searchableAttributeTypes, attribute.Type) || !access.canRead(attribute) {
+			excludedAttributes = append(excludedAttributes, name)
+		}
+	}
+
+	pageSize := request.PageSize
+	if pageSize == 0 {
+		pageSize = 50
+	}
+
+	results, err := s.repo.SearchMembers(ctx, query, excludedAttributes, pageSize+1, pageToken.Offset)
+	if err != nil {
+		return nil, status.Internal(err)
+	}
+
+	var nextPageToken string
+
+	if len(results) > int(pageSize) {
+		results = results[:pageSize]
+
+		nextPageTokenBytes, err := proto.Marshal(&pb.MemberSearchPageToken{Offset: pageToken.Offset + pageSize})
+		if err != nil {
+			return nil, status.Internal(err)
+		}
+
+		nextPageToken = base64.RawStdEncoding.EncodeToString(nextPageTokenBytes)
+	}
+
+	for _, result := range results {
+		access.stripUnreadable(memberAttributes, result.Member)
+	}
+
+	return &pb.SearchMembersResponse{
+		Results:       results,
+		NextPageToken: nextPageToken,
+	}, nil
+}
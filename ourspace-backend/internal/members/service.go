@@ -5,6 +5,7 @@ import (
 	"encoding/base64"
 	"errors"
 	"fmt"
+	"maps"
 	"regexp"
 	"slices"
 	"strings"
@@ -31,17 +32,26 @@ var (
 		pb.MemberAttribute_TYPE_NUMBER,
 		pb.MemberAttribute_TYPE_DATE,
 		pb.MemberAttribute_TYPE_DATETIME,
+		pb.MemberAttribute_TYPE_SELECT,
+		pb.MemberAttribute_TYPE_MULTI_SELECT,
+		pb.MemberAttribute_TYPE_BOOLEAN,
+		pb.MemberAttribute_TYPE_EMAIL,
+		pb.MemberAttribute_TYPE_PHONE,
+		pb.MemberAttribute_TYPE_URL,
+		pb.MemberAttribute_TYPE_DECIMAL,
 	}
 	validTechnicalName = regexp.MustCompile(`^[a-z0-9](?:[a-z0-9_]*[a-z0-9])?$`) // lower_camel_case, doesn't start or end with _
 )
 
 type Service struct {
 	repo *Postgres
+	// requireGuardian rejects underage members without a guardian.
+	requireGuardian bool
 	pb.UnimplementedMemberServiceServer
 }
 
-func NewService(repo *Postgres) *Service {
-	return &Service{repo: repo}
+func NewService(repo *Postgres, requireGuardian bool) *Service {
+	return &Service{repo: repo, requireGuardian: requireGuardian}
 }
 
 func (s Service) CreateMember(ctx context.Context, request *pb.CreateMemberRequest) (*pb.Member, error) {
@@ -51,16 +61,56 @@ func (s Service) CreateMember(ctx context.Context, request *pb.CreateMemberReque
 	}
 
 	validationErrors := validateCreateMember(request, memberAttributes)
+	for i, relationship := range request.Relationships {
+		relationship.Id = uuid.New().String()
+		relationship.MemberId = request.MemberId
+
+		validationErrors = append(
+			validationErrors, validateRelationship(fmt.Sprintf("relationships[%d]", i), relationship)...,
+		)
+	}
+
 	if len(validationErrors) != 0 {
 		return nil, status.FieldViolations(validationErrors)
 	}
 
+	access := accessorFromContext(ctx)
+
+	if !access.canWriteAttributes(memberAttributes, slices.Collect(maps.Keys(request.Member.AdditionalAttributes))) ||
+		(len(request.Member.GetMemberLogin().GetRoles()) != 0 && !access.isAdmin()) {
+		return nil, status.PermissionDenied()
+	}
+
 	if request.MemberId != "" {
 		request.Member.Id = request.MemberId
 	} else {
 		request.Member.Id = uuid.New().String()
 	}
 
+	deriveAgeCategory(request.Member)
+
+	validationErrors, err = s.validateUniqueAttributes(
+		ctx, request.Member.Id, request.Member.AdditionalAttributes, memberAttributes,
+	)
+	if err != nil {
+		return nil, status.Internal(err)
+	}
+
+	validationErrors = append(validationErrors, s.guardianViolations(request.Member, request.Relationships)...)
+
+	for i, relationship := range request.Relationships {
+		relatedMemberViolations, err := s.validateRelatedMember(ctx, fmt.Sprintf("relationships[%d]", i), relationship)
+		if err != nil {
+			return nil, status.Internal(err)
+		}
+
+		validationErrors = append(validationErrors, relatedMemberViolations...)
+	}
+
+	if len(validationErrors) != 0 {
+		return nil, status.FieldViolations(validationErrors)
+	}
+
 	if request.Member.MemberLogin != nil {
 		hash, err := pwhash.Create(request.Member.MemberLogin.Password)
 		if err != nil {
@@ -70,11 +120,17 @@ func (s Service) CreateMember(ctx context.Context, request *pb.CreateMemberReque
 		request.Member.MemberLogin.Password = hash
 	}
 
-	member, err := s.repo.CreateMember(ctx, request.Member)
+	member, err := s.repo.CreateMember(ctx, request.Member, request.Relationships)
+	if errors.Is(err, ErrReferenceNotFound) {
+		return nil, status.FailedPrecondition("related member was deleted")
+	}
+
 	if err != nil {
 		return nil, status.Internal(err)
 	}
 
+	access.stripUnreadable(memberAttributes, member)
+
 	return member, nil
 }
 
@@ -140,7 +196,8 @@ func validateCreateMember(
 		}
 	}
 
-	if !slices.Contains(validAgeCategories, request.Member.AgeCategory) {
+	// the age category is derived from the birth date if it is known
+	if request.Member.BirthDate == nil && !slices.Contains(validAgeCategories, request.Member.AgeCategory) {
 		fieldViolations = append(fieldViolations, &errdetails.BadRequest_FieldViolation{
 			Field:       "member.age_category",
 			Description: fmt.Sprintf("age_category must be in %v", validAgeCategories),
@@ -148,6 +205,8 @@ func validateCreateMember(
 		})
 	}
 
+	fieldViolations = append(fieldViolations, validateBirthDate(request.Member.BirthDate)...)
+
 	for i := range request.Member.Tags {
 		if request.Member.Tags[i] == "" {
 			fieldViolations = append(fieldViolations, &errdetails.BadRequest_FieldViolation{
@@ -186,6 +245,9 @@ func validateCreateMember(
 				Description: "password must contain at least one number",
 			})
 		}
+
+		fieldViolations = append(fieldViolations,
+			validateRoles("member.member_login.roles", request.Member.MemberLogin.Roles)...)
 	}
 
 	for field, value := range request.Member.AdditionalAttributes {
@@ -199,60 +261,13 @@ func validateCreateMember(
 			continue
 		}
 
-		if len(value) > 4096 {
-			fieldViolations = append(fieldViolations, &errdetails.BadRequest_FieldViolation{
-				Field:       fmt.Sprintf("additional_attributes.%s", field),
-				Description: "field can't be longer than 4KB",
-			})
-
-			continue
-		}
-
-		switch memberAttribute.Type {
-		case pb.MemberAttribute_TYPE_UNKNOWN:
-			fieldViolations = append(fieldViolations, &errdetails.BadRequest_FieldViolation{
-				Field:       fmt.Sprintf("additional_attributes.%s", field),
-				Description: "unknown field type configured",
-			})
-		case pb.MemberAttribute_TYPE_TEXT_SINGLE_LINE:
-			if strings.ContainsAny(value, "\r\n") {
-				fieldViolations = append(fieldViolations, &errdetails.BadRequest_FieldViolation{
-					Field:       fmt.Sprintf("additional_attributes.%s", field),
-					Description: "single line field can't contain line breaks",
-				})
-			}
-		case pb.MemberAttribute_TYPE_TEXT_MULI_LINE:
-			// no additional validations
-		case pb.MemberAttribute_TYPE_NUMBER:
-			for _, c := range value {
-				if c < '0' || c > '9' {
-					fieldViolations = append(fieldViolations, &errdetails.BadRequest_FieldViolation{
-						Field:       fmt.Sprintf("additional_attributes.%s", field),
-						Description: "numeric field can't contain non-numeric characters",
-					})
-
-					break
-				}
-			}
-		case pb.MemberAttribute_TYPE_DATE:
-			_, err := time.Parse(time.DateOnly, value)
-			if err != nil {
-				fieldViolations = append(fieldViolations, &errdetails.BadRequest_FieldViolation{
-					Field:       fmt.Sprintf("additional_attributes.%s", field),
-					Description: fmt.Sprintf("invalid date: %v", err.Error()),
-				})
-			}
-		case pb.MemberAttribute_TYPE_DATETIME:
-			_, err := time.Parse(time.RFC3339, value)
-			if err != nil {
-				fieldViolations = append(fieldViolations, &errdetails.BadRequest_FieldViolation{
-					Field:       fmt.Sprintf("additional_attributes.%s", field),
-					Description: fmt.Sprintf("invalid date-time: %v", err.Error()),
-				})
-			}
-		}
+		fieldViolations = append(fieldViolations,
+			validateAttributeValue(fmt.Sprintf("additional_attributes.%s", field), memberAttribute, value)...)
 	}
 
+	fieldViolations = append(fieldViolations,
+		validateRequiredAttributes(request.Member.AdditionalAttributes, additionalAttributes)...)
+
 	if len(fieldViolations) != 0 {
 		return fieldViolations
 	}
@@ -270,6 +285,11 @@ func (s Service) GetMember(ctx context.Context, request *pb.GetMemberRequest) (*
 		return nil, status.Internal(err)
 	}
 
+	err = s.stripUnreadableAttributes(ctx, member)
+	if err != nil {
+		return nil, status.Internal(err)
+	}
+
 	return member, nil
 }
 
@@ -315,12 +335,53 @@ func (s Service) ListMembers(ctx context.Context, request *pb.ListMembersRequest
 		filters.TagsContain = request.TagContains
 	}
 
+	var (
+		access           = accessorFromContext(ctx)
+		memberAttributes map[string]*pb.MemberAttribute
+		sortAttribute    *pb.MemberAttribute
+	)
+
+	if hasAttributeFilters(request) || pageToken.Attribute != "" || !access.isAdmin() {
+		memberAttributes, err = s.listAllMemberAttributes(ctx)
+		if err != nil {
+			return nil, status.Internal(err)
+		}
+	}
+
+	if hasAttributeFilters(request) || pageToken.Attribute != "" {
+		// Filtering by attributes the caller can't read would reveal their values.
+		readableAttributes := access.readableAttributes(memberAttributes)
+
+		fieldViolations := validateAttributeFilters(request, readableAttributes)
+		if pageToken.Attribute != "" && readableAttributes[pageToken.Attribute] == nil {
+			fieldViolations = append(fieldViolations, &errdetails.BadRequest_FieldViolation{
+				Field:       "page_token",
+				Description: "sort attribute of the page token does not exist anymore",
+				Reason:      "FIELD_INVALID",
+			})
+		}
+
+		if len(fieldViolations) != 0 {
+			return nil, status.FieldViolations(fieldViolations)
+		}
+
+		filters.Attributes = attributeFilters(request, readableAttributes)
+
+		if pageToken.Attribute != "" {
+			sortAttribute = readableAttributes[pageToken.Attribute]
+		} else if pageToken.Field == pb.MemberField_MEMBER_FIELD_UNKNOWN && request.SortByAttribute != "" {
+			sortAttribute = readableAttributes[request.SortByAttribute]
+		}
+	}
+
 	pageSize := request.PageSize
 	if pageSize == 0 {
 		pageSize = 50
 	}
 
-	members, err := s.repo.ListMembers(ctx, pageSize+1, pageToken, request.SortBy, request.SortDirection, filters)
+	members, err := s.repo.ListMembers(
+		ctx, pageSize+1, pageToken, request.SortBy, request.SortDirection, sortAttribute, filters,
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -344,18 +405,22 @@ func (s Service) ListMembers(ctx context.Context, request *pb.ListMembersRequest
 			direction = request.SortDirection
 		}
 
-		lastValue, err := getFieldValue(members[pageSize-1], field)
-		if err != nil {
-			return nil, err
-		}
-
 		pbNextPageToken := &pb.MemberPageToken{
-			Field:     field,
-			LastValue: lastValue,
 			Direction: direction,
 			LastId:    members[pageSize-1].Id,
 		}
 
+		if sortAttribute != nil {
+			pbNextPageToken.Attribute = sortAttribute.TechnicalName
+			pbNextPageToken.LastValue = getAttributeSortValue(members[pageSize-1], sortAttribute)
+		} else {
+			pbNextPageToken.Field = field
+			pbNextPageToken.LastValue, err = getFieldValue(members[pageSize-1], field)
+			if err != nil {
+				return nil, err
+			}
+		}
+
 		nextPageTokenBytes, err := proto.Marshal(pbNextPageToken)
 		if err != nil {
 			return nil, err
@@ -364,6 +429,8 @@ func (s Service) ListMembers(ctx context.Context, request *pb.ListMembersRequest
 		nextPageToken = base64.RawStdEncoding.EncodeToString(nextPageTokenBytes)
 	}
 
+	access.stripUnreadable(memberAttributes, members...)
+
 	return &pb.ListMembersResponse{
 		Members:       members,
 		NextPageToken: nextPageToken,
@@ -381,6 +448,69 @@ func (s Service) UpdateMember(ctx context.Context, request *pb.UpdateMemberReque
 		return nil, status.FieldViolations(fieldViolations)
 	}
 
+	switch {
+	case slices.Contains(request.FieldMask.Paths, "birth_date") && request.Member.BirthDate != nil:
+		deriveAgeCategory(request.Member)
+
+		request.FieldMask.Paths = appendMissing(request.FieldMask.Paths, "age_category")
+	case slices.Contains(request.FieldMask.Paths, "age_category") &&
+		!slices.Contains(request.FieldMask.Paths, "birth_date"):
+		existing, err := s.repo.GetMember(ctx, request.Member.Id)
+		if errors.Is(err, ErrNotFound) {
+			return nil, status.NotFound()
+		}
+
+		if err != nil {
+			return nil, status.Internal(err)
+		}
+
+		if existing.BirthDate != nil {
+			return nil, status.FieldViolations([]*errdetails.BadRequest_FieldViolation{{
+				Field:       "member.age_category",
+				Description: "age_category is derived from birth_date and can't be set by hand",
+				Reason:      "FIELD_INVALID",
+			}})
+		}
+	}
+
+	// like when creating members and removing guardians, members can only become underage if they have a guardian
+	if s.requireGuardian && slices.Contains(request.FieldMask.Paths, "age_category") &&
+		request.Member.AgeCategory == pb.AgeCategory_AGE_CATEGORY_UNDERAGE {
+		relationships, err := s.repo.ListRelationships(ctx, request.Member.Id)
+		if err != nil {
+			return nil, status.Internal(err)
+		}
+
+		fieldViolations = s.guardianViolations(request.Member, relationships)
+		if len(fieldViolations) != 0 {
+			return nil, status.FieldViolations(fieldViolations)
+		}
+	}
+
+	updatedAttributes := map[string]string{}
+
+	for _, path := range request.FieldMask.Paths {
+		if field, found := strings.CutPrefix(path, "additional_attributes."); found {
+			updatedAttributes[field] = request.Member.AdditionalAttributes[field]
+		}
+	}
+
+	access := accessorFromContext(ctx)
+
+	if !access.canWriteAttributes(memberAttributes, slices.Collect(maps.Keys(updatedAttributes))) ||
+		(slices.Contains(request.FieldMask.Paths, "member_login.roles") && !access.isAdmin()) {
+		return nil, status.PermissionDenied()
+	}
+
+	fieldViolations, err = s.validateUniqueAttributes(ctx, request.Member.Id, updatedAttributes, memberAttributes)
+	if err != nil {
+		return nil, status.Internal(err)
+	}
+
+	if len(fieldViolations) != 0 {
+		return nil, status.FieldViolations(fieldViolations)
+	}
+
 	if slices.Contains(request.FieldMask.Paths, "member_login") && request.Member.MemberLogin != nil {
 		hash, err := pwhash.Create(request.Member.MemberLogin.Password)
 		if err != nil {
@@ -395,6 +525,8 @@ func (s Service) UpdateMember(ctx context.Context, request *pb.UpdateMemberReque
 		return nil, err
 	}
 
+	access.stripUnreadable(memberAttributes, updated)
+
 	return updated, nil
 }
 
@@ -465,7 +597,8 @@ func validateUpdateMember(
 		case "age_category":
 			validPath = true
 
-			if !slices.Contains(validAgeCategories, request.Member.AgeCategory) {
+			derived := slices.Contains(request.FieldMask.Paths, "birth_date") && request.Member.BirthDate != nil
+			if !derived && !slices.Contains(validAgeCategories, request.Member.AgeCategory) {
 				fieldViolations = append(fieldViolations, &errdetails.BadRequest_FieldViolation{
 					Field:       "member.age_category",
 					Description: fmt.Sprintf("age_category must be in %v", validAgeCategories),
@@ -516,6 +649,15 @@ func validateUpdateMember(
 					})
 				}
 			}
+		case "birth_date":
+			validPath = true
+
+			fieldViolations = append(fieldViolations, validateBirthDate(request.Member.BirthDate)...)
+		case "member_login.roles":
+			validPath = true
+
+			fieldViolations = append(fieldViolations,
+				validateRoles("member.member_login.roles", request.Member.GetMemberLogin().GetRoles())...)
 		}
 
 		if field, found := strings.CutPrefix(path, "additional_attributes."); found {
@@ -533,58 +675,8 @@ func validateUpdateMember(
 
 			validPath = true
 
-			if len(value) > 4096 {
-				fieldViolations = append(fieldViolations, &errdetails.BadRequest_FieldViolation{
-					Field:       fmt.Sprintf("additional_attributes.%s", field),
-					Description: "field can't be longer than 4KB",
-				})
-
-				continue
-			}
-
-			switch memberAttribute.Type {
-			case pb.MemberAttribute_TYPE_UNKNOWN:
-				fieldViolations = append(fieldViolations, &errdetails.BadRequest_FieldViolation{
-					Field:       fmt.Sprintf("additional_attributes.%s", field),
-					Description: "unknown field type configured",
-				})
-			case pb.MemberAttribute_TYPE_TEXT_SINGLE_LINE:
-				if strings.ContainsAny(value, "\r\n") {
-					fieldViolations = append(fieldViolations, &errdetails.BadRequest_FieldViolation{
-						Field:       fmt.Sprintf("additional_attributes.%s", field),
-						Description: "single line field can't contain line breaks",
-					})
-				}
-			case pb.MemberAttribute_TYPE_TEXT_MULI_LINE:
-				// no additional validations
-			case pb.MemberAttribute_TYPE_NUMBER:
-				for _, c := range value {
-					if c < '0' || c > '9' {
-						fieldViolations = append(fieldViolations, &errdetails.BadRequest_FieldViolation{
-							Field:       fmt.Sprintf("additional_attributes.%s", field),
-							Description: "numeric field can't contain non-numeric characters",
-						})
-
-						break
-					}
-				}
-			case pb.MemberAttribute_TYPE_DATE:
-				_, err := time.Parse(time.DateOnly, value)
-				if err != nil {
-					fieldViolations = append(fieldViolations, &errdetails.BadRequest_FieldViolation{
-						Field:       fmt.Sprintf("additional_attributes.%s", field),
-						Description: fmt.Sprintf("invalid date: %v", err.Error()),
-					})
-				}
-			case pb.MemberAttribute_TYPE_DATETIME:
-				_, err := time.Parse(time.RFC3339, value)
-				if err != nil {
-					fieldViolations = append(fieldViolations, &errdetails.BadRequest_FieldViolation{
-						Field:       fmt.Sprintf("additional_attributes.%s", field),
-						Description: fmt.Sprintf("invalid date-time: %v", err.Error()),
-					})
-				}
-			}
+			fieldViolations = append(fieldViolations,
+				validateAttributeValue(fmt.Sprintf("additional_attributes.%s", field), memberAttribute, value)...)
 		}
 
 		if !validPath {
@@ -627,15 +719,15 @@ func (s Service) ListMemberTags(
 		return nil, err
 	}
 
-	tags, err := s.repo.ListMemberTags(ctx, request.PageSize+1, pageToken)
+	tags, err := s.repo.ListMemberTags(ctx, pageSize+1, pageToken)
 	if err != nil {
-		return nil, err
+		return nil, status.Internal(err)
 	}
 
 	var nextPageToken string
 
-	if len(tags) > int(request.PageSize) {
-		tags = tags[:request.PageSize]
+	if len(tags) > int(pageSize) {
+		tags = tags[:pageSize]
 		nextPageTokenPb := &pb.MemberTagsPageToken{
 			Offset: pageToken.Offset + pageSize,
 		}
@@ -648,9 +740,15 @@ func (s Service) ListMemberTags(
 		nextPageToken = base64.RawStdEncoding.EncodeToString(nextPageTokenBytes)
 	}
 
+	names := make([]string, 0, len(tags))
+	for _, tag := range tags {
+		names = append(names, tag.Name)
+	}
+
 	return &pb.ListMemberTagsResponse{
-		Tags:          tags,
+		Tags:          names,
 		NextPageToken: nextPageToken,
+		MemberTags:    tags,
 	}, nil
 }
 
@@ -672,11 +770,20 @@ func getFieldValue(member *pb.Member, field pb.MemberField) (string, error) {
 func (s Service) CreateMemberAttribute(
 	ctx context.Context, req *pb.CreateMemberAttributeRequest,
 ) (*pb.MemberAttribute, error) {
+	if req.Attribute != nil && req.Attribute.Sensitivity == pb.MemberAttribute_SENSITIVITY_UNKNOWN {
+		req.Attribute.Sensitivity = pb.MemberAttribute_SENSITIVITY_PUBLIC
+	}
+
 	validationErrors := validateCreateMemberAttribute(req)
 	if len(validationErrors) != 0 {
 		return nil, status.FieldViolations(validationErrors)
 	}
 
+	if !accessorFromContext(ctx).isAdmin() && (req.Attribute.Sensitivity != pb.MemberAttribute_SENSITIVITY_PUBLIC ||
+		len(req.Attribute.ReadRoles) != 0 || len(req.Attribute.WriteRoles) != 0) {
+		return nil, status.PermissionDenied()
+	}
+
 	if req.Attribute.Id == "" {
 		req.Attribute.Id = uuid.New().String()
 	}
@@ -751,6 +858,9 @@ func validateCreateMemberAttribute(req *pb.CreateMemberAttributeRequest) []*errd
 		})
 	}
 
+	violations = append(violations, validateAttributeConstraints(req.Attribute)...)
+	violations = append(violations, validateAttributeSensitivity(req.Attribute)...)
+
 	return violations
 }
 
@@ -890,15 +1000,54 @@ func (s Service) listAllMemberAttributes(ctx context.Context) (map[string]*pb.Me
 func (s Service) UpdateMemberAttribute(
 	ctx context.Context, req *pb.UpdateMemberAttributeRequest,
 ) (*pb.MemberAttribute, error) {
-	fieldViolations := validateUpdateMemberAttribute(req)
+	existing, err := s.repo.GetMemberAttribute(ctx, req.Attribute.GetId())
+	if errors.Is(err, ErrNotFound) {
+		return nil, status.NotFound()
+	}
+
+	if err != nil {
+		return nil, status.Internal(err)
+	}
+
+	if slices.Contains(req.FieldMask.GetPaths(), "sensitivity") &&
+		req.Attribute.Sensitivity == pb.MemberAttribute_SENSITIVITY_UNKNOWN {
+		req.Attribute.Sensitivity = pb.MemberAttribute_SENSITIVITY_PUBLIC
+	}
+
+	fieldViolations := validateUpdateMemberAttribute(req, existing)
 	if len(fieldViolations) != 0 {
 		return nil, status.FieldViolations(fieldViolations)
 	}
 
+	if !accessorFromContext(ctx).isAdmin() && slices.ContainsFunc(req.FieldMask.Paths, func(path string) bool {
+		return path == "sensitivity" || path == "read_roles" || path == "write_roles"
+	}) {
+		return nil, status.PermissionDenied()
+	}
+
+	if slices.Contains(req.FieldMask.Paths, "unique") && req.Attribute.Unique && !existing.Unique {
+		duplicates, err := s.repo.HasDuplicateAttributeValues(ctx, existing.TechnicalName)
+		if err != nil {
+			return nil, status.Internal(err)
+		}
+
+		if duplicates {
+			return nil, status.FieldViolations([]*errdetails.BadRequest_FieldViolation{{
+				Field:       "attribute.unique",
+				Description: "some members already share the same value",
+				Reason:      "FIELD_INVALID",
+			}})
+		}
+	}
+
 	return s.repo.UpdateMemberAttribute(ctx, req.Attribute, req.FieldMask)
 }
 
-func validateUpdateMemberAttribute(req *pb.UpdateMemberAttributeRequest) []*errdetails.BadRequest_FieldViolation {
+// validateUpdateMemberAttribute validates the updated fields. The constraints are checked on the existing attribute
+// with the updates applied, as they depend on its type.
+func validateUpdateMemberAttribute(
+	req *pb.UpdateMemberAttributeRequest, existing *pb.MemberAttribute,
+) []*errdetails.BadRequest_FieldViolation {
 	if !req.FieldMask.IsValid(&pb.MemberAttribute{}) {
 		return []*errdetails.BadRequest_FieldViolation{{
 			Field:       "field_mask",
@@ -907,7 +1056,10 @@ func validateUpdateMemberAttribute(req *pb.UpdateMemberAttributeRequest) []*errd
 		}}
 	}
 
-	violations := make([]*errdetails.BadRequest_FieldViolation, 0)
+	var (
+		violations = make([]*errdetails.BadRequest_FieldViolation, 0)
+		updated    = proto.Clone(existing).(*pb.MemberAttribute) //nolint:forcetypeassert // clone has same type
+	)
 
 	for _, path := range req.FieldMask.Paths {
 		switch path {
@@ -932,6 +1084,24 @@ func validateUpdateMemberAttribute(req *pb.UpdateMemberAttributeRequest) []*errd
 					Description: "must be smaller than 4KB",
 				})
 			}
+		case "options":
+			updated.Options = req.Attribute.Options
+		case "required":
+			updated.Required = req.Attribute.Required
+		case "unique":
+			updated.Unique = req.Attribute.Unique
+		case "pattern":
+			updated.Pattern = req.Attribute.Pattern
+		case "min":
+			updated.Min = req.Attribute.Min
+		case "max":
+			updated.Max = req.Attribute.Max
+		case "sensitivity":
+			updated.Sensitivity = req.Attribute.Sensitivity
+		case "read_roles":
+			updated.ReadRoles = req.Attribute.ReadRoles
+		case "write_roles":
+			updated.WriteRoles = req.Attribute.WriteRoles
 		default:
 			violations = append(violations, &errdetails.BadRequest_FieldViolation{
 				Field:       "field_mask",
@@ -941,13 +1111,31 @@ func validateUpdateMemberAttribute(req *pb.UpdateMemberAttributeRequest) []*errd
 		}
 	}
 
+	violations = append(violations, validateAttributeConstraints(updated)...)
+	violations = append(violations, validateAttributeSensitivity(updated)...)
+
 	return violations
 }
 
 func (s Service) DeleteMemberAttribute(
 	ctx context.Context, req *pb.DeleteMemberAttributeRequest,
 ) (*emptypb.Empty, error) {
-	err := s.repo.DeleteMemberAttribute(ctx, req.Id)
+	if req.PurgeValues {
+		existing, err := s.repo.GetMemberAttribute(ctx, req.Id)
+		if errors.Is(err, ErrNotFound) {
+			return nil, status.NotFound()
+		}
+
+		if err != nil {
+			return nil, status.Internal(err)
+		}
+
+		if !accessorFromContext(ctx).canWrite(existing) {
+			return nil, status.PermissionDenied()
+		}
+	}
+
+	err := s.repo.DeleteMemberAttribute(ctx, req.Id, req.PurgeValues)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,227 @@
+package members
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"slices"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	pb "github.com/cfhn/our-space/ourspace-backend/proto"
+	"github.com/cfhn/our-space/pkg/status"
+)
+
+//nolint:gochecknoglobals // static pattern
+var colorPattern = regexp.MustCompile(`^#[0-9a-fA-F]{6}$`)
+
+func (s Service) CreateMemberTag(ctx context.Context, request *pb.CreateMemberTagRequest) (*pb.MemberTag, error) {
+	var fieldViolations []*errdetails.BadRequest_FieldViolation
+
+	if request.Tag == nil {
+		fieldViolations = append(fieldViolations, &errdetails.BadRequest_FieldViolation{
+			Field:       "tag",
+			Description: "tag must not be empty",
+			Reason:      "FIELD_EMPTY",
+		})
+	} else {
+		fieldViolations = append(fieldViolations, validateTagName("tag.name", request.Tag.Name)...)
+		fieldViolations = append(fieldViolations, validateTagDefinition(request.Tag)...)
+	}
+
+	if len(fieldViolations) != 0 {
+		return nil, status.FieldViolations(fieldViolations)
+	}
+
+	tag, err := s.repo.CreateMemberTag(ctx, request.Tag)
+	if errors.Is(err, ErrTagExists) {
+		return nil, status.AlreadyExists()
+	}
+
+	if err != nil {
+		return nil, status.Internal(err)
+	}
+
+	return tag, nil
+}
+
+// UpdateMemberTag updates the definition of the tag, tags that are only used by members get a definition.
+func (s Service) UpdateMemberTag(ctx context.Context, request *pb.UpdateMemberTagRequest) (*pb.MemberTag, error) {
+	if request.Tag == nil {
+		return nil, status.FieldViolations([]*errdetails.BadRequest_FieldViolation{{
+			Field:       "tag",
+			Description: "tag must not be empty",
+			Reason:      "FIELD_EMPTY",
+		}})
+	}
+
+	existing, err := s.repo.GetMemberTag(ctx, request.Tag.Name)
+	if errors.Is(err, ErrTagNotFound) {
+		return nil, status.NotFound()
+	}
+
+	if err != nil {
+		return nil, status.Internal(err)
+	}
+
+	updated, fieldViolations := updatedMemberTag(request, existing)
+	if len(fieldViolations) != 0 {
+		return nil, status.FieldViolations(fieldViolations)
+	}
+
+	tag, err := s.repo.SaveMemberTag(ctx, updated)
+	if err != nil {
+		return nil, status.Internal(err)
+	}
+
+	return tag, nil
+}
+
+// updatedMemberTag applies the field mask of the request to the existing tag and validates the result.
+func updatedMemberTag(
+	request *pb.UpdateMemberTagRequest, existing *pb.MemberTag,
+) (*pb.MemberTag, []*errdetails.BadRequest_FieldViolation) {
+	if len(request.FieldMask.GetPaths()) == 0 {
+		return nil, []*errdetails.BadRequest_FieldViolation{{
+			Field:       "field_mask",
+			Description: "field_mask must not be empty",
+			Reason:      "FIELD_EMPTY",
+		}}
+	}
+
+	updated := proto.Clone(existing).(*pb.MemberTag) //nolint:forcetypeassert // clone has same type
+
+	for _, path := range request.FieldMask.Paths {
+		switch path {
+		case "description":
+			updated.Description = request.Tag.Description
+		case "color":
+			updated.Color = request.Tag.Color
+		default:
+			return nil, []*errdetails.BadRequest_FieldViolation{{
+				Field:       "field_mask",
+				Description: "non-updatable field in field mask, use rename to change the name",
+				Reason:      "FIELD_INVALID",
+			}}
+		}
+	}
+
+	return updated, validateTagDefinition(updated)
+}
+
+func (s Service) DeleteMemberTag(ctx context.Context, request *pb.DeleteMemberTagRequest) (*emptypb.Empty, error) {
+	err := s.repo.DeleteMemberTag(ctx, request.Name, request.RemoveFromMembers)
+	if errors.Is(err, ErrTagNotFound) {
+		return nil, status.NotFound()
+	}
+
+	if err != nil {
+		return nil, status.Internal(err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s Service) RenameMemberTag(ctx context.Context, request *pb.RenameMemberTagRequest) (*pb.MemberTag, error) {
+	fieldViolations := validateTagName("new_name", request.NewName)
+	if len(fieldViolations) != 0 {
+		return nil, status.FieldViolations(fieldViolations)
+	}
+
+	tag, err := s.repo.RenameMemberTag(ctx, request.Name, request.NewName)
+
+	switch {
+	case errors.Is(err, ErrTagNotFound):
+		return nil, status.NotFound()
+	case errors.Is(err, ErrTagExists):
+		return nil, status.FailedPrecondition("new_name is already used, merge the tags instead")
+	case err != nil:
+		return nil, status.Internal(err)
+	}
+
+	return tag, nil
+}
+
+func (s Service) MergeMemberTags(ctx context.Context, request *pb.MergeMemberTagsRequest) (*pb.MemberTag, error) {
+	fieldViolations := validateTagName("name", request.Name)
+
+	if len(request.SourceNames) == 0 {
+		fieldViolations = append(fieldViolations, &errdetails.BadRequest_FieldViolation{
+			Field:       "source_names",
+			Description: "source_names must not be empty",
+			Reason:      "FIELD_EMPTY",
+		})
+	}
+
+	for i, sourceName := range request.SourceNames {
+		if sourceName == request.Name || slices.Contains(request.SourceNames[:i], sourceName) {
+			fieldViolations = append(fieldViolations, &errdetails.BadRequest_FieldViolation{
+				Field:       fmt.Sprintf("source_names[%d]", i),
+				Description: "source_names must be distinct and not contain name",
+				Reason:      "FIELD_INVALID",
+			})
+		}
+	}
+
+	if len(fieldViolations) != 0 {
+		return nil, status.FieldViolations(fieldViolations)
+	}
+
+	tag, err := s.repo.MergeMemberTags(ctx, request.Name, request.SourceNames)
+	if errors.Is(err, ErrTagNotFound) {
+		return nil, status.NotFound()
+	}
+
+	if err != nil {
+		return nil, status.Internal(err)
+	}
+
+	return tag, nil
+}
+
+func validateTagName(field, name string) []*errdetails.BadRequest_FieldViolation {
+	var fieldViolations []*errdetails.BadRequest_FieldViolation
+
+	if name == "" {
+		fieldViolations = append(fieldViolations, &errdetails.BadRequest_FieldViolation{
+			Field:       field,
+			Description: "tag must not be empty",
+			Reason:      "FIELD_EMPTY",
+		})
+	}
+
+	if len(name) > 256 {
+		fieldViolations = append(fieldViolations, &errdetails.BadRequest_FieldViolation{
+			Field:       field,
+			Description: "tag must not be longer than 256 characters",
+			Reason:      "FIELD_TOO_LARGE",
+		})
+	}
+
+	return fieldViolations
+}
+
+func validateTagDefinition(tag *pb.MemberTag) []*errdetails.BadRequest_FieldViolation {
+	var fieldViolations []*errdetails.BadRequest_FieldViolation
+
+	if len(tag.Description) > 4096 {
+		fieldViolations = append(fieldViolations, &errdetails.BadRequest_FieldViolation{
+			Field:       "tag.description",
+			Description: "description must be smaller than 4KB",
+			Reason:      "FIELD_TOO_LARGE",
+		})
+	}
+
+	if tag.Color != "" && !colorPattern.MatchString(tag.Color) {
+		fieldViolations = append(fieldViolations, &errdetails.BadRequest_FieldViolation{
+			Field:       "tag.color",
+			Description: "color must be a hex RGB color like #1e90ff",
+			Reason:      "FIELD_INVALID",
+		})
+	}
+
+	return fieldViolations
+}
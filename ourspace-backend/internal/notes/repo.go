@@ -0,0 +1,259 @@
+package notes
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgtype"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	pb "github.com/cfhn/our-space/ourspace-backend/proto"
+)
+
+const foreignKeyViolation = "23503"
+
+var (
+	ErrNotFound       = errors.New("note not found")
+	ErrMemberNotFound = errors.New("member not found")
+)
+
+const selectNote = `
+	select id, member_id, text, author, author_name, create_time, update_time, pinned, visibility
+	from member_notes
+`
+
+// visibleNotes restricts notes to the ones the caller can see, $1 is set for admins and $2 is the username of the
+// caller.
+const visibleNotes = `(visibility = 'VISIBILITY_STAFF' or $1 or (author <> '' and author = $2))`
+
+type Postgres struct {
+	db *sql.DB
+}
+
+func NewPostgresRepo(db *sql.DB) *Postgres {
+	return &Postgres{db: db}
+}
+
+// Viewer is the caller listing notes. Notes visible to admins only are returned to admins and their author.
+type Viewer struct {
+	Admin    bool
+	Username string
+}
+
+func (p *Postgres) CreateNote(ctx context.Context, note *pb.MemberNote) (*pb.MemberNote, error) {
+	created, err := scanNote(p.db.QueryRowContext(ctx, `
+		insert into member_notes (id, member_id, text, author, author_name, pinned, visibility)
+		values ($1, $2, $3, $4, $5, $6, $7)
+		returning id, member_id, text, author, author_name, create_time, update_time, pinned, visibility
+	`, note.Id, note.MemberId, note.Text, note.Author, note.AuthorName, note.Pinned, note.Visibility.String()))
+
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && pgErr.Code == foreignKeyViolation {
+		return nil, ErrMemberNotFound
+	}
+
+	return created, err
+}
+
+func (p *Postgres) GetNote(ctx context.Context, viewer Viewer, memberID, id string) (*pb.MemberNote, error) {
+	note, err := scanNote(p.db.QueryRowContext(ctx, selectNote+`
+		where `+visibleNotes+` and member_id = $3 and id = $4
+	`, viewer.Admin, viewer.Username, memberID, id))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrNotFound
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return note, nil
+}
+
+// ListNotes lists the notes of the member, pinned notes first and then the latest first.
+func (p *Postgres) ListNotes(
+	ctx context.Context, viewer Viewer, memberID string, pinnedOnly bool, pageSize int32,
+	pageToken *pb.MemberNotePageToken,
+) ([]*pb.MemberNote, error) {
+	rows, err := p.db.QueryContext(ctx, selectNote+`
+		where `+visibleNotes+` and member_id = $3 and (not $4 or pinned)
+		order by pinned desc, create_time desc, id
+		limit $5
+		offset $6
+	`, viewer.Admin, viewer.Username, memberID, pinnedOnly, pageSize, pageToken.Offset)
+	if err != nil {
+		return nil, err
+	}
+
+	return scanNotes(rows)
+}
+
+// UpdateNote stores text, pinned and visibility of the note.
+func (p *Postgres) UpdateNote(ctx context.Context, note *pb.MemberNote) (*pb.MemberNote, error) {
+	updated, err := scanNote(p.db.QueryRowContext(ctx, `
+		update member_notes
+		set text = $3, pinned = $4, visibility = $5, update_time = now()
+		where member_id = $1 and id = $2
+		returning id, member_id, text, author, author_name, create_time, update_time, pinned, visibility
+	`, note.MemberId, note.Id, note.Text, note.Pinned, note.Visibility.String()))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrNotFound
+	}
+
+	return updated, err
+}
+
+func (p *Postgres) DeleteNote(ctx context.Context, memberID, id string) error {
+	result, err := p.db.ExecContext(ctx, `delete from member_notes where member_id = $1 and id = $2`, memberID, id)
+	if err != nil {
+		return err
+	}
+
+	deleted, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if deleted == 0 {
+		return ErrNotFound
+	}
+
+	return nil
+}
+
+type entryKind string
+
+const (
+	entryNote     entryKind = "note"
+	entryCard     entryKind = "card"
+	entryBriefing entryKind = "briefing"
+)
+
+// timelineEvent references a note, card or briefing, the service loads them.
+type timelineEvent struct {
+	kind entryKind
+	id   string
+	time time.Time
+}
+
+// ListTimeline lists notes, card issuances and briefings of the member, the latest first.
+func (p *Postgres) ListTimeline(
+	ctx context.Context, viewer Viewer, memberID string, pageSize int32, pageToken *pb.MemberTimelinePageToken,
+) ([]timelineEvent, error) {
+	rows, err := p.db.QueryContext(ctx, `
+		select kind, id, time
+		from (
+			select 'note' as kind, id, create_time as time
+			from member_notes
+			where `+visibleNotes+` and member_id = $3
+			union all
+			select 'card', id, lower(validity)
+			from cards
+			where member_id = $3
+			union all
+			select 'briefing', id, briefing_time
+			from briefings
+			where member_id = $3
+		) timeline
+		order by time desc, id
+		limit $4
+		offset $5
+	`, viewer.Admin, viewer.Username, memberID, pageSize, pageToken.Offset)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var events []timelineEvent
+
+	for rows.Next() {
+		var event timelineEvent
+
+		err = rows.Scan(&event.kind, &event.id, &event.time)
+		if err != nil {
+			return nil, err
+		}
+
+		events = append(events, event)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return events, nil
+}
+
+// NotesByID returns the notes with the given ids, keyed by id.
+func (p *Postgres) NotesByID(ctx context.Context, ids []string) (map[string]*pb.MemberNote, error) {
+	rows, err := p.db.QueryContext(ctx, selectNote+`where id = any($1)`, pgtype.FlatArray[string](ids))
+	if err != nil {
+		return nil, err
+	}
+
+	notes, err := scanNotes(rows)
+	if err != nil {
+		return nil, err
+	}
+
+	byID := make(map[string]*pb.MemberNote, len(notes))
+	for _, note := range notes {
+		byID[note.Id] = note
+	}
+
+	return byID, nil
+}
+
+type scanner interface {
+	Scan(values ...any) error
+}
+
+func scanNotes(rows *sql.Rows) ([]*pb.MemberNote, error) {
+	defer rows.Close()
+
+	var notes []*pb.MemberNote
+
+	for rows.Next() {
+		note, err := scanNote(rows)
+		if err != nil {
+			return nil, err
+		}
+
+		notes = append(notes, note)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return notes, nil
+}
+
+func scanNote(in scanner) (*pb.MemberNote, error) {
+	var (
+		note       = &pb.MemberNote{}
+		createTime time.Time
+		updateTime sql.Null[time.Time]
+		visibility string
+	)
+
+	err := in.Scan(
+		&note.Id, &note.MemberId, &note.Text, &note.Author, &note.AuthorName, &createTime, &updateTime, &note.Pinned,
+		&visibility,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	note.CreateTime = timestamppb.New(createTime)
+	note.Visibility = pb.MemberNote_Visibility(pb.MemberNote_Visibility_value[visibility])
+
+	if updateTime.Valid {
+		note.UpdateTime = timestamppb.New(updateTime.V)
+	}
+
+	return note, nil
+}
@@ -0,0 +1,440 @@
+package notes
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"slices"
+
+	"github.com/google/uuid"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	pb "github.com/cfhn/our-space/ourspace-backend/proto"
+	"github.com/cfhn/our-space/pkg/setup"
+	"github.com/cfhn/our-space/pkg/status"
+)
+
+const adminRole = "admin"
+
+type CardGetter interface {
+	GetCard(ctx context.Context, request *pb.GetCardRequest) (*pb.Card, error)
+}
+
+type BriefingGetter interface {
+	GetBriefing(ctx context.Context, request *pb.GetBriefingRequest) (*pb.Briefing, error)
+}
+
+type Service struct {
+	repo            *Postgres
+	cardService     CardGetter
+	briefingService BriefingGetter
+	pb.UnimplementedMemberNoteServiceServer
+}
+
+func NewService(repo *Postgres, cardService CardGetter, briefingService BriefingGetter) *Service {
+	return &Service{
+		repo:            repo,
+		cardService:     cardService,
+		briefingService: briefingService,
+	}
+}
+
+// caller is the staff member calling the service. Calls without claims come from within the backend and are treated
+// like admins. API keys are used by terminals, they have no access to notes.
+type caller struct {
+	Viewer
+	fullName string
+}
+
+func callerFromContext(ctx context.Context) (caller, error) {
+	claims, ok := setup.GetAccessTokenClaims(ctx)
+	if !ok {
+		return caller{Viewer: Viewer{Admin: true}}, nil
+	}
+
+	if claims.APIKey {
+		return caller{}, status.PermissionDenied()
+	}
+
+	return caller{
+		Viewer: Viewer{
+			Admin:    slices.Contains(claims.Roles, adminRole),
+			Username: claims.Subject,
+		},
+		fullName: claims.FullName,
+	}, nil
+}
+
+// canModify reports whether the caller can update or delete the note, only its author and admins can.
+func (c caller) canModify(note *pb.MemberNote) bool {
+	return c.Admin || (note.Author != "" && note.Author == c.Username)
+}
+
+func (s *Service) CreateMemberNote(ctx context.Context, request *pb.CreateMemberNoteRequest) (*pb.MemberNote, error) {
+	caller, err := callerFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if request.Note == nil {
+		return nil, status.FieldViolations([]*errdetails.BadRequest_FieldViolation{{
+			Field:       "note",
+			Description: "note must not be empty",
+			Reason:      "FIELD_EMPTY",
+		}})
+	}
+
+	if request.Note.Visibility == pb.MemberNote_VISIBILITY_UNKNOWN {
+		request.Note.Visibility = pb.MemberNote_VISIBILITY_STAFF
+	}
+
+	fieldViolations := validateNote(request.Note)
+
+	if _, err := uuid.Parse(request.Note.MemberId); err != nil {
+		fieldViolations = append(fieldViolations, &errdetails.BadRequest_FieldViolation{
+			Field:       "note.member_id",
+			Description: "member_id must be a valid UUID",
+			Reason:      "FIELD_INVALID",
+		})
+	}
+
+	if len(fieldViolations) != 0 {
+		return nil, status.FieldViolations(fieldViolations)
+	}
+
+	note, err := s.repo.CreateNote(ctx, &pb.MemberNote{
+		Id:         uuid.New().String(),
+		MemberId:   request.Note.MemberId,
+		Text:       request.Note.Text,
+		Author:     caller.Username,
+		AuthorName: caller.fullName,
+		Pinned:     request.Note.Pinned,
+		Visibility: request.Note.Visibility,
+	})
+	if errors.Is(err, ErrMemberNotFound) {
+		return nil, status.NotFound()
+	}
+
+	if err != nil {
+		return nil, status.Internal(err)
+	}
+
+	return note, nil
+}
+
+func validateNote(note *pb.MemberNote) []*errdetails.BadRequest_FieldViolation {
+	var fieldViolations []*errdetails.BadRequest_FieldViolation
+
+	if note.Text == "" {
+		fieldViolations = append(fieldViolations, &errdetails.BadRequest_FieldViolation{
+			Field:       "note.text",
+			Description: "text must not be empty",
+			Reason:      "FIELD_EMPTY",
+		})
+	}
+
+	if len(note.Text) > 16384 {
+		fieldViolations = append(fieldViolations, &errdetails.BadRequest_FieldViolation{
+			Field:       "note.text",
+			Description: "text must be smaller than 16KB",
+			Reason:      "FIELD_TOO_LARGE",
+		})
+	}
+
+	if note.Visibility != pb.MemberNote_VISIBILITY_STAFF && note.Visibility != pb.MemberNote_VISIBILITY_ADMINS {
+		fieldViolations = append(fieldViolations, &errdetails.BadRequest_FieldViolation{
+			Field:       "note.visibility",
+			Description: "visibility must be VISIBILITY_STAFF or VISIBILITY_ADMINS",
+			Reason:      "FIELD_INVALID",
+		})
+	}
+
+	return fieldViolations
+}
+
+func (s *Service) ListMemberNotes(
+	ctx context.Context, request *pb.ListMemberNotesRequest,
+) (*pb.ListMemberNotesResponse, error) {
+	caller, err := callerFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := uuid.Parse(request.MemberId); err != nil {
+		return nil, status.NotFound()
+	}
+
+	pageTokenBytes, err := base64.RawStdEncoding.DecodeString(request.PageToken)
+	if err != nil {
+		return nil, err
+	}
+
+	pageToken := &pb.MemberNotePageToken{}
+
+	err = proto.Unmarshal(pageTokenBytes, pageToken)
+	if err != nil {
+		return nil, err
+	}
+
+	pageSize := request.PageSize
+	if pageSize == 0 {
+		pageSize = 50
+	}
+
+	notes, err := s.repo.ListNotes(ctx, caller.Viewer, request.MemberId, request.PinnedOnly, pageSize+1, pageToken)
+	if err != nil {
+		return nil, status.Internal(err)
+	}
+
+	var nextPageToken string
+
+	if len(notes) > int(pageSize) {
+		notes = notes[:pageSize]
+
+		nextPageTokenBytes, err := proto.Marshal(&pb.MemberNotePageToken{Offset: pageToken.Offset + pageSize})
+		if err != nil {
+			return nil, err
+		}
+
+		nextPageToken = base64.RawStdEncoding.EncodeToString(nextPageTokenBytes)
+	}
+
+	return &pb.ListMemberNotesResponse{
+		Notes:         notes,
+		NextPageToken: nextPageToken,
+	}, nil
+}
+
+func (s *Service) UpdateMemberNote(ctx context.Context, request *pb.UpdateMemberNoteRequest) (*pb.MemberNote, error) {
+	if request.Note == nil {
+		return nil, status.FieldViolations([]*errdetails.BadRequest_FieldViolation{{
+			Field:       "note",
+			Description: "note must not be empty",
+			Reason:      "FIELD_EMPTY",
+		}})
+	}
+
+	existing, err := s.modifiableNote(ctx, request.Note.MemberId, request.Note.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	updated, fieldViolations := updatedNote(request, existing)
+	if len(fieldViolations) != 0 {
+		return nil, status.FieldViolations(fieldViolations)
+	}
+
+	note, err := s.repo.UpdateNote(ctx, updated)
+	if errors.Is(err, ErrNotFound) {
+		return nil, status.NotFound()
+	}
+
+	if err != nil {
+		return nil, status.Internal(err)
+	}
+
+	return note, nil
+}
+
+// updatedNote applies the field mask of the request to the existing note and validates the result.
+func updatedNote(
+	request *pb.UpdateMemberNoteRequest, existing *pb.MemberNote,
+) (*pb.MemberNote, []*errdetails.BadRequest_FieldViolation) {
+	if len(request.FieldMask.GetPaths()) == 0 {
+		return nil, []*errdetails.BadRequest_FieldViolation{{
+			Field:       "field_mask",
+			Description: "field_mask must not be empty",
+			Reason:      "FIELD_EMPTY",
+		}}
+	}
+
+	updated := proto.Clone(existing).(*pb.MemberNote) //nolint:forcetypeassert // clone has same type
+
+	for _, path := range request.FieldMask.Paths {
+		switch path {
+		case "text":
+			updated.Text = request.Note.Text
+		case "pinned":
+			updated.Pinned = request.Note.Pinned
+		case "visibility":
+			updated.Visibility = request.Note.Visibility
+		default:
+			return nil, []*errdetails.BadRequest_FieldViolation{{
+				Field:       "field_mask",
+				Description: "non-updatable field in field mask",
+				Reason:      "FIELD_INVALID",
+			}}
+		}
+	}
+
+	return updated, validateNote(updated)
+}
+
+func (s *Service) DeleteMemberNote(ctx context.Context, request *pb.DeleteMemberNoteRequest) (*emptypb.Empty, error) {
+	_, err := s.modifiableNote(ctx, request.MemberId, request.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.repo.DeleteNote(ctx, request.MemberId, request.Id)
+	if errors.Is(err, ErrNotFound) {
+		return nil, status.NotFound()
+	}
+
+	if err != nil {
+		return nil, status.Internal(err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// modifiableNote returns the note if the caller can update or delete it, otherwise a gRPC error.
+func (s *Service) modifiableNote(ctx context.Context, memberID, id string) (*pb.MemberNote, error) {
+	caller, err := callerFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	_, memberErr := uuid.Parse(memberID)
+	_, idErr := uuid.Parse(id)
+
+	if memberErr != nil || idErr != nil {
+		return nil, status.NotFound()
+	}
+
+	note, err := s.repo.GetNote(ctx, caller.Viewer, memberID, id)
+	if errors.Is(err, ErrNotFound) {
+		return nil, status.NotFound()
+	}
+
+	if err != nil {
+		return nil, status.Internal(err)
+	}
+
+	if !caller.canModify(note) {
+		return nil, status.PermissionDenied()
+	}
+
+	return note, nil
+}
+
+// ListMemberTimeline combines notes with card issuances and briefings. Cards and briefings are loaded through their
+// services, entries that were deleted in the meantime are left out.
+func (s *Service) ListMemberTimeline(
+	ctx context.Context, request *pb.ListMemberTimelineRequest,
+) (*pb.ListMemberTimelineResponse, error) {
+	caller, err := callerFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := uuid.Parse(request.MemberId); err != nil {
+		return nil, status.NotFound()
+	}
+
+	pageTokenBytes, err := base64.RawStdEncoding.DecodeString(request.PageToken)
+	if err != nil {
+		return nil, err
+	}
+
+	pageToken := &pb.MemberTimelinePageToken{}
+
+	err = proto.Unmarshal(pageTokenBytes, pageToken)
+	if err != nil {
+		return nil, err
+	}
+
+	pageSize := request.PageSize
+	if pageSize == 0 {
+		pageSize = 50
+	}
+
+	events, err := s.repo.ListTimeline(ctx, caller.Viewer, request.MemberId, pageSize+1, pageToken)
+	if err != nil {
+		return nil, status.Internal(err)
+	}
+
+	var nextPageToken string
+
+	if len(events) > int(pageSize) {
+		events = events[:pageSize]
+
+		nextPageTokenBytes, err := proto.Marshal(&pb.MemberTimelinePageToken{Offset: pageToken.Offset + pageSize})
+		if err != nil {
+			return nil, err
+		}
+
+		nextPageToken = base64.RawStdEncoding.EncodeToString(nextPageTokenBytes)
+	}
+
+	entries, err := s.timelineEntries(ctx, events)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.ListMemberTimelineResponse{
+		Entries:       entries,
+		NextPageToken: nextPageToken,
+	}, nil
+}
+
+func (s *Service) timelineEntries(ctx context.Context, events []timelineEvent) ([]*pb.MemberTimelineEntry, error) {
+	var noteIDs []string
+
+	for _, event := range events {
+		if event.kind == entryNote {
+			noteIDs = append(noteIDs, event.id)
+		}
+	}
+
+	notes, err := s.repo.NotesByID(ctx, noteIDs)
+	if err != nil {
+		return nil, status.Internal(err)
+	}
+
+	entries := make([]*pb.MemberTimelineEntry, 0, len(events))
+
+	for _, event := range events {
+		entry := &pb.MemberTimelineEntry{Time: timestamppb.New(event.time)}
+
+		switch event.kind {
+		case entryNote:
+			note, ok := notes[event.id]
+			if !ok {
+				continue
+			}
+
+			entry.Entry = &pb.MemberTimelineEntry_Note{Note: note}
+		case entryCard:
+			card, err := s.cardService.GetCard(ctx, &pb.GetCardRequest{Id: event.id})
+			if status.FromError(err).Code() == codes.NotFound {
+				continue
+			}
+
+			if err != nil {
+				return nil, err
+			}
+
+			entry.Entry = &pb.MemberTimelineEntry_Card{Card: card}
+		case entryBriefing:
+			briefing, err := s.briefingService.GetBriefing(ctx, &pb.GetBriefingRequest{Id: event.id})
+			if status.FromError(err).Code() == codes.NotFound {
+				continue
+			}
+
+			if err != nil {
+				return nil, err
+			}
+
+			entry.Entry = &pb.MemberTimelineEntry_Briefing{Briefing: briefing}
+		}
+
+		entries = append(entries, entry)
+	}
+
+	return entries, nil
+}
@@ -0,0 +1,164 @@
+package presence
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/cfhn/our-space/pkg/log"
+)
+
+var (
+	ErrUnknownWeekday     = errors.New("unknown weekday")
+	ErrInvalidClosingTime = errors.New("invalid closing time")
+)
+
+//nolint:gochecknoglobals // static lookup map
+var weekdays = map[string]time.Weekday{
+	"sunday":    time.Sunday,
+	"sun":       time.Sunday,
+	"monday":    time.Monday,
+	"mon":       time.Monday,
+	"tuesday":   time.Tuesday,
+	"tue":       time.Tuesday,
+	"wednesday": time.Wednesday,
+	"wed":       time.Wednesday,
+	"thursday":  time.Thursday,
+	"thu":       time.Thursday,
+	"friday":    time.Friday,
+	"fri":       time.Friday,
+	"saturday":  time.Saturday,
+	"sat":       time.Saturday,
+}
+
+// AutoCheckoutRules decide when a presence that is still open is considered forgotten.
+type AutoCheckoutRules struct {
+	// ClosingTimes maps a weekday to the closing time on that day as clock time since midnight. Presences that are still
+	// open after the next closing time following their checkin are closed at that closing time.
+	ClosingTimes map[time.Weekday]time.Duration
+	// MaxDuration is the maximum length of a presence. Zero disables the limit.
+	MaxDuration time.Duration
+	// Location is the time zone the closing times are in.
+	Location *time.Location
+}
+
+// ParseClosingTimes parses closing times in the form weekday=HH:MM, e.g. mon=22:00.
+func ParseClosingTimes(closingTimes map[string]string) (map[time.Weekday]time.Duration, error) {
+	parsed := make(map[time.Weekday]time.Duration, len(closingTimes))
+
+	for day, closingTime := range closingTimes {
+		weekday, ok := weekdays[strings.ToLower(strings.TrimSpace(day))]
+		if !ok {
+			return nil, fmt.Errorf("%w: %q", ErrUnknownWeekday, day)
+		}
+
+		clock, err := time.Parse("15:04", strings.TrimSpace(closingTime))
+		if err != nil {
+			return nil, fmt.Errorf("%w: %q", ErrInvalidClosingTime, closingTime)
+		}
+
+		parsed[weekday] = time.Duration(clock.Hour())*time.Hour + time.Duration(clock.Minute())*time.Minute
+	}
+
+	return parsed, nil
+}
+
+// Deadline returns the time at which a presence checked in at checkinTime is closed automatically. The second return
+// value is false if no rule applies to the presence.
+func (r *AutoCheckoutRules) Deadline(checkinTime time.Time) (time.Time, bool) {
+	var (
+		deadline time.Time
+		found    bool
+	)
+
+	if r.MaxDuration > 0 {
+		deadline = checkinTime.Add(r.MaxDuration)
+		found = true
+	}
+
+	location := r.Location
+	if location == nil {
+		location = time.Local
+	}
+
+	localCheckin := checkinTime.In(location)
+	day := time.Date(localCheckin.Year(), localCheckin.Month(), localCheckin.Day(), 0, 0, 0, 0, location)
+
+	// Look at one week ahead at most, the rules repeat after that.
+	for i := range 8 {
+		current := day.AddDate(0, 0, i)
+
+		offset, ok := r.ClosingTimes[current.Weekday()]
+		if !ok {
+			continue
+		}
+
+		// built from the clock time instead of adding the offset to midnight, which is off by an hour on days the
+		// clocks change
+		closingTime := time.Date(
+			current.Year(), current.Month(), current.Day(),
+			int(offset/time.Hour), int(offset%time.Hour/time.Minute), 0, 0, location,
+		)
+		if !closingTime.After(checkinTime) {
+			continue
+		}
+
+		if !found || closingTime.Before(deadline) {
+			deadline = closingTime
+			found = true
+		}
+
+		break
+	}
+
+	return deadline, found
+}
+
+// AutoCheckout closes presences that are still open after the configured rules, e.g. because a member forgot to
+// check out. It is meant to be run periodically as a job.
+type AutoCheckout struct {
+	repo   *Postgres
+	rules  AutoCheckoutRules
+	logger *slog.Logger
+}
+
+func NewAutoCheckout(repo *Postgres, rules AutoCheckoutRules, logger *slog.Logger) *AutoCheckout {
+	return &AutoCheckout{repo: repo, rules: rules, logger: logger}
+}
+
+func (a *AutoCheckout) Run(ctx context.Context) error {
+	presences, err := a.repo.ListOpenPresences(ctx)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+
+	var closeErrors []error
+
+	for _, presence := range presences {
+		deadline, ok := a.rules.Deadline(presence.CheckinTime.AsTime())
+		if !ok || deadline.After(now) {
+			continue
+		}
+
+		err := a.repo.AutoClosePresence(ctx, presence.Id, deadline)
+		if err != nil {
+			a.logger.ErrorContext(ctx, "failed to close presence", log.Error(err), slog.String("presence_id", presence.Id))
+			closeErrors = append(closeErrors, err)
+
+			continue
+		}
+
+		a.logger.InfoContext(ctx, "closed forgotten presence",
+			slog.String("presence_id", presence.Id),
+			slog.String("member_id", presence.MemberId),
+			slog.Time("checkout_time", deadline),
+		)
+	}
+
+	return errors.Join(closeErrors...)
+}
@@ -0,0 +1,82 @@
+package presence
+
+import (
+	"strconv"
+
+	"google.golang.org/genproto/googleapis/api/httpbody"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/cfhn/our-space/ourspace-backend/internal/export"
+	pb "github.com/cfhn/our-space/ourspace-backend/proto"
+	"github.com/cfhn/our-space/pkg/status"
+)
+
+// ExportPresences writes all presences matching the filter into a file.
+func (s Service) ExportPresences(
+	request *pb.ExportPresencesRequest, stream grpc.ServerStreamingServer[httpbody.HttpBody],
+) error {
+	ctx := stream.Context()
+
+	filter := &pb.ListPresencesRequest{}
+	if request.Filter != nil {
+		filter = proto.Clone(request.Filter).(*pb.ListPresencesRequest) //nolint:forcetypeassert // clone has same type
+	}
+
+	filter.PageSize = export.PageSize
+	filter.PageToken = ""
+
+	writer, err := export.NewWriter(stream, request.Format, "Presences")
+	if err != nil {
+		return status.Internal(err)
+	}
+
+	err = writer.Write([]string{
+		"id", "member_id", "member_name", "checkin_time", "checkout_time", "auto_closed", "terminal_id",
+	})
+	if err != nil {
+		return status.Internal(err)
+	}
+
+	memberNames := export.NewMemberNames(s.memberService)
+
+	for {
+		response, err := s.ListPresences(ctx, filter)
+		if err != nil {
+			return err
+		}
+
+		for _, presence := range response.Presence {
+			memberName, err := memberNames.Name(ctx, presence.MemberId)
+			if err != nil {
+				return err
+			}
+
+			err = writer.Write([]string{
+				presence.Id,
+				presence.MemberId,
+				memberName,
+				export.FormatTimestamp(presence.CheckinTime),
+				export.FormatTimestamp(presence.CheckoutTime),
+				strconv.FormatBool(presence.AutoClosed),
+				presence.GetTerminalId(),
+			})
+			if err != nil {
+				return status.Internal(err)
+			}
+		}
+
+		if response.NextPageToken == "" {
+			break
+		}
+
+		filter.PageToken = response.NextPageToken
+	}
+
+	err = writer.Close()
+	if err != nil {
+		return status.Internal(err)
+	}
+
+	return nil
+}
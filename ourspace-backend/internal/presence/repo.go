@@ -3,15 +3,32 @@ package presence
 import (
 	"context"
 	"database/sql"
+	"errors"
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgconn"
 	"google.golang.org/protobuf/types/known/fieldmaskpb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
 	pb "github.com/cfhn/our-space/ourspace-backend/proto"
 )
 
+const (
+	foreignKeyViolation = "23503"
+	uniqueViolation     = "23505"
+	checkViolation      = "23514"
+	exclusionViolation  = "23P01"
+)
+
+var (
+	ErrNotFound       = errors.New("presence not found")
+	ErrAlreadyPresent = errors.New("member is already checked in")
+	ErrOverlap        = errors.New("presence overlaps with another presence of the member")
+	ErrCheckoutOrder  = errors.New("checkout time is before checkin time")
+	ErrMemberNotFound = errors.New("member not found")
+)
+
 //nolint:gochecknoglobals // static lookup map
 var presenceFields = map[pb.PresenceField]string{
 	pb.PresenceField_PRESENCE_FIELD_ID:            "presence.id",
@@ -28,27 +45,55 @@ func NewPostgresRepo(db *sql.DB) *Postgres {
 	return &Postgres{db: db}
 }
 
-func (p *Postgres) CreatePresence(ctx context.Context, memberID string) (*pb.Presence, error) {
+// CreatePresence checks the member in. The terminal ID is optional and only recorded if it is not empty.
+func (p *Postgres) CreatePresence(ctx context.Context, memberID, terminalID string) (*pb.Presence, error) {
 	checkinTime := time.Now()
 	presenceID := uuid.New().String()
 
 	_, err := p.db.ExecContext(ctx, `
-		insert into presences (id, member_id, checkin_time)
-		values ($1, $2, $3);
-	`, presenceID, memberID, checkinTime)
+		insert into presences (id, member_id, checkin_time, terminal_id)
+		values ($1, $2, $3, $4);
+	`, presenceID, memberID, checkinTime, sql.Null[string]{V: terminalID, Valid: terminalID != ""})
+
 	if err != nil {
-		return nil, err
+		return nil, mapConstraintError(err)
 	}
 
 	return p.GetActivePresence(ctx, memberID)
 }
 
+// mapConstraintError translates violations of the constraints on the presences table into the matching sentinel
+// errors. Other errors are returned unchanged.
+func mapConstraintError(err error) error {
+	var pgErr *pgconn.PgError
+	if !errors.As(err, &pgErr) {
+		return err
+	}
+
+	switch {
+	case pgErr.Code == uniqueViolation && pgErr.ConstraintName == "single_active_presence":
+		return ErrAlreadyPresent
+	case pgErr.Code == exclusionViolation && pgErr.ConstraintName == "presences_no_overlap":
+		return ErrOverlap
+	case pgErr.Code == checkViolation && pgErr.ConstraintName == "presences_checkout_after_checkin":
+		return ErrCheckoutOrder
+	case pgErr.Code == foreignKeyViolation:
+		return ErrMemberNotFound
+	default:
+		return err
+	}
+}
+
 func (p *Postgres) GetActivePresence(ctx context.Context, memberID string) (*pb.Presence, error) {
 	row := p.db.QueryRowContext(ctx, `
-		select id, member_id, checkin_time, checkout_time from presences where member_id = $1 and checkout_time is null
+		select id, member_id, checkin_time, checkout_time, auto_closed, terminal_id from presences where member_id = $1 and checkout_time is null
 	`, memberID)
 
 	presence, err := scanPresence(row)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrNotFound
+	}
+
 	if err != nil {
 		return nil, err
 	}
@@ -57,10 +102,14 @@ func (p *Postgres) GetActivePresence(ctx context.Context, memberID string) (*pb.
 }
 
 func (p *Postgres) GetPresenceByID(ctx context.Context, presenceID string) (*pb.Presence, error) {
-	row := p.db.QueryRowContext(ctx, `select id, member_id, checkin_time, checkout_time from presences where id = $1
+	row := p.db.QueryRowContext(ctx, `select id, member_id, checkin_time, checkout_time, auto_closed, terminal_id from presences where id = $1
 	`, presenceID)
 
 	presence, err := scanPresence(row)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrNotFound
+	}
+
 	if err != nil {
 		return nil, err
 	}
@@ -77,6 +126,7 @@ func scanPresence(in scanner) (*pb.Presence, error) {
 		presence     = &pb.Presence{}
 		checkinTime  time.Time
 		checkoutTime sql.Null[time.Time]
+		terminalID   sql.Null[string]
 	)
 
 	err := in.Scan(
@@ -84,6 +134,8 @@ func scanPresence(in scanner) (*pb.Presence, error) {
 		&presence.MemberId,
 		&checkinTime,
 		&checkoutTime,
+		&presence.AutoClosed,
+		&terminalID,
 	)
 	if err != nil {
 		return nil, err
@@ -94,6 +146,10 @@ func scanPresence(in scanner) (*pb.Presence, error) {
 		presence.CheckoutTime = timestamppb.New(checkoutTime.V)
 	}
 
+	if terminalID.Valid {
+		presence.TerminalId = &terminalID.V
+	}
+
 	return presence, nil
 }
 
@@ -113,33 +169,127 @@ func (p *Postgres) UpdatePresence(ctx context.Context, presence *pb.Presence, fi
 			case "checkin_time":
 				checkinTime = sql.Null[time.Time]{V: presence.CheckinTime.AsTime(), Valid: true}
 			case "checkout_time":
-				checkoutTime = sql.Null[time.Time]{V: presence.CheckoutTime.AsTime(), Valid: true}
+				checkoutTime = sql.Null[time.Time]{V: presence.CheckoutTime.AsTime(), Valid: presence.CheckoutTime != nil}
 				changeCheckout = true
 			}
 		}
 	}
 
-	_, err := p.db.ExecContext(ctx, `
+	result, err := p.db.ExecContext(ctx, `
 		update presences
-		set 
-			checkout_time = case when $5 is true then $3::timestamptz end,
+		set
+			checkout_time = case when $5 is true then $3::timestamptz else checkout_time end,
+			auto_closed = case when $5 is true then false else auto_closed end,
 			checkin_time = coalesce($2, checkin_time),
 			member_id = coalesce($4, member_id)
 		where id = $1
 	`, presence.Id, checkinTime, checkoutTime, memberID, changeCheckout)
+	if err != nil {
+		return nil, mapConstraintError(err)
+	}
+
+	affected, err := result.RowsAffected()
 	if err != nil {
 		return nil, err
 	}
 
+	if affected == 0 {
+		return nil, ErrNotFound
+	}
+
 	return p.GetPresenceByID(ctx, presence.Id)
 }
 
+// CheckoutPresence closes the open presence of the member. It returns ErrNotFound if the member is not checked in.
 func (p *Postgres) CheckoutPresence(ctx context.Context, memberID string) (*pb.Presence, error) {
-	checkoutTime := timestamppb.New(time.Now())
-	presence := &pb.Presence{CheckoutTime: checkoutTime, MemberId: memberID}
-	mask := &fieldmaskpb.FieldMask{Paths: []string{"checkout_time"}}
+	var presenceID string
+
+	err := p.db.QueryRowContext(ctx, `
+		update presences
+		set checkout_time = $2
+		where member_id = $1 and checkout_time is null
+		returning id
+	`, memberID, time.Now()).Scan(&presenceID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrNotFound
+	}
+
+	if err != nil {
+		return nil, err
+	}
 
-	return p.UpdatePresence(ctx, presence, mask)
+	return p.GetPresenceByID(ctx, presenceID)
+}
+
+// FindOverlappingPresence returns a presence of the member, other than the given one, that overlaps with the time
+// range. A zero checkout time is treated as an open presence. It returns ErrNotFound if there is no overlap.
+func (p *Postgres) FindOverlappingPresence(
+	ctx context.Context, presenceID, memberID string, checkinTime, checkoutTime time.Time,
+) (*pb.Presence, error) {
+	row := p.db.QueryRowContext(ctx, `
+		select id, member_id, checkin_time, checkout_time, auto_closed, terminal_id from presences
+		where member_id = $1
+		and id <> $2
+		and tstzrange(checkin_time, checkout_time, '[)') && tstzrange($3::timestamptz, $4::timestamptz, '[)')
+		order by checkin_time
+		limit 1
+	`, memberID, presenceID, checkinTime, sql.Null[time.Time]{V: checkoutTime, Valid: !checkoutTime.IsZero()})
+
+	presence, err := scanPresence(row)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrNotFound
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return presence, nil
+}
+
+// ListOpenPresences returns all presences that have not been checked out yet.
+func (p *Postgres) ListOpenPresences(ctx context.Context) ([]*pb.Presence, error) {
+	rows, err := p.db.QueryContext(ctx, `
+		select id, member_id, checkin_time, checkout_time, auto_closed, terminal_id from presences
+		where checkout_time is null
+		order by checkin_time
+	`)
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	var presences []*pb.Presence
+
+	for rows.Next() {
+		presence, err := scanPresence(rows)
+		if err != nil {
+			return nil, err
+		}
+
+		presences = append(presences, presence)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return presences, nil
+}
+
+// AutoClosePresence closes an open presence at the given checkout time and marks it as auto closed. Presences that
+// have been checked out in the meantime are not touched.
+func (p *Postgres) AutoClosePresence(ctx context.Context, presenceID string, checkoutTime time.Time) error {
+	_, err := p.db.ExecContext(ctx, `
+		update presences
+		set
+			checkout_time = $2,
+			auto_closed = true
+		where id = $1 and checkout_time is null
+	`, presenceID, checkoutTime)
+
+	return err
 }
 
 type Filters struct {
@@ -171,7 +321,7 @@ func (p *Postgres) ListPresences(
 	}
 	//nolint:gosec // safe SQL building, all dynamic data is passed through a lookup map of safe values
 	rows, err := p.db.QueryContext(ctx, `
-		select id, member_id, checkin_time, checkout_time from presences
+		select id, member_id, checkin_time, checkout_time, auto_closed, terminal_id from presences
 		where			    
 		($1::uuid is null OR member_id = $1) 
 		and	($2::timestamptz is null OR checkin_time < $2)
@@ -225,10 +375,19 @@ func getSort(sortField pb.PresenceField, direction pb.SortDirection, token *pb.P
 }
 
 func (p *Postgres) DeletePresence(ctx context.Context, id string) error {
-	_, err := p.db.ExecContext(ctx, `delete from presences where id = $1`, id)
+	result, err := p.db.ExecContext(ctx, `delete from presences where id = $1`, id)
 	if err != nil {
 		return err
 	}
 
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if affected == 0 {
+		return ErrNotFound
+	}
+
 	return nil
 }
@@ -4,35 +4,64 @@ import (
 	"context"
 	"encoding/base64"
 	"errors"
+	"slices"
 	"time"
 
 	"github.com/google/uuid"
 	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
+	"github.com/cfhn/our-space/ourspace-backend/internal/cards"
 	pb "github.com/cfhn/our-space/ourspace-backend/proto"
 	"github.com/cfhn/our-space/pkg/status"
 )
 
 var ErrFieldUnknown = errors.New("unknown field")
 
+type MemberService interface {
+	GetMember(ctx context.Context, request *pb.GetMemberRequest) (*pb.Member, error)
+}
+
 type Service struct {
-	repo *Postgres
+	repo          *Postgres
+	memberService MemberService
+	cardService   cards.CardLister
 	pb.UnimplementedPresenceServiceServer
 }
 
-func NewService(repo *Postgres) *Service {
-	return &Service{repo: repo}
+func NewService(repo *Postgres, memberService MemberService, cardService cards.CardLister) *Service {
+	return &Service{repo: repo, memberService: memberService, cardService: cardService}
 }
 
+// Checkin checks the member in. Checking in a member that is already present is not an error, the open presence is
+// returned instead.
 func (s Service) Checkin(ctx context.Context, request *pb.CheckinRequest) (*pb.Presence, error) {
 	_, fieldViolations := validateCheckinRequest(request)
 	if fieldViolations != nil {
 		return nil, status.FieldViolations(fieldViolations)
 	}
 
-	presence, err := s.repo.CreatePresence(ctx, request.MemberId)
+	err := s.ensureMemberExists(ctx, request.MemberId)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.checkin(ctx, request.MemberId, "")
+}
+
+func (s Service) checkin(ctx context.Context, memberID, terminalID string) (*pb.Presence, error) {
+	presence, err := s.repo.CreatePresence(ctx, memberID, terminalID)
+	if errors.Is(err, ErrAlreadyPresent) {
+		presence, err = s.repo.GetActivePresence(ctx, memberID)
+	}
+
+	if errors.Is(err, ErrOverlap) {
+		return nil, status.FailedPrecondition("member has a presence that ends in the future")
+	}
+
 	if err != nil {
 		return nil, status.Internal(err)
 	}
@@ -40,6 +69,19 @@ func (s Service) Checkin(ctx context.Context, request *pb.CheckinRequest) (*pb.P
 	return presence, nil
 }
 
+func (s Service) ensureMemberExists(ctx context.Context, memberID string) error {
+	_, err := s.memberService.GetMember(ctx, &pb.GetMemberRequest{Id: memberID})
+
+	switch status.FromError(err).Code() {
+	case codes.OK:
+		return nil
+	case codes.NotFound:
+		return status.NotFound()
+	default:
+		return err
+	}
+}
+
 func validateCheckinRequest(request *pb.CheckinRequest) (bool, []*errdetails.BadRequest_FieldViolation) {
 	return validateMemberID(request.MemberId)
 }
@@ -75,7 +117,16 @@ func (s Service) Checkout(ctx context.Context, request *pb.CheckoutRequest) (*pb
 		return nil, status.FieldViolations(fieldViolations)
 	}
 
+	err := s.ensureMemberExists(ctx, request.MemberId)
+	if err != nil {
+		return nil, err
+	}
+
 	presence, err := s.repo.CheckoutPresence(ctx, request.MemberId)
+	if errors.Is(err, ErrNotFound) {
+		return nil, status.FailedPrecondition("member is not checked in")
+	}
+
 	if err != nil {
 		return nil, status.Internal(err)
 	}
@@ -87,6 +138,75 @@ func validateCheckoutRequest(request *pb.CheckoutRequest) (bool, []*errdetails.B
 	return validateMemberID(request.MemberId)
 }
 
+func (s Service) TogglePresence(
+	ctx context.Context, request *pb.TogglePresenceRequest,
+) (*pb.TogglePresenceResponse, error) {
+	_, fieldViolations := validateMemberID(request.MemberId)
+	if fieldViolations != nil {
+		return nil, status.FieldViolations(fieldViolations)
+	}
+
+	err := s.ensureMemberExists(ctx, request.MemberId)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.toggle(ctx, request.MemberId, "")
+}
+
+func (s Service) toggle(ctx context.Context, memberID, terminalID string) (*pb.TogglePresenceResponse, error) {
+	presence, err := s.repo.CheckoutPresence(ctx, memberID)
+	if err == nil {
+		return &pb.TogglePresenceResponse{
+			Presence: presence,
+			Action:   pb.PresenceAction_PRESENCE_ACTION_CHECKOUT,
+		}, nil
+	}
+
+	if !errors.Is(err, ErrNotFound) {
+		return nil, status.Internal(err)
+	}
+
+	presence, err = s.checkin(ctx, memberID, terminalID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.TogglePresenceResponse{
+		Presence: presence,
+		Action:   pb.PresenceAction_PRESENCE_ACTION_CHECKIN,
+	}, nil
+}
+
+// CheckinByCard toggles the presence of the member the presented card belongs to.
+func (s Service) CheckinByCard(
+	ctx context.Context, request *pb.CheckinByCardRequest,
+) (*pb.TogglePresenceResponse, error) {
+	fieldViolations := validateCheckinByCard(request)
+	if len(fieldViolations) != 0 {
+		return nil, status.FieldViolations(fieldViolations)
+	}
+
+	member, err := cards.ResolveActiveMember(ctx, s.cardService, s.memberService, request.RfidValue)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.toggle(ctx, member.Id, request.TerminalId)
+}
+
+func validateCheckinByCard(request *pb.CheckinByCardRequest) []*errdetails.BadRequest_FieldViolation {
+	if len(request.RfidValue) == 0 {
+		return []*errdetails.BadRequest_FieldViolation{{
+			Field:       "rfid_value",
+			Description: "rfid_value field must not be empty",
+			Reason:      "FIELD_EMPTY",
+		}}
+	}
+
+	return nil
+}
+
 func (s Service) ListPresences(ctx context.Context, request *pb.ListPresencesRequest) (*pb.ListPresencesResponse, error) {
 	pageTokenBytes, err := base64.RawURLEncoding.DecodeString(request.PageToken)
 	if err != nil {
@@ -136,14 +256,18 @@ func (s Service) ListPresences(ctx context.Context, request *pb.ListPresencesReq
 	if len(presences) > int(pageSize) {
 		presences = presences[:pageSize]
 
-		field := request.SortBy
+		field := pb.PresenceField_PRESENCE_FIELD_ID
 		if pageToken.Field != pb.PresenceField_PRESENCE_FIELD_UNKNOWN {
 			field = pageToken.Field
+		} else if request.SortBy != pb.PresenceField_PRESENCE_FIELD_UNKNOWN {
+			field = request.SortBy
 		}
 
 		direction := pb.SortDirection_SORT_DIRECTION_ASCENDING
 		if pageToken.Direction != pb.SortDirection_SORT_DIRECTION_DEFAULT {
 			direction = pageToken.Direction
+		} else if request.SortDirection != pb.SortDirection_SORT_DIRECTION_DEFAULT {
+			direction = request.SortDirection
 		}
 
 		lastValue, err := getFieldValue(presences[pageSize-1], field)
@@ -187,21 +311,166 @@ func getFieldValue(presence *pb.Presence, field pb.PresenceField) (string, error
 	}
 }
 
+// UpdatePresence corrects a presence. The resulting presence has to keep the checkout after the checkin and must not
+// overlap with any other presence of the member.
 func (s Service) UpdatePresence(ctx context.Context, request *pb.UpdatePresenceRequest) (*pb.Presence, error) {
-	_, fieldViolations := validateUpdatePresence(request)
-	if fieldViolations != nil {
+	fieldViolations := validateUpdatePresence(request)
+	if len(fieldViolations) != 0 {
 		return nil, status.FieldViolations(fieldViolations)
 	}
 
-	presence, err := s.repo.UpdatePresence(ctx, request.GetPresence(), request.FieldMask)
+	current, err := s.repo.GetPresenceByID(ctx, request.Presence.Id)
+	if errors.Is(err, ErrNotFound) {
+		return nil, status.NotFound()
+	}
+
+	if err != nil {
+		return nil, status.Internal(err)
+	}
+
+	fieldViolations, err = s.validatePresenceConsistency(ctx, mergePresence(current, request), request.FieldMask.Paths)
 	if err != nil {
+		return nil, err
+	}
+
+	if len(fieldViolations) != 0 {
+		return nil, status.FieldViolations(fieldViolations)
+	}
+
+	presence, err := s.repo.UpdatePresence(ctx, request.Presence, request.FieldMask)
+
+	// The checks above can race with concurrent changes, the constraints in the database are the last line of defense.
+	switch {
+	case errors.Is(err, ErrNotFound):
+		return nil, status.NotFound()
+	case errors.Is(err, ErrCheckoutOrder):
+		return nil, status.FieldViolations(checkoutOrderViolations(request.FieldMask.Paths))
+	case errors.Is(err, ErrOverlap), errors.Is(err, ErrAlreadyPresent):
+		return nil, status.FieldViolations(overlapViolations(request.FieldMask.Paths, "another presence of the member"))
+	case errors.Is(err, ErrMemberNotFound):
+		return nil, status.FieldViolations(memberNotFoundViolations())
+	case err != nil:
 		return nil, status.Internal(err)
 	}
 
 	return presence, nil
 }
 
-func validateUpdatePresence(request *pb.UpdatePresenceRequest) (bool, []*errdetails.BadRequest_FieldViolation) {
+func mergePresence(current *pb.Presence, request *pb.UpdatePresenceRequest) *pb.Presence {
+	merged := proto.Clone(current).(*pb.Presence) //nolint:forcetypeassert // clone keeps the type
+
+	for _, path := range request.FieldMask.Paths {
+		switch path {
+		case "member_id":
+			merged.MemberId = request.Presence.MemberId
+		case "checkin_time":
+			merged.CheckinTime = request.Presence.CheckinTime
+		case "checkout_time":
+			merged.CheckoutTime = request.Presence.CheckoutTime
+		}
+	}
+
+	return merged
+}
+
+func (s Service) validatePresenceConsistency(
+	ctx context.Context, presence *pb.Presence, paths []string,
+) ([]*errdetails.BadRequest_FieldViolation, error) {
+	if slices.Contains(paths, "member_id") {
+		_, err := s.memberService.GetMember(ctx, &pb.GetMemberRequest{Id: presence.MemberId})
+		if status.FromError(err).Code() == codes.NotFound {
+			return memberNotFoundViolations(), nil
+		}
+
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	checkinTime := presence.CheckinTime.AsTime()
+
+	var checkoutTime time.Time
+	if presence.CheckoutTime != nil {
+		checkoutTime = presence.CheckoutTime.AsTime()
+
+		if checkoutTime.Before(checkinTime) {
+			return checkoutOrderViolations(paths), nil
+		}
+	}
+
+	overlapping, err := s.repo.FindOverlappingPresence(ctx, presence.Id, presence.MemberId, checkinTime, checkoutTime)
+	if errors.Is(err, ErrNotFound) {
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, status.Internal(err)
+	}
+
+	return overlapViolations(paths, "presence "+overlapping.Id), nil
+}
+
+func checkoutOrderViolations(paths []string) []*errdetails.BadRequest_FieldViolation {
+	field := "presence.checkout_time"
+	if !slices.Contains(paths, "checkout_time") {
+		field = "presence.checkin_time"
+	}
+
+	return []*errdetails.BadRequest_FieldViolation{{
+		Field:       field,
+		Description: "checkout_time must not be before checkin_time",
+		Reason:      "FIELD_INVALID",
+	}}
+}
+
+// overlapViolations reports the overlap on every updated field, as each of them contributes to the conflict.
+func overlapViolations(paths []string, conflict string) []*errdetails.BadRequest_FieldViolation {
+	fieldViolations := make([]*errdetails.BadRequest_FieldViolation, 0, len(paths))
+
+	for _, path := range paths {
+		fieldViolations = append(fieldViolations, &errdetails.BadRequest_FieldViolation{
+			Field:       "presence." + path,
+			Description: "presence must not overlap with " + conflict,
+			Reason:      "PRESENCE_OVERLAP",
+		})
+	}
+
+	return fieldViolations
+}
+
+func memberNotFoundViolations() []*errdetails.BadRequest_FieldViolation {
+	return []*errdetails.BadRequest_FieldViolation{{
+		Field:       "presence.member_id",
+		Description: "member does not exist",
+		Reason:      "FIELD_INVALID",
+	}}
+}
+
+func validateUpdatePresence(request *pb.UpdatePresenceRequest) []*errdetails.BadRequest_FieldViolation {
+	if request.Presence == nil {
+		return []*errdetails.BadRequest_FieldViolation{{
+			Field:       "presence",
+			Description: "presence field must not be empty",
+			Reason:      "FIELD_EMPTY",
+		}}
+	}
+
+	if _, err := uuid.Parse(request.Presence.Id); err != nil {
+		return []*errdetails.BadRequest_FieldViolation{{
+			Field:       "presence.id",
+			Description: "id must be a valid UUID",
+			Reason:      "INVALID_FORMAT",
+		}}
+	}
+
+	if len(request.FieldMask.GetPaths()) == 0 || !request.FieldMask.IsValid(&pb.Presence{}) {
+		return []*errdetails.BadRequest_FieldViolation{{
+			Field:       "field_mask",
+			Description: "field_mask must only contain member_id, checkin_time and checkout_time",
+			Reason:      "FIELD_INVALID",
+		}}
+	}
+
 	fieldViolations := make([]*errdetails.BadRequest_FieldViolation, 0)
 
 	for _, path := range request.FieldMask.Paths {
@@ -211,62 +480,61 @@ func validateUpdatePresence(request *pb.UpdatePresenceRequest) (bool, []*errdeta
 			if err != nil {
 				fieldViolations = append(fieldViolations, &errdetails.BadRequest_FieldViolation{
 					Field:       "presence.member_id",
-					Description: "member_id field must not be empty",
-					Reason:      "INVALID_MEMBERID",
+					Description: "member_id must be a valid UUID",
+					Reason:      "INVALID_FORMAT",
 				})
 			}
 		case "checkin_time":
-			switch {
-			case request.Presence.CheckinTime == nil:
-				fieldViolations = append(fieldViolations, &errdetails.BadRequest_FieldViolation{
-					Field:       "presence.checkin_time",
-					Description: "checkin_time must be set",
-					Reason:      "FIELD_EMPTY",
-				})
-			case request.Presence.CheckinTime.AsTime().Before(time.Date(1900, 1, 1, 0, 0, 0, 0, time.UTC)):
-				fieldViolations = append(fieldViolations, &errdetails.BadRequest_FieldViolation{
-					Field:       "member.membership_start",
-					Description: "membership_start must after the year 1900",
-					Reason:      "FIELD_INVALID",
-				})
-			case request.Presence.CheckinTime.AsTime().After(time.Now().Add(15 * time.Minute)):
-				fieldViolations = append(fieldViolations, &errdetails.BadRequest_FieldViolation{
-					Field:       "member.membership_start",
-					Description: "membership_start must not be in the future",
-					Reason:      "FIELD_INVALID",
-				})
-			}
+			fieldViolations = append(fieldViolations, validatePresenceTime("checkin_time", request.Presence.CheckinTime)...)
 		case "checkout_time":
-			switch {
-			case request.Presence.CheckinTime == nil:
-				fieldViolations = append(fieldViolations, &errdetails.BadRequest_FieldViolation{
-					Field:       "presence.checkout_time",
-					Description: "checkout_time must be set",
-					Reason:      "FIELD_EMPTY",
-				})
-			case request.Presence.CheckoutTime.AsTime().Before(time.Date(1900, 1, 1, 0, 0, 0, 0, time.UTC)):
-				fieldViolations = append(fieldViolations, &errdetails.BadRequest_FieldViolation{
-					Field:       "member.membership_start",
-					Description: "membership_start must after the year 1900",
-					Reason:      "FIELD_INVALID",
-				})
-			case request.Presence.CheckoutTime.AsTime().After(time.Now().Add(15 * time.Minute)):
-				fieldViolations = append(fieldViolations, &errdetails.BadRequest_FieldViolation{
-					Field:       "member.membership_start",
-					Description: "membership_start must not be in the future",
-					Reason:      "FIELD_INVALID",
-				})
-			}
+			fieldViolations = append(fieldViolations, validatePresenceTime("checkout_time", request.Presence.CheckoutTime)...)
+		default:
+			fieldViolations = append(fieldViolations, &errdetails.BadRequest_FieldViolation{
+				Field:       "field_mask",
+				Description: path + " can not be updated",
+				Reason:      "FIELD_INVALID",
+			})
 		}
 	}
 
-	return true, fieldViolations
+	return fieldViolations
+}
+
+func validatePresenceTime(field string, value *timestamppb.Timestamp) []*errdetails.BadRequest_FieldViolation {
+	const futureLeeway = 15 * time.Minute
+
+	switch {
+	case value == nil:
+		return []*errdetails.BadRequest_FieldViolation{{
+			Field:       "presence." + field,
+			Description: field + " must be set",
+			Reason:      "FIELD_EMPTY",
+		}}
+	case value.AsTime().Before(time.Date(1900, 1, 1, 0, 0, 0, 0, time.UTC)):
+		return []*errdetails.BadRequest_FieldViolation{{
+			Field:       "presence." + field,
+			Description: field + " must be after the year 1900",
+			Reason:      "FIELD_INVALID",
+		}}
+	case value.AsTime().After(time.Now().Add(futureLeeway)):
+		return []*errdetails.BadRequest_FieldViolation{{
+			Field:       "presence." + field,
+			Description: field + " must not be in the future",
+			Reason:      "FIELD_INVALID",
+		}}
+	default:
+		return nil
+	}
 }
 
 func (s Service) DeletePresence(ctx context.Context, request *pb.DeletePresenceRequest) (*emptypb.Empty, error) {
 	err := s.repo.DeletePresence(ctx, request.Id)
+	if errors.Is(err, ErrNotFound) {
+		return nil, status.NotFound()
+	}
+
 	if err != nil {
-		return nil, err
+		return nil, status.Internal(err)
 	}
 
 	return &emptypb.Empty{}, nil
@@ -0,0 +1,306 @@
+package reports
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	pb "github.com/cfhn/our-space/ourspace-backend/proto"
+)
+
+//nolint:gochecknoglobals // static lookup map
+var bucketUnits = map[pb.ReportBucket]string{
+	pb.ReportBucket_REPORT_BUCKET_DAY:   "day",
+	pb.ReportBucket_REPORT_BUCKET_WEEK:  "week",
+	pb.ReportBucket_REPORT_BUCKET_MONTH: "month",
+}
+
+// presenceIntervals clips all presences to the buckets they overlap with. Presences that are still open are counted
+// until now. It expects a buckets CTE with the columns bucket_start and bucket_end to be defined before.
+const presenceIntervals = `
+	intervals as (
+		select
+			buckets.bucket_start,
+			presences.member_id,
+			members.age_category,
+			members.tags,
+			greatest(presences.checkin_time, buckets.bucket_start) as from_time,
+			least(coalesce(presences.checkout_time, now()), buckets.bucket_end) as to_time
+		from buckets
+		inner join presences
+			on presences.checkin_time < buckets.bucket_end
+			and coalesce(presences.checkout_time, now()) > buckets.bucket_start
+		inner join members on members.id = presences.member_id
+	)
+`
+
+type Range struct {
+	Start    time.Time
+	End      time.Time
+	Bucket   pb.ReportBucket
+	TimeZone string
+}
+
+type Postgres struct {
+	db *sql.DB
+}
+
+func NewPostgresRepo(db *sql.DB) *Postgres {
+	return &Postgres{db: db}
+}
+
+// PresenceStatistics calculates the presence statistics for every bucket in the range. If the bucket is
+// REPORT_BUCKET_UNKNOWN, the whole range is treated as a single bucket.
+func (p *Postgres) PresenceStatistics(ctx context.Context, reportRange *Range) ([]*pb.PresenceStatistics, error) {
+	bucketsSQL, values := generateBuckets(reportRange)
+
+	//nolint:gosec // manual concatenation is fine here, uses bound placeholders
+	rows, err := p.db.QueryContext(ctx, `
+		with `+bucketsSQL+`, `+presenceIntervals+`,
+		events as (
+			select bucket_start, from_time as event_time, 1 as delta from intervals
+			union all
+			select bucket_start, to_time as event_time, -1 as delta from intervals
+		),
+		occupancy as (
+			select
+				bucket_start,
+				sum(delta) over (
+					partition by bucket_start
+					order by event_time, delta
+					rows between unbounded preceding and current row
+				) as occupancy
+			from events
+		)
+		select
+			buckets.bucket_start,
+			buckets.bucket_end,
+			count(distinct intervals.member_id),
+			count(intervals.member_id),
+			coalesce(sum(extract(epoch from intervals.to_time - intervals.from_time)), 0)::float8 / 3600,
+			coalesce((select max(occupancy.occupancy) from occupancy where occupancy.bucket_start = buckets.bucket_start), 0)
+		from buckets
+		left join intervals on intervals.bucket_start = buckets.bucket_start
+		group by buckets.bucket_start, buckets.bucket_end
+		order by buckets.bucket_start
+	`, values...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var (
+		statistics []*pb.PresenceStatistics
+		byStart    = map[time.Time]*pb.PresenceStatistics{}
+	)
+
+	for rows.Next() {
+		var (
+			bucketStart, bucketEnd time.Time
+			stats                  = &pb.PresenceStatistics{}
+		)
+
+		err := rows.Scan(&bucketStart, &bucketEnd, &stats.UniqueVisitors, &stats.Visits, &stats.TotalHours, &stats.PeakOccupancy)
+		if err != nil {
+			return nil, err
+		}
+
+		stats.StartTime = timestamppb.New(bucketStart)
+		stats.EndTime = timestamppb.New(bucketEnd)
+
+		statistics = append(statistics, stats)
+		byStart[bucketStart.UTC()] = stats
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	err = p.addAgeCategoryStatistics(ctx, bucketsSQL, values, byStart)
+	if err != nil {
+		return nil, err
+	}
+
+	err = p.addTagStatistics(ctx, bucketsSQL, values, byStart)
+	if err != nil {
+		return nil, err
+	}
+
+	return statistics, nil
+}
+
+func (p *Postgres) addAgeCategoryStatistics(
+	ctx context.Context, bucketsSQL string, values []any, byStart map[time.Time]*pb.PresenceStatistics,
+) error {
+	//nolint:gosec // manual concatenation is fine here, uses bound placeholders
+	rows, err := p.db.QueryContext(ctx, `
+		with `+bucketsSQL+`, `+presenceIntervals+`
+		select
+			bucket_start,
+			age_category,
+			count(distinct member_id),
+			count(*),
+			sum(extract(epoch from to_time - from_time))::float8 / 3600
+		from intervals
+		group by bucket_start, age_category
+		order by bucket_start, age_category
+	`, values...)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var (
+			bucketStart time.Time
+			ageCategory string
+			stats       = &pb.AgeCategoryStatistics{}
+		)
+
+		err := rows.Scan(&bucketStart, &ageCategory, &stats.UniqueVisitors, &stats.Visits, &stats.TotalHours)
+		if err != nil {
+			return err
+		}
+
+		stats.AgeCategory = pb.AgeCategory(pb.AgeCategory_value[ageCategory])
+
+		if bucket, ok := byStart[bucketStart.UTC()]; ok {
+			bucket.AgeCategories = append(bucket.AgeCategories, stats)
+		}
+	}
+
+	return rows.Err()
+}
+
+func (p *Postgres) addTagStatistics(
+	ctx context.Context, bucketsSQL string, values []any, byStart map[time.Time]*pb.PresenceStatistics,
+) error {
+	//nolint:gosec // manual concatenation is fine here, uses bound placeholders
+	rows, err := p.db.QueryContext(ctx, `
+		with `+bucketsSQL+`, `+presenceIntervals+`
+		select
+			intervals.bucket_start,
+			tag,
+			count(distinct intervals.member_id),
+			count(*),
+			sum(extract(epoch from intervals.to_time - intervals.from_time))::float8 / 3600
+		from intervals
+		cross join unnest(intervals.tags) as tag
+		group by intervals.bucket_start, tag
+		order by intervals.bucket_start, tag
+	`, values...)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var (
+			bucketStart time.Time
+			stats       = &pb.TagStatistics{}
+		)
+
+		err := rows.Scan(&bucketStart, &stats.Tag, &stats.UniqueVisitors, &stats.Visits, &stats.TotalHours)
+		if err != nil {
+			return err
+		}
+
+		if bucket, ok := byStart[bucketStart.UTC()]; ok {
+			bucket.Tags = append(bucket.Tags, stats)
+		}
+	}
+
+	return rows.Err()
+}
+
+func generateBuckets(reportRange *Range) (string, []any) {
+	unit, ok := bucketUnits[reportRange.Bucket]
+	if !ok {
+		return `buckets as (
+			select $1::timestamptz as bucket_start, $2::timestamptz as bucket_end
+		)`, []any{reportRange.Start, reportRange.End}
+	}
+
+	return `buckets as (
+		select
+			greatest(series.series_start, $1::timestamptz) as bucket_start,
+			least(date_add(series.series_start, $3::interval, $4::text), $2::timestamptz) as bucket_end
+		from generate_series(
+			date_trunc($5::text, $1::timestamptz, $4::text),
+			$2::timestamptz - interval '1 microsecond',
+			$3::interval,
+			$4::text
+		) as series(series_start)
+	)`, []any{reportRange.Start, reportRange.End, "1 " + unit, reportRange.TimeZone, unit}
+}
+
+//nolint:gochecknoglobals // static lookup map
+var usageGroupColumns = map[pb.UsageReportGrouping]string{
+	pb.UsageReportGrouping_USAGE_REPORT_GROUPING_UNKNOWN: "''",
+	pb.UsageReportGrouping_USAGE_REPORT_GROUPING_MEMBER:  "member_id::text",
+	pb.UsageReportGrouping_USAGE_REPORT_GROUPING_MACHINE: "machine_id::text",
+}
+
+// MachineUsage sums up the usage sessions that started in the time range per member or per machine. If groupBy is
+// USAGE_REPORT_GROUPING_UNKNOWN, a single entry for all sessions is returned. Every started minute counts, running
+// sessions are counted until now.
+func (p *Postgres) MachineUsage(
+	ctx context.Context, start, end time.Time, groupBy pb.UsageReportGrouping,
+) ([]*pb.UsageStatistics, error) {
+	//nolint:gosec // manual concatenation is fine here, the column comes from a static lookup map
+	rows, err := p.db.QueryContext(ctx, `
+		with sessions as (
+			select
+				`+usageGroupColumns[groupBy]+` as group_key,
+				ceil(extract(epoch from coalesce(end_time, now()) - start_time) / 60)::bigint as minutes,
+				price_per_minute_cents
+			from usage_sessions
+			where start_time >= $1 and start_time < $2
+		)
+		select
+			group_key,
+			count(*),
+			coalesce(sum(minutes), 0)::bigint,
+			coalesce(sum(minutes * price_per_minute_cents), 0)::bigint
+		from sessions
+		group by group_key
+		order by group_key
+	`, start, end)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var statistics []*pb.UsageStatistics
+
+	for rows.Next() {
+		var (
+			groupKey string
+			stats    = &pb.UsageStatistics{}
+		)
+
+		err := rows.Scan(&groupKey, &stats.Sessions, &stats.TotalMinutes, &stats.TotalPriceCents)
+		if err != nil {
+			return nil, err
+		}
+
+		switch groupBy {
+		case pb.UsageReportGrouping_USAGE_REPORT_GROUPING_MEMBER:
+			stats.MemberId = groupKey
+		case pb.UsageReportGrouping_USAGE_REPORT_GROUPING_MACHINE:
+			stats.MachineId = groupKey
+		case pb.UsageReportGrouping_USAGE_REPORT_GROUPING_UNKNOWN:
+			// totals are not grouped
+		}
+
+		statistics = append(statistics, stats)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return statistics, nil
+}
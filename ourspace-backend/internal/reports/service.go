@@ -0,0 +1,382 @@
+package reports
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"strconv"
+	"time"
+
+	"google.golang.org/genproto/googleapis/api/httpbody"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	pb "github.com/cfhn/our-space/ourspace-backend/proto"
+	"github.com/cfhn/our-space/pkg/status"
+)
+
+const maxBuckets = 1000
+
+type Service struct {
+	repo *Postgres
+	pb.UnimplementedReportServiceServer
+}
+
+func NewService(repo *Postgres) *Service {
+	return &Service{repo: repo}
+}
+
+func (s *Service) GetPresenceReport(
+	ctx context.Context, request *pb.GetPresenceReportRequest,
+) (*pb.PresenceReport, error) {
+	fieldViolations := validateGetPresenceReport(request)
+	if len(fieldViolations) != 0 {
+		return nil, status.FieldViolations(fieldViolations)
+	}
+
+	timeZone := request.TimeZone
+	if timeZone == "" {
+		timeZone = "UTC"
+	}
+
+	reportRange := &Range{
+		Start:    request.StartTime.AsTime(),
+		End:      request.EndTime.AsTime(),
+		Bucket:   request.Bucket,
+		TimeZone: timeZone,
+	}
+
+	buckets, err := s.repo.PresenceStatistics(ctx, reportRange)
+	if err != nil {
+		return nil, status.Internal(err)
+	}
+
+	reportRange.Bucket = pb.ReportBucket_REPORT_BUCKET_UNKNOWN
+
+	total, err := s.repo.PresenceStatistics(ctx, reportRange)
+	if err != nil {
+		return nil, status.Internal(err)
+	}
+
+	report := &pb.PresenceReport{
+		StartTime: request.StartTime,
+		EndTime:   request.EndTime,
+		Bucket:    request.Bucket,
+		Buckets:   buckets,
+	}
+
+	if len(total) != 0 {
+		report.Total = total[0]
+	}
+
+	return report, nil
+}
+
+func validateGetPresenceReport(request *pb.GetPresenceReportRequest) []*errdetails.BadRequest_FieldViolation {
+	var fieldViolations []*errdetails.BadRequest_FieldViolation
+
+	if request.StartTime == nil {
+		fieldViolations = append(fieldViolations, &errdetails.BadRequest_FieldViolation{
+			Field:       "start_time",
+			Description: "start_time must be set",
+			Reason:      "FIELD_EMPTY",
+		})
+	}
+
+	if request.EndTime == nil {
+		fieldViolations = append(fieldViolations, &errdetails.BadRequest_FieldViolation{
+			Field:       "end_time",
+			Description: "end_time must be set",
+			Reason:      "FIELD_EMPTY",
+		})
+	}
+
+	if _, ok := bucketUnits[request.Bucket]; !ok {
+		fieldViolations = append(fieldViolations, &errdetails.BadRequest_FieldViolation{
+			Field:       "bucket",
+			Description: "bucket must be a supported bucket size",
+			Reason:      "FIELD_INVALID",
+		})
+	}
+
+	location := time.UTC
+
+	if request.TimeZone != "" {
+		var err error
+
+		location, err = time.LoadLocation(request.TimeZone)
+		if err != nil {
+			fieldViolations = append(fieldViolations, &errdetails.BadRequest_FieldViolation{
+				Field:       "time_zone",
+				Description: "time_zone must be a valid IANA time zone",
+				Reason:      "FIELD_INVALID",
+			})
+		}
+	}
+
+	if len(fieldViolations) != 0 {
+		return fieldViolations
+	}
+
+	start, end := request.StartTime.AsTime(), request.EndTime.AsTime()
+
+	if !end.After(start) {
+		return []*errdetails.BadRequest_FieldViolation{{
+			Field:       "end_time",
+			Description: "end_time must be after start_time",
+			Reason:      "FIELD_INVALID",
+		}}
+	}
+
+	if countBuckets(start.In(location), end, request.Bucket) > maxBuckets {
+		return []*errdetails.BadRequest_FieldViolation{{
+			Field:       "bucket",
+			Description: "time range contains too many buckets, choose a bigger bucket size or a shorter time range",
+			Reason:      "FIELD_INVALID",
+		}}
+	}
+
+	return nil
+}
+
+func countBuckets(start, end time.Time, bucket pb.ReportBucket) int {
+	count := 0
+
+	for current := start; current.Before(end) && count <= maxBuckets; count++ {
+		switch bucket {
+		case pb.ReportBucket_REPORT_BUCKET_DAY:
+			current = current.AddDate(0, 0, 1)
+		case pb.ReportBucket_REPORT_BUCKET_WEEK:
+			current = current.AddDate(0, 0, 7)
+		case pb.ReportBucket_REPORT_BUCKET_MONTH:
+			current = current.AddDate(0, 1, 0)
+		default:
+			return maxBuckets + 1
+		}
+	}
+
+	return count
+}
+
+func (s *Service) ExportPresenceReport(
+	ctx context.Context, request *pb.GetPresenceReportRequest,
+) (*httpbody.HttpBody, error) {
+	report, err := s.GetPresenceReport(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+
+	writer := csv.NewWriter(&buf)
+
+	err = writer.Write([]string{
+		"bucket_start", "bucket_end", "breakdown", "value", "unique_visitors", "visits", "total_hours", "peak_occupancy",
+	})
+	if err != nil {
+		return nil, status.Internal(err)
+	}
+
+	for _, bucket := range report.Buckets {
+		err = writeStatistics(writer, bucket)
+		if err != nil {
+			return nil, status.Internal(err)
+		}
+	}
+
+	if report.Total != nil {
+		err = writeStatistics(writer, report.Total)
+		if err != nil {
+			return nil, status.Internal(err)
+		}
+	}
+
+	writer.Flush()
+
+	if err := writer.Error(); err != nil {
+		return nil, status.Internal(err)
+	}
+
+	return &httpbody.HttpBody{
+		ContentType: "text/csv",
+		Data:        buf.Bytes(),
+	}, nil
+}
+
+func writeStatistics(writer *csv.Writer, stats *pb.PresenceStatistics) error {
+	start, end := formatTimestamp(stats.StartTime), formatTimestamp(stats.EndTime)
+
+	err := writer.Write([]string{
+		start, end, "total", "",
+		strconv.FormatInt(stats.UniqueVisitors, 10),
+		strconv.FormatInt(stats.Visits, 10),
+		strconv.FormatFloat(stats.TotalHours, 'f', 2, 64),
+		strconv.FormatInt(stats.PeakOccupancy, 10),
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, ageCategory := range stats.AgeCategories {
+		err = writer.Write([]string{
+			start, end, "age_category", ageCategory.AgeCategory.String(),
+			strconv.FormatInt(ageCategory.UniqueVisitors, 10),
+			strconv.FormatInt(ageCategory.Visits, 10),
+			strconv.FormatFloat(ageCategory.TotalHours, 'f', 2, 64),
+			"",
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, tag := range stats.Tags {
+		err = writer.Write([]string{
+			start, end, "tag", tag.Tag,
+			strconv.FormatInt(tag.UniqueVisitors, 10),
+			strconv.FormatInt(tag.Visits, 10),
+			strconv.FormatFloat(tag.TotalHours, 'f', 2, 64),
+			"",
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func formatTimestamp(timestamp *timestamppb.Timestamp) string {
+	if timestamp == nil {
+		return ""
+	}
+
+	return timestamp.AsTime().Format(time.RFC3339)
+}
+
+func (s *Service) GetMachineUsageReport(
+	ctx context.Context, request *pb.GetMachineUsageReportRequest,
+) (*pb.MachineUsageReport, error) {
+	fieldViolations := validateGetMachineUsageReport(request)
+	if len(fieldViolations) != 0 {
+		return nil, status.FieldViolations(fieldViolations)
+	}
+
+	start, end := request.StartTime.AsTime(), request.EndTime.AsTime()
+
+	entries, err := s.repo.MachineUsage(ctx, start, end, request.GroupBy)
+	if err != nil {
+		return nil, status.Internal(err)
+	}
+
+	total, err := s.repo.MachineUsage(ctx, start, end, pb.UsageReportGrouping_USAGE_REPORT_GROUPING_UNKNOWN)
+	if err != nil {
+		return nil, status.Internal(err)
+	}
+
+	report := &pb.MachineUsageReport{
+		StartTime: request.StartTime,
+		EndTime:   request.EndTime,
+		GroupBy:   request.GroupBy,
+		Entries:   entries,
+		Total:     &pb.UsageStatistics{},
+	}
+
+	if len(total) != 0 {
+		report.Total = total[0]
+	}
+
+	return report, nil
+}
+
+func validateGetMachineUsageReport(
+	request *pb.GetMachineUsageReportRequest,
+) []*errdetails.BadRequest_FieldViolation {
+	var fieldViolations []*errdetails.BadRequest_FieldViolation
+
+	if request.StartTime == nil {
+		fieldViolations = append(fieldViolations, &errdetails.BadRequest_FieldViolation{
+			Field:       "start_time",
+			Description: "start_time must be set",
+			Reason:      "FIELD_EMPTY",
+		})
+	}
+
+	if request.EndTime == nil {
+		fieldViolations = append(fieldViolations, &errdetails.BadRequest_FieldViolation{
+			Field:       "end_time",
+			Description: "end_time must be set",
+			Reason:      "FIELD_EMPTY",
+		})
+	}
+
+	if request.GroupBy == pb.UsageReportGrouping_USAGE_REPORT_GROUPING_UNKNOWN {
+		fieldViolations = append(fieldViolations, &errdetails.BadRequest_FieldViolation{
+			Field:       "group_by",
+			Description: "group_by must be set",
+			Reason:      "FIELD_EMPTY",
+		})
+	} else if _, ok := usageGroupColumns[request.GroupBy]; !ok {
+		fieldViolations = append(fieldViolations, &errdetails.BadRequest_FieldViolation{
+			Field:       "group_by",
+			Description: "group_by must be a supported grouping",
+			Reason:      "FIELD_INVALID",
+		})
+	}
+
+	if len(fieldViolations) != 0 {
+		return fieldViolations
+	}
+
+	if !request.EndTime.AsTime().After(request.StartTime.AsTime()) {
+		return []*errdetails.BadRequest_FieldViolation{{
+			Field:       "end_time",
+			Description: "end_time must be after start_time",
+			Reason:      "FIELD_INVALID",
+		}}
+	}
+
+	return nil
+}
+
+func (s *Service) ExportMachineUsageReport(
+	ctx context.Context, request *pb.GetMachineUsageReportRequest,
+) (*httpbody.HttpBody, error) {
+	report, err := s.GetMachineUsageReport(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+
+	writer := csv.NewWriter(&buf)
+
+	err = writer.Write([]string{"member_id", "machine_id", "sessions", "total_minutes", "total_price_cents"})
+	if err != nil {
+		return nil, status.Internal(err)
+	}
+
+	for _, entry := range append(report.Entries, report.Total) {
+		err = writer.Write([]string{
+			entry.MemberId,
+			entry.MachineId,
+			strconv.FormatInt(entry.Sessions, 10),
+			strconv.FormatInt(entry.TotalMinutes, 10),
+			strconv.FormatInt(entry.TotalPriceCents, 10),
+		})
+		if err != nil {
+			return nil, status.Internal(err)
+		}
+	}
+
+	writer.Flush()
+
+	if err := writer.Error(); err != nil {
+		return nil, status.Internal(err)
+	}
+
+	return &httpbody.HttpBody{
+		ContentType: "text/csv",
+		Data:        buf.Bytes(),
+	}, nil
+}
@@ -0,0 +1,33 @@
+package selfservice
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+)
+
+var ErrNotFound = errors.New("no member with this username")
+
+type Postgres struct {
+	db *sql.DB
+}
+
+func NewPostgresRepo(db *sql.DB) *Postgres {
+	return &Postgres{db: db}
+}
+
+// MemberID returns the id of the member the login with the username belongs to.
+func (p *Postgres) MemberID(ctx context.Context, username string) (string, error) {
+	var id string
+
+	err := p.db.QueryRowContext(ctx, `select id from members_auth where username = $1`, username).Scan(&id)
+	if errors.Is(err, sql.ErrNoRows) {
+		return "", ErrNotFound
+	}
+
+	if err != nil {
+		return "", err
+	}
+
+	return id, nil
+}
@@ -0,0 +1,254 @@
+package selfservice
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"strings"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	"github.com/cfhn/our-space/ourspace-backend/internal/cards"
+	pb "github.com/cfhn/our-space/ourspace-backend/proto"
+	"github.com/cfhn/our-space/pkg/setup"
+	"github.com/cfhn/our-space/pkg/status"
+)
+
+type MemberService interface {
+	GetMember(ctx context.Context, request *pb.GetMemberRequest) (*pb.Member, error)
+	UpdateMember(ctx context.Context, request *pb.UpdateMemberRequest) (*pb.Member, error)
+	SubmitChangeRequest(
+		ctx context.Context, memberID string, member *pb.Member, fieldMask *fieldmaskpb.FieldMask,
+	) (*pb.MemberChangeRequest, error)
+	ListChangeRequestsOfMember(
+		ctx context.Context, memberID string, pageSize int32, pageToken string,
+	) (*pb.ListMemberChangeRequestsResponse, error)
+}
+
+type PresenceLister interface {
+	ListPresences(ctx context.Context, request *pb.ListPresencesRequest) (*pb.ListPresencesResponse, error)
+}
+
+type BriefingLister interface {
+	ListBriefings(ctx context.Context, request *pb.ListBriefingsRequest) (*pb.ListBriefingsResponse, error)
+}
+
+// Service lets members access their own data. It forwards the requests to the other services, with the member id
+// taken from the access token, so that the same validation applies as for staff.
+type Service struct {
+	repo            *Postgres
+	memberService   MemberService
+	presenceService PresenceLister
+	cardService     cards.CardLister
+	briefingService BriefingLister
+	// editable can be updated by members, approvable can be changed by change requests approved by staff.
+	editable   Paths
+	approvable Paths
+	pb.UnimplementedSelfServiceServer
+}
+
+// Paths are member fields and additional attributes members have access to.
+type Paths struct {
+	Fields []string
+	// Attributes are the technical names of additional attributes.
+	Attributes []string
+}
+
+// contains reports whether the field mask path is one of the paths. Roles can never be changed by members, even if
+// member_login is configured.
+func (p Paths) contains(path string) bool {
+	if attribute, ok := strings.CutPrefix(path, "additional_attributes."); ok {
+		return slices.Contains(p.Attributes, attribute)
+	}
+
+	return path != "member_login.roles" && slices.Contains(p.Fields, path)
+}
+
+func NewService(
+	repo *Postgres, memberService MemberService, presenceService PresenceLister, cardService cards.CardLister,
+	briefingService BriefingLister, editable, approvable Paths,
+) *Service {
+	return &Service{
+		repo:            repo,
+		memberService:   memberService,
+		presenceService: presenceService,
+		cardService:     cardService,
+		briefingService: briefingService,
+		editable:        editable,
+		approvable:      approvable,
+	}
+}
+
+// memberID returns the id of the member the access token was issued for. The subject of tokens from password logins
+// is the username, tokens of API keys don't belong to a member.
+func (s *Service) memberID(ctx context.Context) (string, error) {
+	claims, ok := setup.GetAccessTokenClaims(ctx)
+	if !ok {
+		return "", status.Unauthenticated()
+	}
+
+	if claims.APIKey {
+		return "", status.PermissionDenied()
+	}
+
+	memberID, err := s.repo.MemberID(ctx, claims.Subject)
+	if errors.Is(err, ErrNotFound) {
+		// the login was removed after the token was issued
+		return "", status.PermissionDenied()
+	}
+
+	if err != nil {
+		return "", status.Internal(err)
+	}
+
+	return memberID, nil
+}
+
+func (s *Service) GetMyProfile(ctx context.Context, _ *pb.GetMyProfileRequest) (*pb.Member, error) {
+	memberID, err := s.memberID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.memberService.GetMember(ctx, &pb.GetMemberRequest{Id: memberID})
+}
+
+func (s *Service) UpdateMyProfile(ctx context.Context, request *pb.UpdateMyProfileRequest) (*pb.Member, error) {
+	memberID, err := s.memberID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	fieldViolations := validateProfileChange(
+		request.Member, request.FieldMask, s.editable, s.approvable, "needs approval, request a change instead",
+	)
+	if len(fieldViolations) != 0 {
+		return nil, status.FieldViolations(fieldViolations)
+	}
+
+	request.Member.Id = memberID
+
+	return s.memberService.UpdateMember(ctx, &pb.UpdateMemberRequest{
+		Member:    request.Member,
+		FieldMask: request.FieldMask,
+	})
+}
+
+// validateProfileChange checks that all paths of the field mask are allowed. Paths that are not allowed, but can be
+// changed the other way, are reported with otherHint.
+func validateProfileChange(
+	member *pb.Member, fieldMask *fieldmaskpb.FieldMask, allowed, other Paths, otherHint string,
+) []*errdetails.BadRequest_FieldViolation {
+	var fieldViolations []*errdetails.BadRequest_FieldViolation
+
+	if member == nil {
+		fieldViolations = append(fieldViolations, &errdetails.BadRequest_FieldViolation{
+			Field:       "member",
+			Description: "member field must not be empty",
+			Reason:      "FIELD_EMPTY",
+		})
+	}
+
+	if len(fieldMask.GetPaths()) == 0 {
+		fieldViolations = append(fieldViolations, &errdetails.BadRequest_FieldViolation{
+			Field:       "field_mask",
+			Description: "field_mask must not be empty",
+			Reason:      "FIELD_EMPTY",
+		})
+	}
+
+	for _, path := range fieldMask.GetPaths() {
+		if allowed.contains(path) {
+			continue
+		}
+
+		description := path + " can't be changed by members themselves"
+		if other.contains(path) {
+			description = path + " " + otherHint
+		}
+
+		fieldViolations = append(fieldViolations, &errdetails.BadRequest_FieldViolation{
+			Field:       "field_mask",
+			Description: description,
+			Reason:      "FIELD_INVALID",
+		})
+	}
+
+	return fieldViolations
+}
+
+func (s *Service) ListMyPresences(
+	ctx context.Context, request *pb.ListMyPresencesRequest,
+) (*pb.ListPresencesResponse, error) {
+	memberID, err := s.memberID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.presenceService.ListPresences(ctx, &pb.ListPresencesRequest{
+		PageSize:      request.PageSize,
+		PageToken:     request.PageToken,
+		SortBy:        pb.PresenceField_PRESENCE_FIELD_CHECKIN_TIME,
+		SortDirection: pb.SortDirection_SORT_DIRECTION_DESCENDING,
+		MemberId:      &memberID,
+	})
+}
+
+func (s *Service) ListMyCards(ctx context.Context, request *pb.ListMyCardsRequest) (*pb.ListCardsResponse, error) {
+	memberID, err := s.memberID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.cardService.ListCards(ctx, &pb.ListCardsRequest{
+		PageSize:  request.PageSize,
+		PageToken: request.PageToken,
+		MemberId:  memberID,
+	})
+}
+
+func (s *Service) ListMyBriefings(
+	ctx context.Context, request *pb.ListMyBriefingsRequest,
+) (*pb.ListBriefingsResponse, error) {
+	memberID, err := s.memberID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.briefingService.ListBriefings(ctx, &pb.ListBriefingsRequest{
+		PageSize:  request.PageSize,
+		PageToken: request.PageToken,
+		MemberId:  &memberID,
+		ValidOnly: request.ValidOnly,
+	})
+}
+
+func (s *Service) CreateMyChangeRequest(
+	ctx context.Context, request *pb.CreateMyChangeRequestRequest,
+) (*pb.MemberChangeRequest, error) {
+	memberID, err := s.memberID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	fieldViolations := validateProfileChange(
+		request.Member, request.FieldMask, s.approvable, s.editable, "can be updated without approval",
+	)
+	if len(fieldViolations) != 0 {
+		return nil, status.FieldViolations(fieldViolations)
+	}
+
+	return s.memberService.SubmitChangeRequest(ctx, memberID, request.Member, request.FieldMask)
+}
+
+func (s *Service) ListMyChangeRequests(
+	ctx context.Context, request *pb.ListMyChangeRequestsRequest,
+) (*pb.ListMemberChangeRequestsResponse, error) {
+	memberID, err := s.memberID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.memberService.ListChangeRequestsOfMember(ctx, memberID, request.PageSize, request.PageToken)
+}
@@ -10,6 +10,7 @@ import (
 	_ "github.com/cfhn/our-space/pkg/setup/proto"
 	_ "github.com/google/gnostic/openapiv3"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
+	httpbody "google.golang.org/genproto/googleapis/api/httpbody"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	durationpb "google.golang.org/protobuf/types/known/durationpb"
@@ -132,107 +133,102 @@ func (MemberField) EnumDescriptor() ([]byte, []int) {
 	return file_ourspace_backend_proto_api_proto_rawDescGZIP(), []int{1}
 }
 
-type PresenceField int32
+type SortDirection int32
 
 const (
-	PresenceField_PRESENCE_FIELD_UNKNOWN       PresenceField = 0
-	PresenceField_PRESENCE_FIELD_ID            PresenceField = 1
-	PresenceField_PRESENCE_FIELD_MEMBER_ID     PresenceField = 2
-	PresenceField_PRESENCE_FIELD_CHECKIN_TIME  PresenceField = 3
-	PresenceField_PRESENCE_FIELD_CHECKOUT_TIME PresenceField = 4
+	SortDirection_SORT_DIRECTION_DEFAULT    SortDirection = 0
+	SortDirection_SORT_DIRECTION_ASCENDING  SortDirection = 1
+	SortDirection_SORT_DIRECTION_DESCENDING SortDirection = 2
 )
 
-// Enum value maps for PresenceField.
+// Enum value maps for SortDirection.
 var (
-	PresenceField_name = map[int32]string{
-		0: "PRESENCE_FIELD_UNKNOWN",
-		1: "PRESENCE_FIELD_ID",
-		2: "PRESENCE_FIELD_MEMBER_ID",
-		3: "PRESENCE_FIELD_CHECKIN_TIME",
-		4: "PRESENCE_FIELD_CHECKOUT_TIME",
+	SortDirection_name = map[int32]string{
+		0: "SORT_DIRECTION_DEFAULT",
+		1: "SORT_DIRECTION_ASCENDING",
+		2: "SORT_DIRECTION_DESCENDING",
 	}
-	PresenceField_value = map[string]int32{
-		"PRESENCE_FIELD_UNKNOWN":       0,
-		"PRESENCE_FIELD_ID":            1,
-		"PRESENCE_FIELD_MEMBER_ID":     2,
-		"PRESENCE_FIELD_CHECKIN_TIME":  3,
-		"PRESENCE_FIELD_CHECKOUT_TIME": 4,
+	SortDirection_value = map[string]int32{
+		"SORT_DIRECTION_DEFAULT":    0,
+		"SORT_DIRECTION_ASCENDING":  1,
+		"SORT_DIRECTION_DESCENDING": 2,
 	}
 )
 
-func (x PresenceField) Enum() *PresenceField {
-	p := new(PresenceField)
+func (x SortDirection) Enum() *SortDirection {
+	p := new(SortDirection)
 	*p = x
 	return p
 }
 
-func (x PresenceField) String() string {
+func (x SortDirection) String() string {
 	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (PresenceField) Descriptor() protoreflect.EnumDescriptor {
+func (SortDirection) Descriptor() protoreflect.EnumDescriptor {
 	return file_ourspace_backend_proto_api_proto_enumTypes[2].Descriptor()
 }
 
-func (PresenceField) Type() protoreflect.EnumType {
+func (SortDirection) Type() protoreflect.EnumType {
 	return &file_ourspace_backend_proto_api_proto_enumTypes[2]
 }
 
-func (x PresenceField) Number() protoreflect.EnumNumber {
+func (x SortDirection) Number() protoreflect.EnumNumber {
 	return protoreflect.EnumNumber(x)
 }
 
-// Deprecated: Use PresenceField.Descriptor instead.
-func (PresenceField) EnumDescriptor() ([]byte, []int) {
+// Deprecated: Use SortDirection.Descriptor instead.
+func (SortDirection) EnumDescriptor() ([]byte, []int) {
 	return file_ourspace_backend_proto_api_proto_rawDescGZIP(), []int{2}
 }
 
-type SortDirection int32
+type ExportFormat int32
 
 const (
-	SortDirection_SORT_DIRECTION_DEFAULT    SortDirection = 0
-	SortDirection_SORT_DIRECTION_ASCENDING  SortDirection = 1
-	SortDirection_SORT_DIRECTION_DESCENDING SortDirection = 2
+	// EXPORT_FORMAT_UNKNOWN defaults to CSV.
+	ExportFormat_EXPORT_FORMAT_UNKNOWN ExportFormat = 0
+	ExportFormat_EXPORT_FORMAT_CSV     ExportFormat = 1
+	ExportFormat_EXPORT_FORMAT_XLSX    ExportFormat = 2
 )
 
-// Enum value maps for SortDirection.
+// Enum value maps for ExportFormat.
 var (
-	SortDirection_name = map[int32]string{
-		0: "SORT_DIRECTION_DEFAULT",
-		1: "SORT_DIRECTION_ASCENDING",
-		2: "SORT_DIRECTION_DESCENDING",
+	ExportFormat_name = map[int32]string{
+		0: "EXPORT_FORMAT_UNKNOWN",
+		1: "EXPORT_FORMAT_CSV",
+		2: "EXPORT_FORMAT_XLSX",
 	}
-	SortDirection_value = map[string]int32{
-		"SORT_DIRECTION_DEFAULT":    0,
-		"SORT_DIRECTION_ASCENDING":  1,
-		"SORT_DIRECTION_DESCENDING": 2,
+	ExportFormat_value = map[string]int32{
+		"EXPORT_FORMAT_UNKNOWN": 0,
+		"EXPORT_FORMAT_CSV":     1,
+		"EXPORT_FORMAT_XLSX":    2,
 	}
 )
 
-func (x SortDirection) Enum() *SortDirection {
-	p := new(SortDirection)
+func (x ExportFormat) Enum() *ExportFormat {
+	p := new(ExportFormat)
 	*p = x
 	return p
 }
 
-func (x SortDirection) String() string {
+func (x ExportFormat) String() string {
 	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (SortDirection) Descriptor() protoreflect.EnumDescriptor {
+func (ExportFormat) Descriptor() protoreflect.EnumDescriptor {
 	return file_ourspace_backend_proto_api_proto_enumTypes[3].Descriptor()
 }
 
-func (SortDirection) Type() protoreflect.EnumType {
+func (ExportFormat) Type() protoreflect.EnumType {
 	return &file_ourspace_backend_proto_api_proto_enumTypes[3]
 }
 
-func (x SortDirection) Number() protoreflect.EnumNumber {
+func (x ExportFormat) Number() protoreflect.EnumNumber {
 	return protoreflect.EnumNumber(x)
 }
 
-// Deprecated: Use SortDirection.Descriptor instead.
-func (SortDirection) EnumDescriptor() ([]byte, []int) {
+// Deprecated: Use ExportFormat.Descriptor instead.
+func (ExportFormat) EnumDescriptor() ([]byte, []int) {
 	return file_ourspace_backend_proto_api_proto_rawDescGZIP(), []int{3}
 }
 
@@ -346,239 +342,10963 @@ func (CardField) EnumDescriptor() ([]byte, []int) {
 	return file_ourspace_backend_proto_api_proto_rawDescGZIP(), []int{5}
 }
 
-type MemberAttribute_Type int32
+type PresenceField int32
 
 const (
-	MemberAttribute_TYPE_UNKNOWN          MemberAttribute_Type = 0
-	MemberAttribute_TYPE_TEXT_SINGLE_LINE MemberAttribute_Type = 1
-	MemberAttribute_TYPE_TEXT_MULI_LINE   MemberAttribute_Type = 2
-	MemberAttribute_TYPE_NUMBER           MemberAttribute_Type = 3
-	MemberAttribute_TYPE_DATE             MemberAttribute_Type = 4
-	MemberAttribute_TYPE_DATETIME         MemberAttribute_Type = 5
+	PresenceField_PRESENCE_FIELD_UNKNOWN       PresenceField = 0
+	PresenceField_PRESENCE_FIELD_ID            PresenceField = 1
+	PresenceField_PRESENCE_FIELD_MEMBER_ID     PresenceField = 2
+	PresenceField_PRESENCE_FIELD_CHECKIN_TIME  PresenceField = 3
+	PresenceField_PRESENCE_FIELD_CHECKOUT_TIME PresenceField = 4
 )
 
-// Enum value maps for MemberAttribute_Type.
+// Enum value maps for PresenceField.
 var (
-	MemberAttribute_Type_name = map[int32]string{
-		0: "TYPE_UNKNOWN",
-		1: "TYPE_TEXT_SINGLE_LINE",
-		2: "TYPE_TEXT_MULI_LINE",
-		3: "TYPE_NUMBER",
-		4: "TYPE_DATE",
-		5: "TYPE_DATETIME",
+	PresenceField_name = map[int32]string{
+		0: "PRESENCE_FIELD_UNKNOWN",
+		1: "PRESENCE_FIELD_ID",
+		2: "PRESENCE_FIELD_MEMBER_ID",
+		3: "PRESENCE_FIELD_CHECKIN_TIME",
+		4: "PRESENCE_FIELD_CHECKOUT_TIME",
 	}
-	MemberAttribute_Type_value = map[string]int32{
-		"TYPE_UNKNOWN":          0,
-		"TYPE_TEXT_SINGLE_LINE": 1,
-		"TYPE_TEXT_MULI_LINE":   2,
-		"TYPE_NUMBER":           3,
-		"TYPE_DATE":             4,
-		"TYPE_DATETIME":         5,
+	PresenceField_value = map[string]int32{
+		"PRESENCE_FIELD_UNKNOWN":       0,
+		"PRESENCE_FIELD_ID":            1,
+		"PRESENCE_FIELD_MEMBER_ID":     2,
+		"PRESENCE_FIELD_CHECKIN_TIME":  3,
+		"PRESENCE_FIELD_CHECKOUT_TIME": 4,
 	}
 )
 
-func (x MemberAttribute_Type) Enum() *MemberAttribute_Type {
-	p := new(MemberAttribute_Type)
+func (x PresenceField) Enum() *PresenceField {
+	p := new(PresenceField)
 	*p = x
 	return p
 }
 
-func (x MemberAttribute_Type) String() string {
+func (x PresenceField) String() string {
 	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (MemberAttribute_Type) Descriptor() protoreflect.EnumDescriptor {
+func (PresenceField) Descriptor() protoreflect.EnumDescriptor {
 	return file_ourspace_backend_proto_api_proto_enumTypes[6].Descriptor()
 }
 
-func (MemberAttribute_Type) Type() protoreflect.EnumType {
+func (PresenceField) Type() protoreflect.EnumType {
 	return &file_ourspace_backend_proto_api_proto_enumTypes[6]
 }
 
-func (x MemberAttribute_Type) Number() protoreflect.EnumNumber {
+func (x PresenceField) Number() protoreflect.EnumNumber {
 	return protoreflect.EnumNumber(x)
 }
 
-// Deprecated: Use MemberAttribute_Type.Descriptor instead.
-func (MemberAttribute_Type) EnumDescriptor() ([]byte, []int) {
-	return file_ourspace_backend_proto_api_proto_rawDescGZIP(), []int{18, 0}
+// Deprecated: Use PresenceField.Descriptor instead.
+func (PresenceField) EnumDescriptor() ([]byte, []int) {
+	return file_ourspace_backend_proto_api_proto_rawDescGZIP(), []int{6}
 }
 
-type CreateMemberRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	MemberId      string                 `protobuf:"bytes,1,opt,name=member_id,proto3" json:"member_id,omitempty"`
-	Member        *Member                `protobuf:"bytes,2,opt,name=member,proto3" json:"member,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type PresenceAction int32
+
+const (
+	PresenceAction_PRESENCE_ACTION_UNKNOWN  PresenceAction = 0
+	PresenceAction_PRESENCE_ACTION_CHECKIN  PresenceAction = 1
+	PresenceAction_PRESENCE_ACTION_CHECKOUT PresenceAction = 2
+)
+
+// Enum value maps for PresenceAction.
+var (
+	PresenceAction_name = map[int32]string{
+		0: "PRESENCE_ACTION_UNKNOWN",
+		1: "PRESENCE_ACTION_CHECKIN",
+		2: "PRESENCE_ACTION_CHECKOUT",
+	}
+	PresenceAction_value = map[string]int32{
+		"PRESENCE_ACTION_UNKNOWN":  0,
+		"PRESENCE_ACTION_CHECKIN":  1,
+		"PRESENCE_ACTION_CHECKOUT": 2,
+	}
+)
+
+func (x PresenceAction) Enum() *PresenceAction {
+	p := new(PresenceAction)
+	*p = x
+	return p
 }
 
-func (x *CreateMemberRequest) Reset() {
-	*x = CreateMemberRequest{}
-	mi := &file_ourspace_backend_proto_api_proto_msgTypes[0]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x PresenceAction) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (x *CreateMemberRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (PresenceAction) Descriptor() protoreflect.EnumDescriptor {
+	return file_ourspace_backend_proto_api_proto_enumTypes[7].Descriptor()
 }
 
-func (*CreateMemberRequest) ProtoMessage() {}
+func (PresenceAction) Type() protoreflect.EnumType {
+	return &file_ourspace_backend_proto_api_proto_enumTypes[7]
+}
 
-func (x *CreateMemberRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ourspace_backend_proto_api_proto_msgTypes[0]
-	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
+func (x PresenceAction) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-// Deprecated: Use CreateMemberRequest.ProtoReflect.Descriptor instead.
-func (*CreateMemberRequest) Descriptor() ([]byte, []int) {
-	return file_ourspace_backend_proto_api_proto_rawDescGZIP(), []int{0}
+// Deprecated: Use PresenceAction.Descriptor instead.
+func (PresenceAction) EnumDescriptor() ([]byte, []int) {
+	return file_ourspace_backend_proto_api_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *CreateMemberRequest) GetMemberId() string {
-	if x != nil {
-		return x.MemberId
+type EventField int32
+
+const (
+	EventField_EVENT_FIELD_UNKNOWN    EventField = 0
+	EventField_EVENT_FIELD_ID         EventField = 1
+	EventField_EVENT_FIELD_TITLE      EventField = 2
+	EventField_EVENT_FIELD_START_TIME EventField = 3
+	EventField_EVENT_FIELD_END_TIME   EventField = 4
+)
+
+// Enum value maps for EventField.
+var (
+	EventField_name = map[int32]string{
+		0: "EVENT_FIELD_UNKNOWN",
+		1: "EVENT_FIELD_ID",
+		2: "EVENT_FIELD_TITLE",
+		3: "EVENT_FIELD_START_TIME",
+		4: "EVENT_FIELD_END_TIME",
 	}
-	return ""
+	EventField_value = map[string]int32{
+		"EVENT_FIELD_UNKNOWN":    0,
+		"EVENT_FIELD_ID":         1,
+		"EVENT_FIELD_TITLE":      2,
+		"EVENT_FIELD_START_TIME": 3,
+		"EVENT_FIELD_END_TIME":   4,
+	}
+)
+
+func (x EventField) Enum() *EventField {
+	p := new(EventField)
+	*p = x
+	return p
 }
 
-func (x *CreateMemberRequest) GetMember() *Member {
-	if x != nil {
-		return x.Member
-	}
-	return nil
+func (x EventField) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-type Member struct {
-	state                protoimpl.MessageState `protogen:"open.v1"`
-	Id                   string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name                 string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	MembershipStart      *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=membership_start,proto3" json:"membership_start,omitempty"`
-	MembershipEnd        *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=membership_end,proto3" json:"membership_end,omitempty"`
-	AgeCategory          AgeCategory            `protobuf:"varint,5,opt,name=age_category,proto3,enum=ourspace_backend.proto.AgeCategory" json:"age_category,omitempty"`
-	Tags                 []string               `protobuf:"bytes,6,rep,name=tags,proto3" json:"tags,omitempty"`
-	MemberLogin          *MemberLogin           `protobuf:"bytes,7,opt,name=member_login,proto3,oneof" json:"member_login,omitempty"`
-	AdditionalAttributes map[string]string      `protobuf:"bytes,8,rep,name=additional_attributes,proto3" json:"additional_attributes,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
-	unknownFields        protoimpl.UnknownFields
-	sizeCache            protoimpl.SizeCache
+func (EventField) Descriptor() protoreflect.EnumDescriptor {
+	return file_ourspace_backend_proto_api_proto_enumTypes[8].Descriptor()
 }
 
-func (x *Member) Reset() {
-	*x = Member{}
-	mi := &file_ourspace_backend_proto_api_proto_msgTypes[1]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (EventField) Type() protoreflect.EnumType {
+	return &file_ourspace_backend_proto_api_proto_enumTypes[8]
 }
 
-func (x *Member) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x EventField) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-func (*Member) ProtoMessage() {}
+// Deprecated: Use EventField.Descriptor instead.
+func (EventField) EnumDescriptor() ([]byte, []int) {
+	return file_ourspace_backend_proto_api_proto_rawDescGZIP(), []int{8}
+}
 
-func (x *Member) ProtoReflect() protoreflect.Message {
-	mi := &file_ourspace_backend_proto_api_proto_msgTypes[1]
-	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+type EventRegistrationStatus int32
+
+const (
+	EventRegistrationStatus_EVENT_REGISTRATION_STATUS_UNKNOWN    EventRegistrationStatus = 0
+	EventRegistrationStatus_EVENT_REGISTRATION_STATUS_REGISTERED EventRegistrationStatus = 1
+	EventRegistrationStatus_EVENT_REGISTRATION_STATUS_WAITLISTED EventRegistrationStatus = 2
+	EventRegistrationStatus_EVENT_REGISTRATION_STATUS_CANCELLED  EventRegistrationStatus = 3
+)
+
+// Enum value maps for EventRegistrationStatus.
+var (
+	EventRegistrationStatus_name = map[int32]string{
+		0: "EVENT_REGISTRATION_STATUS_UNKNOWN",
+		1: "EVENT_REGISTRATION_STATUS_REGISTERED",
+		2: "EVENT_REGISTRATION_STATUS_WAITLISTED",
+		3: "EVENT_REGISTRATION_STATUS_CANCELLED",
 	}
-	return mi.MessageOf(x)
-}
+	EventRegistrationStatus_value = map[string]int32{
+		"EVENT_REGISTRATION_STATUS_UNKNOWN":    0,
+		"EVENT_REGISTRATION_STATUS_REGISTERED": 1,
+		"EVENT_REGISTRATION_STATUS_WAITLISTED": 2,
+		"EVENT_REGISTRATION_STATUS_CANCELLED":  3,
+	}
+)
 
-// Deprecated: Use Member.ProtoReflect.Descriptor instead.
-func (*Member) Descriptor() ([]byte, []int) {
-	return file_ourspace_backend_proto_api_proto_rawDescGZIP(), []int{1}
+func (x EventRegistrationStatus) Enum() *EventRegistrationStatus {
+	p := new(EventRegistrationStatus)
+	*p = x
+	return p
 }
 
-func (x *Member) GetId() string {
-	if x != nil {
-		return x.Id
-	}
-	return ""
+func (x EventRegistrationStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (x *Member) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
+func (EventRegistrationStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_ourspace_backend_proto_api_proto_enumTypes[9].Descriptor()
 }
 
-func (x *Member) GetMembershipStart() *timestamppb.Timestamp {
-	if x != nil {
-		return x.MembershipStart
-	}
-	return nil
+func (EventRegistrationStatus) Type() protoreflect.EnumType {
+	return &file_ourspace_backend_proto_api_proto_enumTypes[9]
 }
 
-func (x *Member) GetMembershipEnd() *timestamppb.Timestamp {
-	if x != nil {
-		return x.MembershipEnd
-	}
-	return nil
+func (x EventRegistrationStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-func (x *Member) GetAgeCategory() AgeCategory {
-	if x != nil {
-		return x.AgeCategory
-	}
-	return AgeCategory_AGE_CATEGORY_UNKNOWN
+// Deprecated: Use EventRegistrationStatus.Descriptor instead.
+func (EventRegistrationStatus) EnumDescriptor() ([]byte, []int) {
+	return file_ourspace_backend_proto_api_proto_rawDescGZIP(), []int{9}
+}
+
+type ItemField int32
+
+const (
+	ItemField_ITEM_FIELD_UNKNOWN ItemField = 0
+	ItemField_ITEM_FIELD_ID      ItemField = 1
+	ItemField_ITEM_FIELD_NAME    ItemField = 2
+)
+
+// Enum value maps for ItemField.
+var (
+	ItemField_name = map[int32]string{
+		0: "ITEM_FIELD_UNKNOWN",
+		1: "ITEM_FIELD_ID",
+		2: "ITEM_FIELD_NAME",
+	}
+	ItemField_value = map[string]int32{
+		"ITEM_FIELD_UNKNOWN": 0,
+		"ITEM_FIELD_ID":      1,
+		"ITEM_FIELD_NAME":    2,
+	}
+)
+
+func (x ItemField) Enum() *ItemField {
+	p := new(ItemField)
+	*p = x
+	return p
+}
+
+func (x ItemField) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ItemField) Descriptor() protoreflect.EnumDescriptor {
+	return file_ourspace_backend_proto_api_proto_enumTypes[10].Descriptor()
+}
+
+func (ItemField) Type() protoreflect.EnumType {
+	return &file_ourspace_backend_proto_api_proto_enumTypes[10]
+}
+
+func (x ItemField) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ItemField.Descriptor instead.
+func (ItemField) EnumDescriptor() ([]byte, []int) {
+	return file_ourspace_backend_proto_api_proto_rawDescGZIP(), []int{10}
+}
+
+type AccessReason int32
+
+const (
+	AccessReason_ACCESS_REASON_UNKNOWN AccessReason = 0
+	// ACCESS_REASON_GRANTED means the member has all required briefings.
+	AccessReason_ACCESS_REASON_GRANTED             AccessReason = 1
+	AccessReason_ACCESS_REASON_UNKNOWN_CARD        AccessReason = 2
+	AccessReason_ACCESS_REASON_MEMBERSHIP_INACTIVE AccessReason = 3
+	AccessReason_ACCESS_REASON_UNKNOWN_MACHINE     AccessReason = 4
+	AccessReason_ACCESS_REASON_MISSING_BRIEFING    AccessReason = 5
+)
+
+// Enum value maps for AccessReason.
+var (
+	AccessReason_name = map[int32]string{
+		0: "ACCESS_REASON_UNKNOWN",
+		1: "ACCESS_REASON_GRANTED",
+		2: "ACCESS_REASON_UNKNOWN_CARD",
+		3: "ACCESS_REASON_MEMBERSHIP_INACTIVE",
+		4: "ACCESS_REASON_UNKNOWN_MACHINE",
+		5: "ACCESS_REASON_MISSING_BRIEFING",
+	}
+	AccessReason_value = map[string]int32{
+		"ACCESS_REASON_UNKNOWN":             0,
+		"ACCESS_REASON_GRANTED":             1,
+		"ACCESS_REASON_UNKNOWN_CARD":        2,
+		"ACCESS_REASON_MEMBERSHIP_INACTIVE": 3,
+		"ACCESS_REASON_UNKNOWN_MACHINE":     4,
+		"ACCESS_REASON_MISSING_BRIEFING":    5,
+	}
+)
+
+func (x AccessReason) Enum() *AccessReason {
+	p := new(AccessReason)
+	*p = x
+	return p
+}
+
+func (x AccessReason) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AccessReason) Descriptor() protoreflect.EnumDescriptor {
+	return file_ourspace_backend_proto_api_proto_enumTypes[11].Descriptor()
+}
+
+func (AccessReason) Type() protoreflect.EnumType {
+	return &file_ourspace_backend_proto_api_proto_enumTypes[11]
+}
+
+func (x AccessReason) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AccessReason.Descriptor instead.
+func (AccessReason) EnumDescriptor() ([]byte, []int) {
+	return file_ourspace_backend_proto_api_proto_rawDescGZIP(), []int{11}
+}
+
+type BillingInterval int32
+
+const (
+	BillingInterval_BILLING_INTERVAL_UNKNOWN   BillingInterval = 0
+	BillingInterval_BILLING_INTERVAL_MONTHLY   BillingInterval = 1
+	BillingInterval_BILLING_INTERVAL_QUARTERLY BillingInterval = 2
+	BillingInterval_BILLING_INTERVAL_YEARLY    BillingInterval = 3
+)
+
+// Enum value maps for BillingInterval.
+var (
+	BillingInterval_name = map[int32]string{
+		0: "BILLING_INTERVAL_UNKNOWN",
+		1: "BILLING_INTERVAL_MONTHLY",
+		2: "BILLING_INTERVAL_QUARTERLY",
+		3: "BILLING_INTERVAL_YEARLY",
+	}
+	BillingInterval_value = map[string]int32{
+		"BILLING_INTERVAL_UNKNOWN":   0,
+		"BILLING_INTERVAL_MONTHLY":   1,
+		"BILLING_INTERVAL_QUARTERLY": 2,
+		"BILLING_INTERVAL_YEARLY":    3,
+	}
+)
+
+func (x BillingInterval) Enum() *BillingInterval {
+	p := new(BillingInterval)
+	*p = x
+	return p
+}
+
+func (x BillingInterval) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (BillingInterval) Descriptor() protoreflect.EnumDescriptor {
+	return file_ourspace_backend_proto_api_proto_enumTypes[12].Descriptor()
+}
+
+func (BillingInterval) Type() protoreflect.EnumType {
+	return &file_ourspace_backend_proto_api_proto_enumTypes[12]
+}
+
+func (x BillingInterval) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use BillingInterval.Descriptor instead.
+func (BillingInterval) EnumDescriptor() ([]byte, []int) {
+	return file_ourspace_backend_proto_api_proto_rawDescGZIP(), []int{12}
+}
+
+type InvoiceStatus int32
+
+const (
+	InvoiceStatus_INVOICE_STATUS_UNKNOWN   InvoiceStatus = 0
+	InvoiceStatus_INVOICE_STATUS_OPEN      InvoiceStatus = 1
+	InvoiceStatus_INVOICE_STATUS_PAID      InvoiceStatus = 2
+	InvoiceStatus_INVOICE_STATUS_CANCELLED InvoiceStatus = 3
+)
+
+// Enum value maps for InvoiceStatus.
+var (
+	InvoiceStatus_name = map[int32]string{
+		0: "INVOICE_STATUS_UNKNOWN",
+		1: "INVOICE_STATUS_OPEN",
+		2: "INVOICE_STATUS_PAID",
+		3: "INVOICE_STATUS_CANCELLED",
+	}
+	InvoiceStatus_value = map[string]int32{
+		"INVOICE_STATUS_UNKNOWN":   0,
+		"INVOICE_STATUS_OPEN":      1,
+		"INVOICE_STATUS_PAID":      2,
+		"INVOICE_STATUS_CANCELLED": 3,
+	}
+)
+
+func (x InvoiceStatus) Enum() *InvoiceStatus {
+	p := new(InvoiceStatus)
+	*p = x
+	return p
+}
+
+func (x InvoiceStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (InvoiceStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_ourspace_backend_proto_api_proto_enumTypes[13].Descriptor()
+}
+
+func (InvoiceStatus) Type() protoreflect.EnumType {
+	return &file_ourspace_backend_proto_api_proto_enumTypes[13]
+}
+
+func (x InvoiceStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use InvoiceStatus.Descriptor instead.
+func (InvoiceStatus) EnumDescriptor() ([]byte, []int) {
+	return file_ourspace_backend_proto_api_proto_rawDescGZIP(), []int{13}
+}
+
+type ReportBucket int32
+
+const (
+	ReportBucket_REPORT_BUCKET_UNKNOWN ReportBucket = 0
+	ReportBucket_REPORT_BUCKET_DAY     ReportBucket = 1
+	ReportBucket_REPORT_BUCKET_WEEK    ReportBucket = 2
+	ReportBucket_REPORT_BUCKET_MONTH   ReportBucket = 3
+)
+
+// Enum value maps for ReportBucket.
+var (
+	ReportBucket_name = map[int32]string{
+		0: "REPORT_BUCKET_UNKNOWN",
+		1: "REPORT_BUCKET_DAY",
+		2: "REPORT_BUCKET_WEEK",
+		3: "REPORT_BUCKET_MONTH",
+	}
+	ReportBucket_value = map[string]int32{
+		"REPORT_BUCKET_UNKNOWN": 0,
+		"REPORT_BUCKET_DAY":     1,
+		"REPORT_BUCKET_WEEK":    2,
+		"REPORT_BUCKET_MONTH":   3,
+	}
+)
+
+func (x ReportBucket) Enum() *ReportBucket {
+	p := new(ReportBucket)
+	*p = x
+	return p
+}
+
+func (x ReportBucket) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ReportBucket) Descriptor() protoreflect.EnumDescriptor {
+	return file_ourspace_backend_proto_api_proto_enumTypes[14].Descriptor()
+}
+
+func (ReportBucket) Type() protoreflect.EnumType {
+	return &file_ourspace_backend_proto_api_proto_enumTypes[14]
+}
+
+func (x ReportBucket) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ReportBucket.Descriptor instead.
+func (ReportBucket) EnumDescriptor() ([]byte, []int) {
+	return file_ourspace_backend_proto_api_proto_rawDescGZIP(), []int{14}
+}
+
+type UsageReportGrouping int32
+
+const (
+	UsageReportGrouping_USAGE_REPORT_GROUPING_UNKNOWN UsageReportGrouping = 0
+	UsageReportGrouping_USAGE_REPORT_GROUPING_MEMBER  UsageReportGrouping = 1
+	UsageReportGrouping_USAGE_REPORT_GROUPING_MACHINE UsageReportGrouping = 2
+)
+
+// Enum value maps for UsageReportGrouping.
+var (
+	UsageReportGrouping_name = map[int32]string{
+		0: "USAGE_REPORT_GROUPING_UNKNOWN",
+		1: "USAGE_REPORT_GROUPING_MEMBER",
+		2: "USAGE_REPORT_GROUPING_MACHINE",
+	}
+	UsageReportGrouping_value = map[string]int32{
+		"USAGE_REPORT_GROUPING_UNKNOWN": 0,
+		"USAGE_REPORT_GROUPING_MEMBER":  1,
+		"USAGE_REPORT_GROUPING_MACHINE": 2,
+	}
+)
+
+func (x UsageReportGrouping) Enum() *UsageReportGrouping {
+	p := new(UsageReportGrouping)
+	*p = x
+	return p
+}
+
+func (x UsageReportGrouping) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (UsageReportGrouping) Descriptor() protoreflect.EnumDescriptor {
+	return file_ourspace_backend_proto_api_proto_enumTypes[15].Descriptor()
+}
+
+func (UsageReportGrouping) Type() protoreflect.EnumType {
+	return &file_ourspace_backend_proto_api_proto_enumTypes[15]
+}
+
+func (x UsageReportGrouping) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use UsageReportGrouping.Descriptor instead.
+func (UsageReportGrouping) EnumDescriptor() ([]byte, []int) {
+	return file_ourspace_backend_proto_api_proto_rawDescGZIP(), []int{15}
+}
+
+type MemberRelationship_Type int32
+
+const (
+	MemberRelationship_TYPE_UNKNOWN MemberRelationship_Type = 0
+	// TYPE_GUARDIAN is a parent or legal guardian who consents for an underage member and can be contacted.
+	MemberRelationship_TYPE_GUARDIAN          MemberRelationship_Type = 1
+	MemberRelationship_TYPE_EMERGENCY_CONTACT MemberRelationship_Type = 2
+	// TYPE_FAMILY links members sharing a family membership.
+	MemberRelationship_TYPE_FAMILY MemberRelationship_Type = 3
+)
+
+// Enum value maps for MemberRelationship_Type.
+var (
+	MemberRelationship_Type_name = map[int32]string{
+		0: "TYPE_UNKNOWN",
+		1: "TYPE_GUARDIAN",
+		2: "TYPE_EMERGENCY_CONTACT",
+		3: "TYPE_FAMILY",
+	}
+	MemberRelationship_Type_value = map[string]int32{
+		"TYPE_UNKNOWN":           0,
+		"TYPE_GUARDIAN":          1,
+		"TYPE_EMERGENCY_CONTACT": 2,
+		"TYPE_FAMILY":            3,
+	}
+)
+
+func (x MemberRelationship_Type) Enum() *MemberRelationship_Type {
+	p := new(MemberRelationship_Type)
+	*p = x
+	return p
+}
+
+func (x MemberRelationship_Type) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (MemberRelationship_Type) Descriptor() protoreflect.EnumDescriptor {
+	return file_ourspace_backend_proto_api_proto_enumTypes[16].Descriptor()
+}
+
+func (MemberRelationship_Type) Type() protoreflect.EnumType {
+	return &file_ourspace_backend_proto_api_proto_enumTypes[16]
+}
+
+func (x MemberRelationship_Type) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use MemberRelationship_Type.Descriptor instead.
+func (MemberRelationship_Type) EnumDescriptor() ([]byte, []int) {
+	return file_ourspace_backend_proto_api_proto_rawDescGZIP(), []int{35, 0}
+}
+
+type MemberChangeRequest_State int32
+
+const (
+	MemberChangeRequest_STATE_UNKNOWN  MemberChangeRequest_State = 0
+	MemberChangeRequest_STATE_PENDING  MemberChangeRequest_State = 1
+	MemberChangeRequest_STATE_APPROVED MemberChangeRequest_State = 2
+	MemberChangeRequest_STATE_REJECTED MemberChangeRequest_State = 3
+)
+
+// Enum value maps for MemberChangeRequest_State.
+var (
+	MemberChangeRequest_State_name = map[int32]string{
+		0: "STATE_UNKNOWN",
+		1: "STATE_PENDING",
+		2: "STATE_APPROVED",
+		3: "STATE_REJECTED",
+	}
+	MemberChangeRequest_State_value = map[string]int32{
+		"STATE_UNKNOWN":  0,
+		"STATE_PENDING":  1,
+		"STATE_APPROVED": 2,
+		"STATE_REJECTED": 3,
+	}
+)
+
+func (x MemberChangeRequest_State) Enum() *MemberChangeRequest_State {
+	p := new(MemberChangeRequest_State)
+	*p = x
+	return p
+}
+
+func (x MemberChangeRequest_State) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (MemberChangeRequest_State) Descriptor() protoreflect.EnumDescriptor {
+	return file_ourspace_backend_proto_api_proto_enumTypes[17].Descriptor()
+}
+
+func (MemberChangeRequest_State) Type() protoreflect.EnumType {
+	return &file_ourspace_backend_proto_api_proto_enumTypes[17]
+}
+
+func (x MemberChangeRequest_State) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use MemberChangeRequest_State.Descriptor instead.
+func (MemberChangeRequest_State) EnumDescriptor() ([]byte, []int) {
+	return file_ourspace_backend_proto_api_proto_rawDescGZIP(), []int{46, 0}
+}
+
+type MemberAttribute_Type int32
+
+const (
+	MemberAttribute_TYPE_UNKNOWN          MemberAttribute_Type = 0
+	MemberAttribute_TYPE_TEXT_SINGLE_LINE MemberAttribute_Type = 1
+	MemberAttribute_TYPE_TEXT_MULI_LINE   MemberAttribute_Type = 2
+	MemberAttribute_TYPE_NUMBER           MemberAttribute_Type = 3
+	MemberAttribute_TYPE_DATE             MemberAttribute_Type = 4
+	MemberAttribute_TYPE_DATETIME         MemberAttribute_Type = 5
+	// TYPE_SELECT values are one of the options.
+	MemberAttribute_TYPE_SELECT MemberAttribute_Type = 6
+	// TYPE_MULTI_SELECT values are a subset of the options, separated by line breaks.
+	MemberAttribute_TYPE_MULTI_SELECT MemberAttribute_Type = 7
+	// TYPE_BOOLEAN values are either "true" or "false".
+	MemberAttribute_TYPE_BOOLEAN MemberAttribute_Type = 8
+	MemberAttribute_TYPE_EMAIL   MemberAttribute_Type = 9
+	MemberAttribute_TYPE_PHONE   MemberAttribute_Type = 10
+	// TYPE_URL values are absolute http or https URLs.
+	MemberAttribute_TYPE_URL MemberAttribute_Type = 11
+	// TYPE_DECIMAL values are decimal numbers with a dot as separator, e.g. "-12.5".
+	MemberAttribute_TYPE_DECIMAL MemberAttribute_Type = 12
+)
+
+// Enum value maps for MemberAttribute_Type.
+var (
+	MemberAttribute_Type_name = map[int32]string{
+		0:  "TYPE_UNKNOWN",
+		1:  "TYPE_TEXT_SINGLE_LINE",
+		2:  "TYPE_TEXT_MULI_LINE",
+		3:  "TYPE_NUMBER",
+		4:  "TYPE_DATE",
+		5:  "TYPE_DATETIME",
+		6:  "TYPE_SELECT",
+		7:  "TYPE_MULTI_SELECT",
+		8:  "TYPE_BOOLEAN",
+		9:  "TYPE_EMAIL",
+		10: "TYPE_PHONE",
+		11: "TYPE_URL",
+		12: "TYPE_DECIMAL",
+	}
+	MemberAttribute_Type_value = map[string]int32{
+		"TYPE_UNKNOWN":          0,
+		"TYPE_TEXT_SINGLE_LINE": 1,
+		"TYPE_TEXT_MULI_LINE":   2,
+		"TYPE_NUMBER":           3,
+		"TYPE_DATE":             4,
+		"TYPE_DATETIME":         5,
+		"TYPE_SELECT":           6,
+		"TYPE_MULTI_SELECT":     7,
+		"TYPE_BOOLEAN":          8,
+		"TYPE_EMAIL":            9,
+		"TYPE_PHONE":            10,
+		"TYPE_URL":              11,
+		"TYPE_DECIMAL":          12,
+	}
+)
+
+func (x MemberAttribute_Type) Enum() *MemberAttribute_Type {
+	p := new(MemberAttribute_Type)
+	*p = x
+	return p
+}
+
+func (x MemberAttribute_Type) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (MemberAttribute_Type) Descriptor() protoreflect.EnumDescriptor {
+	return file_ourspace_backend_proto_api_proto_enumTypes[18].Descriptor()
+}
+
+func (MemberAttribute_Type) Type() protoreflect.EnumType {
+	return &file_ourspace_backend_proto_api_proto_enumTypes[18]
+}
+
+func (x MemberAttribute_Type) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use MemberAttribute_Type.Descriptor instead.
+func (MemberAttribute_Type) EnumDescriptor() ([]byte, []int) {
+	return file_ourspace_backend_proto_api_proto_rawDescGZIP(), []int{51, 0}
+}
+
+type MemberAttribute_Sensitivity int32
+
+const (
+	MemberAttribute_SENSITIVITY_UNKNOWN MemberAttribute_Sensitivity = 0
+	// SENSITIVITY_PUBLIC values can be read and written by every user and are synchronized to terminals.
+	MemberAttribute_SENSITIVITY_PUBLIC MemberAttribute_Sensitivity = 1
+	// SENSITIVITY_INTERNAL values can be read and written by every user, but are never sent to terminals.
+	MemberAttribute_SENSITIVITY_INTERNAL MemberAttribute_Sensitivity = 2
+	// SENSITIVITY_RESTRICTED values can only be read by users with one of the read_roles and only be written by users
+	// with one of the write_roles.
+	MemberAttribute_SENSITIVITY_RESTRICTED MemberAttribute_Sensitivity = 3
+)
+
+// Enum value maps for MemberAttribute_Sensitivity.
+var (
+	MemberAttribute_Sensitivity_name = map[int32]string{
+		0: "SENSITIVITY_UNKNOWN",
+		1: "SENSITIVITY_PUBLIC",
+		2: "SENSITIVITY_INTERNAL",
+		3: "SENSITIVITY_RESTRICTED",
+	}
+	MemberAttribute_Sensitivity_value = map[string]int32{
+		"SENSITIVITY_UNKNOWN":    0,
+		"SENSITIVITY_PUBLIC":     1,
+		"SENSITIVITY_INTERNAL":   2,
+		"SENSITIVITY_RESTRICTED": 3,
+	}
+)
+
+func (x MemberAttribute_Sensitivity) Enum() *MemberAttribute_Sensitivity {
+	p := new(MemberAttribute_Sensitivity)
+	*p = x
+	return p
+}
+
+func (x MemberAttribute_Sensitivity) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (MemberAttribute_Sensitivity) Descriptor() protoreflect.EnumDescriptor {
+	return file_ourspace_backend_proto_api_proto_enumTypes[19].Descriptor()
+}
+
+func (MemberAttribute_Sensitivity) Type() protoreflect.EnumType {
+	return &file_ourspace_backend_proto_api_proto_enumTypes[19]
+}
+
+func (x MemberAttribute_Sensitivity) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use MemberAttribute_Sensitivity.Descriptor instead.
+func (MemberAttribute_Sensitivity) EnumDescriptor() ([]byte, []int) {
+	return file_ourspace_backend_proto_api_proto_rawDescGZIP(), []int{51, 1}
+}
+
+type Application_State int32
+
+const (
+	Application_STATE_UNKNOWN  Application_State = 0
+	Application_STATE_PENDING  Application_State = 1
+	Application_STATE_ACCEPTED Application_State = 2
+	Application_STATE_REJECTED Application_State = 3
+)
+
+// Enum value maps for Application_State.
+var (
+	Application_State_name = map[int32]string{
+		0: "STATE_UNKNOWN",
+		1: "STATE_PENDING",
+		2: "STATE_ACCEPTED",
+		3: "STATE_REJECTED",
+	}
+	Application_State_value = map[string]int32{
+		"STATE_UNKNOWN":  0,
+		"STATE_PENDING":  1,
+		"STATE_ACCEPTED": 2,
+		"STATE_REJECTED": 3,
+	}
+)
+
+func (x Application_State) Enum() *Application_State {
+	p := new(Application_State)
+	*p = x
+	return p
+}
+
+func (x Application_State) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Application_State) Descriptor() protoreflect.EnumDescriptor {
+	return file_ourspace_backend_proto_api_proto_enumTypes[20].Descriptor()
+}
+
+func (Application_State) Type() protoreflect.EnumType {
+	return &file_ourspace_backend_proto_api_proto_enumTypes[20]
+}
+
+func (x Application_State) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Application_State.Descriptor instead.
+func (Application_State) EnumDescriptor() ([]byte, []int) {
+	return file_ourspace_backend_proto_api_proto_rawDescGZIP(), []int{193, 0}
+}
+
+type MemberNote_Visibility int32
+
+const (
+	MemberNote_VISIBILITY_UNKNOWN MemberNote_Visibility = 0
+	// VISIBILITY_STAFF notes can be seen by everyone with access to members.
+	MemberNote_VISIBILITY_STAFF MemberNote_Visibility = 1
+	// VISIBILITY_ADMINS notes can only be seen by admins and the author.
+	MemberNote_VISIBILITY_ADMINS MemberNote_Visibility = 2
+)
+
+// Enum value maps for MemberNote_Visibility.
+var (
+	MemberNote_Visibility_name = map[int32]string{
+		0: "VISIBILITY_UNKNOWN",
+		1: "VISIBILITY_STAFF",
+		2: "VISIBILITY_ADMINS",
+	}
+	MemberNote_Visibility_value = map[string]int32{
+		"VISIBILITY_UNKNOWN": 0,
+		"VISIBILITY_STAFF":   1,
+		"VISIBILITY_ADMINS":  2,
+	}
+)
+
+func (x MemberNote_Visibility) Enum() *MemberNote_Visibility {
+	p := new(MemberNote_Visibility)
+	*p = x
+	return p
+}
+
+func (x MemberNote_Visibility) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (MemberNote_Visibility) Descriptor() protoreflect.EnumDescriptor {
+	return file_ourspace_backend_proto_api_proto_enumTypes[21].Descriptor()
+}
+
+func (MemberNote_Visibility) Type() protoreflect.EnumType {
+	return &file_ourspace_backend_proto_api_proto_enumTypes[21]
+}
+
+func (x MemberNote_Visibility) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use MemberNote_Visibility.Descriptor instead.
+func (MemberNote_Visibility) EnumDescriptor() ([]byte, []int) {
+	return file_ourspace_backend_proto_api_proto_rawDescGZIP(), []int{200, 0}
+}
+
+type CreateMemberRequest struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	MemberId string                 `protobuf:"bytes,1,opt,name=member_id,proto3" json:"member_id,omitempty"`
+	Member   *Member                `protobuf:"bytes,2,opt,name=member,proto3" json:"member,omitempty"`
+	// relationships are created together with the member, e.g. the guardian of an underage member. Their member_id is
+	// ignored.
+	Relationships []*MemberRelationship `protobuf:"bytes,3,rep,name=relationships,proto3" json:"relationships,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateMemberRequest) Reset() {
+	*x = CreateMemberRequest{}
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateMemberRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateMemberRequest) ProtoMessage() {}
+
+func (x *CreateMemberRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateMemberRequest.ProtoReflect.Descriptor instead.
+func (*CreateMemberRequest) Descriptor() ([]byte, []int) {
+	return file_ourspace_backend_proto_api_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *CreateMemberRequest) GetMemberId() string {
+	if x != nil {
+		return x.MemberId
+	}
+	return ""
+}
+
+func (x *CreateMemberRequest) GetMember() *Member {
+	if x != nil {
+		return x.Member
+	}
+	return nil
+}
+
+func (x *CreateMemberRequest) GetRelationships() []*MemberRelationship {
+	if x != nil {
+		return x.Relationships
+	}
+	return nil
+}
+
+type Member struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	Id                   string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name                 string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	MembershipStart      *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=membership_start,proto3" json:"membership_start,omitempty"`
+	MembershipEnd        *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=membership_end,proto3" json:"membership_end,omitempty"`
+	AgeCategory          AgeCategory            `protobuf:"varint,5,opt,name=age_category,proto3,enum=ourspace_backend.proto.AgeCategory" json:"age_category,omitempty"`
+	Tags                 []string               `protobuf:"bytes,6,rep,name=tags,proto3" json:"tags,omitempty"`
+	MemberLogin          *MemberLogin           `protobuf:"bytes,7,opt,name=member_login,proto3,oneof" json:"member_login,omitempty"`
+	AdditionalAttributes map[string]string      `protobuf:"bytes,8,rep,name=additional_attributes,proto3" json:"additional_attributes,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// birth_date is the date of birth as YYYY-MM-DD. If it is known, age_category is derived from it and kept up to date
+	// by a daily job, otherwise age_category is set by hand. Only admins and the member can read it.
+	BirthDate     *string `protobuf:"bytes,9,opt,name=birth_date,proto3,oneof" json:"birth_date,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Member) Reset() {
+	*x = Member{}
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Member) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Member) ProtoMessage() {}
+
+func (x *Member) ProtoReflect() protoreflect.Message {
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Member.ProtoReflect.Descriptor instead.
+func (*Member) Descriptor() ([]byte, []int) {
+	return file_ourspace_backend_proto_api_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Member) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Member) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Member) GetMembershipStart() *timestamppb.Timestamp {
+	if x != nil {
+		return x.MembershipStart
+	}
+	return nil
+}
+
+func (x *Member) GetMembershipEnd() *timestamppb.Timestamp {
+	if x != nil {
+		return x.MembershipEnd
+	}
+	return nil
+}
+
+func (x *Member) GetAgeCategory() AgeCategory {
+	if x != nil {
+		return x.AgeCategory
+	}
+	return AgeCategory_AGE_CATEGORY_UNKNOWN
 }
 
 func (x *Member) GetTags() []string {
 	if x != nil {
-		return x.Tags
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *Member) GetMemberLogin() *MemberLogin {
+	if x != nil {
+		return x.MemberLogin
+	}
+	return nil
+}
+
+func (x *Member) GetAdditionalAttributes() map[string]string {
+	if x != nil {
+		return x.AdditionalAttributes
+	}
+	return nil
+}
+
+func (x *Member) GetBirthDate() string {
+	if x != nil && x.BirthDate != nil {
+		return *x.BirthDate
+	}
+	return ""
+}
+
+type MemberLogin struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Username string                 `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	Password string                 `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+	// roles grant access to restricted member attributes, the admin role grants access to everything. Only admins can
+	// change them, by updating the path member_login.roles. Logins without roles belong to members, they can only use
+	// the SelfService.
+	Roles         []string `protobuf:"bytes,3,rep,name=roles,proto3" json:"roles,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MemberLogin) Reset() {
+	*x = MemberLogin{}
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MemberLogin) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MemberLogin) ProtoMessage() {}
+
+func (x *MemberLogin) ProtoReflect() protoreflect.Message {
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MemberLogin.ProtoReflect.Descriptor instead.
+func (*MemberLogin) Descriptor() ([]byte, []int) {
+	return file_ourspace_backend_proto_api_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *MemberLogin) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *MemberLogin) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+func (x *MemberLogin) GetRoles() []string {
+	if x != nil {
+		return x.Roles
+	}
+	return nil
+}
+
+type GetMemberRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMemberRequest) Reset() {
+	*x = GetMemberRequest{}
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMemberRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMemberRequest) ProtoMessage() {}
+
+func (x *GetMemberRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMemberRequest.ProtoReflect.Descriptor instead.
+func (*GetMemberRequest) Descriptor() ([]byte, []int) {
+	return file_ourspace_backend_proto_api_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetMemberRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type ListMembersRequest struct {
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	PageSize              int32                  `protobuf:"varint,1,opt,name=page_size,proto3" json:"page_size,omitempty"`
+	PageToken             string                 `protobuf:"bytes,2,opt,name=page_token,proto3" json:"page_token,omitempty"`
+	SortBy                MemberField            `protobuf:"varint,3,opt,name=sort_by,proto3,enum=ourspace_backend.proto.MemberField" json:"sort_by,omitempty"`
+	SortDirection         SortDirection          `protobuf:"varint,4,opt,name=sort_direction,proto3,enum=ourspace_backend.proto.SortDirection" json:"sort_direction,omitempty"`
+	NameContains          *string                `protobuf:"bytes,5,opt,name=name_contains,proto3,oneof" json:"name_contains,omitempty"`
+	MembershipStartAfter  *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=membership_start_after,proto3,oneof" json:"membership_start_after,omitempty"`
+	MembershipStartBefore *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=membership_start_before,proto3,oneof" json:"membership_start_before,omitempty"`
+	MembershipEndAfter    *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=membership_end_after,proto3,oneof" json:"membership_end_after,omitempty"`
+	MembershipEndBefore   *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=membership_end_before,proto3,oneof" json:"membership_end_before,omitempty"`
+	AgeCategoryEquals     *AgeCategory           `protobuf:"varint,10,opt,name=age_category_equals,proto3,enum=ourspace_backend.proto.AgeCategory,oneof" json:"age_category_equals,omitempty"`
+	TagContains           []string               `protobuf:"bytes,11,rep,name=tag_contains,proto3" json:"tag_contains,omitempty"`
+	// attribute_equals filters by additional attributes, keyed by their technical name. Numbers, decimals, dates and
+	// date-times are compared by value, multi-selects match if the option is selected and all other attributes have to
+	// match exactly.
+	AttributeEquals map[string]string `protobuf:"bytes,12,rep,name=attribute_equals,proto3" json:"attribute_equals,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// attribute_contains filters text, email, phone and URL attributes that contain the value, ignoring case.
+	AttributeContains map[string]string `protobuf:"bytes,13,rep,name=attribute_contains,proto3" json:"attribute_contains,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// attribute_min and attribute_max filter number, decimal, date and date-time attributes by an inclusive range.
+	AttributeMin map[string]string `protobuf:"bytes,14,rep,name=attribute_min,proto3" json:"attribute_min,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	AttributeMax map[string]string `protobuf:"bytes,15,rep,name=attribute_max,proto3" json:"attribute_max,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// sort_by_attribute sorts by the additional attribute with this technical name instead of sort_by. Members without
+	// a value come first in ascending order.
+	SortByAttribute string `protobuf:"bytes,16,opt,name=sort_by_attribute,proto3" json:"sort_by_attribute,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ListMembersRequest) Reset() {
+	*x = ListMembersRequest{}
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMembersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMembersRequest) ProtoMessage() {}
+
+func (x *ListMembersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMembersRequest.ProtoReflect.Descriptor instead.
+func (*ListMembersRequest) Descriptor() ([]byte, []int) {
+	return file_ourspace_backend_proto_api_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ListMembersRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListMembersRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListMembersRequest) GetSortBy() MemberField {
+	if x != nil {
+		return x.SortBy
+	}
+	return MemberField_MEMBER_FIELD_UNKNOWN
+}
+
+func (x *ListMembersRequest) GetSortDirection() SortDirection {
+	if x != nil {
+		return x.SortDirection
+	}
+	return SortDirection_SORT_DIRECTION_DEFAULT
+}
+
+func (x *ListMembersRequest) GetNameContains() string {
+	if x != nil && x.NameContains != nil {
+		return *x.NameContains
+	}
+	return ""
+}
+
+func (x *ListMembersRequest) GetMembershipStartAfter() *timestamppb.Timestamp {
+	if x != nil {
+		return x.MembershipStartAfter
+	}
+	return nil
+}
+
+func (x *ListMembersRequest) GetMembershipStartBefore() *timestamppb.Timestamp {
+	if x != nil {
+		return x.MembershipStartBefore
+	}
+	return nil
+}
+
+func (x *ListMembersRequest) GetMembershipEndAfter() *timestamppb.Timestamp {
+	if x != nil {
+		return x.MembershipEndAfter
+	}
+	return nil
+}
+
+func (x *ListMembersRequest) GetMembershipEndBefore() *timestamppb.Timestamp {
+	if x != nil {
+		return x.MembershipEndBefore
+	}
+	return nil
+}
+
+func (x *ListMembersRequest) GetAgeCategoryEquals() AgeCategory {
+	if x != nil && x.AgeCategoryEquals != nil {
+		return *x.AgeCategoryEquals
+	}
+	return AgeCategory_AGE_CATEGORY_UNKNOWN
+}
+
+func (x *ListMembersRequest) GetTagContains() []string {
+	if x != nil {
+		return x.TagContains
+	}
+	return nil
+}
+
+func (x *ListMembersRequest) GetAttributeEquals() map[string]string {
+	if x != nil {
+		return x.AttributeEquals
+	}
+	return nil
+}
+
+func (x *ListMembersRequest) GetAttributeContains() map[string]string {
+	if x != nil {
+		return x.AttributeContains
+	}
+	return nil
+}
+
+func (x *ListMembersRequest) GetAttributeMin() map[string]string {
+	if x != nil {
+		return x.AttributeMin
+	}
+	return nil
+}
+
+func (x *ListMembersRequest) GetAttributeMax() map[string]string {
+	if x != nil {
+		return x.AttributeMax
+	}
+	return nil
+}
+
+func (x *ListMembersRequest) GetSortByAttribute() string {
+	if x != nil {
+		return x.SortByAttribute
+	}
+	return ""
+}
+
+type ListMembersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Members       []*Member              `protobuf:"bytes,1,rep,name=members,proto3" json:"members,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMembersResponse) Reset() {
+	*x = ListMembersResponse{}
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMembersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMembersResponse) ProtoMessage() {}
+
+func (x *ListMembersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMembersResponse.ProtoReflect.Descriptor instead.
+func (*ListMembersResponse) Descriptor() ([]byte, []int) {
+	return file_ourspace_backend_proto_api_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ListMembersResponse) GetMembers() []*Member {
+	if x != nil {
+		return x.Members
+	}
+	return nil
+}
+
+func (x *ListMembersResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type ExportMembersRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// filter selects the members like in ListMembers, page_size and page_token are ignored.
+	Filter        *ListMembersRequest `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
+	Format        ExportFormat        `protobuf:"varint,2,opt,name=format,proto3,enum=ourspace_backend.proto.ExportFormat" json:"format,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportMembersRequest) Reset() {
+	*x = ExportMembersRequest{}
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportMembersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportMembersRequest) ProtoMessage() {}
+
+func (x *ExportMembersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportMembersRequest.ProtoReflect.Descriptor instead.
+func (*ExportMembersRequest) Descriptor() ([]byte, []int) {
+	return file_ourspace_backend_proto_api_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ExportMembersRequest) GetFilter() *ListMembersRequest {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+func (x *ExportMembersRequest) GetFormat() ExportFormat {
+	if x != nil {
+		return x.Format
+	}
+	return ExportFormat_EXPORT_FORMAT_UNKNOWN
+}
+
+type MemberPageToken struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Field     MemberField            `protobuf:"varint,1,opt,name=field,proto3,enum=ourspace_backend.proto.MemberField" json:"field,omitempty"`
+	LastValue string                 `protobuf:"bytes,2,opt,name=last_value,proto3" json:"last_value,omitempty"`
+	Direction SortDirection          `protobuf:"varint,3,opt,name=direction,proto3,enum=ourspace_backend.proto.SortDirection" json:"direction,omitempty"`
+	LastId    string                 `protobuf:"bytes,4,opt,name=last_id,proto3" json:"last_id,omitempty"`
+	// attribute is set instead of field when sorting by an additional attribute.
+	Attribute     string `protobuf:"bytes,5,opt,name=attribute,proto3" json:"attribute,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MemberPageToken) Reset() {
+	*x = MemberPageToken{}
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MemberPageToken) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MemberPageToken) ProtoMessage() {}
+
+func (x *MemberPageToken) ProtoReflect() protoreflect.Message {
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MemberPageToken.ProtoReflect.Descriptor instead.
+func (*MemberPageToken) Descriptor() ([]byte, []int) {
+	return file_ourspace_backend_proto_api_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *MemberPageToken) GetField() MemberField {
+	if x != nil {
+		return x.Field
+	}
+	return MemberField_MEMBER_FIELD_UNKNOWN
+}
+
+func (x *MemberPageToken) GetLastValue() string {
+	if x != nil {
+		return x.LastValue
+	}
+	return ""
+}
+
+func (x *MemberPageToken) GetDirection() SortDirection {
+	if x != nil {
+		return x.Direction
+	}
+	return SortDirection_SORT_DIRECTION_DEFAULT
+}
+
+func (x *MemberPageToken) GetLastId() string {
+	if x != nil {
+		return x.LastId
+	}
+	return ""
+}
+
+func (x *MemberPageToken) GetAttribute() string {
+	if x != nil {
+		return x.Attribute
+	}
+	return ""
+}
+
+type SearchMembersRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// query is matched against name, username, tags and text additional attributes, ignoring case and accents.
+	Query         string `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	PageSize      int32  `protobuf:"varint,2,opt,name=page_size,proto3" json:"page_size,omitempty"`
+	PageToken     string `protobuf:"bytes,3,opt,name=page_token,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchMembersRequest) Reset() {
+	*x = SearchMembersRequest{}
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchMembersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchMembersRequest) ProtoMessage() {}
+
+func (x *SearchMembersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchMembersRequest.ProtoReflect.Descriptor instead.
+func (*SearchMembersRequest) Descriptor() ([]byte, []int) {
+	return file_ourspace_backend_proto_api_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *SearchMembersRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *SearchMembersRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *SearchMembersRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type SearchMembersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Results       []*MemberSearchResult  `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchMembersResponse) Reset() {
+	*x = SearchMembersResponse{}
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchMembersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchMembersResponse) ProtoMessage() {}
+
+func (x *SearchMembersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchMembersResponse.ProtoReflect.Descriptor instead.
+func (*SearchMembersResponse) Descriptor() ([]byte, []int) {
+	return file_ourspace_backend_proto_api_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *SearchMembersResponse) GetResults() []*MemberSearchResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+func (x *SearchMembersResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type MemberSearchResult struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Member *Member                `protobuf:"bytes,1,opt,name=member,proto3" json:"member,omitempty"`
+	// score is the similarity between 0 and 1 of the best matching field, results are ordered by it. Matches in tags
+	// and additional attributes are weighted lower than matches in name and username.
+	Score float64 `protobuf:"fixed64,2,opt,name=score,proto3" json:"score,omitempty"`
+	// matched_fields are the fields the query was found in: name, username, tags or additional_attributes.
+	MatchedFields []string `protobuf:"bytes,3,rep,name=matched_fields,proto3" json:"matched_fields,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MemberSearchResult) Reset() {
+	*x = MemberSearchResult{}
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MemberSearchResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MemberSearchResult) ProtoMessage() {}
+
+func (x *MemberSearchResult) ProtoReflect() protoreflect.Message {
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MemberSearchResult.ProtoReflect.Descriptor instead.
+func (*MemberSearchResult) Descriptor() ([]byte, []int) {
+	return file_ourspace_backend_proto_api_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *MemberSearchResult) GetMember() *Member {
+	if x != nil {
+		return x.Member
+	}
+	return nil
+}
+
+func (x *MemberSearchResult) GetScore() float64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *MemberSearchResult) GetMatchedFields() []string {
+	if x != nil {
+		return x.MatchedFields
+	}
+	return nil
+}
+
+type MemberSearchPageToken struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Offset        int32                  `protobuf:"varint,1,opt,name=offset,proto3" json:"offset,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MemberSearchPageToken) Reset() {
+	*x = MemberSearchPageToken{}
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MemberSearchPageToken) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MemberSearchPageToken) ProtoMessage() {}
+
+func (x *MemberSearchPageToken) ProtoReflect() protoreflect.Message {
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MemberSearchPageToken.ProtoReflect.Descriptor instead.
+func (*MemberSearchPageToken) Descriptor() ([]byte, []int) {
+	return file_ourspace_backend_proto_api_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *MemberSearchPageToken) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+type UpdateMemberRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Member        *Member                `protobuf:"bytes,1,opt,name=member,proto3" json:"member,omitempty"`
+	FieldMask     *fieldmaskpb.FieldMask `protobuf:"bytes,2,opt,name=field_mask,proto3" json:"field_mask,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateMemberRequest) Reset() {
+	*x = UpdateMemberRequest{}
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateMemberRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateMemberRequest) ProtoMessage() {}
+
+func (x *UpdateMemberRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateMemberRequest.ProtoReflect.Descriptor instead.
+func (*UpdateMemberRequest) Descriptor() ([]byte, []int) {
+	return file_ourspace_backend_proto_api_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *UpdateMemberRequest) GetMember() *Member {
+	if x != nil {
+		return x.Member
+	}
+	return nil
+}
+
+func (x *UpdateMemberRequest) GetFieldMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.FieldMask
+	}
+	return nil
+}
+
+type DeleteMemberRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteMemberRequest) Reset() {
+	*x = DeleteMemberRequest{}
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteMemberRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteMemberRequest) ProtoMessage() {}
+
+func (x *DeleteMemberRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteMemberRequest.ProtoReflect.Descriptor instead.
+func (*DeleteMemberRequest) Descriptor() ([]byte, []int) {
+	return file_ourspace_backend_proto_api_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *DeleteMemberRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type ImportMembersRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// csv is the content of the CSV file, the first row contains the column names. Exports prefix values that start
+	// with =, +, -, @, tab or carriage return with an apostrophe, which is removed again.
+	Csv string `protobuf:"bytes,1,opt,name=csv,proto3" json:"csv,omitempty"`
+	// column_mapping maps column names to member fields: name, membership_start, membership_end, age_category,
+	// birth_date, tags or additional_attributes.<technical_name>. Columns that are not mapped are ignored. age_category
+	// doesn't need to be mapped if birth_date is.
+	ColumnMapping map[string]string `protobuf:"bytes,2,rep,name=column_mapping,proto3" json:"column_mapping,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// delimiter separates the columns, defaults to ",".
+	Delimiter string `protobuf:"bytes,3,opt,name=delimiter,proto3" json:"delimiter,omitempty"`
+	// tag_separator separates multiple tags in the tags column and the options of multi-select attributes, defaults to
+	// ";".
+	TagSeparator string `protobuf:"bytes,4,opt,name=tag_separator,proto3" json:"tag_separator,omitempty"`
+	// tags are added to every imported member.
+	Tags []string `protobuf:"bytes,5,rep,name=tags,proto3" json:"tags,omitempty"`
+	// dry_run only validates the rows without creating any members.
+	DryRun        bool `protobuf:"varint,6,opt,name=dry_run,proto3" json:"dry_run,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportMembersRequest) Reset() {
+	*x = ImportMembersRequest{}
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportMembersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportMembersRequest) ProtoMessage() {}
+
+func (x *ImportMembersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportMembersRequest.ProtoReflect.Descriptor instead.
+func (*ImportMembersRequest) Descriptor() ([]byte, []int) {
+	return file_ourspace_backend_proto_api_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ImportMembersRequest) GetCsv() string {
+	if x != nil {
+		return x.Csv
+	}
+	return ""
+}
+
+func (x *ImportMembersRequest) GetColumnMapping() map[string]string {
+	if x != nil {
+		return x.ColumnMapping
+	}
+	return nil
+}
+
+func (x *ImportMembersRequest) GetDelimiter() string {
+	if x != nil {
+		return x.Delimiter
+	}
+	return ""
+}
+
+func (x *ImportMembersRequest) GetTagSeparator() string {
+	if x != nil {
+		return x.TagSeparator
+	}
+	return ""
+}
+
+func (x *ImportMembersRequest) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *ImportMembersRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+type ImportMembersResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// member_count is the number of members that were imported, or would be imported in a dry run.
+	MemberCount int32 `protobuf:"varint,1,opt,name=member_count,proto3" json:"member_count,omitempty"`
+	// violations are the reasons why rows can't be imported. No members are imported if there are any.
+	Violations    []*MemberImportViolation `protobuf:"bytes,2,rep,name=violations,proto3" json:"violations,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportMembersResponse) Reset() {
+	*x = ImportMembersResponse{}
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportMembersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportMembersResponse) ProtoMessage() {}
+
+func (x *ImportMembersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportMembersResponse.ProtoReflect.Descriptor instead.
+func (*ImportMembersResponse) Descriptor() ([]byte, []int) {
+	return file_ourspace_backend_proto_api_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ImportMembersResponse) GetMemberCount() int32 {
+	if x != nil {
+		return x.MemberCount
+	}
+	return 0
+}
+
+func (x *ImportMembersResponse) GetViolations() []*MemberImportViolation {
+	if x != nil {
+		return x.Violations
+	}
+	return nil
+}
+
+type MemberImportViolation struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// row is the line of the CSV file, the header is row 1.
+	Row           int32  `protobuf:"varint,1,opt,name=row,proto3" json:"row,omitempty"`
+	Column        string `protobuf:"bytes,2,opt,name=column,proto3" json:"column,omitempty"`
+	Field         string `protobuf:"bytes,3,opt,name=field,proto3" json:"field,omitempty"`
+	Description   string `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MemberImportViolation) Reset() {
+	*x = MemberImportViolation{}
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MemberImportViolation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MemberImportViolation) ProtoMessage() {}
+
+func (x *MemberImportViolation) ProtoReflect() protoreflect.Message {
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MemberImportViolation.ProtoReflect.Descriptor instead.
+func (*MemberImportViolation) Descriptor() ([]byte, []int) {
+	return file_ourspace_backend_proto_api_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *MemberImportViolation) GetRow() int32 {
+	if x != nil {
+		return x.Row
+	}
+	return 0
+}
+
+func (x *MemberImportViolation) GetColumn() string {
+	if x != nil {
+		return x.Column
+	}
+	return ""
+}
+
+func (x *MemberImportViolation) GetField() string {
+	if x != nil {
+		return x.Field
+	}
+	return ""
+}
+
+func (x *MemberImportViolation) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+type ListMemberTagsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PageSize      int32                  `protobuf:"varint,1,opt,name=page_size,proto3" json:"page_size,omitempty"`
+	PageToken     string                 `protobuf:"bytes,2,opt,name=page_token,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMemberTagsRequest) Reset() {
+	*x = ListMemberTagsRequest{}
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMemberTagsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMemberTagsRequest) ProtoMessage() {}
+
+func (x *ListMemberTagsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMemberTagsRequest.ProtoReflect.Descriptor instead.
+func (*ListMemberTagsRequest) Descriptor() ([]byte, []int) {
+	return file_ourspace_backend_proto_api_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ListMemberTagsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListMemberTagsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type ListMemberTagsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tags          []string               `protobuf:"bytes,1,rep,name=tags,proto3" json:"tags,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,proto3" json:"next_page_token,omitempty"`
+	// member_tags are the same tags with their definitions and usage counts.
+	MemberTags    []*MemberTag `protobuf:"bytes,3,rep,name=member_tags,proto3" json:"member_tags,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMemberTagsResponse) Reset() {
+	*x = ListMemberTagsResponse{}
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMemberTagsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMemberTagsResponse) ProtoMessage() {}
+
+func (x *ListMemberTagsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMemberTagsResponse.ProtoReflect.Descriptor instead.
+func (*ListMemberTagsResponse) Descriptor() ([]byte, []int) {
+	return file_ourspace_backend_proto_api_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *ListMemberTagsResponse) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *ListMemberTagsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *ListMemberTagsResponse) GetMemberTags() []*MemberTag {
+	if x != nil {
+		return x.MemberTags
+	}
+	return nil
+}
+
+// MemberTag is the definition of a tag. Tags can be used on members without being defined, they have no description
+// and color then.
+type MemberTag struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Name        string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Description string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	// color as hex RGB, e.g. #1e90ff. It is empty if the tag has no color.
+	Color string `protobuf:"bytes,3,opt,name=color,proto3" json:"color,omitempty"`
+	// member_count is the number of members with the tag.
+	MemberCount   int64 `protobuf:"varint,4,opt,name=member_count,proto3" json:"member_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MemberTag) Reset() {
+	*x = MemberTag{}
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MemberTag) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MemberTag) ProtoMessage() {}
+
+func (x *MemberTag) ProtoReflect() protoreflect.Message {
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MemberTag.ProtoReflect.Descriptor instead.
+func (*MemberTag) Descriptor() ([]byte, []int) {
+	return file_ourspace_backend_proto_api_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *MemberTag) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *MemberTag) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *MemberTag) GetColor() string {
+	if x != nil {
+		return x.Color
+	}
+	return ""
+}
+
+func (x *MemberTag) GetMemberCount() int64 {
+	if x != nil {
+		return x.MemberCount
+	}
+	return 0
+}
+
+type CreateMemberTagRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tag           *MemberTag             `protobuf:"bytes,1,opt,name=tag,proto3" json:"tag,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateMemberTagRequest) Reset() {
+	*x = CreateMemberTagRequest{}
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateMemberTagRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateMemberTagRequest) ProtoMessage() {}
+
+func (x *CreateMemberTagRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateMemberTagRequest.ProtoReflect.Descriptor instead.
+func (*CreateMemberTagRequest) Descriptor() ([]byte, []int) {
+	return file_ourspace_backend_proto_api_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *CreateMemberTagRequest) GetTag() *MemberTag {
+	if x != nil {
+		return x.Tag
+	}
+	return nil
+}
+
+type UpdateMemberTagRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tag           *MemberTag             `protobuf:"bytes,1,opt,name=tag,proto3" json:"tag,omitempty"`
+	FieldMask     *fieldmaskpb.FieldMask `protobuf:"bytes,2,opt,name=field_mask,proto3" json:"field_mask,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateMemberTagRequest) Reset() {
+	*x = UpdateMemberTagRequest{}
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateMemberTagRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateMemberTagRequest) ProtoMessage() {}
+
+func (x *UpdateMemberTagRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateMemberTagRequest.ProtoReflect.Descriptor instead.
+func (*UpdateMemberTagRequest) Descriptor() ([]byte, []int) {
+	return file_ourspace_backend_proto_api_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *UpdateMemberTagRequest) GetTag() *MemberTag {
+	if x != nil {
+		return x.Tag
+	}
+	return nil
+}
+
+func (x *UpdateMemberTagRequest) GetFieldMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.FieldMask
+	}
+	return nil
+}
+
+type DeleteMemberTagRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Name  string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// remove_from_members removes the tag from all members as well, otherwise it stays on them without definition.
+	RemoveFromMembers bool `protobuf:"varint,2,opt,name=remove_from_members,proto3" json:"remove_from_members,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *DeleteMemberTagRequest) Reset() {
+	*x = DeleteMemberTagRequest{}
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteMemberTagRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteMemberTagRequest) ProtoMessage() {}
+
+func (x *DeleteMemberTagRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteMemberTagRequest.ProtoReflect.Descriptor instead.
+func (*DeleteMemberTagRequest) Descriptor() ([]byte, []int) {
+	return file_ourspace_backend_proto_api_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *DeleteMemberTagRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *DeleteMemberTagRequest) GetRemoveFromMembers() bool {
+	if x != nil {
+		return x.RemoveFromMembers
+	}
+	return false
+}
+
+type RenameMemberTagRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	NewName       string                 `protobuf:"bytes,2,opt,name=new_name,proto3" json:"new_name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RenameMemberTagRequest) Reset() {
+	*x = RenameMemberTagRequest{}
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RenameMemberTagRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RenameMemberTagRequest) ProtoMessage() {}
+
+func (x *RenameMemberTagRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RenameMemberTagRequest.ProtoReflect.Descriptor instead.
+func (*RenameMemberTagRequest) Descriptor() ([]byte, []int) {
+	return file_ourspace_backend_proto_api_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *RenameMemberTagRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *RenameMemberTagRequest) GetNewName() string {
+	if x != nil {
+		return x.NewName
+	}
+	return ""
+}
+
+type MergeMemberTagsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// name is the tag that replaces the source tags, it keeps its definition. If it has none, it takes the definition
+	// of the first source tag that has one.
+	Name          string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	SourceNames   []string `protobuf:"bytes,2,rep,name=source_names,proto3" json:"source_names,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MergeMemberTagsRequest) Reset() {
+	*x = MergeMemberTagsRequest{}
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MergeMemberTagsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MergeMemberTagsRequest) ProtoMessage() {}
+
+func (x *MergeMemberTagsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MergeMemberTagsRequest.ProtoReflect.Descriptor instead.
+func (*MergeMemberTagsRequest) Descriptor() ([]byte, []int) {
+	return file_ourspace_backend_proto_api_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *MergeMemberTagsRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *MergeMemberTagsRequest) GetSourceNames() []string {
+	if x != nil {
+		return x.SourceNames
+	}
+	return nil
+}
+
+type MemberTagsPageToken struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Offset        int32                  `protobuf:"varint,1,opt,name=offset,proto3" json:"offset,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MemberTagsPageToken) Reset() {
+	*x = MemberTagsPageToken{}
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MemberTagsPageToken) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MemberTagsPageToken) ProtoMessage() {}
+
+func (x *MemberTagsPageToken) ProtoReflect() protoreflect.Message {
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MemberTagsPageToken.ProtoReflect.Descriptor instead.
+func (*MemberTagsPageToken) Descriptor() ([]byte, []int) {
+	return file_ourspace_backend_proto_api_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *MemberTagsPageToken) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+type CreateMemberAttributeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Attribute     *MemberAttribute       `protobuf:"bytes,1,opt,name=attribute,proto3" json:"attribute,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateMemberAttributeRequest) Reset() {
+	*x = CreateMemberAttributeRequest{}
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateMemberAttributeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateMemberAttributeRequest) ProtoMessage() {}
+
+func (x *CreateMemberAttributeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateMemberAttributeRequest.ProtoReflect.Descriptor instead.
+func (*CreateMemberAttributeRequest) Descriptor() ([]byte, []int) {
+	return file_ourspace_backend_proto_api_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *CreateMemberAttributeRequest) GetAttribute() *MemberAttribute {
+	if x != nil {
+		return x.Attribute
+	}
+	return nil
+}
+
+type GetMemberAttributeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMemberAttributeRequest) Reset() {
+	*x = GetMemberAttributeRequest{}
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMemberAttributeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMemberAttributeRequest) ProtoMessage() {}
+
+func (x *GetMemberAttributeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMemberAttributeRequest.ProtoReflect.Descriptor instead.
+func (*GetMemberAttributeRequest) Descriptor() ([]byte, []int) {
+	return file_ourspace_backend_proto_api_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *GetMemberAttributeRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type ListMemberAttributesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PageSize      int32                  `protobuf:"varint,1,opt,name=page_size,proto3" json:"page_size,omitempty"`
+	PageToken     string                 `protobuf:"bytes,2,opt,name=page_token,proto3" json:"page_token,omitempty"`
+	SortBy        MemberAttributeField   `protobuf:"varint,3,opt,name=sort_by,proto3,enum=ourspace_backend.proto.MemberAttributeField" json:"sort_by,omitempty"`
+	SortDirection SortDirection          `protobuf:"varint,4,opt,name=sort_direction,proto3,enum=ourspace_backend.proto.SortDirection" json:"sort_direction,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMemberAttributesRequest) Reset() {
+	*x = ListMemberAttributesRequest{}
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMemberAttributesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMemberAttributesRequest) ProtoMessage() {}
+
+func (x *ListMemberAttributesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMemberAttributesRequest.ProtoReflect.Descriptor instead.
+func (*ListMemberAttributesRequest) Descriptor() ([]byte, []int) {
+	return file_ourspace_backend_proto_api_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *ListMemberAttributesRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListMemberAttributesRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListMemberAttributesRequest) GetSortBy() MemberAttributeField {
+	if x != nil {
+		return x.SortBy
+	}
+	return MemberAttributeField_MEMBER_ATTRIBUTE_FIELD_UNKNOWN
+}
+
+func (x *ListMemberAttributesRequest) GetSortDirection() SortDirection {
+	if x != nil {
+		return x.SortDirection
+	}
+	return SortDirection_SORT_DIRECTION_DEFAULT
+}
+
+type ListMemberAttributesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Attributes    []*MemberAttribute     `protobuf:"bytes,1,rep,name=attributes,proto3" json:"attributes,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMemberAttributesResponse) Reset() {
+	*x = ListMemberAttributesResponse{}
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMemberAttributesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMemberAttributesResponse) ProtoMessage() {}
+
+func (x *ListMemberAttributesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMemberAttributesResponse.ProtoReflect.Descriptor instead.
+func (*ListMemberAttributesResponse) Descriptor() ([]byte, []int) {
+	return file_ourspace_backend_proto_api_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *ListMemberAttributesResponse) GetAttributes() []*MemberAttribute {
+	if x != nil {
+		return x.Attributes
+	}
+	return nil
+}
+
+func (x *ListMemberAttributesResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type UpdateMemberAttributeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Attribute     *MemberAttribute       `protobuf:"bytes,1,opt,name=attribute,proto3" json:"attribute,omitempty"`
+	FieldMask     *fieldmaskpb.FieldMask `protobuf:"bytes,2,opt,name=field_mask,json=fieldMask,proto3" json:"field_mask,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateMemberAttributeRequest) Reset() {
+	*x = UpdateMemberAttributeRequest{}
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateMemberAttributeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateMemberAttributeRequest) ProtoMessage() {}
+
+func (x *UpdateMemberAttributeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateMemberAttributeRequest.ProtoReflect.Descriptor instead.
+func (*UpdateMemberAttributeRequest) Descriptor() ([]byte, []int) {
+	return file_ourspace_backend_proto_api_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *UpdateMemberAttributeRequest) GetAttribute() *MemberAttribute {
+	if x != nil {
+		return x.Attribute
+	}
+	return nil
+}
+
+func (x *UpdateMemberAttributeRequest) GetFieldMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.FieldMask
+	}
+	return nil
+}
+
+type DeleteMemberAttributeRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// purge_values removes the values of the attribute from all members. Otherwise the values are kept and reappear if
+	// an attribute with the same technical name is created again.
+	PurgeValues   bool `protobuf:"varint,2,opt,name=purge_values,proto3" json:"purge_values,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteMemberAttributeRequest) Reset() {
+	*x = DeleteMemberAttributeRequest{}
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteMemberAttributeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteMemberAttributeRequest) ProtoMessage() {}
+
+func (x *DeleteMemberAttributeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteMemberAttributeRequest.ProtoReflect.Descriptor instead.
+func (*DeleteMemberAttributeRequest) Descriptor() ([]byte, []int) {
+	return file_ourspace_backend_proto_api_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *DeleteMemberAttributeRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *DeleteMemberAttributeRequest) GetPurgeValues() bool {
+	if x != nil {
+		return x.PurgeValues
+	}
+	return false
+}
+
+type MigrateMemberAttributeRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// technical_name renames the attribute, the values of all members are moved to the new name. Unchanged if empty.
+	TechnicalName string `protobuf:"bytes,2,opt,name=technical_name,proto3" json:"technical_name,omitempty"`
+	// type converts the values of all members to the new type. Unchanged if TYPE_UNKNOWN. Pattern, min and max are
+	// removed when the type changes, as they depend on the type.
+	Type MemberAttribute_Type `protobuf:"varint,3,opt,name=type,proto3,enum=ourspace_backend.proto.MemberAttribute_Type" json:"type,omitempty"`
+	// options replace the options of select and multi-select attributes. Values that are not one of the new options
+	// can't be converted.
+	Options []string `protobuf:"bytes,4,rep,name=options,proto3" json:"options,omitempty"`
+	// drop_invalid_values removes values that can't be converted instead of aborting the migration.
+	DropInvalidValues bool `protobuf:"varint,5,opt,name=drop_invalid_values,proto3" json:"drop_invalid_values,omitempty"`
+	// dry_run only reports the values that can't be converted without changing anything.
+	DryRun        bool `protobuf:"varint,6,opt,name=dry_run,proto3" json:"dry_run,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MigrateMemberAttributeRequest) Reset() {
+	*x = MigrateMemberAttributeRequest{}
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MigrateMemberAttributeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MigrateMemberAttributeRequest) ProtoMessage() {}
+
+func (x *MigrateMemberAttributeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MigrateMemberAttributeRequest.ProtoReflect.Descriptor instead.
+func (*MigrateMemberAttributeRequest) Descriptor() ([]byte, []int) {
+	return file_ourspace_backend_proto_api_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *MigrateMemberAttributeRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *MigrateMemberAttributeRequest) GetTechnicalName() string {
+	if x != nil {
+		return x.TechnicalName
+	}
+	return ""
+}
+
+func (x *MigrateMemberAttributeRequest) GetType() MemberAttribute_Type {
+	if x != nil {
+		return x.Type
+	}
+	return MemberAttribute_TYPE_UNKNOWN
+}
+
+func (x *MigrateMemberAttributeRequest) GetOptions() []string {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+func (x *MigrateMemberAttributeRequest) GetDropInvalidValues() bool {
+	if x != nil {
+		return x.DropInvalidValues
+	}
+	return false
+}
+
+func (x *MigrateMemberAttributeRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+type MigrateMemberAttributeResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// attribute is the migrated attribute, or the attribute as it would be after the migration if it was not applied.
+	Attribute *MemberAttribute `protobuf:"bytes,1,opt,name=attribute,proto3" json:"attribute,omitempty"`
+	// member_count is the number of members with a value for the attribute.
+	MemberCount int32 `protobuf:"varint,2,opt,name=member_count,proto3" json:"member_count,omitempty"`
+	// invalid_values are the values that can't be converted to the new type.
+	InvalidValues []*MemberAttributeMigrationIssue `protobuf:"bytes,3,rep,name=invalid_values,proto3" json:"invalid_values,omitempty"`
+	// applied is set if the migration was applied. It is not applied in a dry run or if there are invalid values and
+	// drop_invalid_values is not set.
+	Applied       bool `protobuf:"varint,4,opt,name=applied,proto3" json:"applied,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MigrateMemberAttributeResponse) Reset() {
+	*x = MigrateMemberAttributeResponse{}
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MigrateMemberAttributeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MigrateMemberAttributeResponse) ProtoMessage() {}
+
+func (x *MigrateMemberAttributeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MigrateMemberAttributeResponse.ProtoReflect.Descriptor instead.
+func (*MigrateMemberAttributeResponse) Descriptor() ([]byte, []int) {
+	return file_ourspace_backend_proto_api_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *MigrateMemberAttributeResponse) GetAttribute() *MemberAttribute {
+	if x != nil {
+		return x.Attribute
+	}
+	return nil
+}
+
+func (x *MigrateMemberAttributeResponse) GetMemberCount() int32 {
+	if x != nil {
+		return x.MemberCount
+	}
+	return 0
+}
+
+func (x *MigrateMemberAttributeResponse) GetInvalidValues() []*MemberAttributeMigrationIssue {
+	if x != nil {
+		return x.InvalidValues
+	}
+	return nil
+}
+
+func (x *MigrateMemberAttributeResponse) GetApplied() bool {
+	if x != nil {
+		return x.Applied
+	}
+	return false
+}
+
+type MemberAttributeMigrationIssue struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MemberId      string                 `protobuf:"bytes,1,opt,name=member_id,proto3" json:"member_id,omitempty"`
+	MemberName    string                 `protobuf:"bytes,2,opt,name=member_name,proto3" json:"member_name,omitempty"`
+	Value         string                 `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
+	Description   string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MemberAttributeMigrationIssue) Reset() {
+	*x = MemberAttributeMigrationIssue{}
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MemberAttributeMigrationIssue) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MemberAttributeMigrationIssue) ProtoMessage() {}
+
+func (x *MemberAttributeMigrationIssue) ProtoReflect() protoreflect.Message {
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MemberAttributeMigrationIssue.ProtoReflect.Descriptor instead.
+func (*MemberAttributeMigrationIssue) Descriptor() ([]byte, []int) {
+	return file_ourspace_backend_proto_api_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *MemberAttributeMigrationIssue) GetMemberId() string {
+	if x != nil {
+		return x.MemberId
+	}
+	return ""
+}
+
+func (x *MemberAttributeMigrationIssue) GetMemberName() string {
+	if x != nil {
+		return x.MemberName
+	}
+	return ""
+}
+
+func (x *MemberAttributeMigrationIssue) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *MemberAttributeMigrationIssue) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+type MemberRelationship struct {
+	state    protoimpl.MessageState  `protogen:"open.v1"`
+	Id       string                  `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	MemberId string                  `protobuf:"bytes,2,opt,name=member_id,proto3" json:"member_id,omitempty"`
+	Type     MemberRelationship_Type `protobuf:"varint,3,opt,name=type,proto3,enum=ourspace_backend.proto.MemberRelationship_Type" json:"type,omitempty"`
+	// related_member_id is set if the related person is a member as well. Otherwise it is an external contact described
+	// by name, email and phone.
+	RelatedMemberId *string `protobuf:"bytes,4,opt,name=related_member_id,proto3,oneof" json:"related_member_id,omitempty"`
+	// name is the name of the external contact, for related members it is their name and can't be set.
+	Name  string `protobuf:"bytes,5,opt,name=name,proto3" json:"name,omitempty"`
+	Email string `protobuf:"bytes,6,opt,name=email,proto3" json:"email,omitempty"`
+	Phone string `protobuf:"bytes,7,opt,name=phone,proto3" json:"phone,omitempty"`
+	// description of the relationship, e.g. "mother".
+	Description   string `protobuf:"bytes,8,opt,name=description,proto3" json:"description,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MemberRelationship) Reset() {
+	*x = MemberRelationship{}
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MemberRelationship) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MemberRelationship) ProtoMessage() {}
+
+func (x *MemberRelationship) ProtoReflect() protoreflect.Message {
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MemberRelationship.ProtoReflect.Descriptor instead.
+func (*MemberRelationship) Descriptor() ([]byte, []int) {
+	return file_ourspace_backend_proto_api_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *MemberRelationship) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *MemberRelationship) GetMemberId() string {
+	if x != nil {
+		return x.MemberId
+	}
+	return ""
+}
+
+func (x *MemberRelationship) GetType() MemberRelationship_Type {
+	if x != nil {
+		return x.Type
+	}
+	return MemberRelationship_TYPE_UNKNOWN
+}
+
+func (x *MemberRelationship) GetRelatedMemberId() string {
+	if x != nil && x.RelatedMemberId != nil {
+		return *x.RelatedMemberId
+	}
+	return ""
+}
+
+func (x *MemberRelationship) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *MemberRelationship) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *MemberRelationship) GetPhone() string {
+	if x != nil {
+		return x.Phone
+	}
+	return ""
+}
+
+func (x *MemberRelationship) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+type CreateMemberRelationshipRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Relationship  *MemberRelationship    `protobuf:"bytes,1,opt,name=relationship,proto3" json:"relationship,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateMemberRelationshipRequest) Reset() {
+	*x = CreateMemberRelationshipRequest{}
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateMemberRelationshipRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateMemberRelationshipRequest) ProtoMessage() {}
+
+func (x *CreateMemberRelationshipRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateMemberRelationshipRequest.ProtoReflect.Descriptor instead.
+func (*CreateMemberRelationshipRequest) Descriptor() ([]byte, []int) {
+	return file_ourspace_backend_proto_api_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *CreateMemberRelationshipRequest) GetRelationship() *MemberRelationship {
+	if x != nil {
+		return x.Relationship
+	}
+	return nil
+}
+
+type ListMemberRelationshipsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MemberId      string                 `protobuf:"bytes,1,opt,name=member_id,proto3" json:"member_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMemberRelationshipsRequest) Reset() {
+	*x = ListMemberRelationshipsRequest{}
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMemberRelationshipsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMemberRelationshipsRequest) ProtoMessage() {}
+
+func (x *ListMemberRelationshipsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMemberRelationshipsRequest.ProtoReflect.Descriptor instead.
+func (*ListMemberRelationshipsRequest) Descriptor() ([]byte, []int) {
+	return file_ourspace_backend_proto_api_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *ListMemberRelationshipsRequest) GetMemberId() string {
+	if x != nil {
+		return x.MemberId
+	}
+	return ""
+}
+
+type ListMemberRelationshipsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Relationships []*MemberRelationship  `protobuf:"bytes,1,rep,name=relationships,proto3" json:"relationships,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMemberRelationshipsResponse) Reset() {
+	*x = ListMemberRelationshipsResponse{}
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMemberRelationshipsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMemberRelationshipsResponse) ProtoMessage() {}
+
+func (x *ListMemberRelationshipsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMemberRelationshipsResponse.ProtoReflect.Descriptor instead.
+func (*ListMemberRelationshipsResponse) Descriptor() ([]byte, []int) {
+	return file_ourspace_backend_proto_api_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *ListMemberRelationshipsResponse) GetRelationships() []*MemberRelationship {
+	if x != nil {
+		return x.Relationships
+	}
+	return nil
+}
+
+type UpdateMemberRelationshipRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Relationship  *MemberRelationship    `protobuf:"bytes,1,opt,name=relationship,proto3" json:"relationship,omitempty"`
+	FieldMask     *fieldmaskpb.FieldMask `protobuf:"bytes,2,opt,name=field_mask,proto3" json:"field_mask,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateMemberRelationshipRequest) Reset() {
+	*x = UpdateMemberRelationshipRequest{}
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateMemberRelationshipRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateMemberRelationshipRequest) ProtoMessage() {}
+
+func (x *UpdateMemberRelationshipRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateMemberRelationshipRequest.ProtoReflect.Descriptor instead.
+func (*UpdateMemberRelationshipRequest) Descriptor() ([]byte, []int) {
+	return file_ourspace_backend_proto_api_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *UpdateMemberRelationshipRequest) GetRelationship() *MemberRelationship {
+	if x != nil {
+		return x.Relationship
+	}
+	return nil
+}
+
+func (x *UpdateMemberRelationshipRequest) GetFieldMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.FieldMask
+	}
+	return nil
+}
+
+type DeleteMemberRelationshipRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MemberId      string                 `protobuf:"bytes,1,opt,name=member_id,proto3" json:"member_id,omitempty"`
+	Id            string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteMemberRelationshipRequest) Reset() {
+	*x = DeleteMemberRelationshipRequest{}
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteMemberRelationshipRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteMemberRelationshipRequest) ProtoMessage() {}
+
+func (x *DeleteMemberRelationshipRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteMemberRelationshipRequest.ProtoReflect.Descriptor instead.
+func (*DeleteMemberRelationshipRequest) Descriptor() ([]byte, []int) {
+	return file_ourspace_backend_proto_api_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *DeleteMemberRelationshipRequest) GetMemberId() string {
+	if x != nil {
+		return x.MemberId
+	}
+	return ""
+}
+
+func (x *DeleteMemberRelationshipRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type MemberConsent struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Id       string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	MemberId string                 `protobuf:"bytes,2,opt,name=member_id,proto3" json:"member_id,omitempty"`
+	// purpose describes what was consented to, e.g. "membership" or "photos".
+	Purpose string `protobuf:"bytes,3,opt,name=purpose,proto3" json:"purpose,omitempty"`
+	// relationship_id is the guardian who gave the consent. If it is not set, the member consented themselves.
+	RelationshipId *string `protobuf:"bytes,4,opt,name=relationship_id,proto3,oneof" json:"relationship_id,omitempty"`
+	// given_by is the name of whoever gave the consent at that time, it is kept if the guardian is deleted later.
+	GivenBy string `protobuf:"bytes,5,opt,name=given_by,proto3" json:"given_by,omitempty"`
+	// consent_time is when the consent was given, defaults to now.
+	ConsentTime   *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=consent_time,proto3" json:"consent_time,omitempty"`
+	RevokeTime    *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=revoke_time,proto3" json:"revoke_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MemberConsent) Reset() {
+	*x = MemberConsent{}
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MemberConsent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MemberConsent) ProtoMessage() {}
+
+func (x *MemberConsent) ProtoReflect() protoreflect.Message {
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MemberConsent.ProtoReflect.Descriptor instead.
+func (*MemberConsent) Descriptor() ([]byte, []int) {
+	return file_ourspace_backend_proto_api_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *MemberConsent) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *MemberConsent) GetMemberId() string {
+	if x != nil {
+		return x.MemberId
+	}
+	return ""
+}
+
+func (x *MemberConsent) GetPurpose() string {
+	if x != nil {
+		return x.Purpose
+	}
+	return ""
+}
+
+func (x *MemberConsent) GetRelationshipId() string {
+	if x != nil && x.RelationshipId != nil {
+		return *x.RelationshipId
+	}
+	return ""
+}
+
+func (x *MemberConsent) GetGivenBy() string {
+	if x != nil {
+		return x.GivenBy
+	}
+	return ""
+}
+
+func (x *MemberConsent) GetConsentTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ConsentTime
+	}
+	return nil
+}
+
+func (x *MemberConsent) GetRevokeTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RevokeTime
+	}
+	return nil
+}
+
+type CreateMemberConsentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Consent       *MemberConsent         `protobuf:"bytes,1,opt,name=consent,proto3" json:"consent,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateMemberConsentRequest) Reset() {
+	*x = CreateMemberConsentRequest{}
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateMemberConsentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateMemberConsentRequest) ProtoMessage() {}
+
+func (x *CreateMemberConsentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateMemberConsentRequest.ProtoReflect.Descriptor instead.
+func (*CreateMemberConsentRequest) Descriptor() ([]byte, []int) {
+	return file_ourspace_backend_proto_api_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *CreateMemberConsentRequest) GetConsent() *MemberConsent {
+	if x != nil {
+		return x.Consent
+	}
+	return nil
+}
+
+type ListMemberConsentsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MemberId      string                 `protobuf:"bytes,1,opt,name=member_id,proto3" json:"member_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMemberConsentsRequest) Reset() {
+	*x = ListMemberConsentsRequest{}
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMemberConsentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMemberConsentsRequest) ProtoMessage() {}
+
+func (x *ListMemberConsentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMemberConsentsRequest.ProtoReflect.Descriptor instead.
+func (*ListMemberConsentsRequest) Descriptor() ([]byte, []int) {
+	return file_ourspace_backend_proto_api_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *ListMemberConsentsRequest) GetMemberId() string {
+	if x != nil {
+		return x.MemberId
+	}
+	return ""
+}
+
+type ListMemberConsentsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Consents      []*MemberConsent       `protobuf:"bytes,1,rep,name=consents,proto3" json:"consents,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMemberConsentsResponse) Reset() {
+	*x = ListMemberConsentsResponse{}
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMemberConsentsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMemberConsentsResponse) ProtoMessage() {}
+
+func (x *ListMemberConsentsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMemberConsentsResponse.ProtoReflect.Descriptor instead.
+func (*ListMemberConsentsResponse) Descriptor() ([]byte, []int) {
+	return file_ourspace_backend_proto_api_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *ListMemberConsentsResponse) GetConsents() []*MemberConsent {
+	if x != nil {
+		return x.Consents
+	}
+	return nil
+}
+
+type RevokeMemberConsentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MemberId      string                 `protobuf:"bytes,1,opt,name=member_id,proto3" json:"member_id,omitempty"`
+	Id            string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeMemberConsentRequest) Reset() {
+	*x = RevokeMemberConsentRequest{}
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeMemberConsentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeMemberConsentRequest) ProtoMessage() {}
+
+func (x *RevokeMemberConsentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeMemberConsentRequest.ProtoReflect.Descriptor instead.
+func (*RevokeMemberConsentRequest) Descriptor() ([]byte, []int) {
+	return file_ourspace_backend_proto_api_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *RevokeMemberConsentRequest) GetMemberId() string {
+	if x != nil {
+		return x.MemberId
+	}
+	return ""
+}
+
+func (x *RevokeMemberConsentRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// MemberChangeRequest is a change members requested to their own data, which has to be approved by staff.
+type MemberChangeRequest struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Id       string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	MemberId string                 `protobuf:"bytes,2,opt,name=member_id,proto3" json:"member_id,omitempty"`
+	// member contains the requested values of the fields in field_mask.
+	Member     *Member                   `protobuf:"bytes,3,opt,name=member,proto3" json:"member,omitempty"`
+	FieldMask  *fieldmaskpb.FieldMask    `protobuf:"bytes,4,opt,name=field_mask,proto3" json:"field_mask,omitempty"`
+	State      MemberChangeRequest_State `protobuf:"varint,5,opt,name=state,proto3,enum=ourspace_backend.proto.MemberChangeRequest_State" json:"state,omitempty"`
+	CreateTime *timestamppb.Timestamp    `protobuf:"bytes,6,opt,name=create_time,proto3" json:"create_time,omitempty"`
+	// requested_by is the username of the member who requested the change.
+	RequestedBy string                 `protobuf:"bytes,7,opt,name=requested_by,proto3" json:"requested_by,omitempty"`
+	DecideTime  *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=decide_time,proto3" json:"decide_time,omitempty"`
+	// decided_by is the username of the staff member who approved or rejected the change.
+	DecidedBy string `protobuf:"bytes,9,opt,name=decided_by,proto3" json:"decided_by,omitempty"`
+	// comment of the staff member who decided, e.g. why the change was rejected.
+	Comment       string `protobuf:"bytes,10,opt,name=comment,proto3" json:"comment,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MemberChangeRequest) Reset() {
+	*x = MemberChangeRequest{}
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MemberChangeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MemberChangeRequest) ProtoMessage() {}
+
+func (x *MemberChangeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MemberChangeRequest.ProtoReflect.Descriptor instead.
+func (*MemberChangeRequest) Descriptor() ([]byte, []int) {
+	return file_ourspace_backend_proto_api_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *MemberChangeRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *MemberChangeRequest) GetMemberId() string {
+	if x != nil {
+		return x.MemberId
+	}
+	return ""
+}
+
+func (x *MemberChangeRequest) GetMember() *Member {
+	if x != nil {
+		return x.Member
+	}
+	return nil
+}
+
+func (x *MemberChangeRequest) GetFieldMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.FieldMask
+	}
+	return nil
+}
+
+func (x *MemberChangeRequest) GetState() MemberChangeRequest_State {
+	if x != nil {
+		return x.State
+	}
+	return MemberChangeRequest_STATE_UNKNOWN
+}
+
+func (x *MemberChangeRequest) GetCreateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreateTime
+	}
+	return nil
+}
+
+func (x *MemberChangeRequest) GetRequestedBy() string {
+	if x != nil {
+		return x.RequestedBy
+	}
+	return ""
+}
+
+func (x *MemberChangeRequest) GetDecideTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DecideTime
+	}
+	return nil
+}
+
+func (x *MemberChangeRequest) GetDecidedBy() string {
+	if x != nil {
+		return x.DecidedBy
+	}
+	return ""
+}
+
+func (x *MemberChangeRequest) GetComment() string {
+	if x != nil {
+		return x.Comment
+	}
+	return ""
+}
+
+type ListMemberChangeRequestsRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	PageSize  int32                  `protobuf:"varint,1,opt,name=page_size,proto3" json:"page_size,omitempty"`
+	PageToken string                 `protobuf:"bytes,2,opt,name=page_token,proto3" json:"page_token,omitempty"`
+	// state defaults to pending requests, STATE_UNKNOWN can't be used to list all states.
+	State         MemberChangeRequest_State `protobuf:"varint,3,opt,name=state,proto3,enum=ourspace_backend.proto.MemberChangeRequest_State" json:"state,omitempty"`
+	MemberId      *string                   `protobuf:"bytes,4,opt,name=member_id,proto3,oneof" json:"member_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMemberChangeRequestsRequest) Reset() {
+	*x = ListMemberChangeRequestsRequest{}
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMemberChangeRequestsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMemberChangeRequestsRequest) ProtoMessage() {}
+
+func (x *ListMemberChangeRequestsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMemberChangeRequestsRequest.ProtoReflect.Descriptor instead.
+func (*ListMemberChangeRequestsRequest) Descriptor() ([]byte, []int) {
+	return file_ourspace_backend_proto_api_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *ListMemberChangeRequestsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListMemberChangeRequestsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListMemberChangeRequestsRequest) GetState() MemberChangeRequest_State {
+	if x != nil {
+		return x.State
+	}
+	return MemberChangeRequest_STATE_UNKNOWN
+}
+
+func (x *ListMemberChangeRequestsRequest) GetMemberId() string {
+	if x != nil && x.MemberId != nil {
+		return *x.MemberId
+	}
+	return ""
+}
+
+type ListMemberChangeRequestsResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	ChangeRequests []*MemberChangeRequest `protobuf:"bytes,1,rep,name=change_requests,proto3" json:"change_requests,omitempty"`
+	NextPageToken  string                 `protobuf:"bytes,2,opt,name=next_page_token,proto3" json:"next_page_token,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ListMemberChangeRequestsResponse) Reset() {
+	*x = ListMemberChangeRequestsResponse{}
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMemberChangeRequestsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMemberChangeRequestsResponse) ProtoMessage() {}
+
+func (x *ListMemberChangeRequestsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMemberChangeRequestsResponse.ProtoReflect.Descriptor instead.
+func (*ListMemberChangeRequestsResponse) Descriptor() ([]byte, []int) {
+	return file_ourspace_backend_proto_api_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *ListMemberChangeRequestsResponse) GetChangeRequests() []*MemberChangeRequest {
+	if x != nil {
+		return x.ChangeRequests
+	}
+	return nil
+}
+
+func (x *ListMemberChangeRequestsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type MemberChangeRequestPageToken struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Offset        int32                  `protobuf:"varint,1,opt,name=offset,proto3" json:"offset,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MemberChangeRequestPageToken) Reset() {
+	*x = MemberChangeRequestPageToken{}
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MemberChangeRequestPageToken) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MemberChangeRequestPageToken) ProtoMessage() {}
+
+func (x *MemberChangeRequestPageToken) ProtoReflect() protoreflect.Message {
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MemberChangeRequestPageToken.ProtoReflect.Descriptor instead.
+func (*MemberChangeRequestPageToken) Descriptor() ([]byte, []int) {
+	return file_ourspace_backend_proto_api_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *MemberChangeRequestPageToken) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+type DecideMemberChangeRequestRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Comment       string                 `protobuf:"bytes,2,opt,name=comment,proto3" json:"comment,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DecideMemberChangeRequestRequest) Reset() {
+	*x = DecideMemberChangeRequestRequest{}
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DecideMemberChangeRequestRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DecideMemberChangeRequestRequest) ProtoMessage() {}
+
+func (x *DecideMemberChangeRequestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DecideMemberChangeRequestRequest.ProtoReflect.Descriptor instead.
+func (*DecideMemberChangeRequestRequest) Descriptor() ([]byte, []int) {
+	return file_ourspace_backend_proto_api_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *DecideMemberChangeRequestRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *DecideMemberChangeRequestRequest) GetComment() string {
+	if x != nil {
+		return x.Comment
+	}
+	return ""
+}
+
+type MemberAttribute struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	TechnicalName string                 `protobuf:"bytes,2,opt,name=technical_name,proto3" json:"technical_name,omitempty"`
+	DisplayName   string                 `protobuf:"bytes,3,opt,name=display_name,proto3" json:"display_name,omitempty"`
+	Type          MemberAttribute_Type   `protobuf:"varint,4,opt,name=type,proto3,enum=ourspace_backend.proto.MemberAttribute_Type" json:"type,omitempty"`
+	Description   string                 `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
+	// options are the values of select and multi-select attributes.
+	Options []string `protobuf:"bytes,6,rep,name=options,proto3" json:"options,omitempty"`
+	// required attributes must have a value when a member is created and can't be removed from members afterwards.
+	Required bool `protobuf:"varint,7,opt,name=required,proto3" json:"required,omitempty"`
+	// unique attributes can't have the same value for two members.
+	Unique bool `protobuf:"varint,8,opt,name=unique,proto3" json:"unique,omitempty"`
+	// pattern is a regular expression the whole value of text, email, phone and URL attributes has to match.
+	Pattern string `protobuf:"bytes,9,opt,name=pattern,proto3" json:"pattern,omitempty"`
+	// min and max limit numbers and decimals by value, dates and date-times by time, multi-selects by the number of
+	// selected options and text, email, phone and URL attributes by their length in characters. Both are inclusive.
+	Min *string `protobuf:"bytes,10,opt,name=min,proto3,oneof" json:"min,omitempty"`
+	Max *string `protobuf:"bytes,11,opt,name=max,proto3,oneof" json:"max,omitempty"`
+	// sensitivity controls who can read and write the values of the attribute. Attributes the caller can't read are
+	// removed from members in responses. Only admins can change it.
+	Sensitivity MemberAttribute_Sensitivity `protobuf:"varint,12,opt,name=sensitivity,proto3,enum=ourspace_backend.proto.MemberAttribute_Sensitivity" json:"sensitivity,omitempty"`
+	// read_roles can read the values of restricted attributes, write_roles can read and write them. Admins always can.
+	ReadRoles     []string `protobuf:"bytes,13,rep,name=read_roles,proto3" json:"read_roles,omitempty"`
+	WriteRoles    []string `protobuf:"bytes,14,rep,name=write_roles,proto3" json:"write_roles,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MemberAttribute) Reset() {
+	*x = MemberAttribute{}
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MemberAttribute) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MemberAttribute) ProtoMessage() {}
+
+func (x *MemberAttribute) ProtoReflect() protoreflect.Message {
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MemberAttribute.ProtoReflect.Descriptor instead.
+func (*MemberAttribute) Descriptor() ([]byte, []int) {
+	return file_ourspace_backend_proto_api_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *MemberAttribute) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *MemberAttribute) GetTechnicalName() string {
+	if x != nil {
+		return x.TechnicalName
+	}
+	return ""
+}
+
+func (x *MemberAttribute) GetDisplayName() string {
+	if x != nil {
+		return x.DisplayName
+	}
+	return ""
+}
+
+func (x *MemberAttribute) GetType() MemberAttribute_Type {
+	if x != nil {
+		return x.Type
+	}
+	return MemberAttribute_TYPE_UNKNOWN
+}
+
+func (x *MemberAttribute) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *MemberAttribute) GetOptions() []string {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+func (x *MemberAttribute) GetRequired() bool {
+	if x != nil {
+		return x.Required
+	}
+	return false
+}
+
+func (x *MemberAttribute) GetUnique() bool {
+	if x != nil {
+		return x.Unique
+	}
+	return false
+}
+
+func (x *MemberAttribute) GetPattern() string {
+	if x != nil {
+		return x.Pattern
+	}
+	return ""
+}
+
+func (x *MemberAttribute) GetMin() string {
+	if x != nil && x.Min != nil {
+		return *x.Min
+	}
+	return ""
+}
+
+func (x *MemberAttribute) GetMax() string {
+	if x != nil && x.Max != nil {
+		return *x.Max
+	}
+	return ""
+}
+
+func (x *MemberAttribute) GetSensitivity() MemberAttribute_Sensitivity {
+	if x != nil {
+		return x.Sensitivity
+	}
+	return MemberAttribute_SENSITIVITY_UNKNOWN
+}
+
+func (x *MemberAttribute) GetReadRoles() []string {
+	if x != nil {
+		return x.ReadRoles
+	}
+	return nil
+}
+
+func (x *MemberAttribute) GetWriteRoles() []string {
+	if x != nil {
+		return x.WriteRoles
+	}
+	return nil
+}
+
+type MemberAttributePageToken struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Field         MemberAttributeField   `protobuf:"varint,1,opt,name=field,proto3,enum=ourspace_backend.proto.MemberAttributeField" json:"field,omitempty"`
+	LastValue     string                 `protobuf:"bytes,2,opt,name=last_value,proto3" json:"last_value,omitempty"`
+	Direction     SortDirection          `protobuf:"varint,3,opt,name=direction,proto3,enum=ourspace_backend.proto.SortDirection" json:"direction,omitempty"`
+	LastId        string                 `protobuf:"bytes,4,opt,name=last_id,proto3" json:"last_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MemberAttributePageToken) Reset() {
+	*x = MemberAttributePageToken{}
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MemberAttributePageToken) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MemberAttributePageToken) ProtoMessage() {}
+
+func (x *MemberAttributePageToken) ProtoReflect() protoreflect.Message {
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MemberAttributePageToken.ProtoReflect.Descriptor instead.
+func (*MemberAttributePageToken) Descriptor() ([]byte, []int) {
+	return file_ourspace_backend_proto_api_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *MemberAttributePageToken) GetField() MemberAttributeField {
+	if x != nil {
+		return x.Field
+	}
+	return MemberAttributeField_MEMBER_ATTRIBUTE_FIELD_UNKNOWN
+}
+
+func (x *MemberAttributePageToken) GetLastValue() string {
+	if x != nil {
+		return x.LastValue
+	}
+	return ""
+}
+
+func (x *MemberAttributePageToken) GetDirection() SortDirection {
+	if x != nil {
+		return x.Direction
+	}
+	return SortDirection_SORT_DIRECTION_DEFAULT
+}
+
+func (x *MemberAttributePageToken) GetLastId() string {
+	if x != nil {
+		return x.LastId
+	}
+	return ""
+}
+
+type Card struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	MemberId      string                 `protobuf:"bytes,2,opt,name=member_id,proto3" json:"member_id,omitempty"`
+	RfidValue     []byte                 `protobuf:"bytes,3,opt,name=rfid_value,proto3" json:"rfid_value,omitempty"`
+	ValidFrom     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=valid_from,proto3" json:"valid_from,omitempty"`
+	ValidTo       *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=valid_to,proto3" json:"valid_to,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Card) Reset() {
+	*x = Card{}
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Card) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Card) ProtoMessage() {}
+
+func (x *Card) ProtoReflect() protoreflect.Message {
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Card.ProtoReflect.Descriptor instead.
+func (*Card) Descriptor() ([]byte, []int) {
+	return file_ourspace_backend_proto_api_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *Card) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Card) GetMemberId() string {
+	if x != nil {
+		return x.MemberId
+	}
+	return ""
+}
+
+func (x *Card) GetRfidValue() []byte {
+	if x != nil {
+		return x.RfidValue
+	}
+	return nil
+}
+
+func (x *Card) GetValidFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ValidFrom
+	}
+	return nil
+}
+
+func (x *Card) GetValidTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ValidTo
+	}
+	return nil
+}
+
+type CardPageToken struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Field         CardField              `protobuf:"varint,1,opt,name=field,proto3,enum=ourspace_backend.proto.CardField" json:"field,omitempty"`
+	LastValue     string                 `protobuf:"bytes,2,opt,name=last_value,proto3" json:"last_value,omitempty"`
+	Direction     SortDirection          `protobuf:"varint,3,opt,name=direction,proto3,enum=ourspace_backend.proto.SortDirection" json:"direction,omitempty"`
+	LastId        string                 `protobuf:"bytes,4,opt,name=last_id,proto3" json:"last_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CardPageToken) Reset() {
+	*x = CardPageToken{}
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CardPageToken) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CardPageToken) ProtoMessage() {}
+
+func (x *CardPageToken) ProtoReflect() protoreflect.Message {
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CardPageToken.ProtoReflect.Descriptor instead.
+func (*CardPageToken) Descriptor() ([]byte, []int) {
+	return file_ourspace_backend_proto_api_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *CardPageToken) GetField() CardField {
+	if x != nil {
+		return x.Field
+	}
+	return CardField_CARD_FIELD_UNKNOWN
+}
+
+func (x *CardPageToken) GetLastValue() string {
+	if x != nil {
+		return x.LastValue
+	}
+	return ""
+}
+
+func (x *CardPageToken) GetDirection() SortDirection {
+	if x != nil {
+		return x.Direction
+	}
+	return SortDirection_SORT_DIRECTION_DEFAULT
+}
+
+func (x *CardPageToken) GetLastId() string {
+	if x != nil {
+		return x.LastId
+	}
+	return ""
+}
+
+type CreateCardRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CardId        string                 `protobuf:"bytes,1,opt,name=card_id,proto3" json:"card_id,omitempty"`
+	Card          *Card                  `protobuf:"bytes,2,opt,name=card,proto3" json:"card,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateCardRequest) Reset() {
+	*x = CreateCardRequest{}
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateCardRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCardRequest) ProtoMessage() {}
+
+func (x *CreateCardRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCardRequest.ProtoReflect.Descriptor instead.
+func (*CreateCardRequest) Descriptor() ([]byte, []int) {
+	return file_ourspace_backend_proto_api_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *CreateCardRequest) GetCardId() string {
+	if x != nil {
+		return x.CardId
+	}
+	return ""
+}
+
+func (x *CreateCardRequest) GetCard() *Card {
+	if x != nil {
+		return x.Card
+	}
+	return nil
+}
+
+type GetCardRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCardRequest) Reset() {
+	*x = GetCardRequest{}
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCardRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCardRequest) ProtoMessage() {}
+
+func (x *GetCardRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCardRequest.ProtoReflect.Descriptor instead.
+func (*GetCardRequest) Descriptor() ([]byte, []int) {
+	return file_ourspace_backend_proto_api_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *GetCardRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type ListCardsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PageSize      int32                  `protobuf:"varint,1,opt,name=page_size,proto3" json:"page_size,omitempty"`
+	PageToken     string                 `protobuf:"bytes,2,opt,name=page_token,proto3" json:"page_token,omitempty"`
+	SortBy        CardField              `protobuf:"varint,3,opt,name=sort_by,proto3,enum=ourspace_backend.proto.CardField" json:"sort_by,omitempty"`
+	SortDirection SortDirection          `protobuf:"varint,4,opt,name=sort_direction,proto3,enum=ourspace_backend.proto.SortDirection" json:"sort_direction,omitempty"`
+	MemberId      string                 `protobuf:"bytes,5,opt,name=member_id,proto3" json:"member_id,omitempty"`
+	ValidOn       *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=valid_on,proto3" json:"valid_on,omitempty"`
+	RfidValue     []byte                 `protobuf:"bytes,7,opt,name=rfid_value,proto3" json:"rfid_value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCardsRequest) Reset() {
+	*x = ListCardsRequest{}
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCardsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCardsRequest) ProtoMessage() {}
+
+func (x *ListCardsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCardsRequest.ProtoReflect.Descriptor instead.
+func (*ListCardsRequest) Descriptor() ([]byte, []int) {
+	return file_ourspace_backend_proto_api_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *ListCardsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListCardsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListCardsRequest) GetSortBy() CardField {
+	if x != nil {
+		return x.SortBy
+	}
+	return CardField_CARD_FIELD_UNKNOWN
+}
+
+func (x *ListCardsRequest) GetSortDirection() SortDirection {
+	if x != nil {
+		return x.SortDirection
+	}
+	return SortDirection_SORT_DIRECTION_DEFAULT
+}
+
+func (x *ListCardsRequest) GetMemberId() string {
+	if x != nil {
+		return x.MemberId
+	}
+	return ""
+}
+
+func (x *ListCardsRequest) GetValidOn() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ValidOn
+	}
+	return nil
+}
+
+func (x *ListCardsRequest) GetRfidValue() []byte {
+	if x != nil {
+		return x.RfidValue
+	}
+	return nil
+}
+
+type ListCardsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Cards         []*Card                `protobuf:"bytes,1,rep,name=cards,proto3" json:"cards,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCardsResponse) Reset() {
+	*x = ListCardsResponse{}
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCardsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCardsResponse) ProtoMessage() {}
+
+func (x *ListCardsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCardsResponse.ProtoReflect.Descriptor instead.
+func (*ListCardsResponse) Descriptor() ([]byte, []int) {
+	return file_ourspace_backend_proto_api_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *ListCardsResponse) GetCards() []*Card {
+	if x != nil {
+		return x.Cards
+	}
+	return nil
+}
+
+func (x *ListCardsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type ExportCardsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// filter selects the cards like in ListCards, page_size and page_token are ignored.
+	Filter        *ListCardsRequest `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
+	Format        ExportFormat      `protobuf:"varint,2,opt,name=format,proto3,enum=ourspace_backend.proto.ExportFormat" json:"format,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportCardsRequest) Reset() {
+	*x = ExportCardsRequest{}
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportCardsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportCardsRequest) ProtoMessage() {}
+
+func (x *ExportCardsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportCardsRequest.ProtoReflect.Descriptor instead.
+func (*ExportCardsRequest) Descriptor() ([]byte, []int) {
+	return file_ourspace_backend_proto_api_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *ExportCardsRequest) GetFilter() *ListCardsRequest {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+func (x *ExportCardsRequest) GetFormat() ExportFormat {
+	if x != nil {
+		return x.Format
+	}
+	return ExportFormat_EXPORT_FORMAT_UNKNOWN
+}
+
+type UpdateCardRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Card          *Card                  `protobuf:"bytes,1,opt,name=card,proto3" json:"card,omitempty"`
+	FieldMask     *fieldmaskpb.FieldMask `protobuf:"bytes,2,opt,name=field_mask,proto3" json:"field_mask,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateCardRequest) Reset() {
+	*x = UpdateCardRequest{}
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateCardRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateCardRequest) ProtoMessage() {}
+
+func (x *UpdateCardRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateCardRequest.ProtoReflect.Descriptor instead.
+func (*UpdateCardRequest) Descriptor() ([]byte, []int) {
+	return file_ourspace_backend_proto_api_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *UpdateCardRequest) GetCard() *Card {
+	if x != nil {
+		return x.Card
+	}
+	return nil
+}
+
+func (x *UpdateCardRequest) GetFieldMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.FieldMask
+	}
+	return nil
+}
+
+type DeleteCardRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteCardRequest) Reset() {
+	*x = DeleteCardRequest{}
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteCardRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCardRequest) ProtoMessage() {}
+
+func (x *DeleteCardRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCardRequest.ProtoReflect.Descriptor instead.
+func (*DeleteCardRequest) Descriptor() ([]byte, []int) {
+	return file_ourspace_backend_proto_api_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *DeleteCardRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type BriefingType struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Id          string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	DisplayName string                 `protobuf:"bytes,2,opt,name=display_name,proto3" json:"display_name,omitempty"`
+	Description string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	// expires_after is how long a briefing of this type stays valid, zero means it never expires.
+	ExpiresAfter  *durationpb.Duration `protobuf:"bytes,4,opt,name=expires_after,proto3" json:"expires_after,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BriefingType) Reset() {
+	*x = BriefingType{}
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BriefingType) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BriefingType) ProtoMessage() {}
+
+func (x *BriefingType) ProtoReflect() protoreflect.Message {
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BriefingType.ProtoReflect.Descriptor instead.
+func (*BriefingType) Descriptor() ([]byte, []int) {
+	return file_ourspace_backend_proto_api_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *BriefingType) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *BriefingType) GetDisplayName() string {
+	if x != nil {
+		return x.DisplayName
+	}
+	return ""
+}
+
+func (x *BriefingType) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *BriefingType) GetExpiresAfter() *durationpb.Duration {
+	if x != nil {
+		return x.ExpiresAfter
+	}
+	return nil
+}
+
+type BriefingPageToken struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	LastId        string                 `protobuf:"bytes,1,opt,name=last_id,proto3" json:"last_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BriefingPageToken) Reset() {
+	*x = BriefingPageToken{}
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BriefingPageToken) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BriefingPageToken) ProtoMessage() {}
+
+func (x *BriefingPageToken) ProtoReflect() protoreflect.Message {
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BriefingPageToken.ProtoReflect.Descriptor instead.
+func (*BriefingPageToken) Descriptor() ([]byte, []int) {
+	return file_ourspace_backend_proto_api_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *BriefingPageToken) GetLastId() string {
+	if x != nil {
+		return x.LastId
+	}
+	return ""
+}
+
+type CreateBriefingTypeRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	BriefingTypeId string                 `protobuf:"bytes,1,opt,name=briefing_type_id,proto3" json:"briefing_type_id,omitempty"`
+	BriefingType   *BriefingType          `protobuf:"bytes,2,opt,name=briefing_type,proto3" json:"briefing_type,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *CreateBriefingTypeRequest) Reset() {
+	*x = CreateBriefingTypeRequest{}
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateBriefingTypeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateBriefingTypeRequest) ProtoMessage() {}
+
+func (x *CreateBriefingTypeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateBriefingTypeRequest.ProtoReflect.Descriptor instead.
+func (*CreateBriefingTypeRequest) Descriptor() ([]byte, []int) {
+	return file_ourspace_backend_proto_api_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *CreateBriefingTypeRequest) GetBriefingTypeId() string {
+	if x != nil {
+		return x.BriefingTypeId
+	}
+	return ""
+}
+
+func (x *CreateBriefingTypeRequest) GetBriefingType() *BriefingType {
+	if x != nil {
+		return x.BriefingType
+	}
+	return nil
+}
+
+type GetBriefingTypeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetBriefingTypeRequest) Reset() {
+	*x = GetBriefingTypeRequest{}
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBriefingTypeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBriefingTypeRequest) ProtoMessage() {}
+
+func (x *GetBriefingTypeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBriefingTypeRequest.ProtoReflect.Descriptor instead.
+func (*GetBriefingTypeRequest) Descriptor() ([]byte, []int) {
+	return file_ourspace_backend_proto_api_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *GetBriefingTypeRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type ListBriefingTypesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PageSize      int32                  `protobuf:"varint,1,opt,name=page_size,proto3" json:"page_size,omitempty"`
+	PageToken     string                 `protobuf:"bytes,2,opt,name=page_token,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListBriefingTypesRequest) Reset() {
+	*x = ListBriefingTypesRequest{}
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListBriefingTypesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBriefingTypesRequest) ProtoMessage() {}
+
+func (x *ListBriefingTypesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBriefingTypesRequest.ProtoReflect.Descriptor instead.
+func (*ListBriefingTypesRequest) Descriptor() ([]byte, []int) {
+	return file_ourspace_backend_proto_api_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *ListBriefingTypesRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListBriefingTypesRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type ListBriefingTypesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BriefingTypes []*BriefingType        `protobuf:"bytes,1,rep,name=briefing_types,proto3" json:"briefing_types,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListBriefingTypesResponse) Reset() {
+	*x = ListBriefingTypesResponse{}
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListBriefingTypesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBriefingTypesResponse) ProtoMessage() {}
+
+func (x *ListBriefingTypesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBriefingTypesResponse.ProtoReflect.Descriptor instead.
+func (*ListBriefingTypesResponse) Descriptor() ([]byte, []int) {
+	return file_ourspace_backend_proto_api_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *ListBriefingTypesResponse) GetBriefingTypes() []*BriefingType {
+	if x != nil {
+		return x.BriefingTypes
+	}
+	return nil
+}
+
+func (x *ListBriefingTypesResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type UpdateBriefingTypeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BriefingType  *BriefingType          `protobuf:"bytes,1,opt,name=briefing_type,proto3" json:"briefing_type,omitempty"`
+	FieldMask     *fieldmaskpb.FieldMask `protobuf:"bytes,2,opt,name=field_mask,proto3" json:"field_mask,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateBriefingTypeRequest) Reset() {
+	*x = UpdateBriefingTypeRequest{}
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateBriefingTypeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateBriefingTypeRequest) ProtoMessage() {}
+
+func (x *UpdateBriefingTypeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[68]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateBriefingTypeRequest.ProtoReflect.Descriptor instead.
+func (*UpdateBriefingTypeRequest) Descriptor() ([]byte, []int) {
+	return file_ourspace_backend_proto_api_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *UpdateBriefingTypeRequest) GetBriefingType() *BriefingType {
+	if x != nil {
+		return x.BriefingType
+	}
+	return nil
+}
+
+func (x *UpdateBriefingTypeRequest) GetFieldMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.FieldMask
+	}
+	return nil
+}
+
+type DeleteBriefingTypeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteBriefingTypeRequest) Reset() {
+	*x = DeleteBriefingTypeRequest{}
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteBriefingTypeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteBriefingTypeRequest) ProtoMessage() {}
+
+func (x *DeleteBriefingTypeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[69]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteBriefingTypeRequest.ProtoReflect.Descriptor instead.
+func (*DeleteBriefingTypeRequest) Descriptor() ([]byte, []int) {
+	return file_ourspace_backend_proto_api_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *DeleteBriefingTypeRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type Briefing struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	Id           string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	BriefingType string                 `protobuf:"bytes,2,opt,name=briefing_type,proto3" json:"briefing_type,omitempty"`
+	// member_id is the member that received the briefing.
+	MemberId     string                 `protobuf:"bytes,3,opt,name=member_id,proto3" json:"member_id,omitempty"`
+	BriefingTime *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=briefing_time,proto3" json:"briefing_time,omitempty"`
+	// expiry_time is derived from the briefing type and not set if the briefing never expires.
+	ExpiryTime    *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=expiry_time,proto3" json:"expiry_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Briefing) Reset() {
+	*x = Briefing{}
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[70]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Briefing) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Briefing) ProtoMessage() {}
+
+func (x *Briefing) ProtoReflect() protoreflect.Message {
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[70]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Briefing.ProtoReflect.Descriptor instead.
+func (*Briefing) Descriptor() ([]byte, []int) {
+	return file_ourspace_backend_proto_api_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *Briefing) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Briefing) GetBriefingType() string {
+	if x != nil {
+		return x.BriefingType
+	}
+	return ""
+}
+
+func (x *Briefing) GetMemberId() string {
+	if x != nil {
+		return x.MemberId
+	}
+	return ""
+}
+
+func (x *Briefing) GetBriefingTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.BriefingTime
+	}
+	return nil
+}
+
+func (x *Briefing) GetExpiryTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiryTime
+	}
+	return nil
+}
+
+type CreateBriefingRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BriefingId    string                 `protobuf:"bytes,1,opt,name=briefing_id,proto3" json:"briefing_id,omitempty"`
+	Briefing      *Briefing              `protobuf:"bytes,2,opt,name=briefing,proto3" json:"briefing,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateBriefingRequest) Reset() {
+	*x = CreateBriefingRequest{}
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[71]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateBriefingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateBriefingRequest) ProtoMessage() {}
+
+func (x *CreateBriefingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[71]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateBriefingRequest.ProtoReflect.Descriptor instead.
+func (*CreateBriefingRequest) Descriptor() ([]byte, []int) {
+	return file_ourspace_backend_proto_api_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *CreateBriefingRequest) GetBriefingId() string {
+	if x != nil {
+		return x.BriefingId
+	}
+	return ""
+}
+
+func (x *CreateBriefingRequest) GetBriefing() *Briefing {
+	if x != nil {
+		return x.Briefing
+	}
+	return nil
+}
+
+type GetBriefingRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetBriefingRequest) Reset() {
+	*x = GetBriefingRequest{}
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[72]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBriefingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBriefingRequest) ProtoMessage() {}
+
+func (x *GetBriefingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[72]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBriefingRequest.ProtoReflect.Descriptor instead.
+func (*GetBriefingRequest) Descriptor() ([]byte, []int) {
+	return file_ourspace_backend_proto_api_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *GetBriefingRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type ListBriefingsRequest struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	PageSize     int32                  `protobuf:"varint,1,opt,name=page_size,proto3" json:"page_size,omitempty"`
+	PageToken    string                 `protobuf:"bytes,2,opt,name=page_token,proto3" json:"page_token,omitempty"`
+	MemberId     *string                `protobuf:"bytes,3,opt,name=member_id,proto3,oneof" json:"member_id,omitempty"`
+	BriefingType *string                `protobuf:"bytes,4,opt,name=briefing_type,proto3,oneof" json:"briefing_type,omitempty"`
+	// valid_only limits the result to briefings that have not expired.
+	ValidOnly     bool `protobuf:"varint,5,opt,name=valid_only,proto3" json:"valid_only,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListBriefingsRequest) Reset() {
+	*x = ListBriefingsRequest{}
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[73]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListBriefingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBriefingsRequest) ProtoMessage() {}
+
+func (x *ListBriefingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[73]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBriefingsRequest.ProtoReflect.Descriptor instead.
+func (*ListBriefingsRequest) Descriptor() ([]byte, []int) {
+	return file_ourspace_backend_proto_api_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *ListBriefingsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListBriefingsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListBriefingsRequest) GetMemberId() string {
+	if x != nil && x.MemberId != nil {
+		return *x.MemberId
+	}
+	return ""
+}
+
+func (x *ListBriefingsRequest) GetBriefingType() string {
+	if x != nil && x.BriefingType != nil {
+		return *x.BriefingType
+	}
+	return ""
+}
+
+func (x *ListBriefingsRequest) GetValidOnly() bool {
+	if x != nil {
+		return x.ValidOnly
+	}
+	return false
+}
+
+type ListBriefingsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Briefings     []*Briefing            `protobuf:"bytes,1,rep,name=briefings,proto3" json:"briefings,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListBriefingsResponse) Reset() {
+	*x = ListBriefingsResponse{}
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[74]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListBriefingsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBriefingsResponse) ProtoMessage() {}
+
+func (x *ListBriefingsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[74]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBriefingsResponse.ProtoReflect.Descriptor instead.
+func (*ListBriefingsResponse) Descriptor() ([]byte, []int) {
+	return file_ourspace_backend_proto_api_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *ListBriefingsResponse) GetBriefings() []*Briefing {
+	if x != nil {
+		return x.Briefings
+	}
+	return nil
+}
+
+func (x *ListBriefingsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type UpdateBriefingRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Briefing      *Briefing              `protobuf:"bytes,1,opt,name=briefing,proto3" json:"briefing,omitempty"`
+	FieldMask     *fieldmaskpb.FieldMask `protobuf:"bytes,2,opt,name=field_mask,proto3" json:"field_mask,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateBriefingRequest) Reset() {
+	*x = UpdateBriefingRequest{}
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[75]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateBriefingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateBriefingRequest) ProtoMessage() {}
+
+func (x *UpdateBriefingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[75]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateBriefingRequest.ProtoReflect.Descriptor instead.
+func (*UpdateBriefingRequest) Descriptor() ([]byte, []int) {
+	return file_ourspace_backend_proto_api_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *UpdateBriefingRequest) GetBriefing() *Briefing {
+	if x != nil {
+		return x.Briefing
+	}
+	return nil
+}
+
+func (x *UpdateBriefingRequest) GetFieldMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.FieldMask
+	}
+	return nil
+}
+
+type DeleteBriefingRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteBriefingRequest) Reset() {
+	*x = DeleteBriefingRequest{}
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[76]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteBriefingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteBriefingRequest) ProtoMessage() {}
+
+func (x *DeleteBriefingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[76]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteBriefingRequest.ProtoReflect.Descriptor instead.
+func (*DeleteBriefingRequest) Descriptor() ([]byte, []int) {
+	return file_ourspace_backend_proto_api_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *DeleteBriefingRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type Presence struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	Id           string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	MemberId     string                 `protobuf:"bytes,2,opt,name=member_id,proto3" json:"member_id,omitempty"`
+	CheckinTime  *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=checkin_time,proto3" json:"checkin_time,omitempty"`
+	CheckoutTime *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=checkout_time,proto3" json:"checkout_time,omitempty"`
+	// auto_closed is set if the presence was not checked out by the member, but closed automatically by the backend,
+	// e.g. at closing time. The checkout time is then an estimate and not the time the member actually left.
+	AutoClosed bool `protobuf:"varint,5,opt,name=auto_closed,proto3" json:"auto_closed,omitempty"`
+	// terminal_id is the terminal the member checked in at, if the checkin was done with a card.
+	TerminalId    *string `protobuf:"bytes,6,opt,name=terminal_id,proto3,oneof" json:"terminal_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Presence) Reset() {
+	*x = Presence{}
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[77]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Presence) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Presence) ProtoMessage() {}
+
+func (x *Presence) ProtoReflect() protoreflect.Message {
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[77]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Presence.ProtoReflect.Descriptor instead.
+func (*Presence) Descriptor() ([]byte, []int) {
+	return file_ourspace_backend_proto_api_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *Presence) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Presence) GetMemberId() string {
+	if x != nil {
+		return x.MemberId
+	}
+	return ""
+}
+
+func (x *Presence) GetCheckinTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CheckinTime
+	}
+	return nil
+}
+
+func (x *Presence) GetCheckoutTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CheckoutTime
+	}
+	return nil
+}
+
+func (x *Presence) GetAutoClosed() bool {
+	if x != nil {
+		return x.AutoClosed
+	}
+	return false
+}
+
+func (x *Presence) GetTerminalId() string {
+	if x != nil && x.TerminalId != nil {
+		return *x.TerminalId
+	}
+	return ""
+}
+
+type ListPresencesRequest struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	PageSize           int32                  `protobuf:"varint,1,opt,name=page_size,proto3" json:"page_size,omitempty"`
+	PageToken          string                 `protobuf:"bytes,2,opt,name=page_token,proto3" json:"page_token,omitempty"`
+	SortBy             PresenceField          `protobuf:"varint,8,opt,name=sort_by,proto3,enum=ourspace_backend.proto.PresenceField" json:"sort_by,omitempty"`
+	SortDirection      SortDirection          `protobuf:"varint,9,opt,name=sort_direction,proto3,enum=ourspace_backend.proto.SortDirection" json:"sort_direction,omitempty"`
+	MemberId           *string                `protobuf:"bytes,3,opt,name=member_id,proto3,oneof" json:"member_id,omitempty"`
+	CheckinTimeAfter   *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=checkin_time_after,proto3,oneof" json:"checkin_time_after,omitempty"`
+	CheckinTimeBefore  *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=checkin_time_before,proto3,oneof" json:"checkin_time_before,omitempty"`
+	CheckoutTimeAfter  *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=checkout_time_after,proto3,oneof" json:"checkout_time_after,omitempty"`
+	CheckoutTimeBefore *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=checkout_time_before,proto3,oneof" json:"checkout_time_before,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *ListPresencesRequest) Reset() {
+	*x = ListPresencesRequest{}
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[78]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPresencesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPresencesRequest) ProtoMessage() {}
+
+func (x *ListPresencesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[78]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPresencesRequest.ProtoReflect.Descriptor instead.
+func (*ListPresencesRequest) Descriptor() ([]byte, []int) {
+	return file_ourspace_backend_proto_api_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *ListPresencesRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListPresencesRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListPresencesRequest) GetSortBy() PresenceField {
+	if x != nil {
+		return x.SortBy
+	}
+	return PresenceField_PRESENCE_FIELD_UNKNOWN
+}
+
+func (x *ListPresencesRequest) GetSortDirection() SortDirection {
+	if x != nil {
+		return x.SortDirection
+	}
+	return SortDirection_SORT_DIRECTION_DEFAULT
+}
+
+func (x *ListPresencesRequest) GetMemberId() string {
+	if x != nil && x.MemberId != nil {
+		return *x.MemberId
+	}
+	return ""
+}
+
+func (x *ListPresencesRequest) GetCheckinTimeAfter() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CheckinTimeAfter
+	}
+	return nil
+}
+
+func (x *ListPresencesRequest) GetCheckinTimeBefore() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CheckinTimeBefore
+	}
+	return nil
+}
+
+func (x *ListPresencesRequest) GetCheckoutTimeAfter() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CheckoutTimeAfter
+	}
+	return nil
+}
+
+func (x *ListPresencesRequest) GetCheckoutTimeBefore() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CheckoutTimeBefore
+	}
+	return nil
+}
+
+type ListPresencesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Presence      []*Presence            `protobuf:"bytes,1,rep,name=presence,proto3" json:"presence,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPresencesResponse) Reset() {
+	*x = ListPresencesResponse{}
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[79]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPresencesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPresencesResponse) ProtoMessage() {}
+
+func (x *ListPresencesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[79]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPresencesResponse.ProtoReflect.Descriptor instead.
+func (*ListPresencesResponse) Descriptor() ([]byte, []int) {
+	return file_ourspace_backend_proto_api_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *ListPresencesResponse) GetPresence() []*Presence {
+	if x != nil {
+		return x.Presence
+	}
+	return nil
+}
+
+func (x *ListPresencesResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type ExportPresencesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// filter selects the presences like in ListPresences, page_size and page_token are ignored.
+	Filter        *ListPresencesRequest `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
+	Format        ExportFormat          `protobuf:"varint,2,opt,name=format,proto3,enum=ourspace_backend.proto.ExportFormat" json:"format,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportPresencesRequest) Reset() {
+	*x = ExportPresencesRequest{}
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[80]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportPresencesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportPresencesRequest) ProtoMessage() {}
+
+func (x *ExportPresencesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[80]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportPresencesRequest.ProtoReflect.Descriptor instead.
+func (*ExportPresencesRequest) Descriptor() ([]byte, []int) {
+	return file_ourspace_backend_proto_api_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *ExportPresencesRequest) GetFilter() *ListPresencesRequest {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+func (x *ExportPresencesRequest) GetFormat() ExportFormat {
+	if x != nil {
+		return x.Format
+	}
+	return ExportFormat_EXPORT_FORMAT_UNKNOWN
+}
+
+type PresencePageToken struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Field         PresenceField          `protobuf:"varint,1,opt,name=field,proto3,enum=ourspace_backend.proto.PresenceField" json:"field,omitempty"`
+	LastValue     string                 `protobuf:"bytes,2,opt,name=last_value,proto3" json:"last_value,omitempty"`
+	Direction     SortDirection          `protobuf:"varint,3,opt,name=direction,proto3,enum=ourspace_backend.proto.SortDirection" json:"direction,omitempty"`
+	LastId        string                 `protobuf:"bytes,4,opt,name=last_id,proto3" json:"last_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PresencePageToken) Reset() {
+	*x = PresencePageToken{}
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[81]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PresencePageToken) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PresencePageToken) ProtoMessage() {}
+
+func (x *PresencePageToken) ProtoReflect() protoreflect.Message {
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[81]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PresencePageToken.ProtoReflect.Descriptor instead.
+func (*PresencePageToken) Descriptor() ([]byte, []int) {
+	return file_ourspace_backend_proto_api_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *PresencePageToken) GetField() PresenceField {
+	if x != nil {
+		return x.Field
+	}
+	return PresenceField_PRESENCE_FIELD_UNKNOWN
+}
+
+func (x *PresencePageToken) GetLastValue() string {
+	if x != nil {
+		return x.LastValue
+	}
+	return ""
+}
+
+func (x *PresencePageToken) GetDirection() SortDirection {
+	if x != nil {
+		return x.Direction
+	}
+	return SortDirection_SORT_DIRECTION_DEFAULT
+}
+
+func (x *PresencePageToken) GetLastId() string {
+	if x != nil {
+		return x.LastId
+	}
+	return ""
+}
+
+type CheckinRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MemberId      string                 `protobuf:"bytes,1,opt,name=member_id,proto3" json:"member_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CheckinRequest) Reset() {
+	*x = CheckinRequest{}
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[82]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckinRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckinRequest) ProtoMessage() {}
+
+func (x *CheckinRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[82]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckinRequest.ProtoReflect.Descriptor instead.
+func (*CheckinRequest) Descriptor() ([]byte, []int) {
+	return file_ourspace_backend_proto_api_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *CheckinRequest) GetMemberId() string {
+	if x != nil {
+		return x.MemberId
+	}
+	return ""
+}
+
+type CheckoutRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MemberId      string                 `protobuf:"bytes,1,opt,name=member_id,proto3" json:"member_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CheckoutRequest) Reset() {
+	*x = CheckoutRequest{}
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[83]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckoutRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckoutRequest) ProtoMessage() {}
+
+func (x *CheckoutRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[83]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckoutRequest.ProtoReflect.Descriptor instead.
+func (*CheckoutRequest) Descriptor() ([]byte, []int) {
+	return file_ourspace_backend_proto_api_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *CheckoutRequest) GetMemberId() string {
+	if x != nil {
+		return x.MemberId
+	}
+	return ""
+}
+
+type TogglePresenceRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MemberId      string                 `protobuf:"bytes,1,opt,name=member_id,proto3" json:"member_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TogglePresenceRequest) Reset() {
+	*x = TogglePresenceRequest{}
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[84]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TogglePresenceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TogglePresenceRequest) ProtoMessage() {}
+
+func (x *TogglePresenceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[84]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TogglePresenceRequest.ProtoReflect.Descriptor instead.
+func (*TogglePresenceRequest) Descriptor() ([]byte, []int) {
+	return file_ourspace_backend_proto_api_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *TogglePresenceRequest) GetMemberId() string {
+	if x != nil {
+		return x.MemberId
+	}
+	return ""
+}
+
+type TogglePresenceResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Presence      *Presence              `protobuf:"bytes,1,opt,name=presence,proto3" json:"presence,omitempty"`
+	Action        PresenceAction         `protobuf:"varint,2,opt,name=action,proto3,enum=ourspace_backend.proto.PresenceAction" json:"action,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TogglePresenceResponse) Reset() {
+	*x = TogglePresenceResponse{}
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[85]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TogglePresenceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TogglePresenceResponse) ProtoMessage() {}
+
+func (x *TogglePresenceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[85]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TogglePresenceResponse.ProtoReflect.Descriptor instead.
+func (*TogglePresenceResponse) Descriptor() ([]byte, []int) {
+	return file_ourspace_backend_proto_api_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *TogglePresenceResponse) GetPresence() *Presence {
+	if x != nil {
+		return x.Presence
+	}
+	return nil
+}
+
+func (x *TogglePresenceResponse) GetAction() PresenceAction {
+	if x != nil {
+		return x.Action
+	}
+	return PresenceAction_PRESENCE_ACTION_UNKNOWN
+}
+
+type CheckinByCardRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	RfidValue []byte                 `protobuf:"bytes,1,opt,name=rfid_value,proto3" json:"rfid_value,omitempty"`
+	// terminal_id identifies the terminal the card was presented at.
+	TerminalId    string `protobuf:"bytes,2,opt,name=terminal_id,proto3" json:"terminal_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CheckinByCardRequest) Reset() {
+	*x = CheckinByCardRequest{}
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[86]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckinByCardRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckinByCardRequest) ProtoMessage() {}
+
+func (x *CheckinByCardRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[86]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckinByCardRequest.ProtoReflect.Descriptor instead.
+func (*CheckinByCardRequest) Descriptor() ([]byte, []int) {
+	return file_ourspace_backend_proto_api_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *CheckinByCardRequest) GetRfidValue() []byte {
+	if x != nil {
+		return x.RfidValue
+	}
+	return nil
+}
+
+func (x *CheckinByCardRequest) GetTerminalId() string {
+	if x != nil {
+		return x.TerminalId
+	}
+	return ""
+}
+
+type UpdatePresenceRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Presence      *Presence              `protobuf:"bytes,1,opt,name=presence,proto3" json:"presence,omitempty"`
+	FieldMask     *fieldmaskpb.FieldMask `protobuf:"bytes,2,opt,name=field_mask,proto3" json:"field_mask,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdatePresenceRequest) Reset() {
+	*x = UpdatePresenceRequest{}
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[87]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdatePresenceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdatePresenceRequest) ProtoMessage() {}
+
+func (x *UpdatePresenceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[87]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdatePresenceRequest.ProtoReflect.Descriptor instead.
+func (*UpdatePresenceRequest) Descriptor() ([]byte, []int) {
+	return file_ourspace_backend_proto_api_proto_rawDescGZIP(), []int{87}
+}
+
+func (x *UpdatePresenceRequest) GetPresence() *Presence {
+	if x != nil {
+		return x.Presence
+	}
+	return nil
+}
+
+func (x *UpdatePresenceRequest) GetFieldMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.FieldMask
+	}
+	return nil
+}
+
+type DeletePresenceRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeletePresenceRequest) Reset() {
+	*x = DeletePresenceRequest{}
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[88]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeletePresenceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeletePresenceRequest) ProtoMessage() {}
+
+func (x *DeletePresenceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[88]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeletePresenceRequest.ProtoReflect.Descriptor instead.
+func (*DeletePresenceRequest) Descriptor() ([]byte, []int) {
+	return file_ourspace_backend_proto_api_proto_rawDescGZIP(), []int{88}
+}
+
+func (x *DeletePresenceRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type Event struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Id          string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title       string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Description string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	StartTime   *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=start_time,proto3" json:"start_time,omitempty"`
+	EndTime     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=end_time,proto3" json:"end_time,omitempty"`
+	Location    string                 `protobuf:"bytes,6,opt,name=location,proto3" json:"location,omitempty"`
+	// capacity is the maximum number of registered members, 0 means unlimited.
+	Capacity int32 `protobuf:"varint,7,opt,name=capacity,proto3" json:"capacity,omitempty"`
+	// required_briefing_types are the IDs of the briefing types participants need.
+	RequiredBriefingTypes []string `protobuf:"bytes,8,rep,name=required_briefing_types,proto3" json:"required_briefing_types,omitempty"`
+	// allowed_age_categories restricts who can register, an empty list allows all members.
+	AllowedAgeCategories []AgeCategory `protobuf:"varint,9,rep,packed,name=allowed_age_categories,proto3,enum=ourspace_backend.proto.AgeCategory" json:"allowed_age_categories,omitempty"`
+	RegisteredCount      int32         `protobuf:"varint,10,opt,name=registered_count,proto3" json:"registered_count,omitempty"`
+	WaitlistCount        int32         `protobuf:"varint,11,opt,name=waitlist_count,proto3" json:"waitlist_count,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *Event) Reset() {
+	*x = Event{}
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[89]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Event) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Event) ProtoMessage() {}
+
+func (x *Event) ProtoReflect() protoreflect.Message {
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[89]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Event.ProtoReflect.Descriptor instead.
+func (*Event) Descriptor() ([]byte, []int) {
+	return file_ourspace_backend_proto_api_proto_rawDescGZIP(), []int{89}
+}
+
+func (x *Event) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Event) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *Event) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Event) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *Event) GetEndTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndTime
+	}
+	return nil
+}
+
+func (x *Event) GetLocation() string {
+	if x != nil {
+		return x.Location
+	}
+	return ""
+}
+
+func (x *Event) GetCapacity() int32 {
+	if x != nil {
+		return x.Capacity
+	}
+	return 0
+}
+
+func (x *Event) GetRequiredBriefingTypes() []string {
+	if x != nil {
+		return x.RequiredBriefingTypes
+	}
+	return nil
+}
+
+func (x *Event) GetAllowedAgeCategories() []AgeCategory {
+	if x != nil {
+		return x.AllowedAgeCategories
+	}
+	return nil
+}
+
+func (x *Event) GetRegisteredCount() int32 {
+	if x != nil {
+		return x.RegisteredCount
+	}
+	return 0
+}
+
+func (x *Event) GetWaitlistCount() int32 {
+	if x != nil {
+		return x.WaitlistCount
+	}
+	return 0
+}
+
+type EventPageToken struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Field         EventField             `protobuf:"varint,1,opt,name=field,proto3,enum=ourspace_backend.proto.EventField" json:"field,omitempty"`
+	LastValue     string                 `protobuf:"bytes,2,opt,name=last_value,proto3" json:"last_value,omitempty"`
+	Direction     SortDirection          `protobuf:"varint,3,opt,name=direction,proto3,enum=ourspace_backend.proto.SortDirection" json:"direction,omitempty"`
+	LastId        string                 `protobuf:"bytes,4,opt,name=last_id,proto3" json:"last_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EventPageToken) Reset() {
+	*x = EventPageToken{}
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[90]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EventPageToken) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventPageToken) ProtoMessage() {}
+
+func (x *EventPageToken) ProtoReflect() protoreflect.Message {
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[90]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EventPageToken.ProtoReflect.Descriptor instead.
+func (*EventPageToken) Descriptor() ([]byte, []int) {
+	return file_ourspace_backend_proto_api_proto_rawDescGZIP(), []int{90}
+}
+
+func (x *EventPageToken) GetField() EventField {
+	if x != nil {
+		return x.Field
+	}
+	return EventField_EVENT_FIELD_UNKNOWN
+}
+
+func (x *EventPageToken) GetLastValue() string {
+	if x != nil {
+		return x.LastValue
+	}
+	return ""
+}
+
+func (x *EventPageToken) GetDirection() SortDirection {
+	if x != nil {
+		return x.Direction
+	}
+	return SortDirection_SORT_DIRECTION_DEFAULT
+}
+
+func (x *EventPageToken) GetLastId() string {
+	if x != nil {
+		return x.LastId
+	}
+	return ""
+}
+
+type CreateEventRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EventId       string                 `protobuf:"bytes,1,opt,name=event_id,proto3" json:"event_id,omitempty"`
+	Event         *Event                 `protobuf:"bytes,2,opt,name=event,proto3" json:"event,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateEventRequest) Reset() {
+	*x = CreateEventRequest{}
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[91]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateEventRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateEventRequest) ProtoMessage() {}
+
+func (x *CreateEventRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[91]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateEventRequest.ProtoReflect.Descriptor instead.
+func (*CreateEventRequest) Descriptor() ([]byte, []int) {
+	return file_ourspace_backend_proto_api_proto_rawDescGZIP(), []int{91}
+}
+
+func (x *CreateEventRequest) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *CreateEventRequest) GetEvent() *Event {
+	if x != nil {
+		return x.Event
+	}
+	return nil
+}
+
+type GetEventRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetEventRequest) Reset() {
+	*x = GetEventRequest{}
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[92]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetEventRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEventRequest) ProtoMessage() {}
+
+func (x *GetEventRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[92]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEventRequest.ProtoReflect.Descriptor instead.
+func (*GetEventRequest) Descriptor() ([]byte, []int) {
+	return file_ourspace_backend_proto_api_proto_rawDescGZIP(), []int{92}
+}
+
+func (x *GetEventRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type ListEventsRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	PageSize        int32                  `protobuf:"varint,1,opt,name=page_size,proto3" json:"page_size,omitempty"`
+	PageToken       string                 `protobuf:"bytes,2,opt,name=page_token,proto3" json:"page_token,omitempty"`
+	SortBy          EventField             `protobuf:"varint,3,opt,name=sort_by,proto3,enum=ourspace_backend.proto.EventField" json:"sort_by,omitempty"`
+	SortDirection   SortDirection          `protobuf:"varint,4,opt,name=sort_direction,proto3,enum=ourspace_backend.proto.SortDirection" json:"sort_direction,omitempty"`
+	StartTimeAfter  *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=start_time_after,proto3,oneof" json:"start_time_after,omitempty"`
+	StartTimeBefore *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=start_time_before,proto3,oneof" json:"start_time_before,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ListEventsRequest) Reset() {
+	*x = ListEventsRequest{}
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[93]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListEventsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListEventsRequest) ProtoMessage() {}
+
+func (x *ListEventsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[93]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListEventsRequest.ProtoReflect.Descriptor instead.
+func (*ListEventsRequest) Descriptor() ([]byte, []int) {
+	return file_ourspace_backend_proto_api_proto_rawDescGZIP(), []int{93}
+}
+
+func (x *ListEventsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListEventsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListEventsRequest) GetSortBy() EventField {
+	if x != nil {
+		return x.SortBy
+	}
+	return EventField_EVENT_FIELD_UNKNOWN
+}
+
+func (x *ListEventsRequest) GetSortDirection() SortDirection {
+	if x != nil {
+		return x.SortDirection
+	}
+	return SortDirection_SORT_DIRECTION_DEFAULT
+}
+
+func (x *ListEventsRequest) GetStartTimeAfter() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTimeAfter
+	}
+	return nil
+}
+
+func (x *ListEventsRequest) GetStartTimeBefore() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTimeBefore
+	}
+	return nil
+}
+
+type ListEventsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Events        []*Event               `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListEventsResponse) Reset() {
+	*x = ListEventsResponse{}
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[94]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListEventsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListEventsResponse) ProtoMessage() {}
+
+func (x *ListEventsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[94]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListEventsResponse.ProtoReflect.Descriptor instead.
+func (*ListEventsResponse) Descriptor() ([]byte, []int) {
+	return file_ourspace_backend_proto_api_proto_rawDescGZIP(), []int{94}
+}
+
+func (x *ListEventsResponse) GetEvents() []*Event {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+func (x *ListEventsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type UpdateEventRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Event         *Event                 `protobuf:"bytes,1,opt,name=event,proto3" json:"event,omitempty"`
+	FieldMask     *fieldmaskpb.FieldMask `protobuf:"bytes,2,opt,name=field_mask,proto3" json:"field_mask,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateEventRequest) Reset() {
+	*x = UpdateEventRequest{}
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[95]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateEventRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateEventRequest) ProtoMessage() {}
+
+func (x *UpdateEventRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[95]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateEventRequest.ProtoReflect.Descriptor instead.
+func (*UpdateEventRequest) Descriptor() ([]byte, []int) {
+	return file_ourspace_backend_proto_api_proto_rawDescGZIP(), []int{95}
+}
+
+func (x *UpdateEventRequest) GetEvent() *Event {
+	if x != nil {
+		return x.Event
+	}
+	return nil
+}
+
+func (x *UpdateEventRequest) GetFieldMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.FieldMask
+	}
+	return nil
+}
+
+type DeleteEventRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteEventRequest) Reset() {
+	*x = DeleteEventRequest{}
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[96]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteEventRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteEventRequest) ProtoMessage() {}
+
+func (x *DeleteEventRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[96]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteEventRequest.ProtoReflect.Descriptor instead.
+func (*DeleteEventRequest) Descriptor() ([]byte, []int) {
+	return file_ourspace_backend_proto_api_proto_rawDescGZIP(), []int{96}
+}
+
+func (x *DeleteEventRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type EventRegistration struct {
+	state            protoimpl.MessageState  `protogen:"open.v1"`
+	Id               string                  `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	EventId          string                  `protobuf:"bytes,2,opt,name=event_id,proto3" json:"event_id,omitempty"`
+	MemberId         string                  `protobuf:"bytes,3,opt,name=member_id,proto3" json:"member_id,omitempty"`
+	Status           EventRegistrationStatus `protobuf:"varint,4,opt,name=status,proto3,enum=ourspace_backend.proto.EventRegistrationStatus" json:"status,omitempty"`
+	RegistrationTime *timestamppb.Timestamp  `protobuf:"bytes,5,opt,name=registration_time,proto3" json:"registration_time,omitempty"`
+	CancellationTime *timestamppb.Timestamp  `protobuf:"bytes,6,opt,name=cancellation_time,proto3" json:"cancellation_time,omitempty"`
+	Attended         bool                    `protobuf:"varint,7,opt,name=attended,proto3" json:"attended,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *EventRegistration) Reset() {
+	*x = EventRegistration{}
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[97]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EventRegistration) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventRegistration) ProtoMessage() {}
+
+func (x *EventRegistration) ProtoReflect() protoreflect.Message {
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[97]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EventRegistration.ProtoReflect.Descriptor instead.
+func (*EventRegistration) Descriptor() ([]byte, []int) {
+	return file_ourspace_backend_proto_api_proto_rawDescGZIP(), []int{97}
+}
+
+func (x *EventRegistration) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *EventRegistration) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *EventRegistration) GetMemberId() string {
+	if x != nil {
+		return x.MemberId
+	}
+	return ""
+}
+
+func (x *EventRegistration) GetStatus() EventRegistrationStatus {
+	if x != nil {
+		return x.Status
+	}
+	return EventRegistrationStatus_EVENT_REGISTRATION_STATUS_UNKNOWN
+}
+
+func (x *EventRegistration) GetRegistrationTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RegistrationTime
+	}
+	return nil
+}
+
+func (x *EventRegistration) GetCancellationTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CancellationTime
+	}
+	return nil
+}
+
+func (x *EventRegistration) GetAttended() bool {
+	if x != nil {
+		return x.Attended
+	}
+	return false
+}
+
+type EventRegistrationPageToken struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	LastRegistrationTime *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=last_registration_time,proto3" json:"last_registration_time,omitempty"`
+	LastId               string                 `protobuf:"bytes,2,opt,name=last_id,proto3" json:"last_id,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *EventRegistrationPageToken) Reset() {
+	*x = EventRegistrationPageToken{}
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[98]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EventRegistrationPageToken) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventRegistrationPageToken) ProtoMessage() {}
+
+func (x *EventRegistrationPageToken) ProtoReflect() protoreflect.Message {
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[98]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EventRegistrationPageToken.ProtoReflect.Descriptor instead.
+func (*EventRegistrationPageToken) Descriptor() ([]byte, []int) {
+	return file_ourspace_backend_proto_api_proto_rawDescGZIP(), []int{98}
+}
+
+func (x *EventRegistrationPageToken) GetLastRegistrationTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastRegistrationTime
+	}
+	return nil
+}
+
+func (x *EventRegistrationPageToken) GetLastId() string {
+	if x != nil {
+		return x.LastId
+	}
+	return ""
+}
+
+type RegisterForEventRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EventId       string                 `protobuf:"bytes,1,opt,name=event_id,proto3" json:"event_id,omitempty"`
+	MemberId      string                 `protobuf:"bytes,2,opt,name=member_id,proto3" json:"member_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RegisterForEventRequest) Reset() {
+	*x = RegisterForEventRequest{}
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[99]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RegisterForEventRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegisterForEventRequest) ProtoMessage() {}
+
+func (x *RegisterForEventRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[99]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegisterForEventRequest.ProtoReflect.Descriptor instead.
+func (*RegisterForEventRequest) Descriptor() ([]byte, []int) {
+	return file_ourspace_backend_proto_api_proto_rawDescGZIP(), []int{99}
+}
+
+func (x *RegisterForEventRequest) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *RegisterForEventRequest) GetMemberId() string {
+	if x != nil {
+		return x.MemberId
+	}
+	return ""
+}
+
+type CancelEventRegistrationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EventId       string                 `protobuf:"bytes,1,opt,name=event_id,proto3" json:"event_id,omitempty"`
+	Id            string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelEventRegistrationRequest) Reset() {
+	*x = CancelEventRegistrationRequest{}
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[100]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelEventRegistrationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelEventRegistrationRequest) ProtoMessage() {}
+
+func (x *CancelEventRegistrationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[100]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelEventRegistrationRequest.ProtoReflect.Descriptor instead.
+func (*CancelEventRegistrationRequest) Descriptor() ([]byte, []int) {
+	return file_ourspace_backend_proto_api_proto_rawDescGZIP(), []int{100}
+}
+
+func (x *CancelEventRegistrationRequest) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *CancelEventRegistrationRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type ListEventRegistrationsRequest struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	EventId       string                   `protobuf:"bytes,1,opt,name=event_id,proto3" json:"event_id,omitempty"`
+	PageSize      int32                    `protobuf:"varint,2,opt,name=page_size,proto3" json:"page_size,omitempty"`
+	PageToken     string                   `protobuf:"bytes,3,opt,name=page_token,proto3" json:"page_token,omitempty"`
+	Status        *EventRegistrationStatus `protobuf:"varint,4,opt,name=status,proto3,enum=ourspace_backend.proto.EventRegistrationStatus,oneof" json:"status,omitempty"`
+	MemberId      *string                  `protobuf:"bytes,5,opt,name=member_id,proto3,oneof" json:"member_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListEventRegistrationsRequest) Reset() {
+	*x = ListEventRegistrationsRequest{}
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[101]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListEventRegistrationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListEventRegistrationsRequest) ProtoMessage() {}
+
+func (x *ListEventRegistrationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[101]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListEventRegistrationsRequest.ProtoReflect.Descriptor instead.
+func (*ListEventRegistrationsRequest) Descriptor() ([]byte, []int) {
+	return file_ourspace_backend_proto_api_proto_rawDescGZIP(), []int{101}
+}
+
+func (x *ListEventRegistrationsRequest) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *ListEventRegistrationsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListEventRegistrationsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListEventRegistrationsRequest) GetStatus() EventRegistrationStatus {
+	if x != nil && x.Status != nil {
+		return *x.Status
+	}
+	return EventRegistrationStatus_EVENT_REGISTRATION_STATUS_UNKNOWN
+}
+
+func (x *ListEventRegistrationsRequest) GetMemberId() string {
+	if x != nil && x.MemberId != nil {
+		return *x.MemberId
+	}
+	return ""
+}
+
+type ListEventRegistrationsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Registrations []*EventRegistration   `protobuf:"bytes,1,rep,name=registrations,proto3" json:"registrations,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListEventRegistrationsResponse) Reset() {
+	*x = ListEventRegistrationsResponse{}
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[102]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListEventRegistrationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListEventRegistrationsResponse) ProtoMessage() {}
+
+func (x *ListEventRegistrationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[102]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListEventRegistrationsResponse.ProtoReflect.Descriptor instead.
+func (*ListEventRegistrationsResponse) Descriptor() ([]byte, []int) {
+	return file_ourspace_backend_proto_api_proto_rawDescGZIP(), []int{102}
+}
+
+func (x *ListEventRegistrationsResponse) GetRegistrations() []*EventRegistration {
+	if x != nil {
+		return x.Registrations
+	}
+	return nil
+}
+
+func (x *ListEventRegistrationsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type MarkEventAttendanceRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EventId       string                 `protobuf:"bytes,1,opt,name=event_id,proto3" json:"event_id,omitempty"`
+	Id            string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	Attended      bool                   `protobuf:"varint,3,opt,name=attended,proto3" json:"attended,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MarkEventAttendanceRequest) Reset() {
+	*x = MarkEventAttendanceRequest{}
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[103]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MarkEventAttendanceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarkEventAttendanceRequest) ProtoMessage() {}
+
+func (x *MarkEventAttendanceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[103]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarkEventAttendanceRequest.ProtoReflect.Descriptor instead.
+func (*MarkEventAttendanceRequest) Descriptor() ([]byte, []int) {
+	return file_ourspace_backend_proto_api_proto_rawDescGZIP(), []int{103}
+}
+
+func (x *MarkEventAttendanceRequest) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *MarkEventAttendanceRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *MarkEventAttendanceRequest) GetAttended() bool {
+	if x != nil {
+		return x.Attended
+	}
+	return false
+}
+
+type Item struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Id          string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name        string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	// rfid_value and barcode identify the tag attached to the item, at least one of them is needed to lend the item
+	// by scanning it.
+	RfidValue []byte `protobuf:"bytes,4,opt,name=rfid_value,proto3" json:"rfid_value,omitempty"`
+	Barcode   string `protobuf:"bytes,5,opt,name=barcode,proto3" json:"barcode,omitempty"`
+	// condition_notes describe the current condition of the item, they are updated when the item is returned.
+	ConditionNotes string `protobuf:"bytes,6,opt,name=condition_notes,proto3" json:"condition_notes,omitempty"`
+	// required_briefing_types are the IDs of the briefing types a member needs to borrow the item.
+	RequiredBriefingTypes []string `protobuf:"bytes,7,rep,name=required_briefing_types,proto3" json:"required_briefing_types,omitempty"`
+	// available is false while the item is lent to a member.
+	Available     bool `protobuf:"varint,8,opt,name=available,proto3" json:"available,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Item) Reset() {
+	*x = Item{}
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[104]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Item) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Item) ProtoMessage() {}
+
+func (x *Item) ProtoReflect() protoreflect.Message {
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[104]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Item.ProtoReflect.Descriptor instead.
+func (*Item) Descriptor() ([]byte, []int) {
+	return file_ourspace_backend_proto_api_proto_rawDescGZIP(), []int{104}
+}
+
+func (x *Item) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Item) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Item) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Item) GetRfidValue() []byte {
+	if x != nil {
+		return x.RfidValue
+	}
+	return nil
+}
+
+func (x *Item) GetBarcode() string {
+	if x != nil {
+		return x.Barcode
+	}
+	return ""
+}
+
+func (x *Item) GetConditionNotes() string {
+	if x != nil {
+		return x.ConditionNotes
+	}
+	return ""
+}
+
+func (x *Item) GetRequiredBriefingTypes() []string {
+	if x != nil {
+		return x.RequiredBriefingTypes
+	}
+	return nil
+}
+
+func (x *Item) GetAvailable() bool {
+	if x != nil {
+		return x.Available
+	}
+	return false
+}
+
+type ItemPageToken struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Field         ItemField              `protobuf:"varint,1,opt,name=field,proto3,enum=ourspace_backend.proto.ItemField" json:"field,omitempty"`
+	LastValue     string                 `protobuf:"bytes,2,opt,name=last_value,proto3" json:"last_value,omitempty"`
+	Direction     SortDirection          `protobuf:"varint,3,opt,name=direction,proto3,enum=ourspace_backend.proto.SortDirection" json:"direction,omitempty"`
+	LastId        string                 `protobuf:"bytes,4,opt,name=last_id,proto3" json:"last_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ItemPageToken) Reset() {
+	*x = ItemPageToken{}
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[105]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ItemPageToken) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ItemPageToken) ProtoMessage() {}
+
+func (x *ItemPageToken) ProtoReflect() protoreflect.Message {
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[105]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ItemPageToken.ProtoReflect.Descriptor instead.
+func (*ItemPageToken) Descriptor() ([]byte, []int) {
+	return file_ourspace_backend_proto_api_proto_rawDescGZIP(), []int{105}
+}
+
+func (x *ItemPageToken) GetField() ItemField {
+	if x != nil {
+		return x.Field
+	}
+	return ItemField_ITEM_FIELD_UNKNOWN
+}
+
+func (x *ItemPageToken) GetLastValue() string {
+	if x != nil {
+		return x.LastValue
+	}
+	return ""
+}
+
+func (x *ItemPageToken) GetDirection() SortDirection {
+	if x != nil {
+		return x.Direction
+	}
+	return SortDirection_SORT_DIRECTION_DEFAULT
+}
+
+func (x *ItemPageToken) GetLastId() string {
+	if x != nil {
+		return x.LastId
+	}
+	return ""
+}
+
+type CreateItemRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ItemId        string                 `protobuf:"bytes,1,opt,name=item_id,proto3" json:"item_id,omitempty"`
+	Item          *Item                  `protobuf:"bytes,2,opt,name=item,proto3" json:"item,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateItemRequest) Reset() {
+	*x = CreateItemRequest{}
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[106]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateItemRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateItemRequest) ProtoMessage() {}
+
+func (x *CreateItemRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[106]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateItemRequest.ProtoReflect.Descriptor instead.
+func (*CreateItemRequest) Descriptor() ([]byte, []int) {
+	return file_ourspace_backend_proto_api_proto_rawDescGZIP(), []int{106}
+}
+
+func (x *CreateItemRequest) GetItemId() string {
+	if x != nil {
+		return x.ItemId
+	}
+	return ""
+}
+
+func (x *CreateItemRequest) GetItem() *Item {
+	if x != nil {
+		return x.Item
+	}
+	return nil
+}
+
+type GetItemRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetItemRequest) Reset() {
+	*x = GetItemRequest{}
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[107]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetItemRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetItemRequest) ProtoMessage() {}
+
+func (x *GetItemRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[107]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetItemRequest.ProtoReflect.Descriptor instead.
+func (*GetItemRequest) Descriptor() ([]byte, []int) {
+	return file_ourspace_backend_proto_api_proto_rawDescGZIP(), []int{107}
+}
+
+func (x *GetItemRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type ListItemsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PageSize      int32                  `protobuf:"varint,1,opt,name=page_size,proto3" json:"page_size,omitempty"`
+	PageToken     string                 `protobuf:"bytes,2,opt,name=page_token,proto3" json:"page_token,omitempty"`
+	SortBy        ItemField              `protobuf:"varint,3,opt,name=sort_by,proto3,enum=ourspace_backend.proto.ItemField" json:"sort_by,omitempty"`
+	SortDirection SortDirection          `protobuf:"varint,4,opt,name=sort_direction,proto3,enum=ourspace_backend.proto.SortDirection" json:"sort_direction,omitempty"`
+	Available     *bool                  `protobuf:"varint,5,opt,name=available,proto3,oneof" json:"available,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListItemsRequest) Reset() {
+	*x = ListItemsRequest{}
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[108]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListItemsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListItemsRequest) ProtoMessage() {}
+
+func (x *ListItemsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[108]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListItemsRequest.ProtoReflect.Descriptor instead.
+func (*ListItemsRequest) Descriptor() ([]byte, []int) {
+	return file_ourspace_backend_proto_api_proto_rawDescGZIP(), []int{108}
+}
+
+func (x *ListItemsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListItemsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListItemsRequest) GetSortBy() ItemField {
+	if x != nil {
+		return x.SortBy
+	}
+	return ItemField_ITEM_FIELD_UNKNOWN
+}
+
+func (x *ListItemsRequest) GetSortDirection() SortDirection {
+	if x != nil {
+		return x.SortDirection
+	}
+	return SortDirection_SORT_DIRECTION_DEFAULT
+}
+
+func (x *ListItemsRequest) GetAvailable() bool {
+	if x != nil && x.Available != nil {
+		return *x.Available
+	}
+	return false
+}
+
+type ListItemsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*Item                `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListItemsResponse) Reset() {
+	*x = ListItemsResponse{}
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[109]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListItemsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListItemsResponse) ProtoMessage() {}
+
+func (x *ListItemsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[109]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListItemsResponse.ProtoReflect.Descriptor instead.
+func (*ListItemsResponse) Descriptor() ([]byte, []int) {
+	return file_ourspace_backend_proto_api_proto_rawDescGZIP(), []int{109}
+}
+
+func (x *ListItemsResponse) GetItems() []*Item {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *ListItemsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type UpdateItemRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Item          *Item                  `protobuf:"bytes,1,opt,name=item,proto3" json:"item,omitempty"`
+	FieldMask     *fieldmaskpb.FieldMask `protobuf:"bytes,2,opt,name=field_mask,proto3" json:"field_mask,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateItemRequest) Reset() {
+	*x = UpdateItemRequest{}
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[110]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateItemRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateItemRequest) ProtoMessage() {}
+
+func (x *UpdateItemRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[110]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateItemRequest.ProtoReflect.Descriptor instead.
+func (*UpdateItemRequest) Descriptor() ([]byte, []int) {
+	return file_ourspace_backend_proto_api_proto_rawDescGZIP(), []int{110}
+}
+
+func (x *UpdateItemRequest) GetItem() *Item {
+	if x != nil {
+		return x.Item
+	}
+	return nil
+}
+
+func (x *UpdateItemRequest) GetFieldMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.FieldMask
+	}
+	return nil
+}
+
+type DeleteItemRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteItemRequest) Reset() {
+	*x = DeleteItemRequest{}
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[111]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteItemRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteItemRequest) ProtoMessage() {}
+
+func (x *DeleteItemRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[111]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteItemRequest.ProtoReflect.Descriptor instead.
+func (*DeleteItemRequest) Descriptor() ([]byte, []int) {
+	return file_ourspace_backend_proto_api_proto_rawDescGZIP(), []int{111}
+}
+
+func (x *DeleteItemRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type Loan struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	Id                   string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ItemId               string                 `protobuf:"bytes,2,opt,name=item_id,proto3" json:"item_id,omitempty"`
+	MemberId             string                 `protobuf:"bytes,3,opt,name=member_id,proto3" json:"member_id,omitempty"`
+	LendTime             *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=lend_time,proto3" json:"lend_time,omitempty"`
+	ExpectedReturnTime   *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=expected_return_time,proto3" json:"expected_return_time,omitempty"`
+	ReturnTime           *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=return_time,proto3" json:"return_time,omitempty"`
+	ReturnConditionNotes string                 `protobuf:"bytes,7,opt,name=return_condition_notes,proto3" json:"return_condition_notes,omitempty"`
+	TerminalId           *string                `protobuf:"bytes,8,opt,name=terminal_id,proto3,oneof" json:"terminal_id,omitempty"`
+	Overdue              bool                   `protobuf:"varint,9,opt,name=overdue,proto3" json:"overdue,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *Loan) Reset() {
+	*x = Loan{}
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[112]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Loan) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Loan) ProtoMessage() {}
+
+func (x *Loan) ProtoReflect() protoreflect.Message {
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[112]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Loan.ProtoReflect.Descriptor instead.
+func (*Loan) Descriptor() ([]byte, []int) {
+	return file_ourspace_backend_proto_api_proto_rawDescGZIP(), []int{112}
+}
+
+func (x *Loan) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Loan) GetItemId() string {
+	if x != nil {
+		return x.ItemId
+	}
+	return ""
+}
+
+func (x *Loan) GetMemberId() string {
+	if x != nil {
+		return x.MemberId
+	}
+	return ""
+}
+
+func (x *Loan) GetLendTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LendTime
+	}
+	return nil
+}
+
+func (x *Loan) GetExpectedReturnTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpectedReturnTime
+	}
+	return nil
+}
+
+func (x *Loan) GetReturnTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ReturnTime
+	}
+	return nil
+}
+
+func (x *Loan) GetReturnConditionNotes() string {
+	if x != nil {
+		return x.ReturnConditionNotes
+	}
+	return ""
+}
+
+func (x *Loan) GetTerminalId() string {
+	if x != nil && x.TerminalId != nil {
+		return *x.TerminalId
+	}
+	return ""
+}
+
+func (x *Loan) GetOverdue() bool {
+	if x != nil {
+		return x.Overdue
+	}
+	return false
+}
+
+type LoanPageToken struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	LastLendTime  *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=last_lend_time,proto3" json:"last_lend_time,omitempty"`
+	LastId        string                 `protobuf:"bytes,2,opt,name=last_id,proto3" json:"last_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LoanPageToken) Reset() {
+	*x = LoanPageToken{}
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[113]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LoanPageToken) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LoanPageToken) ProtoMessage() {}
+
+func (x *LoanPageToken) ProtoReflect() protoreflect.Message {
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[113]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LoanPageToken.ProtoReflect.Descriptor instead.
+func (*LoanPageToken) Descriptor() ([]byte, []int) {
+	return file_ourspace_backend_proto_api_proto_rawDescGZIP(), []int{113}
+}
+
+func (x *LoanPageToken) GetLastLendTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastLendTime
+	}
+	return nil
+}
+
+func (x *LoanPageToken) GetLastId() string {
+	if x != nil {
+		return x.LastId
+	}
+	return ""
+}
+
+type LendItemRequest struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	ItemId   string                 `protobuf:"bytes,1,opt,name=item_id,proto3" json:"item_id,omitempty"`
+	MemberId string                 `protobuf:"bytes,2,opt,name=member_id,proto3" json:"member_id,omitempty"`
+	// expected_return_time defaults to the configured lending period.
+	ExpectedReturnTime *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=expected_return_time,proto3" json:"expected_return_time,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *LendItemRequest) Reset() {
+	*x = LendItemRequest{}
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[114]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LendItemRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LendItemRequest) ProtoMessage() {}
+
+func (x *LendItemRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[114]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LendItemRequest.ProtoReflect.Descriptor instead.
+func (*LendItemRequest) Descriptor() ([]byte, []int) {
+	return file_ourspace_backend_proto_api_proto_rawDescGZIP(), []int{114}
+}
+
+func (x *LendItemRequest) GetItemId() string {
+	if x != nil {
+		return x.ItemId
+	}
+	return ""
+}
+
+func (x *LendItemRequest) GetMemberId() string {
+	if x != nil {
+		return x.MemberId
+	}
+	return ""
+}
+
+func (x *LendItemRequest) GetExpectedReturnTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpectedReturnTime
+	}
+	return nil
+}
+
+type LendItemByScanRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	MemberRfidValue []byte                 `protobuf:"bytes,1,opt,name=member_rfid_value,proto3" json:"member_rfid_value,omitempty"`
+	// Types that are valid to be assigned to ItemTag:
+	//
+	//	*LendItemByScanRequest_ItemRfidValue
+	//	*LendItemByScanRequest_ItemBarcode
+	ItemTag    isLendItemByScanRequest_ItemTag `protobuf_oneof:"item_tag"`
+	TerminalId string                          `protobuf:"bytes,4,opt,name=terminal_id,proto3" json:"terminal_id,omitempty"`
+	// expected_return_time defaults to the configured lending period.
+	ExpectedReturnTime *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=expected_return_time,proto3" json:"expected_return_time,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *LendItemByScanRequest) Reset() {
+	*x = LendItemByScanRequest{}
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[115]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LendItemByScanRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LendItemByScanRequest) ProtoMessage() {}
+
+func (x *LendItemByScanRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[115]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LendItemByScanRequest.ProtoReflect.Descriptor instead.
+func (*LendItemByScanRequest) Descriptor() ([]byte, []int) {
+	return file_ourspace_backend_proto_api_proto_rawDescGZIP(), []int{115}
+}
+
+func (x *LendItemByScanRequest) GetMemberRfidValue() []byte {
+	if x != nil {
+		return x.MemberRfidValue
+	}
+	return nil
+}
+
+func (x *LendItemByScanRequest) GetItemTag() isLendItemByScanRequest_ItemTag {
+	if x != nil {
+		return x.ItemTag
+	}
+	return nil
+}
+
+func (x *LendItemByScanRequest) GetItemRfidValue() []byte {
+	if x != nil {
+		if x, ok := x.ItemTag.(*LendItemByScanRequest_ItemRfidValue); ok {
+			return x.ItemRfidValue
+		}
+	}
+	return nil
+}
+
+func (x *LendItemByScanRequest) GetItemBarcode() string {
+	if x != nil {
+		if x, ok := x.ItemTag.(*LendItemByScanRequest_ItemBarcode); ok {
+			return x.ItemBarcode
+		}
+	}
+	return ""
+}
+
+func (x *LendItemByScanRequest) GetTerminalId() string {
+	if x != nil {
+		return x.TerminalId
+	}
+	return ""
+}
+
+func (x *LendItemByScanRequest) GetExpectedReturnTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpectedReturnTime
+	}
+	return nil
+}
+
+type isLendItemByScanRequest_ItemTag interface {
+	isLendItemByScanRequest_ItemTag()
+}
+
+type LendItemByScanRequest_ItemRfidValue struct {
+	ItemRfidValue []byte `protobuf:"bytes,2,opt,name=item_rfid_value,proto3,oneof"`
+}
+
+type LendItemByScanRequest_ItemBarcode struct {
+	ItemBarcode string `protobuf:"bytes,3,opt,name=item_barcode,proto3,oneof"`
+}
+
+func (*LendItemByScanRequest_ItemRfidValue) isLendItemByScanRequest_ItemTag() {}
+
+func (*LendItemByScanRequest_ItemBarcode) isLendItemByScanRequest_ItemTag() {}
+
+type ReturnItemRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ConditionNotes string                 `protobuf:"bytes,2,opt,name=condition_notes,proto3" json:"condition_notes,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ReturnItemRequest) Reset() {
+	*x = ReturnItemRequest{}
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[116]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReturnItemRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReturnItemRequest) ProtoMessage() {}
+
+func (x *ReturnItemRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[116]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReturnItemRequest.ProtoReflect.Descriptor instead.
+func (*ReturnItemRequest) Descriptor() ([]byte, []int) {
+	return file_ourspace_backend_proto_api_proto_rawDescGZIP(), []int{116}
+}
+
+func (x *ReturnItemRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ReturnItemRequest) GetConditionNotes() string {
+	if x != nil {
+		return x.ConditionNotes
+	}
+	return ""
+}
+
+type ReturnItemByScanRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to ItemTag:
+	//
+	//	*ReturnItemByScanRequest_ItemRfidValue
+	//	*ReturnItemByScanRequest_ItemBarcode
+	ItemTag        isReturnItemByScanRequest_ItemTag `protobuf_oneof:"item_tag"`
+	ConditionNotes string                            `protobuf:"bytes,3,opt,name=condition_notes,proto3" json:"condition_notes,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ReturnItemByScanRequest) Reset() {
+	*x = ReturnItemByScanRequest{}
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[117]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReturnItemByScanRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReturnItemByScanRequest) ProtoMessage() {}
+
+func (x *ReturnItemByScanRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[117]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReturnItemByScanRequest.ProtoReflect.Descriptor instead.
+func (*ReturnItemByScanRequest) Descriptor() ([]byte, []int) {
+	return file_ourspace_backend_proto_api_proto_rawDescGZIP(), []int{117}
+}
+
+func (x *ReturnItemByScanRequest) GetItemTag() isReturnItemByScanRequest_ItemTag {
+	if x != nil {
+		return x.ItemTag
+	}
+	return nil
+}
+
+func (x *ReturnItemByScanRequest) GetItemRfidValue() []byte {
+	if x != nil {
+		if x, ok := x.ItemTag.(*ReturnItemByScanRequest_ItemRfidValue); ok {
+			return x.ItemRfidValue
+		}
+	}
+	return nil
+}
+
+func (x *ReturnItemByScanRequest) GetItemBarcode() string {
+	if x != nil {
+		if x, ok := x.ItemTag.(*ReturnItemByScanRequest_ItemBarcode); ok {
+			return x.ItemBarcode
+		}
+	}
+	return ""
+}
+
+func (x *ReturnItemByScanRequest) GetConditionNotes() string {
+	if x != nil {
+		return x.ConditionNotes
+	}
+	return ""
+}
+
+type isReturnItemByScanRequest_ItemTag interface {
+	isReturnItemByScanRequest_ItemTag()
+}
+
+type ReturnItemByScanRequest_ItemRfidValue struct {
+	ItemRfidValue []byte `protobuf:"bytes,1,opt,name=item_rfid_value,proto3,oneof"`
+}
+
+type ReturnItemByScanRequest_ItemBarcode struct {
+	ItemBarcode string `protobuf:"bytes,2,opt,name=item_barcode,proto3,oneof"`
+}
+
+func (*ReturnItemByScanRequest_ItemRfidValue) isReturnItemByScanRequest_ItemTag() {}
+
+func (*ReturnItemByScanRequest_ItemBarcode) isReturnItemByScanRequest_ItemTag() {}
+
+type GetLoanRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetLoanRequest) Reset() {
+	*x = GetLoanRequest{}
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[118]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetLoanRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLoanRequest) ProtoMessage() {}
+
+func (x *GetLoanRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[118]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLoanRequest.ProtoReflect.Descriptor instead.
+func (*GetLoanRequest) Descriptor() ([]byte, []int) {
+	return file_ourspace_backend_proto_api_proto_rawDescGZIP(), []int{118}
+}
+
+func (x *GetLoanRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type ListLoansRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	PageSize  int32                  `protobuf:"varint,1,opt,name=page_size,proto3" json:"page_size,omitempty"`
+	PageToken string                 `protobuf:"bytes,2,opt,name=page_token,proto3" json:"page_token,omitempty"`
+	ItemId    *string                `protobuf:"bytes,3,opt,name=item_id,proto3,oneof" json:"item_id,omitempty"`
+	MemberId  *string                `protobuf:"bytes,4,opt,name=member_id,proto3,oneof" json:"member_id,omitempty"`
+	// open limits the result to loans that have not been returned yet.
+	Open bool `protobuf:"varint,5,opt,name=open,proto3" json:"open,omitempty"`
+	// overdue limits the result to open loans past their expected return time.
+	Overdue       bool `protobuf:"varint,6,opt,name=overdue,proto3" json:"overdue,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListLoansRequest) Reset() {
+	*x = ListLoansRequest{}
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[119]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListLoansRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListLoansRequest) ProtoMessage() {}
+
+func (x *ListLoansRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[119]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListLoansRequest.ProtoReflect.Descriptor instead.
+func (*ListLoansRequest) Descriptor() ([]byte, []int) {
+	return file_ourspace_backend_proto_api_proto_rawDescGZIP(), []int{119}
+}
+
+func (x *ListLoansRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListLoansRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListLoansRequest) GetItemId() string {
+	if x != nil && x.ItemId != nil {
+		return *x.ItemId
+	}
+	return ""
+}
+
+func (x *ListLoansRequest) GetMemberId() string {
+	if x != nil && x.MemberId != nil {
+		return *x.MemberId
+	}
+	return ""
+}
+
+func (x *ListLoansRequest) GetOpen() bool {
+	if x != nil {
+		return x.Open
+	}
+	return false
+}
+
+func (x *ListLoansRequest) GetOverdue() bool {
+	if x != nil {
+		return x.Overdue
+	}
+	return false
+}
+
+type ListLoansResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Loans         []*Loan                `protobuf:"bytes,1,rep,name=loans,proto3" json:"loans,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListLoansResponse) Reset() {
+	*x = ListLoansResponse{}
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[120]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListLoansResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListLoansResponse) ProtoMessage() {}
+
+func (x *ListLoansResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[120]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListLoansResponse.ProtoReflect.Descriptor instead.
+func (*ListLoansResponse) Descriptor() ([]byte, []int) {
+	return file_ourspace_backend_proto_api_proto_rawDescGZIP(), []int{120}
+}
+
+func (x *ListLoansResponse) GetLoans() []*Loan {
+	if x != nil {
+		return x.Loans
+	}
+	return nil
+}
+
+func (x *ListLoansResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type Machine struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Id          string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name        string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Location    string                 `protobuf:"bytes,4,opt,name=location,proto3" json:"location,omitempty"`
+	// required_briefing_types are the IDs of the briefing types a member needs to use the machine.
+	RequiredBriefingTypes []string `protobuf:"bytes,5,rep,name=required_briefing_types,proto3" json:"required_briefing_types,omitempty"`
+	// price_per_minute_cents is charged for every started minute of a usage session, 0 means usage is free.
+	PricePerMinuteCents int64 `protobuf:"varint,6,opt,name=price_per_minute_cents,proto3" json:"price_per_minute_cents,omitempty"`
+	// session_timeout is the time after which a usage session that was not stopped ends automatically. If not set,
+	// the configured default is used.
+	SessionTimeout *durationpb.Duration `protobuf:"bytes,7,opt,name=session_timeout,proto3" json:"session_timeout,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *Machine) Reset() {
+	*x = Machine{}
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[121]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Machine) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Machine) ProtoMessage() {}
+
+func (x *Machine) ProtoReflect() protoreflect.Message {
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[121]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Machine.ProtoReflect.Descriptor instead.
+func (*Machine) Descriptor() ([]byte, []int) {
+	return file_ourspace_backend_proto_api_proto_rawDescGZIP(), []int{121}
+}
+
+func (x *Machine) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Machine) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Machine) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Machine) GetLocation() string {
+	if x != nil {
+		return x.Location
+	}
+	return ""
+}
+
+func (x *Machine) GetRequiredBriefingTypes() []string {
+	if x != nil {
+		return x.RequiredBriefingTypes
+	}
+	return nil
+}
+
+func (x *Machine) GetPricePerMinuteCents() int64 {
+	if x != nil {
+		return x.PricePerMinuteCents
+	}
+	return 0
+}
+
+func (x *Machine) GetSessionTimeout() *durationpb.Duration {
+	if x != nil {
+		return x.SessionTimeout
+	}
+	return nil
+}
+
+type MachinePageToken struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	LastName      string                 `protobuf:"bytes,1,opt,name=last_name,proto3" json:"last_name,omitempty"`
+	LastId        string                 `protobuf:"bytes,2,opt,name=last_id,proto3" json:"last_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MachinePageToken) Reset() {
+	*x = MachinePageToken{}
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[122]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MachinePageToken) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MachinePageToken) ProtoMessage() {}
+
+func (x *MachinePageToken) ProtoReflect() protoreflect.Message {
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[122]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MachinePageToken.ProtoReflect.Descriptor instead.
+func (*MachinePageToken) Descriptor() ([]byte, []int) {
+	return file_ourspace_backend_proto_api_proto_rawDescGZIP(), []int{122}
+}
+
+func (x *MachinePageToken) GetLastName() string {
+	if x != nil {
+		return x.LastName
+	}
+	return ""
+}
+
+func (x *MachinePageToken) GetLastId() string {
+	if x != nil {
+		return x.LastId
+	}
+	return ""
+}
+
+type CreateMachineRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MachineId     string                 `protobuf:"bytes,1,opt,name=machine_id,proto3" json:"machine_id,omitempty"`
+	Machine       *Machine               `protobuf:"bytes,2,opt,name=machine,proto3" json:"machine,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateMachineRequest) Reset() {
+	*x = CreateMachineRequest{}
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[123]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateMachineRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateMachineRequest) ProtoMessage() {}
+
+func (x *CreateMachineRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[123]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateMachineRequest.ProtoReflect.Descriptor instead.
+func (*CreateMachineRequest) Descriptor() ([]byte, []int) {
+	return file_ourspace_backend_proto_api_proto_rawDescGZIP(), []int{123}
+}
+
+func (x *CreateMachineRequest) GetMachineId() string {
+	if x != nil {
+		return x.MachineId
+	}
+	return ""
+}
+
+func (x *CreateMachineRequest) GetMachine() *Machine {
+	if x != nil {
+		return x.Machine
+	}
+	return nil
+}
+
+type GetMachineRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMachineRequest) Reset() {
+	*x = GetMachineRequest{}
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[124]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMachineRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMachineRequest) ProtoMessage() {}
+
+func (x *GetMachineRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[124]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMachineRequest.ProtoReflect.Descriptor instead.
+func (*GetMachineRequest) Descriptor() ([]byte, []int) {
+	return file_ourspace_backend_proto_api_proto_rawDescGZIP(), []int{124}
+}
+
+func (x *GetMachineRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type ListMachinesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PageSize      int32                  `protobuf:"varint,1,opt,name=page_size,proto3" json:"page_size,omitempty"`
+	PageToken     string                 `protobuf:"bytes,2,opt,name=page_token,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMachinesRequest) Reset() {
+	*x = ListMachinesRequest{}
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[125]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMachinesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMachinesRequest) ProtoMessage() {}
+
+func (x *ListMachinesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[125]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMachinesRequest.ProtoReflect.Descriptor instead.
+func (*ListMachinesRequest) Descriptor() ([]byte, []int) {
+	return file_ourspace_backend_proto_api_proto_rawDescGZIP(), []int{125}
+}
+
+func (x *ListMachinesRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListMachinesRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type ListMachinesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Machines      []*Machine             `protobuf:"bytes,1,rep,name=machines,proto3" json:"machines,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMachinesResponse) Reset() {
+	*x = ListMachinesResponse{}
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[126]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMachinesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMachinesResponse) ProtoMessage() {}
+
+func (x *ListMachinesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[126]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMachinesResponse.ProtoReflect.Descriptor instead.
+func (*ListMachinesResponse) Descriptor() ([]byte, []int) {
+	return file_ourspace_backend_proto_api_proto_rawDescGZIP(), []int{126}
+}
+
+func (x *ListMachinesResponse) GetMachines() []*Machine {
+	if x != nil {
+		return x.Machines
+	}
+	return nil
+}
+
+func (x *ListMachinesResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type UpdateMachineRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Machine       *Machine               `protobuf:"bytes,1,opt,name=machine,proto3" json:"machine,omitempty"`
+	FieldMask     *fieldmaskpb.FieldMask `protobuf:"bytes,2,opt,name=field_mask,proto3" json:"field_mask,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateMachineRequest) Reset() {
+	*x = UpdateMachineRequest{}
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[127]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateMachineRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateMachineRequest) ProtoMessage() {}
+
+func (x *UpdateMachineRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[127]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateMachineRequest.ProtoReflect.Descriptor instead.
+func (*UpdateMachineRequest) Descriptor() ([]byte, []int) {
+	return file_ourspace_backend_proto_api_proto_rawDescGZIP(), []int{127}
+}
+
+func (x *UpdateMachineRequest) GetMachine() *Machine {
+	if x != nil {
+		return x.Machine
+	}
+	return nil
+}
+
+func (x *UpdateMachineRequest) GetFieldMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.FieldMask
+	}
+	return nil
+}
+
+type DeleteMachineRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteMachineRequest) Reset() {
+	*x = DeleteMachineRequest{}
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[128]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteMachineRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteMachineRequest) ProtoMessage() {}
+
+func (x *DeleteMachineRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[128]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteMachineRequest.ProtoReflect.Descriptor instead.
+func (*DeleteMachineRequest) Descriptor() ([]byte, []int) {
+	return file_ourspace_backend_proto_api_proto_rawDescGZIP(), []int{128}
+}
+
+func (x *DeleteMachineRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type UsageSession struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Id        string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	MachineId string                 `protobuf:"bytes,2,opt,name=machine_id,proto3" json:"machine_id,omitempty"`
+	MemberId  string                 `protobuf:"bytes,3,opt,name=member_id,proto3" json:"member_id,omitempty"`
+	StartTime *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=start_time,proto3" json:"start_time,omitempty"`
+	// end_time is not set while the session is running.
+	EndTime *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=end_time,proto3,oneof" json:"end_time,omitempty"`
+	// auto_stopped is set if the session was ended by the session timeout of the machine.
+	AutoStopped bool `protobuf:"varint,6,opt,name=auto_stopped,proto3" json:"auto_stopped,omitempty"`
+	// price_per_minute_cents is the price of the machine at the start of the session.
+	PricePerMinuteCents int64 `protobuf:"varint,7,opt,name=price_per_minute_cents,proto3" json:"price_per_minute_cents,omitempty"`
+	// price_cents is the price for all started minutes, for running sessions up to now.
+	PriceCents    int64 `protobuf:"varint,8,opt,name=price_cents,proto3" json:"price_cents,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UsageSession) Reset() {
+	*x = UsageSession{}
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[129]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UsageSession) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UsageSession) ProtoMessage() {}
+
+func (x *UsageSession) ProtoReflect() protoreflect.Message {
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[129]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UsageSession.ProtoReflect.Descriptor instead.
+func (*UsageSession) Descriptor() ([]byte, []int) {
+	return file_ourspace_backend_proto_api_proto_rawDescGZIP(), []int{129}
+}
+
+func (x *UsageSession) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UsageSession) GetMachineId() string {
+	if x != nil {
+		return x.MachineId
+	}
+	return ""
+}
+
+func (x *UsageSession) GetMemberId() string {
+	if x != nil {
+		return x.MemberId
+	}
+	return ""
+}
+
+func (x *UsageSession) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *UsageSession) GetEndTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndTime
+	}
+	return nil
+}
+
+func (x *UsageSession) GetAutoStopped() bool {
+	if x != nil {
+		return x.AutoStopped
+	}
+	return false
+}
+
+func (x *UsageSession) GetPricePerMinuteCents() int64 {
+	if x != nil {
+		return x.PricePerMinuteCents
+	}
+	return 0
+}
+
+func (x *UsageSession) GetPriceCents() int64 {
+	if x != nil {
+		return x.PriceCents
+	}
+	return 0
+}
+
+type StartUsageSessionRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	MachineId string                 `protobuf:"bytes,1,opt,name=machine_id,proto3" json:"machine_id,omitempty"`
+	// Types that are valid to be assigned to Member:
+	//
+	//	*StartUsageSessionRequest_MemberId
+	//	*StartUsageSessionRequest_RfidValue
+	Member        isStartUsageSessionRequest_Member `protobuf_oneof:"member"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StartUsageSessionRequest) Reset() {
+	*x = StartUsageSessionRequest{}
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[130]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StartUsageSessionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartUsageSessionRequest) ProtoMessage() {}
+
+func (x *StartUsageSessionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[130]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartUsageSessionRequest.ProtoReflect.Descriptor instead.
+func (*StartUsageSessionRequest) Descriptor() ([]byte, []int) {
+	return file_ourspace_backend_proto_api_proto_rawDescGZIP(), []int{130}
+}
+
+func (x *StartUsageSessionRequest) GetMachineId() string {
+	if x != nil {
+		return x.MachineId
+	}
+	return ""
+}
+
+func (x *StartUsageSessionRequest) GetMember() isStartUsageSessionRequest_Member {
+	if x != nil {
+		return x.Member
+	}
+	return nil
+}
+
+func (x *StartUsageSessionRequest) GetMemberId() string {
+	if x != nil {
+		if x, ok := x.Member.(*StartUsageSessionRequest_MemberId); ok {
+			return x.MemberId
+		}
+	}
+	return ""
+}
+
+func (x *StartUsageSessionRequest) GetRfidValue() []byte {
+	if x != nil {
+		if x, ok := x.Member.(*StartUsageSessionRequest_RfidValue); ok {
+			return x.RfidValue
+		}
+	}
+	return nil
+}
+
+type isStartUsageSessionRequest_Member interface {
+	isStartUsageSessionRequest_Member()
+}
+
+type StartUsageSessionRequest_MemberId struct {
+	MemberId string `protobuf:"bytes,2,opt,name=member_id,proto3,oneof"`
+}
+
+type StartUsageSessionRequest_RfidValue struct {
+	// rfid_value of the card presented at the machine, only cards of members with an active membership are accepted.
+	RfidValue []byte `protobuf:"bytes,3,opt,name=rfid_value,proto3,oneof"`
+}
+
+func (*StartUsageSessionRequest_MemberId) isStartUsageSessionRequest_Member() {}
+
+func (*StartUsageSessionRequest_RfidValue) isStartUsageSessionRequest_Member() {}
+
+type StopUsageSessionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MachineId     string                 `protobuf:"bytes,1,opt,name=machine_id,proto3" json:"machine_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StopUsageSessionRequest) Reset() {
+	*x = StopUsageSessionRequest{}
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[131]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StopUsageSessionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StopUsageSessionRequest) ProtoMessage() {}
+
+func (x *StopUsageSessionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[131]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StopUsageSessionRequest.ProtoReflect.Descriptor instead.
+func (*StopUsageSessionRequest) Descriptor() ([]byte, []int) {
+	return file_ourspace_backend_proto_api_proto_rawDescGZIP(), []int{131}
+}
+
+func (x *StopUsageSessionRequest) GetMachineId() string {
+	if x != nil {
+		return x.MachineId
+	}
+	return ""
+}
+
+type UsageSessionPageToken struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	LastStartTime *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=last_start_time,proto3" json:"last_start_time,omitempty"`
+	LastId        string                 `protobuf:"bytes,2,opt,name=last_id,proto3" json:"last_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UsageSessionPageToken) Reset() {
+	*x = UsageSessionPageToken{}
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[132]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UsageSessionPageToken) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UsageSessionPageToken) ProtoMessage() {}
+
+func (x *UsageSessionPageToken) ProtoReflect() protoreflect.Message {
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[132]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UsageSessionPageToken.ProtoReflect.Descriptor instead.
+func (*UsageSessionPageToken) Descriptor() ([]byte, []int) {
+	return file_ourspace_backend_proto_api_proto_rawDescGZIP(), []int{132}
+}
+
+func (x *UsageSessionPageToken) GetLastStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastStartTime
+	}
+	return nil
+}
+
+func (x *UsageSessionPageToken) GetLastId() string {
+	if x != nil {
+		return x.LastId
+	}
+	return ""
+}
+
+type ListUsageSessionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PageSize      int32                  `protobuf:"varint,1,opt,name=page_size,proto3" json:"page_size,omitempty"`
+	PageToken     string                 `protobuf:"bytes,2,opt,name=page_token,proto3" json:"page_token,omitempty"`
+	MachineId     *string                `protobuf:"bytes,3,opt,name=machine_id,proto3,oneof" json:"machine_id,omitempty"`
+	MemberId      *string                `protobuf:"bytes,4,opt,name=member_id,proto3,oneof" json:"member_id,omitempty"`
+	RunningOnly   bool                   `protobuf:"varint,5,opt,name=running_only,proto3" json:"running_only,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListUsageSessionsRequest) Reset() {
+	*x = ListUsageSessionsRequest{}
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[133]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListUsageSessionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUsageSessionsRequest) ProtoMessage() {}
+
+func (x *ListUsageSessionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[133]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListUsageSessionsRequest.ProtoReflect.Descriptor instead.
+func (*ListUsageSessionsRequest) Descriptor() ([]byte, []int) {
+	return file_ourspace_backend_proto_api_proto_rawDescGZIP(), []int{133}
+}
+
+func (x *ListUsageSessionsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListUsageSessionsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListUsageSessionsRequest) GetMachineId() string {
+	if x != nil && x.MachineId != nil {
+		return *x.MachineId
+	}
+	return ""
+}
+
+func (x *ListUsageSessionsRequest) GetMemberId() string {
+	if x != nil && x.MemberId != nil {
+		return *x.MemberId
+	}
+	return ""
+}
+
+func (x *ListUsageSessionsRequest) GetRunningOnly() bool {
+	if x != nil {
+		return x.RunningOnly
+	}
+	return false
+}
+
+type ListUsageSessionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Sessions      []*UsageSession        `protobuf:"bytes,1,rep,name=sessions,proto3" json:"sessions,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListUsageSessionsResponse) Reset() {
+	*x = ListUsageSessionsResponse{}
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[134]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListUsageSessionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUsageSessionsResponse) ProtoMessage() {}
+
+func (x *ListUsageSessionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[134]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListUsageSessionsResponse.ProtoReflect.Descriptor instead.
+func (*ListUsageSessionsResponse) Descriptor() ([]byte, []int) {
+	return file_ourspace_backend_proto_api_proto_rawDescGZIP(), []int{134}
+}
+
+func (x *ListUsageSessionsResponse) GetSessions() []*UsageSession {
+	if x != nil {
+		return x.Sessions
+	}
+	return nil
+}
+
+func (x *ListUsageSessionsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type CheckAccessRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RfidValue     []byte                 `protobuf:"bytes,1,opt,name=rfid_value,proto3" json:"rfid_value,omitempty"`
+	MachineId     string                 `protobuf:"bytes,2,opt,name=machine_id,proto3" json:"machine_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CheckAccessRequest) Reset() {
+	*x = CheckAccessRequest{}
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[135]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckAccessRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckAccessRequest) ProtoMessage() {}
+
+func (x *CheckAccessRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[135]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckAccessRequest.ProtoReflect.Descriptor instead.
+func (*CheckAccessRequest) Descriptor() ([]byte, []int) {
+	return file_ourspace_backend_proto_api_proto_rawDescGZIP(), []int{135}
+}
+
+func (x *CheckAccessRequest) GetRfidValue() []byte {
+	if x != nil {
+		return x.RfidValue
+	}
+	return nil
+}
+
+func (x *CheckAccessRequest) GetMachineId() string {
+	if x != nil {
+		return x.MachineId
+	}
+	return ""
+}
+
+type AccessDecision struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	Id           string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	DecisionTime *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=decision_time,proto3" json:"decision_time,omitempty"`
+	MachineId    string                 `protobuf:"bytes,3,opt,name=machine_id,proto3" json:"machine_id,omitempty"`
+	// member_id is only set if the card could be resolved to a member.
+	MemberId *string      `protobuf:"bytes,4,opt,name=member_id,proto3,oneof" json:"member_id,omitempty"`
+	Allowed  bool         `protobuf:"varint,5,opt,name=allowed,proto3" json:"allowed,omitempty"`
+	Reason   AccessReason `protobuf:"varint,6,opt,name=reason,proto3,enum=ourspace_backend.proto.AccessReason" json:"reason,omitempty"`
+	// missing_briefing_types lists the briefing types the member still needs, if access was denied because of them.
+	MissingBriefingTypes []string `protobuf:"bytes,7,rep,name=missing_briefing_types,proto3" json:"missing_briefing_types,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *AccessDecision) Reset() {
+	*x = AccessDecision{}
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[136]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AccessDecision) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccessDecision) ProtoMessage() {}
+
+func (x *AccessDecision) ProtoReflect() protoreflect.Message {
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[136]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccessDecision.ProtoReflect.Descriptor instead.
+func (*AccessDecision) Descriptor() ([]byte, []int) {
+	return file_ourspace_backend_proto_api_proto_rawDescGZIP(), []int{136}
+}
+
+func (x *AccessDecision) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *AccessDecision) GetDecisionTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DecisionTime
+	}
+	return nil
+}
+
+func (x *AccessDecision) GetMachineId() string {
+	if x != nil {
+		return x.MachineId
+	}
+	return ""
+}
+
+func (x *AccessDecision) GetMemberId() string {
+	if x != nil && x.MemberId != nil {
+		return *x.MemberId
+	}
+	return ""
+}
+
+func (x *AccessDecision) GetAllowed() bool {
+	if x != nil {
+		return x.Allowed
+	}
+	return false
+}
+
+func (x *AccessDecision) GetReason() AccessReason {
+	if x != nil {
+		return x.Reason
+	}
+	return AccessReason_ACCESS_REASON_UNKNOWN
+}
+
+func (x *AccessDecision) GetMissingBriefingTypes() []string {
+	if x != nil {
+		return x.MissingBriefingTypes
+	}
+	return nil
+}
+
+type AccessDecisionPageToken struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	LastDecisionTime *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=last_decision_time,proto3" json:"last_decision_time,omitempty"`
+	LastId           string                 `protobuf:"bytes,2,opt,name=last_id,proto3" json:"last_id,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *AccessDecisionPageToken) Reset() {
+	*x = AccessDecisionPageToken{}
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[137]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AccessDecisionPageToken) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccessDecisionPageToken) ProtoMessage() {}
+
+func (x *AccessDecisionPageToken) ProtoReflect() protoreflect.Message {
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[137]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccessDecisionPageToken.ProtoReflect.Descriptor instead.
+func (*AccessDecisionPageToken) Descriptor() ([]byte, []int) {
+	return file_ourspace_backend_proto_api_proto_rawDescGZIP(), []int{137}
+}
+
+func (x *AccessDecisionPageToken) GetLastDecisionTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastDecisionTime
+	}
+	return nil
+}
+
+func (x *AccessDecisionPageToken) GetLastId() string {
+	if x != nil {
+		return x.LastId
+	}
+	return ""
+}
+
+type ListAccessDecisionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PageSize      int32                  `protobuf:"varint,1,opt,name=page_size,proto3" json:"page_size,omitempty"`
+	PageToken     string                 `protobuf:"bytes,2,opt,name=page_token,proto3" json:"page_token,omitempty"`
+	MachineId     *string                `protobuf:"bytes,3,opt,name=machine_id,proto3,oneof" json:"machine_id,omitempty"`
+	MemberId      *string                `protobuf:"bytes,4,opt,name=member_id,proto3,oneof" json:"member_id,omitempty"`
+	Allowed       *bool                  `protobuf:"varint,5,opt,name=allowed,proto3,oneof" json:"allowed,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAccessDecisionsRequest) Reset() {
+	*x = ListAccessDecisionsRequest{}
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[138]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAccessDecisionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAccessDecisionsRequest) ProtoMessage() {}
+
+func (x *ListAccessDecisionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[138]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAccessDecisionsRequest.ProtoReflect.Descriptor instead.
+func (*ListAccessDecisionsRequest) Descriptor() ([]byte, []int) {
+	return file_ourspace_backend_proto_api_proto_rawDescGZIP(), []int{138}
+}
+
+func (x *ListAccessDecisionsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListAccessDecisionsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListAccessDecisionsRequest) GetMachineId() string {
+	if x != nil && x.MachineId != nil {
+		return *x.MachineId
+	}
+	return ""
+}
+
+func (x *ListAccessDecisionsRequest) GetMemberId() string {
+	if x != nil && x.MemberId != nil {
+		return *x.MemberId
+	}
+	return ""
+}
+
+func (x *ListAccessDecisionsRequest) GetAllowed() bool {
+	if x != nil && x.Allowed != nil {
+		return *x.Allowed
+	}
+	return false
+}
+
+type ListAccessDecisionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Decisions     []*AccessDecision      `protobuf:"bytes,1,rep,name=decisions,proto3" json:"decisions,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAccessDecisionsResponse) Reset() {
+	*x = ListAccessDecisionsResponse{}
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[139]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAccessDecisionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAccessDecisionsResponse) ProtoMessage() {}
+
+func (x *ListAccessDecisionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[139]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAccessDecisionsResponse.ProtoReflect.Descriptor instead.
+func (*ListAccessDecisionsResponse) Descriptor() ([]byte, []int) {
+	return file_ourspace_backend_proto_api_proto_rawDescGZIP(), []int{139}
+}
+
+func (x *ListAccessDecisionsResponse) GetDecisions() []*AccessDecision {
+	if x != nil {
+		return x.Decisions
+	}
+	return nil
+}
+
+func (x *ListAccessDecisionsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type MembershipPlan struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Id          string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	DisplayName string                 `protobuf:"bytes,2,opt,name=display_name,proto3" json:"display_name,omitempty"`
+	Description string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	// price_cents is the fee per billing interval.
+	PriceCents int64 `protobuf:"varint,4,opt,name=price_cents,proto3" json:"price_cents,omitempty"`
+	// reduced_price_cents is charged instead of price_cents to members with AGE_CATEGORY_UNDERAGE.
+	ReducedPriceCents *int64          `protobuf:"varint,5,opt,name=reduced_price_cents,proto3,oneof" json:"reduced_price_cents,omitempty"`
+	BillingInterval   BillingInterval `protobuf:"varint,6,opt,name=billing_interval,proto3,enum=ourspace_backend.proto.BillingInterval" json:"billing_interval,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *MembershipPlan) Reset() {
+	*x = MembershipPlan{}
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[140]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MembershipPlan) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MembershipPlan) ProtoMessage() {}
+
+func (x *MembershipPlan) ProtoReflect() protoreflect.Message {
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[140]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MembershipPlan.ProtoReflect.Descriptor instead.
+func (*MembershipPlan) Descriptor() ([]byte, []int) {
+	return file_ourspace_backend_proto_api_proto_rawDescGZIP(), []int{140}
+}
+
+func (x *MembershipPlan) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *MembershipPlan) GetDisplayName() string {
+	if x != nil {
+		return x.DisplayName
+	}
+	return ""
+}
+
+func (x *MembershipPlan) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *MembershipPlan) GetPriceCents() int64 {
+	if x != nil {
+		return x.PriceCents
+	}
+	return 0
+}
+
+func (x *MembershipPlan) GetReducedPriceCents() int64 {
+	if x != nil && x.ReducedPriceCents != nil {
+		return *x.ReducedPriceCents
+	}
+	return 0
+}
+
+func (x *MembershipPlan) GetBillingInterval() BillingInterval {
+	if x != nil {
+		return x.BillingInterval
+	}
+	return BillingInterval_BILLING_INTERVAL_UNKNOWN
+}
+
+type MembershipPlanPageToken struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	LastDisplayName string                 `protobuf:"bytes,1,opt,name=last_display_name,proto3" json:"last_display_name,omitempty"`
+	LastId          string                 `protobuf:"bytes,2,opt,name=last_id,proto3" json:"last_id,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *MembershipPlanPageToken) Reset() {
+	*x = MembershipPlanPageToken{}
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[141]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MembershipPlanPageToken) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MembershipPlanPageToken) ProtoMessage() {}
+
+func (x *MembershipPlanPageToken) ProtoReflect() protoreflect.Message {
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[141]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MembershipPlanPageToken.ProtoReflect.Descriptor instead.
+func (*MembershipPlanPageToken) Descriptor() ([]byte, []int) {
+	return file_ourspace_backend_proto_api_proto_rawDescGZIP(), []int{141}
+}
+
+func (x *MembershipPlanPageToken) GetLastDisplayName() string {
+	if x != nil {
+		return x.LastDisplayName
+	}
+	return ""
+}
+
+func (x *MembershipPlanPageToken) GetLastId() string {
+	if x != nil {
+		return x.LastId
+	}
+	return ""
+}
+
+type CreateMembershipPlanRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	MembershipPlanId string                 `protobuf:"bytes,1,opt,name=membership_plan_id,proto3" json:"membership_plan_id,omitempty"`
+	MembershipPlan   *MembershipPlan        `protobuf:"bytes,2,opt,name=membership_plan,proto3" json:"membership_plan,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *CreateMembershipPlanRequest) Reset() {
+	*x = CreateMembershipPlanRequest{}
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[142]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateMembershipPlanRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateMembershipPlanRequest) ProtoMessage() {}
+
+func (x *CreateMembershipPlanRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[142]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateMembershipPlanRequest.ProtoReflect.Descriptor instead.
+func (*CreateMembershipPlanRequest) Descriptor() ([]byte, []int) {
+	return file_ourspace_backend_proto_api_proto_rawDescGZIP(), []int{142}
+}
+
+func (x *CreateMembershipPlanRequest) GetMembershipPlanId() string {
+	if x != nil {
+		return x.MembershipPlanId
+	}
+	return ""
+}
+
+func (x *CreateMembershipPlanRequest) GetMembershipPlan() *MembershipPlan {
+	if x != nil {
+		return x.MembershipPlan
+	}
+	return nil
+}
+
+type GetMembershipPlanRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMembershipPlanRequest) Reset() {
+	*x = GetMembershipPlanRequest{}
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[143]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMembershipPlanRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMembershipPlanRequest) ProtoMessage() {}
+
+func (x *GetMembershipPlanRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[143]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMembershipPlanRequest.ProtoReflect.Descriptor instead.
+func (*GetMembershipPlanRequest) Descriptor() ([]byte, []int) {
+	return file_ourspace_backend_proto_api_proto_rawDescGZIP(), []int{143}
+}
+
+func (x *GetMembershipPlanRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type ListMembershipPlansRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PageSize      int32                  `protobuf:"varint,1,opt,name=page_size,proto3" json:"page_size,omitempty"`
+	PageToken     string                 `protobuf:"bytes,2,opt,name=page_token,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMembershipPlansRequest) Reset() {
+	*x = ListMembershipPlansRequest{}
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[144]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMembershipPlansRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMembershipPlansRequest) ProtoMessage() {}
+
+func (x *ListMembershipPlansRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[144]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMembershipPlansRequest.ProtoReflect.Descriptor instead.
+func (*ListMembershipPlansRequest) Descriptor() ([]byte, []int) {
+	return file_ourspace_backend_proto_api_proto_rawDescGZIP(), []int{144}
+}
+
+func (x *ListMembershipPlansRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListMembershipPlansRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type ListMembershipPlansResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	MembershipPlans []*MembershipPlan      `protobuf:"bytes,1,rep,name=membership_plans,proto3" json:"membership_plans,omitempty"`
+	NextPageToken   string                 `protobuf:"bytes,2,opt,name=next_page_token,proto3" json:"next_page_token,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ListMembershipPlansResponse) Reset() {
+	*x = ListMembershipPlansResponse{}
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[145]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMembershipPlansResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMembershipPlansResponse) ProtoMessage() {}
+
+func (x *ListMembershipPlansResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[145]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMembershipPlansResponse.ProtoReflect.Descriptor instead.
+func (*ListMembershipPlansResponse) Descriptor() ([]byte, []int) {
+	return file_ourspace_backend_proto_api_proto_rawDescGZIP(), []int{145}
+}
+
+func (x *ListMembershipPlansResponse) GetMembershipPlans() []*MembershipPlan {
+	if x != nil {
+		return x.MembershipPlans
+	}
+	return nil
+}
+
+func (x *ListMembershipPlansResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type UpdateMembershipPlanRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	MembershipPlan *MembershipPlan        `protobuf:"bytes,1,opt,name=membership_plan,proto3" json:"membership_plan,omitempty"`
+	FieldMask      *fieldmaskpb.FieldMask `protobuf:"bytes,2,opt,name=field_mask,proto3" json:"field_mask,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *UpdateMembershipPlanRequest) Reset() {
+	*x = UpdateMembershipPlanRequest{}
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[146]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateMembershipPlanRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateMembershipPlanRequest) ProtoMessage() {}
+
+func (x *UpdateMembershipPlanRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[146]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateMembershipPlanRequest.ProtoReflect.Descriptor instead.
+func (*UpdateMembershipPlanRequest) Descriptor() ([]byte, []int) {
+	return file_ourspace_backend_proto_api_proto_rawDescGZIP(), []int{146}
+}
+
+func (x *UpdateMembershipPlanRequest) GetMembershipPlan() *MembershipPlan {
+	if x != nil {
+		return x.MembershipPlan
+	}
+	return nil
+}
+
+func (x *UpdateMembershipPlanRequest) GetFieldMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.FieldMask
+	}
+	return nil
+}
+
+type DeleteMembershipPlanRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteMembershipPlanRequest) Reset() {
+	*x = DeleteMembershipPlanRequest{}
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[147]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteMembershipPlanRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteMembershipPlanRequest) ProtoMessage() {}
+
+func (x *DeleteMembershipPlanRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[147]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteMembershipPlanRequest.ProtoReflect.Descriptor instead.
+func (*DeleteMembershipPlanRequest) Descriptor() ([]byte, []int) {
+	return file_ourspace_backend_proto_api_proto_rawDescGZIP(), []int{147}
+}
+
+func (x *DeleteMembershipPlanRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type PlanAssignment struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Id       string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	MemberId string                 `protobuf:"bytes,2,opt,name=member_id,proto3" json:"member_id,omitempty"`
+	PlanId   string                 `protobuf:"bytes,3,opt,name=plan_id,proto3" json:"plan_id,omitempty"`
+	// start_time is the start of the first billing period.
+	StartTime *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=start_time,proto3" json:"start_time,omitempty"`
+	EndTime   *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=end_time,proto3,oneof" json:"end_time,omitempty"`
+	// billed_until is the end of the last billing period an invoice was generated for.
+	BilledUntil   *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=billed_until,proto3" json:"billed_until,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PlanAssignment) Reset() {
+	*x = PlanAssignment{}
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[148]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlanAssignment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlanAssignment) ProtoMessage() {}
+
+func (x *PlanAssignment) ProtoReflect() protoreflect.Message {
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[148]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlanAssignment.ProtoReflect.Descriptor instead.
+func (*PlanAssignment) Descriptor() ([]byte, []int) {
+	return file_ourspace_backend_proto_api_proto_rawDescGZIP(), []int{148}
+}
+
+func (x *PlanAssignment) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *PlanAssignment) GetMemberId() string {
+	if x != nil {
+		return x.MemberId
+	}
+	return ""
+}
+
+func (x *PlanAssignment) GetPlanId() string {
+	if x != nil {
+		return x.PlanId
+	}
+	return ""
+}
+
+func (x *PlanAssignment) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *PlanAssignment) GetEndTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndTime
+	}
+	return nil
+}
+
+func (x *PlanAssignment) GetBilledUntil() *timestamppb.Timestamp {
+	if x != nil {
+		return x.BilledUntil
+	}
+	return nil
+}
+
+type PlanAssignmentPageToken struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	LastStartTime *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=last_start_time,proto3" json:"last_start_time,omitempty"`
+	LastId        string                 `protobuf:"bytes,2,opt,name=last_id,proto3" json:"last_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PlanAssignmentPageToken) Reset() {
+	*x = PlanAssignmentPageToken{}
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[149]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlanAssignmentPageToken) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlanAssignmentPageToken) ProtoMessage() {}
+
+func (x *PlanAssignmentPageToken) ProtoReflect() protoreflect.Message {
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[149]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlanAssignmentPageToken.ProtoReflect.Descriptor instead.
+func (*PlanAssignmentPageToken) Descriptor() ([]byte, []int) {
+	return file_ourspace_backend_proto_api_proto_rawDescGZIP(), []int{149}
+}
+
+func (x *PlanAssignmentPageToken) GetLastStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastStartTime
+	}
+	return nil
+}
+
+func (x *PlanAssignmentPageToken) GetLastId() string {
+	if x != nil {
+		return x.LastId
+	}
+	return ""
+}
+
+type AssignMembershipPlanRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	PlanAssignment *PlanAssignment        `protobuf:"bytes,1,opt,name=plan_assignment,proto3" json:"plan_assignment,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *AssignMembershipPlanRequest) Reset() {
+	*x = AssignMembershipPlanRequest{}
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[150]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AssignMembershipPlanRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AssignMembershipPlanRequest) ProtoMessage() {}
+
+func (x *AssignMembershipPlanRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[150]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AssignMembershipPlanRequest.ProtoReflect.Descriptor instead.
+func (*AssignMembershipPlanRequest) Descriptor() ([]byte, []int) {
+	return file_ourspace_backend_proto_api_proto_rawDescGZIP(), []int{150}
+}
+
+func (x *AssignMembershipPlanRequest) GetPlanAssignment() *PlanAssignment {
+	if x != nil {
+		return x.PlanAssignment
+	}
+	return nil
+}
+
+type ListPlanAssignmentsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PageSize      int32                  `protobuf:"varint,1,opt,name=page_size,proto3" json:"page_size,omitempty"`
+	PageToken     string                 `protobuf:"bytes,2,opt,name=page_token,proto3" json:"page_token,omitempty"`
+	MemberId      *string                `protobuf:"bytes,3,opt,name=member_id,proto3,oneof" json:"member_id,omitempty"`
+	PlanId        *string                `protobuf:"bytes,4,opt,name=plan_id,proto3,oneof" json:"plan_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPlanAssignmentsRequest) Reset() {
+	*x = ListPlanAssignmentsRequest{}
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[151]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPlanAssignmentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPlanAssignmentsRequest) ProtoMessage() {}
+
+func (x *ListPlanAssignmentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[151]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPlanAssignmentsRequest.ProtoReflect.Descriptor instead.
+func (*ListPlanAssignmentsRequest) Descriptor() ([]byte, []int) {
+	return file_ourspace_backend_proto_api_proto_rawDescGZIP(), []int{151}
+}
+
+func (x *ListPlanAssignmentsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListPlanAssignmentsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListPlanAssignmentsRequest) GetMemberId() string {
+	if x != nil && x.MemberId != nil {
+		return *x.MemberId
+	}
+	return ""
+}
+
+func (x *ListPlanAssignmentsRequest) GetPlanId() string {
+	if x != nil && x.PlanId != nil {
+		return *x.PlanId
+	}
+	return ""
+}
+
+type ListPlanAssignmentsResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	PlanAssignments []*PlanAssignment      `protobuf:"bytes,1,rep,name=plan_assignments,proto3" json:"plan_assignments,omitempty"`
+	NextPageToken   string                 `protobuf:"bytes,2,opt,name=next_page_token,proto3" json:"next_page_token,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ListPlanAssignmentsResponse) Reset() {
+	*x = ListPlanAssignmentsResponse{}
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[152]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPlanAssignmentsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPlanAssignmentsResponse) ProtoMessage() {}
+
+func (x *ListPlanAssignmentsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[152]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPlanAssignmentsResponse.ProtoReflect.Descriptor instead.
+func (*ListPlanAssignmentsResponse) Descriptor() ([]byte, []int) {
+	return file_ourspace_backend_proto_api_proto_rawDescGZIP(), []int{152}
+}
+
+func (x *ListPlanAssignmentsResponse) GetPlanAssignments() []*PlanAssignment {
+	if x != nil {
+		return x.PlanAssignments
+	}
+	return nil
+}
+
+func (x *ListPlanAssignmentsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type EndPlanAssignmentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	EndTime       *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=end_time,proto3" json:"end_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EndPlanAssignmentRequest) Reset() {
+	*x = EndPlanAssignmentRequest{}
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[153]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EndPlanAssignmentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EndPlanAssignmentRequest) ProtoMessage() {}
+
+func (x *EndPlanAssignmentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[153]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EndPlanAssignmentRequest.ProtoReflect.Descriptor instead.
+func (*EndPlanAssignmentRequest) Descriptor() ([]byte, []int) {
+	return file_ourspace_backend_proto_api_proto_rawDescGZIP(), []int{153}
+}
+
+func (x *EndPlanAssignmentRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *EndPlanAssignmentRequest) GetEndTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndTime
+	}
+	return nil
+}
+
+type Invoice struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Id               string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	MemberId         string                 `protobuf:"bytes,2,opt,name=member_id,proto3" json:"member_id,omitempty"`
+	PlanAssignmentId string                 `protobuf:"bytes,3,opt,name=plan_assignment_id,proto3" json:"plan_assignment_id,omitempty"`
+	PlanId           string                 `protobuf:"bytes,4,opt,name=plan_id,proto3" json:"plan_id,omitempty"`
+	PeriodStart      *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=period_start,proto3" json:"period_start,omitempty"`
+	PeriodEnd        *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=period_end,proto3" json:"period_end,omitempty"`
+	AmountCents      int64                  `protobuf:"varint,7,opt,name=amount_cents,proto3" json:"amount_cents,omitempty"`
+	// paid_cents is the sum of all payments recorded for this invoice.
+	PaidCents     int64                  `protobuf:"varint,8,opt,name=paid_cents,proto3" json:"paid_cents,omitempty"`
+	IssueTime     *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=issue_time,proto3" json:"issue_time,omitempty"`
+	Status        InvoiceStatus          `protobuf:"varint,10,opt,name=status,proto3,enum=ourspace_backend.proto.InvoiceStatus" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Invoice) Reset() {
+	*x = Invoice{}
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[154]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Invoice) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Invoice) ProtoMessage() {}
+
+func (x *Invoice) ProtoReflect() protoreflect.Message {
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[154]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Invoice.ProtoReflect.Descriptor instead.
+func (*Invoice) Descriptor() ([]byte, []int) {
+	return file_ourspace_backend_proto_api_proto_rawDescGZIP(), []int{154}
+}
+
+func (x *Invoice) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Invoice) GetMemberId() string {
+	if x != nil {
+		return x.MemberId
+	}
+	return ""
+}
+
+func (x *Invoice) GetPlanAssignmentId() string {
+	if x != nil {
+		return x.PlanAssignmentId
+	}
+	return ""
+}
+
+func (x *Invoice) GetPlanId() string {
+	if x != nil {
+		return x.PlanId
+	}
+	return ""
+}
+
+func (x *Invoice) GetPeriodStart() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PeriodStart
+	}
+	return nil
+}
+
+func (x *Invoice) GetPeriodEnd() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PeriodEnd
+	}
+	return nil
+}
+
+func (x *Invoice) GetAmountCents() int64 {
+	if x != nil {
+		return x.AmountCents
+	}
+	return 0
+}
+
+func (x *Invoice) GetPaidCents() int64 {
+	if x != nil {
+		return x.PaidCents
+	}
+	return 0
+}
+
+func (x *Invoice) GetIssueTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.IssueTime
+	}
+	return nil
+}
+
+func (x *Invoice) GetStatus() InvoiceStatus {
+	if x != nil {
+		return x.Status
+	}
+	return InvoiceStatus_INVOICE_STATUS_UNKNOWN
+}
+
+type InvoicePageToken struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	LastPeriodStart *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=last_period_start,proto3" json:"last_period_start,omitempty"`
+	LastId          string                 `protobuf:"bytes,2,opt,name=last_id,proto3" json:"last_id,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *InvoicePageToken) Reset() {
+	*x = InvoicePageToken{}
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[155]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InvoicePageToken) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InvoicePageToken) ProtoMessage() {}
+
+func (x *InvoicePageToken) ProtoReflect() protoreflect.Message {
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[155]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InvoicePageToken.ProtoReflect.Descriptor instead.
+func (*InvoicePageToken) Descriptor() ([]byte, []int) {
+	return file_ourspace_backend_proto_api_proto_rawDescGZIP(), []int{155}
+}
+
+func (x *InvoicePageToken) GetLastPeriodStart() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastPeriodStart
+	}
+	return nil
+}
+
+func (x *InvoicePageToken) GetLastId() string {
+	if x != nil {
+		return x.LastId
+	}
+	return ""
+}
+
+type GenerateInvoicesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// until defaults to now.
+	Until         *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=until,proto3" json:"until,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GenerateInvoicesRequest) Reset() {
+	*x = GenerateInvoicesRequest{}
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[156]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GenerateInvoicesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GenerateInvoicesRequest) ProtoMessage() {}
+
+func (x *GenerateInvoicesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[156]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GenerateInvoicesRequest.ProtoReflect.Descriptor instead.
+func (*GenerateInvoicesRequest) Descriptor() ([]byte, []int) {
+	return file_ourspace_backend_proto_api_proto_rawDescGZIP(), []int{156}
+}
+
+func (x *GenerateInvoicesRequest) GetUntil() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Until
+	}
+	return nil
+}
+
+type GenerateInvoicesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Invoices      []*Invoice             `protobuf:"bytes,1,rep,name=invoices,proto3" json:"invoices,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GenerateInvoicesResponse) Reset() {
+	*x = GenerateInvoicesResponse{}
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[157]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GenerateInvoicesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GenerateInvoicesResponse) ProtoMessage() {}
+
+func (x *GenerateInvoicesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[157]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GenerateInvoicesResponse.ProtoReflect.Descriptor instead.
+func (*GenerateInvoicesResponse) Descriptor() ([]byte, []int) {
+	return file_ourspace_backend_proto_api_proto_rawDescGZIP(), []int{157}
+}
+
+func (x *GenerateInvoicesResponse) GetInvoices() []*Invoice {
+	if x != nil {
+		return x.Invoices
+	}
+	return nil
+}
+
+type ListInvoicesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PageSize      int32                  `protobuf:"varint,1,opt,name=page_size,proto3" json:"page_size,omitempty"`
+	PageToken     string                 `protobuf:"bytes,2,opt,name=page_token,proto3" json:"page_token,omitempty"`
+	MemberId      *string                `protobuf:"bytes,3,opt,name=member_id,proto3,oneof" json:"member_id,omitempty"`
+	Status        *InvoiceStatus         `protobuf:"varint,4,opt,name=status,proto3,enum=ourspace_backend.proto.InvoiceStatus,oneof" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListInvoicesRequest) Reset() {
+	*x = ListInvoicesRequest{}
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[158]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListInvoicesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListInvoicesRequest) ProtoMessage() {}
+
+func (x *ListInvoicesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[158]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListInvoicesRequest.ProtoReflect.Descriptor instead.
+func (*ListInvoicesRequest) Descriptor() ([]byte, []int) {
+	return file_ourspace_backend_proto_api_proto_rawDescGZIP(), []int{158}
+}
+
+func (x *ListInvoicesRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListInvoicesRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListInvoicesRequest) GetMemberId() string {
+	if x != nil && x.MemberId != nil {
+		return *x.MemberId
+	}
+	return ""
+}
+
+func (x *ListInvoicesRequest) GetStatus() InvoiceStatus {
+	if x != nil && x.Status != nil {
+		return *x.Status
+	}
+	return InvoiceStatus_INVOICE_STATUS_UNKNOWN
+}
+
+type ListInvoicesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Invoices      []*Invoice             `protobuf:"bytes,1,rep,name=invoices,proto3" json:"invoices,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListInvoicesResponse) Reset() {
+	*x = ListInvoicesResponse{}
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[159]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListInvoicesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListInvoicesResponse) ProtoMessage() {}
+
+func (x *ListInvoicesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[159]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListInvoicesResponse.ProtoReflect.Descriptor instead.
+func (*ListInvoicesResponse) Descriptor() ([]byte, []int) {
+	return file_ourspace_backend_proto_api_proto_rawDescGZIP(), []int{159}
+}
+
+func (x *ListInvoicesResponse) GetInvoices() []*Invoice {
+	if x != nil {
+		return x.Invoices
 	}
 	return nil
 }
 
-func (x *Member) GetMemberLogin() *MemberLogin {
+func (x *ListInvoicesResponse) GetNextPageToken() string {
 	if x != nil {
-		return x.MemberLogin
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type CancelInvoiceRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelInvoiceRequest) Reset() {
+	*x = CancelInvoiceRequest{}
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[160]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelInvoiceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelInvoiceRequest) ProtoMessage() {}
+
+func (x *CancelInvoiceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[160]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelInvoiceRequest.ProtoReflect.Descriptor instead.
+func (*CancelInvoiceRequest) Descriptor() ([]byte, []int) {
+	return file_ourspace_backend_proto_api_proto_rawDescGZIP(), []int{160}
+}
+
+func (x *CancelInvoiceRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type Payment struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Id       string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	MemberId string                 `protobuf:"bytes,2,opt,name=member_id,proto3" json:"member_id,omitempty"`
+	// invoice_id is set if the payment was made for a specific invoice.
+	InvoiceId   *string                `protobuf:"bytes,3,opt,name=invoice_id,proto3,oneof" json:"invoice_id,omitempty"`
+	AmountCents int64                  `protobuf:"varint,4,opt,name=amount_cents,proto3" json:"amount_cents,omitempty"`
+	PaymentTime *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=payment_time,proto3" json:"payment_time,omitempty"`
+	// reference is free text, e.g. the bank transfer reference or "cash".
+	Reference     string `protobuf:"bytes,6,opt,name=reference,proto3" json:"reference,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Payment) Reset() {
+	*x = Payment{}
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[161]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Payment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Payment) ProtoMessage() {}
+
+func (x *Payment) ProtoReflect() protoreflect.Message {
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[161]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Payment.ProtoReflect.Descriptor instead.
+func (*Payment) Descriptor() ([]byte, []int) {
+	return file_ourspace_backend_proto_api_proto_rawDescGZIP(), []int{161}
+}
+
+func (x *Payment) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Payment) GetMemberId() string {
+	if x != nil {
+		return x.MemberId
+	}
+	return ""
+}
+
+func (x *Payment) GetInvoiceId() string {
+	if x != nil && x.InvoiceId != nil {
+		return *x.InvoiceId
+	}
+	return ""
+}
+
+func (x *Payment) GetAmountCents() int64 {
+	if x != nil {
+		return x.AmountCents
+	}
+	return 0
+}
+
+func (x *Payment) GetPaymentTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PaymentTime
 	}
 	return nil
 }
 
-func (x *Member) GetAdditionalAttributes() map[string]string {
+func (x *Payment) GetReference() string {
 	if x != nil {
-		return x.AdditionalAttributes
+		return x.Reference
+	}
+	return ""
+}
+
+type PaymentPageToken struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	LastPaymentTime *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=last_payment_time,proto3" json:"last_payment_time,omitempty"`
+	LastId          string                 `protobuf:"bytes,2,opt,name=last_id,proto3" json:"last_id,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *PaymentPageToken) Reset() {
+	*x = PaymentPageToken{}
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[162]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PaymentPageToken) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PaymentPageToken) ProtoMessage() {}
+
+func (x *PaymentPageToken) ProtoReflect() protoreflect.Message {
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[162]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PaymentPageToken.ProtoReflect.Descriptor instead.
+func (*PaymentPageToken) Descriptor() ([]byte, []int) {
+	return file_ourspace_backend_proto_api_proto_rawDescGZIP(), []int{162}
+}
+
+func (x *PaymentPageToken) GetLastPaymentTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastPaymentTime
 	}
 	return nil
 }
 
-type MemberLogin struct {
+func (x *PaymentPageToken) GetLastId() string {
+	if x != nil {
+		return x.LastId
+	}
+	return ""
+}
+
+type RecordPaymentRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Username      string                 `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
-	Password      string                 `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+	PaymentId     string                 `protobuf:"bytes,1,opt,name=payment_id,proto3" json:"payment_id,omitempty"`
+	Payment       *Payment               `protobuf:"bytes,2,opt,name=payment,proto3" json:"payment,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *MemberLogin) Reset() {
-	*x = MemberLogin{}
-	mi := &file_ourspace_backend_proto_api_proto_msgTypes[2]
+func (x *RecordPaymentRequest) Reset() {
+	*x = RecordPaymentRequest{}
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[163]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *MemberLogin) String() string {
+func (x *RecordPaymentRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MemberLogin) ProtoMessage() {}
+func (*RecordPaymentRequest) ProtoMessage() {}
 
-func (x *MemberLogin) ProtoReflect() protoreflect.Message {
-	mi := &file_ourspace_backend_proto_api_proto_msgTypes[2]
+func (x *RecordPaymentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[163]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -589,47 +11309,116 @@ func (x *MemberLogin) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MemberLogin.ProtoReflect.Descriptor instead.
-func (*MemberLogin) Descriptor() ([]byte, []int) {
-	return file_ourspace_backend_proto_api_proto_rawDescGZIP(), []int{2}
+// Deprecated: Use RecordPaymentRequest.ProtoReflect.Descriptor instead.
+func (*RecordPaymentRequest) Descriptor() ([]byte, []int) {
+	return file_ourspace_backend_proto_api_proto_rawDescGZIP(), []int{163}
 }
 
-func (x *MemberLogin) GetUsername() string {
+func (x *RecordPaymentRequest) GetPaymentId() string {
 	if x != nil {
-		return x.Username
+		return x.PaymentId
 	}
 	return ""
 }
 
-func (x *MemberLogin) GetPassword() string {
+func (x *RecordPaymentRequest) GetPayment() *Payment {
 	if x != nil {
-		return x.Password
+		return x.Payment
+	}
+	return nil
+}
+
+type ListPaymentsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PageSize      int32                  `protobuf:"varint,1,opt,name=page_size,proto3" json:"page_size,omitempty"`
+	PageToken     string                 `protobuf:"bytes,2,opt,name=page_token,proto3" json:"page_token,omitempty"`
+	MemberId      *string                `protobuf:"bytes,3,opt,name=member_id,proto3,oneof" json:"member_id,omitempty"`
+	InvoiceId     *string                `protobuf:"bytes,4,opt,name=invoice_id,proto3,oneof" json:"invoice_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPaymentsRequest) Reset() {
+	*x = ListPaymentsRequest{}
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[164]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPaymentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPaymentsRequest) ProtoMessage() {}
+
+func (x *ListPaymentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[164]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPaymentsRequest.ProtoReflect.Descriptor instead.
+func (*ListPaymentsRequest) Descriptor() ([]byte, []int) {
+	return file_ourspace_backend_proto_api_proto_rawDescGZIP(), []int{164}
+}
+
+func (x *ListPaymentsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListPaymentsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
 	}
 	return ""
 }
 
-type GetMemberRequest struct {
+func (x *ListPaymentsRequest) GetMemberId() string {
+	if x != nil && x.MemberId != nil {
+		return *x.MemberId
+	}
+	return ""
+}
+
+func (x *ListPaymentsRequest) GetInvoiceId() string {
+	if x != nil && x.InvoiceId != nil {
+		return *x.InvoiceId
+	}
+	return ""
+}
+
+type ListPaymentsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Payments      []*Payment             `protobuf:"bytes,1,rep,name=payments,proto3" json:"payments,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,proto3" json:"next_page_token,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetMemberRequest) Reset() {
-	*x = GetMemberRequest{}
-	mi := &file_ourspace_backend_proto_api_proto_msgTypes[3]
+func (x *ListPaymentsResponse) Reset() {
+	*x = ListPaymentsResponse{}
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[165]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetMemberRequest) String() string {
+func (x *ListPaymentsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetMemberRequest) ProtoMessage() {}
+func (*ListPaymentsResponse) ProtoMessage() {}
 
-func (x *GetMemberRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ourspace_backend_proto_api_proto_msgTypes[3]
+func (x *ListPaymentsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[165]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -640,50 +11429,96 @@ func (x *GetMemberRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetMemberRequest.ProtoReflect.Descriptor instead.
-func (*GetMemberRequest) Descriptor() ([]byte, []int) {
-	return file_ourspace_backend_proto_api_proto_rawDescGZIP(), []int{3}
+// Deprecated: Use ListPaymentsResponse.ProtoReflect.Descriptor instead.
+func (*ListPaymentsResponse) Descriptor() ([]byte, []int) {
+	return file_ourspace_backend_proto_api_proto_rawDescGZIP(), []int{165}
+}
+
+func (x *ListPaymentsResponse) GetPayments() []*Payment {
+	if x != nil {
+		return x.Payments
+	}
+	return nil
+}
+
+func (x *ListPaymentsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type DeletePaymentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeletePaymentRequest) Reset() {
+	*x = DeletePaymentRequest{}
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[166]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeletePaymentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeletePaymentRequest) ProtoMessage() {}
+
+func (x *DeletePaymentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[166]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeletePaymentRequest.ProtoReflect.Descriptor instead.
+func (*DeletePaymentRequest) Descriptor() ([]byte, []int) {
+	return file_ourspace_backend_proto_api_proto_rawDescGZIP(), []int{166}
 }
 
-func (x *GetMemberRequest) GetId() string {
+func (x *DeletePaymentRequest) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-type ListMembersRequest struct {
-	state                 protoimpl.MessageState `protogen:"open.v1"`
-	PageSize              int32                  `protobuf:"varint,1,opt,name=page_size,proto3" json:"page_size,omitempty"`
-	PageToken             string                 `protobuf:"bytes,2,opt,name=page_token,proto3" json:"page_token,omitempty"`
-	SortBy                MemberField            `protobuf:"varint,3,opt,name=sort_by,proto3,enum=ourspace_backend.proto.MemberField" json:"sort_by,omitempty"`
-	SortDirection         SortDirection          `protobuf:"varint,4,opt,name=sort_direction,proto3,enum=ourspace_backend.proto.SortDirection" json:"sort_direction,omitempty"`
-	NameContains          *string                `protobuf:"bytes,5,opt,name=name_contains,proto3,oneof" json:"name_contains,omitempty"`
-	MembershipStartAfter  *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=membership_start_after,proto3,oneof" json:"membership_start_after,omitempty"`
-	MembershipStartBefore *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=membership_start_before,proto3,oneof" json:"membership_start_before,omitempty"`
-	MembershipEndAfter    *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=membership_end_after,proto3,oneof" json:"membership_end_after,omitempty"`
-	MembershipEndBefore   *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=membership_end_before,proto3,oneof" json:"membership_end_before,omitempty"`
-	AgeCategoryEquals     *AgeCategory           `protobuf:"varint,10,opt,name=age_category_equals,proto3,enum=ourspace_backend.proto.AgeCategory,oneof" json:"age_category_equals,omitempty"`
-	TagContains           []string               `protobuf:"bytes,11,rep,name=tag_contains,proto3" json:"tag_contains,omitempty"`
-	unknownFields         protoimpl.UnknownFields
-	sizeCache             protoimpl.SizeCache
+type Balance struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	MemberId string                 `protobuf:"bytes,1,opt,name=member_id,proto3" json:"member_id,omitempty"`
+	// invoiced_cents is the sum of all invoices that are not cancelled.
+	InvoicedCents int64 `protobuf:"varint,2,opt,name=invoiced_cents,proto3" json:"invoiced_cents,omitempty"`
+	PaidCents     int64 `protobuf:"varint,3,opt,name=paid_cents,proto3" json:"paid_cents,omitempty"`
+	// outstanding_cents is negative if the member paid in advance.
+	OutstandingCents int64 `protobuf:"varint,4,opt,name=outstanding_cents,proto3" json:"outstanding_cents,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
 }
 
-func (x *ListMembersRequest) Reset() {
-	*x = ListMembersRequest{}
-	mi := &file_ourspace_backend_proto_api_proto_msgTypes[4]
+func (x *Balance) Reset() {
+	*x = Balance{}
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[167]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListMembersRequest) String() string {
+func (x *Balance) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListMembersRequest) ProtoMessage() {}
+func (*Balance) ProtoMessage() {}
 
-func (x *ListMembersRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ourspace_backend_proto_api_proto_msgTypes[4]
+func (x *Balance) ProtoReflect() protoreflect.Message {
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[167]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -694,111 +11529,109 @@ func (x *ListMembersRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListMembersRequest.ProtoReflect.Descriptor instead.
-func (*ListMembersRequest) Descriptor() ([]byte, []int) {
-	return file_ourspace_backend_proto_api_proto_rawDescGZIP(), []int{4}
+// Deprecated: Use Balance.ProtoReflect.Descriptor instead.
+func (*Balance) Descriptor() ([]byte, []int) {
+	return file_ourspace_backend_proto_api_proto_rawDescGZIP(), []int{167}
 }
 
-func (x *ListMembersRequest) GetPageSize() int32 {
+func (x *Balance) GetMemberId() string {
 	if x != nil {
-		return x.PageSize
+		return x.MemberId
 	}
-	return 0
+	return ""
 }
 
-func (x *ListMembersRequest) GetPageToken() string {
+func (x *Balance) GetInvoicedCents() int64 {
 	if x != nil {
-		return x.PageToken
+		return x.InvoicedCents
 	}
-	return ""
+	return 0
 }
 
-func (x *ListMembersRequest) GetSortBy() MemberField {
+func (x *Balance) GetPaidCents() int64 {
 	if x != nil {
-		return x.SortBy
+		return x.PaidCents
 	}
-	return MemberField_MEMBER_FIELD_UNKNOWN
+	return 0
 }
 
-func (x *ListMembersRequest) GetSortDirection() SortDirection {
+func (x *Balance) GetOutstandingCents() int64 {
 	if x != nil {
-		return x.SortDirection
+		return x.OutstandingCents
 	}
-	return SortDirection_SORT_DIRECTION_DEFAULT
+	return 0
 }
 
-func (x *ListMembersRequest) GetNameContains() string {
-	if x != nil && x.NameContains != nil {
-		return *x.NameContains
-	}
-	return ""
+type BalancePageToken struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	LastMemberId  string                 `protobuf:"bytes,1,opt,name=last_member_id,proto3" json:"last_member_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListMembersRequest) GetMembershipStartAfter() *timestamppb.Timestamp {
-	if x != nil {
-		return x.MembershipStartAfter
-	}
-	return nil
+func (x *BalancePageToken) Reset() {
+	*x = BalancePageToken{}
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[168]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListMembersRequest) GetMembershipStartBefore() *timestamppb.Timestamp {
-	if x != nil {
-		return x.MembershipStartBefore
-	}
-	return nil
+func (x *BalancePageToken) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *ListMembersRequest) GetMembershipEndAfter() *timestamppb.Timestamp {
-	if x != nil {
-		return x.MembershipEndAfter
-	}
-	return nil
-}
+func (*BalancePageToken) ProtoMessage() {}
 
-func (x *ListMembersRequest) GetMembershipEndBefore() *timestamppb.Timestamp {
+func (x *BalancePageToken) ProtoReflect() protoreflect.Message {
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[168]
 	if x != nil {
-		return x.MembershipEndBefore
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *ListMembersRequest) GetAgeCategoryEquals() AgeCategory {
-	if x != nil && x.AgeCategoryEquals != nil {
-		return *x.AgeCategoryEquals
-	}
-	return AgeCategory_AGE_CATEGORY_UNKNOWN
+// Deprecated: Use BalancePageToken.ProtoReflect.Descriptor instead.
+func (*BalancePageToken) Descriptor() ([]byte, []int) {
+	return file_ourspace_backend_proto_api_proto_rawDescGZIP(), []int{168}
 }
 
-func (x *ListMembersRequest) GetTagContains() []string {
+func (x *BalancePageToken) GetLastMemberId() string {
 	if x != nil {
-		return x.TagContains
+		return x.LastMemberId
 	}
-	return nil
+	return ""
 }
 
-type ListMembersResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Members       []*Member              `protobuf:"bytes,1,rep,name=members,proto3" json:"members,omitempty"`
-	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,proto3" json:"next_page_token,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type ListBalancesRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	PageSize  int32                  `protobuf:"varint,1,opt,name=page_size,proto3" json:"page_size,omitempty"`
+	PageToken string                 `protobuf:"bytes,2,opt,name=page_token,proto3" json:"page_token,omitempty"`
+	MemberId  *string                `protobuf:"bytes,3,opt,name=member_id,proto3,oneof" json:"member_id,omitempty"`
+	// outstanding_only only returns members that owe money.
+	OutstandingOnly bool `protobuf:"varint,4,opt,name=outstanding_only,proto3" json:"outstanding_only,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
-func (x *ListMembersResponse) Reset() {
-	*x = ListMembersResponse{}
-	mi := &file_ourspace_backend_proto_api_proto_msgTypes[5]
+func (x *ListBalancesRequest) Reset() {
+	*x = ListBalancesRequest{}
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[169]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListMembersResponse) String() string {
+func (x *ListBalancesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListMembersResponse) ProtoMessage() {}
+func (*ListBalancesRequest) ProtoMessage() {}
 
-func (x *ListMembersResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ourspace_backend_proto_api_proto_msgTypes[5]
+func (x *ListBalancesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[169]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -809,50 +11642,69 @@ func (x *ListMembersResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListMembersResponse.ProtoReflect.Descriptor instead.
-func (*ListMembersResponse) Descriptor() ([]byte, []int) {
-	return file_ourspace_backend_proto_api_proto_rawDescGZIP(), []int{5}
+// Deprecated: Use ListBalancesRequest.ProtoReflect.Descriptor instead.
+func (*ListBalancesRequest) Descriptor() ([]byte, []int) {
+	return file_ourspace_backend_proto_api_proto_rawDescGZIP(), []int{169}
 }
 
-func (x *ListMembersResponse) GetMembers() []*Member {
+func (x *ListBalancesRequest) GetPageSize() int32 {
 	if x != nil {
-		return x.Members
+		return x.PageSize
 	}
-	return nil
+	return 0
 }
 
-func (x *ListMembersResponse) GetNextPageToken() string {
+func (x *ListBalancesRequest) GetPageToken() string {
 	if x != nil {
-		return x.NextPageToken
+		return x.PageToken
 	}
 	return ""
 }
 
-type MemberPageToken struct {
+func (x *ListBalancesRequest) GetMemberId() string {
+	if x != nil && x.MemberId != nil {
+		return *x.MemberId
+	}
+	return ""
+}
+
+func (x *ListBalancesRequest) GetOutstandingOnly() bool {
+	if x != nil {
+		return x.OutstandingOnly
+	}
+	return false
+}
+
+type SepaMandate struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Field         MemberField            `protobuf:"varint,1,opt,name=field,proto3,enum=ourspace_backend.proto.MemberField" json:"field,omitempty"`
-	LastValue     string                 `protobuf:"bytes,2,opt,name=last_value,proto3" json:"last_value,omitempty"`
-	Direction     SortDirection          `protobuf:"varint,3,opt,name=direction,proto3,enum=ourspace_backend.proto.SortDirection" json:"direction,omitempty"`
-	LastId        string                 `protobuf:"bytes,4,opt,name=last_id,proto3" json:"last_id,omitempty"`
+	MemberId      string                 `protobuf:"bytes,1,opt,name=member_id,proto3" json:"member_id,omitempty"`
+	AccountHolder string                 `protobuf:"bytes,2,opt,name=account_holder,proto3" json:"account_holder,omitempty"`
+	Iban          string                 `protobuf:"bytes,3,opt,name=iban,proto3" json:"iban,omitempty"`
+	// bic is optional, SEPA payments within the EEA only require the IBAN.
+	Bic string `protobuf:"bytes,4,opt,name=bic,proto3" json:"bic,omitempty"`
+	// mandate_reference is the unique mandate ID, up to 35 characters without spaces.
+	MandateReference string `protobuf:"bytes,5,opt,name=mandate_reference,proto3" json:"mandate_reference,omitempty"`
+	// mandate_date is the day the mandate was signed.
+	MandateDate   *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=mandate_date,proto3" json:"mandate_date,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *MemberPageToken) Reset() {
-	*x = MemberPageToken{}
-	mi := &file_ourspace_backend_proto_api_proto_msgTypes[6]
+func (x *SepaMandate) Reset() {
+	*x = SepaMandate{}
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[170]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *MemberPageToken) String() string {
+func (x *SepaMandate) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MemberPageToken) ProtoMessage() {}
+func (*SepaMandate) ProtoMessage() {}
 
-func (x *MemberPageToken) ProtoReflect() protoreflect.Message {
-	mi := &file_ourspace_backend_proto_api_proto_msgTypes[6]
+func (x *SepaMandate) ProtoReflect() protoreflect.Message {
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[170]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -863,62 +11715,75 @@ func (x *MemberPageToken) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MemberPageToken.ProtoReflect.Descriptor instead.
-func (*MemberPageToken) Descriptor() ([]byte, []int) {
-	return file_ourspace_backend_proto_api_proto_rawDescGZIP(), []int{6}
+// Deprecated: Use SepaMandate.ProtoReflect.Descriptor instead.
+func (*SepaMandate) Descriptor() ([]byte, []int) {
+	return file_ourspace_backend_proto_api_proto_rawDescGZIP(), []int{170}
 }
 
-func (x *MemberPageToken) GetField() MemberField {
+func (x *SepaMandate) GetMemberId() string {
 	if x != nil {
-		return x.Field
+		return x.MemberId
 	}
-	return MemberField_MEMBER_FIELD_UNKNOWN
+	return ""
 }
 
-func (x *MemberPageToken) GetLastValue() string {
+func (x *SepaMandate) GetAccountHolder() string {
 	if x != nil {
-		return x.LastValue
+		return x.AccountHolder
 	}
 	return ""
 }
 
-func (x *MemberPageToken) GetDirection() SortDirection {
+func (x *SepaMandate) GetIban() string {
 	if x != nil {
-		return x.Direction
+		return x.Iban
 	}
-	return SortDirection_SORT_DIRECTION_DEFAULT
+	return ""
 }
 
-func (x *MemberPageToken) GetLastId() string {
+func (x *SepaMandate) GetBic() string {
 	if x != nil {
-		return x.LastId
+		return x.Bic
 	}
 	return ""
 }
 
-type UpdateMemberRequest struct {
+func (x *SepaMandate) GetMandateReference() string {
+	if x != nil {
+		return x.MandateReference
+	}
+	return ""
+}
+
+func (x *SepaMandate) GetMandateDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.MandateDate
+	}
+	return nil
+}
+
+type SetSepaMandateRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Member        *Member                `protobuf:"bytes,1,opt,name=member,proto3" json:"member,omitempty"`
-	FieldMask     *fieldmaskpb.FieldMask `protobuf:"bytes,2,opt,name=field_mask,proto3" json:"field_mask,omitempty"`
+	SepaMandate   *SepaMandate           `protobuf:"bytes,1,opt,name=sepa_mandate,proto3" json:"sepa_mandate,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateMemberRequest) Reset() {
-	*x = UpdateMemberRequest{}
-	mi := &file_ourspace_backend_proto_api_proto_msgTypes[7]
+func (x *SetSepaMandateRequest) Reset() {
+	*x = SetSepaMandateRequest{}
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[171]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateMemberRequest) String() string {
+func (x *SetSepaMandateRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateMemberRequest) ProtoMessage() {}
+func (*SetSepaMandateRequest) ProtoMessage() {}
 
-func (x *UpdateMemberRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ourspace_backend_proto_api_proto_msgTypes[7]
+func (x *SetSepaMandateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[171]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -929,47 +11794,40 @@ func (x *UpdateMemberRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateMemberRequest.ProtoReflect.Descriptor instead.
-func (*UpdateMemberRequest) Descriptor() ([]byte, []int) {
-	return file_ourspace_backend_proto_api_proto_rawDescGZIP(), []int{7}
-}
-
-func (x *UpdateMemberRequest) GetMember() *Member {
-	if x != nil {
-		return x.Member
-	}
-	return nil
+// Deprecated: Use SetSepaMandateRequest.ProtoReflect.Descriptor instead.
+func (*SetSepaMandateRequest) Descriptor() ([]byte, []int) {
+	return file_ourspace_backend_proto_api_proto_rawDescGZIP(), []int{171}
 }
 
-func (x *UpdateMemberRequest) GetFieldMask() *fieldmaskpb.FieldMask {
+func (x *SetSepaMandateRequest) GetSepaMandate() *SepaMandate {
 	if x != nil {
-		return x.FieldMask
+		return x.SepaMandate
 	}
 	return nil
 }
 
-type DeleteMemberRequest struct {
+type GetSepaMandateRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	MemberId      string                 `protobuf:"bytes,1,opt,name=member_id,proto3" json:"member_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteMemberRequest) Reset() {
-	*x = DeleteMemberRequest{}
-	mi := &file_ourspace_backend_proto_api_proto_msgTypes[8]
+func (x *GetSepaMandateRequest) Reset() {
+	*x = GetSepaMandateRequest{}
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[172]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteMemberRequest) String() string {
+func (x *GetSepaMandateRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteMemberRequest) ProtoMessage() {}
+func (*GetSepaMandateRequest) ProtoMessage() {}
 
-func (x *DeleteMemberRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ourspace_backend_proto_api_proto_msgTypes[8]
+func (x *GetSepaMandateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[172]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -980,41 +11838,40 @@ func (x *DeleteMemberRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteMemberRequest.ProtoReflect.Descriptor instead.
-func (*DeleteMemberRequest) Descriptor() ([]byte, []int) {
-	return file_ourspace_backend_proto_api_proto_rawDescGZIP(), []int{8}
+// Deprecated: Use GetSepaMandateRequest.ProtoReflect.Descriptor instead.
+func (*GetSepaMandateRequest) Descriptor() ([]byte, []int) {
+	return file_ourspace_backend_proto_api_proto_rawDescGZIP(), []int{172}
 }
 
-func (x *DeleteMemberRequest) GetId() string {
+func (x *GetSepaMandateRequest) GetMemberId() string {
 	if x != nil {
-		return x.Id
+		return x.MemberId
 	}
 	return ""
 }
 
-type ListMemberTagsRequest struct {
+type DeleteSepaMandateRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	PageSize      int32                  `protobuf:"varint,1,opt,name=page_size,proto3" json:"page_size,omitempty"`
-	PageToken     string                 `protobuf:"bytes,2,opt,name=page_token,proto3" json:"page_token,omitempty"`
+	MemberId      string                 `protobuf:"bytes,1,opt,name=member_id,proto3" json:"member_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListMemberTagsRequest) Reset() {
-	*x = ListMemberTagsRequest{}
-	mi := &file_ourspace_backend_proto_api_proto_msgTypes[9]
+func (x *DeleteSepaMandateRequest) Reset() {
+	*x = DeleteSepaMandateRequest{}
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[173]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListMemberTagsRequest) String() string {
+func (x *DeleteSepaMandateRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListMemberTagsRequest) ProtoMessage() {}
+func (*DeleteSepaMandateRequest) ProtoMessage() {}
 
-func (x *ListMemberTagsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ourspace_backend_proto_api_proto_msgTypes[9]
+func (x *DeleteSepaMandateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[173]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1025,48 +11882,116 @@ func (x *ListMemberTagsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListMemberTagsRequest.ProtoReflect.Descriptor instead.
-func (*ListMemberTagsRequest) Descriptor() ([]byte, []int) {
-	return file_ourspace_backend_proto_api_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use DeleteSepaMandateRequest.ProtoReflect.Descriptor instead.
+func (*DeleteSepaMandateRequest) Descriptor() ([]byte, []int) {
+	return file_ourspace_backend_proto_api_proto_rawDescGZIP(), []int{173}
 }
 
-func (x *ListMemberTagsRequest) GetPageSize() int32 {
+func (x *DeleteSepaMandateRequest) GetMemberId() string {
 	if x != nil {
-		return x.PageSize
+		return x.MemberId
 	}
-	return 0
+	return ""
 }
 
-func (x *ListMemberTagsRequest) GetPageToken() string {
+type ExportSepaDirectDebitRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Invoices with a billing period starting in [period_start, period_end) are collected.
+	PeriodStart *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=period_start,proto3" json:"period_start,omitempty"`
+	PeriodEnd   *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=period_end,proto3" json:"period_end,omitempty"`
+	// collection_date is the requested day of the debit.
+	CollectionDate *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=collection_date,proto3" json:"collection_date,omitempty"`
+	// include_exported collects invoices again that were part of an earlier export, e.g. because the bank rejected the
+	// file. Otherwise they are left out, so that members are not charged twice.
+	IncludeExported bool `protobuf:"varint,4,opt,name=include_exported,proto3" json:"include_exported,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ExportSepaDirectDebitRequest) Reset() {
+	*x = ExportSepaDirectDebitRequest{}
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[174]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportSepaDirectDebitRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportSepaDirectDebitRequest) ProtoMessage() {}
+
+func (x *ExportSepaDirectDebitRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[174]
 	if x != nil {
-		return x.PageToken
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-type ListMemberTagsResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Tags          []string               `protobuf:"bytes,1,rep,name=tags,proto3" json:"tags,omitempty"`
-	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,proto3" json:"next_page_token,omitempty"`
+// Deprecated: Use ExportSepaDirectDebitRequest.ProtoReflect.Descriptor instead.
+func (*ExportSepaDirectDebitRequest) Descriptor() ([]byte, []int) {
+	return file_ourspace_backend_proto_api_proto_rawDescGZIP(), []int{174}
+}
+
+func (x *ExportSepaDirectDebitRequest) GetPeriodStart() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PeriodStart
+	}
+	return nil
+}
+
+func (x *ExportSepaDirectDebitRequest) GetPeriodEnd() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PeriodEnd
+	}
+	return nil
+}
+
+func (x *ExportSepaDirectDebitRequest) GetCollectionDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CollectionDate
+	}
+	return nil
+}
+
+func (x *ExportSepaDirectDebitRequest) GetIncludeExported() bool {
+	if x != nil {
+		return x.IncludeExported
+	}
+	return false
+}
+
+type SepaExportIssue struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	MemberId string                 `protobuf:"bytes,1,opt,name=member_id,proto3" json:"member_id,omitempty"`
+	// invoice_ids are the invoices of the member that were left out of the export.
+	InvoiceIds    []string `protobuf:"bytes,2,rep,name=invoice_ids,proto3" json:"invoice_ids,omitempty"`
+	Field         string   `protobuf:"bytes,3,opt,name=field,proto3" json:"field,omitempty"`
+	Description   string   `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListMemberTagsResponse) Reset() {
-	*x = ListMemberTagsResponse{}
-	mi := &file_ourspace_backend_proto_api_proto_msgTypes[10]
+func (x *SepaExportIssue) Reset() {
+	*x = SepaExportIssue{}
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[175]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListMemberTagsResponse) String() string {
+func (x *SepaExportIssue) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListMemberTagsResponse) ProtoMessage() {}
+func (*SepaExportIssue) ProtoMessage() {}
 
-func (x *ListMemberTagsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ourspace_backend_proto_api_proto_msgTypes[10]
+func (x *SepaExportIssue) ProtoReflect() protoreflect.Message {
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[175]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1077,47 +12002,67 @@ func (x *ListMemberTagsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListMemberTagsResponse.ProtoReflect.Descriptor instead.
-func (*ListMemberTagsResponse) Descriptor() ([]byte, []int) {
-	return file_ourspace_backend_proto_api_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use SepaExportIssue.ProtoReflect.Descriptor instead.
+func (*SepaExportIssue) Descriptor() ([]byte, []int) {
+	return file_ourspace_backend_proto_api_proto_rawDescGZIP(), []int{175}
 }
 
-func (x *ListMemberTagsResponse) GetTags() []string {
+func (x *SepaExportIssue) GetMemberId() string {
 	if x != nil {
-		return x.Tags
+		return x.MemberId
+	}
+	return ""
+}
+
+func (x *SepaExportIssue) GetInvoiceIds() []string {
+	if x != nil {
+		return x.InvoiceIds
 	}
 	return nil
 }
 
-func (x *ListMemberTagsResponse) GetNextPageToken() string {
+func (x *SepaExportIssue) GetField() string {
 	if x != nil {
-		return x.NextPageToken
+		return x.Field
 	}
 	return ""
 }
 
-type MemberTagsPageToken struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Offset        int32                  `protobuf:"varint,1,opt,name=offset,proto3" json:"offset,omitempty"`
+func (x *SepaExportIssue) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+type SepaDirectDebitExport struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// document is the pain.008.001.08 XML file, empty if there is nothing to collect.
+	Document     string             `protobuf:"bytes,1,opt,name=document,proto3" json:"document,omitempty"`
+	Transactions int64              `protobuf:"varint,2,opt,name=transactions,proto3" json:"transactions,omitempty"`
+	TotalCents   int64              `protobuf:"varint,3,opt,name=total_cents,proto3" json:"total_cents,omitempty"`
+	Issues       []*SepaExportIssue `protobuf:"bytes,4,rep,name=issues,proto3" json:"issues,omitempty"`
+	// export_id identifies the export the invoices were recorded with, empty if there is nothing to collect.
+	ExportId      string `protobuf:"bytes,5,opt,name=export_id,proto3" json:"export_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *MemberTagsPageToken) Reset() {
-	*x = MemberTagsPageToken{}
-	mi := &file_ourspace_backend_proto_api_proto_msgTypes[11]
+func (x *SepaDirectDebitExport) Reset() {
+	*x = SepaDirectDebitExport{}
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[176]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *MemberTagsPageToken) String() string {
+func (x *SepaDirectDebitExport) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MemberTagsPageToken) ProtoMessage() {}
+func (*SepaDirectDebitExport) ProtoMessage() {}
 
-func (x *MemberTagsPageToken) ProtoReflect() protoreflect.Message {
-	mi := &file_ourspace_backend_proto_api_proto_msgTypes[11]
+func (x *SepaDirectDebitExport) ProtoReflect() protoreflect.Message {
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[176]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1128,40 +12073,69 @@ func (x *MemberTagsPageToken) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MemberTagsPageToken.ProtoReflect.Descriptor instead.
-func (*MemberTagsPageToken) Descriptor() ([]byte, []int) {
-	return file_ourspace_backend_proto_api_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use SepaDirectDebitExport.ProtoReflect.Descriptor instead.
+func (*SepaDirectDebitExport) Descriptor() ([]byte, []int) {
+	return file_ourspace_backend_proto_api_proto_rawDescGZIP(), []int{176}
 }
 
-func (x *MemberTagsPageToken) GetOffset() int32 {
+func (x *SepaDirectDebitExport) GetDocument() string {
 	if x != nil {
-		return x.Offset
+		return x.Document
+	}
+	return ""
+}
+
+func (x *SepaDirectDebitExport) GetTransactions() int64 {
+	if x != nil {
+		return x.Transactions
 	}
 	return 0
 }
 
-type CreateMemberAttributeRequest struct {
+func (x *SepaDirectDebitExport) GetTotalCents() int64 {
+	if x != nil {
+		return x.TotalCents
+	}
+	return 0
+}
+
+func (x *SepaDirectDebitExport) GetIssues() []*SepaExportIssue {
+	if x != nil {
+		return x.Issues
+	}
+	return nil
+}
+
+func (x *SepaDirectDebitExport) GetExportId() string {
+	if x != nil {
+		return x.ExportId
+	}
+	return ""
+}
+
+type ListBalancesResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Attribute     *MemberAttribute       `protobuf:"bytes,1,opt,name=attribute,proto3" json:"attribute,omitempty"`
+	Balances      []*Balance             `protobuf:"bytes,1,rep,name=balances,proto3" json:"balances,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,proto3" json:"next_page_token,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateMemberAttributeRequest) Reset() {
-	*x = CreateMemberAttributeRequest{}
-	mi := &file_ourspace_backend_proto_api_proto_msgTypes[12]
+func (x *ListBalancesResponse) Reset() {
+	*x = ListBalancesResponse{}
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[177]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateMemberAttributeRequest) String() string {
+func (x *ListBalancesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateMemberAttributeRequest) ProtoMessage() {}
+func (*ListBalancesResponse) ProtoMessage() {}
 
-func (x *CreateMemberAttributeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ourspace_backend_proto_api_proto_msgTypes[12]
+func (x *ListBalancesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[177]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1172,40 +12146,46 @@ func (x *CreateMemberAttributeRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateMemberAttributeRequest.ProtoReflect.Descriptor instead.
-func (*CreateMemberAttributeRequest) Descriptor() ([]byte, []int) {
-	return file_ourspace_backend_proto_api_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use ListBalancesResponse.ProtoReflect.Descriptor instead.
+func (*ListBalancesResponse) Descriptor() ([]byte, []int) {
+	return file_ourspace_backend_proto_api_proto_rawDescGZIP(), []int{177}
 }
 
-func (x *CreateMemberAttributeRequest) GetAttribute() *MemberAttribute {
+func (x *ListBalancesResponse) GetBalances() []*Balance {
 	if x != nil {
-		return x.Attribute
+		return x.Balances
 	}
 	return nil
 }
 
-type GetMemberAttributeRequest struct {
+func (x *ListBalancesResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type GetMyProfileRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetMemberAttributeRequest) Reset() {
-	*x = GetMemberAttributeRequest{}
-	mi := &file_ourspace_backend_proto_api_proto_msgTypes[13]
+func (x *GetMyProfileRequest) Reset() {
+	*x = GetMyProfileRequest{}
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[178]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetMemberAttributeRequest) String() string {
+func (x *GetMyProfileRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetMemberAttributeRequest) ProtoMessage() {}
+func (*GetMyProfileRequest) ProtoMessage() {}
 
-func (x *GetMemberAttributeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ourspace_backend_proto_api_proto_msgTypes[13]
+func (x *GetMyProfileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[178]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1216,43 +12196,36 @@ func (x *GetMemberAttributeRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetMemberAttributeRequest.ProtoReflect.Descriptor instead.
-func (*GetMemberAttributeRequest) Descriptor() ([]byte, []int) {
-	return file_ourspace_backend_proto_api_proto_rawDescGZIP(), []int{13}
-}
-
-func (x *GetMemberAttributeRequest) GetId() string {
-	if x != nil {
-		return x.Id
-	}
-	return ""
+// Deprecated: Use GetMyProfileRequest.ProtoReflect.Descriptor instead.
+func (*GetMyProfileRequest) Descriptor() ([]byte, []int) {
+	return file_ourspace_backend_proto_api_proto_rawDescGZIP(), []int{178}
 }
 
-type ListMemberAttributesRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	PageSize      int32                  `protobuf:"varint,1,opt,name=page_size,proto3" json:"page_size,omitempty"`
-	PageToken     string                 `protobuf:"bytes,2,opt,name=page_token,proto3" json:"page_token,omitempty"`
-	SortBy        MemberAttributeField   `protobuf:"varint,3,opt,name=sort_by,proto3,enum=ourspace_backend.proto.MemberAttributeField" json:"sort_by,omitempty"`
-	SortDirection SortDirection          `protobuf:"varint,4,opt,name=sort_direction,proto3,enum=ourspace_backend.proto.SortDirection" json:"sort_direction,omitempty"`
+type UpdateMyProfileRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Member *Member                `protobuf:"bytes,1,opt,name=member,proto3" json:"member,omitempty"`
+	// field_mask may only contain the fields and additional_attributes.<technical_name> paths that are configured for
+	// self service.
+	FieldMask     *fieldmaskpb.FieldMask `protobuf:"bytes,2,opt,name=field_mask,proto3" json:"field_mask,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListMemberAttributesRequest) Reset() {
-	*x = ListMemberAttributesRequest{}
-	mi := &file_ourspace_backend_proto_api_proto_msgTypes[14]
+func (x *UpdateMyProfileRequest) Reset() {
+	*x = UpdateMyProfileRequest{}
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[179]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListMemberAttributesRequest) String() string {
+func (x *UpdateMyProfileRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListMemberAttributesRequest) ProtoMessage() {}
+func (*UpdateMyProfileRequest) ProtoMessage() {}
 
-func (x *ListMemberAttributesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ourspace_backend_proto_api_proto_msgTypes[14]
+func (x *UpdateMyProfileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[179]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1263,62 +12236,48 @@ func (x *ListMemberAttributesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListMemberAttributesRequest.ProtoReflect.Descriptor instead.
-func (*ListMemberAttributesRequest) Descriptor() ([]byte, []int) {
-	return file_ourspace_backend_proto_api_proto_rawDescGZIP(), []int{14}
-}
-
-func (x *ListMemberAttributesRequest) GetPageSize() int32 {
-	if x != nil {
-		return x.PageSize
-	}
-	return 0
-}
-
-func (x *ListMemberAttributesRequest) GetPageToken() string {
-	if x != nil {
-		return x.PageToken
-	}
-	return ""
+// Deprecated: Use UpdateMyProfileRequest.ProtoReflect.Descriptor instead.
+func (*UpdateMyProfileRequest) Descriptor() ([]byte, []int) {
+	return file_ourspace_backend_proto_api_proto_rawDescGZIP(), []int{179}
 }
 
-func (x *ListMemberAttributesRequest) GetSortBy() MemberAttributeField {
+func (x *UpdateMyProfileRequest) GetMember() *Member {
 	if x != nil {
-		return x.SortBy
+		return x.Member
 	}
-	return MemberAttributeField_MEMBER_ATTRIBUTE_FIELD_UNKNOWN
+	return nil
 }
 
-func (x *ListMemberAttributesRequest) GetSortDirection() SortDirection {
+func (x *UpdateMyProfileRequest) GetFieldMask() *fieldmaskpb.FieldMask {
 	if x != nil {
-		return x.SortDirection
+		return x.FieldMask
 	}
-	return SortDirection_SORT_DIRECTION_DEFAULT
+	return nil
 }
 
-type ListMemberAttributesResponse struct {
+type ListMyPresencesRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Attributes    []*MemberAttribute     `protobuf:"bytes,1,rep,name=attributes,proto3" json:"attributes,omitempty"`
-	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	PageSize      int32                  `protobuf:"varint,1,opt,name=page_size,proto3" json:"page_size,omitempty"`
+	PageToken     string                 `protobuf:"bytes,2,opt,name=page_token,proto3" json:"page_token,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListMemberAttributesResponse) Reset() {
-	*x = ListMemberAttributesResponse{}
-	mi := &file_ourspace_backend_proto_api_proto_msgTypes[15]
+func (x *ListMyPresencesRequest) Reset() {
+	*x = ListMyPresencesRequest{}
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[180]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListMemberAttributesResponse) String() string {
+func (x *ListMyPresencesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListMemberAttributesResponse) ProtoMessage() {}
+func (*ListMyPresencesRequest) ProtoMessage() {}
 
-func (x *ListMemberAttributesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ourspace_backend_proto_api_proto_msgTypes[15]
+func (x *ListMyPresencesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[180]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1329,48 +12288,48 @@ func (x *ListMemberAttributesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListMemberAttributesResponse.ProtoReflect.Descriptor instead.
-func (*ListMemberAttributesResponse) Descriptor() ([]byte, []int) {
-	return file_ourspace_backend_proto_api_proto_rawDescGZIP(), []int{15}
+// Deprecated: Use ListMyPresencesRequest.ProtoReflect.Descriptor instead.
+func (*ListMyPresencesRequest) Descriptor() ([]byte, []int) {
+	return file_ourspace_backend_proto_api_proto_rawDescGZIP(), []int{180}
 }
 
-func (x *ListMemberAttributesResponse) GetAttributes() []*MemberAttribute {
+func (x *ListMyPresencesRequest) GetPageSize() int32 {
 	if x != nil {
-		return x.Attributes
+		return x.PageSize
 	}
-	return nil
+	return 0
 }
 
-func (x *ListMemberAttributesResponse) GetNextPageToken() string {
+func (x *ListMyPresencesRequest) GetPageToken() string {
 	if x != nil {
-		return x.NextPageToken
+		return x.PageToken
 	}
 	return ""
 }
 
-type UpdateMemberAttributeRequest struct {
+type ListMyCardsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Attribute     *MemberAttribute       `protobuf:"bytes,1,opt,name=attribute,proto3" json:"attribute,omitempty"`
-	FieldMask     *fieldmaskpb.FieldMask `protobuf:"bytes,2,opt,name=field_mask,json=fieldMask,proto3" json:"field_mask,omitempty"`
+	PageSize      int32                  `protobuf:"varint,1,opt,name=page_size,proto3" json:"page_size,omitempty"`
+	PageToken     string                 `protobuf:"bytes,2,opt,name=page_token,proto3" json:"page_token,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateMemberAttributeRequest) Reset() {
-	*x = UpdateMemberAttributeRequest{}
-	mi := &file_ourspace_backend_proto_api_proto_msgTypes[16]
+func (x *ListMyCardsRequest) Reset() {
+	*x = ListMyCardsRequest{}
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[181]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateMemberAttributeRequest) String() string {
+func (x *ListMyCardsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateMemberAttributeRequest) ProtoMessage() {}
+func (*ListMyCardsRequest) ProtoMessage() {}
 
-func (x *UpdateMemberAttributeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ourspace_backend_proto_api_proto_msgTypes[16]
+func (x *ListMyCardsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[181]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1381,47 +12340,50 @@ func (x *UpdateMemberAttributeRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateMemberAttributeRequest.ProtoReflect.Descriptor instead.
-func (*UpdateMemberAttributeRequest) Descriptor() ([]byte, []int) {
-	return file_ourspace_backend_proto_api_proto_rawDescGZIP(), []int{16}
+// Deprecated: Use ListMyCardsRequest.ProtoReflect.Descriptor instead.
+func (*ListMyCardsRequest) Descriptor() ([]byte, []int) {
+	return file_ourspace_backend_proto_api_proto_rawDescGZIP(), []int{181}
 }
 
-func (x *UpdateMemberAttributeRequest) GetAttribute() *MemberAttribute {
+func (x *ListMyCardsRequest) GetPageSize() int32 {
 	if x != nil {
-		return x.Attribute
+		return x.PageSize
 	}
-	return nil
+	return 0
 }
 
-func (x *UpdateMemberAttributeRequest) GetFieldMask() *fieldmaskpb.FieldMask {
+func (x *ListMyCardsRequest) GetPageToken() string {
 	if x != nil {
-		return x.FieldMask
+		return x.PageToken
 	}
-	return nil
+	return ""
 }
 
-type DeleteMemberAttributeRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+type ListMyBriefingsRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	PageSize  int32                  `protobuf:"varint,1,opt,name=page_size,proto3" json:"page_size,omitempty"`
+	PageToken string                 `protobuf:"bytes,2,opt,name=page_token,proto3" json:"page_token,omitempty"`
+	// valid_only limits the result to briefings that have not expired.
+	ValidOnly     bool `protobuf:"varint,3,opt,name=valid_only,proto3" json:"valid_only,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteMemberAttributeRequest) Reset() {
-	*x = DeleteMemberAttributeRequest{}
-	mi := &file_ourspace_backend_proto_api_proto_msgTypes[17]
+func (x *ListMyBriefingsRequest) Reset() {
+	*x = ListMyBriefingsRequest{}
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[182]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteMemberAttributeRequest) String() string {
+func (x *ListMyBriefingsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteMemberAttributeRequest) ProtoMessage() {}
+func (*ListMyBriefingsRequest) ProtoMessage() {}
 
-func (x *DeleteMemberAttributeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ourspace_backend_proto_api_proto_msgTypes[17]
+func (x *ListMyBriefingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[182]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1432,119 +12394,109 @@ func (x *DeleteMemberAttributeRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteMemberAttributeRequest.ProtoReflect.Descriptor instead.
-func (*DeleteMemberAttributeRequest) Descriptor() ([]byte, []int) {
-	return file_ourspace_backend_proto_api_proto_rawDescGZIP(), []int{17}
+// Deprecated: Use ListMyBriefingsRequest.ProtoReflect.Descriptor instead.
+func (*ListMyBriefingsRequest) Descriptor() ([]byte, []int) {
+	return file_ourspace_backend_proto_api_proto_rawDescGZIP(), []int{182}
 }
 
-func (x *DeleteMemberAttributeRequest) GetId() string {
+func (x *ListMyBriefingsRequest) GetPageSize() int32 {
 	if x != nil {
-		return x.Id
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListMyBriefingsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
 	}
 	return ""
 }
 
-type MemberAttribute struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	TechnicalName string                 `protobuf:"bytes,2,opt,name=technical_name,proto3" json:"technical_name,omitempty"`
-	DisplayName   string                 `protobuf:"bytes,3,opt,name=display_name,proto3" json:"display_name,omitempty"`
-	Type          MemberAttribute_Type   `protobuf:"varint,4,opt,name=type,proto3,enum=ourspace_backend.proto.MemberAttribute_Type" json:"type,omitempty"`
-	Description   string                 `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
+func (x *ListMyBriefingsRequest) GetValidOnly() bool {
+	if x != nil {
+		return x.ValidOnly
+	}
+	return false
+}
+
+type CreateMyChangeRequestRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Member *Member                `protobuf:"bytes,1,opt,name=member,proto3" json:"member,omitempty"`
+	// field_mask may only contain the fields and additional_attributes.<technical_name> paths that are configured to
+	// need approval.
+	FieldMask     *fieldmaskpb.FieldMask `protobuf:"bytes,2,opt,name=field_mask,proto3" json:"field_mask,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *MemberAttribute) Reset() {
-	*x = MemberAttribute{}
-	mi := &file_ourspace_backend_proto_api_proto_msgTypes[18]
+func (x *CreateMyChangeRequestRequest) Reset() {
+	*x = CreateMyChangeRequestRequest{}
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[183]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *MemberAttribute) String() string {
+func (x *CreateMyChangeRequestRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MemberAttribute) ProtoMessage() {}
+func (*CreateMyChangeRequestRequest) ProtoMessage() {}
 
-func (x *MemberAttribute) ProtoReflect() protoreflect.Message {
-	mi := &file_ourspace_backend_proto_api_proto_msgTypes[18]
+func (x *CreateMyChangeRequestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[183]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
 		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use MemberAttribute.ProtoReflect.Descriptor instead.
-func (*MemberAttribute) Descriptor() ([]byte, []int) {
-	return file_ourspace_backend_proto_api_proto_rawDescGZIP(), []int{18}
-}
-
-func (x *MemberAttribute) GetId() string {
-	if x != nil {
-		return x.Id
-	}
-	return ""
-}
-
-func (x *MemberAttribute) GetTechnicalName() string {
-	if x != nil {
-		return x.TechnicalName
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *MemberAttribute) GetDisplayName() string {
-	if x != nil {
-		return x.DisplayName
-	}
-	return ""
+// Deprecated: Use CreateMyChangeRequestRequest.ProtoReflect.Descriptor instead.
+func (*CreateMyChangeRequestRequest) Descriptor() ([]byte, []int) {
+	return file_ourspace_backend_proto_api_proto_rawDescGZIP(), []int{183}
 }
 
-func (x *MemberAttribute) GetType() MemberAttribute_Type {
+func (x *CreateMyChangeRequestRequest) GetMember() *Member {
 	if x != nil {
-		return x.Type
+		return x.Member
 	}
-	return MemberAttribute_TYPE_UNKNOWN
+	return nil
 }
 
-func (x *MemberAttribute) GetDescription() string {
+func (x *CreateMyChangeRequestRequest) GetFieldMask() *fieldmaskpb.FieldMask {
 	if x != nil {
-		return x.Description
+		return x.FieldMask
 	}
-	return ""
+	return nil
 }
 
-type MemberAttributePageToken struct {
+type ListMyChangeRequestsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Field         MemberAttributeField   `protobuf:"varint,1,opt,name=field,proto3,enum=ourspace_backend.proto.MemberAttributeField" json:"field,omitempty"`
-	LastValue     string                 `protobuf:"bytes,2,opt,name=last_value,proto3" json:"last_value,omitempty"`
-	Direction     SortDirection          `protobuf:"varint,3,opt,name=direction,proto3,enum=ourspace_backend.proto.SortDirection" json:"direction,omitempty"`
-	LastId        string                 `protobuf:"bytes,4,opt,name=last_id,proto3" json:"last_id,omitempty"`
+	PageSize      int32                  `protobuf:"varint,1,opt,name=page_size,proto3" json:"page_size,omitempty"`
+	PageToken     string                 `protobuf:"bytes,2,opt,name=page_token,proto3" json:"page_token,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *MemberAttributePageToken) Reset() {
-	*x = MemberAttributePageToken{}
-	mi := &file_ourspace_backend_proto_api_proto_msgTypes[19]
+func (x *ListMyChangeRequestsRequest) Reset() {
+	*x = ListMyChangeRequestsRequest{}
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[184]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *MemberAttributePageToken) String() string {
+func (x *ListMyChangeRequestsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MemberAttributePageToken) ProtoMessage() {}
+func (*ListMyChangeRequestsRequest) ProtoMessage() {}
 
-func (x *MemberAttributePageToken) ProtoReflect() protoreflect.Message {
-	mi := &file_ourspace_backend_proto_api_proto_msgTypes[19]
+func (x *ListMyChangeRequestsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[184]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1555,65 +12507,51 @@ func (x *MemberAttributePageToken) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MemberAttributePageToken.ProtoReflect.Descriptor instead.
-func (*MemberAttributePageToken) Descriptor() ([]byte, []int) {
-	return file_ourspace_backend_proto_api_proto_rawDescGZIP(), []int{19}
-}
-
-func (x *MemberAttributePageToken) GetField() MemberAttributeField {
-	if x != nil {
-		return x.Field
-	}
-	return MemberAttributeField_MEMBER_ATTRIBUTE_FIELD_UNKNOWN
-}
-
-func (x *MemberAttributePageToken) GetLastValue() string {
-	if x != nil {
-		return x.LastValue
-	}
-	return ""
+// Deprecated: Use ListMyChangeRequestsRequest.ProtoReflect.Descriptor instead.
+func (*ListMyChangeRequestsRequest) Descriptor() ([]byte, []int) {
+	return file_ourspace_backend_proto_api_proto_rawDescGZIP(), []int{184}
 }
 
-func (x *MemberAttributePageToken) GetDirection() SortDirection {
+func (x *ListMyChangeRequestsRequest) GetPageSize() int32 {
 	if x != nil {
-		return x.Direction
+		return x.PageSize
 	}
-	return SortDirection_SORT_DIRECTION_DEFAULT
+	return 0
 }
 
-func (x *MemberAttributePageToken) GetLastId() string {
+func (x *ListMyChangeRequestsRequest) GetPageToken() string {
 	if x != nil {
-		return x.LastId
+		return x.PageToken
 	}
 	return ""
 }
 
-type Card struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	MemberId      string                 `protobuf:"bytes,2,opt,name=member_id,proto3" json:"member_id,omitempty"`
-	RfidValue     []byte                 `protobuf:"bytes,3,opt,name=rfid_value,proto3" json:"rfid_value,omitempty"`
-	ValidFrom     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=valid_from,proto3" json:"valid_from,omitempty"`
-	ValidTo       *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=valid_to,proto3" json:"valid_to,omitempty"`
+type GetPresenceReportRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	StartTime *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=start_time,proto3" json:"start_time,omitempty"`
+	EndTime   *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=end_time,proto3" json:"end_time,omitempty"`
+	Bucket    ReportBucket           `protobuf:"varint,3,opt,name=bucket,proto3,enum=ourspace_backend.proto.ReportBucket" json:"bucket,omitempty"`
+	// time_zone is the IANA time zone used to determine the bucket boundaries, defaults to UTC.
+	TimeZone      string `protobuf:"bytes,4,opt,name=time_zone,proto3" json:"time_zone,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Card) Reset() {
-	*x = Card{}
-	mi := &file_ourspace_backend_proto_api_proto_msgTypes[20]
+func (x *GetPresenceReportRequest) Reset() {
+	*x = GetPresenceReportRequest{}
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[185]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Card) String() string {
+func (x *GetPresenceReportRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Card) ProtoMessage() {}
+func (*GetPresenceReportRequest) ProtoMessage() {}
 
-func (x *Card) ProtoReflect() protoreflect.Message {
-	mi := &file_ourspace_backend_proto_api_proto_msgTypes[20]
+func (x *GetPresenceReportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[185]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1624,71 +12562,66 @@ func (x *Card) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Card.ProtoReflect.Descriptor instead.
-func (*Card) Descriptor() ([]byte, []int) {
-	return file_ourspace_backend_proto_api_proto_rawDescGZIP(), []int{20}
-}
-
-func (x *Card) GetId() string {
-	if x != nil {
-		return x.Id
-	}
-	return ""
+// Deprecated: Use GetPresenceReportRequest.ProtoReflect.Descriptor instead.
+func (*GetPresenceReportRequest) Descriptor() ([]byte, []int) {
+	return file_ourspace_backend_proto_api_proto_rawDescGZIP(), []int{185}
 }
 
-func (x *Card) GetMemberId() string {
+func (x *GetPresenceReportRequest) GetStartTime() *timestamppb.Timestamp {
 	if x != nil {
-		return x.MemberId
+		return x.StartTime
 	}
-	return ""
+	return nil
 }
 
-func (x *Card) GetRfidValue() []byte {
+func (x *GetPresenceReportRequest) GetEndTime() *timestamppb.Timestamp {
 	if x != nil {
-		return x.RfidValue
+		return x.EndTime
 	}
 	return nil
 }
 
-func (x *Card) GetValidFrom() *timestamppb.Timestamp {
+func (x *GetPresenceReportRequest) GetBucket() ReportBucket {
 	if x != nil {
-		return x.ValidFrom
+		return x.Bucket
 	}
-	return nil
+	return ReportBucket_REPORT_BUCKET_UNKNOWN
 }
 
-func (x *Card) GetValidTo() *timestamppb.Timestamp {
+func (x *GetPresenceReportRequest) GetTimeZone() string {
 	if x != nil {
-		return x.ValidTo
+		return x.TimeZone
 	}
-	return nil
+	return ""
 }
 
-type CardPageToken struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Field         CardField              `protobuf:"varint,1,opt,name=field,proto3,enum=ourspace_backend.proto.CardField" json:"field,omitempty"`
-	LastValue     string                 `protobuf:"bytes,2,opt,name=last_value,proto3" json:"last_value,omitempty"`
-	Direction     SortDirection          `protobuf:"varint,3,opt,name=direction,proto3,enum=ourspace_backend.proto.SortDirection" json:"direction,omitempty"`
-	LastId        string                 `protobuf:"bytes,4,opt,name=last_id,proto3" json:"last_id,omitempty"`
+type PresenceReport struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	StartTime *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=start_time,proto3" json:"start_time,omitempty"`
+	EndTime   *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=end_time,proto3" json:"end_time,omitempty"`
+	Bucket    ReportBucket           `protobuf:"varint,3,opt,name=bucket,proto3,enum=ourspace_backend.proto.ReportBucket" json:"bucket,omitempty"`
+	Buckets   []*PresenceStatistics  `protobuf:"bytes,4,rep,name=buckets,proto3" json:"buckets,omitempty"`
+	// total contains the statistics over the whole time range.
+	Total         *PresenceStatistics `protobuf:"bytes,5,opt,name=total,proto3" json:"total,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CardPageToken) Reset() {
-	*x = CardPageToken{}
-	mi := &file_ourspace_backend_proto_api_proto_msgTypes[21]
+func (x *PresenceReport) Reset() {
+	*x = PresenceReport{}
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[186]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CardPageToken) String() string {
+func (x *PresenceReport) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CardPageToken) ProtoMessage() {}
+func (*PresenceReport) ProtoMessage() {}
 
-func (x *CardPageToken) ProtoReflect() protoreflect.Message {
-	mi := &file_ourspace_backend_proto_api_proto_msgTypes[21]
+func (x *PresenceReport) ProtoReflect() protoreflect.Message {
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[186]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1699,62 +12632,75 @@ func (x *CardPageToken) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CardPageToken.ProtoReflect.Descriptor instead.
-func (*CardPageToken) Descriptor() ([]byte, []int) {
-	return file_ourspace_backend_proto_api_proto_rawDescGZIP(), []int{21}
+// Deprecated: Use PresenceReport.ProtoReflect.Descriptor instead.
+func (*PresenceReport) Descriptor() ([]byte, []int) {
+	return file_ourspace_backend_proto_api_proto_rawDescGZIP(), []int{186}
 }
 
-func (x *CardPageToken) GetField() CardField {
+func (x *PresenceReport) GetStartTime() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Field
+		return x.StartTime
 	}
-	return CardField_CARD_FIELD_UNKNOWN
+	return nil
 }
 
-func (x *CardPageToken) GetLastValue() string {
+func (x *PresenceReport) GetEndTime() *timestamppb.Timestamp {
 	if x != nil {
-		return x.LastValue
+		return x.EndTime
 	}
-	return ""
+	return nil
 }
 
-func (x *CardPageToken) GetDirection() SortDirection {
+func (x *PresenceReport) GetBucket() ReportBucket {
 	if x != nil {
-		return x.Direction
+		return x.Bucket
 	}
-	return SortDirection_SORT_DIRECTION_DEFAULT
+	return ReportBucket_REPORT_BUCKET_UNKNOWN
 }
 
-func (x *CardPageToken) GetLastId() string {
+func (x *PresenceReport) GetBuckets() []*PresenceStatistics {
 	if x != nil {
-		return x.LastId
+		return x.Buckets
 	}
-	return ""
+	return nil
 }
 
-type CreateCardRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	CardId        string                 `protobuf:"bytes,1,opt,name=card_id,proto3" json:"card_id,omitempty"`
-	Card          *Card                  `protobuf:"bytes,2,opt,name=card,proto3" json:"card,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *PresenceReport) GetTotal() *PresenceStatistics {
+	if x != nil {
+		return x.Total
+	}
+	return nil
 }
 
-func (x *CreateCardRequest) Reset() {
-	*x = CreateCardRequest{}
-	mi := &file_ourspace_backend_proto_api_proto_msgTypes[22]
+type PresenceStatistics struct {
+	state          protoimpl.MessageState   `protogen:"open.v1"`
+	StartTime      *timestamppb.Timestamp   `protobuf:"bytes,1,opt,name=start_time,proto3" json:"start_time,omitempty"`
+	EndTime        *timestamppb.Timestamp   `protobuf:"bytes,2,opt,name=end_time,proto3" json:"end_time,omitempty"`
+	UniqueVisitors int64                    `protobuf:"varint,3,opt,name=unique_visitors,proto3" json:"unique_visitors,omitempty"`
+	Visits         int64                    `protobuf:"varint,4,opt,name=visits,proto3" json:"visits,omitempty"`
+	TotalHours     float64                  `protobuf:"fixed64,5,opt,name=total_hours,proto3" json:"total_hours,omitempty"`
+	PeakOccupancy  int64                    `protobuf:"varint,6,opt,name=peak_occupancy,proto3" json:"peak_occupancy,omitempty"`
+	AgeCategories  []*AgeCategoryStatistics `protobuf:"bytes,7,rep,name=age_categories,proto3" json:"age_categories,omitempty"`
+	Tags           []*TagStatistics         `protobuf:"bytes,8,rep,name=tags,proto3" json:"tags,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *PresenceStatistics) Reset() {
+	*x = PresenceStatistics{}
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[187]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateCardRequest) String() string {
+func (x *PresenceStatistics) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateCardRequest) ProtoMessage() {}
+func (*PresenceStatistics) ProtoMessage() {}
 
-func (x *CreateCardRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ourspace_backend_proto_api_proto_msgTypes[22]
+func (x *PresenceStatistics) ProtoReflect() protoreflect.Message {
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[187]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1765,97 +12711,92 @@ func (x *CreateCardRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateCardRequest.ProtoReflect.Descriptor instead.
-func (*CreateCardRequest) Descriptor() ([]byte, []int) {
-	return file_ourspace_backend_proto_api_proto_rawDescGZIP(), []int{22}
+// Deprecated: Use PresenceStatistics.ProtoReflect.Descriptor instead.
+func (*PresenceStatistics) Descriptor() ([]byte, []int) {
+	return file_ourspace_backend_proto_api_proto_rawDescGZIP(), []int{187}
 }
 
-func (x *CreateCardRequest) GetCardId() string {
+func (x *PresenceStatistics) GetStartTime() *timestamppb.Timestamp {
 	if x != nil {
-		return x.CardId
+		return x.StartTime
 	}
-	return ""
+	return nil
 }
 
-func (x *CreateCardRequest) GetCard() *Card {
+func (x *PresenceStatistics) GetEndTime() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Card
+		return x.EndTime
 	}
 	return nil
 }
 
-type GetCardRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *PresenceStatistics) GetUniqueVisitors() int64 {
+	if x != nil {
+		return x.UniqueVisitors
+	}
+	return 0
 }
 
-func (x *GetCardRequest) Reset() {
-	*x = GetCardRequest{}
-	mi := &file_ourspace_backend_proto_api_proto_msgTypes[23]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *PresenceStatistics) GetVisits() int64 {
+	if x != nil {
+		return x.Visits
+	}
+	return 0
 }
 
-func (x *GetCardRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *PresenceStatistics) GetTotalHours() float64 {
+	if x != nil {
+		return x.TotalHours
+	}
+	return 0
 }
 
-func (*GetCardRequest) ProtoMessage() {}
-
-func (x *GetCardRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ourspace_backend_proto_api_proto_msgTypes[23]
+func (x *PresenceStatistics) GetPeakOccupancy() int64 {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.PeakOccupancy
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use GetCardRequest.ProtoReflect.Descriptor instead.
-func (*GetCardRequest) Descriptor() ([]byte, []int) {
-	return file_ourspace_backend_proto_api_proto_rawDescGZIP(), []int{23}
+func (x *PresenceStatistics) GetAgeCategories() []*AgeCategoryStatistics {
+	if x != nil {
+		return x.AgeCategories
+	}
+	return nil
 }
 
-func (x *GetCardRequest) GetId() string {
+func (x *PresenceStatistics) GetTags() []*TagStatistics {
 	if x != nil {
-		return x.Id
+		return x.Tags
 	}
-	return ""
+	return nil
 }
 
-type ListCardsRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	PageSize      int32                  `protobuf:"varint,1,opt,name=page_size,proto3" json:"page_size,omitempty"`
-	PageToken     string                 `protobuf:"bytes,2,opt,name=page_token,proto3" json:"page_token,omitempty"`
-	SortBy        CardField              `protobuf:"varint,3,opt,name=sort_by,proto3,enum=ourspace_backend.proto.CardField" json:"sort_by,omitempty"`
-	SortDirection SortDirection          `protobuf:"varint,4,opt,name=sort_direction,proto3,enum=ourspace_backend.proto.SortDirection" json:"sort_direction,omitempty"`
-	MemberId      string                 `protobuf:"bytes,5,opt,name=member_id,proto3" json:"member_id,omitempty"`
-	ValidOn       *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=valid_on,proto3" json:"valid_on,omitempty"`
-	RfidValue     []byte                 `protobuf:"bytes,7,opt,name=rfid_value,proto3" json:"rfid_value,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type AgeCategoryStatistics struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	AgeCategory    AgeCategory            `protobuf:"varint,1,opt,name=age_category,proto3,enum=ourspace_backend.proto.AgeCategory" json:"age_category,omitempty"`
+	UniqueVisitors int64                  `protobuf:"varint,2,opt,name=unique_visitors,proto3" json:"unique_visitors,omitempty"`
+	Visits         int64                  `protobuf:"varint,3,opt,name=visits,proto3" json:"visits,omitempty"`
+	TotalHours     float64                `protobuf:"fixed64,4,opt,name=total_hours,proto3" json:"total_hours,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
-func (x *ListCardsRequest) Reset() {
-	*x = ListCardsRequest{}
-	mi := &file_ourspace_backend_proto_api_proto_msgTypes[24]
+func (x *AgeCategoryStatistics) Reset() {
+	*x = AgeCategoryStatistics{}
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[188]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListCardsRequest) String() string {
+func (x *AgeCategoryStatistics) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListCardsRequest) ProtoMessage() {}
+func (*AgeCategoryStatistics) ProtoMessage() {}
 
-func (x *ListCardsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ourspace_backend_proto_api_proto_msgTypes[24]
+func (x *AgeCategoryStatistics) ProtoReflect() protoreflect.Message {
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[188]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1866,83 +12807,64 @@ func (x *ListCardsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListCardsRequest.ProtoReflect.Descriptor instead.
-func (*ListCardsRequest) Descriptor() ([]byte, []int) {
-	return file_ourspace_backend_proto_api_proto_rawDescGZIP(), []int{24}
-}
-
-func (x *ListCardsRequest) GetPageSize() int32 {
-	if x != nil {
-		return x.PageSize
-	}
-	return 0
-}
-
-func (x *ListCardsRequest) GetPageToken() string {
-	if x != nil {
-		return x.PageToken
-	}
-	return ""
-}
-
-func (x *ListCardsRequest) GetSortBy() CardField {
-	if x != nil {
-		return x.SortBy
-	}
-	return CardField_CARD_FIELD_UNKNOWN
+// Deprecated: Use AgeCategoryStatistics.ProtoReflect.Descriptor instead.
+func (*AgeCategoryStatistics) Descriptor() ([]byte, []int) {
+	return file_ourspace_backend_proto_api_proto_rawDescGZIP(), []int{188}
 }
 
-func (x *ListCardsRequest) GetSortDirection() SortDirection {
+func (x *AgeCategoryStatistics) GetAgeCategory() AgeCategory {
 	if x != nil {
-		return x.SortDirection
+		return x.AgeCategory
 	}
-	return SortDirection_SORT_DIRECTION_DEFAULT
+	return AgeCategory_AGE_CATEGORY_UNKNOWN
 }
 
-func (x *ListCardsRequest) GetMemberId() string {
+func (x *AgeCategoryStatistics) GetUniqueVisitors() int64 {
 	if x != nil {
-		return x.MemberId
+		return x.UniqueVisitors
 	}
-	return ""
+	return 0
 }
 
-func (x *ListCardsRequest) GetValidOn() *timestamppb.Timestamp {
+func (x *AgeCategoryStatistics) GetVisits() int64 {
 	if x != nil {
-		return x.ValidOn
+		return x.Visits
 	}
-	return nil
+	return 0
 }
 
-func (x *ListCardsRequest) GetRfidValue() []byte {
+func (x *AgeCategoryStatistics) GetTotalHours() float64 {
 	if x != nil {
-		return x.RfidValue
+		return x.TotalHours
 	}
-	return nil
+	return 0
 }
 
-type ListCardsResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Cards         []*Card                `protobuf:"bytes,1,rep,name=cards,proto3" json:"cards,omitempty"`
-	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,proto3" json:"next_page_token,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type TagStatistics struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Tag            string                 `protobuf:"bytes,1,opt,name=tag,proto3" json:"tag,omitempty"`
+	UniqueVisitors int64                  `protobuf:"varint,2,opt,name=unique_visitors,proto3" json:"unique_visitors,omitempty"`
+	Visits         int64                  `protobuf:"varint,3,opt,name=visits,proto3" json:"visits,omitempty"`
+	TotalHours     float64                `protobuf:"fixed64,4,opt,name=total_hours,proto3" json:"total_hours,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
-func (x *ListCardsResponse) Reset() {
-	*x = ListCardsResponse{}
-	mi := &file_ourspace_backend_proto_api_proto_msgTypes[25]
+func (x *TagStatistics) Reset() {
+	*x = TagStatistics{}
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[189]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListCardsResponse) String() string {
+func (x *TagStatistics) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListCardsResponse) ProtoMessage() {}
+func (*TagStatistics) ProtoMessage() {}
 
-func (x *ListCardsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ourspace_backend_proto_api_proto_msgTypes[25]
+func (x *TagStatistics) ProtoReflect() protoreflect.Message {
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[189]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1953,48 +12875,63 @@ func (x *ListCardsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListCardsResponse.ProtoReflect.Descriptor instead.
-func (*ListCardsResponse) Descriptor() ([]byte, []int) {
-	return file_ourspace_backend_proto_api_proto_rawDescGZIP(), []int{25}
+// Deprecated: Use TagStatistics.ProtoReflect.Descriptor instead.
+func (*TagStatistics) Descriptor() ([]byte, []int) {
+	return file_ourspace_backend_proto_api_proto_rawDescGZIP(), []int{189}
 }
 
-func (x *ListCardsResponse) GetCards() []*Card {
+func (x *TagStatistics) GetTag() string {
 	if x != nil {
-		return x.Cards
+		return x.Tag
 	}
-	return nil
+	return ""
 }
 
-func (x *ListCardsResponse) GetNextPageToken() string {
+func (x *TagStatistics) GetUniqueVisitors() int64 {
 	if x != nil {
-		return x.NextPageToken
+		return x.UniqueVisitors
 	}
-	return ""
+	return 0
 }
 
-type UpdateCardRequest struct {
+func (x *TagStatistics) GetVisits() int64 {
+	if x != nil {
+		return x.Visits
+	}
+	return 0
+}
+
+func (x *TagStatistics) GetTotalHours() float64 {
+	if x != nil {
+		return x.TotalHours
+	}
+	return 0
+}
+
+type GetMachineUsageReportRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Card          *Card                  `protobuf:"bytes,1,opt,name=card,proto3" json:"card,omitempty"`
-	FieldMask     *fieldmaskpb.FieldMask `protobuf:"bytes,2,opt,name=field_mask,proto3" json:"field_mask,omitempty"`
+	StartTime     *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=start_time,proto3" json:"start_time,omitempty"`
+	EndTime       *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=end_time,proto3" json:"end_time,omitempty"`
+	GroupBy       UsageReportGrouping    `protobuf:"varint,3,opt,name=group_by,proto3,enum=ourspace_backend.proto.UsageReportGrouping" json:"group_by,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateCardRequest) Reset() {
-	*x = UpdateCardRequest{}
-	mi := &file_ourspace_backend_proto_api_proto_msgTypes[26]
+func (x *GetMachineUsageReportRequest) Reset() {
+	*x = GetMachineUsageReportRequest{}
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[190]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateCardRequest) String() string {
+func (x *GetMachineUsageReportRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateCardRequest) ProtoMessage() {}
+func (*GetMachineUsageReportRequest) ProtoMessage() {}
 
-func (x *UpdateCardRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ourspace_backend_proto_api_proto_msgTypes[26]
+func (x *GetMachineUsageReportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[190]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2005,47 +12942,60 @@ func (x *UpdateCardRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateCardRequest.ProtoReflect.Descriptor instead.
-func (*UpdateCardRequest) Descriptor() ([]byte, []int) {
-	return file_ourspace_backend_proto_api_proto_rawDescGZIP(), []int{26}
+// Deprecated: Use GetMachineUsageReportRequest.ProtoReflect.Descriptor instead.
+func (*GetMachineUsageReportRequest) Descriptor() ([]byte, []int) {
+	return file_ourspace_backend_proto_api_proto_rawDescGZIP(), []int{190}
 }
 
-func (x *UpdateCardRequest) GetCard() *Card {
+func (x *GetMachineUsageReportRequest) GetStartTime() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Card
+		return x.StartTime
 	}
 	return nil
 }
 
-func (x *UpdateCardRequest) GetFieldMask() *fieldmaskpb.FieldMask {
+func (x *GetMachineUsageReportRequest) GetEndTime() *timestamppb.Timestamp {
 	if x != nil {
-		return x.FieldMask
+		return x.EndTime
 	}
 	return nil
 }
 
-type DeleteCardRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+func (x *GetMachineUsageReportRequest) GetGroupBy() UsageReportGrouping {
+	if x != nil {
+		return x.GroupBy
+	}
+	return UsageReportGrouping_USAGE_REPORT_GROUPING_UNKNOWN
+}
+
+type MachineUsageReport struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	StartTime *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=start_time,proto3" json:"start_time,omitempty"`
+	EndTime   *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=end_time,proto3" json:"end_time,omitempty"`
+	GroupBy   UsageReportGrouping    `protobuf:"varint,3,opt,name=group_by,proto3,enum=ourspace_backend.proto.UsageReportGrouping" json:"group_by,omitempty"`
+	// entries contain one entry per member or machine, depending on group_by. Sessions count towards the time range
+	// they started in.
+	Entries       []*UsageStatistics `protobuf:"bytes,4,rep,name=entries,proto3" json:"entries,omitempty"`
+	Total         *UsageStatistics   `protobuf:"bytes,5,opt,name=total,proto3" json:"total,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteCardRequest) Reset() {
-	*x = DeleteCardRequest{}
-	mi := &file_ourspace_backend_proto_api_proto_msgTypes[27]
+func (x *MachineUsageReport) Reset() {
+	*x = MachineUsageReport{}
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[191]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteCardRequest) String() string {
+func (x *MachineUsageReport) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteCardRequest) ProtoMessage() {}
+func (*MachineUsageReport) ProtoMessage() {}
 
-func (x *DeleteCardRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ourspace_backend_proto_api_proto_msgTypes[27]
+func (x *MachineUsageReport) ProtoReflect() protoreflect.Message {
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[191]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2056,43 +13006,75 @@ func (x *DeleteCardRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteCardRequest.ProtoReflect.Descriptor instead.
-func (*DeleteCardRequest) Descriptor() ([]byte, []int) {
-	return file_ourspace_backend_proto_api_proto_rawDescGZIP(), []int{27}
+// Deprecated: Use MachineUsageReport.ProtoReflect.Descriptor instead.
+func (*MachineUsageReport) Descriptor() ([]byte, []int) {
+	return file_ourspace_backend_proto_api_proto_rawDescGZIP(), []int{191}
 }
 
-func (x *DeleteCardRequest) GetId() string {
+func (x *MachineUsageReport) GetStartTime() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Id
+		return x.StartTime
 	}
-	return ""
+	return nil
 }
 
-type BriefingType struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	DisplayName   string                 `protobuf:"bytes,2,opt,name=display_name,proto3" json:"display_name,omitempty"`
-	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
-	ExpiresAfter  *durationpb.Duration   `protobuf:"bytes,4,opt,name=expires_after,proto3" json:"expires_after,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *MachineUsageReport) GetEndTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndTime
+	}
+	return nil
 }
 
-func (x *BriefingType) Reset() {
-	*x = BriefingType{}
-	mi := &file_ourspace_backend_proto_api_proto_msgTypes[28]
+func (x *MachineUsageReport) GetGroupBy() UsageReportGrouping {
+	if x != nil {
+		return x.GroupBy
+	}
+	return UsageReportGrouping_USAGE_REPORT_GROUPING_UNKNOWN
+}
+
+func (x *MachineUsageReport) GetEntries() []*UsageStatistics {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+func (x *MachineUsageReport) GetTotal() *UsageStatistics {
+	if x != nil {
+		return x.Total
+	}
+	return nil
+}
+
+type UsageStatistics struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// member_id is set when grouping by member.
+	MemberId string `protobuf:"bytes,1,opt,name=member_id,proto3" json:"member_id,omitempty"`
+	// machine_id is set when grouping by machine.
+	MachineId string `protobuf:"bytes,2,opt,name=machine_id,proto3" json:"machine_id,omitempty"`
+	Sessions  int64  `protobuf:"varint,3,opt,name=sessions,proto3" json:"sessions,omitempty"`
+	// total_minutes counts every started minute of a session.
+	TotalMinutes    int64 `protobuf:"varint,4,opt,name=total_minutes,proto3" json:"total_minutes,omitempty"`
+	TotalPriceCents int64 `protobuf:"varint,5,opt,name=total_price_cents,proto3" json:"total_price_cents,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *UsageStatistics) Reset() {
+	*x = UsageStatistics{}
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[192]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *BriefingType) String() string {
+func (x *UsageStatistics) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BriefingType) ProtoMessage() {}
+func (*UsageStatistics) ProtoMessage() {}
 
-func (x *BriefingType) ProtoReflect() protoreflect.Message {
-	mi := &file_ourspace_backend_proto_api_proto_msgTypes[28]
+func (x *UsageStatistics) ProtoReflect() protoreflect.Message {
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[192]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2103,62 +13085,84 @@ func (x *BriefingType) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BriefingType.ProtoReflect.Descriptor instead.
-func (*BriefingType) Descriptor() ([]byte, []int) {
-	return file_ourspace_backend_proto_api_proto_rawDescGZIP(), []int{28}
+// Deprecated: Use UsageStatistics.ProtoReflect.Descriptor instead.
+func (*UsageStatistics) Descriptor() ([]byte, []int) {
+	return file_ourspace_backend_proto_api_proto_rawDescGZIP(), []int{192}
 }
 
-func (x *BriefingType) GetId() string {
+func (x *UsageStatistics) GetMemberId() string {
 	if x != nil {
-		return x.Id
+		return x.MemberId
 	}
 	return ""
 }
 
-func (x *BriefingType) GetDisplayName() string {
+func (x *UsageStatistics) GetMachineId() string {
 	if x != nil {
-		return x.DisplayName
+		return x.MachineId
 	}
 	return ""
 }
 
-func (x *BriefingType) GetDescription() string {
+func (x *UsageStatistics) GetSessions() int64 {
 	if x != nil {
-		return x.Description
+		return x.Sessions
 	}
-	return ""
+	return 0
 }
 
-func (x *BriefingType) GetExpiresAfter() *durationpb.Duration {
+func (x *UsageStatistics) GetTotalMinutes() int64 {
 	if x != nil {
-		return x.ExpiresAfter
+		return x.TotalMinutes
 	}
-	return nil
+	return 0
 }
 
-type CreateBriefingTypeRequest struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	BriefingTypeId string                 `protobuf:"bytes,1,opt,name=briefing_type_id,proto3" json:"briefing_type_id,omitempty"`
-	BriefingType   *BriefingType          `protobuf:"bytes,2,opt,name=briefing_type,proto3" json:"briefing_type,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+func (x *UsageStatistics) GetTotalPriceCents() int64 {
+	if x != nil {
+		return x.TotalPriceCents
+	}
+	return 0
 }
 
-func (x *CreateBriefingTypeRequest) Reset() {
-	*x = CreateBriefingTypeRequest{}
-	mi := &file_ourspace_backend_proto_api_proto_msgTypes[29]
+// Application is an online membership application that has to be accepted by staff.
+type Application struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name  string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Email string                 `protobuf:"bytes,3,opt,name=email,proto3" json:"email,omitempty"`
+	Phone string                 `protobuf:"bytes,4,opt,name=phone,proto3" json:"phone,omitempty"`
+	// birth_date is the date of birth as YYYY-MM-DD.
+	BirthDate *string `protobuf:"bytes,5,opt,name=birth_date,proto3,oneof" json:"birth_date,omitempty"`
+	// message of the applicant to the staff.
+	Message    string                 `protobuf:"bytes,6,opt,name=message,proto3" json:"message,omitempty"`
+	State      Application_State      `protobuf:"varint,7,opt,name=state,proto3,enum=ourspace_backend.proto.Application_State" json:"state,omitempty"`
+	CreateTime *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=create_time,proto3" json:"create_time,omitempty"`
+	DecideTime *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=decide_time,proto3" json:"decide_time,omitempty"`
+	// decided_by is the username of the staff member who accepted or rejected the application.
+	DecidedBy string `protobuf:"bytes,10,opt,name=decided_by,proto3" json:"decided_by,omitempty"`
+	Comment   string `protobuf:"bytes,11,opt,name=comment,proto3" json:"comment,omitempty"`
+	// member_id is set once the application is accepted.
+	MemberId      *string `protobuf:"bytes,12,opt,name=member_id,proto3,oneof" json:"member_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Application) Reset() {
+	*x = Application{}
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[193]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateBriefingTypeRequest) String() string {
+func (x *Application) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateBriefingTypeRequest) ProtoMessage() {}
+func (*Application) ProtoMessage() {}
 
-func (x *CreateBriefingTypeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ourspace_backend_proto_api_proto_msgTypes[29]
+func (x *Application) ProtoReflect() protoreflect.Message {
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[193]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2169,92 +13173,124 @@ func (x *CreateBriefingTypeRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateBriefingTypeRequest.ProtoReflect.Descriptor instead.
-func (*CreateBriefingTypeRequest) Descriptor() ([]byte, []int) {
-	return file_ourspace_backend_proto_api_proto_rawDescGZIP(), []int{29}
+// Deprecated: Use Application.ProtoReflect.Descriptor instead.
+func (*Application) Descriptor() ([]byte, []int) {
+	return file_ourspace_backend_proto_api_proto_rawDescGZIP(), []int{193}
 }
 
-func (x *CreateBriefingTypeRequest) GetBriefingTypeId() string {
+func (x *Application) GetId() string {
 	if x != nil {
-		return x.BriefingTypeId
+		return x.Id
 	}
 	return ""
 }
 
-func (x *CreateBriefingTypeRequest) GetBriefingType() *BriefingType {
+func (x *Application) GetName() string {
 	if x != nil {
-		return x.BriefingType
+		return x.Name
 	}
-	return nil
+	return ""
 }
 
-type GetBriefingTypeRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *Application) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
 }
 
-func (x *GetBriefingTypeRequest) Reset() {
-	*x = GetBriefingTypeRequest{}
-	mi := &file_ourspace_backend_proto_api_proto_msgTypes[30]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *Application) GetPhone() string {
+	if x != nil {
+		return x.Phone
+	}
+	return ""
 }
 
-func (x *GetBriefingTypeRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *Application) GetBirthDate() string {
+	if x != nil && x.BirthDate != nil {
+		return *x.BirthDate
+	}
+	return ""
 }
 
-func (*GetBriefingTypeRequest) ProtoMessage() {}
+func (x *Application) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
 
-func (x *GetBriefingTypeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ourspace_backend_proto_api_proto_msgTypes[30]
+func (x *Application) GetState() Application_State {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.State
 	}
-	return mi.MessageOf(x)
+	return Application_STATE_UNKNOWN
 }
 
-// Deprecated: Use GetBriefingTypeRequest.ProtoReflect.Descriptor instead.
-func (*GetBriefingTypeRequest) Descriptor() ([]byte, []int) {
-	return file_ourspace_backend_proto_api_proto_rawDescGZIP(), []int{30}
+func (x *Application) GetCreateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreateTime
+	}
+	return nil
 }
 
-func (x *GetBriefingTypeRequest) GetId() string {
+func (x *Application) GetDecideTime() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Id
+		return x.DecideTime
+	}
+	return nil
+}
+
+func (x *Application) GetDecidedBy() string {
+	if x != nil {
+		return x.DecidedBy
 	}
 	return ""
 }
 
-type ListBriefingTypesRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	PageSize      int32                  `protobuf:"varint,1,opt,name=page_size,proto3" json:"page_size,omitempty"`
-	PageToken     string                 `protobuf:"bytes,2,opt,name=page_token,proto3" json:"page_token,omitempty"`
+func (x *Application) GetComment() string {
+	if x != nil {
+		return x.Comment
+	}
+	return ""
+}
+
+func (x *Application) GetMemberId() string {
+	if x != nil && x.MemberId != nil {
+		return *x.MemberId
+	}
+	return ""
+}
+
+type SubmitApplicationRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Name      string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Email     string                 `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
+	Phone     string                 `protobuf:"bytes,3,opt,name=phone,proto3" json:"phone,omitempty"`
+	BirthDate *string                `protobuf:"bytes,4,opt,name=birth_date,proto3,oneof" json:"birth_date,omitempty"`
+	Message   string                 `protobuf:"bytes,5,opt,name=message,proto3" json:"message,omitempty"`
+	// website is a honeypot, forms must hide it from humans and leave it empty. Applications with a website are
+	// silently dropped.
+	Website       string `protobuf:"bytes,6,opt,name=website,proto3" json:"website,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListBriefingTypesRequest) Reset() {
-	*x = ListBriefingTypesRequest{}
-	mi := &file_ourspace_backend_proto_api_proto_msgTypes[31]
+func (x *SubmitApplicationRequest) Reset() {
+	*x = SubmitApplicationRequest{}
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[194]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListBriefingTypesRequest) String() string {
+func (x *SubmitApplicationRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListBriefingTypesRequest) ProtoMessage() {}
+func (*SubmitApplicationRequest) ProtoMessage() {}
 
-func (x *ListBriefingTypesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ourspace_backend_proto_api_proto_msgTypes[31]
+func (x *SubmitApplicationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[194]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2265,100 +13301,78 @@ func (x *ListBriefingTypesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListBriefingTypesRequest.ProtoReflect.Descriptor instead.
-func (*ListBriefingTypesRequest) Descriptor() ([]byte, []int) {
-	return file_ourspace_backend_proto_api_proto_rawDescGZIP(), []int{31}
+// Deprecated: Use SubmitApplicationRequest.ProtoReflect.Descriptor instead.
+func (*SubmitApplicationRequest) Descriptor() ([]byte, []int) {
+	return file_ourspace_backend_proto_api_proto_rawDescGZIP(), []int{194}
 }
 
-func (x *ListBriefingTypesRequest) GetPageSize() int32 {
+func (x *SubmitApplicationRequest) GetName() string {
 	if x != nil {
-		return x.PageSize
+		return x.Name
 	}
-	return 0
+	return ""
 }
 
-func (x *ListBriefingTypesRequest) GetPageToken() string {
+func (x *SubmitApplicationRequest) GetEmail() string {
 	if x != nil {
-		return x.PageToken
+		return x.Email
 	}
 	return ""
 }
-
-type ListBriefingTypesResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	BriefingTypes []*BriefingType        `protobuf:"bytes,1,rep,name=briefing_types,proto3" json:"briefing_types,omitempty"`
-	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,proto3" json:"next_page_token,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *ListBriefingTypesResponse) Reset() {
-	*x = ListBriefingTypesResponse{}
-	mi := &file_ourspace_backend_proto_api_proto_msgTypes[32]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *ListBriefingTypesResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*ListBriefingTypesResponse) ProtoMessage() {}
-
-func (x *ListBriefingTypesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ourspace_backend_proto_api_proto_msgTypes[32]
+
+func (x *SubmitApplicationRequest) GetPhone() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Phone
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use ListBriefingTypesResponse.ProtoReflect.Descriptor instead.
-func (*ListBriefingTypesResponse) Descriptor() ([]byte, []int) {
-	return file_ourspace_backend_proto_api_proto_rawDescGZIP(), []int{32}
+func (x *SubmitApplicationRequest) GetBirthDate() string {
+	if x != nil && x.BirthDate != nil {
+		return *x.BirthDate
+	}
+	return ""
 }
 
-func (x *ListBriefingTypesResponse) GetBriefingTypes() []*BriefingType {
+func (x *SubmitApplicationRequest) GetMessage() string {
 	if x != nil {
-		return x.BriefingTypes
+		return x.Message
 	}
-	return nil
+	return ""
 }
 
-func (x *ListBriefingTypesResponse) GetNextPageToken() string {
+func (x *SubmitApplicationRequest) GetWebsite() string {
 	if x != nil {
-		return x.NextPageToken
+		return x.Website
 	}
 	return ""
 }
 
-type UpdateBriefingTypeRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	BriefingType  *BriefingType          `protobuf:"bytes,1,opt,name=briefing_type,proto3" json:"briefing_type,omitempty"`
-	FieldMask     *fieldmaskpb.FieldMask `protobuf:"bytes,2,opt,name=field_mask,proto3" json:"field_mask,omitempty"`
+type ListApplicationsRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	PageSize  int32                  `protobuf:"varint,1,opt,name=page_size,proto3" json:"page_size,omitempty"`
+	PageToken string                 `protobuf:"bytes,2,opt,name=page_token,proto3" json:"page_token,omitempty"`
+	// state defaults to pending applications.
+	State         Application_State `protobuf:"varint,3,opt,name=state,proto3,enum=ourspace_backend.proto.Application_State" json:"state,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateBriefingTypeRequest) Reset() {
-	*x = UpdateBriefingTypeRequest{}
-	mi := &file_ourspace_backend_proto_api_proto_msgTypes[33]
+func (x *ListApplicationsRequest) Reset() {
+	*x = ListApplicationsRequest{}
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[195]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateBriefingTypeRequest) String() string {
+func (x *ListApplicationsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateBriefingTypeRequest) ProtoMessage() {}
+func (*ListApplicationsRequest) ProtoMessage() {}
 
-func (x *UpdateBriefingTypeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ourspace_backend_proto_api_proto_msgTypes[33]
+func (x *ListApplicationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[195]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2369,47 +13383,55 @@ func (x *UpdateBriefingTypeRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateBriefingTypeRequest.ProtoReflect.Descriptor instead.
-func (*UpdateBriefingTypeRequest) Descriptor() ([]byte, []int) {
-	return file_ourspace_backend_proto_api_proto_rawDescGZIP(), []int{33}
+// Deprecated: Use ListApplicationsRequest.ProtoReflect.Descriptor instead.
+func (*ListApplicationsRequest) Descriptor() ([]byte, []int) {
+	return file_ourspace_backend_proto_api_proto_rawDescGZIP(), []int{195}
 }
 
-func (x *UpdateBriefingTypeRequest) GetBriefingType() *BriefingType {
+func (x *ListApplicationsRequest) GetPageSize() int32 {
 	if x != nil {
-		return x.BriefingType
+		return x.PageSize
 	}
-	return nil
+	return 0
 }
 
-func (x *UpdateBriefingTypeRequest) GetFieldMask() *fieldmaskpb.FieldMask {
+func (x *ListApplicationsRequest) GetPageToken() string {
 	if x != nil {
-		return x.FieldMask
+		return x.PageToken
 	}
-	return nil
+	return ""
 }
 
-type DeleteBriefingTypeRequest struct {
+func (x *ListApplicationsRequest) GetState() Application_State {
+	if x != nil {
+		return x.State
+	}
+	return Application_STATE_UNKNOWN
+}
+
+type ListApplicationsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Applications  []*Application         `protobuf:"bytes,1,rep,name=applications,proto3" json:"applications,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,proto3" json:"next_page_token,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteBriefingTypeRequest) Reset() {
-	*x = DeleteBriefingTypeRequest{}
-	mi := &file_ourspace_backend_proto_api_proto_msgTypes[34]
+func (x *ListApplicationsResponse) Reset() {
+	*x = ListApplicationsResponse{}
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[196]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteBriefingTypeRequest) String() string {
+func (x *ListApplicationsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteBriefingTypeRequest) ProtoMessage() {}
+func (*ListApplicationsResponse) ProtoMessage() {}
 
-func (x *DeleteBriefingTypeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ourspace_backend_proto_api_proto_msgTypes[34]
+func (x *ListApplicationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[196]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2420,41 +13442,47 @@ func (x *DeleteBriefingTypeRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteBriefingTypeRequest.ProtoReflect.Descriptor instead.
-func (*DeleteBriefingTypeRequest) Descriptor() ([]byte, []int) {
-	return file_ourspace_backend_proto_api_proto_rawDescGZIP(), []int{34}
+// Deprecated: Use ListApplicationsResponse.ProtoReflect.Descriptor instead.
+func (*ListApplicationsResponse) Descriptor() ([]byte, []int) {
+	return file_ourspace_backend_proto_api_proto_rawDescGZIP(), []int{196}
 }
 
-func (x *DeleteBriefingTypeRequest) GetId() string {
+func (x *ListApplicationsResponse) GetApplications() []*Application {
 	if x != nil {
-		return x.Id
+		return x.Applications
+	}
+	return nil
+}
+
+func (x *ListApplicationsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
 	}
 	return ""
 }
 
-type Briefing struct {
+type ApplicationPageToken struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	BriefingType  string                 `protobuf:"bytes,2,opt,name=briefing_type,proto3" json:"briefing_type,omitempty"`
+	Offset        int32                  `protobuf:"varint,1,opt,name=offset,proto3" json:"offset,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Briefing) Reset() {
-	*x = Briefing{}
-	mi := &file_ourspace_backend_proto_api_proto_msgTypes[35]
+func (x *ApplicationPageToken) Reset() {
+	*x = ApplicationPageToken{}
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[197]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Briefing) String() string {
+func (x *ApplicationPageToken) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Briefing) ProtoMessage() {}
+func (*ApplicationPageToken) ProtoMessage() {}
 
-func (x *Briefing) ProtoReflect() protoreflect.Message {
-	mi := &file_ourspace_backend_proto_api_proto_msgTypes[35]
+func (x *ApplicationPageToken) ProtoReflect() protoreflect.Message {
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[197]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2465,48 +13493,49 @@ func (x *Briefing) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Briefing.ProtoReflect.Descriptor instead.
-func (*Briefing) Descriptor() ([]byte, []int) {
-	return file_ourspace_backend_proto_api_proto_rawDescGZIP(), []int{35}
-}
-
-func (x *Briefing) GetId() string {
-	if x != nil {
-		return x.Id
-	}
-	return ""
+// Deprecated: Use ApplicationPageToken.ProtoReflect.Descriptor instead.
+func (*ApplicationPageToken) Descriptor() ([]byte, []int) {
+	return file_ourspace_backend_proto_api_proto_rawDescGZIP(), []int{197}
 }
 
-func (x *Briefing) GetBriefingType() string {
+func (x *ApplicationPageToken) GetOffset() int32 {
 	if x != nil {
-		return x.BriefingType
+		return x.Offset
 	}
-	return ""
+	return 0
 }
 
-type CreateBriefingRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	BriefingId    string                 `protobuf:"bytes,1,opt,name=briefing_id,proto3" json:"briefing_id,omitempty"`
-	Briefing      *Briefing              `protobuf:"bytes,2,opt,name=briefing,proto3" json:"briefing,omitempty"`
+type AcceptApplicationRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Id              string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	MembershipStart *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=membership_start,proto3" json:"membership_start,omitempty"`
+	// age_category must be set if the applicant didn't give a birth date.
+	AgeCategory AgeCategory `protobuf:"varint,3,opt,name=age_category,proto3,enum=ourspace_backend.proto.AgeCategory" json:"age_category,omitempty"`
+	Tags        []string    `protobuf:"bytes,4,rep,name=tags,proto3" json:"tags,omitempty"`
+	// additional_attributes of the member, e.g. the email address of the application.
+	AdditionalAttributes map[string]string `protobuf:"bytes,5,rep,name=additional_attributes,proto3" json:"additional_attributes,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// relationships are created with the member, e.g. the guardian of an underage applicant.
+	Relationships []*MemberRelationship `protobuf:"bytes,6,rep,name=relationships,proto3" json:"relationships,omitempty"`
+	Comment       string                `protobuf:"bytes,7,opt,name=comment,proto3" json:"comment,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateBriefingRequest) Reset() {
-	*x = CreateBriefingRequest{}
-	mi := &file_ourspace_backend_proto_api_proto_msgTypes[36]
+func (x *AcceptApplicationRequest) Reset() {
+	*x = AcceptApplicationRequest{}
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[198]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateBriefingRequest) String() string {
+func (x *AcceptApplicationRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateBriefingRequest) ProtoMessage() {}
+func (*AcceptApplicationRequest) ProtoMessage() {}
 
-func (x *CreateBriefingRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ourspace_backend_proto_api_proto_msgTypes[36]
+func (x *AcceptApplicationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[198]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2517,92 +13546,83 @@ func (x *CreateBriefingRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateBriefingRequest.ProtoReflect.Descriptor instead.
-func (*CreateBriefingRequest) Descriptor() ([]byte, []int) {
-	return file_ourspace_backend_proto_api_proto_rawDescGZIP(), []int{36}
+// Deprecated: Use AcceptApplicationRequest.ProtoReflect.Descriptor instead.
+func (*AcceptApplicationRequest) Descriptor() ([]byte, []int) {
+	return file_ourspace_backend_proto_api_proto_rawDescGZIP(), []int{198}
 }
 
-func (x *CreateBriefingRequest) GetBriefingId() string {
+func (x *AcceptApplicationRequest) GetId() string {
 	if x != nil {
-		return x.BriefingId
+		return x.Id
 	}
 	return ""
 }
 
-func (x *CreateBriefingRequest) GetBriefing() *Briefing {
+func (x *AcceptApplicationRequest) GetMembershipStart() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Briefing
+		return x.MembershipStart
 	}
 	return nil
 }
 
-type GetBriefingRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *GetBriefingRequest) Reset() {
-	*x = GetBriefingRequest{}
-	mi := &file_ourspace_backend_proto_api_proto_msgTypes[37]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *AcceptApplicationRequest) GetAgeCategory() AgeCategory {
+	if x != nil {
+		return x.AgeCategory
+	}
+	return AgeCategory_AGE_CATEGORY_UNKNOWN
 }
 
-func (x *GetBriefingRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *AcceptApplicationRequest) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
 }
 
-func (*GetBriefingRequest) ProtoMessage() {}
-
-func (x *GetBriefingRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ourspace_backend_proto_api_proto_msgTypes[37]
+func (x *AcceptApplicationRequest) GetAdditionalAttributes() map[string]string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.AdditionalAttributes
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use GetBriefingRequest.ProtoReflect.Descriptor instead.
-func (*GetBriefingRequest) Descriptor() ([]byte, []int) {
-	return file_ourspace_backend_proto_api_proto_rawDescGZIP(), []int{37}
+func (x *AcceptApplicationRequest) GetRelationships() []*MemberRelationship {
+	if x != nil {
+		return x.Relationships
+	}
+	return nil
 }
 
-func (x *GetBriefingRequest) GetId() string {
+func (x *AcceptApplicationRequest) GetComment() string {
 	if x != nil {
-		return x.Id
+		return x.Comment
 	}
 	return ""
 }
 
-type ListBriefingsRequest struct {
+type RejectApplicationRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	PageSize      int32                  `protobuf:"varint,1,opt,name=page_size,proto3" json:"page_size,omitempty"`
-	PageToken     string                 `protobuf:"bytes,2,opt,name=page_token,proto3" json:"page_token,omitempty"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Comment       string                 `protobuf:"bytes,2,opt,name=comment,proto3" json:"comment,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListBriefingsRequest) Reset() {
-	*x = ListBriefingsRequest{}
-	mi := &file_ourspace_backend_proto_api_proto_msgTypes[38]
+func (x *RejectApplicationRequest) Reset() {
+	*x = RejectApplicationRequest{}
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[199]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListBriefingsRequest) String() string {
+func (x *RejectApplicationRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListBriefingsRequest) ProtoMessage() {}
+func (*RejectApplicationRequest) ProtoMessage() {}
 
-func (x *ListBriefingsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ourspace_backend_proto_api_proto_msgTypes[38]
+func (x *RejectApplicationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[199]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2613,48 +13633,58 @@ func (x *ListBriefingsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListBriefingsRequest.ProtoReflect.Descriptor instead.
-func (*ListBriefingsRequest) Descriptor() ([]byte, []int) {
-	return file_ourspace_backend_proto_api_proto_rawDescGZIP(), []int{38}
+// Deprecated: Use RejectApplicationRequest.ProtoReflect.Descriptor instead.
+func (*RejectApplicationRequest) Descriptor() ([]byte, []int) {
+	return file_ourspace_backend_proto_api_proto_rawDescGZIP(), []int{199}
 }
 
-func (x *ListBriefingsRequest) GetPageSize() int32 {
+func (x *RejectApplicationRequest) GetId() string {
 	if x != nil {
-		return x.PageSize
+		return x.Id
 	}
-	return 0
+	return ""
 }
 
-func (x *ListBriefingsRequest) GetPageToken() string {
+func (x *RejectApplicationRequest) GetComment() string {
 	if x != nil {
-		return x.PageToken
+		return x.Comment
 	}
 	return ""
 }
 
-type ListBriefingsResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Briefings     []*Briefing            `protobuf:"bytes,1,rep,name=briefings,proto3" json:"briefings,omitempty"`
-	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,proto3" json:"next_page_token,omitempty"`
+type MemberNote struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Id       string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	MemberId string                 `protobuf:"bytes,2,opt,name=member_id,proto3" json:"member_id,omitempty"`
+	Text     string                 `protobuf:"bytes,3,opt,name=text,proto3" json:"text,omitempty"`
+	// author is the username of the staff member who wrote the note, author_name their full name at that time.
+	Author     string                 `protobuf:"bytes,4,opt,name=author,proto3" json:"author,omitempty"`
+	AuthorName string                 `protobuf:"bytes,5,opt,name=author_name,proto3" json:"author_name,omitempty"`
+	CreateTime *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=create_time,proto3" json:"create_time,omitempty"`
+	UpdateTime *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=update_time,proto3" json:"update_time,omitempty"`
+	// pinned notes are listed before all other notes.
+	Pinned bool `protobuf:"varint,8,opt,name=pinned,proto3" json:"pinned,omitempty"`
+	// visibility defaults to staff.
+	Visibility    MemberNote_Visibility `protobuf:"varint,9,opt,name=visibility,proto3,enum=ourspace_backend.proto.MemberNote_Visibility" json:"visibility,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListBriefingsResponse) Reset() {
-	*x = ListBriefingsResponse{}
-	mi := &file_ourspace_backend_proto_api_proto_msgTypes[39]
+func (x *MemberNote) Reset() {
+	*x = MemberNote{}
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[200]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListBriefingsResponse) String() string {
+func (x *MemberNote) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListBriefingsResponse) ProtoMessage() {}
+func (*MemberNote) ProtoMessage() {}
 
-func (x *ListBriefingsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ourspace_backend_proto_api_proto_msgTypes[39]
+func (x *MemberNote) ProtoReflect() protoreflect.Message {
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[200]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2665,99 +13695,96 @@ func (x *ListBriefingsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListBriefingsResponse.ProtoReflect.Descriptor instead.
-func (*ListBriefingsResponse) Descriptor() ([]byte, []int) {
-	return file_ourspace_backend_proto_api_proto_rawDescGZIP(), []int{39}
+// Deprecated: Use MemberNote.ProtoReflect.Descriptor instead.
+func (*MemberNote) Descriptor() ([]byte, []int) {
+	return file_ourspace_backend_proto_api_proto_rawDescGZIP(), []int{200}
 }
 
-func (x *ListBriefingsResponse) GetBriefings() []*Briefing {
+func (x *MemberNote) GetId() string {
 	if x != nil {
-		return x.Briefings
+		return x.Id
 	}
-	return nil
+	return ""
 }
 
-func (x *ListBriefingsResponse) GetNextPageToken() string {
+func (x *MemberNote) GetMemberId() string {
 	if x != nil {
-		return x.NextPageToken
+		return x.MemberId
 	}
 	return ""
 }
 
-type UpdateBriefingRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Briefing      *Briefing              `protobuf:"bytes,1,opt,name=briefing,proto3" json:"briefing,omitempty"`
-	FieldMask     *fieldmaskpb.FieldMask `protobuf:"bytes,2,opt,name=field_mask,proto3" json:"field_mask,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *MemberNote) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
 }
 
-func (x *UpdateBriefingRequest) Reset() {
-	*x = UpdateBriefingRequest{}
-	mi := &file_ourspace_backend_proto_api_proto_msgTypes[40]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *MemberNote) GetAuthor() string {
+	if x != nil {
+		return x.Author
+	}
+	return ""
 }
 
-func (x *UpdateBriefingRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *MemberNote) GetAuthorName() string {
+	if x != nil {
+		return x.AuthorName
+	}
+	return ""
 }
 
-func (*UpdateBriefingRequest) ProtoMessage() {}
-
-func (x *UpdateBriefingRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ourspace_backend_proto_api_proto_msgTypes[40]
+func (x *MemberNote) GetCreateTime() *timestamppb.Timestamp {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.CreateTime
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use UpdateBriefingRequest.ProtoReflect.Descriptor instead.
-func (*UpdateBriefingRequest) Descriptor() ([]byte, []int) {
-	return file_ourspace_backend_proto_api_proto_rawDescGZIP(), []int{40}
+func (x *MemberNote) GetUpdateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdateTime
+	}
+	return nil
 }
 
-func (x *UpdateBriefingRequest) GetBriefing() *Briefing {
+func (x *MemberNote) GetPinned() bool {
 	if x != nil {
-		return x.Briefing
+		return x.Pinned
 	}
-	return nil
+	return false
 }
 
-func (x *UpdateBriefingRequest) GetFieldMask() *fieldmaskpb.FieldMask {
+func (x *MemberNote) GetVisibility() MemberNote_Visibility {
 	if x != nil {
-		return x.FieldMask
+		return x.Visibility
 	}
-	return nil
+	return MemberNote_VISIBILITY_UNKNOWN
 }
 
-type DeleteBriefingRequest struct {
+type CreateMemberNoteRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Note          *MemberNote            `protobuf:"bytes,1,opt,name=note,proto3" json:"note,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteBriefingRequest) Reset() {
-	*x = DeleteBriefingRequest{}
-	mi := &file_ourspace_backend_proto_api_proto_msgTypes[41]
+func (x *CreateMemberNoteRequest) Reset() {
+	*x = CreateMemberNoteRequest{}
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[201]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteBriefingRequest) String() string {
+func (x *CreateMemberNoteRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteBriefingRequest) ProtoMessage() {}
+func (*CreateMemberNoteRequest) ProtoMessage() {}
 
-func (x *DeleteBriefingRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ourspace_backend_proto_api_proto_msgTypes[41]
+func (x *CreateMemberNoteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[201]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2768,43 +13795,43 @@ func (x *DeleteBriefingRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteBriefingRequest.ProtoReflect.Descriptor instead.
-func (*DeleteBriefingRequest) Descriptor() ([]byte, []int) {
-	return file_ourspace_backend_proto_api_proto_rawDescGZIP(), []int{41}
+// Deprecated: Use CreateMemberNoteRequest.ProtoReflect.Descriptor instead.
+func (*CreateMemberNoteRequest) Descriptor() ([]byte, []int) {
+	return file_ourspace_backend_proto_api_proto_rawDescGZIP(), []int{201}
 }
 
-func (x *DeleteBriefingRequest) GetId() string {
+func (x *CreateMemberNoteRequest) GetNote() *MemberNote {
 	if x != nil {
-		return x.Id
+		return x.Note
 	}
-	return ""
+	return nil
 }
 
-type Presence struct {
+type ListMemberNotesRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	MemberId      string                 `protobuf:"bytes,2,opt,name=member_id,proto3" json:"member_id,omitempty"`
-	CheckinTime   *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=checkin_time,proto3" json:"checkin_time,omitempty"`
-	CheckoutTime  *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=checkout_time,proto3" json:"checkout_time,omitempty"`
+	MemberId      string                 `protobuf:"bytes,1,opt,name=member_id,proto3" json:"member_id,omitempty"`
+	PageSize      int32                  `protobuf:"varint,2,opt,name=page_size,proto3" json:"page_size,omitempty"`
+	PageToken     string                 `protobuf:"bytes,3,opt,name=page_token,proto3" json:"page_token,omitempty"`
+	PinnedOnly    bool                   `protobuf:"varint,4,opt,name=pinned_only,proto3" json:"pinned_only,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Presence) Reset() {
-	*x = Presence{}
-	mi := &file_ourspace_backend_proto_api_proto_msgTypes[42]
+func (x *ListMemberNotesRequest) Reset() {
+	*x = ListMemberNotesRequest{}
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[202]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Presence) String() string {
+func (x *ListMemberNotesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Presence) ProtoMessage() {}
+func (*ListMemberNotesRequest) ProtoMessage() {}
 
-func (x *Presence) ProtoReflect() protoreflect.Message {
-	mi := &file_ourspace_backend_proto_api_proto_msgTypes[42]
+func (x *ListMemberNotesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[202]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2815,69 +13842,62 @@ func (x *Presence) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Presence.ProtoReflect.Descriptor instead.
-func (*Presence) Descriptor() ([]byte, []int) {
-	return file_ourspace_backend_proto_api_proto_rawDescGZIP(), []int{42}
+// Deprecated: Use ListMemberNotesRequest.ProtoReflect.Descriptor instead.
+func (*ListMemberNotesRequest) Descriptor() ([]byte, []int) {
+	return file_ourspace_backend_proto_api_proto_rawDescGZIP(), []int{202}
 }
 
-func (x *Presence) GetId() string {
+func (x *ListMemberNotesRequest) GetMemberId() string {
 	if x != nil {
-		return x.Id
+		return x.MemberId
 	}
 	return ""
 }
 
-func (x *Presence) GetMemberId() string {
+func (x *ListMemberNotesRequest) GetPageSize() int32 {
 	if x != nil {
-		return x.MemberId
+		return x.PageSize
 	}
-	return ""
+	return 0
 }
 
-func (x *Presence) GetCheckinTime() *timestamppb.Timestamp {
+func (x *ListMemberNotesRequest) GetPageToken() string {
 	if x != nil {
-		return x.CheckinTime
+		return x.PageToken
 	}
-	return nil
+	return ""
 }
 
-func (x *Presence) GetCheckoutTime() *timestamppb.Timestamp {
+func (x *ListMemberNotesRequest) GetPinnedOnly() bool {
 	if x != nil {
-		return x.CheckoutTime
+		return x.PinnedOnly
 	}
-	return nil
+	return false
 }
 
-type ListPresencesRequest struct {
-	state              protoimpl.MessageState `protogen:"open.v1"`
-	PageSize           int32                  `protobuf:"varint,1,opt,name=page_size,proto3" json:"page_size,omitempty"`
-	PageToken          string                 `protobuf:"bytes,2,opt,name=page_token,proto3" json:"page_token,omitempty"`
-	SortBy             PresenceField          `protobuf:"varint,8,opt,name=sort_by,proto3,enum=ourspace_backend.proto.PresenceField" json:"sort_by,omitempty"`
-	SortDirection      SortDirection          `protobuf:"varint,9,opt,name=sort_direction,proto3,enum=ourspace_backend.proto.SortDirection" json:"sort_direction,omitempty"`
-	MemberId           *string                `protobuf:"bytes,3,opt,name=member_id,proto3,oneof" json:"member_id,omitempty"`
-	CheckinTimeAfter   *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=checkin_time_after,proto3,oneof" json:"checkin_time_after,omitempty"`
-	CheckinTimeBefore  *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=checkin_time_before,proto3,oneof" json:"checkin_time_before,omitempty"`
-	CheckoutTimeAfter  *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=checkout_time_after,proto3,oneof" json:"checkout_time_after,omitempty"`
-	CheckoutTimeBefore *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=checkout_time_before,proto3,oneof" json:"checkout_time_before,omitempty"`
-	unknownFields      protoimpl.UnknownFields
-	sizeCache          protoimpl.SizeCache
+type ListMemberNotesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Notes         []*MemberNote          `protobuf:"bytes,1,rep,name=notes,proto3" json:"notes,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListPresencesRequest) Reset() {
-	*x = ListPresencesRequest{}
-	mi := &file_ourspace_backend_proto_api_proto_msgTypes[43]
+func (x *ListMemberNotesResponse) Reset() {
+	*x = ListMemberNotesResponse{}
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[203]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListPresencesRequest) String() string {
+func (x *ListMemberNotesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListPresencesRequest) ProtoMessage() {}
+func (*ListMemberNotesResponse) ProtoMessage() {}
 
-func (x *ListPresencesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ourspace_backend_proto_api_proto_msgTypes[43]
+func (x *ListMemberNotesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[203]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2888,97 +13908,92 @@ func (x *ListPresencesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListPresencesRequest.ProtoReflect.Descriptor instead.
-func (*ListPresencesRequest) Descriptor() ([]byte, []int) {
-	return file_ourspace_backend_proto_api_proto_rawDescGZIP(), []int{43}
+// Deprecated: Use ListMemberNotesResponse.ProtoReflect.Descriptor instead.
+func (*ListMemberNotesResponse) Descriptor() ([]byte, []int) {
+	return file_ourspace_backend_proto_api_proto_rawDescGZIP(), []int{203}
 }
 
-func (x *ListPresencesRequest) GetPageSize() int32 {
+func (x *ListMemberNotesResponse) GetNotes() []*MemberNote {
 	if x != nil {
-		return x.PageSize
+		return x.Notes
 	}
-	return 0
+	return nil
 }
 
-func (x *ListPresencesRequest) GetPageToken() string {
+func (x *ListMemberNotesResponse) GetNextPageToken() string {
 	if x != nil {
-		return x.PageToken
+		return x.NextPageToken
 	}
 	return ""
 }
 
-func (x *ListPresencesRequest) GetSortBy() PresenceField {
-	if x != nil {
-		return x.SortBy
-	}
-	return PresenceField_PRESENCE_FIELD_UNKNOWN
+type MemberNotePageToken struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Offset        int32                  `protobuf:"varint,1,opt,name=offset,proto3" json:"offset,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListPresencesRequest) GetSortDirection() SortDirection {
-	if x != nil {
-		return x.SortDirection
-	}
-	return SortDirection_SORT_DIRECTION_DEFAULT
+func (x *MemberNotePageToken) Reset() {
+	*x = MemberNotePageToken{}
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[204]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListPresencesRequest) GetMemberId() string {
-	if x != nil && x.MemberId != nil {
-		return *x.MemberId
-	}
-	return ""
+func (x *MemberNotePageToken) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *ListPresencesRequest) GetCheckinTimeAfter() *timestamppb.Timestamp {
-	if x != nil {
-		return x.CheckinTimeAfter
-	}
-	return nil
-}
+func (*MemberNotePageToken) ProtoMessage() {}
 
-func (x *ListPresencesRequest) GetCheckinTimeBefore() *timestamppb.Timestamp {
+func (x *MemberNotePageToken) ProtoReflect() protoreflect.Message {
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[204]
 	if x != nil {
-		return x.CheckinTimeBefore
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *ListPresencesRequest) GetCheckoutTimeAfter() *timestamppb.Timestamp {
-	if x != nil {
-		return x.CheckoutTimeAfter
-	}
-	return nil
+// Deprecated: Use MemberNotePageToken.ProtoReflect.Descriptor instead.
+func (*MemberNotePageToken) Descriptor() ([]byte, []int) {
+	return file_ourspace_backend_proto_api_proto_rawDescGZIP(), []int{204}
 }
 
-func (x *ListPresencesRequest) GetCheckoutTimeBefore() *timestamppb.Timestamp {
+func (x *MemberNotePageToken) GetOffset() int32 {
 	if x != nil {
-		return x.CheckoutTimeBefore
+		return x.Offset
 	}
-	return nil
+	return 0
 }
 
-type ListPresencesResponse struct {
+type UpdateMemberNoteRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Presence      []*Presence            `protobuf:"bytes,1,rep,name=presence,proto3" json:"presence,omitempty"`
-	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,proto3" json:"next_page_token,omitempty"`
+	Note          *MemberNote            `protobuf:"bytes,1,opt,name=note,proto3" json:"note,omitempty"`
+	FieldMask     *fieldmaskpb.FieldMask `protobuf:"bytes,2,opt,name=field_mask,proto3" json:"field_mask,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListPresencesResponse) Reset() {
-	*x = ListPresencesResponse{}
-	mi := &file_ourspace_backend_proto_api_proto_msgTypes[44]
+func (x *UpdateMemberNoteRequest) Reset() {
+	*x = UpdateMemberNoteRequest{}
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[205]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListPresencesResponse) String() string {
+func (x *UpdateMemberNoteRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListPresencesResponse) ProtoMessage() {}
+func (*UpdateMemberNoteRequest) ProtoMessage() {}
 
-func (x *ListPresencesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ourspace_backend_proto_api_proto_msgTypes[44]
+func (x *UpdateMemberNoteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[205]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2989,50 +14004,48 @@ func (x *ListPresencesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListPresencesResponse.ProtoReflect.Descriptor instead.
-func (*ListPresencesResponse) Descriptor() ([]byte, []int) {
-	return file_ourspace_backend_proto_api_proto_rawDescGZIP(), []int{44}
+// Deprecated: Use UpdateMemberNoteRequest.ProtoReflect.Descriptor instead.
+func (*UpdateMemberNoteRequest) Descriptor() ([]byte, []int) {
+	return file_ourspace_backend_proto_api_proto_rawDescGZIP(), []int{205}
 }
 
-func (x *ListPresencesResponse) GetPresence() []*Presence {
+func (x *UpdateMemberNoteRequest) GetNote() *MemberNote {
 	if x != nil {
-		return x.Presence
+		return x.Note
 	}
 	return nil
 }
 
-func (x *ListPresencesResponse) GetNextPageToken() string {
+func (x *UpdateMemberNoteRequest) GetFieldMask() *fieldmaskpb.FieldMask {
 	if x != nil {
-		return x.NextPageToken
+		return x.FieldMask
 	}
-	return ""
+	return nil
 }
 
-type PresencePageToken struct {
+type DeleteMemberNoteRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Field         PresenceField          `protobuf:"varint,1,opt,name=field,proto3,enum=ourspace_backend.proto.PresenceField" json:"field,omitempty"`
-	LastValue     string                 `protobuf:"bytes,2,opt,name=last_value,proto3" json:"last_value,omitempty"`
-	Direction     SortDirection          `protobuf:"varint,3,opt,name=direction,proto3,enum=ourspace_backend.proto.SortDirection" json:"direction,omitempty"`
-	LastId        string                 `protobuf:"bytes,4,opt,name=last_id,proto3" json:"last_id,omitempty"`
+	MemberId      string                 `protobuf:"bytes,1,opt,name=member_id,proto3" json:"member_id,omitempty"`
+	Id            string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *PresencePageToken) Reset() {
-	*x = PresencePageToken{}
-	mi := &file_ourspace_backend_proto_api_proto_msgTypes[45]
+func (x *DeleteMemberNoteRequest) Reset() {
+	*x = DeleteMemberNoteRequest{}
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[206]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *PresencePageToken) String() string {
+func (x *DeleteMemberNoteRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PresencePageToken) ProtoMessage() {}
+func (*DeleteMemberNoteRequest) ProtoMessage() {}
 
-func (x *PresencePageToken) ProtoReflect() protoreflect.Message {
-	mi := &file_ourspace_backend_proto_api_proto_msgTypes[45]
+func (x *DeleteMemberNoteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[206]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3043,61 +14056,55 @@ func (x *PresencePageToken) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PresencePageToken.ProtoReflect.Descriptor instead.
-func (*PresencePageToken) Descriptor() ([]byte, []int) {
-	return file_ourspace_backend_proto_api_proto_rawDescGZIP(), []int{45}
-}
-
-func (x *PresencePageToken) GetField() PresenceField {
-	if x != nil {
-		return x.Field
-	}
-	return PresenceField_PRESENCE_FIELD_UNKNOWN
+// Deprecated: Use DeleteMemberNoteRequest.ProtoReflect.Descriptor instead.
+func (*DeleteMemberNoteRequest) Descriptor() ([]byte, []int) {
+	return file_ourspace_backend_proto_api_proto_rawDescGZIP(), []int{206}
 }
 
-func (x *PresencePageToken) GetLastValue() string {
+func (x *DeleteMemberNoteRequest) GetMemberId() string {
 	if x != nil {
-		return x.LastValue
+		return x.MemberId
 	}
 	return ""
 }
 
-func (x *PresencePageToken) GetDirection() SortDirection {
-	if x != nil {
-		return x.Direction
-	}
-	return SortDirection_SORT_DIRECTION_DEFAULT
-}
-
-func (x *PresencePageToken) GetLastId() string {
+func (x *DeleteMemberNoteRequest) GetId() string {
 	if x != nil {
-		return x.LastId
+		return x.Id
 	}
 	return ""
 }
 
-type CheckinRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	MemberId      string                 `protobuf:"bytes,1,opt,name=member_id,proto3" json:"member_id,omitempty"`
+// MemberTimelineEntry is one event of the timeline, exactly one of the entries is set.
+type MemberTimelineEntry struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// time is the creation time of notes, the start of validity of cards and the time of briefings.
+	Time *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=time,proto3" json:"time,omitempty"`
+	// Types that are valid to be assigned to Entry:
+	//
+	//	*MemberTimelineEntry_Note
+	//	*MemberTimelineEntry_Card
+	//	*MemberTimelineEntry_Briefing
+	Entry         isMemberTimelineEntry_Entry `protobuf_oneof:"entry"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CheckinRequest) Reset() {
-	*x = CheckinRequest{}
-	mi := &file_ourspace_backend_proto_api_proto_msgTypes[46]
+func (x *MemberTimelineEntry) Reset() {
+	*x = MemberTimelineEntry{}
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[207]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CheckinRequest) String() string {
+func (x *MemberTimelineEntry) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CheckinRequest) ProtoMessage() {}
+func (*MemberTimelineEntry) ProtoMessage() {}
 
-func (x *CheckinRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ourspace_backend_proto_api_proto_msgTypes[46]
+func (x *MemberTimelineEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[207]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3108,40 +14115,98 @@ func (x *CheckinRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CheckinRequest.ProtoReflect.Descriptor instead.
-func (*CheckinRequest) Descriptor() ([]byte, []int) {
-	return file_ourspace_backend_proto_api_proto_rawDescGZIP(), []int{46}
+// Deprecated: Use MemberTimelineEntry.ProtoReflect.Descriptor instead.
+func (*MemberTimelineEntry) Descriptor() ([]byte, []int) {
+	return file_ourspace_backend_proto_api_proto_rawDescGZIP(), []int{207}
 }
 
-func (x *CheckinRequest) GetMemberId() string {
+func (x *MemberTimelineEntry) GetTime() *timestamppb.Timestamp {
 	if x != nil {
-		return x.MemberId
+		return x.Time
 	}
-	return ""
+	return nil
 }
 
-type CheckoutRequest struct {
+func (x *MemberTimelineEntry) GetEntry() isMemberTimelineEntry_Entry {
+	if x != nil {
+		return x.Entry
+	}
+	return nil
+}
+
+func (x *MemberTimelineEntry) GetNote() *MemberNote {
+	if x != nil {
+		if x, ok := x.Entry.(*MemberTimelineEntry_Note); ok {
+			return x.Note
+		}
+	}
+	return nil
+}
+
+func (x *MemberTimelineEntry) GetCard() *Card {
+	if x != nil {
+		if x, ok := x.Entry.(*MemberTimelineEntry_Card); ok {
+			return x.Card
+		}
+	}
+	return nil
+}
+
+func (x *MemberTimelineEntry) GetBriefing() *Briefing {
+	if x != nil {
+		if x, ok := x.Entry.(*MemberTimelineEntry_Briefing); ok {
+			return x.Briefing
+		}
+	}
+	return nil
+}
+
+type isMemberTimelineEntry_Entry interface {
+	isMemberTimelineEntry_Entry()
+}
+
+type MemberTimelineEntry_Note struct {
+	Note *MemberNote `protobuf:"bytes,2,opt,name=note,proto3,oneof"`
+}
+
+type MemberTimelineEntry_Card struct {
+	Card *Card `protobuf:"bytes,3,opt,name=card,proto3,oneof"`
+}
+
+type MemberTimelineEntry_Briefing struct {
+	Briefing *Briefing `protobuf:"bytes,4,opt,name=briefing,proto3,oneof"`
+}
+
+func (*MemberTimelineEntry_Note) isMemberTimelineEntry_Entry() {}
+
+func (*MemberTimelineEntry_Card) isMemberTimelineEntry_Entry() {}
+
+func (*MemberTimelineEntry_Briefing) isMemberTimelineEntry_Entry() {}
+
+type ListMemberTimelineRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	MemberId      string                 `protobuf:"bytes,1,opt,name=member_id,proto3" json:"member_id,omitempty"`
+	PageSize      int32                  `protobuf:"varint,2,opt,name=page_size,proto3" json:"page_size,omitempty"`
+	PageToken     string                 `protobuf:"bytes,3,opt,name=page_token,proto3" json:"page_token,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CheckoutRequest) Reset() {
-	*x = CheckoutRequest{}
-	mi := &file_ourspace_backend_proto_api_proto_msgTypes[47]
+func (x *ListMemberTimelineRequest) Reset() {
+	*x = ListMemberTimelineRequest{}
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[208]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CheckoutRequest) String() string {
+func (x *ListMemberTimelineRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CheckoutRequest) ProtoMessage() {}
+func (*ListMemberTimelineRequest) ProtoMessage() {}
 
-func (x *CheckoutRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ourspace_backend_proto_api_proto_msgTypes[47]
+func (x *ListMemberTimelineRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[208]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3152,41 +14217,55 @@ func (x *CheckoutRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CheckoutRequest.ProtoReflect.Descriptor instead.
-func (*CheckoutRequest) Descriptor() ([]byte, []int) {
-	return file_ourspace_backend_proto_api_proto_rawDescGZIP(), []int{47}
+// Deprecated: Use ListMemberTimelineRequest.ProtoReflect.Descriptor instead.
+func (*ListMemberTimelineRequest) Descriptor() ([]byte, []int) {
+	return file_ourspace_backend_proto_api_proto_rawDescGZIP(), []int{208}
 }
 
-func (x *CheckoutRequest) GetMemberId() string {
+func (x *ListMemberTimelineRequest) GetMemberId() string {
 	if x != nil {
 		return x.MemberId
 	}
 	return ""
 }
 
-type UpdatePresenceRequest struct {
+func (x *ListMemberTimelineRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListMemberTimelineRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type ListMemberTimelineResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Presence      *Presence              `protobuf:"bytes,1,opt,name=presence,proto3" json:"presence,omitempty"`
-	FieldMask     *fieldmaskpb.FieldMask `protobuf:"bytes,2,opt,name=field_mask,proto3" json:"field_mask,omitempty"`
+	Entries       []*MemberTimelineEntry `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,proto3" json:"next_page_token,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
-
-func (x *UpdatePresenceRequest) Reset() {
-	*x = UpdatePresenceRequest{}
-	mi := &file_ourspace_backend_proto_api_proto_msgTypes[48]
+
+func (x *ListMemberTimelineResponse) Reset() {
+	*x = ListMemberTimelineResponse{}
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[209]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdatePresenceRequest) String() string {
+func (x *ListMemberTimelineResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdatePresenceRequest) ProtoMessage() {}
+func (*ListMemberTimelineResponse) ProtoMessage() {}
 
-func (x *UpdatePresenceRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ourspace_backend_proto_api_proto_msgTypes[48]
+func (x *ListMemberTimelineResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[209]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3197,47 +14276,47 @@ func (x *UpdatePresenceRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdatePresenceRequest.ProtoReflect.Descriptor instead.
-func (*UpdatePresenceRequest) Descriptor() ([]byte, []int) {
-	return file_ourspace_backend_proto_api_proto_rawDescGZIP(), []int{48}
+// Deprecated: Use ListMemberTimelineResponse.ProtoReflect.Descriptor instead.
+func (*ListMemberTimelineResponse) Descriptor() ([]byte, []int) {
+	return file_ourspace_backend_proto_api_proto_rawDescGZIP(), []int{209}
 }
 
-func (x *UpdatePresenceRequest) GetPresence() *Presence {
+func (x *ListMemberTimelineResponse) GetEntries() []*MemberTimelineEntry {
 	if x != nil {
-		return x.Presence
+		return x.Entries
 	}
 	return nil
 }
 
-func (x *UpdatePresenceRequest) GetFieldMask() *fieldmaskpb.FieldMask {
+func (x *ListMemberTimelineResponse) GetNextPageToken() string {
 	if x != nil {
-		return x.FieldMask
+		return x.NextPageToken
 	}
-	return nil
+	return ""
 }
 
-type DeletePresenceRequest struct {
+type MemberTimelinePageToken struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Offset        int32                  `protobuf:"varint,1,opt,name=offset,proto3" json:"offset,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeletePresenceRequest) Reset() {
-	*x = DeletePresenceRequest{}
-	mi := &file_ourspace_backend_proto_api_proto_msgTypes[49]
+func (x *MemberTimelinePageToken) Reset() {
+	*x = MemberTimelinePageToken{}
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[210]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeletePresenceRequest) String() string {
+func (x *MemberTimelinePageToken) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeletePresenceRequest) ProtoMessage() {}
+func (*MemberTimelinePageToken) ProtoMessage() {}
 
-func (x *DeletePresenceRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ourspace_backend_proto_api_proto_msgTypes[49]
+func (x *MemberTimelinePageToken) ProtoReflect() protoreflect.Message {
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[210]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3248,16 +14327,16 @@ func (x *DeletePresenceRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeletePresenceRequest.ProtoReflect.Descriptor instead.
-func (*DeletePresenceRequest) Descriptor() ([]byte, []int) {
-	return file_ourspace_backend_proto_api_proto_rawDescGZIP(), []int{49}
+// Deprecated: Use MemberTimelinePageToken.ProtoReflect.Descriptor instead.
+func (*MemberTimelinePageToken) Descriptor() ([]byte, []int) {
+	return file_ourspace_backend_proto_api_proto_rawDescGZIP(), []int{210}
 }
 
-func (x *DeletePresenceRequest) GetId() string {
+func (x *MemberTimelinePageToken) GetOffset() int32 {
 	if x != nil {
-		return x.Id
+		return x.Offset
 	}
-	return ""
+	return 0
 }
 
 type LoginRequest struct {
@@ -3274,7 +14353,7 @@ type LoginRequest struct {
 
 func (x *LoginRequest) Reset() {
 	*x = LoginRequest{}
-	mi := &file_ourspace_backend_proto_api_proto_msgTypes[50]
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[211]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3286,7 +14365,7 @@ func (x *LoginRequest) String() string {
 func (*LoginRequest) ProtoMessage() {}
 
 func (x *LoginRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ourspace_backend_proto_api_proto_msgTypes[50]
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[211]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3299,7 +14378,7 @@ func (x *LoginRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LoginRequest.ProtoReflect.Descriptor instead.
 func (*LoginRequest) Descriptor() ([]byte, []int) {
-	return file_ourspace_backend_proto_api_proto_rawDescGZIP(), []int{50}
+	return file_ourspace_backend_proto_api_proto_rawDescGZIP(), []int{211}
 }
 
 func (x *LoginRequest) GetCredentials() isLoginRequest_Credentials {
@@ -3368,7 +14447,7 @@ type LoginPassword struct {
 
 func (x *LoginPassword) Reset() {
 	*x = LoginPassword{}
-	mi := &file_ourspace_backend_proto_api_proto_msgTypes[51]
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[212]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3380,7 +14459,7 @@ func (x *LoginPassword) String() string {
 func (*LoginPassword) ProtoMessage() {}
 
 func (x *LoginPassword) ProtoReflect() protoreflect.Message {
-	mi := &file_ourspace_backend_proto_api_proto_msgTypes[51]
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[212]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3393,7 +14472,7 @@ func (x *LoginPassword) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LoginPassword.ProtoReflect.Descriptor instead.
 func (*LoginPassword) Descriptor() ([]byte, []int) {
-	return file_ourspace_backend_proto_api_proto_rawDescGZIP(), []int{51}
+	return file_ourspace_backend_proto_api_proto_rawDescGZIP(), []int{212}
 }
 
 func (x *LoginPassword) GetUsername() string {
@@ -3421,7 +14500,7 @@ type LoginOpenIDConnect struct {
 
 func (x *LoginOpenIDConnect) Reset() {
 	*x = LoginOpenIDConnect{}
-	mi := &file_ourspace_backend_proto_api_proto_msgTypes[52]
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[213]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3433,7 +14512,7 @@ func (x *LoginOpenIDConnect) String() string {
 func (*LoginOpenIDConnect) ProtoMessage() {}
 
 func (x *LoginOpenIDConnect) ProtoReflect() protoreflect.Message {
-	mi := &file_ourspace_backend_proto_api_proto_msgTypes[52]
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[213]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3446,7 +14525,7 @@ func (x *LoginOpenIDConnect) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LoginOpenIDConnect.ProtoReflect.Descriptor instead.
 func (*LoginOpenIDConnect) Descriptor() ([]byte, []int) {
-	return file_ourspace_backend_proto_api_proto_rawDescGZIP(), []int{52}
+	return file_ourspace_backend_proto_api_proto_rawDescGZIP(), []int{213}
 }
 
 func (x *LoginOpenIDConnect) GetAuthCode() string {
@@ -3479,7 +14558,7 @@ type LoginApiKey struct {
 
 func (x *LoginApiKey) Reset() {
 	*x = LoginApiKey{}
-	mi := &file_ourspace_backend_proto_api_proto_msgTypes[53]
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[214]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3491,7 +14570,7 @@ func (x *LoginApiKey) String() string {
 func (*LoginApiKey) ProtoMessage() {}
 
 func (x *LoginApiKey) ProtoReflect() protoreflect.Message {
-	mi := &file_ourspace_backend_proto_api_proto_msgTypes[53]
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[214]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3504,7 +14583,7 @@ func (x *LoginApiKey) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LoginApiKey.ProtoReflect.Descriptor instead.
 func (*LoginApiKey) Descriptor() ([]byte, []int) {
-	return file_ourspace_backend_proto_api_proto_rawDescGZIP(), []int{53}
+	return file_ourspace_backend_proto_api_proto_rawDescGZIP(), []int{214}
 }
 
 func (x *LoginApiKey) GetApiKey() string {
@@ -3526,7 +14605,7 @@ type LoginResponse struct {
 
 func (x *LoginResponse) Reset() {
 	*x = LoginResponse{}
-	mi := &file_ourspace_backend_proto_api_proto_msgTypes[54]
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[215]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3538,7 +14617,7 @@ func (x *LoginResponse) String() string {
 func (*LoginResponse) ProtoMessage() {}
 
 func (x *LoginResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ourspace_backend_proto_api_proto_msgTypes[54]
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[215]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3551,7 +14630,7 @@ func (x *LoginResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LoginResponse.ProtoReflect.Descriptor instead.
 func (*LoginResponse) Descriptor() ([]byte, []int) {
-	return file_ourspace_backend_proto_api_proto_rawDescGZIP(), []int{54}
+	return file_ourspace_backend_proto_api_proto_rawDescGZIP(), []int{215}
 }
 
 func (x *LoginResponse) GetOutcome() isLoginResponse_Outcome {
@@ -3592,7 +14671,7 @@ type LoginSuccess struct {
 
 func (x *LoginSuccess) Reset() {
 	*x = LoginSuccess{}
-	mi := &file_ourspace_backend_proto_api_proto_msgTypes[55]
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[216]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3604,7 +14683,7 @@ func (x *LoginSuccess) String() string {
 func (*LoginSuccess) ProtoMessage() {}
 
 func (x *LoginSuccess) ProtoReflect() protoreflect.Message {
-	mi := &file_ourspace_backend_proto_api_proto_msgTypes[55]
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[216]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3617,7 +14696,7 @@ func (x *LoginSuccess) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LoginSuccess.ProtoReflect.Descriptor instead.
 func (*LoginSuccess) Descriptor() ([]byte, []int) {
-	return file_ourspace_backend_proto_api_proto_rawDescGZIP(), []int{55}
+	return file_ourspace_backend_proto_api_proto_rawDescGZIP(), []int{216}
 }
 
 func (x *LoginSuccess) GetAccessToken() string {
@@ -3656,7 +14735,7 @@ type RefreshRequest struct {
 
 func (x *RefreshRequest) Reset() {
 	*x = RefreshRequest{}
-	mi := &file_ourspace_backend_proto_api_proto_msgTypes[56]
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[217]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3668,7 +14747,7 @@ func (x *RefreshRequest) String() string {
 func (*RefreshRequest) ProtoMessage() {}
 
 func (x *RefreshRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ourspace_backend_proto_api_proto_msgTypes[56]
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[217]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3681,7 +14760,7 @@ func (x *RefreshRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RefreshRequest.ProtoReflect.Descriptor instead.
 func (*RefreshRequest) Descriptor() ([]byte, []int) {
-	return file_ourspace_backend_proto_api_proto_rawDescGZIP(), []int{56}
+	return file_ourspace_backend_proto_api_proto_rawDescGZIP(), []int{217}
 }
 
 type RefreshResponse struct {
@@ -3693,7 +14772,7 @@ type RefreshResponse struct {
 
 func (x *RefreshResponse) Reset() {
 	*x = RefreshResponse{}
-	mi := &file_ourspace_backend_proto_api_proto_msgTypes[57]
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[218]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3705,7 +14784,7 @@ func (x *RefreshResponse) String() string {
 func (*RefreshResponse) ProtoMessage() {}
 
 func (x *RefreshResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ourspace_backend_proto_api_proto_msgTypes[57]
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[218]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3718,7 +14797,7 @@ func (x *RefreshResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RefreshResponse.ProtoReflect.Descriptor instead.
 func (*RefreshResponse) Descriptor() ([]byte, []int) {
-	return file_ourspace_backend_proto_api_proto_rawDescGZIP(), []int{57}
+	return file_ourspace_backend_proto_api_proto_rawDescGZIP(), []int{218}
 }
 
 func (x *RefreshResponse) GetSuccess() *LoginSuccess {
@@ -3736,7 +14815,7 @@ type LogoutRequest struct {
 
 func (x *LogoutRequest) Reset() {
 	*x = LogoutRequest{}
-	mi := &file_ourspace_backend_proto_api_proto_msgTypes[58]
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[219]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3748,7 +14827,7 @@ func (x *LogoutRequest) String() string {
 func (*LogoutRequest) ProtoMessage() {}
 
 func (x *LogoutRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ourspace_backend_proto_api_proto_msgTypes[58]
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[219]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3761,7 +14840,7 @@ func (x *LogoutRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LogoutRequest.ProtoReflect.Descriptor instead.
 func (*LogoutRequest) Descriptor() ([]byte, []int) {
-	return file_ourspace_backend_proto_api_proto_rawDescGZIP(), []int{58}
+	return file_ourspace_backend_proto_api_proto_rawDescGZIP(), []int{219}
 }
 
 type LogoutResponse struct {
@@ -3772,7 +14851,7 @@ type LogoutResponse struct {
 
 func (x *LogoutResponse) Reset() {
 	*x = LogoutResponse{}
-	mi := &file_ourspace_backend_proto_api_proto_msgTypes[59]
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[220]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3784,7 +14863,7 @@ func (x *LogoutResponse) String() string {
 func (*LogoutResponse) ProtoMessage() {}
 
 func (x *LogoutResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ourspace_backend_proto_api_proto_msgTypes[59]
+	mi := &file_ourspace_backend_proto_api_proto_msgTypes[220]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3797,17 +14876,18 @@ func (x *LogoutResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LogoutResponse.ProtoReflect.Descriptor instead.
 func (*LogoutResponse) Descriptor() ([]byte, []int) {
-	return file_ourspace_backend_proto_api_proto_rawDescGZIP(), []int{59}
+	return file_ourspace_backend_proto_api_proto_rawDescGZIP(), []int{220}
 }
 
 var File_ourspace_backend_proto_api_proto protoreflect.FileDescriptor
 
 const file_ourspace_backend_proto_api_proto_rawDesc = "" +
 	"\n" +
-	" ourspace-backend/proto/api.proto\x12\x16ourspace_backend.proto\x1a$gnostic/openapi/v3/annotations.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/api/field_behavior.proto\x1a\x1egoogle/protobuf/duration.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a google/protobuf/field_mask.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1apkg/setup/proto/auth.proto\"y\n" +
+	" ourspace-backend/proto/api.proto\x12\x16ourspace_backend.proto\x1a$gnostic/openapi/v3/annotations.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/api/field_behavior.proto\x1a\x19google/api/httpbody.proto\x1a\x1egoogle/protobuf/duration.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a google/protobuf/field_mask.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1apkg/setup/proto/auth.proto\"\xcb\x01\n" +
 	"\x13CreateMemberRequest\x12\x1c\n" +
 	"\tmember_id\x18\x01 \x01(\tR\tmember_id\x126\n" +
-	"\x06member\x18\x02 \x01(\v2\x1e.ourspace_backend.proto.MemberR\x06member:\f\xbaG\t\xba\x01\x06member\"\xec\x04\n" +
+	"\x06member\x18\x02 \x01(\v2\x1e.ourspace_backend.proto.MemberR\x06member\x12P\n" +
+	"\rrelationships\x18\x03 \x03(\v2*.ourspace_backend.proto.MemberRelationshipR\rrelationships:\f\xbaG\t\xba\x01\x06member\"\xa0\x05\n" +
 	"\x06Member\x12\x13\n" +
 	"\x02id\x18\x01 \x01(\tB\x03\xe0A\x03R\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12F\n" +
@@ -3816,16 +14896,21 @@ const file_ourspace_backend_proto_api_proto_rawDesc = "" +
 	"\fage_category\x18\x05 \x01(\x0e2#.ourspace_backend.proto.AgeCategoryR\fage_category\x12\x12\n" +
 	"\x04tags\x18\x06 \x03(\tR\x04tags\x12L\n" +
 	"\fmember_login\x18\a \x01(\v2#.ourspace_backend.proto.MemberLoginH\x00R\fmember_login\x88\x01\x01\x12n\n" +
-	"\x15additional_attributes\x18\b \x03(\v28.ourspace_backend.proto.Member.AdditionalAttributesEntryR\x15additional_attributes\x1aG\n" +
+	"\x15additional_attributes\x18\b \x03(\v28.ourspace_backend.proto.Member.AdditionalAttributesEntryR\x15additional_attributes\x12#\n" +
+	"\n" +
+	"birth_date\x18\t \x01(\tH\x01R\n" +
+	"birth_date\x88\x01\x01\x1aG\n" +
 	"\x19AdditionalAttributesEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
 	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01:8\xbaG5\xba\x01\x02id\xba\x01\x04name\xba\x01\x10membership_start\xba\x01\fage_category\xba\x01\x04tagsB\x0f\n" +
-	"\r_member_login\"J\n" +
+	"\r_member_loginB\r\n" +
+	"\v_birth_date\"`\n" +
 	"\vMemberLogin\x12\x1a\n" +
 	"\busername\x18\x01 \x01(\tR\busername\x12\x1f\n" +
-	"\bpassword\x18\x02 \x01(\tB\x03\xe0A\x04R\bpassword\"\"\n" +
+	"\bpassword\x18\x02 \x01(\tB\x03\xe0A\x04R\bpassword\x12\x14\n" +
+	"\x05roles\x18\x03 \x03(\tR\x05roles\"\"\n" +
 	"\x10GetMemberRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\"\xff\x06\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\xe1\f\n" +
 	"\x12ListMembersRequest\x12\x1c\n" +
 	"\tpage_size\x18\x01 \x01(\x05R\tpage_size\x12\x1e\n" +
 	"\n" +
@@ -3840,7 +14925,24 @@ const file_ourspace_backend_proto_api_proto_rawDesc = "" +
 	"\x15membership_end_before\x18\t \x01(\v2\x1a.google.protobuf.TimestampH\x04R\x15membership_end_before\x88\x01\x01\x12Z\n" +
 	"\x13age_category_equals\x18\n" +
 	" \x01(\x0e2#.ourspace_backend.proto.AgeCategoryH\x05R\x13age_category_equals\x88\x01\x01\x12\"\n" +
-	"\ftag_contains\x18\v \x03(\tR\ftag_containsB\x10\n" +
+	"\ftag_contains\x18\v \x03(\tR\ftag_contains\x12k\n" +
+	"\x10attribute_equals\x18\f \x03(\v2?.ourspace_backend.proto.ListMembersRequest.AttributeEqualsEntryR\x10attribute_equals\x12q\n" +
+	"\x12attribute_contains\x18\r \x03(\v2A.ourspace_backend.proto.ListMembersRequest.AttributeContainsEntryR\x12attribute_contains\x12b\n" +
+	"\rattribute_min\x18\x0e \x03(\v2<.ourspace_backend.proto.ListMembersRequest.AttributeMinEntryR\rattribute_min\x12b\n" +
+	"\rattribute_max\x18\x0f \x03(\v2<.ourspace_backend.proto.ListMembersRequest.AttributeMaxEntryR\rattribute_max\x12,\n" +
+	"\x11sort_by_attribute\x18\x10 \x01(\tR\x11sort_by_attribute\x1aB\n" +
+	"\x14AttributeEqualsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1aD\n" +
+	"\x16AttributeContainsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1a?\n" +
+	"\x11AttributeMinEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1a?\n" +
+	"\x11AttributeMaxEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01B\x10\n" +
 	"\x0e_name_containsB\x19\n" +
 	"\x17_membership_start_afterB\x1a\n" +
 	"\x18_membership_start_beforeB\x17\n" +
@@ -3849,29 +14951,92 @@ const file_ourspace_backend_proto_api_proto_rawDesc = "" +
 	"\x14_age_category_equals\"\x9a\x01\n" +
 	"\x13ListMembersResponse\x128\n" +
 	"\amembers\x18\x01 \x03(\v2\x1e.ourspace_backend.proto.MemberR\amembers\x12(\n" +
-	"\x0fnext_page_token\x18\x02 \x01(\tR\x0fnext_page_token:\x1f\xbaG\x1c\xba\x01\amembers\xba\x01\x0fnext_page_token\"\xcb\x01\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\x0fnext_page_token:\x1f\xbaG\x1c\xba\x01\amembers\xba\x01\x0fnext_page_token\"\x98\x01\n" +
+	"\x14ExportMembersRequest\x12B\n" +
+	"\x06filter\x18\x01 \x01(\v2*.ourspace_backend.proto.ListMembersRequestR\x06filter\x12<\n" +
+	"\x06format\x18\x02 \x01(\x0e2$.ourspace_backend.proto.ExportFormatR\x06format\"\xe9\x01\n" +
 	"\x0fMemberPageToken\x129\n" +
 	"\x05field\x18\x01 \x01(\x0e2#.ourspace_backend.proto.MemberFieldR\x05field\x12\x1e\n" +
 	"\n" +
 	"last_value\x18\x02 \x01(\tR\n" +
 	"last_value\x12C\n" +
 	"\tdirection\x18\x03 \x01(\x0e2%.ourspace_backend.proto.SortDirectionR\tdirection\x12\x18\n" +
-	"\alast_id\x18\x04 \x01(\tR\alast_id\"\x89\x01\n" +
+	"\alast_id\x18\x04 \x01(\tR\alast_id\x12\x1c\n" +
+	"\tattribute\x18\x05 \x01(\tR\tattribute\"w\n" +
+	"\x14SearchMembersRequest\x12\x14\n" +
+	"\x05query\x18\x01 \x01(\tR\x05query\x12\x1c\n" +
+	"\tpage_size\x18\x02 \x01(\x05R\tpage_size\x12\x1e\n" +
+	"\n" +
+	"page_token\x18\x03 \x01(\tR\n" +
+	"page_token:\v\xbaG\b\xba\x01\x05query\"\xa8\x01\n" +
+	"\x15SearchMembersResponse\x12D\n" +
+	"\aresults\x18\x01 \x03(\v2*.ourspace_backend.proto.MemberSearchResultR\aresults\x12(\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\x0fnext_page_token:\x1f\xbaG\x1c\xba\x01\aresults\xba\x01\x0fnext_page_token\"\xb1\x01\n" +
+	"\x12MemberSearchResult\x126\n" +
+	"\x06member\x18\x01 \x01(\v2\x1e.ourspace_backend.proto.MemberR\x06member\x12\x14\n" +
+	"\x05score\x18\x02 \x01(\x01R\x05score\x12&\n" +
+	"\x0ematched_fields\x18\x03 \x03(\tR\x0ematched_fields:%\xbaG\"\xba\x01\x06member\xba\x01\x05score\xba\x01\x0ematched_fields\"/\n" +
+	"\x15MemberSearchPageToken\x12\x16\n" +
+	"\x06offset\x18\x01 \x01(\x05R\x06offset\"\x89\x01\n" +
 	"\x13UpdateMemberRequest\x126\n" +
 	"\x06member\x18\x01 \x01(\v2\x1e.ourspace_backend.proto.MemberR\x06member\x12:\n" +
 	"\n" +
 	"field_mask\x18\x02 \x01(\v2\x1a.google.protobuf.FieldMaskR\n" +
 	"field_mask\"%\n" +
 	"\x13DeleteMemberRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\"U\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\xe1\x02\n" +
+	"\x14ImportMembersRequest\x12\x10\n" +
+	"\x03csv\x18\x01 \x01(\tR\x03csv\x12g\n" +
+	"\x0ecolumn_mapping\x18\x02 \x03(\v2?.ourspace_backend.proto.ImportMembersRequest.ColumnMappingEntryR\x0ecolumn_mapping\x12\x1c\n" +
+	"\tdelimiter\x18\x03 \x01(\tR\tdelimiter\x12$\n" +
+	"\rtag_separator\x18\x04 \x01(\tR\rtag_separator\x12\x12\n" +
+	"\x04tags\x18\x05 \x03(\tR\x04tags\x12\x18\n" +
+	"\adry_run\x18\x06 \x01(\bR\adry_run\x1a@\n" +
+	"\x12ColumnMappingEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01:\x1a\xbaG\x17\xba\x01\x03csv\xba\x01\x0ecolumn_mapping\"\xab\x01\n" +
+	"\x15ImportMembersResponse\x12\"\n" +
+	"\fmember_count\x18\x01 \x01(\x05R\fmember_count\x12M\n" +
+	"\n" +
+	"violations\x18\x02 \x03(\v2-.ourspace_backend.proto.MemberImportViolationR\n" +
+	"violations:\x1f\xbaG\x1c\xba\x01\fmember_count\xba\x01\n" +
+	"violations\"y\n" +
+	"\x15MemberImportViolation\x12\x10\n" +
+	"\x03row\x18\x01 \x01(\x05R\x03row\x12\x16\n" +
+	"\x06column\x18\x02 \x01(\tR\x06column\x12\x14\n" +
+	"\x05field\x18\x03 \x01(\tR\x05field\x12 \n" +
+	"\vdescription\x18\x04 \x01(\tR\vdescription\"U\n" +
 	"\x15ListMemberTagsRequest\x12\x1c\n" +
 	"\tpage_size\x18\x01 \x01(\x05R\tpage_size\x12\x1e\n" +
 	"\n" +
 	"page_token\x18\x02 \x01(\tR\n" +
-	"page_token\"V\n" +
+	"page_token\"\x9b\x01\n" +
 	"\x16ListMemberTagsResponse\x12\x12\n" +
 	"\x04tags\x18\x01 \x03(\tR\x04tags\x12(\n" +
-	"\x0fnext_page_token\x18\x02 \x01(\tR\x0fnext_page_token\"-\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\x0fnext_page_token\x12C\n" +
+	"\vmember_tags\x18\x03 \x03(\v2!.ourspace_backend.proto.MemberTagR\vmember_tags\"\xb6\x01\n" +
+	"\tMemberTag\x12\x17\n" +
+	"\x04name\x18\x01 \x01(\tB\x03\xe0A\x05R\x04name\x12 \n" +
+	"\vdescription\x18\x02 \x01(\tR\vdescription\x12\x14\n" +
+	"\x05color\x18\x03 \x01(\tR\x05color\x12'\n" +
+	"\fmember_count\x18\x04 \x01(\x03B\x03\xe0A\x03R\fmember_count:/\xbaG,\xba\x01\x04name\xba\x01\vdescription\xba\x01\x05color\xba\x01\fmember_count\"X\n" +
+	"\x16CreateMemberTagRequest\x123\n" +
+	"\x03tag\x18\x01 \x01(\v2!.ourspace_backend.proto.MemberTagR\x03tag:\t\xbaG\x06\xba\x01\x03tag\"\xa1\x01\n" +
+	"\x16UpdateMemberTagRequest\x123\n" +
+	"\x03tag\x18\x01 \x01(\v2!.ourspace_backend.proto.MemberTagR\x03tag\x12:\n" +
+	"\n" +
+	"field_mask\x18\x02 \x01(\v2\x1a.google.protobuf.FieldMaskR\n" +
+	"field_mask:\x16\xbaG\x13\xba\x01\x03tag\xba\x01\n" +
+	"field_mask\"^\n" +
+	"\x16DeleteMemberTagRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x120\n" +
+	"\x13remove_from_members\x18\x02 \x01(\bR\x13remove_from_members\"H\n" +
+	"\x16RenameMemberTagRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1a\n" +
+	"\bnew_name\x18\x02 \x01(\tR\bnew_name\"P\n" +
+	"\x16MergeMemberTagsRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\"\n" +
+	"\fsource_names\x18\x02 \x03(\tR\fsource_names\"-\n" +
 	"\x13MemberTagsPageToken\x12\x16\n" +
 	"\x06offset\x18\x01 \x01(\x05R\x06offset\"e\n" +
 	"\x1cCreateMemberAttributeRequest\x12E\n" +
@@ -3893,22 +15058,159 @@ const file_ourspace_backend_proto_api_proto_rawDesc = "" +
 	"\x1cUpdateMemberAttributeRequest\x12E\n" +
 	"\tattribute\x18\x01 \x01(\v2'.ourspace_backend.proto.MemberAttributeR\tattribute\x129\n" +
 	"\n" +
-	"field_mask\x18\x02 \x01(\v2\x1a.google.protobuf.FieldMaskR\tfieldMask\".\n" +
+	"field_mask\x18\x02 \x01(\v2\x1a.google.protobuf.FieldMaskR\tfieldMask\"R\n" +
 	"\x1cDeleteMemberAttributeRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\"\x9b\x03\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\"\n" +
+	"\fpurge_values\x18\x02 \x01(\bR\fpurge_values\"\xff\x01\n" +
+	"\x1dMigrateMemberAttributeRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12&\n" +
+	"\x0etechnical_name\x18\x02 \x01(\tR\x0etechnical_name\x12@\n" +
+	"\x04type\x18\x03 \x01(\x0e2,.ourspace_backend.proto.MemberAttribute.TypeR\x04type\x12\x18\n" +
+	"\aoptions\x18\x04 \x03(\tR\aoptions\x120\n" +
+	"\x13drop_invalid_values\x18\x05 \x01(\bR\x13drop_invalid_values\x12\x18\n" +
+	"\adry_run\x18\x06 \x01(\bR\adry_run\"\xbf\x02\n" +
+	"\x1eMigrateMemberAttributeResponse\x12E\n" +
+	"\tattribute\x18\x01 \x01(\v2'.ourspace_backend.proto.MemberAttributeR\tattribute\x12\"\n" +
+	"\fmember_count\x18\x02 \x01(\x05R\fmember_count\x12]\n" +
+	"\x0einvalid_values\x18\x03 \x03(\v25.ourspace_backend.proto.MemberAttributeMigrationIssueR\x0einvalid_values\x12\x18\n" +
+	"\aapplied\x18\x04 \x01(\bR\aapplied:9\xbaG6\xba\x01\tattribute\xba\x01\fmember_count\xba\x01\x0einvalid_values\xba\x01\aapplied\"\xcc\x01\n" +
+	"\x1dMemberAttributeMigrationIssue\x12\x1c\n" +
+	"\tmember_id\x18\x01 \x01(\tR\tmember_id\x12 \n" +
+	"\vmember_name\x18\x02 \x01(\tR\vmember_name\x12\x14\n" +
+	"\x05value\x18\x03 \x01(\tR\x05value\x12 \n" +
+	"\vdescription\x18\x04 \x01(\tR\vdescription:3\xbaG0\xba\x01\tmember_id\xba\x01\vmember_name\xba\x01\x05value\xba\x01\vdescription\"\xd8\x03\n" +
+	"\x12MemberRelationship\x12\x13\n" +
+	"\x02id\x18\x01 \x01(\tB\x03\xe0A\x03R\x02id\x12!\n" +
+	"\tmember_id\x18\x02 \x01(\tB\x03\xe0A\x05R\tmember_id\x12C\n" +
+	"\x04type\x18\x03 \x01(\x0e2/.ourspace_backend.proto.MemberRelationship.TypeR\x04type\x121\n" +
+	"\x11related_member_id\x18\x04 \x01(\tH\x00R\x11related_member_id\x88\x01\x01\x12\x12\n" +
+	"\x04name\x18\x05 \x01(\tR\x04name\x12\x14\n" +
+	"\x05email\x18\x06 \x01(\tR\x05email\x12\x14\n" +
+	"\x05phone\x18\a \x01(\tR\x05phone\x12 \n" +
+	"\vdescription\x18\b \x01(\tR\vdescription\"X\n" +
+	"\x04Type\x12\x10\n" +
+	"\fTYPE_UNKNOWN\x10\x00\x12\x11\n" +
+	"\rTYPE_GUARDIAN\x10\x01\x12\x1a\n" +
+	"\x16TYPE_EMERGENCY_CONTACT\x10\x02\x12\x0f\n" +
+	"\vTYPE_FAMILY\x10\x03:@\xbaG=\xba\x01\x02id\xba\x01\tmember_id\xba\x01\x04type\xba\x01\x04name\xba\x01\x05email\xba\x01\x05phone\xba\x01\vdescriptionB\x14\n" +
+	"\x12_related_member_id\"\x85\x01\n" +
+	"\x1fCreateMemberRelationshipRequest\x12N\n" +
+	"\frelationship\x18\x01 \x01(\v2*.ourspace_backend.proto.MemberRelationshipR\frelationship:\x12\xbaG\x0f\xba\x01\frelationship\">\n" +
+	"\x1eListMemberRelationshipsRequest\x12\x1c\n" +
+	"\tmember_id\x18\x01 \x01(\tR\tmember_id\"\x88\x01\n" +
+	"\x1fListMemberRelationshipsResponse\x12P\n" +
+	"\rrelationships\x18\x01 \x03(\v2*.ourspace_backend.proto.MemberRelationshipR\rrelationships:\x13\xbaG\x10\xba\x01\rrelationships\"\xce\x01\n" +
+	"\x1fUpdateMemberRelationshipRequest\x12N\n" +
+	"\frelationship\x18\x01 \x01(\v2*.ourspace_backend.proto.MemberRelationshipR\frelationship\x12:\n" +
+	"\n" +
+	"field_mask\x18\x02 \x01(\v2\x1a.google.protobuf.FieldMaskR\n" +
+	"field_mask:\x1f\xbaG\x1c\xba\x01\frelationship\xba\x01\n" +
+	"field_mask\"O\n" +
+	"\x1fDeleteMemberRelationshipRequest\x12\x1c\n" +
+	"\tmember_id\x18\x01 \x01(\tR\tmember_id\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\tR\x02id\"\xfd\x02\n" +
+	"\rMemberConsent\x12\x13\n" +
+	"\x02id\x18\x01 \x01(\tB\x03\xe0A\x03R\x02id\x12\x1c\n" +
+	"\tmember_id\x18\x02 \x01(\tR\tmember_id\x12\x18\n" +
+	"\apurpose\x18\x03 \x01(\tR\apurpose\x12-\n" +
+	"\x0frelationship_id\x18\x04 \x01(\tH\x00R\x0frelationship_id\x88\x01\x01\x12\x1f\n" +
+	"\bgiven_by\x18\x05 \x01(\tB\x03\xe0A\x03R\bgiven_by\x12>\n" +
+	"\fconsent_time\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\fconsent_time\x12A\n" +
+	"\vrevoke_time\x18\a \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x03R\vrevoke_time:8\xbaG5\xba\x01\x02id\xba\x01\tmember_id\xba\x01\apurpose\xba\x01\bgiven_by\xba\x01\fconsent_timeB\x12\n" +
+	"\x10_relationship_id\"l\n" +
+	"\x1aCreateMemberConsentRequest\x12?\n" +
+	"\aconsent\x18\x01 \x01(\v2%.ourspace_backend.proto.MemberConsentR\aconsent:\r\xbaG\n" +
+	"\xba\x01\aconsent\"9\n" +
+	"\x19ListMemberConsentsRequest\x12\x1c\n" +
+	"\tmember_id\x18\x01 \x01(\tR\tmember_id\"o\n" +
+	"\x1aListMemberConsentsResponse\x12A\n" +
+	"\bconsents\x18\x01 \x03(\v2%.ourspace_backend.proto.MemberConsentR\bconsents:\x0e\xbaG\v\xba\x01\bconsents\"J\n" +
+	"\x1aRevokeMemberConsentRequest\x12\x1c\n" +
+	"\tmember_id\x18\x01 \x01(\tR\tmember_id\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\tR\x02id\"\xc1\x05\n" +
+	"\x13MemberChangeRequest\x12\x13\n" +
+	"\x02id\x18\x01 \x01(\tB\x03\xe0A\x03R\x02id\x12!\n" +
+	"\tmember_id\x18\x02 \x01(\tB\x03\xe0A\x03R\tmember_id\x126\n" +
+	"\x06member\x18\x03 \x01(\v2\x1e.ourspace_backend.proto.MemberR\x06member\x12:\n" +
+	"\n" +
+	"field_mask\x18\x04 \x01(\v2\x1a.google.protobuf.FieldMaskR\n" +
+	"field_mask\x12L\n" +
+	"\x05state\x18\x05 \x01(\x0e21.ourspace_backend.proto.MemberChangeRequest.StateB\x03\xe0A\x03R\x05state\x12A\n" +
+	"\vcreate_time\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x03R\vcreate_time\x12'\n" +
+	"\frequested_by\x18\a \x01(\tB\x03\xe0A\x03R\frequested_by\x12A\n" +
+	"\vdecide_time\x18\b \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x03R\vdecide_time\x12#\n" +
+	"\n" +
+	"decided_by\x18\t \x01(\tB\x03\xe0A\x03R\n" +
+	"decided_by\x12\x1d\n" +
+	"\acomment\x18\n" +
+	" \x01(\tB\x03\xe0A\x03R\acomment\"U\n" +
+	"\x05State\x12\x11\n" +
+	"\rSTATE_UNKNOWN\x10\x00\x12\x11\n" +
+	"\rSTATE_PENDING\x10\x01\x12\x12\n" +
+	"\x0eSTATE_APPROVED\x10\x02\x12\x12\n" +
+	"\x0eSTATE_REJECTED\x10\x03:f\xbaGc\xba\x01\x02id\xba\x01\tmember_id\xba\x01\x06member\xba\x01\n" +
+	"field_mask\xba\x01\x05state\xba\x01\vcreate_time\xba\x01\frequested_by\xba\x01\n" +
+	"decided_by\xba\x01\acomment\"\xd9\x01\n" +
+	"\x1fListMemberChangeRequestsRequest\x12\x1c\n" +
+	"\tpage_size\x18\x01 \x01(\x05R\tpage_size\x12\x1e\n" +
+	"\n" +
+	"page_token\x18\x02 \x01(\tR\n" +
+	"page_token\x12G\n" +
+	"\x05state\x18\x03 \x01(\x0e21.ourspace_backend.proto.MemberChangeRequest.StateR\x05state\x12!\n" +
+	"\tmember_id\x18\x04 \x01(\tH\x00R\tmember_id\x88\x01\x01B\f\n" +
+	"\n" +
+	"_member_id\"\xcc\x01\n" +
+	" ListMemberChangeRequestsResponse\x12U\n" +
+	"\x0fchange_requests\x18\x01 \x03(\v2+.ourspace_backend.proto.MemberChangeRequestR\x0fchange_requests\x12(\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\x0fnext_page_token:'\xbaG$\xba\x01\x0fchange_requests\xba\x01\x0fnext_page_token\"6\n" +
+	"\x1cMemberChangeRequestPageToken\x12\x16\n" +
+	"\x06offset\x18\x01 \x01(\x05R\x06offset\"L\n" +
+	" DecideMemberChangeRequestRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x18\n" +
+	"\acomment\x18\x02 \x01(\tR\acomment\"\x9e\b\n" +
 	"\x0fMemberAttribute\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12+\n" +
 	"\x0etechnical_name\x18\x02 \x01(\tB\x03\xe0A\x05R\x0etechnical_name\x12\"\n" +
 	"\fdisplay_name\x18\x03 \x01(\tR\fdisplay_name\x12E\n" +
 	"\x04type\x18\x04 \x01(\x0e2,.ourspace_backend.proto.MemberAttribute.TypeB\x03\xe0A\x05R\x04type\x12 \n" +
-	"\vdescription\x18\x05 \x01(\tR\vdescription\"\x7f\n" +
+	"\vdescription\x18\x05 \x01(\tR\vdescription\x12\x18\n" +
+	"\aoptions\x18\x06 \x03(\tR\aoptions\x12\x1a\n" +
+	"\brequired\x18\a \x01(\bR\brequired\x12\x16\n" +
+	"\x06unique\x18\b \x01(\bR\x06unique\x12\x18\n" +
+	"\apattern\x18\t \x01(\tR\apattern\x12\x15\n" +
+	"\x03min\x18\n" +
+	" \x01(\tH\x00R\x03min\x88\x01\x01\x12\x15\n" +
+	"\x03max\x18\v \x01(\tH\x01R\x03max\x88\x01\x01\x12U\n" +
+	"\vsensitivity\x18\f \x01(\x0e23.ourspace_backend.proto.MemberAttribute.SensitivityR\vsensitivity\x12\x1e\n" +
+	"\n" +
+	"read_roles\x18\r \x03(\tR\n" +
+	"read_roles\x12 \n" +
+	"\vwrite_roles\x18\x0e \x03(\tR\vwrite_roles\"\xf9\x01\n" +
 	"\x04Type\x12\x10\n" +
 	"\fTYPE_UNKNOWN\x10\x00\x12\x19\n" +
 	"\x15TYPE_TEXT_SINGLE_LINE\x10\x01\x12\x17\n" +
 	"\x13TYPE_TEXT_MULI_LINE\x10\x02\x12\x0f\n" +
 	"\vTYPE_NUMBER\x10\x03\x12\r\n" +
 	"\tTYPE_DATE\x10\x04\x12\x11\n" +
-	"\rTYPE_DATETIME\x10\x05:=\xbaG:\xba\x01\x02id\xba\x01\x0etechnical_name\xba\x01\fdisplay_name\xba\x01\x04type\xba\x01\vdescription\"\xdd\x01\n" +
+	"\rTYPE_DATETIME\x10\x05\x12\x0f\n" +
+	"\vTYPE_SELECT\x10\x06\x12\x15\n" +
+	"\x11TYPE_MULTI_SELECT\x10\a\x12\x10\n" +
+	"\fTYPE_BOOLEAN\x10\b\x12\x0e\n" +
+	"\n" +
+	"TYPE_EMAIL\x10\t\x12\x0e\n" +
+	"\n" +
+	"TYPE_PHONE\x10\n" +
+	"\x12\f\n" +
+	"\bTYPE_URL\x10\v\x12\x10\n" +
+	"\fTYPE_DECIMAL\x10\f\"t\n" +
+	"\vSensitivity\x12\x17\n" +
+	"\x13SENSITIVITY_UNKNOWN\x10\x00\x12\x16\n" +
+	"\x12SENSITIVITY_PUBLIC\x10\x01\x12\x18\n" +
+	"\x14SENSITIVITY_INTERNAL\x10\x02\x12\x1a\n" +
+	"\x16SENSITIVITY_RESTRICTED\x10\x03:\x8f\x01\xbaG\x8b\x01\xba\x01\x02id\xba\x01\x0etechnical_name\xba\x01\fdisplay_name\xba\x01\x04type\xba\x01\vdescription\xba\x01\aoptions\xba\x01\brequired\xba\x01\x06unique\xba\x01\apattern\xba\x01\vsensitivity\xba\x01\n" +
+	"read_roles\xba\x01\vwrite_rolesB\x06\n" +
+	"\x04_minB\x06\n" +
+	"\x04_max\"\xdd\x01\n" +
 	"\x18MemberAttributePageToken\x12B\n" +
 	"\x05field\x18\x01 \x01(\x0e2,.ourspace_backend.proto.MemberAttributeFieldR\x05field\x12\x1e\n" +
 	"\n" +
@@ -3954,7 +15256,10 @@ const file_ourspace_backend_proto_api_proto_rawDesc = "" +
 	"rfid_value\"\x90\x01\n" +
 	"\x11ListCardsResponse\x122\n" +
 	"\x05cards\x18\x01 \x03(\v2\x1c.ourspace_backend.proto.CardR\x05cards\x12(\n" +
-	"\x0fnext_page_token\x18\x02 \x01(\tR\x0fnext_page_token:\x1d\xbaG\x1a\xba\x01\x05cards\xba\x01\x0fnext_page_token\"\x81\x01\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\x0fnext_page_token:\x1d\xbaG\x1a\xba\x01\x05cards\xba\x01\x0fnext_page_token\"\x94\x01\n" +
+	"\x12ExportCardsRequest\x12@\n" +
+	"\x06filter\x18\x01 \x01(\v2(.ourspace_backend.proto.ListCardsRequestR\x06filter\x12<\n" +
+	"\x06format\x18\x02 \x01(\x0e2$.ourspace_backend.proto.ExportFormatR\x06format\"\x81\x01\n" +
 	"\x11UpdateCardRequest\x120\n" +
 	"\x04card\x18\x01 \x01(\v2\x1c.ourspace_backend.proto.CardR\x04card\x12:\n" +
 	"\n" +
@@ -3966,7 +15271,9 @@ const file_ourspace_backend_proto_api_proto_rawDesc = "" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\"\n" +
 	"\fdisplay_name\x18\x02 \x01(\tR\fdisplay_name\x12 \n" +
 	"\vdescription\x18\x03 \x01(\tR\vdescription\x12?\n" +
-	"\rexpires_after\x18\x04 \x01(\v2\x19.google.protobuf.DurationR\rexpires_after:5\xbaG2\xba\x01\x02id\xba\x01\fdisplay_name\xba\x01\vdescription\xba\x01\rexpires_after\"\x93\x01\n" +
+	"\rexpires_after\x18\x04 \x01(\v2\x19.google.protobuf.DurationR\rexpires_after:5\xbaG2\xba\x01\x02id\xba\x01\fdisplay_name\xba\x01\vdescription\xba\x01\rexpires_after\"-\n" +
+	"\x11BriefingPageToken\x12\x18\n" +
+	"\alast_id\x18\x01 \x01(\tR\alast_id\"\x93\x01\n" +
 	"\x19CreateBriefingTypeRequest\x12*\n" +
 	"\x10briefing_type_id\x18\x01 \x01(\tR\x10briefing_type_id\x12J\n" +
 	"\rbriefing_type\x18\x02 \x01(\v2$.ourspace_backend.proto.BriefingTypeR\rbriefing_type\"(\n" +
@@ -3986,20 +15293,31 @@ const file_ourspace_backend_proto_api_proto_rawDesc = "" +
 	"field_mask\x18\x02 \x01(\v2\x1a.google.protobuf.FieldMaskR\n" +
 	"field_mask\"+\n" +
 	"\x19DeleteBriefingTypeRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\"Z\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\x99\x02\n" +
 	"\bBriefing\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12$\n" +
-	"\rbriefing_type\x18\x02 \x01(\tR\rbriefing_type:\x18\xbaG\x15\xba\x01\x02id\xba\x01\rbriefing_type\"w\n" +
+	"\rbriefing_type\x18\x02 \x01(\tR\rbriefing_type\x12\x1c\n" +
+	"\tmember_id\x18\x03 \x01(\tR\tmember_id\x12@\n" +
+	"\rbriefing_time\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\rbriefing_time\x12A\n" +
+	"\vexpiry_time\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x03R\vexpiry_time:4\xbaG1\xba\x01\x02id\xba\x01\rbriefing_type\xba\x01\tmember_id\xba\x01\rbriefing_time\"w\n" +
 	"\x15CreateBriefingRequest\x12 \n" +
 	"\vbriefing_id\x18\x01 \x01(\tR\vbriefing_id\x12<\n" +
 	"\bbriefing\x18\x02 \x01(\v2 .ourspace_backend.proto.BriefingR\bbriefing\"$\n" +
 	"\x12GetBriefingRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\"T\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\xe2\x01\n" +
 	"\x14ListBriefingsRequest\x12\x1c\n" +
 	"\tpage_size\x18\x01 \x01(\x05R\tpage_size\x12\x1e\n" +
 	"\n" +
 	"page_token\x18\x02 \x01(\tR\n" +
-	"page_token\"\x81\x01\n" +
+	"page_token\x12!\n" +
+	"\tmember_id\x18\x03 \x01(\tH\x00R\tmember_id\x88\x01\x01\x12)\n" +
+	"\rbriefing_type\x18\x04 \x01(\tH\x01R\rbriefing_type\x88\x01\x01\x12\x1e\n" +
+	"\n" +
+	"valid_only\x18\x05 \x01(\bR\n" +
+	"valid_onlyB\f\n" +
+	"\n" +
+	"_member_idB\x10\n" +
+	"\x0e_briefing_type\"\x81\x01\n" +
 	"\x15ListBriefingsResponse\x12>\n" +
 	"\tbriefings\x18\x01 \x03(\v2 .ourspace_backend.proto.BriefingR\tbriefings\x12(\n" +
 	"\x0fnext_page_token\x18\x02 \x01(\tR\x0fnext_page_token\"\x91\x01\n" +
@@ -4009,12 +15327,15 @@ const file_ourspace_backend_proto_api_proto_rawDesc = "" +
 	"field_mask\x18\x02 \x01(\v2\x1a.google.protobuf.FieldMaskR\n" +
 	"field_mask\"'\n" +
 	"\x15DeleteBriefingRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\"\xef\x01\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\xe0\x02\n" +
 	"\bPresence\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1c\n" +
 	"\tmember_id\x18\x02 \x01(\tR\tmember_id\x12>\n" +
 	"\fcheckin_time\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\fcheckin_time\x12@\n" +
-	"\rcheckout_time\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\rcheckout_time:3\xbaG0\xba\x01\x02id\xba\x01\tmember_id\xba\x01\fcheckin_time\xba\x01\rcheckout_time\"\xc1\x05\n" +
+	"\rcheckout_time\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\rcheckout_time\x12%\n" +
+	"\vauto_closed\x18\x05 \x01(\bB\x03\xe0A\x03R\vauto_closed\x12*\n" +
+	"\vterminal_id\x18\x06 \x01(\tB\x03\xe0A\x03H\x00R\vterminal_id\x88\x01\x01:A\xbaG>\xba\x01\x02id\xba\x01\tmember_id\xba\x01\fcheckin_time\xba\x01\rcheckout_time\xba\x01\vauto_closedB\x0e\n" +
+	"\f_terminal_id\"\xc1\x05\n" +
 	"\x14ListPresencesRequest\x12\x1c\n" +
 	"\tpage_size\x18\x01 \x01(\x05R\tpage_size\x12\x1e\n" +
 	"\n" +
@@ -4035,7 +15356,10 @@ const file_ourspace_backend_proto_api_proto_rawDesc = "" +
 	"\x15_checkout_time_before\"\xa1\x01\n" +
 	"\x15ListPresencesResponse\x12<\n" +
 	"\bpresence\x18\x01 \x03(\v2 .ourspace_backend.proto.PresenceR\bpresence\x12(\n" +
-	"\x0fnext_page_token\x18\x02 \x01(\tR\x0fnext_page_token: \xbaG\x1d\xba\x01\bpresence\xba\x01\x0fnext_page_token\"\xcf\x01\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\x0fnext_page_token: \xbaG\x1d\xba\x01\bpresence\xba\x01\x0fnext_page_token\"\x9c\x01\n" +
+	"\x16ExportPresencesRequest\x12D\n" +
+	"\x06filter\x18\x01 \x01(\v2,.ourspace_backend.proto.ListPresencesRequestR\x06filter\x12<\n" +
+	"\x06format\x18\x02 \x01(\x0e2$.ourspace_backend.proto.ExportFormatR\x06format\"\xcf\x01\n" +
 	"\x11PresencePageToken\x12;\n" +
 	"\x05field\x18\x01 \x01(\x0e2%.ourspace_backend.proto.PresenceFieldR\x05field\x12\x1e\n" +
 	"\n" +
@@ -4046,14 +15370,742 @@ const file_ourspace_backend_proto_api_proto_rawDesc = "" +
 	"\x0eCheckinRequest\x12\x1c\n" +
 	"\tmember_id\x18\x01 \x01(\tR\tmember_id\"/\n" +
 	"\x0fCheckoutRequest\x12\x1c\n" +
-	"\tmember_id\x18\x01 \x01(\tR\tmember_id\"\x91\x01\n" +
+	"\tmember_id\x18\x01 \x01(\tR\tmember_id\"5\n" +
+	"\x15TogglePresenceRequest\x12\x1c\n" +
+	"\tmember_id\x18\x01 \x01(\tR\tmember_id\"\xaf\x01\n" +
+	"\x16TogglePresenceResponse\x12<\n" +
+	"\bpresence\x18\x01 \x01(\v2 .ourspace_backend.proto.PresenceR\bpresence\x12>\n" +
+	"\x06action\x18\x02 \x01(\x0e2&.ourspace_backend.proto.PresenceActionR\x06action:\x17\xbaG\x14\xba\x01\bpresence\xba\x01\x06action\"X\n" +
+	"\x14CheckinByCardRequest\x12\x1e\n" +
+	"\n" +
+	"rfid_value\x18\x01 \x01(\fR\n" +
+	"rfid_value\x12 \n" +
+	"\vterminal_id\x18\x02 \x01(\tR\vterminal_id\"\x91\x01\n" +
 	"\x15UpdatePresenceRequest\x12<\n" +
 	"\bpresence\x18\x01 \x01(\v2 .ourspace_backend.proto.PresenceR\bpresence\x12:\n" +
 	"\n" +
 	"field_mask\x18\x02 \x01(\v2\x1a.google.protobuf.FieldMaskR\n" +
 	"field_mask\"'\n" +
 	"\x15DeletePresenceRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\"\xe4\x01\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\x9c\x05\n" +
+	"\x05Event\x12\x13\n" +
+	"\x02id\x18\x01 \x01(\tB\x03\xe0A\x03R\x02id\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12:\n" +
+	"\n" +
+	"start_time\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"start_time\x126\n" +
+	"\bend_time\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\bend_time\x12\x1a\n" +
+	"\blocation\x18\x06 \x01(\tR\blocation\x12\x1a\n" +
+	"\bcapacity\x18\a \x01(\x05R\bcapacity\x128\n" +
+	"\x17required_briefing_types\x18\b \x03(\tR\x17required_briefing_types\x12[\n" +
+	"\x16allowed_age_categories\x18\t \x03(\x0e2#.ourspace_backend.proto.AgeCategoryR\x16allowed_age_categories\x12/\n" +
+	"\x10registered_count\x18\n" +
+	" \x01(\x05B\x03\xe0A\x03R\x10registered_count\x12+\n" +
+	"\x0ewaitlist_count\x18\v \x01(\x05B\x03\xe0A\x03R\x0ewaitlist_count:\xa4\x01\xbaG\xa0\x01\xba\x01\x02id\xba\x01\x05title\xba\x01\vdescription\xba\x01\n" +
+	"start_time\xba\x01\bend_time\xba\x01\blocation\xba\x01\bcapacity\xba\x01\x17required_briefing_types\xba\x01\x16allowed_age_categories\xba\x01\x10registered_count\xba\x01\x0ewaitlist_count\"\xc9\x01\n" +
+	"\x0eEventPageToken\x128\n" +
+	"\x05field\x18\x01 \x01(\x0e2\".ourspace_backend.proto.EventFieldR\x05field\x12\x1e\n" +
+	"\n" +
+	"last_value\x18\x02 \x01(\tR\n" +
+	"last_value\x12C\n" +
+	"\tdirection\x18\x03 \x01(\x0e2%.ourspace_backend.proto.SortDirectionR\tdirection\x12\x18\n" +
+	"\alast_id\x18\x04 \x01(\tR\alast_id\"e\n" +
+	"\x12CreateEventRequest\x12\x1a\n" +
+	"\bevent_id\x18\x01 \x01(\tR\bevent_id\x123\n" +
+	"\x05event\x18\x02 \x01(\v2\x1d.ourspace_backend.proto.EventR\x05event\"!\n" +
+	"\x0fGetEventRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\xa5\x03\n" +
+	"\x11ListEventsRequest\x12\x1c\n" +
+	"\tpage_size\x18\x01 \x01(\x05R\tpage_size\x12\x1e\n" +
+	"\n" +
+	"page_token\x18\x02 \x01(\tR\n" +
+	"page_token\x12<\n" +
+	"\asort_by\x18\x03 \x01(\x0e2\".ourspace_backend.proto.EventFieldR\asort_by\x12M\n" +
+	"\x0esort_direction\x18\x04 \x01(\x0e2%.ourspace_backend.proto.SortDirectionR\x0esort_direction\x12K\n" +
+	"\x10start_time_after\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampH\x00R\x10start_time_after\x88\x01\x01\x12M\n" +
+	"\x11start_time_before\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampH\x01R\x11start_time_before\x88\x01\x01B\x13\n" +
+	"\x11_start_time_afterB\x14\n" +
+	"\x12_start_time_before\"\x95\x01\n" +
+	"\x12ListEventsResponse\x125\n" +
+	"\x06events\x18\x01 \x03(\v2\x1d.ourspace_backend.proto.EventR\x06events\x12(\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\x0fnext_page_token:\x1e\xbaG\x1b\xba\x01\x06events\xba\x01\x0fnext_page_token\"\x85\x01\n" +
+	"\x12UpdateEventRequest\x123\n" +
+	"\x05event\x18\x01 \x01(\v2\x1d.ourspace_backend.proto.EventR\x05event\x12:\n" +
+	"\n" +
+	"field_mask\x18\x02 \x01(\v2\x1a.google.protobuf.FieldMaskR\n" +
+	"field_mask\"$\n" +
+	"\x12DeleteEventRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\xb8\x03\n" +
+	"\x11EventRegistration\x12\x13\n" +
+	"\x02id\x18\x01 \x01(\tB\x03\xe0A\x03R\x02id\x12\x1a\n" +
+	"\bevent_id\x18\x02 \x01(\tR\bevent_id\x12\x1c\n" +
+	"\tmember_id\x18\x03 \x01(\tR\tmember_id\x12L\n" +
+	"\x06status\x18\x04 \x01(\x0e2/.ourspace_backend.proto.EventRegistrationStatusB\x03\xe0A\x03R\x06status\x12M\n" +
+	"\x11registration_time\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x03R\x11registration_time\x12M\n" +
+	"\x11cancellation_time\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x03R\x11cancellation_time\x12\x1f\n" +
+	"\battended\x18\a \x01(\bB\x03\xe0A\x03R\battended:G\xbaGD\xba\x01\x02id\xba\x01\bevent_id\xba\x01\tmember_id\xba\x01\x06status\xba\x01\x11registration_time\xba\x01\battended\"\x8a\x01\n" +
+	"\x1aEventRegistrationPageToken\x12R\n" +
+	"\x16last_registration_time\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\x16last_registration_time\x12\x18\n" +
+	"\alast_id\x18\x02 \x01(\tR\alast_id\"S\n" +
+	"\x17RegisterForEventRequest\x12\x1a\n" +
+	"\bevent_id\x18\x01 \x01(\tR\bevent_id\x12\x1c\n" +
+	"\tmember_id\x18\x02 \x01(\tR\tmember_id\"L\n" +
+	"\x1eCancelEventRegistrationRequest\x12\x1a\n" +
+	"\bevent_id\x18\x01 \x01(\tR\bevent_id\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\tR\x02id\"\x83\x02\n" +
+	"\x1dListEventRegistrationsRequest\x12\x1a\n" +
+	"\bevent_id\x18\x01 \x01(\tR\bevent_id\x12\x1c\n" +
+	"\tpage_size\x18\x02 \x01(\x05R\tpage_size\x12\x1e\n" +
+	"\n" +
+	"page_token\x18\x03 \x01(\tR\n" +
+	"page_token\x12L\n" +
+	"\x06status\x18\x04 \x01(\x0e2/.ourspace_backend.proto.EventRegistrationStatusH\x00R\x06status\x88\x01\x01\x12!\n" +
+	"\tmember_id\x18\x05 \x01(\tH\x01R\tmember_id\x88\x01\x01B\t\n" +
+	"\a_statusB\f\n" +
+	"\n" +
+	"_member_id\"\xc2\x01\n" +
+	"\x1eListEventRegistrationsResponse\x12O\n" +
+	"\rregistrations\x18\x01 \x03(\v2).ourspace_backend.proto.EventRegistrationR\rregistrations\x12(\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\x0fnext_page_token:%\xbaG\"\xba\x01\rregistrations\xba\x01\x0fnext_page_token\"d\n" +
+	"\x1aMarkEventAttendanceRequest\x12\x1a\n" +
+	"\bevent_id\x18\x01 \x01(\tR\bevent_id\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\tR\x02id\x12\x1a\n" +
+	"\battended\x18\x03 \x01(\bR\battended\"\x80\x03\n" +
+	"\x04Item\x12\x13\n" +
+	"\x02id\x18\x01 \x01(\tB\x03\xe0A\x03R\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12\x1e\n" +
+	"\n" +
+	"rfid_value\x18\x04 \x01(\fR\n" +
+	"rfid_value\x12\x18\n" +
+	"\abarcode\x18\x05 \x01(\tR\abarcode\x12(\n" +
+	"\x0fcondition_notes\x18\x06 \x01(\tR\x0fcondition_notes\x128\n" +
+	"\x17required_briefing_types\x18\a \x03(\tR\x17required_briefing_types\x12!\n" +
+	"\tavailable\x18\b \x01(\bB\x03\xe0A\x03R\tavailable:l\xbaGi\xba\x01\x02id\xba\x01\x04name\xba\x01\vdescription\xba\x01\n" +
+	"rfid_value\xba\x01\abarcode\xba\x01\x0fcondition_notes\xba\x01\x17required_briefing_types\xba\x01\tavailable\"\xc7\x01\n" +
+	"\rItemPageToken\x127\n" +
+	"\x05field\x18\x01 \x01(\x0e2!.ourspace_backend.proto.ItemFieldR\x05field\x12\x1e\n" +
+	"\n" +
+	"last_value\x18\x02 \x01(\tR\n" +
+	"last_value\x12C\n" +
+	"\tdirection\x18\x03 \x01(\x0e2%.ourspace_backend.proto.SortDirectionR\tdirection\x12\x18\n" +
+	"\alast_id\x18\x04 \x01(\tR\alast_id\"_\n" +
+	"\x11CreateItemRequest\x12\x18\n" +
+	"\aitem_id\x18\x01 \x01(\tR\aitem_id\x120\n" +
+	"\x04item\x18\x02 \x01(\v2\x1c.ourspace_backend.proto.ItemR\x04item\" \n" +
+	"\x0eGetItemRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\x8d\x02\n" +
+	"\x10ListItemsRequest\x12\x1c\n" +
+	"\tpage_size\x18\x01 \x01(\x05R\tpage_size\x12\x1e\n" +
+	"\n" +
+	"page_token\x18\x02 \x01(\tR\n" +
+	"page_token\x12;\n" +
+	"\asort_by\x18\x03 \x01(\x0e2!.ourspace_backend.proto.ItemFieldR\asort_by\x12M\n" +
+	"\x0esort_direction\x18\x04 \x01(\x0e2%.ourspace_backend.proto.SortDirectionR\x0esort_direction\x12!\n" +
+	"\tavailable\x18\x05 \x01(\bH\x00R\tavailable\x88\x01\x01B\f\n" +
+	"\n" +
+	"_available\"\x90\x01\n" +
+	"\x11ListItemsResponse\x122\n" +
+	"\x05items\x18\x01 \x03(\v2\x1c.ourspace_backend.proto.ItemR\x05items\x12(\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\x0fnext_page_token:\x1d\xbaG\x1a\xba\x01\x05items\xba\x01\x0fnext_page_token\"\x81\x01\n" +
+	"\x11UpdateItemRequest\x120\n" +
+	"\x04item\x18\x01 \x01(\v2\x1c.ourspace_backend.proto.ItemR\x04item\x12:\n" +
+	"\n" +
+	"field_mask\x18\x02 \x01(\v2\x1a.google.protobuf.FieldMaskR\n" +
+	"field_mask\"#\n" +
+	"\x11DeleteItemRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\x8a\x04\n" +
+	"\x04Loan\x12\x13\n" +
+	"\x02id\x18\x01 \x01(\tB\x03\xe0A\x03R\x02id\x12\x18\n" +
+	"\aitem_id\x18\x02 \x01(\tR\aitem_id\x12\x1c\n" +
+	"\tmember_id\x18\x03 \x01(\tR\tmember_id\x12=\n" +
+	"\tlend_time\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x03R\tlend_time\x12N\n" +
+	"\x14expected_return_time\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\x14expected_return_time\x12A\n" +
+	"\vreturn_time\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x03R\vreturn_time\x12;\n" +
+	"\x16return_condition_notes\x18\a \x01(\tB\x03\xe0A\x03R\x16return_condition_notes\x12*\n" +
+	"\vterminal_id\x18\b \x01(\tB\x03\xe0A\x03H\x00R\vterminal_id\x88\x01\x01\x12\x1d\n" +
+	"\aoverdue\x18\t \x01(\bB\x03\xe0A\x03R\aoverdue:K\xbaGH\xba\x01\x02id\xba\x01\aitem_id\xba\x01\tmember_id\xba\x01\tlend_time\xba\x01\x14expected_return_time\xba\x01\aoverdueB\x0e\n" +
+	"\f_terminal_id\"m\n" +
+	"\rLoanPageToken\x12B\n" +
+	"\x0elast_lend_time\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\x0elast_lend_time\x12\x18\n" +
+	"\alast_id\x18\x02 \x01(\tR\alast_id\"\x99\x01\n" +
+	"\x0fLendItemRequest\x12\x18\n" +
+	"\aitem_id\x18\x01 \x01(\tR\aitem_id\x12\x1c\n" +
+	"\tmember_id\x18\x02 \x01(\tR\tmember_id\x12N\n" +
+	"\x14expected_return_time\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x14expected_return_time\"\x95\x02\n" +
+	"\x15LendItemByScanRequest\x12,\n" +
+	"\x11member_rfid_value\x18\x01 \x01(\fR\x11member_rfid_value\x12*\n" +
+	"\x0fitem_rfid_value\x18\x02 \x01(\fH\x00R\x0fitem_rfid_value\x12$\n" +
+	"\fitem_barcode\x18\x03 \x01(\tH\x00R\fitem_barcode\x12 \n" +
+	"\vterminal_id\x18\x04 \x01(\tR\vterminal_id\x12N\n" +
+	"\x14expected_return_time\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\x14expected_return_timeB\n" +
+	"\n" +
+	"\bitem_tag\"M\n" +
+	"\x11ReturnItemRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12(\n" +
+	"\x0fcondition_notes\x18\x02 \x01(\tR\x0fcondition_notes\"\xa1\x01\n" +
+	"\x17ReturnItemByScanRequest\x12*\n" +
+	"\x0fitem_rfid_value\x18\x01 \x01(\fH\x00R\x0fitem_rfid_value\x12$\n" +
+	"\fitem_barcode\x18\x02 \x01(\tH\x00R\fitem_barcode\x12(\n" +
+	"\x0fcondition_notes\x18\x03 \x01(\tR\x0fcondition_notesB\n" +
+	"\n" +
+	"\bitem_tag\" \n" +
+	"\x0eGetLoanRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\xda\x01\n" +
+	"\x10ListLoansRequest\x12\x1c\n" +
+	"\tpage_size\x18\x01 \x01(\x05R\tpage_size\x12\x1e\n" +
+	"\n" +
+	"page_token\x18\x02 \x01(\tR\n" +
+	"page_token\x12\x1d\n" +
+	"\aitem_id\x18\x03 \x01(\tH\x00R\aitem_id\x88\x01\x01\x12!\n" +
+	"\tmember_id\x18\x04 \x01(\tH\x01R\tmember_id\x88\x01\x01\x12\x12\n" +
+	"\x04open\x18\x05 \x01(\bR\x04open\x12\x18\n" +
+	"\aoverdue\x18\x06 \x01(\bR\aoverdueB\n" +
+	"\n" +
+	"\b_item_idB\f\n" +
+	"\n" +
+	"_member_id\"\x90\x01\n" +
+	"\x11ListLoansResponse\x122\n" +
+	"\x05loans\x18\x01 \x03(\v2\x1c.ourspace_backend.proto.LoanR\x05loans\x12(\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\x0fnext_page_token:\x1d\xbaG\x1a\xba\x01\x05loans\xba\x01\x0fnext_page_token\"\xeb\x02\n" +
+	"\aMachine\x12\x13\n" +
+	"\x02id\x18\x01 \x01(\tB\x03\xe0A\x03R\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12\x1a\n" +
+	"\blocation\x18\x04 \x01(\tR\blocation\x128\n" +
+	"\x17required_briefing_types\x18\x05 \x03(\tR\x17required_briefing_types\x126\n" +
+	"\x16price_per_minute_cents\x18\x06 \x01(\x03R\x16price_per_minute_cents\x12C\n" +
+	"\x0fsession_timeout\x18\a \x01(\v2\x19.google.protobuf.DurationR\x0fsession_timeout:B\xbaG?\xba\x01\x02id\xba\x01\x04name\xba\x01\vdescription\xba\x01\blocation\xba\x01\x17required_briefing_types\"J\n" +
+	"\x10MachinePageToken\x12\x1c\n" +
+	"\tlast_name\x18\x01 \x01(\tR\tlast_name\x12\x18\n" +
+	"\alast_id\x18\x02 \x01(\tR\alast_id\"q\n" +
+	"\x14CreateMachineRequest\x12\x1e\n" +
+	"\n" +
+	"machine_id\x18\x01 \x01(\tR\n" +
+	"machine_id\x129\n" +
+	"\amachine\x18\x02 \x01(\v2\x1f.ourspace_backend.proto.MachineR\amachine\"#\n" +
+	"\x11GetMachineRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"S\n" +
+	"\x13ListMachinesRequest\x12\x1c\n" +
+	"\tpage_size\x18\x01 \x01(\x05R\tpage_size\x12\x1e\n" +
+	"\n" +
+	"page_token\x18\x02 \x01(\tR\n" +
+	"page_token\"\x9f\x01\n" +
+	"\x14ListMachinesResponse\x12;\n" +
+	"\bmachines\x18\x01 \x03(\v2\x1f.ourspace_backend.proto.MachineR\bmachines\x12(\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\x0fnext_page_token: \xbaG\x1d\xba\x01\bmachines\xba\x01\x0fnext_page_token\"\x8d\x01\n" +
+	"\x14UpdateMachineRequest\x129\n" +
+	"\amachine\x18\x01 \x01(\v2\x1f.ourspace_backend.proto.MachineR\amachine\x12:\n" +
+	"\n" +
+	"field_mask\x18\x02 \x01(\v2\x1a.google.protobuf.FieldMaskR\n" +
+	"field_mask\"&\n" +
+	"\x14DeleteMachineRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\xc6\x03\n" +
+	"\fUsageSession\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1e\n" +
+	"\n" +
+	"machine_id\x18\x02 \x01(\tR\n" +
+	"machine_id\x12\x1c\n" +
+	"\tmember_id\x18\x03 \x01(\tR\tmember_id\x12:\n" +
+	"\n" +
+	"start_time\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"start_time\x12;\n" +
+	"\bend_time\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampH\x00R\bend_time\x88\x01\x01\x12\"\n" +
+	"\fauto_stopped\x18\x06 \x01(\bR\fauto_stopped\x126\n" +
+	"\x16price_per_minute_cents\x18\a \x01(\x03R\x16price_per_minute_cents\x12 \n" +
+	"\vprice_cents\x18\b \x01(\x03R\vprice_cents:d\xbaGa\xba\x01\x02id\xba\x01\n" +
+	"machine_id\xba\x01\tmember_id\xba\x01\n" +
+	"start_time\xba\x01\fauto_stopped\xba\x01\x16price_per_minute_cents\xba\x01\vprice_centsB\v\n" +
+	"\t_end_time\"\x86\x01\n" +
+	"\x18StartUsageSessionRequest\x12\x1e\n" +
+	"\n" +
+	"machine_id\x18\x01 \x01(\tR\n" +
+	"machine_id\x12\x1e\n" +
+	"\tmember_id\x18\x02 \x01(\tH\x00R\tmember_id\x12 \n" +
+	"\n" +
+	"rfid_value\x18\x03 \x01(\fH\x00R\n" +
+	"rfid_valueB\b\n" +
+	"\x06member\"9\n" +
+	"\x17StopUsageSessionRequest\x12\x1e\n" +
+	"\n" +
+	"machine_id\x18\x01 \x01(\tR\n" +
+	"machine_id\"w\n" +
+	"\x15UsageSessionPageToken\x12D\n" +
+	"\x0flast_start_time\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\x0flast_start_time\x12\x18\n" +
+	"\alast_id\x18\x02 \x01(\tR\alast_id\"\xe1\x01\n" +
+	"\x18ListUsageSessionsRequest\x12\x1c\n" +
+	"\tpage_size\x18\x01 \x01(\x05R\tpage_size\x12\x1e\n" +
+	"\n" +
+	"page_token\x18\x02 \x01(\tR\n" +
+	"page_token\x12#\n" +
+	"\n" +
+	"machine_id\x18\x03 \x01(\tH\x00R\n" +
+	"machine_id\x88\x01\x01\x12!\n" +
+	"\tmember_id\x18\x04 \x01(\tH\x01R\tmember_id\x88\x01\x01\x12\"\n" +
+	"\frunning_only\x18\x05 \x01(\bR\frunning_onlyB\r\n" +
+	"\v_machine_idB\f\n" +
+	"\n" +
+	"_member_id\"\xa9\x01\n" +
+	"\x19ListUsageSessionsResponse\x12@\n" +
+	"\bsessions\x18\x01 \x03(\v2$.ourspace_backend.proto.UsageSessionR\bsessions\x12(\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\x0fnext_page_token: \xbaG\x1d\xba\x01\bsessions\xba\x01\x0fnext_page_token\"T\n" +
+	"\x12CheckAccessRequest\x12\x1e\n" +
+	"\n" +
+	"rfid_value\x18\x01 \x01(\fR\n" +
+	"rfid_value\x12\x1e\n" +
+	"\n" +
+	"machine_id\x18\x02 \x01(\tR\n" +
+	"machine_id\"\x96\x03\n" +
+	"\x0eAccessDecision\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12@\n" +
+	"\rdecision_time\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\rdecision_time\x12\x1e\n" +
+	"\n" +
+	"machine_id\x18\x03 \x01(\tR\n" +
+	"machine_id\x12!\n" +
+	"\tmember_id\x18\x04 \x01(\tH\x00R\tmember_id\x88\x01\x01\x12\x18\n" +
+	"\aallowed\x18\x05 \x01(\bR\aallowed\x12<\n" +
+	"\x06reason\x18\x06 \x01(\x0e2$.ourspace_backend.proto.AccessReasonR\x06reason\x126\n" +
+	"\x16missing_briefing_types\x18\a \x03(\tR\x16missing_briefing_types:Q\xbaGN\xba\x01\x02id\xba\x01\rdecision_time\xba\x01\n" +
+	"machine_id\xba\x01\aallowed\xba\x01\x06reason\xba\x01\x16missing_briefing_typesB\f\n" +
+	"\n" +
+	"_member_id\"\x7f\n" +
+	"\x17AccessDecisionPageToken\x12J\n" +
+	"\x12last_decision_time\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\x12last_decision_time\x12\x18\n" +
+	"\alast_id\x18\x02 \x01(\tR\alast_id\"\xea\x01\n" +
+	"\x1aListAccessDecisionsRequest\x12\x1c\n" +
+	"\tpage_size\x18\x01 \x01(\x05R\tpage_size\x12\x1e\n" +
+	"\n" +
+	"page_token\x18\x02 \x01(\tR\n" +
+	"page_token\x12#\n" +
+	"\n" +
+	"machine_id\x18\x03 \x01(\tH\x00R\n" +
+	"machine_id\x88\x01\x01\x12!\n" +
+	"\tmember_id\x18\x04 \x01(\tH\x01R\tmember_id\x88\x01\x01\x12\x1d\n" +
+	"\aallowed\x18\x05 \x01(\bH\x02R\aallowed\x88\x01\x01B\r\n" +
+	"\v_machine_idB\f\n" +
+	"\n" +
+	"_member_idB\n" +
+	"\n" +
+	"\b_allowed\"\xb0\x01\n" +
+	"\x1bListAccessDecisionsResponse\x12D\n" +
+	"\tdecisions\x18\x01 \x03(\v2&.ourspace_backend.proto.AccessDecisionR\tdecisions\x12(\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\x0fnext_page_token:!\xbaG\x1e\xba\x01\tdecisions\xba\x01\x0fnext_page_token\"\xf9\x02\n" +
+	"\x0eMembershipPlan\x12\x13\n" +
+	"\x02id\x18\x01 \x01(\tB\x03\xe0A\x03R\x02id\x12\"\n" +
+	"\fdisplay_name\x18\x02 \x01(\tR\fdisplay_name\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12 \n" +
+	"\vprice_cents\x18\x04 \x01(\x03R\vprice_cents\x125\n" +
+	"\x13reduced_price_cents\x18\x05 \x01(\x03H\x00R\x13reduced_price_cents\x88\x01\x01\x12S\n" +
+	"\x10billing_interval\x18\x06 \x01(\x0e2'.ourspace_backend.proto.BillingIntervalR\x10billing_interval:F\xbaGC\xba\x01\x02id\xba\x01\fdisplay_name\xba\x01\vdescription\xba\x01\vprice_cents\xba\x01\x10billing_intervalB\x16\n" +
+	"\x14_reduced_price_cents\"a\n" +
+	"\x17MembershipPlanPageToken\x12,\n" +
+	"\x11last_display_name\x18\x01 \x01(\tR\x11last_display_name\x12\x18\n" +
+	"\alast_id\x18\x02 \x01(\tR\alast_id\"\x9f\x01\n" +
+	"\x1bCreateMembershipPlanRequest\x12.\n" +
+	"\x12membership_plan_id\x18\x01 \x01(\tR\x12membership_plan_id\x12P\n" +
+	"\x0fmembership_plan\x18\x02 \x01(\v2&.ourspace_backend.proto.MembershipPlanR\x0fmembership_plan\"*\n" +
+	"\x18GetMembershipPlanRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"Z\n" +
+	"\x1aListMembershipPlansRequest\x12\x1c\n" +
+	"\tpage_size\x18\x01 \x01(\x05R\tpage_size\x12\x1e\n" +
+	"\n" +
+	"page_token\x18\x02 \x01(\tR\n" +
+	"page_token\"\xc5\x01\n" +
+	"\x1bListMembershipPlansResponse\x12R\n" +
+	"\x10membership_plans\x18\x01 \x03(\v2&.ourspace_backend.proto.MembershipPlanR\x10membership_plans\x12(\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\x0fnext_page_token:(\xbaG%\xba\x01\x10membership_plans\xba\x01\x0fnext_page_token\"\xab\x01\n" +
+	"\x1bUpdateMembershipPlanRequest\x12P\n" +
+	"\x0fmembership_plan\x18\x01 \x01(\v2&.ourspace_backend.proto.MembershipPlanR\x0fmembership_plan\x12:\n" +
+	"\n" +
+	"field_mask\x18\x02 \x01(\v2\x1a.google.protobuf.FieldMaskR\n" +
+	"field_mask\"-\n" +
+	"\x1bDeleteMembershipPlanRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\xe4\x02\n" +
+	"\x0ePlanAssignment\x12\x13\n" +
+	"\x02id\x18\x01 \x01(\tB\x03\xe0A\x03R\x02id\x12\x1c\n" +
+	"\tmember_id\x18\x02 \x01(\tR\tmember_id\x12\x18\n" +
+	"\aplan_id\x18\x03 \x01(\tR\aplan_id\x12:\n" +
+	"\n" +
+	"start_time\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"start_time\x12;\n" +
+	"\bend_time\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampH\x00R\bend_time\x88\x01\x01\x12C\n" +
+	"\fbilled_until\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x03R\fbilled_until::\xbaG7\xba\x01\x02id\xba\x01\tmember_id\xba\x01\aplan_id\xba\x01\n" +
+	"start_time\xba\x01\fbilled_untilB\v\n" +
+	"\t_end_time\"y\n" +
+	"\x17PlanAssignmentPageToken\x12D\n" +
+	"\x0flast_start_time\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\x0flast_start_time\x12\x18\n" +
+	"\alast_id\x18\x02 \x01(\tR\alast_id\"o\n" +
+	"\x1bAssignMembershipPlanRequest\x12P\n" +
+	"\x0fplan_assignment\x18\x01 \x01(\v2&.ourspace_backend.proto.PlanAssignmentR\x0fplan_assignment\"\xb6\x01\n" +
+	"\x1aListPlanAssignmentsRequest\x12\x1c\n" +
+	"\tpage_size\x18\x01 \x01(\x05R\tpage_size\x12\x1e\n" +
+	"\n" +
+	"page_token\x18\x02 \x01(\tR\n" +
+	"page_token\x12!\n" +
+	"\tmember_id\x18\x03 \x01(\tH\x00R\tmember_id\x88\x01\x01\x12\x1d\n" +
+	"\aplan_id\x18\x04 \x01(\tH\x01R\aplan_id\x88\x01\x01B\f\n" +
+	"\n" +
+	"_member_idB\n" +
+	"\n" +
+	"\b_plan_id\"\xc5\x01\n" +
+	"\x1bListPlanAssignmentsResponse\x12R\n" +
+	"\x10plan_assignments\x18\x01 \x03(\v2&.ourspace_backend.proto.PlanAssignmentR\x10plan_assignments\x12(\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\x0fnext_page_token:(\xbaG%\xba\x01\x10plan_assignments\xba\x01\x0fnext_page_token\"b\n" +
+	"\x18EndPlanAssignmentRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x126\n" +
+	"\bend_time\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\bend_time\"\xaa\x04\n" +
+	"\aInvoice\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1c\n" +
+	"\tmember_id\x18\x02 \x01(\tR\tmember_id\x12.\n" +
+	"\x12plan_assignment_id\x18\x03 \x01(\tR\x12plan_assignment_id\x12\x18\n" +
+	"\aplan_id\x18\x04 \x01(\tR\aplan_id\x12>\n" +
+	"\fperiod_start\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\fperiod_start\x12:\n" +
+	"\n" +
+	"period_end\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"period_end\x12\"\n" +
+	"\famount_cents\x18\a \x01(\x03R\famount_cents\x12\x1e\n" +
+	"\n" +
+	"paid_cents\x18\b \x01(\x03R\n" +
+	"paid_cents\x12:\n" +
+	"\n" +
+	"issue_time\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"issue_time\x12=\n" +
+	"\x06status\x18\n" +
+	" \x01(\x0e2%.ourspace_backend.proto.InvoiceStatusR\x06status:l\xbaGi\xba\x01\x02id\xba\x01\tmember_id\xba\x01\aplan_id\xba\x01\fperiod_start\xba\x01\n" +
+	"period_end\xba\x01\famount_cents\xba\x01\n" +
+	"paid_cents\xba\x01\n" +
+	"issue_time\xba\x01\x06status\"v\n" +
+	"\x10InvoicePageToken\x12H\n" +
+	"\x11last_period_start\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\x11last_period_start\x12\x18\n" +
+	"\alast_id\x18\x02 \x01(\tR\alast_id\"K\n" +
+	"\x17GenerateInvoicesRequest\x120\n" +
+	"\x05until\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\x05until\"g\n" +
+	"\x18GenerateInvoicesResponse\x12;\n" +
+	"\binvoices\x18\x01 \x03(\v2\x1f.ourspace_backend.proto.InvoiceR\binvoices:\x0e\xbaG\v\xba\x01\binvoices\"\xd3\x01\n" +
+	"\x13ListInvoicesRequest\x12\x1c\n" +
+	"\tpage_size\x18\x01 \x01(\x05R\tpage_size\x12\x1e\n" +
+	"\n" +
+	"page_token\x18\x02 \x01(\tR\n" +
+	"page_token\x12!\n" +
+	"\tmember_id\x18\x03 \x01(\tH\x00R\tmember_id\x88\x01\x01\x12B\n" +
+	"\x06status\x18\x04 \x01(\x0e2%.ourspace_backend.proto.InvoiceStatusH\x01R\x06status\x88\x01\x01B\f\n" +
+	"\n" +
+	"_member_idB\t\n" +
+	"\a_status\"\x9f\x01\n" +
+	"\x14ListInvoicesResponse\x12;\n" +
+	"\binvoices\x18\x01 \x03(\v2\x1f.ourspace_backend.proto.InvoiceR\binvoices\x12(\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\x0fnext_page_token: \xbaG\x1d\xba\x01\binvoices\xba\x01\x0fnext_page_token\"&\n" +
+	"\x14CancelInvoiceRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\xb2\x02\n" +
+	"\aPayment\x12\x13\n" +
+	"\x02id\x18\x01 \x01(\tB\x03\xe0A\x03R\x02id\x12\x1c\n" +
+	"\tmember_id\x18\x02 \x01(\tR\tmember_id\x12#\n" +
+	"\n" +
+	"invoice_id\x18\x03 \x01(\tH\x00R\n" +
+	"invoice_id\x88\x01\x01\x12\"\n" +
+	"\famount_cents\x18\x04 \x01(\x03R\famount_cents\x12>\n" +
+	"\fpayment_time\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\fpayment_time\x12\x1c\n" +
+	"\treference\x18\x06 \x01(\tR\treference:>\xbaG;\xba\x01\x02id\xba\x01\tmember_id\xba\x01\famount_cents\xba\x01\fpayment_time\xba\x01\treferenceB\r\n" +
+	"\v_invoice_id\"v\n" +
+	"\x10PaymentPageToken\x12H\n" +
+	"\x11last_payment_time\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\x11last_payment_time\x12\x18\n" +
+	"\alast_id\x18\x02 \x01(\tR\alast_id\"q\n" +
+	"\x14RecordPaymentRequest\x12\x1e\n" +
+	"\n" +
+	"payment_id\x18\x01 \x01(\tR\n" +
+	"payment_id\x129\n" +
+	"\apayment\x18\x02 \x01(\v2\x1f.ourspace_backend.proto.PaymentR\apayment\"\xb8\x01\n" +
+	"\x13ListPaymentsRequest\x12\x1c\n" +
+	"\tpage_size\x18\x01 \x01(\x05R\tpage_size\x12\x1e\n" +
+	"\n" +
+	"page_token\x18\x02 \x01(\tR\n" +
+	"page_token\x12!\n" +
+	"\tmember_id\x18\x03 \x01(\tH\x00R\tmember_id\x88\x01\x01\x12#\n" +
+	"\n" +
+	"invoice_id\x18\x04 \x01(\tH\x01R\n" +
+	"invoice_id\x88\x01\x01B\f\n" +
+	"\n" +
+	"_member_idB\r\n" +
+	"\v_invoice_id\"\x9f\x01\n" +
+	"\x14ListPaymentsResponse\x12;\n" +
+	"\bpayments\x18\x01 \x03(\v2\x1f.ourspace_backend.proto.PaymentR\bpayments\x12(\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\x0fnext_page_token: \xbaG\x1d\xba\x01\bpayments\xba\x01\x0fnext_page_token\"&\n" +
+	"\x14DeletePaymentRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\xe0\x01\n" +
+	"\aBalance\x12\x1c\n" +
+	"\tmember_id\x18\x01 \x01(\tR\tmember_id\x12&\n" +
+	"\x0einvoiced_cents\x18\x02 \x01(\x03R\x0einvoiced_cents\x12\x1e\n" +
+	"\n" +
+	"paid_cents\x18\x03 \x01(\x03R\n" +
+	"paid_cents\x12,\n" +
+	"\x11outstanding_cents\x18\x04 \x01(\x03R\x11outstanding_cents:A\xbaG>\xba\x01\tmember_id\xba\x01\x0einvoiced_cents\xba\x01\n" +
+	"paid_cents\xba\x01\x11outstanding_cents\":\n" +
+	"\x10BalancePageToken\x12&\n" +
+	"\x0elast_member_id\x18\x01 \x01(\tR\x0elast_member_id\"\xb0\x01\n" +
+	"\x13ListBalancesRequest\x12\x1c\n" +
+	"\tpage_size\x18\x01 \x01(\x05R\tpage_size\x12\x1e\n" +
+	"\n" +
+	"page_token\x18\x02 \x01(\tR\n" +
+	"page_token\x12!\n" +
+	"\tmember_id\x18\x03 \x01(\tH\x00R\tmember_id\x88\x01\x01\x12*\n" +
+	"\x10outstanding_only\x18\x04 \x01(\bR\x10outstanding_onlyB\f\n" +
+	"\n" +
+	"_member_id\"\xb3\x02\n" +
+	"\vSepaMandate\x12\x1c\n" +
+	"\tmember_id\x18\x01 \x01(\tR\tmember_id\x12&\n" +
+	"\x0eaccount_holder\x18\x02 \x01(\tR\x0eaccount_holder\x12\x12\n" +
+	"\x04iban\x18\x03 \x01(\tR\x04iban\x12\x10\n" +
+	"\x03bic\x18\x04 \x01(\tR\x03bic\x12,\n" +
+	"\x11mandate_reference\x18\x05 \x01(\tR\x11mandate_reference\x12>\n" +
+	"\fmandate_date\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\fmandate_date:J\xbaGG\xba\x01\tmember_id\xba\x01\x0eaccount_holder\xba\x01\x04iban\xba\x01\x11mandate_reference\xba\x01\fmandate_date\"`\n" +
+	"\x15SetSepaMandateRequest\x12G\n" +
+	"\fsepa_mandate\x18\x01 \x01(\v2#.ourspace_backend.proto.SepaMandateR\fsepa_mandate\"5\n" +
+	"\x15GetSepaMandateRequest\x12\x1c\n" +
+	"\tmember_id\x18\x01 \x01(\tR\tmember_id\"8\n" +
+	"\x18DeleteSepaMandateRequest\x12\x1c\n" +
+	"\tmember_id\x18\x01 \x01(\tR\tmember_id\"\x8c\x02\n" +
+	"\x1cExportSepaDirectDebitRequest\x12>\n" +
+	"\fperiod_start\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\fperiod_start\x12:\n" +
+	"\n" +
+	"period_end\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"period_end\x12D\n" +
+	"\x0fcollection_date\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x0fcollection_date\x12*\n" +
+	"\x10include_exported\x18\x04 \x01(\bR\x10include_exported\"\xbe\x01\n" +
+	"\x0fSepaExportIssue\x12\x1c\n" +
+	"\tmember_id\x18\x01 \x01(\tR\tmember_id\x12 \n" +
+	"\vinvoice_ids\x18\x02 \x03(\tR\vinvoice_ids\x12\x14\n" +
+	"\x05field\x18\x03 \x01(\tR\x05field\x12 \n" +
+	"\vdescription\x18\x04 \x01(\tR\vdescription:3\xbaG0\xba\x01\tmember_id\xba\x01\vinvoice_ids\xba\x01\x05field\xba\x01\vdescription\"\x8e\x02\n" +
+	"\x15SepaDirectDebitExport\x12\x1a\n" +
+	"\bdocument\x18\x01 \x01(\tR\bdocument\x12\"\n" +
+	"\ftransactions\x18\x02 \x01(\x03R\ftransactions\x12 \n" +
+	"\vtotal_cents\x18\x03 \x01(\x03R\vtotal_cents\x12?\n" +
+	"\x06issues\x18\x04 \x03(\v2'.ourspace_backend.proto.SepaExportIssueR\x06issues\x12\x1c\n" +
+	"\texport_id\x18\x05 \x01(\tR\texport_id:4\xbaG1\xba\x01\bdocument\xba\x01\ftransactions\xba\x01\vtotal_cents\xba\x01\x06issues\"\x9f\x01\n" +
+	"\x14ListBalancesResponse\x12;\n" +
+	"\bbalances\x18\x01 \x03(\v2\x1f.ourspace_backend.proto.BalanceR\bbalances\x12(\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\x0fnext_page_token: \xbaG\x1d\xba\x01\bbalances\xba\x01\x0fnext_page_token\"\x15\n" +
+	"\x13GetMyProfileRequest\"\xa7\x01\n" +
+	"\x16UpdateMyProfileRequest\x126\n" +
+	"\x06member\x18\x01 \x01(\v2\x1e.ourspace_backend.proto.MemberR\x06member\x12:\n" +
+	"\n" +
+	"field_mask\x18\x02 \x01(\v2\x1a.google.protobuf.FieldMaskR\n" +
+	"field_mask:\x19\xbaG\x16\xba\x01\x06member\xba\x01\n" +
+	"field_mask\"V\n" +
+	"\x16ListMyPresencesRequest\x12\x1c\n" +
+	"\tpage_size\x18\x01 \x01(\x05R\tpage_size\x12\x1e\n" +
+	"\n" +
+	"page_token\x18\x02 \x01(\tR\n" +
+	"page_token\"R\n" +
+	"\x12ListMyCardsRequest\x12\x1c\n" +
+	"\tpage_size\x18\x01 \x01(\x05R\tpage_size\x12\x1e\n" +
+	"\n" +
+	"page_token\x18\x02 \x01(\tR\n" +
+	"page_token\"v\n" +
+	"\x16ListMyBriefingsRequest\x12\x1c\n" +
+	"\tpage_size\x18\x01 \x01(\x05R\tpage_size\x12\x1e\n" +
+	"\n" +
+	"page_token\x18\x02 \x01(\tR\n" +
+	"page_token\x12\x1e\n" +
+	"\n" +
+	"valid_only\x18\x03 \x01(\bR\n" +
+	"valid_only\"\xad\x01\n" +
+	"\x1cCreateMyChangeRequestRequest\x126\n" +
+	"\x06member\x18\x01 \x01(\v2\x1e.ourspace_backend.proto.MemberR\x06member\x12:\n" +
+	"\n" +
+	"field_mask\x18\x02 \x01(\v2\x1a.google.protobuf.FieldMaskR\n" +
+	"field_mask:\x19\xbaG\x16\xba\x01\x06member\xba\x01\n" +
+	"field_mask\"[\n" +
+	"\x1bListMyChangeRequestsRequest\x12\x1c\n" +
+	"\tpage_size\x18\x01 \x01(\x05R\tpage_size\x12\x1e\n" +
+	"\n" +
+	"page_token\x18\x02 \x01(\tR\n" +
+	"page_token\"\xea\x01\n" +
+	"\x18GetPresenceReportRequest\x12:\n" +
+	"\n" +
+	"start_time\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"start_time\x126\n" +
+	"\bend_time\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\bend_time\x12<\n" +
+	"\x06bucket\x18\x03 \x01(\x0e2$.ourspace_backend.proto.ReportBucketR\x06bucket\x12\x1c\n" +
+	"\ttime_zone\x18\x04 \x01(\tR\ttime_zone\"\x82\x03\n" +
+	"\x0ePresenceReport\x12:\n" +
+	"\n" +
+	"start_time\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"start_time\x126\n" +
+	"\bend_time\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\bend_time\x12<\n" +
+	"\x06bucket\x18\x03 \x01(\x0e2$.ourspace_backend.proto.ReportBucketR\x06bucket\x12D\n" +
+	"\abuckets\x18\x04 \x03(\v2*.ourspace_backend.proto.PresenceStatisticsR\abuckets\x12@\n" +
+	"\x05total\x18\x05 \x01(\v2*.ourspace_backend.proto.PresenceStatisticsR\x05total:6\xbaG3\xba\x01\n" +
+	"start_time\xba\x01\bend_time\xba\x01\x06bucket\xba\x01\abuckets\xba\x01\x05total\"\x95\x04\n" +
+	"\x12PresenceStatistics\x12:\n" +
+	"\n" +
+	"start_time\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"start_time\x126\n" +
+	"\bend_time\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\bend_time\x12(\n" +
+	"\x0funique_visitors\x18\x03 \x01(\x03R\x0funique_visitors\x12\x16\n" +
+	"\x06visits\x18\x04 \x01(\x03R\x06visits\x12 \n" +
+	"\vtotal_hours\x18\x05 \x01(\x01R\vtotal_hours\x12&\n" +
+	"\x0epeak_occupancy\x18\x06 \x01(\x03R\x0epeak_occupancy\x12U\n" +
+	"\x0eage_categories\x18\a \x03(\v2-.ourspace_backend.proto.AgeCategoryStatisticsR\x0eage_categories\x129\n" +
+	"\x04tags\x18\b \x03(\v2%.ourspace_backend.proto.TagStatisticsR\x04tags:m\xbaGj\xba\x01\n" +
+	"start_time\xba\x01\bend_time\xba\x01\x0funique_visitors\xba\x01\x06visits\xba\x01\vtotal_hours\xba\x01\x0epeak_occupancy\xba\x01\x0eage_categories\xba\x01\x04tags\"\xc4\x01\n" +
+	"\x15AgeCategoryStatistics\x12G\n" +
+	"\fage_category\x18\x01 \x01(\x0e2#.ourspace_backend.proto.AgeCategoryR\fage_category\x12(\n" +
+	"\x0funique_visitors\x18\x02 \x01(\x03R\x0funique_visitors\x12\x16\n" +
+	"\x06visits\x18\x03 \x01(\x03R\x06visits\x12 \n" +
+	"\vtotal_hours\x18\x04 \x01(\x01R\vtotal_hours\"\x85\x01\n" +
+	"\rTagStatistics\x12\x10\n" +
+	"\x03tag\x18\x01 \x01(\tR\x03tag\x12(\n" +
+	"\x0funique_visitors\x18\x02 \x01(\x03R\x0funique_visitors\x12\x16\n" +
+	"\x06visits\x18\x03 \x01(\x03R\x06visits\x12 \n" +
+	"\vtotal_hours\x18\x04 \x01(\x01R\vtotal_hours\"\xdb\x01\n" +
+	"\x1cGetMachineUsageReportRequest\x12:\n" +
+	"\n" +
+	"start_time\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"start_time\x126\n" +
+	"\bend_time\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\bend_time\x12G\n" +
+	"\bgroup_by\x18\x03 \x01(\x0e2+.ourspace_backend.proto.UsageReportGroupingR\bgroup_by\"\x8d\x03\n" +
+	"\x12MachineUsageReport\x12:\n" +
+	"\n" +
+	"start_time\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"start_time\x126\n" +
+	"\bend_time\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\bend_time\x12G\n" +
+	"\bgroup_by\x18\x03 \x01(\x0e2+.ourspace_backend.proto.UsageReportGroupingR\bgroup_by\x12A\n" +
+	"\aentries\x18\x04 \x03(\v2'.ourspace_backend.proto.UsageStatisticsR\aentries\x12=\n" +
+	"\x05total\x18\x05 \x01(\v2'.ourspace_backend.proto.UsageStatisticsR\x05total:8\xbaG5\xba\x01\n" +
+	"start_time\xba\x01\bend_time\xba\x01\bgroup_by\xba\x01\aentries\xba\x01\x05total\"\xf3\x01\n" +
+	"\x0fUsageStatistics\x12\x1c\n" +
+	"\tmember_id\x18\x01 \x01(\tR\tmember_id\x12\x1e\n" +
+	"\n" +
+	"machine_id\x18\x02 \x01(\tR\n" +
+	"machine_id\x12\x1a\n" +
+	"\bsessions\x18\x03 \x01(\x03R\bsessions\x12$\n" +
+	"\rtotal_minutes\x18\x04 \x01(\x03R\rtotal_minutes\x12,\n" +
+	"\x11total_price_cents\x18\x05 \x01(\x03R\x11total_price_cents:2\xbaG/\xba\x01\bsessions\xba\x01\rtotal_minutes\xba\x01\x11total_price_cents\"\xa5\x05\n" +
+	"\vApplication\x12\x13\n" +
+	"\x02id\x18\x01 \x01(\tB\x03\xe0A\x03R\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x14\n" +
+	"\x05email\x18\x03 \x01(\tR\x05email\x12\x14\n" +
+	"\x05phone\x18\x04 \x01(\tR\x05phone\x12#\n" +
+	"\n" +
+	"birth_date\x18\x05 \x01(\tH\x00R\n" +
+	"birth_date\x88\x01\x01\x12\x18\n" +
+	"\amessage\x18\x06 \x01(\tR\amessage\x12D\n" +
+	"\x05state\x18\a \x01(\x0e2).ourspace_backend.proto.Application.StateB\x03\xe0A\x03R\x05state\x12A\n" +
+	"\vcreate_time\x18\b \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x03R\vcreate_time\x12A\n" +
+	"\vdecide_time\x18\t \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x03R\vdecide_time\x12#\n" +
+	"\n" +
+	"decided_by\x18\n" +
+	" \x01(\tB\x03\xe0A\x03R\n" +
+	"decided_by\x12\x1d\n" +
+	"\acomment\x18\v \x01(\tB\x03\xe0A\x03R\acomment\x12&\n" +
+	"\tmember_id\x18\f \x01(\tB\x03\xe0A\x03H\x01R\tmember_id\x88\x01\x01\"U\n" +
+	"\x05State\x12\x11\n" +
+	"\rSTATE_UNKNOWN\x10\x00\x12\x11\n" +
+	"\rSTATE_PENDING\x10\x01\x12\x12\n" +
+	"\x0eSTATE_ACCEPTED\x10\x02\x12\x12\n" +
+	"\x0eSTATE_REJECTED\x10\x03:V\xbaGS\xba\x01\x02id\xba\x01\x04name\xba\x01\x05email\xba\x01\x05phone\xba\x01\amessage\xba\x01\x05state\xba\x01\vcreate_time\xba\x01\n" +
+	"decided_by\xba\x01\acommentB\r\n" +
+	"\v_birth_dateB\f\n" +
+	"\n" +
+	"_member_id\"\xd6\x01\n" +
+	"\x18SubmitApplicationRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x14\n" +
+	"\x05email\x18\x02 \x01(\tR\x05email\x12\x14\n" +
+	"\x05phone\x18\x03 \x01(\tR\x05phone\x12#\n" +
+	"\n" +
+	"birth_date\x18\x04 \x01(\tH\x00R\n" +
+	"birth_date\x88\x01\x01\x12\x18\n" +
+	"\amessage\x18\x05 \x01(\tR\amessage\x12\x18\n" +
+	"\awebsite\x18\x06 \x01(\tR\awebsite:\x12\xbaG\x0f\xba\x01\x04name\xba\x01\x05emailB\r\n" +
+	"\v_birth_date\"\x98\x01\n" +
+	"\x17ListApplicationsRequest\x12\x1c\n" +
+	"\tpage_size\x18\x01 \x01(\x05R\tpage_size\x12\x1e\n" +
+	"\n" +
+	"page_token\x18\x02 \x01(\tR\n" +
+	"page_token\x12?\n" +
+	"\x05state\x18\x03 \x01(\x0e2).ourspace_backend.proto.Application.StateR\x05state\"\xb3\x01\n" +
+	"\x18ListApplicationsResponse\x12G\n" +
+	"\fapplications\x18\x01 \x03(\v2#.ourspace_backend.proto.ApplicationR\fapplications\x12(\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\x0fnext_page_token:$\xbaG!\xba\x01\fapplications\xba\x01\x0fnext_page_token\".\n" +
+	"\x14ApplicationPageToken\x12\x16\n" +
+	"\x06offset\x18\x01 \x01(\x05R\x06offset\"\x9f\x04\n" +
+	"\x18AcceptApplicationRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12F\n" +
+	"\x10membership_start\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x10membership_start\x12G\n" +
+	"\fage_category\x18\x03 \x01(\x0e2#.ourspace_backend.proto.AgeCategoryR\fage_category\x12\x12\n" +
+	"\x04tags\x18\x04 \x03(\tR\x04tags\x12\x80\x01\n" +
+	"\x15additional_attributes\x18\x05 \x03(\v2J.ourspace_backend.proto.AcceptApplicationRequest.AdditionalAttributesEntryR\x15additional_attributes\x12P\n" +
+	"\rrelationships\x18\x06 \x03(\v2*.ourspace_backend.proto.MemberRelationshipR\rrelationships\x12\x18\n" +
+	"\acomment\x18\a \x01(\tR\acomment\x1aG\n" +
+	"\x19AdditionalAttributesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01:\x16\xbaG\x13\xba\x01\x10membership_start\"D\n" +
+	"\x18RejectApplicationRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x18\n" +
+	"\acomment\x18\x02 \x01(\tR\acomment\"\xb4\x04\n" +
+	"\n" +
+	"MemberNote\x12\x13\n" +
+	"\x02id\x18\x01 \x01(\tB\x03\xe0A\x03R\x02id\x12!\n" +
+	"\tmember_id\x18\x02 \x01(\tB\x03\xe0A\x05R\tmember_id\x12\x12\n" +
+	"\x04text\x18\x03 \x01(\tR\x04text\x12\x1b\n" +
+	"\x06author\x18\x04 \x01(\tB\x03\xe0A\x03R\x06author\x12%\n" +
+	"\vauthor_name\x18\x05 \x01(\tB\x03\xe0A\x03R\vauthor_name\x12A\n" +
+	"\vcreate_time\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x03R\vcreate_time\x12A\n" +
+	"\vupdate_time\x18\a \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x03R\vupdate_time\x12\x16\n" +
+	"\x06pinned\x18\b \x01(\bR\x06pinned\x12M\n" +
+	"\n" +
+	"visibility\x18\t \x01(\x0e2-.ourspace_backend.proto.MemberNote.VisibilityR\n" +
+	"visibility\"Q\n" +
+	"\n" +
+	"Visibility\x12\x16\n" +
+	"\x12VISIBILITY_UNKNOWN\x10\x00\x12\x14\n" +
+	"\x10VISIBILITY_STAFF\x10\x01\x12\x15\n" +
+	"\x11VISIBILITY_ADMINS\x10\x02:V\xbaGS\xba\x01\x02id\xba\x01\tmember_id\xba\x01\x04text\xba\x01\x06author\xba\x01\vauthor_name\xba\x01\vcreate_time\xba\x01\x06pinned\xba\x01\n" +
+	"visibility\"]\n" +
+	"\x17CreateMemberNoteRequest\x126\n" +
+	"\x04note\x18\x01 \x01(\v2\".ourspace_backend.proto.MemberNoteR\x04note:\n" +
+	"\xbaG\a\xba\x01\x04note\"\x96\x01\n" +
+	"\x16ListMemberNotesRequest\x12\x1c\n" +
+	"\tmember_id\x18\x01 \x01(\tR\tmember_id\x12\x1c\n" +
+	"\tpage_size\x18\x02 \x01(\x05R\tpage_size\x12\x1e\n" +
+	"\n" +
+	"page_token\x18\x03 \x01(\tR\n" +
+	"page_token\x12 \n" +
+	"\vpinned_only\x18\x04 \x01(\bR\vpinned_only\"\x9c\x01\n" +
+	"\x17ListMemberNotesResponse\x128\n" +
+	"\x05notes\x18\x01 \x03(\v2\".ourspace_backend.proto.MemberNoteR\x05notes\x12(\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\x0fnext_page_token:\x1d\xbaG\x1a\xba\x01\x05notes\xba\x01\x0fnext_page_token\"-\n" +
+	"\x13MemberNotePageToken\x12\x16\n" +
+	"\x06offset\x18\x01 \x01(\x05R\x06offset\"\xa6\x01\n" +
+	"\x17UpdateMemberNoteRequest\x126\n" +
+	"\x04note\x18\x01 \x01(\v2\".ourspace_backend.proto.MemberNoteR\x04note\x12:\n" +
+	"\n" +
+	"field_mask\x18\x02 \x01(\v2\x1a.google.protobuf.FieldMaskR\n" +
+	"field_mask:\x17\xbaG\x14\xba\x01\x04note\xba\x01\n" +
+	"field_mask\"G\n" +
+	"\x17DeleteMemberNoteRequest\x12\x1c\n" +
+	"\tmember_id\x18\x01 \x01(\tR\tmember_id\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\tR\x02id\"\x88\x02\n" +
+	"\x13MemberTimelineEntry\x12.\n" +
+	"\x04time\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\x04time\x128\n" +
+	"\x04note\x18\x02 \x01(\v2\".ourspace_backend.proto.MemberNoteH\x00R\x04note\x122\n" +
+	"\x04card\x18\x03 \x01(\v2\x1c.ourspace_backend.proto.CardH\x00R\x04card\x12>\n" +
+	"\bbriefing\x18\x04 \x01(\v2 .ourspace_backend.proto.BriefingH\x00R\bbriefing:\n" +
+	"\xbaG\a\xba\x01\x04timeB\a\n" +
+	"\x05entry\"w\n" +
+	"\x19ListMemberTimelineRequest\x12\x1c\n" +
+	"\tmember_id\x18\x01 \x01(\tR\tmember_id\x12\x1c\n" +
+	"\tpage_size\x18\x02 \x01(\x05R\tpage_size\x12\x1e\n" +
+	"\n" +
+	"page_token\x18\x03 \x01(\tR\n" +
+	"page_token\"\xae\x01\n" +
+	"\x1aListMemberTimelineResponse\x12E\n" +
+	"\aentries\x18\x01 \x03(\v2+.ourspace_backend.proto.MemberTimelineEntryR\aentries\x12(\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\x0fnext_page_token:\x1f\xbaG\x1c\xba\x01\aentries\xba\x01\x0fnext_page_token\"1\n" +
+	"\x17MemberTimelinePageToken\x12\x16\n" +
+	"\x06offset\x18\x01 \x01(\x05R\x06offset\"\xe4\x01\n" +
 	"\fLoginRequest\x12C\n" +
 	"\bpassword\x18\x01 \x01(\v2%.ourspace_backend.proto.LoginPasswordH\x00R\bpassword\x12@\n" +
 	"\x04oidc\x18\x02 \x01(\v2*.ourspace_backend.proto.LoginOpenIDConnectH\x00R\x04oidc\x12>\n" +
@@ -4090,17 +16142,15 @@ const file_ourspace_backend_proto_api_proto_rawDesc = "" +
 	"\x0fMEMBER_FIELD_ID\x10\x01\x12\x15\n" +
 	"\x11MEMBER_FIELD_NAME\x10\x02\x12!\n" +
 	"\x1dMEMBER_FIELD_MEMBERSHIP_START\x10\x03\x12\x1f\n" +
-	"\x1bMEMBER_FIELD_MEMBERSHIP_END\x10\x04*\xa3\x01\n" +
-	"\rPresenceField\x12\x1a\n" +
-	"\x16PRESENCE_FIELD_UNKNOWN\x10\x00\x12\x15\n" +
-	"\x11PRESENCE_FIELD_ID\x10\x01\x12\x1c\n" +
-	"\x18PRESENCE_FIELD_MEMBER_ID\x10\x02\x12\x1f\n" +
-	"\x1bPRESENCE_FIELD_CHECKIN_TIME\x10\x03\x12 \n" +
-	"\x1cPRESENCE_FIELD_CHECKOUT_TIME\x10\x04*h\n" +
+	"\x1bMEMBER_FIELD_MEMBERSHIP_END\x10\x04*h\n" +
 	"\rSortDirection\x12\x1a\n" +
 	"\x16SORT_DIRECTION_DEFAULT\x10\x00\x12\x1c\n" +
 	"\x18SORT_DIRECTION_ASCENDING\x10\x01\x12\x1d\n" +
-	"\x19SORT_DIRECTION_DESCENDING\x10\x02*\xce\x01\n" +
+	"\x19SORT_DIRECTION_DESCENDING\x10\x02*X\n" +
+	"\fExportFormat\x12\x19\n" +
+	"\x15EXPORT_FORMAT_UNKNOWN\x10\x00\x12\x15\n" +
+	"\x11EXPORT_FORMAT_CSV\x10\x01\x12\x16\n" +
+	"\x12EXPORT_FORMAT_XLSX\x10\x02*\xce\x01\n" +
 	"\x14MemberAttributeField\x12\"\n" +
 	"\x1eMEMBER_ATTRIBUTE_FIELD_UNKNOWN\x10\x00\x12\x1d\n" +
 	"\x19MEMBER_ATTRIBUTE_FIELD_ID\x10\x01\x12)\n" +
@@ -4112,7 +16162,59 @@ const file_ourspace_backend_proto_api_proto_rawDesc = "" +
 	"\rCARD_FIELD_ID\x10\x01\x12\x18\n" +
 	"\x14CARD_FIELD_MEMBER_ID\x10\x02\x12\x19\n" +
 	"\x15CARD_FIELD_VALID_FROM\x10\x03\x12\x17\n" +
-	"\x13CARD_FIELD_VALID_TO\x10\x042\xf9\x0e\n" +
+	"\x13CARD_FIELD_VALID_TO\x10\x04*\xa3\x01\n" +
+	"\rPresenceField\x12\x1a\n" +
+	"\x16PRESENCE_FIELD_UNKNOWN\x10\x00\x12\x15\n" +
+	"\x11PRESENCE_FIELD_ID\x10\x01\x12\x1c\n" +
+	"\x18PRESENCE_FIELD_MEMBER_ID\x10\x02\x12\x1f\n" +
+	"\x1bPRESENCE_FIELD_CHECKIN_TIME\x10\x03\x12 \n" +
+	"\x1cPRESENCE_FIELD_CHECKOUT_TIME\x10\x04*h\n" +
+	"\x0ePresenceAction\x12\x1b\n" +
+	"\x17PRESENCE_ACTION_UNKNOWN\x10\x00\x12\x1b\n" +
+	"\x17PRESENCE_ACTION_CHECKIN\x10\x01\x12\x1c\n" +
+	"\x18PRESENCE_ACTION_CHECKOUT\x10\x02*\x86\x01\n" +
+	"\n" +
+	"EventField\x12\x17\n" +
+	"\x13EVENT_FIELD_UNKNOWN\x10\x00\x12\x12\n" +
+	"\x0eEVENT_FIELD_ID\x10\x01\x12\x15\n" +
+	"\x11EVENT_FIELD_TITLE\x10\x02\x12\x1a\n" +
+	"\x16EVENT_FIELD_START_TIME\x10\x03\x12\x18\n" +
+	"\x14EVENT_FIELD_END_TIME\x10\x04*\xbd\x01\n" +
+	"\x17EventRegistrationStatus\x12%\n" +
+	"!EVENT_REGISTRATION_STATUS_UNKNOWN\x10\x00\x12(\n" +
+	"$EVENT_REGISTRATION_STATUS_REGISTERED\x10\x01\x12(\n" +
+	"$EVENT_REGISTRATION_STATUS_WAITLISTED\x10\x02\x12'\n" +
+	"#EVENT_REGISTRATION_STATUS_CANCELLED\x10\x03*K\n" +
+	"\tItemField\x12\x16\n" +
+	"\x12ITEM_FIELD_UNKNOWN\x10\x00\x12\x11\n" +
+	"\rITEM_FIELD_ID\x10\x01\x12\x13\n" +
+	"\x0fITEM_FIELD_NAME\x10\x02*\xd2\x01\n" +
+	"\fAccessReason\x12\x19\n" +
+	"\x15ACCESS_REASON_UNKNOWN\x10\x00\x12\x19\n" +
+	"\x15ACCESS_REASON_GRANTED\x10\x01\x12\x1e\n" +
+	"\x1aACCESS_REASON_UNKNOWN_CARD\x10\x02\x12%\n" +
+	"!ACCESS_REASON_MEMBERSHIP_INACTIVE\x10\x03\x12!\n" +
+	"\x1dACCESS_REASON_UNKNOWN_MACHINE\x10\x04\x12\"\n" +
+	"\x1eACCESS_REASON_MISSING_BRIEFING\x10\x05*\x8a\x01\n" +
+	"\x0fBillingInterval\x12\x1c\n" +
+	"\x18BILLING_INTERVAL_UNKNOWN\x10\x00\x12\x1c\n" +
+	"\x18BILLING_INTERVAL_MONTHLY\x10\x01\x12\x1e\n" +
+	"\x1aBILLING_INTERVAL_QUARTERLY\x10\x02\x12\x1b\n" +
+	"\x17BILLING_INTERVAL_YEARLY\x10\x03*{\n" +
+	"\rInvoiceStatus\x12\x1a\n" +
+	"\x16INVOICE_STATUS_UNKNOWN\x10\x00\x12\x17\n" +
+	"\x13INVOICE_STATUS_OPEN\x10\x01\x12\x17\n" +
+	"\x13INVOICE_STATUS_PAID\x10\x02\x12\x1c\n" +
+	"\x18INVOICE_STATUS_CANCELLED\x10\x03*q\n" +
+	"\fReportBucket\x12\x19\n" +
+	"\x15REPORT_BUCKET_UNKNOWN\x10\x00\x12\x15\n" +
+	"\x11REPORT_BUCKET_DAY\x10\x01\x12\x16\n" +
+	"\x12REPORT_BUCKET_WEEK\x10\x02\x12\x17\n" +
+	"\x13REPORT_BUCKET_MONTH\x10\x03*}\n" +
+	"\x13UsageReportGrouping\x12!\n" +
+	"\x1dUSAGE_REPORT_GROUPING_UNKNOWN\x10\x00\x12 \n" +
+	"\x1cUSAGE_REPORT_GROUPING_MEMBER\x10\x01\x12!\n" +
+	"\x1dUSAGE_REPORT_GROUPING_MACHINE\x10\x022\x919\n" +
 	"\rMemberService\x12\xa8\x01\n" +
 	"\fCreateMember\x12+.ourspace_backend.proto.CreateMemberRequest\x1a\x1e.ourspace_backend.proto.Member\"K\xbaG-\n" +
 	"\aMembers\x12\rCreate Member\x1a\x13Create Space Member\x82\xd3\xe4\x93\x02\x15:\x06member\"\v/v1/members\x12\x9f\x01\n" +
@@ -4120,18 +16222,56 @@ const file_ourspace_backend_proto_api_proto_rawDesc = "" +
 	"\aMembers\x12\n" +
 	"Get member\x1a\x16Get member information\x82\xd3\xe4\x93\x02\x12\x12\x10/v1/members/{id}\x12\xb2\x01\n" +
 	"\vListMembers\x12*.ourspace_backend.proto.ListMembersRequest\x1a+.ourspace_backend.proto.ListMembersResponse\"J\xbaG4\n" +
-	"\aMembers\x12\fList members\x1a\x1bList all registered members\x82\xd3\xe4\x93\x02\r\x12\v/v1/members\x12\xc3\x01\n" +
+	"\aMembers\x12\fList members\x1a\x1bList all registered members\x82\xd3\xe4\x93\x02\r\x12\v/v1/members\x12\xfd\x01\n" +
+	"\rSearchMembers\x12,.ourspace_backend.proto.SearchMembersRequest\x1a-.ourspace_backend.proto.SearchMembersResponse\"\x8e\x01\xbaGq\n" +
+	"\aMembers\x12\x0eSearch members\x1aVFind members by name, username, tags and text attributes, tolerating typos and accents\x82\xd3\xe4\x93\x02\x14\x12\x12/v1/members:search\x12\xf2\x01\n" +
+	"\rExportMembers\x12,.ourspace_backend.proto.ExportMembersRequest\x1a\x14.google.api.HttpBody\"\x9a\x01\xbaG}\n" +
+	"\aMembers\x12\x0eExport members\x1abExport all members matching the filters as CSV or XLSX file, including their additional attributes\x82\xd3\xe4\x93\x02\x14\x12\x12/v1/members:export0\x01\x12\xc3\x01\n" +
 	"\fUpdateMember\x12+.ourspace_backend.proto.UpdateMemberRequest\x1a\x1e.ourspace_backend.proto.Member\"f\xbaG<\n" +
 	"\aMembers\x12\rUpdate member\x1a\"Update specified fields of members\x82\xd3\xe4\x93\x02!:\x06member2\x17/v1/members/{member.id}\x12\xa5\x01\n" +
 	"\fDeleteMember\x12+.ourspace_backend.proto.DeleteMemberRequest\x1a\x16.google.protobuf.Empty\"P\xbaG5\n" +
-	"\aMembers\x12\rDelete member\x1a\x1bDelete the specified member\x82\xd3\xe4\x93\x02\x12*\x10/v1/members/{id}\x12\xdc\x01\n" +
-	"\x0eListMemberTags\x12-.ourspace_backend.proto.ListMemberTagsRequest\x1a..ourspace_backend.proto.ListMemberTagsResponse\"k\xbaGQ\n" +
-	"\aMembers\x12\x10List member tags\x1a4List all possible tags that appear on members so far\x82\xd3\xe4\x93\x02\x11\x12\x0f/v1/member-tags\x12\xa0\x01\n" +
+	"\aMembers\x12\rDelete member\x1a\x1bDelete the specified member\x82\xd3\xe4\x93\x02\x12*\x10/v1/members/{id}\x12\x82\x02\n" +
+	"\x0eListMemberTags\x12-.ourspace_backend.proto.ListMemberTagsRequest\x1a..ourspace_backend.proto.ListMemberTagsResponse\"\x90\x01\xbaGv\n" +
+	"\aMembers\x12\x10List member tags\x1aYList all defined tags and all tags that appear on members so far, with their usage counts\x82\xd3\xe4\x93\x02\x11\x12\x0f/v1/member-tags\x12\xf4\x01\n" +
+	"\x0fCreateMemberTag\x12..ourspace_backend.proto.CreateMemberTagRequest\x1a!.ourspace_backend.proto.MemberTag\"\x8d\x01\xbaGn\n" +
+	"\aMembers\x12\x11Create member tag\x1aPDefine a tag with description and color. The tag may already be used by members.\x82\xd3\xe4\x93\x02\x16:\x03tag\"\x0f/v1/member-tags\x12\xf7\x01\n" +
+	"\x0fUpdateMemberTag\x12..ourspace_backend.proto.UpdateMemberTagRequest\x1a!.ourspace_backend.proto.MemberTag\"\x90\x01\xbaGf\n" +
+	"\aMembers\x12\x11Update member tag\x1aHUpdate the description or color of a tag. Use rename to change the name.\x82\xd3\xe4\x93\x02!:\x03tag2\x1a/v1/member-tags/{tag.name}\x12\xe8\x01\n" +
+	"\x0fDeleteMemberTag\x12..ourspace_backend.proto.DeleteMemberTagRequest\x1a\x16.google.protobuf.Empty\"\x8c\x01\xbaGk\n" +
+	"\aMembers\x12\x11Delete member tag\x1aMDelete the definition of a tag and optionally remove the tag from all members\x82\xd3\xe4\x93\x02\x18*\x16/v1/member-tags/{name}\x12\xff\x01\n" +
+	"\x0fRenameMemberTag\x12..ourspace_backend.proto.RenameMemberTagRequest\x1a!.ourspace_backend.proto.MemberTag\"\x98\x01\xbaGm\n" +
+	"\aMembers\x12\x11Rename member tag\x1aORename a tag on all members at once. Use merge if the new name is already used.\x82\xd3\xe4\x93\x02\":\x01*\"\x1d/v1/member-tags/{name}:rename\x12\x85\x02\n" +
+	"\x0fMergeMemberTags\x12..ourspace_backend.proto.MergeMemberTagsRequest\x1a!.ourspace_backend.proto.MemberTag\"\x9e\x01\xbaGt\n" +
+	"\aMembers\x12\x11Merge member tags\x1aVReplace the source tags by the tag on all members at once and delete their definitions\x82\xd3\xe4\x93\x02!:\x01*\"\x1c/v1/member-tags/{name}:merge\x12\xed\x01\n" +
+	"\rImportMembers\x12,.ourspace_backend.proto.ImportMembersRequest\x1a-.ourspace_backend.proto.ImportMembersResponse\"\x7f\xbaG_\n" +
+	"\aMembers\x12\x0eImport members\x1aDCreate members from a CSV file, either all rows are imported or none\x82\xd3\xe4\x93\x02\x17:\x01*\"\x12/v1/members:import\x12\xa0\x01\n" +
 	"\x15CreateMemberAttribute\x124.ourspace_backend.proto.CreateMemberAttributeRequest\x1a'.ourspace_backend.proto.MemberAttribute\"(\x82\xd3\xe4\x93\x02\":\tattribute\"\x15/v1/member-attributes\x12\x94\x01\n" +
 	"\x12GetMemberAttribute\x121.ourspace_backend.proto.GetMemberAttributeRequest\x1a'.ourspace_backend.proto.MemberAttribute\"\"\x82\xd3\xe4\x93\x02\x1c\x12\x1a/v1/member-attributes/{id}\x12\xa0\x01\n" +
 	"\x14ListMemberAttributes\x123.ourspace_backend.proto.ListMemberAttributesRequest\x1a4.ourspace_backend.proto.ListMemberAttributesResponse\"\x1d\x82\xd3\xe4\x93\x02\x17\x12\x15/v1/member-attributes\x12\xaf\x01\n" +
 	"\x15UpdateMemberAttribute\x124.ourspace_backend.proto.UpdateMemberAttributeRequest\x1a'.ourspace_backend.proto.MemberAttribute\"7\x82\xd3\xe4\x93\x021:\tattribute2$/v1/member-attributes/{attribute.id}\x12\x89\x01\n" +
-	"\x15DeleteMemberAttribute\x124.ourspace_backend.proto.DeleteMemberAttributeRequest\x1a\x16.google.protobuf.Empty\"\"\x82\xd3\xe4\x93\x02\x1c*\x1a/v1/member-attributes/{id}2\xb3\x06\n" +
+	"\x15DeleteMemberAttribute\x124.ourspace_backend.proto.DeleteMemberAttributeRequest\x1a\x16.google.protobuf.Empty\"\"\x82\xd3\xe4\x93\x02\x1c*\x1a/v1/member-attributes/{id}\x12\xbf\x02\n" +
+	"\x16MigrateMemberAttribute\x125.ourspace_backend.proto.MigrateMemberAttributeRequest\x1a6.ourspace_backend.proto.MigrateMemberAttributeResponse\"\xb5\x01\xbaG\x84\x01\n" +
+	"\aMembers\x12\x18Migrate Member Attribute\x1a_Rename an attribute or change its type and convert the values of all members in one transaction\x82\xd3\xe4\x93\x02':\x01*\"\"/v1/member-attributes/{id}:migrate\x12\xab\x02\n" +
+	"\x18CreateMemberRelationship\x127.ourspace_backend.proto.CreateMemberRelationshipRequest\x1a*.ourspace_backend.proto.MemberRelationship\"\xa9\x01\xbaG^\n" +
+	"\aMembers\x12\x13Create relationship\x1a>Add a guardian, emergency contact or family member to a member\x82\xd3\xe4\x93\x02B:\frelationship\"2/v1/members/{relationship.member_id}/relationships\x12\xfd\x01\n" +
+	"\x17ListMemberRelationships\x126.ourspace_backend.proto.ListMemberRelationshipsRequest\x1a7.ourspace_backend.proto.ListMemberRelationshipsResponse\"q\xbaGA\n" +
+	"\aMembers\x12\x12List relationships\x1a\"List all relationships of a member\x82\xd3\xe4\x93\x02'\x12%/v1/members/{member_id}/relationships\x12\xa8\x02\n" +
+	"\x18UpdateMemberRelationship\x127.ourspace_backend.proto.UpdateMemberRelationshipRequest\x1a*.ourspace_backend.proto.MemberRelationship\"\xa6\x01\xbaGI\n" +
+	"\aMembers\x12\x13Update relationship\x1a)Update specified fields of a relationship\x82\xd3\xe4\x93\x02T:\frelationship2D/v1/members/{relationship.member_id}/relationships/{relationship.id}\x12\xae\x02\n" +
+	"\x18DeleteMemberRelationship\x127.ourspace_backend.proto.DeleteMemberRelationshipRequest\x1a\x16.google.protobuf.Empty\"\xc0\x01\xbaG\x8a\x01\n" +
+	"\aMembers\x12\x13Delete relationship\x1ajDelete a relationship. The last guardian of an underage member can't be deleted if guardians are required.\x82\xd3\xe4\x93\x02,**/v1/members/{member_id}/relationships/{id}\x12\x85\x02\n" +
+	"\x13CreateMemberConsent\x122.ourspace_backend.proto.CreateMemberConsentRequest\x1a%.ourspace_backend.proto.MemberConsent\"\x92\x01\xbaGV\n" +
+	"\aMembers\x12\x0eRecord consent\x1a;Record that a member or one of their guardians gave consent\x82\xd3\xe4\x93\x023:\aconsent\"(/v1/members/{consent.member_id}/consents\x12\x89\x02\n" +
+	"\x12ListMemberConsents\x121.ourspace_backend.proto.ListMemberConsentsRequest\x1a2.ourspace_backend.proto.ListMemberConsentsResponse\"\x8b\x01\xbaG`\n" +
+	"\aMembers\x12\rList consents\x1aFList all consents of a member including revoked ones, the latest first\x82\xd3\xe4\x93\x02\"\x12 /v1/members/{member_id}/consents\x12\xa2\x02\n" +
+	"\x13RevokeMemberConsent\x122.ourspace_backend.proto.RevokeMemberConsentRequest\x1a%.ourspace_backend.proto.MemberConsent\"\xaf\x01\xbaGu\n" +
+	"\aMembers\x12\x0eRevoke consent\x1aZRecord that a consent was revoked. Consents are never deleted, so that they can be traced.\x82\xd3\xe4\x93\x021:\x01*\",/v1/members/{member_id}/consents/{id}:revoke\x12\xc3\x02\n" +
+	"\x18ListMemberChangeRequests\x127.ourspace_backend.proto.ListMemberChangeRequestsRequest\x1a8.ourspace_backend.proto.ListMemberChangeRequestsResponse\"\xb3\x01\xbaG\x8d\x01\n" +
+	"\aMembers\x12\x14List change requests\x1alList the changes members requested to their own data, the oldest first. Requires the admin or approver role.\x82\xd3\xe4\x93\x02\x1c\x12\x1a/v1/member-change-requests\x12\x90\x03\n" +
+	"\x1aApproveMemberChangeRequest\x128.ourspace_backend.proto.DecideMemberChangeRequestRequest\x1a+.ourspace_backend.proto.MemberChangeRequest\"\x8a\x02\xbaG\xd4\x01\n" +
+	"\aMembers\x12\x16Approve change request\x1a\xb0\x01Apply the requested changes to the member. The changes are validated like any update of the member. Requires the admin or approver role, staff can't approve their own requests.\x82\xd3\xe4\x93\x02,:\x01*\"'/v1/member-change-requests/{id}:approve\x12\xc6\x02\n" +
+	"\x19RejectMemberChangeRequest\x128.ourspace_backend.proto.DecideMemberChangeRequestRequest\x1a+.ourspace_backend.proto.MemberChangeRequest\"\xc1\x01\xbaG\x8c\x01\n" +
+	"\aMembers\x12\x15Reject change request\x1ajReject the requested changes, the comment should tell the member why. Requires the admin or approver role.\x82\xd3\xe4\x93\x02+:\x01*\"&/v1/member-change-requests/{id}:reject2\xf4\a\n" +
 	"\vCardService\x12\x98\x01\n" +
 	"\n" +
 	"CreateCard\x12).ourspace_backend.proto.CreateCardRequest\x1a\x1c.ourspace_backend.proto.Card\"A\xbaG'\n" +
@@ -4140,7 +16280,9 @@ const file_ourspace_backend_proto_api_proto_rawDesc = "" +
 	"\x05Cards\x12\bGet card\x1a\x14Get card information\x82\xd3\xe4\x93\x02\x10\x12\x0e/v1/cards/{id}\x12\xa4\x01\n" +
 	"\tListCards\x12(.ourspace_backend.proto.ListCardsRequest\x1a).ourspace_backend.proto.ListCardsResponse\"B\xbaG.\n" +
 	"\x05Cards\x12\n" +
-	"List cards\x1a\x19List all registered cards\x82\xd3\xe4\x93\x02\v\x12\t/v1/cards\x12\xb1\x01\n" +
+	"List cards\x1a\x19List all registered cards\x82\xd3\xe4\x93\x02\v\x12\t/v1/cards\x12\xbe\x01\n" +
+	"\vExportCards\x12*.ourspace_backend.proto.ExportCardsRequest\x1a\x14.google.api.HttpBody\"k\xbaGP\n" +
+	"\x05Cards\x12\fExport cards\x1a9Export all cards matching the filters as CSV or XLSX file\x82\xd3\xe4\x93\x02\x12\x12\x10/v1/cards:export0\x01\x12\xb1\x01\n" +
 	"\n" +
 	"UpdateCard\x12).ourspace_backend.proto.UpdateCardRequest\x1a\x1c.ourspace_backend.proto.Card\"Z\xbaG6\n" +
 	"\x05Cards\x12\vUpdate card\x1a Update specified fields of cards\x82\xd3\xe4\x93\x02\x1b:\x04card2\x13/v1/cards/{card.id}\x12\x99\x01\n" +
@@ -4167,18 +16309,184 @@ const file_ourspace_backend_proto_api_proto_rawDesc = "" +
 	"\x12UpdateBriefingType\x121.ourspace_backend.proto.UpdateBriefingTypeRequest\x1a$.ourspace_backend.proto.BriefingType\"\x8f\x01\xbaGP\n" +
 	"\rBriefingTypes\x12\x14Update briefing type\x1a)Update specified fields of briefing types\x82\xd3\xe4\x93\x026:\rbriefing_type2%/v1/briefing-types/{briefing_type.id}\x12\xcc\x01\n" +
 	"\x12DeleteBriefingType\x121.ourspace_backend.proto.DeleteBriefingTypeRequest\x1a\x16.google.protobuf.Empty\"k\xbaGI\n" +
-	"\rBriefingTypes\x12\x14Delete briefing-type\x1a\"Delete the specified briefing type\x82\xd3\xe4\x93\x02\x19*\x17/v1/briefing-types/{id}2\xaf\b\n" +
+	"\rBriefingTypes\x12\x14Delete briefing-type\x1a\"Delete the specified briefing type\x82\xd3\xe4\x93\x02\x19*\x17/v1/briefing-types/{id}2\xdc\x0e\n" +
 	"\x0fPresenceService\x12\xd4\x01\n" +
 	"\rListPresences\x12,.ourspace_backend.proto.ListPresencesRequest\x1a-.ourspace_backend.proto.ListPresencesResponse\"f\xbaGN\n" +
-	"\tPresences\x12\x0eList presences\x1a1List precenses, where members have checked in/out\x82\xd3\xe4\x93\x02\x0f\x12\r/v1/presences\x12\xbd\x01\n" +
+	"\tPresences\x12\x0eList presences\x1a1List precenses, where members have checked in/out\x82\xd3\xe4\x93\x02\x0f\x12\r/v1/presences\x12\xd6\x01\n" +
+	"\x0fExportPresences\x12..ourspace_backend.proto.ExportPresencesRequest\x1a\x14.google.api.HttpBody\"{\xbaG\\\n" +
+	"\tPresences\x12\x10Export presences\x1a=Export all presences matching the filters as CSV or XLSX file\x82\xd3\xe4\x93\x02\x16\x12\x14/v1/presences:export0\x01\x12\xbd\x01\n" +
 	"\aCheckin\x12&.ourspace_backend.proto.CheckinRequest\x1a .ourspace_backend.proto.Presence\"h\xbaGE\n" +
 	"\tPresences\x12\bCheck in\x1a.Check in a member, this creates a new presence\x82\xd3\xe4\x93\x02\x1a:\x01*\"\x15/v1/presences:checkin\x12\xcc\x01\n" +
 	"\bCheckout\x12'.ourspace_backend.proto.CheckoutRequest\x1a .ourspace_backend.proto.Presence\"u\xbaGQ\n" +
-	"\tPresences\x12\tCheck out\x1a9Check out a member, ends an open presence if there is one\x82\xd3\xe4\x93\x02\x1b:\x01*\"\x16/v1/presences:checkout\x12\x86\x02\n" +
+	"\tPresences\x12\tCheck out\x1a9Check out a member, ends an open presence if there is one\x82\xd3\xe4\x93\x02\x1b:\x01*\"\x16/v1/presences:checkout\x12\x8c\x02\n" +
+	"\x0eTogglePresence\x12-.ourspace_backend.proto.TogglePresenceRequest\x1a..ourspace_backend.proto.TogglePresenceResponse\"\x9a\x01\xbaGx\n" +
+	"\tPresences\x12\x0fToggle presence\x1aZChecks a member out if they are checked in, otherwise checks them in. Meant for terminals.\x82\xd3\xe4\x93\x02\x19:\x01*\"\x14/v1/presences:toggle\x12\xc2\x02\n" +
+	"\rCheckinByCard\x12,.ourspace_backend.proto.CheckinByCardRequest\x1a..ourspace_backend.proto.TogglePresenceResponse\"\xd2\x01\xbaG\xa6\x01\n" +
+	"\tPresences\x12\x14Check in/out by card\x1a\x82\x01Resolves the currently valid card with the given RFID value and toggles the presence of its member. Meant for simple card readers.\x82\xd3\xe4\x93\x02\":\x01*\"\x1d/v1/presences:checkin-by-card\x12\x86\x02\n" +
 	"\x0eUpdatePresence\x12-.ourspace_backend.proto.UpdatePresenceRequest\x1a .ourspace_backend.proto.Presence\"\xa2\x01\xbaGr\n" +
 	"\tPresences\x12\x0fUpdate presence\x1aTUpdates a presence. Usual operation should be via checkin/checkout instead of update\x82\xd3\xe4\x93\x02':\bpresence\"\x1b/v1/presences/{presence.id}\x12\xac\x01\n" +
 	"\x0eDeletePresence\x12-.ourspace_backend.proto.DeletePresenceRequest\x1a\x16.google.protobuf.Empty\"S\xbaG6\n" +
-	"\tPresences\x12\x0fDelete Presence\x1a\x18Delete a presence record\x82\xd3\xe4\x93\x02\x14*\x12/v1/presences/{id}2\xb4\x04\n" +
+	"\tPresences\x12\x0fDelete Presence\x1a\x18Delete a presence record\x82\xd3\xe4\x93\x02\x14*\x12/v1/presences/{id}2\x82\x11\n" +
+	"\fEventService\x12\xc1\x01\n" +
+	"\vCreateEvent\x12*.ourspace_backend.proto.CreateEventRequest\x1a\x1d.ourspace_backend.proto.Event\"g\xbaGK\n" +
+	"\x06Events\x12\fCreate event\x1a3Create a workshop or event members can register for\x82\xd3\xe4\x93\x02\x13:\x05event\"\n" +
+	"/v1/events\x12\x98\x01\n" +
+	"\bGetEvent\x12'.ourspace_backend.proto.GetEventRequest\x1a\x1d.ourspace_backend.proto.Event\"D\xbaG*\n" +
+	"\x06Events\x12\tGet event\x1a\x15Get event information\x82\xd3\xe4\x93\x02\x11\x12\x0f/v1/events/{id}\x12\xc0\x01\n" +
+	"\n" +
+	"ListEvents\x12).ourspace_backend.proto.ListEventsRequest\x1a*.ourspace_backend.proto.ListEventsResponse\"[\xbaGF\n" +
+	"\x06Events\x12\vList events\x1a/List events, optionally limited to a time range\x82\xd3\xe4\x93\x02\f\x12\n" +
+	"/v1/events\x12\xf7\x01\n" +
+	"\vUpdateEvent\x12*.ourspace_backend.proto.UpdateEventRequest\x1a\x1d.ourspace_backend.proto.Event\"\x9c\x01\xbaGu\n" +
+	"\x06Events\x12\fUpdate event\x1a]Update specified fields of an event. Raising the capacity moves members up from the waitlist.\x82\xd3\xe4\x93\x02\x1e:\x05event2\x15/v1/events/{event.id}\x12\xb2\x01\n" +
+	"\vDeleteEvent\x12*.ourspace_backend.proto.DeleteEventRequest\x1a\x16.google.protobuf.Empty\"_\xbaGE\n" +
+	"\x06Events\x12\fDelete event\x1a-Delete the event and all registrations for it\x82\xd3\xe4\x93\x02\x11*\x0f/v1/events/{id}\x12\x98\x02\n" +
+	"\x10RegisterForEvent\x12/.ourspace_backend.proto.RegisterForEventRequest\x1a).ourspace_backend.proto.EventRegistration\"\xa7\x01\xbaGv\n" +
+	"\x06Events\x12\x12Register for event\x1aXRegister a member for an event. If the event is full, the member is put on the waitlist.\x82\xd3\xe4\x93\x02(:\x01*\"#/v1/events/{event_id}/registrations\x12\xab\x02\n" +
+	"\x17CancelEventRegistration\x126.ourspace_backend.proto.CancelEventRegistrationRequest\x1a).ourspace_backend.proto.EventRegistration\"\xac\x01\xbaGo\n" +
+	"\x06Events\x12\x13Cancel registration\x1aPCancel a registration. The freed place goes to the first member on the waitlist.\x82\xd3\xe4\x93\x024:\x01*\"//v1/events/{event_id}/registrations/{id}:cancel\x12\x94\x02\n" +
+	"\x16ListEventRegistrations\x125.ourspace_backend.proto.ListEventRegistrationsRequest\x1a6.ourspace_backend.proto.ListEventRegistrationsResponse\"\x8a\x01\xbaG\\\n" +
+	"\x06Events\x12\x12List registrations\x1a>List the registrations of an event in the order they were made\x82\xd3\xe4\x93\x02%\x12#/v1/events/{event_id}/registrations\x12\xc0\x02\n" +
+	"\x13MarkEventAttendance\x122.ourspace_backend.proto.MarkEventAttendanceRequest\x1a).ourspace_backend.proto.EventRegistration\"\xc9\x01\xbaG\x87\x01\n" +
+	"\x06Events\x12\x0fMark attendance\x1alManually mark whether a registered member attended. Attendance is also derived from presences automatically.\x82\xd3\xe4\x93\x028:\x01*\"3/v1/events/{event_id}/registrations/{id}:attendance2\x98\x10\n" +
+	"\x0eLendingService\x12\xad\x01\n" +
+	"\n" +
+	"CreateItem\x12).ourspace_backend.proto.CreateItemRequest\x1a\x1c.ourspace_backend.proto.Item\"V\xbaG<\n" +
+	"\aLending\x12\vCreate item\x1a$Add a lendable item to the inventory\x82\xd3\xe4\x93\x02\x11:\x04item\"\t/v1/items\x12\x93\x01\n" +
+	"\aGetItem\x12&.ourspace_backend.proto.GetItemRequest\x1a\x1c.ourspace_backend.proto.Item\"B\xbaG)\n" +
+	"\aLending\x12\bGet item\x1a\x14Get item information\x82\xd3\xe4\x93\x02\x10\x12\x0e/v1/items/{id}\x12\xb5\x01\n" +
+	"\tListItems\x12(.ourspace_backend.proto.ListItemsRequest\x1a).ourspace_backend.proto.ListItemsResponse\"S\xbaG?\n" +
+	"\aLending\x12\n" +
+	"List items\x1a(List the lendable items in the inventory\x82\xd3\xe4\x93\x02\v\x12\t/v1/items\x12\xb5\x01\n" +
+	"\n" +
+	"UpdateItem\x12).ourspace_backend.proto.UpdateItemRequest\x1a\x1c.ourspace_backend.proto.Item\"^\xbaG:\n" +
+	"\aLending\x12\vUpdate item\x1a\"Update specified fields of an item\x82\xd3\xe4\x93\x02\x1b:\x04item2\x13/v1/items/{item.id}\x12\xbb\x01\n" +
+	"\n" +
+	"DeleteItem\x12).ourspace_backend.proto.DeleteItemRequest\x1a\x16.google.protobuf.Empty\"j\xbaGQ\n" +
+	"\aLending\x12\vDelete item\x1a9Remove an item and its lending history from the inventory\x82\xd3\xe4\x93\x02\x10*\x0e/v1/items/{id}\x12\x98\x01\n" +
+	"\bLendItem\x12'.ourspace_backend.proto.LendItemRequest\x1a\x1c.ourspace_backend.proto.Loan\"E\xbaG.\n" +
+	"\aLending\x12\tLend item\x1a\x18Lend an item to a member\x82\xd3\xe4\x93\x02\x0e:\x01*\"\t/v1/loans\x12\xe6\x01\n" +
+	"\x0eLendItemByScan\x12-.ourspace_backend.proto.LendItemByScanRequest\x1a\x1c.ourspace_backend.proto.Loan\"\x86\x01\xbaGj\n" +
+	"\aLending\x12\x11Lend item by scan\x1aLLend an item on a terminal by scanning the member card and then the item tag\x82\xd3\xe4\x93\x02\x13:\x01*\"\x0e/v1/loans:scan\x12\xbd\x01\n" +
+	"\n" +
+	"ReturnItem\x12).ourspace_backend.proto.ReturnItemRequest\x1a\x1c.ourspace_backend.proto.Loan\"f\xbaGC\n" +
+	"\aLending\x12\vReturn item\x1a+Return a lent item and record its condition\x82\xd3\xe4\x93\x02\x1a:\x01*\"\x15/v1/loans/{id}:return\x12\xe4\x01\n" +
+	"\x10ReturnItemByScan\x12/.ourspace_backend.proto.ReturnItemByScanRequest\x1a\x1c.ourspace_backend.proto.Loan\"\x80\x01\xbaG]\n" +
+	"\aLending\x12\x13Return item by scan\x1a=Return the item with the scanned tag and record its condition\x82\xd3\xe4\x93\x02\x1a:\x01*\"\x15/v1/loans:return-scan\x12\xa7\x01\n" +
+	"\aGetLoan\x12&.ourspace_backend.proto.GetLoanRequest\x1a\x1c.ourspace_backend.proto.Loan\"V\xbaG=\n" +
+	"\aLending\x12\bGet loan\x1a(Get a single entry of the lending ledger\x82\xd3\xe4\x93\x02\x10\x12\x0e/v1/loans/{id}\x12\xbc\x01\n" +
+	"\tListLoans\x12(.ourspace_backend.proto.ListLoansRequest\x1a).ourspace_backend.proto.ListLoansResponse\"Z\xbaGF\n" +
+	"\aLending\x12\n" +
+	"List loans\x1a/List the lending ledger, e.g. all overdue items\x82\xd3\xe4\x93\x02\v\x12\t/v1/loans2\xfb\r\n" +
+	"\x0eMachineService\x12\xd9\x01\n" +
+	"\rCreateMachine\x12,.ourspace_backend.proto.CreateMachineRequest\x1a\x1f.ourspace_backend.proto.Machine\"y\xbaGY\n" +
+	"\bMachines\x12\x0eCreate machine\x1a=Register a machine or resource that is gated behind briefings\x82\xd3\xe4\x93\x02\x17:\amachine\"\f/v1/machines\x12\xa6\x01\n" +
+	"\n" +
+	"GetMachine\x12).ourspace_backend.proto.GetMachineRequest\x1a\x1f.ourspace_backend.proto.Machine\"L\xbaG0\n" +
+	"\bMachines\x12\vGet machine\x1a\x17Get machine information\x82\xd3\xe4\x93\x02\x13\x12\x11/v1/machines/{id}\x12\xb9\x01\n" +
+	"\fListMachines\x12+.ourspace_backend.proto.ListMachinesRequest\x1a,.ourspace_backend.proto.ListMachinesResponse\"N\xbaG7\n" +
+	"\bMachines\x12\rList machines\x1a\x1cList all registered machines\x82\xd3\xe4\x93\x02\x0e\x12\f/v1/machines\x12\xcd\x01\n" +
+	"\rUpdateMachine\x12,.ourspace_backend.proto.UpdateMachineRequest\x1a\x1f.ourspace_backend.proto.Machine\"m\xbaG@\n" +
+	"\bMachines\x12\x0eUpdate machine\x1a$Update specified fields of a machine\x82\xd3\xe4\x93\x02$:\amachine2\x19/v1/machines/{machine.id}\x12\xab\x01\n" +
+	"\rDeleteMachine\x12,.ourspace_backend.proto.DeleteMachineRequest\x1a\x16.google.protobuf.Empty\"T\xbaG8\n" +
+	"\bMachines\x12\x0eDelete machine\x1a\x1cDelete the specified machine\x82\xd3\xe4\x93\x02\x13*\x11/v1/machines/{id}\x12\xc9\x02\n" +
+	"\x11StartUsageSession\x120.ourspace_backend.proto.StartUsageSessionRequest\x1a$.ourspace_backend.proto.UsageSession\"\xdb\x01\xbaG\xa4\x01\n" +
+	"\bMachines\x12\x13Start usage session\x1a\x82\x01Start using a machine. The member needs all briefings the machine requires and a machine can only be used by one member at a time.\x82\xd3\xe4\x93\x02-:\x01*\"(/v1/machines/{machine_id}/sessions:start\x12\xec\x01\n" +
+	"\x10StopUsageSession\x12/.ourspace_backend.proto.StopUsageSessionRequest\x1a$.ourspace_backend.proto.UsageSession\"\x80\x01\xbaGK\n" +
+	"\bMachines\x12\x12Stop usage session\x1a+Stop the running usage session of a machine\x82\xd3\xe4\x93\x02,:\x01*\"'/v1/machines/{machine_id}/sessions:stop\x12\xee\x01\n" +
+	"\x11ListUsageSessions\x120.ourspace_backend.proto.ListUsageSessionsRequest\x1a1.ourspace_backend.proto.ListUsageSessionsResponse\"t\xbaGW\n" +
+	"\bMachines\x12\x13List usage sessions\x1a6List usage sessions of machines, the most recent first\x82\xd3\xe4\x93\x02\x14\x12\x12/v1/usage-sessions2\x9a\x04\n" +
+	"\rAccessService\x12\x8e\x02\n" +
+	"\vCheckAccess\x12*.ourspace_backend.proto.CheckAccessRequest\x1a&.ourspace_backend.proto.AccessDecision\"\xaa\x01\xbaG\x8b\x01\n" +
+	"\x06Access\x12\fCheck access\x1asDecide whether the owner of a card may use a machine. Meant for machine-side controllers, every decision is logged.\x82\xd3\xe4\x93\x02\x15:\x01*\"\x10/v1/access:check\x12\xf7\x01\n" +
+	"\x13ListAccessDecisions\x122.ourspace_backend.proto.ListAccessDecisionsRequest\x1a3.ourspace_backend.proto.ListAccessDecisionsResponse\"w\xbaGX\n" +
+	"\x06Access\x12\x15List access decisions\x1a7List the log of access decisions, the most recent first\x82\xd3\xe4\x93\x02\x16\x12\x14/v1/access/decisions2\xd3&\n" +
+	"\n" +
+	"FeeService\x12\x80\x02\n" +
+	"\x14CreateMembershipPlan\x123.ourspace_backend.proto.CreateMembershipPlanRequest\x1a&.ourspace_backend.proto.MembershipPlan\"\x8a\x01\xbaGZ\n" +
+	"\x04Fees\x12\x16Create membership plan\x1a:Create a membership plan with its fee and billing interval\x82\xd3\xe4\x93\x02':\x0fmembership_plan\"\x14/v1/membership-plans\x12\xcf\x01\n" +
+	"\x11GetMembershipPlan\x120.ourspace_backend.proto.GetMembershipPlanRequest\x1a&.ourspace_backend.proto.MembershipPlan\"`\xbaG<\n" +
+	"\x04Fees\x12\x13Get membership plan\x1a\x1fGet membership plan information\x82\xd3\xe4\x93\x02\x1b\x12\x19/v1/membership-plans/{id}\x12\xd7\x01\n" +
+	"\x13ListMembershipPlans\x122.ourspace_backend.proto.ListMembershipPlansRequest\x1a3.ourspace_backend.proto.ListMembershipPlansResponse\"W\xbaG8\n" +
+	"\x04Fees\x12\x15List membership plans\x1a\x19List all membership plans\x82\xd3\xe4\x93\x02\x16\x12\x14/v1/membership-plans\x12\xc1\x02\n" +
+	"\x14UpdateMembershipPlan\x123.ourspace_backend.proto.UpdateMembershipPlanRequest\x1a&.ourspace_backend.proto.MembershipPlan\"\xcb\x01\xbaG\x85\x01\n" +
+	"\x04Fees\x12\x16Update membership plan\x1aeUpdate specified fields of a membership plan. Invoices that were already generated keep their amount.\x82\xd3\xe4\x93\x02<:\x0fmembership_plan2)/v1/membership-plans/{membership_plan.id}\x12\xf8\x01\n" +
+	"\x14DeleteMembershipPlan\x123.ourspace_backend.proto.DeleteMembershipPlanRequest\x1a\x16.google.protobuf.Empty\"\x92\x01\xbaGn\n" +
+	"\x04Fees\x12\x16Delete membership plan\x1aNDelete a membership plan, only possible while it is not assigned to any member\x82\xd3\xe4\x93\x02\x1b*\x19/v1/membership-plans/{id}\x12\x95\x02\n" +
+	"\x14AssignMembershipPlan\x123.ourspace_backend.proto.AssignMembershipPlanRequest\x1a&.ourspace_backend.proto.PlanAssignment\"\x9f\x01\xbaGo\n" +
+	"\x04Fees\x12\x16Assign membership plan\x1aOAssign a membership plan to a member. Assignments of a member must not overlap.\x82\xd3\xe4\x93\x02':\x0fplan_assignment\"\x14/v1/plan-assignments\x12\xf2\x01\n" +
+	"\x13ListPlanAssignments\x122.ourspace_backend.proto.ListPlanAssignmentsRequest\x1a3.ourspace_backend.proto.ListPlanAssignmentsResponse\"r\xbaGS\n" +
+	"\x04Fees\x12\x15List plan assignments\x1a4List plan assignments, optionally of a single member\x82\xd3\xe4\x93\x02\x16\x12\x14/v1/plan-assignments\x12\x96\x02\n" +
+	"\x11EndPlanAssignment\x120.ourspace_backend.proto.EndPlanAssignmentRequest\x1a&.ourspace_backend.proto.PlanAssignment\"\xa6\x01\xbaG{\n" +
+	"\x04Fees\x12\x13End plan assignment\x1a^End a plan assignment, no invoices are generated for periods starting at or after the end time\x82\xd3\xe4\x93\x02\":\x01*\"\x1d/v1/plan-assignments/{id}:end\x12\xb9\x02\n" +
+	"\x10GenerateInvoices\x12/.ourspace_backend.proto.GenerateInvoicesRequest\x1a0.ourspace_backend.proto.GenerateInvoicesResponse\"\xc1\x01\xbaG\x9d\x01\n" +
+	"\x04Fees\x12\x11Generate invoices\x1a\x81\x01Generate the dues of all billing periods starting before the given time. Periods are only billed once, so this is safe to repeat.\x82\xd3\xe4\x93\x02\x1a:\x01*\"\x15/v1/invoices:generate\x12\xcc\x01\n" +
+	"\fListInvoices\x12+.ourspace_backend.proto.ListInvoicesRequest\x1a,.ourspace_backend.proto.ListInvoicesResponse\"a\xbaGJ\n" +
+	"\x04Fees\x12\rList invoices\x1a3List invoices, the most recent billing period first\x82\xd3\xe4\x93\x02\x0e\x12\f/v1/invoices\x12\x80\x02\n" +
+	"\rCancelInvoice\x12,.ourspace_backend.proto.CancelInvoiceRequest\x1a\x1f.ourspace_backend.proto.Invoice\"\x9f\x01\xbaGy\n" +
+	"\x04Fees\x12\x0eCancel invoice\x1aaCancel an invoice, e.g. if a fee was waived. Cancelled invoices do not count towards the balance.\x82\xd3\xe4\x93\x02\x1d:\x01*\"\x18/v1/invoices/{id}:cancel\x12\xd7\x01\n" +
+	"\rRecordPayment\x12,.ourspace_backend.proto.RecordPaymentRequest\x1a\x1f.ourspace_backend.proto.Payment\"w\xbaGW\n" +
+	"\x04Fees\x12\x0eRecord payment\x1a?Record a payment of a member, optionally for a specific invoice\x82\xd3\xe4\x93\x02\x17:\apayment\"\f/v1/payments\x12\xc6\x01\n" +
+	"\fListPayments\x12+.ourspace_backend.proto.ListPaymentsRequest\x1a,.ourspace_backend.proto.ListPaymentsResponse\"[\xbaGD\n" +
+	"\x04Fees\x12\rList payments\x1a-List recorded payments, the most recent first\x82\xd3\xe4\x93\x02\x0e\x12\f/v1/payments\x12\xac\x01\n" +
+	"\rDeletePayment\x12,.ourspace_backend.proto.DeletePaymentRequest\x1a\x16.google.protobuf.Empty\"U\xbaG9\n" +
+	"\x04Fees\x12\x0eDelete payment\x1a!Delete a wrongly recorded payment\x82\xd3\xe4\x93\x02\x13*\x11/v1/payments/{id}\x12\xed\x01\n" +
+	"\fListBalances\x12+.ourspace_backend.proto.ListBalancesRequest\x1a,.ourspace_backend.proto.ListBalancesResponse\"\x81\x01\xbaGj\n" +
+	"\x04Fees\x12\rList balances\x1aSList the balance of members, i.e. the difference between invoiced fees and payments\x82\xd3\xe4\x93\x02\x0e\x12\f/v1/balances\x12\x85\x02\n" +
+	"\x0eSetSepaMandate\x12-.ourspace_backend.proto.SetSepaMandateRequest\x1a#.ourspace_backend.proto.SepaMandate\"\x9e\x01\xbaGT\n" +
+	"\x04Fees\x12\x10Set SEPA mandate\x1a:Store or replace the SEPA direct debit mandate of a member\x82\xd3\xe4\x93\x02A:\fsepa_mandate\x1a1/v1/members/{sepa_mandate.member_id}/sepa-mandate\x12\xdc\x01\n" +
+	"\x0eGetSepaMandate\x12-.ourspace_backend.proto.GetSepaMandateRequest\x1a#.ourspace_backend.proto.SepaMandate\"v\xbaGG\n" +
+	"\x04Fees\x12\x10Get SEPA mandate\x1a-Get the SEPA direct debit mandate of a member\x82\xd3\xe4\x93\x02&\x12$/v1/members/{member_id}/sepa-mandate\x12\xf7\x01\n" +
+	"\x11DeleteSepaMandate\x120.ourspace_backend.proto.DeleteSepaMandateRequest\x1a\x16.google.protobuf.Empty\"\x97\x01\xbaGh\n" +
+	"\x04Fees\x12\x13Delete SEPA mandate\x1aKDelete the SEPA direct debit mandate of a member, e.g. after it was revoked\x82\xd3\xe4\x93\x02&*$/v1/members/{member_id}/sepa-mandate\x12\xff\x03\n" +
+	"\x15ExportSepaDirectDebit\x124.ourspace_backend.proto.ExportSepaDirectDebitRequest\x1a-.ourspace_backend.proto.SepaDirectDebitExport\"\x80\x03\xbaG\xd9\x02\n" +
+	"\x04Fees\x12\x18Export SEPA direct debit\x1a\xb6\x02Generate a pain.008 file collecting the unpaid amount of all open invoices of a period. Members with missing or invalid mandate data are reported and left out. Invoices of earlier exports are left out unless include_exported is set. No payments are recorded, record them once the bank confirmed the collection.\x82\xd3\xe4\x93\x02\x1d:\x01*\"\x18/v1/invoices:export-sepa2\x89\r\n" +
+	"\vSelfService\x12\xb3\x01\n" +
+	"\fGetMyProfile\x12+.ourspace_backend.proto.GetMyProfileRequest\x1a\x1e.ourspace_backend.proto.Member\"V\xbaG?\n" +
+	"\fSelf Service\x12\x0eGet my profile\x1a\x1fGet the member who is logged in\x82\xf3\x19\x02\x10\x01\x82\xd3\xe4\x93\x02\b\x12\x06/v1/me\x12\xe4\x01\n" +
+	"\x0fUpdateMyProfile\x12..ourspace_backend.proto.UpdateMyProfileRequest\x1a\x1e.ourspace_backend.proto.Member\"\x80\x01\xbaGa\n" +
+	"\fSelf Service\x12\x11Update my profile\x1a>Update the fields and attributes members may change themselves\x82\xf3\x19\x02\x10\x01\x82\xd3\xe4\x93\x02\x10:\x06member2\x06/v1/me\x12\xe7\x01\n" +
+	"\x0fListMyPresences\x12..ourspace_backend.proto.ListMyPresencesRequest\x1a-.ourspace_backend.proto.ListPresencesResponse\"u\xbaGT\n" +
+	"\fSelf Service\x12\x11List my presences\x1a1List the presences of the member who is logged in\x82\xf3\x19\x02\x10\x01\x82\xd3\xe4\x93\x02\x12\x12\x10/v1/me/presences\x12\xcf\x01\n" +
+	"\vListMyCards\x12*.ourspace_backend.proto.ListMyCardsRequest\x1a).ourspace_backend.proto.ListCardsResponse\"i\xbaGL\n" +
+	"\fSelf Service\x12\rList my cards\x1a-List the cards of the member who is logged in\x82\xf3\x19\x02\x10\x01\x82\xd3\xe4\x93\x02\x0e\x12\f/v1/me/cards\x12\xe7\x01\n" +
+	"\x0fListMyBriefings\x12..ourspace_backend.proto.ListMyBriefingsRequest\x1a-.ourspace_backend.proto.ListBriefingsResponse\"u\xbaGT\n" +
+	"\fSelf Service\x12\x11List my briefings\x1a1List the briefings of the member who is logged in\x82\xf3\x19\x02\x10\x01\x82\xd3\xe4\x93\x02\x12\x12\x10/v1/me/briefings\x12\x92\x02\n" +
+	"\x15CreateMyChangeRequest\x124.ourspace_backend.proto.CreateMyChangeRequestRequest\x1a+.ourspace_backend.proto.MemberChangeRequest\"\x95\x01\xbaGk\n" +
+	"\fSelf Service\x12\x0eRequest change\x1aKRequest a change of fields and attributes that have to be approved by staff\x82\xf3\x19\x02\x10\x01\x82\xd3\xe4\x93\x02\x1b:\x01*\"\x16/v1/me/change-requests\x12\xa1\x02\n" +
+	"\x14ListMyChangeRequests\x123.ourspace_backend.proto.ListMyChangeRequestsRequest\x1a8.ourspace_backend.proto.ListMemberChangeRequestsResponse\"\x99\x01\xbaGr\n" +
+	"\fSelf Service\x12\x17List my change requests\x1aIList the change requests of the member who is logged in, the latest first\x82\xf3\x19\x02\x10\x01\x82\xd3\xe4\x93\x02\x18\x12\x16/v1/me/change-requests2\x92\b\n" +
+	"\rReportService\x12\x8c\x02\n" +
+	"\x11GetPresenceReport\x120.ourspace_backend.proto.GetPresenceReportRequest\x1a&.ourspace_backend.proto.PresenceReport\"\x9c\x01\xbaG|\n" +
+	"\aReports\x12\x0fPresence report\x1a`Aggregated presence statistics for a time range, e.g. for annual reports or funding applications\x82\xd3\xe4\x93\x02\x17\x12\x15/v1/reports/presences\x12\xdf\x01\n" +
+	"\x14ExportPresenceReport\x120.ourspace_backend.proto.GetPresenceReportRequest\x1a\x14.google.api.HttpBody\"\x7f\xbaGX\n" +
+	"\aReports\x12\x16Export presence report\x1a5Same as the presence report, but returned as CSV file\x82\xd3\xe4\x93\x02\x1e\x12\x1c/v1/reports/presences:export\x12\x96\x02\n" +
+	"\x15GetMachineUsageReport\x124.ourspace_backend.proto.GetMachineUsageReportRequest\x1a*.ourspace_backend.proto.MachineUsageReport\"\x9a\x01\xbaGv\n" +
+	"\aReports\x12\x14Machine usage report\x1aUMachine usage and prices per member or per machine for a time range, e.g. for billing\x82\xd3\xe4\x93\x02\x1b\x12\x19/v1/reports/machine-usage\x12\xf6\x01\n" +
+	"\x18ExportMachineUsageReport\x124.ourspace_backend.proto.GetMachineUsageReportRequest\x1a\x14.google.api.HttpBody\"\x8d\x01\xbaGb\n" +
+	"\aReports\x12\x1bExport machine usage report\x1a:Same as the machine usage report, but returned as CSV file\x82\xd3\xe4\x93\x02\"\x12 /v1/reports/machine-usage:export2\xff\a\n" +
+	"\x12ApplicationService\x12\x87\x02\n" +
+	"\x11SubmitApplication\x120.ourspace_backend.proto.SubmitApplicationRequest\x1a\x16.google.protobuf.Empty\"\xa7\x01\xbaG\x82\x01\n" +
+	"\fApplications\x12\x14Apply for membership\x1aZSubmit an online membership application. The number of applications per client is limited.Z\x00\x82\xf3\x19\x02\b\x01\x82\xd3\xe4\x93\x02\x15:\x01*\"\x10/v1/applications\x12\xe3\x01\n" +
+	"\x10ListApplications\x12/.ourspace_backend.proto.ListApplicationsRequest\x1a0.ourspace_backend.proto.ListApplicationsResponse\"l\xbaGQ\n" +
+	"\fApplications\x12\x11List applications\x1a.List membership applications, the oldest first\x82\xd3\xe4\x93\x02\x12\x12\x10/v1/applications\x12\x8f\x02\n" +
+	"\x11AcceptApplication\x120.ourspace_backend.proto.AcceptApplicationRequest\x1a#.ourspace_backend.proto.Application\"\xa2\x01\xbaGx\n" +
+	"\fApplications\x12\x12Accept application\x1aTCreate a member from the application. The member has the same id as the application.\x82\xd3\xe4\x93\x02!:\x01*\"\x1c/v1/applications/{id}:accept\x12\xe6\x01\n" +
+	"\x11RejectApplication\x120.ourspace_backend.proto.RejectApplicationRequest\x1a#.ourspace_backend.proto.Application\"z\xbaGP\n" +
+	"\fApplications\x12\x12Reject application\x1a,Reject the application, no member is created\x82\xd3\xe4\x93\x02!:\x01*\"\x1c/v1/applications/{id}:reject2\xca\n" +
+	"\n" +
+	"\x11MemberNoteService\x12\xfd\x01\n" +
+	"\x10CreateMemberNote\x12/.ourspace_backend.proto.CreateMemberNoteRequest\x1a\".ourspace_backend.proto.MemberNote\"\x93\x01\xbaG`\n" +
+	"\fMember Notes\x12\vCreate note\x1aCLeave a note on a member, the author is taken from the access token\x82\xd3\xe4\x93\x02*:\x04note\"\"/v1/members/{note.member_id}/notes\x12\x94\x02\n" +
+	"\x0fListMemberNotes\x12..ourspace_backend.proto.ListMemberNotesRequest\x1a/.ourspace_backend.proto.ListMemberNotesResponse\"\x9f\x01\xbaGw\n" +
+	"\fMember Notes\x12\n" +
+	"List notes\x1a[List the notes on a member the caller can see, pinned notes first and then the latest first\x82\xd3\xe4\x93\x02\x1f\x12\x1d/v1/members/{member_id}/notes\x12\xa2\x02\n" +
+	"\x10UpdateMemberNote\x12/.ourspace_backend.proto.UpdateMemberNoteRequest\x1a\".ourspace_backend.proto.MemberNote\"\xb8\x01\xbaG{\n" +
+	"\fMember Notes\x12\vUpdate note\x1a^Update the text, pinning or visibility of a note. Only the author and admins can update notes.\x82\xd3\xe4\x93\x024:\x04note2,/v1/members/{note.member_id}/notes/{note.id}\x12\xe3\x01\n" +
+	"\x10DeleteMemberNote\x12/.ourspace_backend.proto.DeleteMemberNoteRequest\x1a\x16.google.protobuf.Empty\"\x85\x01\xbaGX\n" +
+	"\fMember Notes\x12\vDelete note\x1a;Delete a note. Only the author and admins can delete notes.\x82\xd3\xe4\x93\x02$*\"/v1/members/{member_id}/notes/{id}\x12\x92\x02\n" +
+	"\x12ListMemberTimeline\x121.ourspace_backend.proto.ListMemberTimelineRequest\x1a2.ourspace_backend.proto.ListMemberTimelineResponse\"\x94\x01\xbaGi\n" +
+	"\fMember Notes\x12\rList timeline\x1aJList the notes, card issuances and briefings of a member, the latest first\x82\xd3\xe4\x93\x02\"\x12 /v1/members/{member_id}/timeline2\xb4\x04\n" +
 	"\vAuthService\x12\xa4\x01\n" +
 	"\x05Login\x12$.ourspace_backend.proto.LoginRequest\x1a%.ourspace_backend.proto.LoginResponse\"N\xbaG,\n" +
 	"\x04Auth\x12\x05Login\x1a\x1bAuthenticate with our-spaceZ\x00\x82\xf3\x19\x02\b\x01\x82\xd3\xe4\x93\x02\x13:\x01*\"\x0e/v1/auth/login\x12\xd3\x01\n" +
@@ -4205,223 +16513,758 @@ func file_ourspace_backend_proto_api_proto_rawDescGZIP() []byte {
 	return file_ourspace_backend_proto_api_proto_rawDescData
 }
 
-var file_ourspace_backend_proto_api_proto_enumTypes = make([]protoimpl.EnumInfo, 7)
-var file_ourspace_backend_proto_api_proto_msgTypes = make([]protoimpl.MessageInfo, 61)
+var file_ourspace_backend_proto_api_proto_enumTypes = make([]protoimpl.EnumInfo, 22)
+var file_ourspace_backend_proto_api_proto_msgTypes = make([]protoimpl.MessageInfo, 228)
 var file_ourspace_backend_proto_api_proto_goTypes = []any{
-	(AgeCategory)(0),                     // 0: ourspace_backend.proto.AgeCategory
-	(MemberField)(0),                     // 1: ourspace_backend.proto.MemberField
-	(PresenceField)(0),                   // 2: ourspace_backend.proto.PresenceField
-	(SortDirection)(0),                   // 3: ourspace_backend.proto.SortDirection
-	(MemberAttributeField)(0),            // 4: ourspace_backend.proto.MemberAttributeField
-	(CardField)(0),                       // 5: ourspace_backend.proto.CardField
-	(MemberAttribute_Type)(0),            // 6: ourspace_backend.proto.MemberAttribute.Type
-	(*CreateMemberRequest)(nil),          // 7: ourspace_backend.proto.CreateMemberRequest
-	(*Member)(nil),                       // 8: ourspace_backend.proto.Member
-	(*MemberLogin)(nil),                  // 9: ourspace_backend.proto.MemberLogin
-	(*GetMemberRequest)(nil),             // 10: ourspace_backend.proto.GetMemberRequest
-	(*ListMembersRequest)(nil),           // 11: ourspace_backend.proto.ListMembersRequest
-	(*ListMembersResponse)(nil),          // 12: ourspace_backend.proto.ListMembersResponse
-	(*MemberPageToken)(nil),              // 13: ourspace_backend.proto.MemberPageToken
-	(*UpdateMemberRequest)(nil),          // 14: ourspace_backend.proto.UpdateMemberRequest
-	(*DeleteMemberRequest)(nil),          // 15: ourspace_backend.proto.DeleteMemberRequest
-	(*ListMemberTagsRequest)(nil),        // 16: ourspace_backend.proto.ListMemberTagsRequest
-	(*ListMemberTagsResponse)(nil),       // 17: ourspace_backend.proto.ListMemberTagsResponse
-	(*MemberTagsPageToken)(nil),          // 18: ourspace_backend.proto.MemberTagsPageToken
-	(*CreateMemberAttributeRequest)(nil), // 19: ourspace_backend.proto.CreateMemberAttributeRequest
-	(*GetMemberAttributeRequest)(nil),    // 20: ourspace_backend.proto.GetMemberAttributeRequest
-	(*ListMemberAttributesRequest)(nil),  // 21: ourspace_backend.proto.ListMemberAttributesRequest
-	(*ListMemberAttributesResponse)(nil), // 22: ourspace_backend.proto.ListMemberAttributesResponse
-	(*UpdateMemberAttributeRequest)(nil), // 23: ourspace_backend.proto.UpdateMemberAttributeRequest
-	(*DeleteMemberAttributeRequest)(nil), // 24: ourspace_backend.proto.DeleteMemberAttributeRequest
-	(*MemberAttribute)(nil),              // 25: ourspace_backend.proto.MemberAttribute
-	(*MemberAttributePageToken)(nil),     // 26: ourspace_backend.proto.MemberAttributePageToken
-	(*Card)(nil),                         // 27: ourspace_backend.proto.Card
-	(*CardPageToken)(nil),                // 28: ourspace_backend.proto.CardPageToken
-	(*CreateCardRequest)(nil),            // 29: ourspace_backend.proto.CreateCardRequest
-	(*GetCardRequest)(nil),               // 30: ourspace_backend.proto.GetCardRequest
-	(*ListCardsRequest)(nil),             // 31: ourspace_backend.proto.ListCardsRequest
-	(*ListCardsResponse)(nil),            // 32: ourspace_backend.proto.ListCardsResponse
-	(*UpdateCardRequest)(nil),            // 33: ourspace_backend.proto.UpdateCardRequest
-	(*DeleteCardRequest)(nil),            // 34: ourspace_backend.proto.DeleteCardRequest
-	(*BriefingType)(nil),                 // 35: ourspace_backend.proto.BriefingType
-	(*CreateBriefingTypeRequest)(nil),    // 36: ourspace_backend.proto.CreateBriefingTypeRequest
-	(*GetBriefingTypeRequest)(nil),       // 37: ourspace_backend.proto.GetBriefingTypeRequest
-	(*ListBriefingTypesRequest)(nil),     // 38: ourspace_backend.proto.ListBriefingTypesRequest
-	(*ListBriefingTypesResponse)(nil),    // 39: ourspace_backend.proto.ListBriefingTypesResponse
-	(*UpdateBriefingTypeRequest)(nil),    // 40: ourspace_backend.proto.UpdateBriefingTypeRequest
-	(*DeleteBriefingTypeRequest)(nil),    // 41: ourspace_backend.proto.DeleteBriefingTypeRequest
-	(*Briefing)(nil),                     // 42: ourspace_backend.proto.Briefing
-	(*CreateBriefingRequest)(nil),        // 43: ourspace_backend.proto.CreateBriefingRequest
-	(*GetBriefingRequest)(nil),           // 44: ourspace_backend.proto.GetBriefingRequest
-	(*ListBriefingsRequest)(nil),         // 45: ourspace_backend.proto.ListBriefingsRequest
-	(*ListBriefingsResponse)(nil),        // 46: ourspace_backend.proto.ListBriefingsResponse
-	(*UpdateBriefingRequest)(nil),        // 47: ourspace_backend.proto.UpdateBriefingRequest
-	(*DeleteBriefingRequest)(nil),        // 48: ourspace_backend.proto.DeleteBriefingRequest
-	(*Presence)(nil),                     // 49: ourspace_backend.proto.Presence
-	(*ListPresencesRequest)(nil),         // 50: ourspace_backend.proto.ListPresencesRequest
-	(*ListPresencesResponse)(nil),        // 51: ourspace_backend.proto.ListPresencesResponse
-	(*PresencePageToken)(nil),            // 52: ourspace_backend.proto.PresencePageToken
-	(*CheckinRequest)(nil),               // 53: ourspace_backend.proto.CheckinRequest
-	(*CheckoutRequest)(nil),              // 54: ourspace_backend.proto.CheckoutRequest
-	(*UpdatePresenceRequest)(nil),        // 55: ourspace_backend.proto.UpdatePresenceRequest
-	(*DeletePresenceRequest)(nil),        // 56: ourspace_backend.proto.DeletePresenceRequest
-	(*LoginRequest)(nil),                 // 57: ourspace_backend.proto.LoginRequest
-	(*LoginPassword)(nil),                // 58: ourspace_backend.proto.LoginPassword
-	(*LoginOpenIDConnect)(nil),           // 59: ourspace_backend.proto.LoginOpenIDConnect
-	(*LoginApiKey)(nil),                  // 60: ourspace_backend.proto.LoginApiKey
-	(*LoginResponse)(nil),                // 61: ourspace_backend.proto.LoginResponse
-	(*LoginSuccess)(nil),                 // 62: ourspace_backend.proto.LoginSuccess
-	(*RefreshRequest)(nil),               // 63: ourspace_backend.proto.RefreshRequest
-	(*RefreshResponse)(nil),              // 64: ourspace_backend.proto.RefreshResponse
-	(*LogoutRequest)(nil),                // 65: ourspace_backend.proto.LogoutRequest
-	(*LogoutResponse)(nil),               // 66: ourspace_backend.proto.LogoutResponse
-	nil,                                  // 67: ourspace_backend.proto.Member.AdditionalAttributesEntry
-	(*timestamppb.Timestamp)(nil),        // 68: google.protobuf.Timestamp
-	(*fieldmaskpb.FieldMask)(nil),        // 69: google.protobuf.FieldMask
-	(*durationpb.Duration)(nil),          // 70: google.protobuf.Duration
-	(*emptypb.Empty)(nil),                // 71: google.protobuf.Empty
+	(AgeCategory)(0),                         // 0: ourspace_backend.proto.AgeCategory
+	(MemberField)(0),                         // 1: ourspace_backend.proto.MemberField
+	(SortDirection)(0),                       // 2: ourspace_backend.proto.SortDirection
+	(ExportFormat)(0),                        // 3: ourspace_backend.proto.ExportFormat
+	(MemberAttributeField)(0),                // 4: ourspace_backend.proto.MemberAttributeField
+	(CardField)(0),                           // 5: ourspace_backend.proto.CardField
+	(PresenceField)(0),                       // 6: ourspace_backend.proto.PresenceField
+	(PresenceAction)(0),                      // 7: ourspace_backend.proto.PresenceAction
+	(EventField)(0),                          // 8: ourspace_backend.proto.EventField
+	(EventRegistrationStatus)(0),             // 9: ourspace_backend.proto.EventRegistrationStatus
+	(ItemField)(0),                           // 10: ourspace_backend.proto.ItemField
+	(AccessReason)(0),                        // 11: ourspace_backend.proto.AccessReason
+	(BillingInterval)(0),                     // 12: ourspace_backend.proto.BillingInterval
+	(InvoiceStatus)(0),                       // 13: ourspace_backend.proto.InvoiceStatus
+	(ReportBucket)(0),                        // 14: ourspace_backend.proto.ReportBucket
+	(UsageReportGrouping)(0),                 // 15: ourspace_backend.proto.UsageReportGrouping
+	(MemberRelationship_Type)(0),             // 16: ourspace_backend.proto.MemberRelationship.Type
+	(MemberChangeRequest_State)(0),           // 17: ourspace_backend.proto.MemberChangeRequest.State
+	(MemberAttribute_Type)(0),                // 18: ourspace_backend.proto.MemberAttribute.Type
+	(MemberAttribute_Sensitivity)(0),         // 19: ourspace_backend.proto.MemberAttribute.Sensitivity
+	(Application_State)(0),                   // 20: ourspace_backend.proto.Application.State
+	(MemberNote_Visibility)(0),               // 21: ourspace_backend.proto.MemberNote.Visibility
+	(*CreateMemberRequest)(nil),              // 22: ourspace_backend.proto.CreateMemberRequest
+	(*Member)(nil),                           // 23: ourspace_backend.proto.Member
+	(*MemberLogin)(nil),                      // 24: ourspace_backend.proto.MemberLogin
+	(*GetMemberRequest)(nil),                 // 25: ourspace_backend.proto.GetMemberRequest
+	(*ListMembersRequest)(nil),               // 26: ourspace_backend.proto.ListMembersRequest
+	(*ListMembersResponse)(nil),              // 27: ourspace_backend.proto.ListMembersResponse
+	(*ExportMembersRequest)(nil),             // 28: ourspace_backend.proto.ExportMembersRequest
+	(*MemberPageToken)(nil),                  // 29: ourspace_backend.proto.MemberPageToken
+	(*SearchMembersRequest)(nil),             // 30: ourspace_backend.proto.SearchMembersRequest
+	(*SearchMembersResponse)(nil),            // 31: ourspace_backend.proto.SearchMembersResponse
+	(*MemberSearchResult)(nil),               // 32: ourspace_backend.proto.MemberSearchResult
+	(*MemberSearchPageToken)(nil),            // 33: ourspace_backend.proto.MemberSearchPageToken
+	(*UpdateMemberRequest)(nil),              // 34: ourspace_backend.proto.UpdateMemberRequest
+	(*DeleteMemberRequest)(nil),              // 35: ourspace_backend.proto.DeleteMemberRequest
+	(*ImportMembersRequest)(nil),             // 36: ourspace_backend.proto.ImportMembersRequest
+	(*ImportMembersResponse)(nil),            // 37: ourspace_backend.proto.ImportMembersResponse
+	(*MemberImportViolation)(nil),            // 38: ourspace_backend.proto.MemberImportViolation
+	(*ListMemberTagsRequest)(nil),            // 39: ourspace_backend.proto.ListMemberTagsRequest
+	(*ListMemberTagsResponse)(nil),           // 40: ourspace_backend.proto.ListMemberTagsResponse
+	(*MemberTag)(nil),                        // 41: ourspace_backend.proto.MemberTag
+	(*CreateMemberTagRequest)(nil),           // 42: ourspace_backend.proto.CreateMemberTagRequest
+	(*UpdateMemberTagRequest)(nil),           // 43: ourspace_backend.proto.UpdateMemberTagRequest
+	(*DeleteMemberTagRequest)(nil),           // 44: ourspace_backend.proto.DeleteMemberTagRequest
+	(*RenameMemberTagRequest)(nil),           // 45: ourspace_backend.proto.RenameMemberTagRequest
+	(*MergeMemberTagsRequest)(nil),           // 46: ourspace_backend.proto.MergeMemberTagsRequest
+	(*MemberTagsPageToken)(nil),              // 47: ourspace_backend.proto.MemberTagsPageToken
+	(*CreateMemberAttributeRequest)(nil),     // 48: ourspace_backend.proto.CreateMemberAttributeRequest
+	(*GetMemberAttributeRequest)(nil),        // 49: ourspace_backend.proto.GetMemberAttributeRequest
+	(*ListMemberAttributesRequest)(nil),      // 50: ourspace_backend.proto.ListMemberAttributesRequest
+	(*ListMemberAttributesResponse)(nil),     // 51: ourspace_backend.proto.ListMemberAttributesResponse
+	(*UpdateMemberAttributeRequest)(nil),     // 52: ourspace_backend.proto.UpdateMemberAttributeRequest
+	(*DeleteMemberAttributeRequest)(nil),     // 53: ourspace_backend.proto.DeleteMemberAttributeRequest
+	(*MigrateMemberAttributeRequest)(nil),    // 54: ourspace_backend.proto.MigrateMemberAttributeRequest
+	(*MigrateMemberAttributeResponse)(nil),   // 55: ourspace_backend.proto.MigrateMemberAttributeResponse
+	(*MemberAttributeMigrationIssue)(nil),    // 56: ourspace_backend.proto.MemberAttributeMigrationIssue
+	(*MemberRelationship)(nil),               // 57: ourspace_backend.proto.MemberRelationship
+	(*CreateMemberRelationshipRequest)(nil),  // 58: ourspace_backend.proto.CreateMemberRelationshipRequest
+	(*ListMemberRelationshipsRequest)(nil),   // 59: ourspace_backend.proto.ListMemberRelationshipsRequest
+	(*ListMemberRelationshipsResponse)(nil),  // 60: ourspace_backend.proto.ListMemberRelationshipsResponse
+	(*UpdateMemberRelationshipRequest)(nil),  // 61: ourspace_backend.proto.UpdateMemberRelationshipRequest
+	(*DeleteMemberRelationshipRequest)(nil),  // 62: ourspace_backend.proto.DeleteMemberRelationshipRequest
+	(*MemberConsent)(nil),                    // 63: ourspace_backend.proto.MemberConsent
+	(*CreateMemberConsentRequest)(nil),       // 64: ourspace_backend.proto.CreateMemberConsentRequest
+	(*ListMemberConsentsRequest)(nil),        // 65: ourspace_backend.proto.ListMemberConsentsRequest
+	(*ListMemberConsentsResponse)(nil),       // 66: ourspace_backend.proto.ListMemberConsentsResponse
+	(*RevokeMemberConsentRequest)(nil),       // 67: ourspace_backend.proto.RevokeMemberConsentRequest
+	(*MemberChangeRequest)(nil),              // 68: ourspace_backend.proto.MemberChangeRequest
+	(*ListMemberChangeRequestsRequest)(nil),  // 69: ourspace_backend.proto.ListMemberChangeRequestsRequest
+	(*ListMemberChangeRequestsResponse)(nil), // 70: ourspace_backend.proto.ListMemberChangeRequestsResponse
+	(*MemberChangeRequestPageToken)(nil),     // 71: ourspace_backend.proto.MemberChangeRequestPageToken
+	(*DecideMemberChangeRequestRequest)(nil), // 72: ourspace_backend.proto.DecideMemberChangeRequestRequest
+	(*MemberAttribute)(nil),                  // 73: ourspace_backend.proto.MemberAttribute
+	(*MemberAttributePageToken)(nil),         // 74: ourspace_backend.proto.MemberAttributePageToken
+	(*Card)(nil),                             // 75: ourspace_backend.proto.Card
+	(*CardPageToken)(nil),                    // 76: ourspace_backend.proto.CardPageToken
+	(*CreateCardRequest)(nil),                // 77: ourspace_backend.proto.CreateCardRequest
+	(*GetCardRequest)(nil),                   // 78: ourspace_backend.proto.GetCardRequest
+	(*ListCardsRequest)(nil),                 // 79: ourspace_backend.proto.ListCardsRequest
+	(*ListCardsResponse)(nil),                // 80: ourspace_backend.proto.ListCardsResponse
+	(*ExportCardsRequest)(nil),               // 81: ourspace_backend.proto.ExportCardsRequest
+	(*UpdateCardRequest)(nil),                // 82: ourspace_backend.proto.UpdateCardRequest
+	(*DeleteCardRequest)(nil),                // 83: ourspace_backend.proto.DeleteCardRequest
+	(*BriefingType)(nil),                     // 84: ourspace_backend.proto.BriefingType
+	(*BriefingPageToken)(nil),                // 85: ourspace_backend.proto.BriefingPageToken
+	(*CreateBriefingTypeRequest)(nil),        // 86: ourspace_backend.proto.CreateBriefingTypeRequest
+	(*GetBriefingTypeRequest)(nil),           // 87: ourspace_backend.proto.GetBriefingTypeRequest
+	(*ListBriefingTypesRequest)(nil),         // 88: ourspace_backend.proto.ListBriefingTypesRequest
+	(*ListBriefingTypesResponse)(nil),        // 89: ourspace_backend.proto.ListBriefingTypesResponse
+	(*UpdateBriefingTypeRequest)(nil),        // 90: ourspace_backend.proto.UpdateBriefingTypeRequest
+	(*DeleteBriefingTypeRequest)(nil),        // 91: ourspace_backend.proto.DeleteBriefingTypeRequest
+	(*Briefing)(nil),                         // 92: ourspace_backend.proto.Briefing
+	(*CreateBriefingRequest)(nil),            // 93: ourspace_backend.proto.CreateBriefingRequest
+	(*GetBriefingRequest)(nil),               // 94: ourspace_backend.proto.GetBriefingRequest
+	(*ListBriefingsRequest)(nil),             // 95: ourspace_backend.proto.ListBriefingsRequest
+	(*ListBriefingsResponse)(nil),            // 96: ourspace_backend.proto.ListBriefingsResponse
+	(*UpdateBriefingRequest)(nil),            // 97: ourspace_backend.proto.UpdateBriefingRequest
+	(*DeleteBriefingRequest)(nil),            // 98: ourspace_backend.proto.DeleteBriefingRequest
+	(*Presence)(nil),                         // 99: ourspace_backend.proto.Presence
+	(*ListPresencesRequest)(nil),             // 100: ourspace_backend.proto.ListPresencesRequest
+	(*ListPresencesResponse)(nil),            // 101: ourspace_backend.proto.ListPresencesResponse
+	(*ExportPresencesRequest)(nil),           // 102: ourspace_backend.proto.ExportPresencesRequest
+	(*PresencePageToken)(nil),                // 103: ourspace_backend.proto.PresencePageToken
+	(*CheckinRequest)(nil),                   // 104: ourspace_backend.proto.CheckinRequest
+	(*CheckoutRequest)(nil),                  // 105: ourspace_backend.proto.CheckoutRequest
+	(*TogglePresenceRequest)(nil),            // 106: ourspace_backend.proto.TogglePresenceRequest
+	(*TogglePresenceResponse)(nil),           // 107: ourspace_backend.proto.TogglePresenceResponse
+	(*CheckinByCardRequest)(nil),             // 108: ourspace_backend.proto.CheckinByCardRequest
+	(*UpdatePresenceRequest)(nil),            // 109: ourspace_backend.proto.UpdatePresenceRequest
+	(*DeletePresenceRequest)(nil),            // 110: ourspace_backend.proto.DeletePresenceRequest
+	(*Event)(nil),                            // 111: ourspace_backend.proto.Event
+	(*EventPageToken)(nil),                   // 112: ourspace_backend.proto.EventPageToken
+	(*CreateEventRequest)(nil),               // 113: ourspace_backend.proto.CreateEventRequest
+	(*GetEventRequest)(nil),                  // 114: ourspace_backend.proto.GetEventRequest
+	(*ListEventsRequest)(nil),                // 115: ourspace_backend.proto.ListEventsRequest
+	(*ListEventsResponse)(nil),               // 116: ourspace_backend.proto.ListEventsResponse
+	(*UpdateEventRequest)(nil),               // 117: ourspace_backend.proto.UpdateEventRequest
+	(*DeleteEventRequest)(nil),               // 118: ourspace_backend.proto.DeleteEventRequest
+	(*EventRegistration)(nil),                // 119: ourspace_backend.proto.EventRegistration
+	(*EventRegistrationPageToken)(nil),       // 120: ourspace_backend.proto.EventRegistrationPageToken
+	(*RegisterForEventRequest)(nil),          // 121: ourspace_backend.proto.RegisterForEventRequest
+	(*CancelEventRegistrationRequest)(nil),   // 122: ourspace_backend.proto.CancelEventRegistrationRequest
+	(*ListEventRegistrationsRequest)(nil),    // 123: ourspace_backend.proto.ListEventRegistrationsRequest
+	(*ListEventRegistrationsResponse)(nil),   // 124: ourspace_backend.proto.ListEventRegistrationsResponse
+	(*MarkEventAttendanceRequest)(nil),       // 125: ourspace_backend.proto.MarkEventAttendanceRequest
+	(*Item)(nil),                             // 126: ourspace_backend.proto.Item
+	(*ItemPageToken)(nil),                    // 127: ourspace_backend.proto.ItemPageToken
+	(*CreateItemRequest)(nil),                // 128: ourspace_backend.proto.CreateItemRequest
+	(*GetItemRequest)(nil),                   // 129: ourspace_backend.proto.GetItemRequest
+	(*ListItemsRequest)(nil),                 // 130: ourspace_backend.proto.ListItemsRequest
+	(*ListItemsResponse)(nil),                // 131: ourspace_backend.proto.ListItemsResponse
+	(*UpdateItemRequest)(nil),                // 132: ourspace_backend.proto.UpdateItemRequest
+	(*DeleteItemRequest)(nil),                // 133: ourspace_backend.proto.DeleteItemRequest
+	(*Loan)(nil),                             // 134: ourspace_backend.proto.Loan
+	(*LoanPageToken)(nil),                    // 135: ourspace_backend.proto.LoanPageToken
+	(*LendItemRequest)(nil),                  // 136: ourspace_backend.proto.LendItemRequest
+	(*LendItemByScanRequest)(nil),            // 137: ourspace_backend.proto.LendItemByScanRequest
+	(*ReturnItemRequest)(nil),                // 138: ourspace_backend.proto.ReturnItemRequest
+	(*ReturnItemByScanRequest)(nil),          // 139: ourspace_backend.proto.ReturnItemByScanRequest
+	(*GetLoanRequest)(nil),                   // 140: ourspace_backend.proto.GetLoanRequest
+	(*ListLoansRequest)(nil),                 // 141: ourspace_backend.proto.ListLoansRequest
+	(*ListLoansResponse)(nil),                // 142: ourspace_backend.proto.ListLoansResponse
+	(*Machine)(nil),                          // 143: ourspace_backend.proto.Machine
+	(*MachinePageToken)(nil),                 // 144: ourspace_backend.proto.MachinePageToken
+	(*CreateMachineRequest)(nil),             // 145: ourspace_backend.proto.CreateMachineRequest
+	(*GetMachineRequest)(nil),                // 146: ourspace_backend.proto.GetMachineRequest
+	(*ListMachinesRequest)(nil),              // 147: ourspace_backend.proto.ListMachinesRequest
+	(*ListMachinesResponse)(nil),             // 148: ourspace_backend.proto.ListMachinesResponse
+	(*UpdateMachineRequest)(nil),             // 149: ourspace_backend.proto.UpdateMachineRequest
+	(*DeleteMachineRequest)(nil),             // 150: ourspace_backend.proto.DeleteMachineRequest
+	(*UsageSession)(nil),                     // 151: ourspace_backend.proto.UsageSession
+	(*StartUsageSessionRequest)(nil),         // 152: ourspace_backend.proto.StartUsageSessionRequest
+	(*StopUsageSessionRequest)(nil),          // 153: ourspace_backend.proto.StopUsageSessionRequest
+	(*UsageSessionPageToken)(nil),            // 154: ourspace_backend.proto.UsageSessionPageToken
+	(*ListUsageSessionsRequest)(nil),         // 155: ourspace_backend.proto.ListUsageSessionsRequest
+	(*ListUsageSessionsResponse)(nil),        // 156: ourspace_backend.proto.ListUsageSessionsResponse
+	(*CheckAccessRequest)(nil),               // 157: ourspace_backend.proto.CheckAccessRequest
+	(*AccessDecision)(nil),                   // 158: ourspace_backend.proto.AccessDecision
+	(*AccessDecisionPageToken)(nil),          // 159: ourspace_backend.proto.AccessDecisionPageToken
+	(*ListAccessDecisionsRequest)(nil),       // 160: ourspace_backend.proto.ListAccessDecisionsRequest
+	(*ListAccessDecisionsResponse)(nil),      // 161: ourspace_backend.proto.ListAccessDecisionsResponse
+	(*MembershipPlan)(nil),                   // 162: ourspace_backend.proto.MembershipPlan
+	(*MembershipPlanPageToken)(nil),          // 163: ourspace_backend.proto.MembershipPlanPageToken
+	(*CreateMembershipPlanRequest)(nil),      // 164: ourspace_backend.proto.CreateMembershipPlanRequest
+	(*GetMembershipPlanRequest)(nil),         // 165: ourspace_backend.proto.GetMembershipPlanRequest
+	(*ListMembershipPlansRequest)(nil),       // 166: ourspace_backend.proto.ListMembershipPlansRequest
+	(*ListMembershipPlansResponse)(nil),      // 167: ourspace_backend.proto.ListMembershipPlansResponse
+	(*UpdateMembershipPlanRequest)(nil),      // 168: ourspace_backend.proto.UpdateMembershipPlanRequest
+	(*DeleteMembershipPlanRequest)(nil),      // 169: ourspace_backend.proto.DeleteMembershipPlanRequest
+	(*PlanAssignment)(nil),                   // 170: ourspace_backend.proto.PlanAssignment
+	(*PlanAssignmentPageToken)(nil),          // 171: ourspace_backend.proto.PlanAssignmentPageToken
+	(*AssignMembershipPlanRequest)(nil),      // 172: ourspace_backend.proto.AssignMembershipPlanRequest
+	(*ListPlanAssignmentsRequest)(nil),       // 173: ourspace_backend.proto.ListPlanAssignmentsRequest
+	(*ListPlanAssignmentsResponse)(nil),      // 174: ourspace_backend.proto.ListPlanAssignmentsResponse
+	(*EndPlanAssignmentRequest)(nil),         // 175: ourspace_backend.proto.EndPlanAssignmentRequest
+	(*Invoice)(nil),                          // 176: ourspace_backend.proto.Invoice
+	(*InvoicePageToken)(nil),                 // 177: ourspace_backend.proto.InvoicePageToken
+	(*GenerateInvoicesRequest)(nil),          // 178: ourspace_backend.proto.GenerateInvoicesRequest
+	(*GenerateInvoicesResponse)(nil),         // 179: ourspace_backend.proto.GenerateInvoicesResponse
+	(*ListInvoicesRequest)(nil),              // 180: ourspace_backend.proto.ListInvoicesRequest
+	(*ListInvoicesResponse)(nil),             // 181: ourspace_backend.proto.ListInvoicesResponse
+	(*CancelInvoiceRequest)(nil),             // 182: ourspace_backend.proto.CancelInvoiceRequest
+	(*Payment)(nil),                          // 183: ourspace_backend.proto.Payment
+	(*PaymentPageToken)(nil),                 // 184: ourspace_backend.proto.PaymentPageToken
+	(*RecordPaymentRequest)(nil),             // 185: ourspace_backend.proto.RecordPaymentRequest
+	(*ListPaymentsRequest)(nil),              // 186: ourspace_backend.proto.ListPaymentsRequest
+	(*ListPaymentsResponse)(nil),             // 187: ourspace_backend.proto.ListPaymentsResponse
+	(*DeletePaymentRequest)(nil),             // 188: ourspace_backend.proto.DeletePaymentRequest
+	(*Balance)(nil),                          // 189: ourspace_backend.proto.Balance
+	(*BalancePageToken)(nil),                 // 190: ourspace_backend.proto.BalancePageToken
+	(*ListBalancesRequest)(nil),              // 191: ourspace_backend.proto.ListBalancesRequest
+	(*SepaMandate)(nil),                      // 192: ourspace_backend.proto.SepaMandate
+	(*SetSepaMandateRequest)(nil),            // 193: ourspace_backend.proto.SetSepaMandateRequest
+	(*GetSepaMandateRequest)(nil),            // 194: ourspace_backend.proto.GetSepaMandateRequest
+	(*DeleteSepaMandateRequest)(nil),         // 195: ourspace_backend.proto.DeleteSepaMandateRequest
+	(*ExportSepaDirectDebitRequest)(nil),     // 196: ourspace_backend.proto.ExportSepaDirectDebitRequest
+	(*SepaExportIssue)(nil),                  // 197: ourspace_backend.proto.SepaExportIssue
+	(*SepaDirectDebitExport)(nil),            // 198: ourspace_backend.proto.SepaDirectDebitExport
+	(*ListBalancesResponse)(nil),             // 199: ourspace_backend.proto.ListBalancesResponse
+	(*GetMyProfileRequest)(nil),              // 200: ourspace_backend.proto.GetMyProfileRequest
+	(*UpdateMyProfileRequest)(nil),           // 201: ourspace_backend.proto.UpdateMyProfileRequest
+	(*ListMyPresencesRequest)(nil),           // 202: ourspace_backend.proto.ListMyPresencesRequest
+	(*ListMyCardsRequest)(nil),               // 203: ourspace_backend.proto.ListMyCardsRequest
+	(*ListMyBriefingsRequest)(nil),           // 204: ourspace_backend.proto.ListMyBriefingsRequest
+	(*CreateMyChangeRequestRequest)(nil),     // 205: ourspace_backend.proto.CreateMyChangeRequestRequest
+	(*ListMyChangeRequestsRequest)(nil),      // 206: ourspace_backend.proto.ListMyChangeRequestsRequest
+	(*GetPresenceReportRequest)(nil),         // 207: ourspace_backend.proto.GetPresenceReportRequest
+	(*PresenceReport)(nil),                   // 208: ourspace_backend.proto.PresenceReport
+	(*PresenceStatistics)(nil),               // 209: ourspace_backend.proto.PresenceStatistics
+	(*AgeCategoryStatistics)(nil),            // 210: ourspace_backend.proto.AgeCategoryStatistics
+	(*TagStatistics)(nil),                    // 211: ourspace_backend.proto.TagStatistics
+	(*GetMachineUsageReportRequest)(nil),     // 212: ourspace_backend.proto.GetMachineUsageReportRequest
+	(*MachineUsageReport)(nil),               // 213: ourspace_backend.proto.MachineUsageReport
+	(*UsageStatistics)(nil),                  // 214: ourspace_backend.proto.UsageStatistics
+	(*Application)(nil),                      // 215: ourspace_backend.proto.Application
+	(*SubmitApplicationRequest)(nil),         // 216: ourspace_backend.proto.SubmitApplicationRequest
+	(*ListApplicationsRequest)(nil),          // 217: ourspace_backend.proto.ListApplicationsRequest
+	(*ListApplicationsResponse)(nil),         // 218: ourspace_backend.proto.ListApplicationsResponse
+	(*ApplicationPageToken)(nil),             // 219: ourspace_backend.proto.ApplicationPageToken
+	(*AcceptApplicationRequest)(nil),         // 220: ourspace_backend.proto.AcceptApplicationRequest
+	(*RejectApplicationRequest)(nil),         // 221: ourspace_backend.proto.RejectApplicationRequest
+	(*MemberNote)(nil),                       // 222: ourspace_backend.proto.MemberNote
+	(*CreateMemberNoteRequest)(nil),          // 223: ourspace_backend.proto.CreateMemberNoteRequest
+	(*ListMemberNotesRequest)(nil),           // 224: ourspace_backend.proto.ListMemberNotesRequest
+	(*ListMemberNotesResponse)(nil),          // 225: ourspace_backend.proto.ListMemberNotesResponse
+	(*MemberNotePageToken)(nil),              // 226: ourspace_backend.proto.MemberNotePageToken
+	(*UpdateMemberNoteRequest)(nil),          // 227: ourspace_backend.proto.UpdateMemberNoteRequest
+	(*DeleteMemberNoteRequest)(nil),          // 228: ourspace_backend.proto.DeleteMemberNoteRequest
+	(*MemberTimelineEntry)(nil),              // 229: ourspace_backend.proto.MemberTimelineEntry
+	(*ListMemberTimelineRequest)(nil),        // 230: ourspace_backend.proto.ListMemberTimelineRequest
+	(*ListMemberTimelineResponse)(nil),       // 231: ourspace_backend.proto.ListMemberTimelineResponse
+	(*MemberTimelinePageToken)(nil),          // 232: ourspace_backend.proto.MemberTimelinePageToken
+	(*LoginRequest)(nil),                     // 233: ourspace_backend.proto.LoginRequest
+	(*LoginPassword)(nil),                    // 234: ourspace_backend.proto.LoginPassword
+	(*LoginOpenIDConnect)(nil),               // 235: ourspace_backend.proto.LoginOpenIDConnect
+	(*LoginApiKey)(nil),                      // 236: ourspace_backend.proto.LoginApiKey
+	(*LoginResponse)(nil),                    // 237: ourspace_backend.proto.LoginResponse
+	(*LoginSuccess)(nil),                     // 238: ourspace_backend.proto.LoginSuccess
+	(*RefreshRequest)(nil),                   // 239: ourspace_backend.proto.RefreshRequest
+	(*RefreshResponse)(nil),                  // 240: ourspace_backend.proto.RefreshResponse
+	(*LogoutRequest)(nil),                    // 241: ourspace_backend.proto.LogoutRequest
+	(*LogoutResponse)(nil),                   // 242: ourspace_backend.proto.LogoutResponse
+	nil,                                      // 243: ourspace_backend.proto.Member.AdditionalAttributesEntry
+	nil,                                      // 244: ourspace_backend.proto.ListMembersRequest.AttributeEqualsEntry
+	nil,                                      // 245: ourspace_backend.proto.ListMembersRequest.AttributeContainsEntry
+	nil,                                      // 246: ourspace_backend.proto.ListMembersRequest.AttributeMinEntry
+	nil,                                      // 247: ourspace_backend.proto.ListMembersRequest.AttributeMaxEntry
+	nil,                                      // 248: ourspace_backend.proto.ImportMembersRequest.ColumnMappingEntry
+	nil,                                      // 249: ourspace_backend.proto.AcceptApplicationRequest.AdditionalAttributesEntry
+	(*timestamppb.Timestamp)(nil),            // 250: google.protobuf.Timestamp
+	(*fieldmaskpb.FieldMask)(nil),            // 251: google.protobuf.FieldMask
+	(*durationpb.Duration)(nil),              // 252: google.protobuf.Duration
+	(*httpbody.HttpBody)(nil),                // 253: google.api.HttpBody
+	(*emptypb.Empty)(nil),                    // 254: google.protobuf.Empty
 }
 var file_ourspace_backend_proto_api_proto_depIdxs = []int32{
-	8,   // 0: ourspace_backend.proto.CreateMemberRequest.member:type_name -> ourspace_backend.proto.Member
-	68,  // 1: ourspace_backend.proto.Member.membership_start:type_name -> google.protobuf.Timestamp
-	68,  // 2: ourspace_backend.proto.Member.membership_end:type_name -> google.protobuf.Timestamp
-	0,   // 3: ourspace_backend.proto.Member.age_category:type_name -> ourspace_backend.proto.AgeCategory
-	9,   // 4: ourspace_backend.proto.Member.member_login:type_name -> ourspace_backend.proto.MemberLogin
-	67,  // 5: ourspace_backend.proto.Member.additional_attributes:type_name -> ourspace_backend.proto.Member.AdditionalAttributesEntry
-	1,   // 6: ourspace_backend.proto.ListMembersRequest.sort_by:type_name -> ourspace_backend.proto.MemberField
-	3,   // 7: ourspace_backend.proto.ListMembersRequest.sort_direction:type_name -> ourspace_backend.proto.SortDirection
-	68,  // 8: ourspace_backend.proto.ListMembersRequest.membership_start_after:type_name -> google.protobuf.Timestamp
-	68,  // 9: ourspace_backend.proto.ListMembersRequest.membership_start_before:type_name -> google.protobuf.Timestamp
-	68,  // 10: ourspace_backend.proto.ListMembersRequest.membership_end_after:type_name -> google.protobuf.Timestamp
-	68,  // 11: ourspace_backend.proto.ListMembersRequest.membership_end_before:type_name -> google.protobuf.Timestamp
-	0,   // 12: ourspace_backend.proto.ListMembersRequest.age_category_equals:type_name -> ourspace_backend.proto.AgeCategory
-	8,   // 13: ourspace_backend.proto.ListMembersResponse.members:type_name -> ourspace_backend.proto.Member
-	1,   // 14: ourspace_backend.proto.MemberPageToken.field:type_name -> ourspace_backend.proto.MemberField
-	3,   // 15: ourspace_backend.proto.MemberPageToken.direction:type_name -> ourspace_backend.proto.SortDirection
-	8,   // 16: ourspace_backend.proto.UpdateMemberRequest.member:type_name -> ourspace_backend.proto.Member
-	69,  // 17: ourspace_backend.proto.UpdateMemberRequest.field_mask:type_name -> google.protobuf.FieldMask
-	25,  // 18: ourspace_backend.proto.CreateMemberAttributeRequest.attribute:type_name -> ourspace_backend.proto.MemberAttribute
-	4,   // 19: ourspace_backend.proto.ListMemberAttributesRequest.sort_by:type_name -> ourspace_backend.proto.MemberAttributeField
-	3,   // 20: ourspace_backend.proto.ListMemberAttributesRequest.sort_direction:type_name -> ourspace_backend.proto.SortDirection
-	25,  // 21: ourspace_backend.proto.ListMemberAttributesResponse.attributes:type_name -> ourspace_backend.proto.MemberAttribute
-	25,  // 22: ourspace_backend.proto.UpdateMemberAttributeRequest.attribute:type_name -> ourspace_backend.proto.MemberAttribute
-	69,  // 23: ourspace_backend.proto.UpdateMemberAttributeRequest.field_mask:type_name -> google.protobuf.FieldMask
-	6,   // 24: ourspace_backend.proto.MemberAttribute.type:type_name -> ourspace_backend.proto.MemberAttribute.Type
-	4,   // 25: ourspace_backend.proto.MemberAttributePageToken.field:type_name -> ourspace_backend.proto.MemberAttributeField
-	3,   // 26: ourspace_backend.proto.MemberAttributePageToken.direction:type_name -> ourspace_backend.proto.SortDirection
-	68,  // 27: ourspace_backend.proto.Card.valid_from:type_name -> google.protobuf.Timestamp
-	68,  // 28: ourspace_backend.proto.Card.valid_to:type_name -> google.protobuf.Timestamp
-	5,   // 29: ourspace_backend.proto.CardPageToken.field:type_name -> ourspace_backend.proto.CardField
-	3,   // 30: ourspace_backend.proto.CardPageToken.direction:type_name -> ourspace_backend.proto.SortDirection
-	27,  // 31: ourspace_backend.proto.CreateCardRequest.card:type_name -> ourspace_backend.proto.Card
-	5,   // 32: ourspace_backend.proto.ListCardsRequest.sort_by:type_name -> ourspace_backend.proto.CardField
-	3,   // 33: ourspace_backend.proto.ListCardsRequest.sort_direction:type_name -> ourspace_backend.proto.SortDirection
-	68,  // 34: ourspace_backend.proto.ListCardsRequest.valid_on:type_name -> google.protobuf.Timestamp
-	27,  // 35: ourspace_backend.proto.ListCardsResponse.cards:type_name -> ourspace_backend.proto.Card
-	27,  // 36: ourspace_backend.proto.UpdateCardRequest.card:type_name -> ourspace_backend.proto.Card
-	69,  // 37: ourspace_backend.proto.UpdateCardRequest.field_mask:type_name -> google.protobuf.FieldMask
-	70,  // 38: ourspace_backend.proto.BriefingType.expires_after:type_name -> google.protobuf.Duration
-	35,  // 39: ourspace_backend.proto.CreateBriefingTypeRequest.briefing_type:type_name -> ourspace_backend.proto.BriefingType
-	35,  // 40: ourspace_backend.proto.ListBriefingTypesResponse.briefing_types:type_name -> ourspace_backend.proto.BriefingType
-	35,  // 41: ourspace_backend.proto.UpdateBriefingTypeRequest.briefing_type:type_name -> ourspace_backend.proto.BriefingType
-	69,  // 42: ourspace_backend.proto.UpdateBriefingTypeRequest.field_mask:type_name -> google.protobuf.FieldMask
-	42,  // 43: ourspace_backend.proto.CreateBriefingRequest.briefing:type_name -> ourspace_backend.proto.Briefing
-	42,  // 44: ourspace_backend.proto.ListBriefingsResponse.briefings:type_name -> ourspace_backend.proto.Briefing
-	42,  // 45: ourspace_backend.proto.UpdateBriefingRequest.briefing:type_name -> ourspace_backend.proto.Briefing
-	69,  // 46: ourspace_backend.proto.UpdateBriefingRequest.field_mask:type_name -> google.protobuf.FieldMask
-	68,  // 47: ourspace_backend.proto.Presence.checkin_time:type_name -> google.protobuf.Timestamp
-	68,  // 48: ourspace_backend.proto.Presence.checkout_time:type_name -> google.protobuf.Timestamp
-	2,   // 49: ourspace_backend.proto.ListPresencesRequest.sort_by:type_name -> ourspace_backend.proto.PresenceField
-	3,   // 50: ourspace_backend.proto.ListPresencesRequest.sort_direction:type_name -> ourspace_backend.proto.SortDirection
-	68,  // 51: ourspace_backend.proto.ListPresencesRequest.checkin_time_after:type_name -> google.protobuf.Timestamp
-	68,  // 52: ourspace_backend.proto.ListPresencesRequest.checkin_time_before:type_name -> google.protobuf.Timestamp
-	68,  // 53: ourspace_backend.proto.ListPresencesRequest.checkout_time_after:type_name -> google.protobuf.Timestamp
-	68,  // 54: ourspace_backend.proto.ListPresencesRequest.checkout_time_before:type_name -> google.protobuf.Timestamp
-	49,  // 55: ourspace_backend.proto.ListPresencesResponse.presence:type_name -> ourspace_backend.proto.Presence
-	2,   // 56: ourspace_backend.proto.PresencePageToken.field:type_name -> ourspace_backend.proto.PresenceField
-	3,   // 57: ourspace_backend.proto.PresencePageToken.direction:type_name -> ourspace_backend.proto.SortDirection
-	49,  // 58: ourspace_backend.proto.UpdatePresenceRequest.presence:type_name -> ourspace_backend.proto.Presence
-	69,  // 59: ourspace_backend.proto.UpdatePresenceRequest.field_mask:type_name -> google.protobuf.FieldMask
-	58,  // 60: ourspace_backend.proto.LoginRequest.password:type_name -> ourspace_backend.proto.LoginPassword
-	59,  // 61: ourspace_backend.proto.LoginRequest.oidc:type_name -> ourspace_backend.proto.LoginOpenIDConnect
-	60,  // 62: ourspace_backend.proto.LoginRequest.api_key:type_name -> ourspace_backend.proto.LoginApiKey
-	62,  // 63: ourspace_backend.proto.LoginResponse.success:type_name -> ourspace_backend.proto.LoginSuccess
-	68,  // 64: ourspace_backend.proto.LoginSuccess.access_token_expiry:type_name -> google.protobuf.Timestamp
-	68,  // 65: ourspace_backend.proto.LoginSuccess.refresh_token_expiry:type_name -> google.protobuf.Timestamp
-	62,  // 66: ourspace_backend.proto.RefreshResponse.success:type_name -> ourspace_backend.proto.LoginSuccess
-	7,   // 67: ourspace_backend.proto.MemberService.CreateMember:input_type -> ourspace_backend.proto.CreateMemberRequest
-	10,  // 68: ourspace_backend.proto.MemberService.GetMember:input_type -> ourspace_backend.proto.GetMemberRequest
-	11,  // 69: ourspace_backend.proto.MemberService.ListMembers:input_type -> ourspace_backend.proto.ListMembersRequest
-	14,  // 70: ourspace_backend.proto.MemberService.UpdateMember:input_type -> ourspace_backend.proto.UpdateMemberRequest
-	15,  // 71: ourspace_backend.proto.MemberService.DeleteMember:input_type -> ourspace_backend.proto.DeleteMemberRequest
-	16,  // 72: ourspace_backend.proto.MemberService.ListMemberTags:input_type -> ourspace_backend.proto.ListMemberTagsRequest
-	19,  // 73: ourspace_backend.proto.MemberService.CreateMemberAttribute:input_type -> ourspace_backend.proto.CreateMemberAttributeRequest
-	20,  // 74: ourspace_backend.proto.MemberService.GetMemberAttribute:input_type -> ourspace_backend.proto.GetMemberAttributeRequest
-	21,  // 75: ourspace_backend.proto.MemberService.ListMemberAttributes:input_type -> ourspace_backend.proto.ListMemberAttributesRequest
-	23,  // 76: ourspace_backend.proto.MemberService.UpdateMemberAttribute:input_type -> ourspace_backend.proto.UpdateMemberAttributeRequest
-	24,  // 77: ourspace_backend.proto.MemberService.DeleteMemberAttribute:input_type -> ourspace_backend.proto.DeleteMemberAttributeRequest
-	29,  // 78: ourspace_backend.proto.CardService.CreateCard:input_type -> ourspace_backend.proto.CreateCardRequest
-	30,  // 79: ourspace_backend.proto.CardService.GetCard:input_type -> ourspace_backend.proto.GetCardRequest
-	31,  // 80: ourspace_backend.proto.CardService.ListCards:input_type -> ourspace_backend.proto.ListCardsRequest
-	33,  // 81: ourspace_backend.proto.CardService.UpdateCard:input_type -> ourspace_backend.proto.UpdateCardRequest
-	34,  // 82: ourspace_backend.proto.CardService.DeleteCard:input_type -> ourspace_backend.proto.DeleteCardRequest
-	43,  // 83: ourspace_backend.proto.BriefingService.CreateBriefing:input_type -> ourspace_backend.proto.CreateBriefingRequest
-	44,  // 84: ourspace_backend.proto.BriefingService.GetBriefing:input_type -> ourspace_backend.proto.GetBriefingRequest
-	45,  // 85: ourspace_backend.proto.BriefingService.ListBriefings:input_type -> ourspace_backend.proto.ListBriefingsRequest
-	47,  // 86: ourspace_backend.proto.BriefingService.UpdateBriefing:input_type -> ourspace_backend.proto.UpdateBriefingRequest
-	48,  // 87: ourspace_backend.proto.BriefingService.DeleteBriefing:input_type -> ourspace_backend.proto.DeleteBriefingRequest
-	36,  // 88: ourspace_backend.proto.BriefingService.CreateBriefingType:input_type -> ourspace_backend.proto.CreateBriefingTypeRequest
-	37,  // 89: ourspace_backend.proto.BriefingService.GetBriefingType:input_type -> ourspace_backend.proto.GetBriefingTypeRequest
-	38,  // 90: ourspace_backend.proto.BriefingService.ListBriefingTypes:input_type -> ourspace_backend.proto.ListBriefingTypesRequest
-	40,  // 91: ourspace_backend.proto.BriefingService.UpdateBriefingType:input_type -> ourspace_backend.proto.UpdateBriefingTypeRequest
-	41,  // 92: ourspace_backend.proto.BriefingService.DeleteBriefingType:input_type -> ourspace_backend.proto.DeleteBriefingTypeRequest
-	50,  // 93: ourspace_backend.proto.PresenceService.ListPresences:input_type -> ourspace_backend.proto.ListPresencesRequest
-	53,  // 94: ourspace_backend.proto.PresenceService.Checkin:input_type -> ourspace_backend.proto.CheckinRequest
-	54,  // 95: ourspace_backend.proto.PresenceService.Checkout:input_type -> ourspace_backend.proto.CheckoutRequest
-	55,  // 96: ourspace_backend.proto.PresenceService.UpdatePresence:input_type -> ourspace_backend.proto.UpdatePresenceRequest
-	56,  // 97: ourspace_backend.proto.PresenceService.DeletePresence:input_type -> ourspace_backend.proto.DeletePresenceRequest
-	57,  // 98: ourspace_backend.proto.AuthService.Login:input_type -> ourspace_backend.proto.LoginRequest
-	63,  // 99: ourspace_backend.proto.AuthService.Refresh:input_type -> ourspace_backend.proto.RefreshRequest
-	65,  // 100: ourspace_backend.proto.AuthService.Logout:input_type -> ourspace_backend.proto.LogoutRequest
-	8,   // 101: ourspace_backend.proto.MemberService.CreateMember:output_type -> ourspace_backend.proto.Member
-	8,   // 102: ourspace_backend.proto.MemberService.GetMember:output_type -> ourspace_backend.proto.Member
-	12,  // 103: ourspace_backend.proto.MemberService.ListMembers:output_type -> ourspace_backend.proto.ListMembersResponse
-	8,   // 104: ourspace_backend.proto.MemberService.UpdateMember:output_type -> ourspace_backend.proto.Member
-	71,  // 105: ourspace_backend.proto.MemberService.DeleteMember:output_type -> google.protobuf.Empty
-	17,  // 106: ourspace_backend.proto.MemberService.ListMemberTags:output_type -> ourspace_backend.proto.ListMemberTagsResponse
-	25,  // 107: ourspace_backend.proto.MemberService.CreateMemberAttribute:output_type -> ourspace_backend.proto.MemberAttribute
-	25,  // 108: ourspace_backend.proto.MemberService.GetMemberAttribute:output_type -> ourspace_backend.proto.MemberAttribute
-	22,  // 109: ourspace_backend.proto.MemberService.ListMemberAttributes:output_type -> ourspace_backend.proto.ListMemberAttributesResponse
-	25,  // 110: ourspace_backend.proto.MemberService.UpdateMemberAttribute:output_type -> ourspace_backend.proto.MemberAttribute
-	71,  // 111: ourspace_backend.proto.MemberService.DeleteMemberAttribute:output_type -> google.protobuf.Empty
-	27,  // 112: ourspace_backend.proto.CardService.CreateCard:output_type -> ourspace_backend.proto.Card
-	27,  // 113: ourspace_backend.proto.CardService.GetCard:output_type -> ourspace_backend.proto.Card
-	32,  // 114: ourspace_backend.proto.CardService.ListCards:output_type -> ourspace_backend.proto.ListCardsResponse
-	27,  // 115: ourspace_backend.proto.CardService.UpdateCard:output_type -> ourspace_backend.proto.Card
-	71,  // 116: ourspace_backend.proto.CardService.DeleteCard:output_type -> google.protobuf.Empty
-	42,  // 117: ourspace_backend.proto.BriefingService.CreateBriefing:output_type -> ourspace_backend.proto.Briefing
-	42,  // 118: ourspace_backend.proto.BriefingService.GetBriefing:output_type -> ourspace_backend.proto.Briefing
-	46,  // 119: ourspace_backend.proto.BriefingService.ListBriefings:output_type -> ourspace_backend.proto.ListBriefingsResponse
-	42,  // 120: ourspace_backend.proto.BriefingService.UpdateBriefing:output_type -> ourspace_backend.proto.Briefing
-	71,  // 121: ourspace_backend.proto.BriefingService.DeleteBriefing:output_type -> google.protobuf.Empty
-	35,  // 122: ourspace_backend.proto.BriefingService.CreateBriefingType:output_type -> ourspace_backend.proto.BriefingType
-	35,  // 123: ourspace_backend.proto.BriefingService.GetBriefingType:output_type -> ourspace_backend.proto.BriefingType
-	39,  // 124: ourspace_backend.proto.BriefingService.ListBriefingTypes:output_type -> ourspace_backend.proto.ListBriefingTypesResponse
-	35,  // 125: ourspace_backend.proto.BriefingService.UpdateBriefingType:output_type -> ourspace_backend.proto.BriefingType
-	71,  // 126: ourspace_backend.proto.BriefingService.DeleteBriefingType:output_type -> google.protobuf.Empty
-	51,  // 127: ourspace_backend.proto.PresenceService.ListPresences:output_type -> ourspace_backend.proto.ListPresencesResponse
-	49,  // 128: ourspace_backend.proto.PresenceService.Checkin:output_type -> ourspace_backend.proto.Presence
-	49,  // 129: ourspace_backend.proto.PresenceService.Checkout:output_type -> ourspace_backend.proto.Presence
-	49,  // 130: ourspace_backend.proto.PresenceService.UpdatePresence:output_type -> ourspace_backend.proto.Presence
-	71,  // 131: ourspace_backend.proto.PresenceService.DeletePresence:output_type -> google.protobuf.Empty
-	61,  // 132: ourspace_backend.proto.AuthService.Login:output_type -> ourspace_backend.proto.LoginResponse
-	64,  // 133: ourspace_backend.proto.AuthService.Refresh:output_type -> ourspace_backend.proto.RefreshResponse
-	66,  // 134: ourspace_backend.proto.AuthService.Logout:output_type -> ourspace_backend.proto.LogoutResponse
-	101, // [101:135] is the sub-list for method output_type
-	67,  // [67:101] is the sub-list for method input_type
-	67,  // [67:67] is the sub-list for extension type_name
-	67,  // [67:67] is the sub-list for extension extendee
-	0,   // [0:67] is the sub-list for field type_name
+	23,  // 0: ourspace_backend.proto.CreateMemberRequest.member:type_name -> ourspace_backend.proto.Member
+	57,  // 1: ourspace_backend.proto.CreateMemberRequest.relationships:type_name -> ourspace_backend.proto.MemberRelationship
+	250, // 2: ourspace_backend.proto.Member.membership_start:type_name -> google.protobuf.Timestamp
+	250, // 3: ourspace_backend.proto.Member.membership_end:type_name -> google.protobuf.Timestamp
+	0,   // 4: ourspace_backend.proto.Member.age_category:type_name -> ourspace_backend.proto.AgeCategory
+	24,  // 5: ourspace_backend.proto.Member.member_login:type_name -> ourspace_backend.proto.MemberLogin
+	243, // 6: ourspace_backend.proto.Member.additional_attributes:type_name -> ourspace_backend.proto.Member.AdditionalAttributesEntry
+	1,   // 7: ourspace_backend.proto.ListMembersRequest.sort_by:type_name -> ourspace_backend.proto.MemberField
+	2,   // 8: ourspace_backend.proto.ListMembersRequest.sort_direction:type_name -> ourspace_backend.proto.SortDirection
+	250, // 9: ourspace_backend.proto.ListMembersRequest.membership_start_after:type_name -> google.protobuf.Timestamp
+	250, // 10: ourspace_backend.proto.ListMembersRequest.membership_start_before:type_name -> google.protobuf.Timestamp
+	250, // 11: ourspace_backend.proto.ListMembersRequest.membership_end_after:type_name -> google.protobuf.Timestamp
+	250, // 12: ourspace_backend.proto.ListMembersRequest.membership_end_before:type_name -> google.protobuf.Timestamp
+	0,   // 13: ourspace_backend.proto.ListMembersRequest.age_category_equals:type_name -> ourspace_backend.proto.AgeCategory
+	244, // 14: ourspace_backend.proto.ListMembersRequest.attribute_equals:type_name -> ourspace_backend.proto.ListMembersRequest.AttributeEqualsEntry
+	245, // 15: ourspace_backend.proto.ListMembersRequest.attribute_contains:type_name -> ourspace_backend.proto.ListMembersRequest.AttributeContainsEntry
+	246, // 16: ourspace_backend.proto.ListMembersRequest.attribute_min:type_name -> ourspace_backend.proto.ListMembersRequest.AttributeMinEntry
+	247, // 17: ourspace_backend.proto.ListMembersRequest.attribute_max:type_name -> ourspace_backend.proto.ListMembersRequest.AttributeMaxEntry
+	23,  // 18: ourspace_backend.proto.ListMembersResponse.members:type_name -> ourspace_backend.proto.Member
+	26,  // 19: ourspace_backend.proto.ExportMembersRequest.filter:type_name -> ourspace_backend.proto.ListMembersRequest
+	3,   // 20: ourspace_backend.proto.ExportMembersRequest.format:type_name -> ourspace_backend.proto.ExportFormat
+	1,   // 21: ourspace_backend.proto.MemberPageToken.field:type_name -> ourspace_backend.proto.MemberField
+	2,   // 22: ourspace_backend.proto.MemberPageToken.direction:type_name -> ourspace_backend.proto.SortDirection
+	32,  // 23: ourspace_backend.proto.SearchMembersResponse.results:type_name -> ourspace_backend.proto.MemberSearchResult
+	23,  // 24: ourspace_backend.proto.MemberSearchResult.member:type_name -> ourspace_backend.proto.Member
+	23,  // 25: ourspace_backend.proto.UpdateMemberRequest.member:type_name -> ourspace_backend.proto.Member
+	251, // 26: ourspace_backend.proto.UpdateMemberRequest.field_mask:type_name -> google.protobuf.FieldMask
+	248, // 27: ourspace_backend.proto.ImportMembersRequest.column_mapping:type_name -> ourspace_backend.proto.ImportMembersRequest.ColumnMappingEntry
+	38,  // 28: ourspace_backend.proto.ImportMembersResponse.violations:type_name -> ourspace_backend.proto.MemberImportViolation
+	41,  // 29: ourspace_backend.proto.ListMemberTagsResponse.member_tags:type_name -> ourspace_backend.proto.MemberTag
+	41,  // 30: ourspace_backend.proto.CreateMemberTagRequest.tag:type_name -> ourspace_backend.proto.MemberTag
+	41,  // 31: ourspace_backend.proto.UpdateMemberTagRequest.tag:type_name -> ourspace_backend.proto.MemberTag
+	251, // 32: ourspace_backend.proto.UpdateMemberTagRequest.field_mask:type_name -> google.protobuf.FieldMask
+	73,  // 33: ourspace_backend.proto.CreateMemberAttributeRequest.attribute:type_name -> ourspace_backend.proto.MemberAttribute
+	4,   // 34: ourspace_backend.proto.ListMemberAttributesRequest.sort_by:type_name -> ourspace_backend.proto.MemberAttributeField
+	2,   // 35: ourspace_backend.proto.ListMemberAttributesRequest.sort_direction:type_name -> ourspace_backend.proto.SortDirection
+	73,  // 36: ourspace_backend.proto.ListMemberAttributesResponse.attributes:type_name -> ourspace_backend.proto.MemberAttribute
+	73,  // 37: ourspace_backend.proto.UpdateMemberAttributeRequest.attribute:type_name -> ourspace_backend.proto.MemberAttribute
+	251, // 38: ourspace_backend.proto.UpdateMemberAttributeRequest.field_mask:type_name -> google.protobuf.FieldMask
+	18,  // 39: ourspace_backend.proto.MigrateMemberAttributeRequest.type:type_name -> ourspace_backend.proto.MemberAttribute.Type
+	73,  // 40: ourspace_backend.proto.MigrateMemberAttributeResponse.attribute:type_name -> ourspace_backend.proto.MemberAttribute
+	56,  // 41: ourspace_backend.proto.MigrateMemberAttributeResponse.invalid_values:type_name -> ourspace_backend.proto.MemberAttributeMigrationIssue
+	16,  // 42: ourspace_backend.proto.MemberRelationship.type:type_name -> ourspace_backend.proto.MemberRelationship.Type
+	57,  // 43: ourspace_backend.proto.CreateMemberRelationshipRequest.relationship:type_name -> ourspace_backend.proto.MemberRelationship
+	57,  // 44: ourspace_backend.proto.ListMemberRelationshipsResponse.relationships:type_name -> ourspace_backend.proto.MemberRelationship
+	57,  // 45: ourspace_backend.proto.UpdateMemberRelationshipRequest.relationship:type_name -> ourspace_backend.proto.MemberRelationship
+	251, // 46: ourspace_backend.proto.UpdateMemberRelationshipRequest.field_mask:type_name -> google.protobuf.FieldMask
+	250, // 47: ourspace_backend.proto.MemberConsent.consent_time:type_name -> google.protobuf.Timestamp
+	250, // 48: ourspace_backend.proto.MemberConsent.revoke_time:type_name -> google.protobuf.Timestamp
+	63,  // 49: ourspace_backend.proto.CreateMemberConsentRequest.consent:type_name -> ourspace_backend.proto.MemberConsent
+	63,  // 50: ourspace_backend.proto.ListMemberConsentsResponse.consents:type_name -> ourspace_backend.proto.MemberConsent
+	23,  // 51: ourspace_backend.proto.MemberChangeRequest.member:type_name -> ourspace_backend.proto.Member
+	251, // 52: ourspace_backend.proto.MemberChangeRequest.field_mask:type_name -> google.protobuf.FieldMask
+	17,  // 53: ourspace_backend.proto.MemberChangeRequest.state:type_name -> ourspace_backend.proto.MemberChangeRequest.State
+	250, // 54: ourspace_backend.proto.MemberChangeRequest.create_time:type_name -> google.protobuf.Timestamp
+	250, // 55: ourspace_backend.proto.MemberChangeRequest.decide_time:type_name -> google.protobuf.Timestamp
+	17,  // 56: ourspace_backend.proto.ListMemberChangeRequestsRequest.state:type_name -> ourspace_backend.proto.MemberChangeRequest.State
+	68,  // 57: ourspace_backend.proto.ListMemberChangeRequestsResponse.change_requests:type_name -> ourspace_backend.proto.MemberChangeRequest
+	18,  // 58: ourspace_backend.proto.MemberAttribute.type:type_name -> ourspace_backend.proto.MemberAttribute.Type
+	19,  // 59: ourspace_backend.proto.MemberAttribute.sensitivity:type_name -> ourspace_backend.proto.MemberAttribute.Sensitivity
+	4,   // 60: ourspace_backend.proto.MemberAttributePageToken.field:type_name -> ourspace_backend.proto.MemberAttributeField
+	2,   // 61: ourspace_backend.proto.MemberAttributePageToken.direction:type_name -> ourspace_backend.proto.SortDirection
+	250, // 62: ourspace_backend.proto.Card.valid_from:type_name -> google.protobuf.Timestamp
+	250, // 63: ourspace_backend.proto.Card.valid_to:type_name -> google.protobuf.Timestamp
+	5,   // 64: ourspace_backend.proto.CardPageToken.field:type_name -> ourspace_backend.proto.CardField
+	2,   // 65: ourspace_backend.proto.CardPageToken.direction:type_name -> ourspace_backend.proto.SortDirection
+	75,  // 66: ourspace_backend.proto.CreateCardRequest.card:type_name -> ourspace_backend.proto.Card
+	5,   // 67: ourspace_backend.proto.ListCardsRequest.sort_by:type_name -> ourspace_backend.proto.CardField
+	2,   // 68: ourspace_backend.proto.ListCardsRequest.sort_direction:type_name -> ourspace_backend.proto.SortDirection
+	250, // 69: ourspace_backend.proto.ListCardsRequest.valid_on:type_name -> google.protobuf.Timestamp
+	75,  // 70: ourspace_backend.proto.ListCardsResponse.cards:type_name -> ourspace_backend.proto.Card
+	79,  // 71: ourspace_backend.proto.ExportCardsRequest.filter:type_name -> ourspace_backend.proto.ListCardsRequest
+	3,   // 72: ourspace_backend.proto.ExportCardsRequest.format:type_name -> ourspace_backend.proto.ExportFormat
+	75,  // 73: ourspace_backend.proto.UpdateCardRequest.card:type_name -> ourspace_backend.proto.Card
+	251, // 74: ourspace_backend.proto.UpdateCardRequest.field_mask:type_name -> google.protobuf.FieldMask
+	252, // 75: ourspace_backend.proto.BriefingType.expires_after:type_name -> google.protobuf.Duration
+	84,  // 76: ourspace_backend.proto.CreateBriefingTypeRequest.briefing_type:type_name -> ourspace_backend.proto.BriefingType
+	84,  // 77: ourspace_backend.proto.ListBriefingTypesResponse.briefing_types:type_name -> ourspace_backend.proto.BriefingType
+	84,  // 78: ourspace_backend.proto.UpdateBriefingTypeRequest.briefing_type:type_name -> ourspace_backend.proto.BriefingType
+	251, // 79: ourspace_backend.proto.UpdateBriefingTypeRequest.field_mask:type_name -> google.protobuf.FieldMask
+	250, // 80: ourspace_backend.proto.Briefing.briefing_time:type_name -> google.protobuf.Timestamp
+	250, // 81: ourspace_backend.proto.Briefing.expiry_time:type_name -> google.protobuf.Timestamp
+	92,  // 82: ourspace_backend.proto.CreateBriefingRequest.briefing:type_name -> ourspace_backend.proto.Briefing
+	92,  // 83: ourspace_backend.proto.ListBriefingsResponse.briefings:type_name -> ourspace_backend.proto.Briefing
+	92,  // 84: ourspace_backend.proto.UpdateBriefingRequest.briefing:type_name -> ourspace_backend.proto.Briefing
+	251, // 85: ourspace_backend.proto.UpdateBriefingRequest.field_mask:type_name -> google.protobuf.FieldMask
+	250, // 86: ourspace_backend.proto.Presence.checkin_time:type_name -> google.protobuf.Timestamp
+	250, // 87: ourspace_backend.proto.Presence.checkout_time:type_name -> google.protobuf.Timestamp
+	6,   // 88: ourspace_backend.proto.ListPresencesRequest.sort_by:type_name -> ourspace_backend.proto.PresenceField
+	2,   // 89: ourspace_backend.proto.ListPresencesRequest.sort_direction:type_name -> ourspace_backend.proto.SortDirection
+	250, // 90: ourspace_backend.proto.ListPresencesRequest.checkin_time_after:type_name -> google.protobuf.Timestamp
+	250, // 91: ourspace_backend.proto.ListPresencesRequest.checkin_time_before:type_name -> google.protobuf.Timestamp
+	250, // 92: ourspace_backend.proto.ListPresencesRequest.checkout_time_after:type_name -> google.protobuf.Timestamp
+	250, // 93: ourspace_backend.proto.ListPresencesRequest.checkout_time_before:type_name -> google.protobuf.Timestamp
+	99,  // 94: ourspace_backend.proto.ListPresencesResponse.presence:type_name -> ourspace_backend.proto.Presence
+	100, // 95: ourspace_backend.proto.ExportPresencesRequest.filter:type_name -> ourspace_backend.proto.ListPresencesRequest
+	3,   // 96: ourspace_backend.proto.ExportPresencesRequest.format:type_name -> ourspace_backend.proto.ExportFormat
+	6,   // 97: ourspace_backend.proto.PresencePageToken.field:type_name -> ourspace_backend.proto.PresenceField
+	2,   // 98: ourspace_backend.proto.PresencePageToken.direction:type_name -> ourspace_backend.proto.SortDirection
+	99,  // 99: ourspace_backend.proto.TogglePresenceResponse.presence:type_name -> ourspace_backend.proto.Presence
+	7,   // 100: ourspace_backend.proto.TogglePresenceResponse.action:type_name -> ourspace_backend.proto.PresenceAction
+	99,  // 101: ourspace_backend.proto.UpdatePresenceRequest.presence:type_name -> ourspace_backend.proto.Presence
+	251, // 102: ourspace_backend.proto.UpdatePresenceRequest.field_mask:type_name -> google.protobuf.FieldMask
+	250, // 103: ourspace_backend.proto.Event.start_time:type_name -> google.protobuf.Timestamp
+	250, // 104: ourspace_backend.proto.Event.end_time:type_name -> google.protobuf.Timestamp
+	0,   // 105: ourspace_backend.proto.Event.allowed_age_categories:type_name -> ourspace_backend.proto.AgeCategory
+	8,   // 106: ourspace_backend.proto.EventPageToken.field:type_name -> ourspace_backend.proto.EventField
+	2,   // 107: ourspace_backend.proto.EventPageToken.direction:type_name -> ourspace_backend.proto.SortDirection
+	111, // 108: ourspace_backend.proto.CreateEventRequest.event:type_name -> ourspace_backend.proto.Event
+	8,   // 109: ourspace_backend.proto.ListEventsRequest.sort_by:type_name -> ourspace_backend.proto.EventField
+	2,   // 110: ourspace_backend.proto.ListEventsRequest.sort_direction:type_name -> ourspace_backend.proto.SortDirection
+	250, // 111: ourspace_backend.proto.ListEventsRequest.start_time_after:type_name -> google.protobuf.Timestamp
+	250, // 112: ourspace_backend.proto.ListEventsRequest.start_time_before:type_name -> google.protobuf.Timestamp
+	111, // 113: ourspace_backend.proto.ListEventsResponse.events:type_name -> ourspace_backend.proto.Event
+	111, // 114: ourspace_backend.proto.UpdateEventRequest.event:type_name -> ourspace_backend.proto.Event
+	251, // 115: ourspace_backend.proto.UpdateEventRequest.field_mask:type_name -> google.protobuf.FieldMask
+	9,   // 116: ourspace_backend.proto.EventRegistration.status:type_name -> ourspace_backend.proto.EventRegistrationStatus
+	250, // 117: ourspace_backend.proto.EventRegistration.registration_time:type_name -> google.protobuf.Timestamp
+	250, // 118: ourspace_backend.proto.EventRegistration.cancellation_time:type_name -> google.protobuf.Timestamp
+	250, // 119: ourspace_backend.proto.EventRegistrationPageToken.last_registration_time:type_name -> google.protobuf.Timestamp
+	9,   // 120: ourspace_backend.proto.ListEventRegistrationsRequest.status:type_name -> ourspace_backend.proto.EventRegistrationStatus
+	119, // 121: ourspace_backend.proto.ListEventRegistrationsResponse.registrations:type_name -> ourspace_backend.proto.EventRegistration
+	10,  // 122: ourspace_backend.proto.ItemPageToken.field:type_name -> ourspace_backend.proto.ItemField
+	2,   // 123: ourspace_backend.proto.ItemPageToken.direction:type_name -> ourspace_backend.proto.SortDirection
+	126, // 124: ourspace_backend.proto.CreateItemRequest.item:type_name -> ourspace_backend.proto.Item
+	10,  // 125: ourspace_backend.proto.ListItemsRequest.sort_by:type_name -> ourspace_backend.proto.ItemField
+	2,   // 126: ourspace_backend.proto.ListItemsRequest.sort_direction:type_name -> ourspace_backend.proto.SortDirection
+	126, // 127: ourspace_backend.proto.ListItemsResponse.items:type_name -> ourspace_backend.proto.Item
+	126, // 128: ourspace_backend.proto.UpdateItemRequest.item:type_name -> ourspace_backend.proto.Item
+	251, // 129: ourspace_backend.proto.UpdateItemRequest.field_mask:type_name -> google.protobuf.FieldMask
+	250, // 130: ourspace_backend.proto.Loan.lend_time:type_name -> google.protobuf.Timestamp
+	250, // 131: ourspace_backend.proto.Loan.expected_return_time:type_name -> google.protobuf.Timestamp
+	250, // 132: ourspace_backend.proto.Loan.return_time:type_name -> google.protobuf.Timestamp
+	250, // 133: ourspace_backend.proto.LoanPageToken.last_lend_time:type_name -> google.protobuf.Timestamp
+	250, // 134: ourspace_backend.proto.LendItemRequest.expected_return_time:type_name -> google.protobuf.Timestamp
+	250, // 135: ourspace_backend.proto.LendItemByScanRequest.expected_return_time:type_name -> google.protobuf.Timestamp
+	134, // 136: ourspace_backend.proto.ListLoansResponse.loans:type_name -> ourspace_backend.proto.Loan
+	252, // 137: ourspace_backend.proto.Machine.session_timeout:type_name -> google.protobuf.Duration
+	143, // 138: ourspace_backend.proto.CreateMachineRequest.machine:type_name -> ourspace_backend.proto.Machine
+	143, // 139: ourspace_backend.proto.ListMachinesResponse.machines:type_name -> ourspace_backend.proto.Machine
+	143, // 140: ourspace_backend.proto.UpdateMachineRequest.machine:type_name -> ourspace_backend.proto.Machine
+	251, // 141: ourspace_backend.proto.UpdateMachineRequest.field_mask:type_name -> google.protobuf.FieldMask
+	250, // 142: ourspace_backend.proto.UsageSession.start_time:type_name -> google.protobuf.Timestamp
+	250, // 143: ourspace_backend.proto.UsageSession.end_time:type_name -> google.protobuf.Timestamp
+	250, // 144: ourspace_backend.proto.UsageSessionPageToken.last_start_time:type_name -> google.protobuf.Timestamp
+	151, // 145: ourspace_backend.proto.ListUsageSessionsResponse.sessions:type_name -> ourspace_backend.proto.UsageSession
+	250, // 146: ourspace_backend.proto.AccessDecision.decision_time:type_name -> google.protobuf.Timestamp
+	11,  // 147: ourspace_backend.proto.AccessDecision.reason:type_name -> ourspace_backend.proto.AccessReason
+	250, // 148: ourspace_backend.proto.AccessDecisionPageToken.last_decision_time:type_name -> google.protobuf.Timestamp
+	158, // 149: ourspace_backend.proto.ListAccessDecisionsResponse.decisions:type_name -> ourspace_backend.proto.AccessDecision
+	12,  // 150: ourspace_backend.proto.MembershipPlan.billing_interval:type_name -> ourspace_backend.proto.BillingInterval
+	162, // 151: ourspace_backend.proto.CreateMembershipPlanRequest.membership_plan:type_name -> ourspace_backend.proto.MembershipPlan
+	162, // 152: ourspace_backend.proto.ListMembershipPlansResponse.membership_plans:type_name -> ourspace_backend.proto.MembershipPlan
+	162, // 153: ourspace_backend.proto.UpdateMembershipPlanRequest.membership_plan:type_name -> ourspace_backend.proto.MembershipPlan
+	251, // 154: ourspace_backend.proto.UpdateMembershipPlanRequest.field_mask:type_name -> google.protobuf.FieldMask
+	250, // 155: ourspace_backend.proto.PlanAssignment.start_time:type_name -> google.protobuf.Timestamp
+	250, // 156: ourspace_backend.proto.PlanAssignment.end_time:type_name -> google.protobuf.Timestamp
+	250, // 157: ourspace_backend.proto.PlanAssignment.billed_until:type_name -> google.protobuf.Timestamp
+	250, // 158: ourspace_backend.proto.PlanAssignmentPageToken.last_start_time:type_name -> google.protobuf.Timestamp
+	170, // 159: ourspace_backend.proto.AssignMembershipPlanRequest.plan_assignment:type_name -> ourspace_backend.proto.PlanAssignment
+	170, // 160: ourspace_backend.proto.ListPlanAssignmentsResponse.plan_assignments:type_name -> ourspace_backend.proto.PlanAssignment
+	250, // 161: ourspace_backend.proto.EndPlanAssignmentRequest.end_time:type_name -> google.protobuf.Timestamp
+	250, // 162: ourspace_backend.proto.Invoice.period_start:type_name -> google.protobuf.Timestamp
+	250, // 163: ourspace_backend.proto.Invoice.period_end:type_name -> google.protobuf.Timestamp
+	250, // 164: ourspace_backend.proto.Invoice.issue_time:type_name -> google.protobuf.Timestamp
+	13,  // 165: ourspace_backend.proto.Invoice.status:type_name -> ourspace_backend.proto.InvoiceStatus
+	250, // 166: ourspace_backend.proto.InvoicePageToken.last_period_start:type_name -> google.protobuf.Timestamp
+	250, // 167: ourspace_backend.proto.GenerateInvoicesRequest.until:type_name -> google.protobuf.Timestamp
+	176, // 168: ourspace_backend.proto.GenerateInvoicesResponse.invoices:type_name -> ourspace_backend.proto.Invoice
+	13,  // 169: ourspace_backend.proto.ListInvoicesRequest.status:type_name -> ourspace_backend.proto.InvoiceStatus
+	176, // 170: ourspace_backend.proto.ListInvoicesResponse.invoices:type_name -> ourspace_backend.proto.Invoice
+	250, // 171: ourspace_backend.proto.Payment.payment_time:type_name -> google.protobuf.Timestamp
+	250, // 172: ourspace_backend.proto.PaymentPageToken.last_payment_time:type_name -> google.protobuf.Timestamp
+	183, // 173: ourspace_backend.proto.RecordPaymentRequest.payment:type_name -> ourspace_backend.proto.Payment
+	183, // 174: ourspace_backend.proto.ListPaymentsResponse.payments:type_name -> ourspace_backend.proto.Payment
+	250, // 175: ourspace_backend.proto.SepaMandate.mandate_date:type_name -> google.protobuf.Timestamp
+	192, // 176: ourspace_backend.proto.SetSepaMandateRequest.sepa_mandate:type_name -> ourspace_backend.proto.SepaMandate
+	250, // 177: ourspace_backend.proto.ExportSepaDirectDebitRequest.period_start:type_name -> google.protobuf.Timestamp
+	250, // 178: ourspace_backend.proto.ExportSepaDirectDebitRequest.period_end:type_name -> google.protobuf.Timestamp
+	250, // 179: ourspace_backend.proto.ExportSepaDirectDebitRequest.collection_date:type_name -> google.protobuf.Timestamp
+	197, // 180: ourspace_backend.proto.SepaDirectDebitExport.issues:type_name -> ourspace_backend.proto.SepaExportIssue
+	189, // 181: ourspace_backend.proto.ListBalancesResponse.balances:type_name -> ourspace_backend.proto.Balance
+	23,  // 182: ourspace_backend.proto.UpdateMyProfileRequest.member:type_name -> ourspace_backend.proto.Member
+	251, // 183: ourspace_backend.proto.UpdateMyProfileRequest.field_mask:type_name -> google.protobuf.FieldMask
+	23,  // 184: ourspace_backend.proto.CreateMyChangeRequestRequest.member:type_name -> ourspace_backend.proto.Member
+	251, // 185: ourspace_backend.proto.CreateMyChangeRequestRequest.field_mask:type_name -> google.protobuf.FieldMask
+	250, // 186: ourspace_backend.proto.GetPresenceReportRequest.start_time:type_name -> google.protobuf.Timestamp
+	250, // 187: ourspace_backend.proto.GetPresenceReportRequest.end_time:type_name -> google.protobuf.Timestamp
+	14,  // 188: ourspace_backend.proto.GetPresenceReportRequest.bucket:type_name -> ourspace_backend.proto.ReportBucket
+	250, // 189: ourspace_backend.proto.PresenceReport.start_time:type_name -> google.protobuf.Timestamp
+	250, // 190: ourspace_backend.proto.PresenceReport.end_time:type_name -> google.protobuf.Timestamp
+	14,  // 191: ourspace_backend.proto.PresenceReport.bucket:type_name -> ourspace_backend.proto.ReportBucket
+	209, // 192: ourspace_backend.proto.PresenceReport.buckets:type_name -> ourspace_backend.proto.PresenceStatistics
+	209, // 193: ourspace_backend.proto.PresenceReport.total:type_name -> ourspace_backend.proto.PresenceStatistics
+	250, // 194: ourspace_backend.proto.PresenceStatistics.start_time:type_name -> google.protobuf.Timestamp
+	250, // 195: ourspace_backend.proto.PresenceStatistics.end_time:type_name -> google.protobuf.Timestamp
+	210, // 196: ourspace_backend.proto.PresenceStatistics.age_categories:type_name -> ourspace_backend.proto.AgeCategoryStatistics
+	211, // 197: ourspace_backend.proto.PresenceStatistics.tags:type_name -> ourspace_backend.proto.TagStatistics
+	0,   // 198: ourspace_backend.proto.AgeCategoryStatistics.age_category:type_name -> ourspace_backend.proto.AgeCategory
+	250, // 199: ourspace_backend.proto.GetMachineUsageReportRequest.start_time:type_name -> google.protobuf.Timestamp
+	250, // 200: ourspace_backend.proto.GetMachineUsageReportRequest.end_time:type_name -> google.protobuf.Timestamp
+	15,  // 201: ourspace_backend.proto.GetMachineUsageReportRequest.group_by:type_name -> ourspace_backend.proto.UsageReportGrouping
+	250, // 202: ourspace_backend.proto.MachineUsageReport.start_time:type_name -> google.protobuf.Timestamp
+	250, // 203: ourspace_backend.proto.MachineUsageReport.end_time:type_name -> google.protobuf.Timestamp
+	15,  // 204: ourspace_backend.proto.MachineUsageReport.group_by:type_name -> ourspace_backend.proto.UsageReportGrouping
+	214, // 205: ourspace_backend.proto.MachineUsageReport.entries:type_name -> ourspace_backend.proto.UsageStatistics
+	214, // 206: ourspace_backend.proto.MachineUsageReport.total:type_name -> ourspace_backend.proto.UsageStatistics
+	20,  // 207: ourspace_backend.proto.Application.state:type_name -> ourspace_backend.proto.Application.State
+	250, // 208: ourspace_backend.proto.Application.create_time:type_name -> google.protobuf.Timestamp
+	250, // 209: ourspace_backend.proto.Application.decide_time:type_name -> google.protobuf.Timestamp
+	20,  // 210: ourspace_backend.proto.ListApplicationsRequest.state:type_name -> ourspace_backend.proto.Application.State
+	215, // 211: ourspace_backend.proto.ListApplicationsResponse.applications:type_name -> ourspace_backend.proto.Application
+	250, // 212: ourspace_backend.proto.AcceptApplicationRequest.membership_start:type_name -> google.protobuf.Timestamp
+	0,   // 213: ourspace_backend.proto.AcceptApplicationRequest.age_category:type_name -> ourspace_backend.proto.AgeCategory
+	249, // 214: ourspace_backend.proto.AcceptApplicationRequest.additional_attributes:type_name -> ourspace_backend.proto.AcceptApplicationRequest.AdditionalAttributesEntry
+	57,  // 215: ourspace_backend.proto.AcceptApplicationRequest.relationships:type_name -> ourspace_backend.proto.MemberRelationship
+	250, // 216: ourspace_backend.proto.MemberNote.create_time:type_name -> google.protobuf.Timestamp
+	250, // 217: ourspace_backend.proto.MemberNote.update_time:type_name -> google.protobuf.Timestamp
+	21,  // 218: ourspace_backend.proto.MemberNote.visibility:type_name -> ourspace_backend.proto.MemberNote.Visibility
+	222, // 219: ourspace_backend.proto.CreateMemberNoteRequest.note:type_name -> ourspace_backend.proto.MemberNote
+	222, // 220: ourspace_backend.proto.ListMemberNotesResponse.notes:type_name -> ourspace_backend.proto.MemberNote
+	222, // 221: ourspace_backend.proto.UpdateMemberNoteRequest.note:type_name -> ourspace_backend.proto.MemberNote
+	251, // 222: ourspace_backend.proto.UpdateMemberNoteRequest.field_mask:type_name -> google.protobuf.FieldMask
+	250, // 223: ourspace_backend.proto.MemberTimelineEntry.time:type_name -> google.protobuf.Timestamp
+	222, // 224: ourspace_backend.proto.MemberTimelineEntry.note:type_name -> ourspace_backend.proto.MemberNote
+	75,  // 225: ourspace_backend.proto.MemberTimelineEntry.card:type_name -> ourspace_backend.proto.Card
+	92,  // 226: ourspace_backend.proto.MemberTimelineEntry.briefing:type_name -> ourspace_backend.proto.Briefing
+	229, // 227: ourspace_backend.proto.ListMemberTimelineResponse.entries:type_name -> ourspace_backend.proto.MemberTimelineEntry
+	234, // 228: ourspace_backend.proto.LoginRequest.password:type_name -> ourspace_backend.proto.LoginPassword
+	235, // 229: ourspace_backend.proto.LoginRequest.oidc:type_name -> ourspace_backend.proto.LoginOpenIDConnect
+	236, // 230: ourspace_backend.proto.LoginRequest.api_key:type_name -> ourspace_backend.proto.LoginApiKey
+	238, // 231: ourspace_backend.proto.LoginResponse.success:type_name -> ourspace_backend.proto.LoginSuccess
+	250, // 232: ourspace_backend.proto.LoginSuccess.access_token_expiry:type_name -> google.protobuf.Timestamp
+	250, // 233: ourspace_backend.proto.LoginSuccess.refresh_token_expiry:type_name -> google.protobuf.Timestamp
+	238, // 234: ourspace_backend.proto.RefreshResponse.success:type_name -> ourspace_backend.proto.LoginSuccess
+	22,  // 235: ourspace_backend.proto.MemberService.CreateMember:input_type -> ourspace_backend.proto.CreateMemberRequest
+	25,  // 236: ourspace_backend.proto.MemberService.GetMember:input_type -> ourspace_backend.proto.GetMemberRequest
+	26,  // 237: ourspace_backend.proto.MemberService.ListMembers:input_type -> ourspace_backend.proto.ListMembersRequest
+	30,  // 238: ourspace_backend.proto.MemberService.SearchMembers:input_type -> ourspace_backend.proto.SearchMembersRequest
+	28,  // 239: ourspace_backend.proto.MemberService.ExportMembers:input_type -> ourspace_backend.proto.ExportMembersRequest
+	34,  // 240: ourspace_backend.proto.MemberService.UpdateMember:input_type -> ourspace_backend.proto.UpdateMemberRequest
+	35,  // 241: ourspace_backend.proto.MemberService.DeleteMember:input_type -> ourspace_backend.proto.DeleteMemberRequest
+	39,  // 242: ourspace_backend.proto.MemberService.ListMemberTags:input_type -> ourspace_backend.proto.ListMemberTagsRequest
+	42,  // 243: ourspace_backend.proto.MemberService.CreateMemberTag:input_type -> ourspace_backend.proto.CreateMemberTagRequest
+	43,  // 244: ourspace_backend.proto.MemberService.UpdateMemberTag:input_type -> ourspace_backend.proto.UpdateMemberTagRequest
+	44,  // 245: ourspace_backend.proto.MemberService.DeleteMemberTag:input_type -> ourspace_backend.proto.DeleteMemberTagRequest
+	45,  // 246: ourspace_backend.proto.MemberService.RenameMemberTag:input_type -> ourspace_backend.proto.RenameMemberTagRequest
+	46,  // 247: ourspace_backend.proto.MemberService.MergeMemberTags:input_type -> ourspace_backend.proto.MergeMemberTagsRequest
+	36,  // 248: ourspace_backend.proto.MemberService.ImportMembers:input_type -> ourspace_backend.proto.ImportMembersRequest
+	48,  // 249: ourspace_backend.proto.MemberService.CreateMemberAttribute:input_type -> ourspace_backend.proto.CreateMemberAttributeRequest
+	49,  // 250: ourspace_backend.proto.MemberService.GetMemberAttribute:input_type -> ourspace_backend.proto.GetMemberAttributeRequest
+	50,  // 251: ourspace_backend.proto.MemberService.ListMemberAttributes:input_type -> ourspace_backend.proto.ListMemberAttributesRequest
+	52,  // 252: ourspace_backend.proto.MemberService.UpdateMemberAttribute:input_type -> ourspace_backend.proto.UpdateMemberAttributeRequest
+	53,  // 253: ourspace_backend.proto.MemberService.DeleteMemberAttribute:input_type -> ourspace_backend.proto.DeleteMemberAttributeRequest
+	54,  // 254: ourspace_backend.proto.MemberService.MigrateMemberAttribute:input_type -> ourspace_backend.proto.MigrateMemberAttributeRequest
+	58,  // 255: ourspace_backend.proto.MemberService.CreateMemberRelationship:input_type -> ourspace_backend.proto.CreateMemberRelationshipRequest
+	59,  // 256: ourspace_backend.proto.MemberService.ListMemberRelationships:input_type -> ourspace_backend.proto.ListMemberRelationshipsRequest
+	61,  // 257: ourspace_backend.proto.MemberService.UpdateMemberRelationship:input_type -> ourspace_backend.proto.UpdateMemberRelationshipRequest
+	62,  // 258: ourspace_backend.proto.MemberService.DeleteMemberRelationship:input_type -> ourspace_backend.proto.DeleteMemberRelationshipRequest
+	64,  // 259: ourspace_backend.proto.MemberService.CreateMemberConsent:input_type -> ourspace_backend.proto.CreateMemberConsentRequest
+	65,  // 260: ourspace_backend.proto.MemberService.ListMemberConsents:input_type -> ourspace_backend.proto.ListMemberConsentsRequest
+	67,  // 261: ourspace_backend.proto.MemberService.RevokeMemberConsent:input_type -> ourspace_backend.proto.RevokeMemberConsentRequest
+	69,  // 262: ourspace_backend.proto.MemberService.ListMemberChangeRequests:input_type -> ourspace_backend.proto.ListMemberChangeRequestsRequest
+	72,  // 263: ourspace_backend.proto.MemberService.ApproveMemberChangeRequest:input_type -> ourspace_backend.proto.DecideMemberChangeRequestRequest
+	72,  // 264: ourspace_backend.proto.MemberService.RejectMemberChangeRequest:input_type -> ourspace_backend.proto.DecideMemberChangeRequestRequest
+	77,  // 265: ourspace_backend.proto.CardService.CreateCard:input_type -> ourspace_backend.proto.CreateCardRequest
+	78,  // 266: ourspace_backend.proto.CardService.GetCard:input_type -> ourspace_backend.proto.GetCardRequest
+	79,  // 267: ourspace_backend.proto.CardService.ListCards:input_type -> ourspace_backend.proto.ListCardsRequest
+	81,  // 268: ourspace_backend.proto.CardService.ExportCards:input_type -> ourspace_backend.proto.ExportCardsRequest
+	82,  // 269: ourspace_backend.proto.CardService.UpdateCard:input_type -> ourspace_backend.proto.UpdateCardRequest
+	83,  // 270: ourspace_backend.proto.CardService.DeleteCard:input_type -> ourspace_backend.proto.DeleteCardRequest
+	93,  // 271: ourspace_backend.proto.BriefingService.CreateBriefing:input_type -> ourspace_backend.proto.CreateBriefingRequest
+	94,  // 272: ourspace_backend.proto.BriefingService.GetBriefing:input_type -> ourspace_backend.proto.GetBriefingRequest
+	95,  // 273: ourspace_backend.proto.BriefingService.ListBriefings:input_type -> ourspace_backend.proto.ListBriefingsRequest
+	97,  // 274: ourspace_backend.proto.BriefingService.UpdateBriefing:input_type -> ourspace_backend.proto.UpdateBriefingRequest
+	98,  // 275: ourspace_backend.proto.BriefingService.DeleteBriefing:input_type -> ourspace_backend.proto.DeleteBriefingRequest
+	86,  // 276: ourspace_backend.proto.BriefingService.CreateBriefingType:input_type -> ourspace_backend.proto.CreateBriefingTypeRequest
+	87,  // 277: ourspace_backend.proto.BriefingService.GetBriefingType:input_type -> ourspace_backend.proto.GetBriefingTypeRequest
+	88,  // 278: ourspace_backend.proto.BriefingService.ListBriefingTypes:input_type -> ourspace_backend.proto.ListBriefingTypesRequest
+	90,  // 279: ourspace_backend.proto.BriefingService.UpdateBriefingType:input_type -> ourspace_backend.proto.UpdateBriefingTypeRequest
+	91,  // 280: ourspace_backend.proto.BriefingService.DeleteBriefingType:input_type -> ourspace_backend.proto.DeleteBriefingTypeRequest
+	100, // 281: ourspace_backend.proto.PresenceService.ListPresences:input_type -> ourspace_backend.proto.ListPresencesRequest
+	102, // 282: ourspace_backend.proto.PresenceService.ExportPresences:input_type -> ourspace_backend.proto.ExportPresencesRequest
+	104, // 283: ourspace_backend.proto.PresenceService.Checkin:input_type -> ourspace_backend.proto.CheckinRequest
+	105, // 284: ourspace_backend.proto.PresenceService.Checkout:input_type -> ourspace_backend.proto.CheckoutRequest
+	106, // 285: ourspace_backend.proto.PresenceService.TogglePresence:input_type -> ourspace_backend.proto.TogglePresenceRequest
+	108, // 286: ourspace_backend.proto.PresenceService.CheckinByCard:input_type -> ourspace_backend.proto.CheckinByCardRequest
+	109, // 287: ourspace_backend.proto.PresenceService.UpdatePresence:input_type -> ourspace_backend.proto.UpdatePresenceRequest
+	110, // 288: ourspace_backend.proto.PresenceService.DeletePresence:input_type -> ourspace_backend.proto.DeletePresenceRequest
+	113, // 289: ourspace_backend.proto.EventService.CreateEvent:input_type -> ourspace_backend.proto.CreateEventRequest
+	114, // 290: ourspace_backend.proto.EventService.GetEvent:input_type -> ourspace_backend.proto.GetEventRequest
+	115, // 291: ourspace_backend.proto.EventService.ListEvents:input_type -> ourspace_backend.proto.ListEventsRequest
+	117, // 292: ourspace_backend.proto.EventService.UpdateEvent:input_type -> ourspace_backend.proto.UpdateEventRequest
+	118, // 293: ourspace_backend.proto.EventService.DeleteEvent:input_type -> ourspace_backend.proto.DeleteEventRequest
+	121, // 294: ourspace_backend.proto.EventService.RegisterForEvent:input_type -> ourspace_backend.proto.RegisterForEventRequest
+	122, // 295: ourspace_backend.proto.EventService.CancelEventRegistration:input_type -> ourspace_backend.proto.CancelEventRegistrationRequest
+	123, // 296: ourspace_backend.proto.EventService.ListEventRegistrations:input_type -> ourspace_backend.proto.ListEventRegistrationsRequest
+	125, // 297: ourspace_backend.proto.EventService.MarkEventAttendance:input_type -> ourspace_backend.proto.MarkEventAttendanceRequest
+	128, // 298: ourspace_backend.proto.LendingService.CreateItem:input_type -> ourspace_backend.proto.CreateItemRequest
+	129, // 299: ourspace_backend.proto.LendingService.GetItem:input_type -> ourspace_backend.proto.GetItemRequest
+	130, // 300: ourspace_backend.proto.LendingService.ListItems:input_type -> ourspace_backend.proto.ListItemsRequest
+	132, // 301: ourspace_backend.proto.LendingService.UpdateItem:input_type -> ourspace_backend.proto.UpdateItemRequest
+	133, // 302: ourspace_backend.proto.LendingService.DeleteItem:input_type -> ourspace_backend.proto.DeleteItemRequest
+	136, // 303: ourspace_backend.proto.LendingService.LendItem:input_type -> ourspace_backend.proto.LendItemRequest
+	137, // 304: ourspace_backend.proto.LendingService.LendItemByScan:input_type -> ourspace_backend.proto.LendItemByScanRequest
+	138, // 305: ourspace_backend.proto.LendingService.ReturnItem:input_type -> ourspace_backend.proto.ReturnItemRequest
+	139, // 306: ourspace_backend.proto.LendingService.ReturnItemByScan:input_type -> ourspace_backend.proto.ReturnItemByScanRequest
+	140, // 307: ourspace_backend.proto.LendingService.GetLoan:input_type -> ourspace_backend.proto.GetLoanRequest
+	141, // 308: ourspace_backend.proto.LendingService.ListLoans:input_type -> ourspace_backend.proto.ListLoansRequest
+	145, // 309: ourspace_backend.proto.MachineService.CreateMachine:input_type -> ourspace_backend.proto.CreateMachineRequest
+	146, // 310: ourspace_backend.proto.MachineService.GetMachine:input_type -> ourspace_backend.proto.GetMachineRequest
+	147, // 311: ourspace_backend.proto.MachineService.ListMachines:input_type -> ourspace_backend.proto.ListMachinesRequest
+	149, // 312: ourspace_backend.proto.MachineService.UpdateMachine:input_type -> ourspace_backend.proto.UpdateMachineRequest
+	150, // 313: ourspace_backend.proto.MachineService.DeleteMachine:input_type -> ourspace_backend.proto.DeleteMachineRequest
+	152, // 314: ourspace_backend.proto.MachineService.StartUsageSession:input_type -> ourspace_backend.proto.StartUsageSessionRequest
+	153, // 315: ourspace_backend.proto.MachineService.StopUsageSession:input_type -> ourspace_backend.proto.StopUsageSessionRequest
+	155, // 316: ourspace_backend.proto.MachineService.ListUsageSessions:input_type -> ourspace_backend.proto.ListUsageSessionsRequest
+	157, // 317: ourspace_backend.proto.AccessService.CheckAccess:input_type -> ourspace_backend.proto.CheckAccessRequest
+	160, // 318: ourspace_backend.proto.AccessService.ListAccessDecisions:input_type -> ourspace_backend.proto.ListAccessDecisionsRequest
+	164, // 319: ourspace_backend.proto.FeeService.CreateMembershipPlan:input_type -> ourspace_backend.proto.CreateMembershipPlanRequest
+	165, // 320: ourspace_backend.proto.FeeService.GetMembershipPlan:input_type -> ourspace_backend.proto.GetMembershipPlanRequest
+	166, // 321: ourspace_backend.proto.FeeService.ListMembershipPlans:input_type -> ourspace_backend.proto.ListMembershipPlansRequest
+	168, // 322: ourspace_backend.proto.FeeService.UpdateMembershipPlan:input_type -> ourspace_backend.proto.UpdateMembershipPlanRequest
+	169, // 323: ourspace_backend.proto.FeeService.DeleteMembershipPlan:input_type -> ourspace_backend.proto.DeleteMembershipPlanRequest
+	172, // 324: ourspace_backend.proto.FeeService.AssignMembershipPlan:input_type -> ourspace_backend.proto.AssignMembershipPlanRequest
+	173, // 325: ourspace_backend.proto.FeeService.ListPlanAssignments:input_type -> ourspace_backend.proto.ListPlanAssignmentsRequest
+	175, // 326: ourspace_backend.proto.FeeService.EndPlanAssignment:input_type -> ourspace_backend.proto.EndPlanAssignmentRequest
+	178, // 327: ourspace_backend.proto.FeeService.GenerateInvoices:input_type -> ourspace_backend.proto.GenerateInvoicesRequest
+	180, // 328: ourspace_backend.proto.FeeService.ListInvoices:input_type -> ourspace_backend.proto.ListInvoicesRequest
+	182, // 329: ourspace_backend.proto.FeeService.CancelInvoice:input_type -> ourspace_backend.proto.CancelInvoiceRequest
+	185, // 330: ourspace_backend.proto.FeeService.RecordPayment:input_type -> ourspace_backend.proto.RecordPaymentRequest
+	186, // 331: ourspace_backend.proto.FeeService.ListPayments:input_type -> ourspace_backend.proto.ListPaymentsRequest
+	188, // 332: ourspace_backend.proto.FeeService.DeletePayment:input_type -> ourspace_backend.proto.DeletePaymentRequest
+	191, // 333: ourspace_backend.proto.FeeService.ListBalances:input_type -> ourspace_backend.proto.ListBalancesRequest
+	193, // 334: ourspace_backend.proto.FeeService.SetSepaMandate:input_type -> ourspace_backend.proto.SetSepaMandateRequest
+	194, // 335: ourspace_backend.proto.FeeService.GetSepaMandate:input_type -> ourspace_backend.proto.GetSepaMandateRequest
+	195, // 336: ourspace_backend.proto.FeeService.DeleteSepaMandate:input_type -> ourspace_backend.proto.DeleteSepaMandateRequest
+	196, // 337: ourspace_backend.proto.FeeService.ExportSepaDirectDebit:input_type -> ourspace_backend.proto.ExportSepaDirectDebitRequest
+	200, // 338: ourspace_backend.proto.SelfService.GetMyProfile:input_type -> ourspace_backend.proto.GetMyProfileRequest
+	201, // 339: ourspace_backend.proto.SelfService.UpdateMyProfile:input_type -> ourspace_backend.proto.UpdateMyProfileRequest
+	202, // 340: ourspace_backend.proto.SelfService.ListMyPresences:input_type -> ourspace_backend.proto.ListMyPresencesRequest
+	203, // 341: ourspace_backend.proto.SelfService.ListMyCards:input_type -> ourspace_backend.proto.ListMyCardsRequest
+	204, // 342: ourspace_backend.proto.SelfService.ListMyBriefings:input_type -> ourspace_backend.proto.ListMyBriefingsRequest
+	205, // 343: ourspace_backend.proto.SelfService.CreateMyChangeRequest:input_type -> ourspace_backend.proto.CreateMyChangeRequestRequest
+	206, // 344: ourspace_backend.proto.SelfService.ListMyChangeRequests:input_type -> ourspace_backend.proto.ListMyChangeRequestsRequest
+	207, // 345: ourspace_backend.proto.ReportService.GetPresenceReport:input_type -> ourspace_backend.proto.GetPresenceReportRequest
+	207, // 346: ourspace_backend.proto.ReportService.ExportPresenceReport:input_type -> ourspace_backend.proto.GetPresenceReportRequest
+	212, // 347: ourspace_backend.proto.ReportService.GetMachineUsageReport:input_type -> ourspace_backend.proto.GetMachineUsageReportRequest
+	212, // 348: ourspace_backend.proto.ReportService.ExportMachineUsageReport:input_type -> ourspace_backend.proto.GetMachineUsageReportRequest
+	216, // 349: ourspace_backend.proto.ApplicationService.SubmitApplication:input_type -> ourspace_backend.proto.SubmitApplicationRequest
+	217, // 350: ourspace_backend.proto.ApplicationService.ListApplications:input_type -> ourspace_backend.proto.ListApplicationsRequest
+	220, // 351: ourspace_backend.proto.ApplicationService.AcceptApplication:input_type -> ourspace_backend.proto.AcceptApplicationRequest
+	221, // 352: ourspace_backend.proto.ApplicationService.RejectApplication:input_type -> ourspace_backend.proto.RejectApplicationRequest
+	223, // 353: ourspace_backend.proto.MemberNoteService.CreateMemberNote:input_type -> ourspace_backend.proto.CreateMemberNoteRequest
+	224, // 354: ourspace_backend.proto.MemberNoteService.ListMemberNotes:input_type -> ourspace_backend.proto.ListMemberNotesRequest
+	227, // 355: ourspace_backend.proto.MemberNoteService.UpdateMemberNote:input_type -> ourspace_backend.proto.UpdateMemberNoteRequest
+	228, // 356: ourspace_backend.proto.MemberNoteService.DeleteMemberNote:input_type -> ourspace_backend.proto.DeleteMemberNoteRequest
+	230, // 357: ourspace_backend.proto.MemberNoteService.ListMemberTimeline:input_type -> ourspace_backend.proto.ListMemberTimelineRequest
+	233, // 358: ourspace_backend.proto.AuthService.Login:input_type -> ourspace_backend.proto.LoginRequest
+	239, // 359: ourspace_backend.proto.AuthService.Refresh:input_type -> ourspace_backend.proto.RefreshRequest
+	241, // 360: ourspace_backend.proto.AuthService.Logout:input_type -> ourspace_backend.proto.LogoutRequest
+	23,  // 361: ourspace_backend.proto.MemberService.CreateMember:output_type -> ourspace_backend.proto.Member
+	23,  // 362: ourspace_backend.proto.MemberService.GetMember:output_type -> ourspace_backend.proto.Member
+	27,  // 363: ourspace_backend.proto.MemberService.ListMembers:output_type -> ourspace_backend.proto.ListMembersResponse
+	31,  // 364: ourspace_backend.proto.MemberService.SearchMembers:output_type -> ourspace_backend.proto.SearchMembersResponse
+	253, // 365: ourspace_backend.proto.MemberService.ExportMembers:output_type -> google.api.HttpBody
+	23,  // 366: ourspace_backend.proto.MemberService.UpdateMember:output_type -> ourspace_backend.proto.Member
+	254, // 367: ourspace_backend.proto.MemberService.DeleteMember:output_type -> google.protobuf.Empty
+	40,  // 368: ourspace_backend.proto.MemberService.ListMemberTags:output_type -> ourspace_backend.proto.ListMemberTagsResponse
+	41,  // 369: ourspace_backend.proto.MemberService.CreateMemberTag:output_type -> ourspace_backend.proto.MemberTag
+	41,  // 370: ourspace_backend.proto.MemberService.UpdateMemberTag:output_type -> ourspace_backend.proto.MemberTag
+	254, // 371: ourspace_backend.proto.MemberService.DeleteMemberTag:output_type -> google.protobuf.Empty
+	41,  // 372: ourspace_backend.proto.MemberService.RenameMemberTag:output_type -> ourspace_backend.proto.MemberTag
+	41,  // 373: ourspace_backend.proto.MemberService.MergeMemberTags:output_type -> ourspace_backend.proto.MemberTag
+	37,  // 374: ourspace_backend.proto.MemberService.ImportMembers:output_type -> ourspace_backend.proto.ImportMembersResponse
+	73,  // 375: ourspace_backend.proto.MemberService.CreateMemberAttribute:output_type -> ourspace_backend.proto.MemberAttribute
+	73,  // 376: ourspace_backend.proto.MemberService.GetMemberAttribute:output_type -> ourspace_backend.proto.MemberAttribute
+	51,  // 377: ourspace_backend.proto.MemberService.ListMemberAttributes:output_type -> ourspace_backend.proto.ListMemberAttributesResponse
+	73,  // 378: ourspace_backend.proto.MemberService.UpdateMemberAttribute:output_type -> ourspace_backend.proto.MemberAttribute
+	254, // 379: ourspace_backend.proto.MemberService.DeleteMemberAttribute:output_type -> google.protobuf.Empty
+	55,  // 380: ourspace_backend.proto.MemberService.MigrateMemberAttribute:output_type -> ourspace_backend.proto.MigrateMemberAttributeResponse
+	57,  // 381: ourspace_backend.proto.MemberService.CreateMemberRelationship:output_type -> ourspace_backend.proto.MemberRelationship
+	60,  // 382: ourspace_backend.proto.MemberService.ListMemberRelationships:output_type -> ourspace_backend.proto.ListMemberRelationshipsResponse
+	57,  // 383: ourspace_backend.proto.MemberService.UpdateMemberRelationship:output_type -> ourspace_backend.proto.MemberRelationship
+	254, // 384: ourspace_backend.proto.MemberService.DeleteMemberRelationship:output_type -> google.protobuf.Empty
+	63,  // 385: ourspace_backend.proto.MemberService.CreateMemberConsent:output_type -> ourspace_backend.proto.MemberConsent
+	66,  // 386: ourspace_backend.proto.MemberService.ListMemberConsents:output_type -> ourspace_backend.proto.ListMemberConsentsResponse
+	63,  // 387: ourspace_backend.proto.MemberService.RevokeMemberConsent:output_type -> ourspace_backend.proto.MemberConsent
+	70,  // 388: ourspace_backend.proto.MemberService.ListMemberChangeRequests:output_type -> ourspace_backend.proto.ListMemberChangeRequestsResponse
+	68,  // 389: ourspace_backend.proto.MemberService.ApproveMemberChangeRequest:output_type -> ourspace_backend.proto.MemberChangeRequest
+	68,  // 390: ourspace_backend.proto.MemberService.RejectMemberChangeRequest:output_type -> ourspace_backend.proto.MemberChangeRequest
+	75,  // 391: ourspace_backend.proto.CardService.CreateCard:output_type -> ourspace_backend.proto.Card
+	75,  // 392: ourspace_backend.proto.CardService.GetCard:output_type -> ourspace_backend.proto.Card
+	80,  // 393: ourspace_backend.proto.CardService.ListCards:output_type -> ourspace_backend.proto.ListCardsResponse
+	253, // 394: ourspace_backend.proto.CardService.ExportCards:output_type -> google.api.HttpBody
+	75,  // 395: ourspace_backend.proto.CardService.UpdateCard:output_type -> ourspace_backend.proto.Card
+	254, // 396: ourspace_backend.proto.CardService.DeleteCard:output_type -> google.protobuf.Empty
+	92,  // 397: ourspace_backend.proto.BriefingService.CreateBriefing:output_type -> ourspace_backend.proto.Briefing
+	92,  // 398: ourspace_backend.proto.BriefingService.GetBriefing:output_type -> ourspace_backend.proto.Briefing
+	96,  // 399: ourspace_backend.proto.BriefingService.ListBriefings:output_type -> ourspace_backend.proto.ListBriefingsResponse
+	92,  // 400: ourspace_backend.proto.BriefingService.UpdateBriefing:output_type -> ourspace_backend.proto.Briefing
+	254, // 401: ourspace_backend.proto.BriefingService.DeleteBriefing:output_type -> google.protobuf.Empty
+	84,  // 402: ourspace_backend.proto.BriefingService.CreateBriefingType:output_type -> ourspace_backend.proto.BriefingType
+	84,  // 403: ourspace_backend.proto.BriefingService.GetBriefingType:output_type -> ourspace_backend.proto.BriefingType
+	89,  // 404: ourspace_backend.proto.BriefingService.ListBriefingTypes:output_type -> ourspace_backend.proto.ListBriefingTypesResponse
+	84,  // 405: ourspace_backend.proto.BriefingService.UpdateBriefingType:output_type -> ourspace_backend.proto.BriefingType
+	254, // 406: ourspace_backend.proto.BriefingService.DeleteBriefingType:output_type -> google.protobuf.Empty
+	101, // 407: ourspace_backend.proto.PresenceService.ListPresences:output_type -> ourspace_backend.proto.ListPresencesResponse
+	253, // 408: ourspace_backend.proto.PresenceService.ExportPresences:output_type -> google.api.HttpBody
+	99,  // 409: ourspace_backend.proto.PresenceService.Checkin:output_type -> ourspace_backend.proto.Presence
+	99,  // 410: ourspace_backend.proto.PresenceService.Checkout:output_type -> ourspace_backend.proto.Presence
+	107, // 411: ourspace_backend.proto.PresenceService.TogglePresence:output_type -> ourspace_backend.proto.TogglePresenceResponse
+	107, // 412: ourspace_backend.proto.PresenceService.CheckinByCard:output_type -> ourspace_backend.proto.TogglePresenceResponse
+	99,  // 413: ourspace_backend.proto.PresenceService.UpdatePresence:output_type -> ourspace_backend.proto.Presence
+	254, // 414: ourspace_backend.proto.PresenceService.DeletePresence:output_type -> google.protobuf.Empty
+	111, // 415: ourspace_backend.proto.EventService.CreateEvent:output_type -> ourspace_backend.proto.Event
+	111, // 416: ourspace_backend.proto.EventService.GetEvent:output_type -> ourspace_backend.proto.Event
+	116, // 417: ourspace_backend.proto.EventService.ListEvents:output_type -> ourspace_backend.proto.ListEventsResponse
+	111, // 418: ourspace_backend.proto.EventService.UpdateEvent:output_type -> ourspace_backend.proto.Event
+	254, // 419: ourspace_backend.proto.EventService.DeleteEvent:output_type -> google.protobuf.Empty
+	119, // 420: ourspace_backend.proto.EventService.RegisterForEvent:output_type -> ourspace_backend.proto.EventRegistration
+	119, // 421: ourspace_backend.proto.EventService.CancelEventRegistration:output_type -> ourspace_backend.proto.EventRegistration
+	124, // 422: ourspace_backend.proto.EventService.ListEventRegistrations:output_type -> ourspace_backend.proto.ListEventRegistrationsResponse
+	119, // 423: ourspace_backend.proto.EventService.MarkEventAttendance:output_type -> ourspace_backend.proto.EventRegistration
+	126, // 424: ourspace_backend.proto.LendingService.CreateItem:output_type -> ourspace_backend.proto.Item
+	126, // 425: ourspace_backend.proto.LendingService.GetItem:output_type -> ourspace_backend.proto.Item
+	131, // 426: ourspace_backend.proto.LendingService.ListItems:output_type -> ourspace_backend.proto.ListItemsResponse
+	126, // 427: ourspace_backend.proto.LendingService.UpdateItem:output_type -> ourspace_backend.proto.Item
+	254, // 428: ourspace_backend.proto.LendingService.DeleteItem:output_type -> google.protobuf.Empty
+	134, // 429: ourspace_backend.proto.LendingService.LendItem:output_type -> ourspace_backend.proto.Loan
+	134, // 430: ourspace_backend.proto.LendingService.LendItemByScan:output_type -> ourspace_backend.proto.Loan
+	134, // 431: ourspace_backend.proto.LendingService.ReturnItem:output_type -> ourspace_backend.proto.Loan
+	134, // 432: ourspace_backend.proto.LendingService.ReturnItemByScan:output_type -> ourspace_backend.proto.Loan
+	134, // 433: ourspace_backend.proto.LendingService.GetLoan:output_type -> ourspace_backend.proto.Loan
+	142, // 434: ourspace_backend.proto.LendingService.ListLoans:output_type -> ourspace_backend.proto.ListLoansResponse
+	143, // 435: ourspace_backend.proto.MachineService.CreateMachine:output_type -> ourspace_backend.proto.Machine
+	143, // 436: ourspace_backend.proto.MachineService.GetMachine:output_type -> ourspace_backend.proto.Machine
+	148, // 437: ourspace_backend.proto.MachineService.ListMachines:output_type -> ourspace_backend.proto.ListMachinesResponse
+	143, // 438: ourspace_backend.proto.MachineService.UpdateMachine:output_type -> ourspace_backend.proto.Machine
+	254, // 439: ourspace_backend.proto.MachineService.DeleteMachine:output_type -> google.protobuf.Empty
+	151, // 440: ourspace_backend.proto.MachineService.StartUsageSession:output_type -> ourspace_backend.proto.UsageSession
+	151, // 441: ourspace_backend.proto.MachineService.StopUsageSession:output_type -> ourspace_backend.proto.UsageSession
+	156, // 442: ourspace_backend.proto.MachineService.ListUsageSessions:output_type -> ourspace_backend.proto.ListUsageSessionsResponse
+	158, // 443: ourspace_backend.proto.AccessService.CheckAccess:output_type -> ourspace_backend.proto.AccessDecision
+	161, // 444: ourspace_backend.proto.AccessService.ListAccessDecisions:output_type -> ourspace_backend.proto.ListAccessDecisionsResponse
+	162, // 445: ourspace_backend.proto.FeeService.CreateMembershipPlan:output_type -> ourspace_backend.proto.MembershipPlan
+	162, // 446: ourspace_backend.proto.FeeService.GetMembershipPlan:output_type -> ourspace_backend.proto.MembershipPlan
+	167, // 447: ourspace_backend.proto.FeeService.ListMembershipPlans:output_type -> ourspace_backend.proto.ListMembershipPlansResponse
+	162, // 448: ourspace_backend.proto.FeeService.UpdateMembershipPlan:output_type -> ourspace_backend.proto.MembershipPlan
+	254, // 449: ourspace_backend.proto.FeeService.DeleteMembershipPlan:output_type -> google.protobuf.Empty
+	170, // 450: ourspace_backend.proto.FeeService.AssignMembershipPlan:output_type -> ourspace_backend.proto.PlanAssignment
+	174, // 451: ourspace_backend.proto.FeeService.ListPlanAssignments:output_type -> ourspace_backend.proto.ListPlanAssignmentsResponse
+	170, // 452: ourspace_backend.proto.FeeService.EndPlanAssignment:output_type -> ourspace_backend.proto.PlanAssignment
+	179, // 453: ourspace_backend.proto.FeeService.GenerateInvoices:output_type -> ourspace_backend.proto.GenerateInvoicesResponse
+	181, // 454: ourspace_backend.proto.FeeService.ListInvoices:output_type -> ourspace_backend.proto.ListInvoicesResponse
+	176, // 455: ourspace_backend.proto.FeeService.CancelInvoice:output_type -> ourspace_backend.proto.Invoice
+	183, // 456: ourspace_backend.proto.FeeService.RecordPayment:output_type -> ourspace_backend.proto.Payment
+	187, // 457: ourspace_backend.proto.FeeService.ListPayments:output_type -> ourspace_backend.proto.ListPaymentsResponse
+	254, // 458: ourspace_backend.proto.FeeService.DeletePayment:output_type -> google.protobuf.Empty
+	199, // 459: ourspace_backend.proto.FeeService.ListBalances:output_type -> ourspace_backend.proto.ListBalancesResponse
+	192, // 460: ourspace_backend.proto.FeeService.SetSepaMandate:output_type -> ourspace_backend.proto.SepaMandate
+	192, // 461: ourspace_backend.proto.FeeService.GetSepaMandate:output_type -> ourspace_backend.proto.SepaMandate
+	254, // 462: ourspace_backend.proto.FeeService.DeleteSepaMandate:output_type -> google.protobuf.Empty
+	198, // 463: ourspace_backend.proto.FeeService.ExportSepaDirectDebit:output_type -> ourspace_backend.proto.SepaDirectDebitExport
+	23,  // 464: ourspace_backend.proto.SelfService.GetMyProfile:output_type -> ourspace_backend.proto.Member
+	23,  // 465: ourspace_backend.proto.SelfService.UpdateMyProfile:output_type -> ourspace_backend.proto.Member
+	101, // 466: ourspace_backend.proto.SelfService.ListMyPresences:output_type -> ourspace_backend.proto.ListPresencesResponse
+	80,  // 467: ourspace_backend.proto.SelfService.ListMyCards:output_type -> ourspace_backend.proto.ListCardsResponse
+	96,  // 468: ourspace_backend.proto.SelfService.ListMyBriefings:output_type -> ourspace_backend.proto.ListBriefingsResponse
+	68,  // 469: ourspace_backend.proto.SelfService.CreateMyChangeRequest:output_type -> ourspace_backend.proto.MemberChangeRequest
+	70,  // 470: ourspace_backend.proto.SelfService.ListMyChangeRequests:output_type -> ourspace_backend.proto.ListMemberChangeRequestsResponse
+	208, // 471: ourspace_backend.proto.ReportService.GetPresenceReport:output_type -> ourspace_backend.proto.PresenceReport
+	253, // 472: ourspace_backend.proto.ReportService.ExportPresenceReport:output_type -> google.api.HttpBody
+	213, // 473: ourspace_backend.proto.ReportService.GetMachineUsageReport:output_type -> ourspace_backend.proto.MachineUsageReport
+	253, // 474: ourspace_backend.proto.ReportService.ExportMachineUsageReport:output_type -> google.api.HttpBody
+	254, // 475: ourspace_backend.proto.ApplicationService.SubmitApplication:output_type -> google.protobuf.Empty
+	218, // 476: ourspace_backend.proto.ApplicationService.ListApplications:output_type -> ourspace_backend.proto.ListApplicationsResponse
+	215, // 477: ourspace_backend.proto.ApplicationService.AcceptApplication:output_type -> ourspace_backend.proto.Application
+	215, // 478: ourspace_backend.proto.ApplicationService.RejectApplication:output_type -> ourspace_backend.proto.Application
+	222, // 479: ourspace_backend.proto.MemberNoteService.CreateMemberNote:output_type -> ourspace_backend.proto.MemberNote
+	225, // 480: ourspace_backend.proto.MemberNoteService.ListMemberNotes:output_type -> ourspace_backend.proto.ListMemberNotesResponse
+	222, // 481: ourspace_backend.proto.MemberNoteService.UpdateMemberNote:output_type -> ourspace_backend.proto.MemberNote
+	254, // 482: ourspace_backend.proto.MemberNoteService.DeleteMemberNote:output_type -> google.protobuf.Empty
+	231, // 483: ourspace_backend.proto.MemberNoteService.ListMemberTimeline:output_type -> ourspace_backend.proto.ListMemberTimelineResponse
+	237, // 484: ourspace_backend.proto.AuthService.Login:output_type -> ourspace_backend.proto.LoginResponse
+	240, // 485: ourspace_backend.proto.AuthService.Refresh:output_type -> ourspace_backend.proto.RefreshResponse
+	242, // 486: ourspace_backend.proto.AuthService.Logout:output_type -> ourspace_backend.proto.LogoutResponse
+	361, // [361:487] is the sub-list for method output_type
+	235, // [235:361] is the sub-list for method input_type
+	235, // [235:235] is the sub-list for extension type_name
+	235, // [235:235] is the sub-list for extension extendee
+	0,   // [0:235] is the sub-list for field type_name
 }
 
 func init() { file_ourspace_backend_proto_api_proto_init() }
@@ -4431,13 +17274,54 @@ func file_ourspace_backend_proto_api_proto_init() {
 	}
 	file_ourspace_backend_proto_api_proto_msgTypes[1].OneofWrappers = []any{}
 	file_ourspace_backend_proto_api_proto_msgTypes[4].OneofWrappers = []any{}
-	file_ourspace_backend_proto_api_proto_msgTypes[43].OneofWrappers = []any{}
-	file_ourspace_backend_proto_api_proto_msgTypes[50].OneofWrappers = []any{
+	file_ourspace_backend_proto_api_proto_msgTypes[35].OneofWrappers = []any{}
+	file_ourspace_backend_proto_api_proto_msgTypes[41].OneofWrappers = []any{}
+	file_ourspace_backend_proto_api_proto_msgTypes[47].OneofWrappers = []any{}
+	file_ourspace_backend_proto_api_proto_msgTypes[51].OneofWrappers = []any{}
+	file_ourspace_backend_proto_api_proto_msgTypes[73].OneofWrappers = []any{}
+	file_ourspace_backend_proto_api_proto_msgTypes[77].OneofWrappers = []any{}
+	file_ourspace_backend_proto_api_proto_msgTypes[78].OneofWrappers = []any{}
+	file_ourspace_backend_proto_api_proto_msgTypes[93].OneofWrappers = []any{}
+	file_ourspace_backend_proto_api_proto_msgTypes[101].OneofWrappers = []any{}
+	file_ourspace_backend_proto_api_proto_msgTypes[108].OneofWrappers = []any{}
+	file_ourspace_backend_proto_api_proto_msgTypes[112].OneofWrappers = []any{}
+	file_ourspace_backend_proto_api_proto_msgTypes[115].OneofWrappers = []any{
+		(*LendItemByScanRequest_ItemRfidValue)(nil),
+		(*LendItemByScanRequest_ItemBarcode)(nil),
+	}
+	file_ourspace_backend_proto_api_proto_msgTypes[117].OneofWrappers = []any{
+		(*ReturnItemByScanRequest_ItemRfidValue)(nil),
+		(*ReturnItemByScanRequest_ItemBarcode)(nil),
+	}
+	file_ourspace_backend_proto_api_proto_msgTypes[119].OneofWrappers = []any{}
+	file_ourspace_backend_proto_api_proto_msgTypes[129].OneofWrappers = []any{}
+	file_ourspace_backend_proto_api_proto_msgTypes[130].OneofWrappers = []any{
+		(*StartUsageSessionRequest_MemberId)(nil),
+		(*StartUsageSessionRequest_RfidValue)(nil),
+	}
+	file_ourspace_backend_proto_api_proto_msgTypes[133].OneofWrappers = []any{}
+	file_ourspace_backend_proto_api_proto_msgTypes[136].OneofWrappers = []any{}
+	file_ourspace_backend_proto_api_proto_msgTypes[138].OneofWrappers = []any{}
+	file_ourspace_backend_proto_api_proto_msgTypes[140].OneofWrappers = []any{}
+	file_ourspace_backend_proto_api_proto_msgTypes[148].OneofWrappers = []any{}
+	file_ourspace_backend_proto_api_proto_msgTypes[151].OneofWrappers = []any{}
+	file_ourspace_backend_proto_api_proto_msgTypes[158].OneofWrappers = []any{}
+	file_ourspace_backend_proto_api_proto_msgTypes[161].OneofWrappers = []any{}
+	file_ourspace_backend_proto_api_proto_msgTypes[164].OneofWrappers = []any{}
+	file_ourspace_backend_proto_api_proto_msgTypes[169].OneofWrappers = []any{}
+	file_ourspace_backend_proto_api_proto_msgTypes[193].OneofWrappers = []any{}
+	file_ourspace_backend_proto_api_proto_msgTypes[194].OneofWrappers = []any{}
+	file_ourspace_backend_proto_api_proto_msgTypes[207].OneofWrappers = []any{
+		(*MemberTimelineEntry_Note)(nil),
+		(*MemberTimelineEntry_Card)(nil),
+		(*MemberTimelineEntry_Briefing)(nil),
+	}
+	file_ourspace_backend_proto_api_proto_msgTypes[211].OneofWrappers = []any{
 		(*LoginRequest_Password)(nil),
 		(*LoginRequest_Oidc)(nil),
 		(*LoginRequest_ApiKey)(nil),
 	}
-	file_ourspace_backend_proto_api_proto_msgTypes[54].OneofWrappers = []any{
+	file_ourspace_backend_proto_api_proto_msgTypes[215].OneofWrappers = []any{
 		(*LoginResponse_Success)(nil),
 	}
 	type x struct{}
@@ -4445,10 +17329,10 @@ func file_ourspace_backend_proto_api_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_ourspace_backend_proto_api_proto_rawDesc), len(file_ourspace_backend_proto_api_proto_rawDesc)),
-			NumEnums:      7,
-			NumMessages:   61,
+			NumEnums:      22,
+			NumMessages:   228,
 			NumExtensions: 0,
-			NumServices:   5,
+			NumServices:   14,
 		},
 		GoTypes:           file_ourspace_backend_proto_api_proto_goTypes,
 		DependencyIndexes: file_ourspace_backend_proto_api_proto_depIdxs,
@@ -88,6 +88,40 @@ func (m *CreateMemberRequest) validate(all bool) error {
 		}
 	}
 
+	for idx, item := range m.GetRelationships() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, CreateMemberRequestValidationError{
+						field:  fmt.Sprintf("Relationships[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, CreateMemberRequestValidationError{
+						field:  fmt.Sprintf("Relationships[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return CreateMemberRequestValidationError{
+					field:  fmt.Sprintf("Relationships[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
 	if len(errors) > 0 {
 		return CreateMemberRequestMultiError(errors)
 	}
@@ -288,6 +322,10 @@ func (m *Member) validate(all bool) error {
 
 	}
 
+	if m.BirthDate != nil {
+		// no validation rules for BirthDate
+	}
+
 	if len(errors) > 0 {
 		return MemberMultiError(errors)
 	}
@@ -600,6 +638,16 @@ func (m *ListMembersRequest) validate(all bool) error {
 
 	// no validation rules for SortDirection
 
+	// no validation rules for AttributeEquals
+
+	// no validation rules for AttributeContains
+
+	// no validation rules for AttributeMin
+
+	// no validation rules for AttributeMax
+
+	// no validation rules for SortByAttribute
+
 	if m.NameContains != nil {
 		// no validation rules for NameContains
 	}
@@ -958,50 +1006,73 @@ var _ interface {
 	ErrorName() string
 } = ListMembersResponseValidationError{}
 
-// Validate checks the field values on MemberPageToken with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *MemberPageToken) Validate() error {
+// Validate checks the field values on ExportMembersRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ExportMembersRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on MemberPageToken with the rules
+// ValidateAll checks the field values on ExportMembersRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// MemberPageTokenMultiError, or nil if none found.
-func (m *MemberPageToken) ValidateAll() error {
+// ExportMembersRequestMultiError, or nil if none found.
+func (m *ExportMembersRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *MemberPageToken) validate(all bool) error {
+func (m *ExportMembersRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Field
-
-	// no validation rules for LastValue
-
-	// no validation rules for Direction
+	if all {
+		switch v := interface{}(m.GetFilter()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ExportMembersRequestValidationError{
+					field:  "Filter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ExportMembersRequestValidationError{
+					field:  "Filter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetFilter()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ExportMembersRequestValidationError{
+				field:  "Filter",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
 
-	// no validation rules for LastId
+	// no validation rules for Format
 
 	if len(errors) > 0 {
-		return MemberPageTokenMultiError(errors)
+		return ExportMembersRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// MemberPageTokenMultiError is an error wrapping multiple validation errors
-// returned by MemberPageToken.ValidateAll() if the designated constraints
-// aren't met.
-type MemberPageTokenMultiError []error
+// ExportMembersRequestMultiError is an error wrapping multiple validation
+// errors returned by ExportMembersRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ExportMembersRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m MemberPageTokenMultiError) Error() string {
+func (m ExportMembersRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1010,11 +1081,11 @@ func (m MemberPageTokenMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m MemberPageTokenMultiError) AllErrors() []error { return m }
+func (m ExportMembersRequestMultiError) AllErrors() []error { return m }
 
-// MemberPageTokenValidationError is the validation error returned by
-// MemberPageToken.Validate if the designated constraints aren't met.
-type MemberPageTokenValidationError struct {
+// ExportMembersRequestValidationError is the validation error returned by
+// ExportMembersRequest.Validate if the designated constraints aren't met.
+type ExportMembersRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1022,22 +1093,24 @@ type MemberPageTokenValidationError struct {
 }
 
 // Field function returns field value.
-func (e MemberPageTokenValidationError) Field() string { return e.field }
+func (e ExportMembersRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e MemberPageTokenValidationError) Reason() string { return e.reason }
+func (e ExportMembersRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e MemberPageTokenValidationError) Cause() error { return e.cause }
+func (e ExportMembersRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e MemberPageTokenValidationError) Key() bool { return e.key }
+func (e ExportMembersRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e MemberPageTokenValidationError) ErrorName() string { return "MemberPageTokenValidationError" }
+func (e ExportMembersRequestValidationError) ErrorName() string {
+	return "ExportMembersRequestValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e MemberPageTokenValidationError) Error() string {
+func (e ExportMembersRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1049,14 +1122,14 @@ func (e MemberPageTokenValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sMemberPageToken.%s: %s%s",
+		"invalid %sExportMembersRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = MemberPageTokenValidationError{}
+var _ error = ExportMembersRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -1064,102 +1137,54 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = MemberPageTokenValidationError{}
+} = ExportMembersRequestValidationError{}
 
-// Validate checks the field values on UpdateMemberRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *UpdateMemberRequest) Validate() error {
+// Validate checks the field values on MemberPageToken with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *MemberPageToken) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on UpdateMemberRequest with the rules
+// ValidateAll checks the field values on MemberPageToken with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// UpdateMemberRequestMultiError, or nil if none found.
-func (m *UpdateMemberRequest) ValidateAll() error {
+// MemberPageTokenMultiError, or nil if none found.
+func (m *MemberPageToken) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *UpdateMemberRequest) validate(all bool) error {
+func (m *MemberPageToken) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if all {
-		switch v := interface{}(m.GetMember()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, UpdateMemberRequestValidationError{
-					field:  "Member",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, UpdateMemberRequestValidationError{
-					field:  "Member",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetMember()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return UpdateMemberRequestValidationError{
-				field:  "Member",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
+	// no validation rules for Field
 
-	if all {
-		switch v := interface{}(m.GetFieldMask()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, UpdateMemberRequestValidationError{
-					field:  "FieldMask",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, UpdateMemberRequestValidationError{
-					field:  "FieldMask",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetFieldMask()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return UpdateMemberRequestValidationError{
-				field:  "FieldMask",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
+	// no validation rules for LastValue
+
+	// no validation rules for Direction
+
+	// no validation rules for LastId
+
+	// no validation rules for Attribute
 
 	if len(errors) > 0 {
-		return UpdateMemberRequestMultiError(errors)
+		return MemberPageTokenMultiError(errors)
 	}
 
 	return nil
 }
 
-// UpdateMemberRequestMultiError is an error wrapping multiple validation
-// errors returned by UpdateMemberRequest.ValidateAll() if the designated
-// constraints aren't met.
-type UpdateMemberRequestMultiError []error
+// MemberPageTokenMultiError is an error wrapping multiple validation errors
+// returned by MemberPageToken.ValidateAll() if the designated constraints
+// aren't met.
+type MemberPageTokenMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m UpdateMemberRequestMultiError) Error() string {
+func (m MemberPageTokenMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1168,11 +1193,11 @@ func (m UpdateMemberRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m UpdateMemberRequestMultiError) AllErrors() []error { return m }
+func (m MemberPageTokenMultiError) AllErrors() []error { return m }
 
-// UpdateMemberRequestValidationError is the validation error returned by
-// UpdateMemberRequest.Validate if the designated constraints aren't met.
-type UpdateMemberRequestValidationError struct {
+// MemberPageTokenValidationError is the validation error returned by
+// MemberPageToken.Validate if the designated constraints aren't met.
+type MemberPageTokenValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1180,24 +1205,22 @@ type UpdateMemberRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e UpdateMemberRequestValidationError) Field() string { return e.field }
+func (e MemberPageTokenValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UpdateMemberRequestValidationError) Reason() string { return e.reason }
+func (e MemberPageTokenValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UpdateMemberRequestValidationError) Cause() error { return e.cause }
+func (e MemberPageTokenValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UpdateMemberRequestValidationError) Key() bool { return e.key }
+func (e MemberPageTokenValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UpdateMemberRequestValidationError) ErrorName() string {
-	return "UpdateMemberRequestValidationError"
-}
+func (e MemberPageTokenValidationError) ErrorName() string { return "MemberPageTokenValidationError" }
 
 // Error satisfies the builtin error interface
-func (e UpdateMemberRequestValidationError) Error() string {
+func (e MemberPageTokenValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1209,14 +1232,14 @@ func (e UpdateMemberRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUpdateMemberRequest.%s: %s%s",
+		"invalid %sMemberPageToken.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UpdateMemberRequestValidationError{}
+var _ error = MemberPageTokenValidationError{}
 
 var _ interface {
 	Field() string
@@ -1224,46 +1247,50 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UpdateMemberRequestValidationError{}
+} = MemberPageTokenValidationError{}
 
-// Validate checks the field values on DeleteMemberRequest with the rules
+// Validate checks the field values on SearchMembersRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *DeleteMemberRequest) Validate() error {
+func (m *SearchMembersRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on DeleteMemberRequest with the rules
+// ValidateAll checks the field values on SearchMembersRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// DeleteMemberRequestMultiError, or nil if none found.
-func (m *DeleteMemberRequest) ValidateAll() error {
+// SearchMembersRequestMultiError, or nil if none found.
+func (m *SearchMembersRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *DeleteMemberRequest) validate(all bool) error {
+func (m *SearchMembersRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Id
+	// no validation rules for Query
+
+	// no validation rules for PageSize
+
+	// no validation rules for PageToken
 
 	if len(errors) > 0 {
-		return DeleteMemberRequestMultiError(errors)
+		return SearchMembersRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// DeleteMemberRequestMultiError is an error wrapping multiple validation
-// errors returned by DeleteMemberRequest.ValidateAll() if the designated
+// SearchMembersRequestMultiError is an error wrapping multiple validation
+// errors returned by SearchMembersRequest.ValidateAll() if the designated
 // constraints aren't met.
-type DeleteMemberRequestMultiError []error
+type SearchMembersRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m DeleteMemberRequestMultiError) Error() string {
+func (m SearchMembersRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1272,11 +1299,11 @@ func (m DeleteMemberRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m DeleteMemberRequestMultiError) AllErrors() []error { return m }
+func (m SearchMembersRequestMultiError) AllErrors() []error { return m }
 
-// DeleteMemberRequestValidationError is the validation error returned by
-// DeleteMemberRequest.Validate if the designated constraints aren't met.
-type DeleteMemberRequestValidationError struct {
+// SearchMembersRequestValidationError is the validation error returned by
+// SearchMembersRequest.Validate if the designated constraints aren't met.
+type SearchMembersRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1284,24 +1311,24 @@ type DeleteMemberRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e DeleteMemberRequestValidationError) Field() string { return e.field }
+func (e SearchMembersRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DeleteMemberRequestValidationError) Reason() string { return e.reason }
+func (e SearchMembersRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DeleteMemberRequestValidationError) Cause() error { return e.cause }
+func (e SearchMembersRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DeleteMemberRequestValidationError) Key() bool { return e.key }
+func (e SearchMembersRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DeleteMemberRequestValidationError) ErrorName() string {
-	return "DeleteMemberRequestValidationError"
+func (e SearchMembersRequestValidationError) ErrorName() string {
+	return "SearchMembersRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e DeleteMemberRequestValidationError) Error() string {
+func (e SearchMembersRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1313,14 +1340,14 @@ func (e DeleteMemberRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDeleteMemberRequest.%s: %s%s",
+		"invalid %sSearchMembersRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DeleteMemberRequestValidationError{}
+var _ error = SearchMembersRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -1328,48 +1355,80 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DeleteMemberRequestValidationError{}
+} = SearchMembersRequestValidationError{}
 
-// Validate checks the field values on ListMemberTagsRequest with the rules
+// Validate checks the field values on SearchMembersResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ListMemberTagsRequest) Validate() error {
+func (m *SearchMembersResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ListMemberTagsRequest with the rules
+// ValidateAll checks the field values on SearchMembersResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ListMemberTagsRequestMultiError, or nil if none found.
-func (m *ListMemberTagsRequest) ValidateAll() error {
+// SearchMembersResponseMultiError, or nil if none found.
+func (m *SearchMembersResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ListMemberTagsRequest) validate(all bool) error {
+func (m *SearchMembersResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for PageSize
-
-	// no validation rules for PageToken
-
-	if len(errors) > 0 {
-		return ListMemberTagsRequestMultiError(errors)
-	}
+	for idx, item := range m.GetResults() {
+		_, _ = idx, item
 
-	return nil
-}
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SearchMembersResponseValidationError{
+						field:  fmt.Sprintf("Results[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SearchMembersResponseValidationError{
+						field:  fmt.Sprintf("Results[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SearchMembersResponseValidationError{
+					field:  fmt.Sprintf("Results[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
 
-// ListMemberTagsRequestMultiError is an error wrapping multiple validation
-// errors returned by ListMemberTagsRequest.ValidateAll() if the designated
+	}
+
+	// no validation rules for NextPageToken
+
+	if len(errors) > 0 {
+		return SearchMembersResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// SearchMembersResponseMultiError is an error wrapping multiple validation
+// errors returned by SearchMembersResponse.ValidateAll() if the designated
 // constraints aren't met.
-type ListMemberTagsRequestMultiError []error
+type SearchMembersResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ListMemberTagsRequestMultiError) Error() string {
+func (m SearchMembersResponseMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1378,11 +1437,11 @@ func (m ListMemberTagsRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ListMemberTagsRequestMultiError) AllErrors() []error { return m }
+func (m SearchMembersResponseMultiError) AllErrors() []error { return m }
 
-// ListMemberTagsRequestValidationError is the validation error returned by
-// ListMemberTagsRequest.Validate if the designated constraints aren't met.
-type ListMemberTagsRequestValidationError struct {
+// SearchMembersResponseValidationError is the validation error returned by
+// SearchMembersResponse.Validate if the designated constraints aren't met.
+type SearchMembersResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1390,24 +1449,24 @@ type ListMemberTagsRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListMemberTagsRequestValidationError) Field() string { return e.field }
+func (e SearchMembersResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListMemberTagsRequestValidationError) Reason() string { return e.reason }
+func (e SearchMembersResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListMemberTagsRequestValidationError) Cause() error { return e.cause }
+func (e SearchMembersResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListMemberTagsRequestValidationError) Key() bool { return e.key }
+func (e SearchMembersResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListMemberTagsRequestValidationError) ErrorName() string {
-	return "ListMemberTagsRequestValidationError"
+func (e SearchMembersResponseValidationError) ErrorName() string {
+	return "SearchMembersResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ListMemberTagsRequestValidationError) Error() string {
+func (e SearchMembersResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1419,14 +1478,14 @@ func (e ListMemberTagsRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListMemberTagsRequest.%s: %s%s",
+		"invalid %sSearchMembersResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListMemberTagsRequestValidationError{}
+var _ error = SearchMembersResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -1434,46 +1493,75 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListMemberTagsRequestValidationError{}
+} = SearchMembersResponseValidationError{}
 
-// Validate checks the field values on ListMemberTagsResponse with the rules
+// Validate checks the field values on MemberSearchResult with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ListMemberTagsResponse) Validate() error {
+func (m *MemberSearchResult) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ListMemberTagsResponse with the rules
+// ValidateAll checks the field values on MemberSearchResult with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ListMemberTagsResponseMultiError, or nil if none found.
-func (m *ListMemberTagsResponse) ValidateAll() error {
+// MemberSearchResultMultiError, or nil if none found.
+func (m *MemberSearchResult) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ListMemberTagsResponse) validate(all bool) error {
+func (m *MemberSearchResult) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for NextPageToken
+	if all {
+		switch v := interface{}(m.GetMember()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, MemberSearchResultValidationError{
+					field:  "Member",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, MemberSearchResultValidationError{
+					field:  "Member",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetMember()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return MemberSearchResultValidationError{
+				field:  "Member",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Score
 
 	if len(errors) > 0 {
-		return ListMemberTagsResponseMultiError(errors)
+		return MemberSearchResultMultiError(errors)
 	}
 
 	return nil
 }
 
-// ListMemberTagsResponseMultiError is an error wrapping multiple validation
-// errors returned by ListMemberTagsResponse.ValidateAll() if the designated
-// constraints aren't met.
-type ListMemberTagsResponseMultiError []error
+// MemberSearchResultMultiError is an error wrapping multiple validation errors
+// returned by MemberSearchResult.ValidateAll() if the designated constraints
+// aren't met.
+type MemberSearchResultMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ListMemberTagsResponseMultiError) Error() string {
+func (m MemberSearchResultMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1482,11 +1570,11 @@ func (m ListMemberTagsResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ListMemberTagsResponseMultiError) AllErrors() []error { return m }
+func (m MemberSearchResultMultiError) AllErrors() []error { return m }
 
-// ListMemberTagsResponseValidationError is the validation error returned by
-// ListMemberTagsResponse.Validate if the designated constraints aren't met.
-type ListMemberTagsResponseValidationError struct {
+// MemberSearchResultValidationError is the validation error returned by
+// MemberSearchResult.Validate if the designated constraints aren't met.
+type MemberSearchResultValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1494,24 +1582,24 @@ type ListMemberTagsResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListMemberTagsResponseValidationError) Field() string { return e.field }
+func (e MemberSearchResultValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListMemberTagsResponseValidationError) Reason() string { return e.reason }
+func (e MemberSearchResultValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListMemberTagsResponseValidationError) Cause() error { return e.cause }
+func (e MemberSearchResultValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListMemberTagsResponseValidationError) Key() bool { return e.key }
+func (e MemberSearchResultValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListMemberTagsResponseValidationError) ErrorName() string {
-	return "ListMemberTagsResponseValidationError"
+func (e MemberSearchResultValidationError) ErrorName() string {
+	return "MemberSearchResultValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ListMemberTagsResponseValidationError) Error() string {
+func (e MemberSearchResultValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1523,14 +1611,14 @@ func (e ListMemberTagsResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListMemberTagsResponse.%s: %s%s",
+		"invalid %sMemberSearchResult.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListMemberTagsResponseValidationError{}
+var _ error = MemberSearchResultValidationError{}
 
 var _ interface {
 	Field() string
@@ -1538,24 +1626,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListMemberTagsResponseValidationError{}
+} = MemberSearchResultValidationError{}
 
-// Validate checks the field values on MemberTagsPageToken with the rules
+// Validate checks the field values on MemberSearchPageToken with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *MemberTagsPageToken) Validate() error {
+func (m *MemberSearchPageToken) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on MemberTagsPageToken with the rules
+// ValidateAll checks the field values on MemberSearchPageToken with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// MemberTagsPageTokenMultiError, or nil if none found.
-func (m *MemberTagsPageToken) ValidateAll() error {
+// MemberSearchPageTokenMultiError, or nil if none found.
+func (m *MemberSearchPageToken) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *MemberTagsPageToken) validate(all bool) error {
+func (m *MemberSearchPageToken) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -1565,19 +1653,19 @@ func (m *MemberTagsPageToken) validate(all bool) error {
 	// no validation rules for Offset
 
 	if len(errors) > 0 {
-		return MemberTagsPageTokenMultiError(errors)
+		return MemberSearchPageTokenMultiError(errors)
 	}
 
 	return nil
 }
 
-// MemberTagsPageTokenMultiError is an error wrapping multiple validation
-// errors returned by MemberTagsPageToken.ValidateAll() if the designated
+// MemberSearchPageTokenMultiError is an error wrapping multiple validation
+// errors returned by MemberSearchPageToken.ValidateAll() if the designated
 // constraints aren't met.
-type MemberTagsPageTokenMultiError []error
+type MemberSearchPageTokenMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m MemberTagsPageTokenMultiError) Error() string {
+func (m MemberSearchPageTokenMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1586,11 +1674,11 @@ func (m MemberTagsPageTokenMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m MemberTagsPageTokenMultiError) AllErrors() []error { return m }
+func (m MemberSearchPageTokenMultiError) AllErrors() []error { return m }
 
-// MemberTagsPageTokenValidationError is the validation error returned by
-// MemberTagsPageToken.Validate if the designated constraints aren't met.
-type MemberTagsPageTokenValidationError struct {
+// MemberSearchPageTokenValidationError is the validation error returned by
+// MemberSearchPageToken.Validate if the designated constraints aren't met.
+type MemberSearchPageTokenValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1598,24 +1686,24 @@ type MemberTagsPageTokenValidationError struct {
 }
 
 // Field function returns field value.
-func (e MemberTagsPageTokenValidationError) Field() string { return e.field }
+func (e MemberSearchPageTokenValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e MemberTagsPageTokenValidationError) Reason() string { return e.reason }
+func (e MemberSearchPageTokenValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e MemberTagsPageTokenValidationError) Cause() error { return e.cause }
+func (e MemberSearchPageTokenValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e MemberTagsPageTokenValidationError) Key() bool { return e.key }
+func (e MemberSearchPageTokenValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e MemberTagsPageTokenValidationError) ErrorName() string {
-	return "MemberTagsPageTokenValidationError"
+func (e MemberSearchPageTokenValidationError) ErrorName() string {
+	return "MemberSearchPageTokenValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e MemberTagsPageTokenValidationError) Error() string {
+func (e MemberSearchPageTokenValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1627,14 +1715,14 @@ func (e MemberTagsPageTokenValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sMemberTagsPageToken.%s: %s%s",
+		"invalid %sMemberSearchPageToken.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = MemberTagsPageTokenValidationError{}
+var _ error = MemberSearchPageTokenValidationError{}
 
 var _ interface {
 	Field() string
@@ -1642,24 +1730,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = MemberTagsPageTokenValidationError{}
+} = MemberSearchPageTokenValidationError{}
 
-// Validate checks the field values on CreateMemberAttributeRequest with the
-// rules defined in the proto definition for this message. If any rules are
+// Validate checks the field values on UpdateMemberRequest with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *CreateMemberAttributeRequest) Validate() error {
+func (m *UpdateMemberRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CreateMemberAttributeRequest with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on UpdateMemberRequest with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// CreateMemberAttributeRequestMultiError, or nil if none found.
-func (m *CreateMemberAttributeRequest) ValidateAll() error {
+// UpdateMemberRequestMultiError, or nil if none found.
+func (m *UpdateMemberRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CreateMemberAttributeRequest) validate(all bool) error {
+func (m *UpdateMemberRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -1667,28 +1755,57 @@ func (m *CreateMemberAttributeRequest) validate(all bool) error {
 	var errors []error
 
 	if all {
-		switch v := interface{}(m.GetAttribute()).(type) {
+		switch v := interface{}(m.GetMember()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, CreateMemberAttributeRequestValidationError{
-					field:  "Attribute",
+				errors = append(errors, UpdateMemberRequestValidationError{
+					field:  "Member",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, CreateMemberAttributeRequestValidationError{
-					field:  "Attribute",
+				errors = append(errors, UpdateMemberRequestValidationError{
+					field:  "Member",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetAttribute()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetMember()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return CreateMemberAttributeRequestValidationError{
-				field:  "Attribute",
+			return UpdateMemberRequestValidationError{
+				field:  "Member",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetFieldMask()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateMemberRequestValidationError{
+					field:  "FieldMask",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateMemberRequestValidationError{
+					field:  "FieldMask",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetFieldMask()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateMemberRequestValidationError{
+				field:  "FieldMask",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
@@ -1696,19 +1813,19 @@ func (m *CreateMemberAttributeRequest) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return CreateMemberAttributeRequestMultiError(errors)
+		return UpdateMemberRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// CreateMemberAttributeRequestMultiError is an error wrapping multiple
-// validation errors returned by CreateMemberAttributeRequest.ValidateAll() if
-// the designated constraints aren't met.
-type CreateMemberAttributeRequestMultiError []error
+// UpdateMemberRequestMultiError is an error wrapping multiple validation
+// errors returned by UpdateMemberRequest.ValidateAll() if the designated
+// constraints aren't met.
+type UpdateMemberRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CreateMemberAttributeRequestMultiError) Error() string {
+func (m UpdateMemberRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1717,12 +1834,11 @@ func (m CreateMemberAttributeRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CreateMemberAttributeRequestMultiError) AllErrors() []error { return m }
+func (m UpdateMemberRequestMultiError) AllErrors() []error { return m }
 
-// CreateMemberAttributeRequestValidationError is the validation error returned
-// by CreateMemberAttributeRequest.Validate if the designated constraints
-// aren't met.
-type CreateMemberAttributeRequestValidationError struct {
+// UpdateMemberRequestValidationError is the validation error returned by
+// UpdateMemberRequest.Validate if the designated constraints aren't met.
+type UpdateMemberRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1730,24 +1846,24 @@ type CreateMemberAttributeRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e CreateMemberAttributeRequestValidationError) Field() string { return e.field }
+func (e UpdateMemberRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CreateMemberAttributeRequestValidationError) Reason() string { return e.reason }
+func (e UpdateMemberRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CreateMemberAttributeRequestValidationError) Cause() error { return e.cause }
+func (e UpdateMemberRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CreateMemberAttributeRequestValidationError) Key() bool { return e.key }
+func (e UpdateMemberRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CreateMemberAttributeRequestValidationError) ErrorName() string {
-	return "CreateMemberAttributeRequestValidationError"
+func (e UpdateMemberRequestValidationError) ErrorName() string {
+	return "UpdateMemberRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e CreateMemberAttributeRequestValidationError) Error() string {
+func (e UpdateMemberRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1759,14 +1875,14 @@ func (e CreateMemberAttributeRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCreateMemberAttributeRequest.%s: %s%s",
+		"invalid %sUpdateMemberRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CreateMemberAttributeRequestValidationError{}
+var _ error = UpdateMemberRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -1774,24 +1890,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CreateMemberAttributeRequestValidationError{}
+} = UpdateMemberRequestValidationError{}
 
-// Validate checks the field values on GetMemberAttributeRequest with the rules
+// Validate checks the field values on DeleteMemberRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetMemberAttributeRequest) Validate() error {
+func (m *DeleteMemberRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetMemberAttributeRequest with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on DeleteMemberRequest with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetMemberAttributeRequestMultiError, or nil if none found.
-func (m *GetMemberAttributeRequest) ValidateAll() error {
+// DeleteMemberRequestMultiError, or nil if none found.
+func (m *DeleteMemberRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetMemberAttributeRequest) validate(all bool) error {
+func (m *DeleteMemberRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -1801,19 +1917,19 @@ func (m *GetMemberAttributeRequest) validate(all bool) error {
 	// no validation rules for Id
 
 	if len(errors) > 0 {
-		return GetMemberAttributeRequestMultiError(errors)
+		return DeleteMemberRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetMemberAttributeRequestMultiError is an error wrapping multiple validation
-// errors returned by GetMemberAttributeRequest.ValidateAll() if the
-// designated constraints aren't met.
-type GetMemberAttributeRequestMultiError []error
+// DeleteMemberRequestMultiError is an error wrapping multiple validation
+// errors returned by DeleteMemberRequest.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteMemberRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetMemberAttributeRequestMultiError) Error() string {
+func (m DeleteMemberRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1822,11 +1938,11 @@ func (m GetMemberAttributeRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetMemberAttributeRequestMultiError) AllErrors() []error { return m }
+func (m DeleteMemberRequestMultiError) AllErrors() []error { return m }
 
-// GetMemberAttributeRequestValidationError is the validation error returned by
-// GetMemberAttributeRequest.Validate if the designated constraints aren't met.
-type GetMemberAttributeRequestValidationError struct {
+// DeleteMemberRequestValidationError is the validation error returned by
+// DeleteMemberRequest.Validate if the designated constraints aren't met.
+type DeleteMemberRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1834,24 +1950,24 @@ type GetMemberAttributeRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetMemberAttributeRequestValidationError) Field() string { return e.field }
+func (e DeleteMemberRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetMemberAttributeRequestValidationError) Reason() string { return e.reason }
+func (e DeleteMemberRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetMemberAttributeRequestValidationError) Cause() error { return e.cause }
+func (e DeleteMemberRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetMemberAttributeRequestValidationError) Key() bool { return e.key }
+func (e DeleteMemberRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetMemberAttributeRequestValidationError) ErrorName() string {
-	return "GetMemberAttributeRequestValidationError"
+func (e DeleteMemberRequestValidationError) ErrorName() string {
+	return "DeleteMemberRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetMemberAttributeRequestValidationError) Error() string {
+func (e DeleteMemberRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1863,14 +1979,14 @@ func (e GetMemberAttributeRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetMemberAttributeRequest.%s: %s%s",
+		"invalid %sDeleteMemberRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetMemberAttributeRequestValidationError{}
+var _ error = DeleteMemberRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -1878,52 +1994,54 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetMemberAttributeRequestValidationError{}
+} = DeleteMemberRequestValidationError{}
 
-// Validate checks the field values on ListMemberAttributesRequest with the
-// rules defined in the proto definition for this message. If any rules are
+// Validate checks the field values on ImportMembersRequest with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ListMemberAttributesRequest) Validate() error {
+func (m *ImportMembersRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ListMemberAttributesRequest with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on ImportMembersRequest with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ListMemberAttributesRequestMultiError, or nil if none found.
-func (m *ListMemberAttributesRequest) ValidateAll() error {
+// ImportMembersRequestMultiError, or nil if none found.
+func (m *ImportMembersRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ListMemberAttributesRequest) validate(all bool) error {
+func (m *ImportMembersRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for PageSize
+	// no validation rules for Csv
 
-	// no validation rules for PageToken
+	// no validation rules for ColumnMapping
 
-	// no validation rules for SortBy
+	// no validation rules for Delimiter
 
-	// no validation rules for SortDirection
+	// no validation rules for TagSeparator
+
+	// no validation rules for DryRun
 
 	if len(errors) > 0 {
-		return ListMemberAttributesRequestMultiError(errors)
+		return ImportMembersRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// ListMemberAttributesRequestMultiError is an error wrapping multiple
-// validation errors returned by ListMemberAttributesRequest.ValidateAll() if
-// the designated constraints aren't met.
-type ListMemberAttributesRequestMultiError []error
+// ImportMembersRequestMultiError is an error wrapping multiple validation
+// errors returned by ImportMembersRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ImportMembersRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ListMemberAttributesRequestMultiError) Error() string {
+func (m ImportMembersRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1932,12 +2050,11 @@ func (m ListMemberAttributesRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ListMemberAttributesRequestMultiError) AllErrors() []error { return m }
+func (m ImportMembersRequestMultiError) AllErrors() []error { return m }
 
-// ListMemberAttributesRequestValidationError is the validation error returned
-// by ListMemberAttributesRequest.Validate if the designated constraints
-// aren't met.
-type ListMemberAttributesRequestValidationError struct {
+// ImportMembersRequestValidationError is the validation error returned by
+// ImportMembersRequest.Validate if the designated constraints aren't met.
+type ImportMembersRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1945,24 +2062,24 @@ type ListMemberAttributesRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListMemberAttributesRequestValidationError) Field() string { return e.field }
+func (e ImportMembersRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListMemberAttributesRequestValidationError) Reason() string { return e.reason }
+func (e ImportMembersRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListMemberAttributesRequestValidationError) Cause() error { return e.cause }
+func (e ImportMembersRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListMemberAttributesRequestValidationError) Key() bool { return e.key }
+func (e ImportMembersRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListMemberAttributesRequestValidationError) ErrorName() string {
-	return "ListMemberAttributesRequestValidationError"
+func (e ImportMembersRequestValidationError) ErrorName() string {
+	return "ImportMembersRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ListMemberAttributesRequestValidationError) Error() string {
+func (e ImportMembersRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1974,14 +2091,14 @@ func (e ListMemberAttributesRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListMemberAttributesRequest.%s: %s%s",
+		"invalid %sImportMembersRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListMemberAttributesRequestValidationError{}
+var _ error = ImportMembersRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -1989,47 +2106,49 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListMemberAttributesRequestValidationError{}
+} = ImportMembersRequestValidationError{}
 
-// Validate checks the field values on ListMemberAttributesResponse with the
-// rules defined in the proto definition for this message. If any rules are
+// Validate checks the field values on ImportMembersResponse with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ListMemberAttributesResponse) Validate() error {
+func (m *ImportMembersResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ListMemberAttributesResponse with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on ImportMembersResponse with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ListMemberAttributesResponseMultiError, or nil if none found.
-func (m *ListMemberAttributesResponse) ValidateAll() error {
+// ImportMembersResponseMultiError, or nil if none found.
+func (m *ImportMembersResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ListMemberAttributesResponse) validate(all bool) error {
+func (m *ImportMembersResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetAttributes() {
+	// no validation rules for MemberCount
+
+	for idx, item := range m.GetViolations() {
 		_, _ = idx, item
 
 		if all {
 			switch v := interface{}(item).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, ListMemberAttributesResponseValidationError{
-						field:  fmt.Sprintf("Attributes[%v]", idx),
+					errors = append(errors, ImportMembersResponseValidationError{
+						field:  fmt.Sprintf("Violations[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
-					errors = append(errors, ListMemberAttributesResponseValidationError{
-						field:  fmt.Sprintf("Attributes[%v]", idx),
+					errors = append(errors, ImportMembersResponseValidationError{
+						field:  fmt.Sprintf("Violations[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
@@ -2037,8 +2156,8 @@ func (m *ListMemberAttributesResponse) validate(all bool) error {
 			}
 		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				return ListMemberAttributesResponseValidationError{
-					field:  fmt.Sprintf("Attributes[%v]", idx),
+				return ImportMembersResponseValidationError{
+					field:  fmt.Sprintf("Violations[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
 				}
@@ -2047,22 +2166,20 @@ func (m *ListMemberAttributesResponse) validate(all bool) error {
 
 	}
 
-	// no validation rules for NextPageToken
-
 	if len(errors) > 0 {
-		return ListMemberAttributesResponseMultiError(errors)
+		return ImportMembersResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// ListMemberAttributesResponseMultiError is an error wrapping multiple
-// validation errors returned by ListMemberAttributesResponse.ValidateAll() if
-// the designated constraints aren't met.
-type ListMemberAttributesResponseMultiError []error
+// ImportMembersResponseMultiError is an error wrapping multiple validation
+// errors returned by ImportMembersResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ImportMembersResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ListMemberAttributesResponseMultiError) Error() string {
+func (m ImportMembersResponseMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2071,12 +2188,11 @@ func (m ListMemberAttributesResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ListMemberAttributesResponseMultiError) AllErrors() []error { return m }
+func (m ImportMembersResponseMultiError) AllErrors() []error { return m }
 
-// ListMemberAttributesResponseValidationError is the validation error returned
-// by ListMemberAttributesResponse.Validate if the designated constraints
-// aren't met.
-type ListMemberAttributesResponseValidationError struct {
+// ImportMembersResponseValidationError is the validation error returned by
+// ImportMembersResponse.Validate if the designated constraints aren't met.
+type ImportMembersResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2084,24 +2200,24 @@ type ListMemberAttributesResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListMemberAttributesResponseValidationError) Field() string { return e.field }
+func (e ImportMembersResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListMemberAttributesResponseValidationError) Reason() string { return e.reason }
+func (e ImportMembersResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListMemberAttributesResponseValidationError) Cause() error { return e.cause }
+func (e ImportMembersResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListMemberAttributesResponseValidationError) Key() bool { return e.key }
+func (e ImportMembersResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListMemberAttributesResponseValidationError) ErrorName() string {
-	return "ListMemberAttributesResponseValidationError"
+func (e ImportMembersResponseValidationError) ErrorName() string {
+	return "ImportMembersResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ListMemberAttributesResponseValidationError) Error() string {
+func (e ImportMembersResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2113,14 +2229,14 @@ func (e ListMemberAttributesResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListMemberAttributesResponse.%s: %s%s",
+		"invalid %sImportMembersResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListMemberAttributesResponseValidationError{}
+var _ error = ImportMembersResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -2128,102 +2244,52 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListMemberAttributesResponseValidationError{}
+} = ImportMembersResponseValidationError{}
 
-// Validate checks the field values on UpdateMemberAttributeRequest with the
-// rules defined in the proto definition for this message. If any rules are
+// Validate checks the field values on MemberImportViolation with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *UpdateMemberAttributeRequest) Validate() error {
+func (m *MemberImportViolation) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on UpdateMemberAttributeRequest with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on MemberImportViolation with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// UpdateMemberAttributeRequestMultiError, or nil if none found.
-func (m *UpdateMemberAttributeRequest) ValidateAll() error {
+// MemberImportViolationMultiError, or nil if none found.
+func (m *MemberImportViolation) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *UpdateMemberAttributeRequest) validate(all bool) error {
+func (m *MemberImportViolation) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if all {
-		switch v := interface{}(m.GetAttribute()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, UpdateMemberAttributeRequestValidationError{
-					field:  "Attribute",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, UpdateMemberAttributeRequestValidationError{
-					field:  "Attribute",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetAttribute()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return UpdateMemberAttributeRequestValidationError{
-				field:  "Attribute",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
+	// no validation rules for Row
 
-	if all {
-		switch v := interface{}(m.GetFieldMask()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, UpdateMemberAttributeRequestValidationError{
-					field:  "FieldMask",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, UpdateMemberAttributeRequestValidationError{
-					field:  "FieldMask",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetFieldMask()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return UpdateMemberAttributeRequestValidationError{
-				field:  "FieldMask",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
+	// no validation rules for Column
+
+	// no validation rules for Field
+
+	// no validation rules for Description
 
 	if len(errors) > 0 {
-		return UpdateMemberAttributeRequestMultiError(errors)
+		return MemberImportViolationMultiError(errors)
 	}
 
 	return nil
 }
 
-// UpdateMemberAttributeRequestMultiError is an error wrapping multiple
-// validation errors returned by UpdateMemberAttributeRequest.ValidateAll() if
-// the designated constraints aren't met.
-type UpdateMemberAttributeRequestMultiError []error
+// MemberImportViolationMultiError is an error wrapping multiple validation
+// errors returned by MemberImportViolation.ValidateAll() if the designated
+// constraints aren't met.
+type MemberImportViolationMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m UpdateMemberAttributeRequestMultiError) Error() string {
+func (m MemberImportViolationMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2232,12 +2298,11 @@ func (m UpdateMemberAttributeRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m UpdateMemberAttributeRequestMultiError) AllErrors() []error { return m }
+func (m MemberImportViolationMultiError) AllErrors() []error { return m }
 
-// UpdateMemberAttributeRequestValidationError is the validation error returned
-// by UpdateMemberAttributeRequest.Validate if the designated constraints
-// aren't met.
-type UpdateMemberAttributeRequestValidationError struct {
+// MemberImportViolationValidationError is the validation error returned by
+// MemberImportViolation.Validate if the designated constraints aren't met.
+type MemberImportViolationValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2245,24 +2310,24 @@ type UpdateMemberAttributeRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e UpdateMemberAttributeRequestValidationError) Field() string { return e.field }
+func (e MemberImportViolationValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UpdateMemberAttributeRequestValidationError) Reason() string { return e.reason }
+func (e MemberImportViolationValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UpdateMemberAttributeRequestValidationError) Cause() error { return e.cause }
+func (e MemberImportViolationValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UpdateMemberAttributeRequestValidationError) Key() bool { return e.key }
+func (e MemberImportViolationValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UpdateMemberAttributeRequestValidationError) ErrorName() string {
-	return "UpdateMemberAttributeRequestValidationError"
+func (e MemberImportViolationValidationError) ErrorName() string {
+	return "MemberImportViolationValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e UpdateMemberAttributeRequestValidationError) Error() string {
+func (e MemberImportViolationValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2274,14 +2339,14 @@ func (e UpdateMemberAttributeRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUpdateMemberAttributeRequest.%s: %s%s",
+		"invalid %sMemberImportViolation.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UpdateMemberAttributeRequestValidationError{}
+var _ error = MemberImportViolationValidationError{}
 
 var _ interface {
 	Field() string
@@ -2289,46 +2354,48 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UpdateMemberAttributeRequestValidationError{}
+} = MemberImportViolationValidationError{}
 
-// Validate checks the field values on DeleteMemberAttributeRequest with the
-// rules defined in the proto definition for this message. If any rules are
+// Validate checks the field values on ListMemberTagsRequest with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *DeleteMemberAttributeRequest) Validate() error {
+func (m *ListMemberTagsRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on DeleteMemberAttributeRequest with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on ListMemberTagsRequest with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// DeleteMemberAttributeRequestMultiError, or nil if none found.
-func (m *DeleteMemberAttributeRequest) ValidateAll() error {
+// ListMemberTagsRequestMultiError, or nil if none found.
+func (m *ListMemberTagsRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *DeleteMemberAttributeRequest) validate(all bool) error {
+func (m *ListMemberTagsRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Id
+	// no validation rules for PageSize
+
+	// no validation rules for PageToken
 
 	if len(errors) > 0 {
-		return DeleteMemberAttributeRequestMultiError(errors)
+		return ListMemberTagsRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// DeleteMemberAttributeRequestMultiError is an error wrapping multiple
-// validation errors returned by DeleteMemberAttributeRequest.ValidateAll() if
-// the designated constraints aren't met.
-type DeleteMemberAttributeRequestMultiError []error
+// ListMemberTagsRequestMultiError is an error wrapping multiple validation
+// errors returned by ListMemberTagsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListMemberTagsRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m DeleteMemberAttributeRequestMultiError) Error() string {
+func (m ListMemberTagsRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2337,12 +2404,11 @@ func (m DeleteMemberAttributeRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m DeleteMemberAttributeRequestMultiError) AllErrors() []error { return m }
+func (m ListMemberTagsRequestMultiError) AllErrors() []error { return m }
 
-// DeleteMemberAttributeRequestValidationError is the validation error returned
-// by DeleteMemberAttributeRequest.Validate if the designated constraints
-// aren't met.
-type DeleteMemberAttributeRequestValidationError struct {
+// ListMemberTagsRequestValidationError is the validation error returned by
+// ListMemberTagsRequest.Validate if the designated constraints aren't met.
+type ListMemberTagsRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2350,24 +2416,24 @@ type DeleteMemberAttributeRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e DeleteMemberAttributeRequestValidationError) Field() string { return e.field }
+func (e ListMemberTagsRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DeleteMemberAttributeRequestValidationError) Reason() string { return e.reason }
+func (e ListMemberTagsRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DeleteMemberAttributeRequestValidationError) Cause() error { return e.cause }
+func (e ListMemberTagsRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DeleteMemberAttributeRequestValidationError) Key() bool { return e.key }
+func (e ListMemberTagsRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DeleteMemberAttributeRequestValidationError) ErrorName() string {
-	return "DeleteMemberAttributeRequestValidationError"
+func (e ListMemberTagsRequestValidationError) ErrorName() string {
+	return "ListMemberTagsRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e DeleteMemberAttributeRequestValidationError) Error() string {
+func (e ListMemberTagsRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2379,14 +2445,14 @@ func (e DeleteMemberAttributeRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDeleteMemberAttributeRequest.%s: %s%s",
+		"invalid %sListMemberTagsRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DeleteMemberAttributeRequestValidationError{}
+var _ error = ListMemberTagsRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -2394,54 +2460,80 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DeleteMemberAttributeRequestValidationError{}
+} = ListMemberTagsRequestValidationError{}
 
-// Validate checks the field values on MemberAttribute with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *MemberAttribute) Validate() error {
+// Validate checks the field values on ListMemberTagsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListMemberTagsResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on MemberAttribute with the rules
+// ValidateAll checks the field values on ListMemberTagsResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// MemberAttributeMultiError, or nil if none found.
-func (m *MemberAttribute) ValidateAll() error {
+// ListMemberTagsResponseMultiError, or nil if none found.
+func (m *ListMemberTagsResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *MemberAttribute) validate(all bool) error {
+func (m *ListMemberTagsResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Id
-
-	// no validation rules for TechnicalName
+	// no validation rules for NextPageToken
 
-	// no validation rules for DisplayName
+	for idx, item := range m.GetMemberTags() {
+		_, _ = idx, item
 
-	// no validation rules for Type
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListMemberTagsResponseValidationError{
+						field:  fmt.Sprintf("MemberTags[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListMemberTagsResponseValidationError{
+						field:  fmt.Sprintf("MemberTags[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListMemberTagsResponseValidationError{
+					field:  fmt.Sprintf("MemberTags[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
 
-	// no validation rules for Description
+	}
 
 	if len(errors) > 0 {
-		return MemberAttributeMultiError(errors)
+		return ListMemberTagsResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// MemberAttributeMultiError is an error wrapping multiple validation errors
-// returned by MemberAttribute.ValidateAll() if the designated constraints
-// aren't met.
-type MemberAttributeMultiError []error
+// ListMemberTagsResponseMultiError is an error wrapping multiple validation
+// errors returned by ListMemberTagsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ListMemberTagsResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m MemberAttributeMultiError) Error() string {
+func (m ListMemberTagsResponseMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2450,11 +2542,11 @@ func (m MemberAttributeMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m MemberAttributeMultiError) AllErrors() []error { return m }
+func (m ListMemberTagsResponseMultiError) AllErrors() []error { return m }
 
-// MemberAttributeValidationError is the validation error returned by
-// MemberAttribute.Validate if the designated constraints aren't met.
-type MemberAttributeValidationError struct {
+// ListMemberTagsResponseValidationError is the validation error returned by
+// ListMemberTagsResponse.Validate if the designated constraints aren't met.
+type ListMemberTagsResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2462,22 +2554,24 @@ type MemberAttributeValidationError struct {
 }
 
 // Field function returns field value.
-func (e MemberAttributeValidationError) Field() string { return e.field }
+func (e ListMemberTagsResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e MemberAttributeValidationError) Reason() string { return e.reason }
+func (e ListMemberTagsResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e MemberAttributeValidationError) Cause() error { return e.cause }
+func (e ListMemberTagsResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e MemberAttributeValidationError) Key() bool { return e.key }
+func (e ListMemberTagsResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e MemberAttributeValidationError) ErrorName() string { return "MemberAttributeValidationError" }
+func (e ListMemberTagsResponseValidationError) ErrorName() string {
+	return "ListMemberTagsResponseValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e MemberAttributeValidationError) Error() string {
+func (e ListMemberTagsResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2489,14 +2583,14 @@ func (e MemberAttributeValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sMemberAttribute.%s: %s%s",
+		"invalid %sListMemberTagsResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = MemberAttributeValidationError{}
+var _ error = ListMemberTagsResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -2504,52 +2598,51 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = MemberAttributeValidationError{}
+} = ListMemberTagsResponseValidationError{}
 
-// Validate checks the field values on MemberAttributePageToken with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *MemberAttributePageToken) Validate() error {
+// Validate checks the field values on MemberTag with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *MemberTag) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on MemberAttributePageToken with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// MemberAttributePageTokenMultiError, or nil if none found.
-func (m *MemberAttributePageToken) ValidateAll() error {
+// ValidateAll checks the field values on MemberTag with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in MemberTagMultiError, or nil
+// if none found.
+func (m *MemberTag) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *MemberAttributePageToken) validate(all bool) error {
+func (m *MemberTag) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Field
+	// no validation rules for Name
 
-	// no validation rules for LastValue
+	// no validation rules for Description
 
-	// no validation rules for Direction
+	// no validation rules for Color
 
-	// no validation rules for LastId
+	// no validation rules for MemberCount
 
 	if len(errors) > 0 {
-		return MemberAttributePageTokenMultiError(errors)
+		return MemberTagMultiError(errors)
 	}
 
 	return nil
 }
 
-// MemberAttributePageTokenMultiError is an error wrapping multiple validation
-// errors returned by MemberAttributePageToken.ValidateAll() if the designated
-// constraints aren't met.
-type MemberAttributePageTokenMultiError []error
+// MemberTagMultiError is an error wrapping multiple validation errors returned
+// by MemberTag.ValidateAll() if the designated constraints aren't met.
+type MemberTagMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m MemberAttributePageTokenMultiError) Error() string {
+func (m MemberTagMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2558,11 +2651,11 @@ func (m MemberAttributePageTokenMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m MemberAttributePageTokenMultiError) AllErrors() []error { return m }
+func (m MemberTagMultiError) AllErrors() []error { return m }
 
-// MemberAttributePageTokenValidationError is the validation error returned by
-// MemberAttributePageToken.Validate if the designated constraints aren't met.
-type MemberAttributePageTokenValidationError struct {
+// MemberTagValidationError is the validation error returned by
+// MemberTag.Validate if the designated constraints aren't met.
+type MemberTagValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2570,24 +2663,22 @@ type MemberAttributePageTokenValidationError struct {
 }
 
 // Field function returns field value.
-func (e MemberAttributePageTokenValidationError) Field() string { return e.field }
+func (e MemberTagValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e MemberAttributePageTokenValidationError) Reason() string { return e.reason }
+func (e MemberTagValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e MemberAttributePageTokenValidationError) Cause() error { return e.cause }
+func (e MemberTagValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e MemberAttributePageTokenValidationError) Key() bool { return e.key }
+func (e MemberTagValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e MemberAttributePageTokenValidationError) ErrorName() string {
-	return "MemberAttributePageTokenValidationError"
-}
+func (e MemberTagValidationError) ErrorName() string { return "MemberTagValidationError" }
 
 // Error satisfies the builtin error interface
-func (e MemberAttributePageTokenValidationError) Error() string {
+func (e MemberTagValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2599,14 +2690,14 @@ func (e MemberAttributePageTokenValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sMemberAttributePageToken.%s: %s%s",
+		"invalid %sMemberTag.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = MemberAttributePageTokenValidationError{}
+var _ error = MemberTagValidationError{}
 
 var _ interface {
 	Field() string
@@ -2614,87 +2705,53 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = MemberAttributePageTokenValidationError{}
+} = MemberTagValidationError{}
 
-// Validate checks the field values on Card with the rules defined in the proto
-// definition for this message. If any rules are violated, the first error
-// encountered is returned, or nil if there are no violations.
-func (m *Card) Validate() error {
+// Validate checks the field values on CreateMemberTagRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateMemberTagRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on Card with the rules defined in the
-// proto definition for this message. If any rules are violated, the result is
-// a list of violation errors wrapped in CardMultiError, or nil if none found.
-func (m *Card) ValidateAll() error {
+// ValidateAll checks the field values on CreateMemberTagRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateMemberTagRequestMultiError, or nil if none found.
+func (m *CreateMemberTagRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *Card) validate(all bool) error {
+func (m *CreateMemberTagRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Id
-
-	// no validation rules for MemberId
-
-	// no validation rules for RfidValue
-
-	if all {
-		switch v := interface{}(m.GetValidFrom()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, CardValidationError{
-					field:  "ValidFrom",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, CardValidationError{
-					field:  "ValidFrom",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetValidFrom()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return CardValidationError{
-				field:  "ValidFrom",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
-
 	if all {
-		switch v := interface{}(m.GetValidTo()).(type) {
+		switch v := interface{}(m.GetTag()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, CardValidationError{
-					field:  "ValidTo",
+				errors = append(errors, CreateMemberTagRequestValidationError{
+					field:  "Tag",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, CardValidationError{
-					field:  "ValidTo",
+				errors = append(errors, CreateMemberTagRequestValidationError{
+					field:  "Tag",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetValidTo()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetTag()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return CardValidationError{
-				field:  "ValidTo",
+			return CreateMemberTagRequestValidationError{
+				field:  "Tag",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
@@ -2702,18 +2759,19 @@ func (m *Card) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return CardMultiError(errors)
+		return CreateMemberTagRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// CardMultiError is an error wrapping multiple validation errors returned by
-// Card.ValidateAll() if the designated constraints aren't met.
-type CardMultiError []error
+// CreateMemberTagRequestMultiError is an error wrapping multiple validation
+// errors returned by CreateMemberTagRequest.ValidateAll() if the designated
+// constraints aren't met.
+type CreateMemberTagRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CardMultiError) Error() string {
+func (m CreateMemberTagRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2722,11 +2780,11 @@ func (m CardMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CardMultiError) AllErrors() []error { return m }
+func (m CreateMemberTagRequestMultiError) AllErrors() []error { return m }
 
-// CardValidationError is the validation error returned by Card.Validate if the
-// designated constraints aren't met.
-type CardValidationError struct {
+// CreateMemberTagRequestValidationError is the validation error returned by
+// CreateMemberTagRequest.Validate if the designated constraints aren't met.
+type CreateMemberTagRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2734,22 +2792,24 @@ type CardValidationError struct {
 }
 
 // Field function returns field value.
-func (e CardValidationError) Field() string { return e.field }
+func (e CreateMemberTagRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CardValidationError) Reason() string { return e.reason }
+func (e CreateMemberTagRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CardValidationError) Cause() error { return e.cause }
+func (e CreateMemberTagRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CardValidationError) Key() bool { return e.key }
+func (e CreateMemberTagRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CardValidationError) ErrorName() string { return "CardValidationError" }
+func (e CreateMemberTagRequestValidationError) ErrorName() string {
+	return "CreateMemberTagRequestValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e CardValidationError) Error() string {
+func (e CreateMemberTagRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2761,14 +2821,14 @@ func (e CardValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCard.%s: %s%s",
+		"invalid %sCreateMemberTagRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CardValidationError{}
+var _ error = CreateMemberTagRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -2776,52 +2836,102 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CardValidationError{}
+} = CreateMemberTagRequestValidationError{}
 
-// Validate checks the field values on CardPageToken with the rules defined in
-// the proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *CardPageToken) Validate() error {
+// Validate checks the field values on UpdateMemberTagRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateMemberTagRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CardPageToken with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in CardPageTokenMultiError, or
-// nil if none found.
-func (m *CardPageToken) ValidateAll() error {
+// ValidateAll checks the field values on UpdateMemberTagRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateMemberTagRequestMultiError, or nil if none found.
+func (m *UpdateMemberTagRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CardPageToken) validate(all bool) error {
+func (m *UpdateMemberTagRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Field
-
-	// no validation rules for LastValue
-
-	// no validation rules for Direction
-
-	// no validation rules for LastId
-
-	if len(errors) > 0 {
-		return CardPageTokenMultiError(errors)
+	if all {
+		switch v := interface{}(m.GetTag()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateMemberTagRequestValidationError{
+					field:  "Tag",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateMemberTagRequestValidationError{
+					field:  "Tag",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTag()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateMemberTagRequestValidationError{
+				field:  "Tag",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
 	}
 
-	return nil
-}
-
-// CardPageTokenMultiError is an error wrapping multiple validation errors
-// returned by CardPageToken.ValidateAll() if the designated constraints
-// aren't met.
-type CardPageTokenMultiError []error
+	if all {
+		switch v := interface{}(m.GetFieldMask()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateMemberTagRequestValidationError{
+					field:  "FieldMask",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateMemberTagRequestValidationError{
+					field:  "FieldMask",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetFieldMask()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateMemberTagRequestValidationError{
+				field:  "FieldMask",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return UpdateMemberTagRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateMemberTagRequestMultiError is an error wrapping multiple validation
+// errors returned by UpdateMemberTagRequest.ValidateAll() if the designated
+// constraints aren't met.
+type UpdateMemberTagRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CardPageTokenMultiError) Error() string {
+func (m UpdateMemberTagRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2830,11 +2940,11 @@ func (m CardPageTokenMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CardPageTokenMultiError) AllErrors() []error { return m }
+func (m UpdateMemberTagRequestMultiError) AllErrors() []error { return m }
 
-// CardPageTokenValidationError is the validation error returned by
-// CardPageToken.Validate if the designated constraints aren't met.
-type CardPageTokenValidationError struct {
+// UpdateMemberTagRequestValidationError is the validation error returned by
+// UpdateMemberTagRequest.Validate if the designated constraints aren't met.
+type UpdateMemberTagRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2842,22 +2952,24 @@ type CardPageTokenValidationError struct {
 }
 
 // Field function returns field value.
-func (e CardPageTokenValidationError) Field() string { return e.field }
+func (e UpdateMemberTagRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CardPageTokenValidationError) Reason() string { return e.reason }
+func (e UpdateMemberTagRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CardPageTokenValidationError) Cause() error { return e.cause }
+func (e UpdateMemberTagRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CardPageTokenValidationError) Key() bool { return e.key }
+func (e UpdateMemberTagRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CardPageTokenValidationError) ErrorName() string { return "CardPageTokenValidationError" }
+func (e UpdateMemberTagRequestValidationError) ErrorName() string {
+	return "UpdateMemberTagRequestValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e CardPageTokenValidationError) Error() string {
+func (e UpdateMemberTagRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2869,14 +2981,14 @@ func (e CardPageTokenValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCardPageToken.%s: %s%s",
+		"invalid %sUpdateMemberTagRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CardPageTokenValidationError{}
+var _ error = UpdateMemberTagRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -2884,75 +2996,48 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CardPageTokenValidationError{}
+} = UpdateMemberTagRequestValidationError{}
 
-// Validate checks the field values on CreateCardRequest with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *CreateCardRequest) Validate() error {
+// Validate checks the field values on DeleteMemberTagRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteMemberTagRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CreateCardRequest with the rules
+// ValidateAll checks the field values on DeleteMemberTagRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// CreateCardRequestMultiError, or nil if none found.
-func (m *CreateCardRequest) ValidateAll() error {
+// DeleteMemberTagRequestMultiError, or nil if none found.
+func (m *DeleteMemberTagRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CreateCardRequest) validate(all bool) error {
+func (m *DeleteMemberTagRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for CardId
+	// no validation rules for Name
 
-	if all {
-		switch v := interface{}(m.GetCard()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, CreateCardRequestValidationError{
-					field:  "Card",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, CreateCardRequestValidationError{
-					field:  "Card",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetCard()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return CreateCardRequestValidationError{
-				field:  "Card",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
+	// no validation rules for RemoveFromMembers
 
 	if len(errors) > 0 {
-		return CreateCardRequestMultiError(errors)
+		return DeleteMemberTagRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// CreateCardRequestMultiError is an error wrapping multiple validation errors
-// returned by CreateCardRequest.ValidateAll() if the designated constraints
-// aren't met.
-type CreateCardRequestMultiError []error
+// DeleteMemberTagRequestMultiError is an error wrapping multiple validation
+// errors returned by DeleteMemberTagRequest.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteMemberTagRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CreateCardRequestMultiError) Error() string {
+func (m DeleteMemberTagRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2961,11 +3046,11 @@ func (m CreateCardRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CreateCardRequestMultiError) AllErrors() []error { return m }
+func (m DeleteMemberTagRequestMultiError) AllErrors() []error { return m }
 
-// CreateCardRequestValidationError is the validation error returned by
-// CreateCardRequest.Validate if the designated constraints aren't met.
-type CreateCardRequestValidationError struct {
+// DeleteMemberTagRequestValidationError is the validation error returned by
+// DeleteMemberTagRequest.Validate if the designated constraints aren't met.
+type DeleteMemberTagRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2973,24 +3058,24 @@ type CreateCardRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e CreateCardRequestValidationError) Field() string { return e.field }
+func (e DeleteMemberTagRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CreateCardRequestValidationError) Reason() string { return e.reason }
+func (e DeleteMemberTagRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CreateCardRequestValidationError) Cause() error { return e.cause }
+func (e DeleteMemberTagRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CreateCardRequestValidationError) Key() bool { return e.key }
+func (e DeleteMemberTagRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CreateCardRequestValidationError) ErrorName() string {
-	return "CreateCardRequestValidationError"
+func (e DeleteMemberTagRequestValidationError) ErrorName() string {
+	return "DeleteMemberTagRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e CreateCardRequestValidationError) Error() string {
+func (e DeleteMemberTagRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3002,14 +3087,14 @@ func (e CreateCardRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCreateCardRequest.%s: %s%s",
+		"invalid %sDeleteMemberTagRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CreateCardRequestValidationError{}
+var _ error = DeleteMemberTagRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -3017,46 +3102,48 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CreateCardRequestValidationError{}
+} = DeleteMemberTagRequestValidationError{}
 
-// Validate checks the field values on GetCardRequest with the rules defined in
-// the proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *GetCardRequest) Validate() error {
+// Validate checks the field values on RenameMemberTagRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RenameMemberTagRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetCardRequest with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in GetCardRequestMultiError,
-// or nil if none found.
-func (m *GetCardRequest) ValidateAll() error {
+// ValidateAll checks the field values on RenameMemberTagRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RenameMemberTagRequestMultiError, or nil if none found.
+func (m *RenameMemberTagRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetCardRequest) validate(all bool) error {
+func (m *RenameMemberTagRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Id
+	// no validation rules for Name
+
+	// no validation rules for NewName
 
 	if len(errors) > 0 {
-		return GetCardRequestMultiError(errors)
+		return RenameMemberTagRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetCardRequestMultiError is an error wrapping multiple validation errors
-// returned by GetCardRequest.ValidateAll() if the designated constraints
-// aren't met.
-type GetCardRequestMultiError []error
+// RenameMemberTagRequestMultiError is an error wrapping multiple validation
+// errors returned by RenameMemberTagRequest.ValidateAll() if the designated
+// constraints aren't met.
+type RenameMemberTagRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetCardRequestMultiError) Error() string {
+func (m RenameMemberTagRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3065,11 +3152,11 @@ func (m GetCardRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetCardRequestMultiError) AllErrors() []error { return m }
+func (m RenameMemberTagRequestMultiError) AllErrors() []error { return m }
 
-// GetCardRequestValidationError is the validation error returned by
-// GetCardRequest.Validate if the designated constraints aren't met.
-type GetCardRequestValidationError struct {
+// RenameMemberTagRequestValidationError is the validation error returned by
+// RenameMemberTagRequest.Validate if the designated constraints aren't met.
+type RenameMemberTagRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3077,22 +3164,24 @@ type GetCardRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetCardRequestValidationError) Field() string { return e.field }
+func (e RenameMemberTagRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetCardRequestValidationError) Reason() string { return e.reason }
+func (e RenameMemberTagRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetCardRequestValidationError) Cause() error { return e.cause }
+func (e RenameMemberTagRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetCardRequestValidationError) Key() bool { return e.key }
+func (e RenameMemberTagRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetCardRequestValidationError) ErrorName() string { return "GetCardRequestValidationError" }
+func (e RenameMemberTagRequestValidationError) ErrorName() string {
+	return "RenameMemberTagRequestValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e GetCardRequestValidationError) Error() string {
+func (e RenameMemberTagRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3104,14 +3193,14 @@ func (e GetCardRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetCardRequest.%s: %s%s",
+		"invalid %sRenameMemberTagRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetCardRequestValidationError{}
+var _ error = RenameMemberTagRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -3119,85 +3208,46 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetCardRequestValidationError{}
+} = RenameMemberTagRequestValidationError{}
 
-// Validate checks the field values on ListCardsRequest with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *ListCardsRequest) Validate() error {
+// Validate checks the field values on MergeMemberTagsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *MergeMemberTagsRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ListCardsRequest with the rules
+// ValidateAll checks the field values on MergeMemberTagsRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ListCardsRequestMultiError, or nil if none found.
-func (m *ListCardsRequest) ValidateAll() error {
+// MergeMemberTagsRequestMultiError, or nil if none found.
+func (m *MergeMemberTagsRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ListCardsRequest) validate(all bool) error {
+func (m *MergeMemberTagsRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for PageSize
-
-	// no validation rules for PageToken
-
-	// no validation rules for SortBy
-
-	// no validation rules for SortDirection
-
-	// no validation rules for MemberId
-
-	if all {
-		switch v := interface{}(m.GetValidOn()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, ListCardsRequestValidationError{
-					field:  "ValidOn",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, ListCardsRequestValidationError{
-					field:  "ValidOn",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetValidOn()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return ListCardsRequestValidationError{
-				field:  "ValidOn",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
-
-	// no validation rules for RfidValue
+	// no validation rules for Name
 
 	if len(errors) > 0 {
-		return ListCardsRequestMultiError(errors)
+		return MergeMemberTagsRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// ListCardsRequestMultiError is an error wrapping multiple validation errors
-// returned by ListCardsRequest.ValidateAll() if the designated constraints
-// aren't met.
-type ListCardsRequestMultiError []error
+// MergeMemberTagsRequestMultiError is an error wrapping multiple validation
+// errors returned by MergeMemberTagsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type MergeMemberTagsRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ListCardsRequestMultiError) Error() string {
+func (m MergeMemberTagsRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3206,11 +3256,11 @@ func (m ListCardsRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ListCardsRequestMultiError) AllErrors() []error { return m }
+func (m MergeMemberTagsRequestMultiError) AllErrors() []error { return m }
 
-// ListCardsRequestValidationError is the validation error returned by
-// ListCardsRequest.Validate if the designated constraints aren't met.
-type ListCardsRequestValidationError struct {
+// MergeMemberTagsRequestValidationError is the validation error returned by
+// MergeMemberTagsRequest.Validate if the designated constraints aren't met.
+type MergeMemberTagsRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3218,22 +3268,24 @@ type ListCardsRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListCardsRequestValidationError) Field() string { return e.field }
+func (e MergeMemberTagsRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListCardsRequestValidationError) Reason() string { return e.reason }
+func (e MergeMemberTagsRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListCardsRequestValidationError) Cause() error { return e.cause }
+func (e MergeMemberTagsRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListCardsRequestValidationError) Key() bool { return e.key }
+func (e MergeMemberTagsRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListCardsRequestValidationError) ErrorName() string { return "ListCardsRequestValidationError" }
+func (e MergeMemberTagsRequestValidationError) ErrorName() string {
+	return "MergeMemberTagsRequestValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e ListCardsRequestValidationError) Error() string {
+func (e MergeMemberTagsRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3245,14 +3297,14 @@ func (e ListCardsRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListCardsRequest.%s: %s%s",
+		"invalid %sMergeMemberTagsRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListCardsRequestValidationError{}
+var _ error = MergeMemberTagsRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -3260,80 +3312,46 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListCardsRequestValidationError{}
+} = MergeMemberTagsRequestValidationError{}
 
-// Validate checks the field values on ListCardsResponse with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *ListCardsResponse) Validate() error {
+// Validate checks the field values on MemberTagsPageToken with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *MemberTagsPageToken) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ListCardsResponse with the rules
+// ValidateAll checks the field values on MemberTagsPageToken with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ListCardsResponseMultiError, or nil if none found.
-func (m *ListCardsResponse) ValidateAll() error {
+// MemberTagsPageTokenMultiError, or nil if none found.
+func (m *MemberTagsPageToken) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ListCardsResponse) validate(all bool) error {
+func (m *MemberTagsPageToken) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetCards() {
-		_, _ = idx, item
-
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, ListCardsResponseValidationError{
-						field:  fmt.Sprintf("Cards[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, ListCardsResponseValidationError{
-						field:  fmt.Sprintf("Cards[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return ListCardsResponseValidationError{
-					field:  fmt.Sprintf("Cards[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
-
-	}
-
-	// no validation rules for NextPageToken
+	// no validation rules for Offset
 
 	if len(errors) > 0 {
-		return ListCardsResponseMultiError(errors)
+		return MemberTagsPageTokenMultiError(errors)
 	}
 
 	return nil
 }
 
-// ListCardsResponseMultiError is an error wrapping multiple validation errors
-// returned by ListCardsResponse.ValidateAll() if the designated constraints
-// aren't met.
-type ListCardsResponseMultiError []error
+// MemberTagsPageTokenMultiError is an error wrapping multiple validation
+// errors returned by MemberTagsPageToken.ValidateAll() if the designated
+// constraints aren't met.
+type MemberTagsPageTokenMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ListCardsResponseMultiError) Error() string {
+func (m MemberTagsPageTokenMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3342,11 +3360,11 @@ func (m ListCardsResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ListCardsResponseMultiError) AllErrors() []error { return m }
+func (m MemberTagsPageTokenMultiError) AllErrors() []error { return m }
 
-// ListCardsResponseValidationError is the validation error returned by
-// ListCardsResponse.Validate if the designated constraints aren't met.
-type ListCardsResponseValidationError struct {
+// MemberTagsPageTokenValidationError is the validation error returned by
+// MemberTagsPageToken.Validate if the designated constraints aren't met.
+type MemberTagsPageTokenValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3354,24 +3372,24 @@ type ListCardsResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListCardsResponseValidationError) Field() string { return e.field }
+func (e MemberTagsPageTokenValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListCardsResponseValidationError) Reason() string { return e.reason }
+func (e MemberTagsPageTokenValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListCardsResponseValidationError) Cause() error { return e.cause }
+func (e MemberTagsPageTokenValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListCardsResponseValidationError) Key() bool { return e.key }
+func (e MemberTagsPageTokenValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListCardsResponseValidationError) ErrorName() string {
-	return "ListCardsResponseValidationError"
+func (e MemberTagsPageTokenValidationError) ErrorName() string {
+	return "MemberTagsPageTokenValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ListCardsResponseValidationError) Error() string {
+func (e MemberTagsPageTokenValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3383,14 +3401,14 @@ func (e ListCardsResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListCardsResponse.%s: %s%s",
+		"invalid %sMemberTagsPageToken.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListCardsResponseValidationError{}
+var _ error = MemberTagsPageTokenValidationError{}
 
 var _ interface {
 	Field() string
@@ -3398,24 +3416,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListCardsResponseValidationError{}
+} = MemberTagsPageTokenValidationError{}
 
-// Validate checks the field values on UpdateCardRequest with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *UpdateCardRequest) Validate() error {
+// Validate checks the field values on CreateMemberAttributeRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateMemberAttributeRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on UpdateCardRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on CreateMemberAttributeRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// UpdateCardRequestMultiError, or nil if none found.
-func (m *UpdateCardRequest) ValidateAll() error {
+// CreateMemberAttributeRequestMultiError, or nil if none found.
+func (m *CreateMemberAttributeRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *UpdateCardRequest) validate(all bool) error {
+func (m *CreateMemberAttributeRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -3423,57 +3441,28 @@ func (m *UpdateCardRequest) validate(all bool) error {
 	var errors []error
 
 	if all {
-		switch v := interface{}(m.GetCard()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, UpdateCardRequestValidationError{
-					field:  "Card",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, UpdateCardRequestValidationError{
-					field:  "Card",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetCard()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return UpdateCardRequestValidationError{
-				field:  "Card",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
-
-	if all {
-		switch v := interface{}(m.GetFieldMask()).(type) {
+		switch v := interface{}(m.GetAttribute()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, UpdateCardRequestValidationError{
-					field:  "FieldMask",
+				errors = append(errors, CreateMemberAttributeRequestValidationError{
+					field:  "Attribute",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, UpdateCardRequestValidationError{
-					field:  "FieldMask",
+				errors = append(errors, CreateMemberAttributeRequestValidationError{
+					field:  "Attribute",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetFieldMask()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetAttribute()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return UpdateCardRequestValidationError{
-				field:  "FieldMask",
+			return CreateMemberAttributeRequestValidationError{
+				field:  "Attribute",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
@@ -3481,19 +3470,19 @@ func (m *UpdateCardRequest) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return UpdateCardRequestMultiError(errors)
+		return CreateMemberAttributeRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// UpdateCardRequestMultiError is an error wrapping multiple validation errors
-// returned by UpdateCardRequest.ValidateAll() if the designated constraints
-// aren't met.
-type UpdateCardRequestMultiError []error
+// CreateMemberAttributeRequestMultiError is an error wrapping multiple
+// validation errors returned by CreateMemberAttributeRequest.ValidateAll() if
+// the designated constraints aren't met.
+type CreateMemberAttributeRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m UpdateCardRequestMultiError) Error() string {
+func (m CreateMemberAttributeRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3502,11 +3491,12 @@ func (m UpdateCardRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m UpdateCardRequestMultiError) AllErrors() []error { return m }
+func (m CreateMemberAttributeRequestMultiError) AllErrors() []error { return m }
 
-// UpdateCardRequestValidationError is the validation error returned by
-// UpdateCardRequest.Validate if the designated constraints aren't met.
-type UpdateCardRequestValidationError struct {
+// CreateMemberAttributeRequestValidationError is the validation error returned
+// by CreateMemberAttributeRequest.Validate if the designated constraints
+// aren't met.
+type CreateMemberAttributeRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3514,24 +3504,24 @@ type UpdateCardRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e UpdateCardRequestValidationError) Field() string { return e.field }
+func (e CreateMemberAttributeRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UpdateCardRequestValidationError) Reason() string { return e.reason }
+func (e CreateMemberAttributeRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UpdateCardRequestValidationError) Cause() error { return e.cause }
+func (e CreateMemberAttributeRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UpdateCardRequestValidationError) Key() bool { return e.key }
+func (e CreateMemberAttributeRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UpdateCardRequestValidationError) ErrorName() string {
-	return "UpdateCardRequestValidationError"
+func (e CreateMemberAttributeRequestValidationError) ErrorName() string {
+	return "CreateMemberAttributeRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e UpdateCardRequestValidationError) Error() string {
+func (e CreateMemberAttributeRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3543,14 +3533,14 @@ func (e UpdateCardRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUpdateCardRequest.%s: %s%s",
+		"invalid %sCreateMemberAttributeRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UpdateCardRequestValidationError{}
+var _ error = CreateMemberAttributeRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -3558,24 +3548,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UpdateCardRequestValidationError{}
+} = CreateMemberAttributeRequestValidationError{}
 
-// Validate checks the field values on DeleteCardRequest with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *DeleteCardRequest) Validate() error {
+// Validate checks the field values on GetMemberAttributeRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetMemberAttributeRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on DeleteCardRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on GetMemberAttributeRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// DeleteCardRequestMultiError, or nil if none found.
-func (m *DeleteCardRequest) ValidateAll() error {
+// GetMemberAttributeRequestMultiError, or nil if none found.
+func (m *GetMemberAttributeRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *DeleteCardRequest) validate(all bool) error {
+func (m *GetMemberAttributeRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -3585,19 +3575,19 @@ func (m *DeleteCardRequest) validate(all bool) error {
 	// no validation rules for Id
 
 	if len(errors) > 0 {
-		return DeleteCardRequestMultiError(errors)
+		return GetMemberAttributeRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// DeleteCardRequestMultiError is an error wrapping multiple validation errors
-// returned by DeleteCardRequest.ValidateAll() if the designated constraints
-// aren't met.
-type DeleteCardRequestMultiError []error
+// GetMemberAttributeRequestMultiError is an error wrapping multiple validation
+// errors returned by GetMemberAttributeRequest.ValidateAll() if the
+// designated constraints aren't met.
+type GetMemberAttributeRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m DeleteCardRequestMultiError) Error() string {
+func (m GetMemberAttributeRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3606,11 +3596,11 @@ func (m DeleteCardRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m DeleteCardRequestMultiError) AllErrors() []error { return m }
+func (m GetMemberAttributeRequestMultiError) AllErrors() []error { return m }
 
-// DeleteCardRequestValidationError is the validation error returned by
-// DeleteCardRequest.Validate if the designated constraints aren't met.
-type DeleteCardRequestValidationError struct {
+// GetMemberAttributeRequestValidationError is the validation error returned by
+// GetMemberAttributeRequest.Validate if the designated constraints aren't met.
+type GetMemberAttributeRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3618,24 +3608,24 @@ type DeleteCardRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e DeleteCardRequestValidationError) Field() string { return e.field }
+func (e GetMemberAttributeRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DeleteCardRequestValidationError) Reason() string { return e.reason }
+func (e GetMemberAttributeRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DeleteCardRequestValidationError) Cause() error { return e.cause }
+func (e GetMemberAttributeRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DeleteCardRequestValidationError) Key() bool { return e.key }
+func (e GetMemberAttributeRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DeleteCardRequestValidationError) ErrorName() string {
-	return "DeleteCardRequestValidationError"
+func (e GetMemberAttributeRequestValidationError) ErrorName() string {
+	return "GetMemberAttributeRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e DeleteCardRequestValidationError) Error() string {
+func (e GetMemberAttributeRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3647,14 +3637,14 @@ func (e DeleteCardRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDeleteCardRequest.%s: %s%s",
+		"invalid %sGetMemberAttributeRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DeleteCardRequestValidationError{}
+var _ error = GetMemberAttributeRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -3662,78 +3652,52 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DeleteCardRequestValidationError{}
+} = GetMemberAttributeRequestValidationError{}
 
-// Validate checks the field values on BriefingType with the rules defined in
-// the proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *BriefingType) Validate() error {
+// Validate checks the field values on ListMemberAttributesRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListMemberAttributesRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on BriefingType with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in BriefingTypeMultiError, or
-// nil if none found.
-func (m *BriefingType) ValidateAll() error {
+// ValidateAll checks the field values on ListMemberAttributesRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListMemberAttributesRequestMultiError, or nil if none found.
+func (m *ListMemberAttributesRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *BriefingType) validate(all bool) error {
+func (m *ListMemberAttributesRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Id
+	// no validation rules for PageSize
 
-	// no validation rules for DisplayName
+	// no validation rules for PageToken
 
-	// no validation rules for Description
+	// no validation rules for SortBy
 
-	if all {
-		switch v := interface{}(m.GetExpiresAfter()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, BriefingTypeValidationError{
-					field:  "ExpiresAfter",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, BriefingTypeValidationError{
-					field:  "ExpiresAfter",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetExpiresAfter()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return BriefingTypeValidationError{
-				field:  "ExpiresAfter",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
+	// no validation rules for SortDirection
 
 	if len(errors) > 0 {
-		return BriefingTypeMultiError(errors)
+		return ListMemberAttributesRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// BriefingTypeMultiError is an error wrapping multiple validation errors
-// returned by BriefingType.ValidateAll() if the designated constraints aren't met.
-type BriefingTypeMultiError []error
+// ListMemberAttributesRequestMultiError is an error wrapping multiple
+// validation errors returned by ListMemberAttributesRequest.ValidateAll() if
+// the designated constraints aren't met.
+type ListMemberAttributesRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m BriefingTypeMultiError) Error() string {
+func (m ListMemberAttributesRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3742,11 +3706,12 @@ func (m BriefingTypeMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m BriefingTypeMultiError) AllErrors() []error { return m }
+func (m ListMemberAttributesRequestMultiError) AllErrors() []error { return m }
 
-// BriefingTypeValidationError is the validation error returned by
-// BriefingType.Validate if the designated constraints aren't met.
-type BriefingTypeValidationError struct {
+// ListMemberAttributesRequestValidationError is the validation error returned
+// by ListMemberAttributesRequest.Validate if the designated constraints
+// aren't met.
+type ListMemberAttributesRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3754,22 +3719,24 @@ type BriefingTypeValidationError struct {
 }
 
 // Field function returns field value.
-func (e BriefingTypeValidationError) Field() string { return e.field }
+func (e ListMemberAttributesRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e BriefingTypeValidationError) Reason() string { return e.reason }
+func (e ListMemberAttributesRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e BriefingTypeValidationError) Cause() error { return e.cause }
+func (e ListMemberAttributesRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e BriefingTypeValidationError) Key() bool { return e.key }
+func (e ListMemberAttributesRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e BriefingTypeValidationError) ErrorName() string { return "BriefingTypeValidationError" }
+func (e ListMemberAttributesRequestValidationError) ErrorName() string {
+	return "ListMemberAttributesRequestValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e BriefingTypeValidationError) Error() string {
+func (e ListMemberAttributesRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3781,14 +3748,14 @@ func (e BriefingTypeValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sBriefingType.%s: %s%s",
+		"invalid %sListMemberAttributesRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = BriefingTypeValidationError{}
+var _ error = ListMemberAttributesRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -3796,75 +3763,80 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = BriefingTypeValidationError{}
+} = ListMemberAttributesRequestValidationError{}
 
-// Validate checks the field values on CreateBriefingTypeRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on ListMemberAttributesResponse with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *CreateBriefingTypeRequest) Validate() error {
+func (m *ListMemberAttributesResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CreateBriefingTypeRequest with the
+// ValidateAll checks the field values on ListMemberAttributesResponse with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// CreateBriefingTypeRequestMultiError, or nil if none found.
-func (m *CreateBriefingTypeRequest) ValidateAll() error {
+// ListMemberAttributesResponseMultiError, or nil if none found.
+func (m *ListMemberAttributesResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CreateBriefingTypeRequest) validate(all bool) error {
+func (m *ListMemberAttributesResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for BriefingTypeId
+	for idx, item := range m.GetAttributes() {
+		_, _ = idx, item
 
-	if all {
-		switch v := interface{}(m.GetBriefingType()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, CreateBriefingTypeRequestValidationError{
-					field:  "BriefingType",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListMemberAttributesResponseValidationError{
+						field:  fmt.Sprintf("Attributes[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListMemberAttributesResponseValidationError{
+						field:  fmt.Sprintf("Attributes[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
 			}
-		case interface{ Validate() error }:
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				errors = append(errors, CreateBriefingTypeRequestValidationError{
-					field:  "BriefingType",
+				return ListMemberAttributesResponseValidationError{
+					field:  fmt.Sprintf("Attributes[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetBriefingType()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return CreateBriefingTypeRequestValidationError{
-				field:  "BriefingType",
-				reason: "embedded message failed validation",
-				cause:  err,
+				}
 			}
 		}
+
 	}
 
+	// no validation rules for NextPageToken
+
 	if len(errors) > 0 {
-		return CreateBriefingTypeRequestMultiError(errors)
+		return ListMemberAttributesResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// CreateBriefingTypeRequestMultiError is an error wrapping multiple validation
-// errors returned by CreateBriefingTypeRequest.ValidateAll() if the
-// designated constraints aren't met.
-type CreateBriefingTypeRequestMultiError []error
+// ListMemberAttributesResponseMultiError is an error wrapping multiple
+// validation errors returned by ListMemberAttributesResponse.ValidateAll() if
+// the designated constraints aren't met.
+type ListMemberAttributesResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CreateBriefingTypeRequestMultiError) Error() string {
+func (m ListMemberAttributesResponseMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3873,11 +3845,12 @@ func (m CreateBriefingTypeRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CreateBriefingTypeRequestMultiError) AllErrors() []error { return m }
+func (m ListMemberAttributesResponseMultiError) AllErrors() []error { return m }
 
-// CreateBriefingTypeRequestValidationError is the validation error returned by
-// CreateBriefingTypeRequest.Validate if the designated constraints aren't met.
-type CreateBriefingTypeRequestValidationError struct {
+// ListMemberAttributesResponseValidationError is the validation error returned
+// by ListMemberAttributesResponse.Validate if the designated constraints
+// aren't met.
+type ListMemberAttributesResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3885,24 +3858,24 @@ type CreateBriefingTypeRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e CreateBriefingTypeRequestValidationError) Field() string { return e.field }
+func (e ListMemberAttributesResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CreateBriefingTypeRequestValidationError) Reason() string { return e.reason }
+func (e ListMemberAttributesResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CreateBriefingTypeRequestValidationError) Cause() error { return e.cause }
+func (e ListMemberAttributesResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CreateBriefingTypeRequestValidationError) Key() bool { return e.key }
+func (e ListMemberAttributesResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CreateBriefingTypeRequestValidationError) ErrorName() string {
-	return "CreateBriefingTypeRequestValidationError"
+func (e ListMemberAttributesResponseValidationError) ErrorName() string {
+	return "ListMemberAttributesResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e CreateBriefingTypeRequestValidationError) Error() string {
+func (e ListMemberAttributesResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3914,14 +3887,14 @@ func (e CreateBriefingTypeRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCreateBriefingTypeRequest.%s: %s%s",
+		"invalid %sListMemberAttributesResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CreateBriefingTypeRequestValidationError{}
+var _ error = ListMemberAttributesResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -3929,46 +3902,102 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CreateBriefingTypeRequestValidationError{}
+} = ListMemberAttributesResponseValidationError{}
 
-// Validate checks the field values on GetBriefingTypeRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on UpdateMemberAttributeRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetBriefingTypeRequest) Validate() error {
+func (m *UpdateMemberAttributeRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetBriefingTypeRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on UpdateMemberAttributeRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetBriefingTypeRequestMultiError, or nil if none found.
-func (m *GetBriefingTypeRequest) ValidateAll() error {
+// UpdateMemberAttributeRequestMultiError, or nil if none found.
+func (m *UpdateMemberAttributeRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetBriefingTypeRequest) validate(all bool) error {
+func (m *UpdateMemberAttributeRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Id
+	if all {
+		switch v := interface{}(m.GetAttribute()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateMemberAttributeRequestValidationError{
+					field:  "Attribute",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateMemberAttributeRequestValidationError{
+					field:  "Attribute",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAttribute()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateMemberAttributeRequestValidationError{
+				field:  "Attribute",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetFieldMask()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateMemberAttributeRequestValidationError{
+					field:  "FieldMask",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateMemberAttributeRequestValidationError{
+					field:  "FieldMask",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetFieldMask()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateMemberAttributeRequestValidationError{
+				field:  "FieldMask",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
 
 	if len(errors) > 0 {
-		return GetBriefingTypeRequestMultiError(errors)
+		return UpdateMemberAttributeRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetBriefingTypeRequestMultiError is an error wrapping multiple validation
-// errors returned by GetBriefingTypeRequest.ValidateAll() if the designated
-// constraints aren't met.
-type GetBriefingTypeRequestMultiError []error
+// UpdateMemberAttributeRequestMultiError is an error wrapping multiple
+// validation errors returned by UpdateMemberAttributeRequest.ValidateAll() if
+// the designated constraints aren't met.
+type UpdateMemberAttributeRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetBriefingTypeRequestMultiError) Error() string {
+func (m UpdateMemberAttributeRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3977,36 +4006,37 @@ func (m GetBriefingTypeRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetBriefingTypeRequestMultiError) AllErrors() []error { return m }
+func (m UpdateMemberAttributeRequestMultiError) AllErrors() []error { return m }
 
-// GetBriefingTypeRequestValidationError is the validation error returned by
-// GetBriefingTypeRequest.Validate if the designated constraints aren't met.
-type GetBriefingTypeRequestValidationError struct {
-	field  string
-	reason string
+// UpdateMemberAttributeRequestValidationError is the validation error returned
+// by UpdateMemberAttributeRequest.Validate if the designated constraints
+// aren't met.
+type UpdateMemberAttributeRequestValidationError struct {
+	field  string
+	reason string
 	cause  error
 	key    bool
 }
 
 // Field function returns field value.
-func (e GetBriefingTypeRequestValidationError) Field() string { return e.field }
+func (e UpdateMemberAttributeRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetBriefingTypeRequestValidationError) Reason() string { return e.reason }
+func (e UpdateMemberAttributeRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetBriefingTypeRequestValidationError) Cause() error { return e.cause }
+func (e UpdateMemberAttributeRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetBriefingTypeRequestValidationError) Key() bool { return e.key }
+func (e UpdateMemberAttributeRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetBriefingTypeRequestValidationError) ErrorName() string {
-	return "GetBriefingTypeRequestValidationError"
+func (e UpdateMemberAttributeRequestValidationError) ErrorName() string {
+	return "UpdateMemberAttributeRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetBriefingTypeRequestValidationError) Error() string {
+func (e UpdateMemberAttributeRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -4018,14 +4048,14 @@ func (e GetBriefingTypeRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetBriefingTypeRequest.%s: %s%s",
+		"invalid %sUpdateMemberAttributeRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetBriefingTypeRequestValidationError{}
+var _ error = UpdateMemberAttributeRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -4033,48 +4063,48 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetBriefingTypeRequestValidationError{}
+} = UpdateMemberAttributeRequestValidationError{}
 
-// Validate checks the field values on ListBriefingTypesRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on DeleteMemberAttributeRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ListBriefingTypesRequest) Validate() error {
+func (m *DeleteMemberAttributeRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ListBriefingTypesRequest with the
+// ValidateAll checks the field values on DeleteMemberAttributeRequest with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ListBriefingTypesRequestMultiError, or nil if none found.
-func (m *ListBriefingTypesRequest) ValidateAll() error {
+// DeleteMemberAttributeRequestMultiError, or nil if none found.
+func (m *DeleteMemberAttributeRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ListBriefingTypesRequest) validate(all bool) error {
+func (m *DeleteMemberAttributeRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for PageSize
+	// no validation rules for Id
 
-	// no validation rules for PageToken
+	// no validation rules for PurgeValues
 
 	if len(errors) > 0 {
-		return ListBriefingTypesRequestMultiError(errors)
+		return DeleteMemberAttributeRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// ListBriefingTypesRequestMultiError is an error wrapping multiple validation
-// errors returned by ListBriefingTypesRequest.ValidateAll() if the designated
-// constraints aren't met.
-type ListBriefingTypesRequestMultiError []error
+// DeleteMemberAttributeRequestMultiError is an error wrapping multiple
+// validation errors returned by DeleteMemberAttributeRequest.ValidateAll() if
+// the designated constraints aren't met.
+type DeleteMemberAttributeRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ListBriefingTypesRequestMultiError) Error() string {
+func (m DeleteMemberAttributeRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -4083,11 +4113,12 @@ func (m ListBriefingTypesRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ListBriefingTypesRequestMultiError) AllErrors() []error { return m }
+func (m DeleteMemberAttributeRequestMultiError) AllErrors() []error { return m }
 
-// ListBriefingTypesRequestValidationError is the validation error returned by
-// ListBriefingTypesRequest.Validate if the designated constraints aren't met.
-type ListBriefingTypesRequestValidationError struct {
+// DeleteMemberAttributeRequestValidationError is the validation error returned
+// by DeleteMemberAttributeRequest.Validate if the designated constraints
+// aren't met.
+type DeleteMemberAttributeRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -4095,24 +4126,24 @@ type ListBriefingTypesRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListBriefingTypesRequestValidationError) Field() string { return e.field }
+func (e DeleteMemberAttributeRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListBriefingTypesRequestValidationError) Reason() string { return e.reason }
+func (e DeleteMemberAttributeRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListBriefingTypesRequestValidationError) Cause() error { return e.cause }
+func (e DeleteMemberAttributeRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListBriefingTypesRequestValidationError) Key() bool { return e.key }
+func (e DeleteMemberAttributeRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListBriefingTypesRequestValidationError) ErrorName() string {
-	return "ListBriefingTypesRequestValidationError"
+func (e DeleteMemberAttributeRequestValidationError) ErrorName() string {
+	return "DeleteMemberAttributeRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ListBriefingTypesRequestValidationError) Error() string {
+func (e DeleteMemberAttributeRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -4124,14 +4155,14 @@ func (e ListBriefingTypesRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListBriefingTypesRequest.%s: %s%s",
+		"invalid %sDeleteMemberAttributeRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListBriefingTypesRequestValidationError{}
+var _ error = DeleteMemberAttributeRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -4139,80 +4170,54 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListBriefingTypesRequestValidationError{}
+} = DeleteMemberAttributeRequestValidationError{}
 
-// Validate checks the field values on ListBriefingTypesResponse with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on MigrateMemberAttributeRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ListBriefingTypesResponse) Validate() error {
+func (m *MigrateMemberAttributeRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ListBriefingTypesResponse with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// ListBriefingTypesResponseMultiError, or nil if none found.
-func (m *ListBriefingTypesResponse) ValidateAll() error {
+// ValidateAll checks the field values on MigrateMemberAttributeRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// MigrateMemberAttributeRequestMultiError, or nil if none found.
+func (m *MigrateMemberAttributeRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ListBriefingTypesResponse) validate(all bool) error {
+func (m *MigrateMemberAttributeRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetBriefingTypes() {
-		_, _ = idx, item
+	// no validation rules for Id
 
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, ListBriefingTypesResponseValidationError{
-						field:  fmt.Sprintf("BriefingTypes[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, ListBriefingTypesResponseValidationError{
-						field:  fmt.Sprintf("BriefingTypes[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return ListBriefingTypesResponseValidationError{
-					field:  fmt.Sprintf("BriefingTypes[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
+	// no validation rules for TechnicalName
 
-	}
+	// no validation rules for Type
 
-	// no validation rules for NextPageToken
+	// no validation rules for DropInvalidValues
+
+	// no validation rules for DryRun
 
 	if len(errors) > 0 {
-		return ListBriefingTypesResponseMultiError(errors)
+		return MigrateMemberAttributeRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// ListBriefingTypesResponseMultiError is an error wrapping multiple validation
-// errors returned by ListBriefingTypesResponse.ValidateAll() if the
-// designated constraints aren't met.
-type ListBriefingTypesResponseMultiError []error
+// MigrateMemberAttributeRequestMultiError is an error wrapping multiple
+// validation errors returned by MigrateMemberAttributeRequest.ValidateAll()
+// if the designated constraints aren't met.
+type MigrateMemberAttributeRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ListBriefingTypesResponseMultiError) Error() string {
+func (m MigrateMemberAttributeRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -4221,11 +4226,12 @@ func (m ListBriefingTypesResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ListBriefingTypesResponseMultiError) AllErrors() []error { return m }
+func (m MigrateMemberAttributeRequestMultiError) AllErrors() []error { return m }
 
-// ListBriefingTypesResponseValidationError is the validation error returned by
-// ListBriefingTypesResponse.Validate if the designated constraints aren't met.
-type ListBriefingTypesResponseValidationError struct {
+// MigrateMemberAttributeRequestValidationError is the validation error
+// returned by MigrateMemberAttributeRequest.Validate if the designated
+// constraints aren't met.
+type MigrateMemberAttributeRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -4233,24 +4239,24 @@ type ListBriefingTypesResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListBriefingTypesResponseValidationError) Field() string { return e.field }
+func (e MigrateMemberAttributeRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListBriefingTypesResponseValidationError) Reason() string { return e.reason }
+func (e MigrateMemberAttributeRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListBriefingTypesResponseValidationError) Cause() error { return e.cause }
+func (e MigrateMemberAttributeRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListBriefingTypesResponseValidationError) Key() bool { return e.key }
+func (e MigrateMemberAttributeRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListBriefingTypesResponseValidationError) ErrorName() string {
-	return "ListBriefingTypesResponseValidationError"
+func (e MigrateMemberAttributeRequestValidationError) ErrorName() string {
+	return "MigrateMemberAttributeRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ListBriefingTypesResponseValidationError) Error() string {
+func (e MigrateMemberAttributeRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -4262,14 +4268,14 @@ func (e ListBriefingTypesResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListBriefingTypesResponse.%s: %s%s",
+		"invalid %sMigrateMemberAttributeRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListBriefingTypesResponseValidationError{}
+var _ error = MigrateMemberAttributeRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -4277,24 +4283,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListBriefingTypesResponseValidationError{}
+} = MigrateMemberAttributeRequestValidationError{}
 
-// Validate checks the field values on UpdateBriefingTypeRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on MigrateMemberAttributeResponse with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *UpdateBriefingTypeRequest) Validate() error {
+func (m *MigrateMemberAttributeResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on UpdateBriefingTypeRequest with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// UpdateBriefingTypeRequestMultiError, or nil if none found.
-func (m *UpdateBriefingTypeRequest) ValidateAll() error {
+// ValidateAll checks the field values on MigrateMemberAttributeResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// MigrateMemberAttributeResponseMultiError, or nil if none found.
+func (m *MigrateMemberAttributeResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *UpdateBriefingTypeRequest) validate(all bool) error {
+func (m *MigrateMemberAttributeResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -4302,77 +4308,86 @@ func (m *UpdateBriefingTypeRequest) validate(all bool) error {
 	var errors []error
 
 	if all {
-		switch v := interface{}(m.GetBriefingType()).(type) {
+		switch v := interface{}(m.GetAttribute()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, UpdateBriefingTypeRequestValidationError{
-					field:  "BriefingType",
+				errors = append(errors, MigrateMemberAttributeResponseValidationError{
+					field:  "Attribute",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, UpdateBriefingTypeRequestValidationError{
-					field:  "BriefingType",
+				errors = append(errors, MigrateMemberAttributeResponseValidationError{
+					field:  "Attribute",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetBriefingType()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetAttribute()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return UpdateBriefingTypeRequestValidationError{
-				field:  "BriefingType",
+			return MigrateMemberAttributeResponseValidationError{
+				field:  "Attribute",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
 		}
 	}
 
-	if all {
-		switch v := interface{}(m.GetFieldMask()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, UpdateBriefingTypeRequestValidationError{
-					field:  "FieldMask",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
+	// no validation rules for MemberCount
+
+	for idx, item := range m.GetInvalidValues() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, MigrateMemberAttributeResponseValidationError{
+						field:  fmt.Sprintf("InvalidValues[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, MigrateMemberAttributeResponseValidationError{
+						field:  fmt.Sprintf("InvalidValues[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
 			}
-		case interface{ Validate() error }:
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				errors = append(errors, UpdateBriefingTypeRequestValidationError{
-					field:  "FieldMask",
+				return MigrateMemberAttributeResponseValidationError{
+					field:  fmt.Sprintf("InvalidValues[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetFieldMask()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return UpdateBriefingTypeRequestValidationError{
-				field:  "FieldMask",
-				reason: "embedded message failed validation",
-				cause:  err,
+				}
 			}
 		}
+
 	}
 
+	// no validation rules for Applied
+
 	if len(errors) > 0 {
-		return UpdateBriefingTypeRequestMultiError(errors)
+		return MigrateMemberAttributeResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// UpdateBriefingTypeRequestMultiError is an error wrapping multiple validation
-// errors returned by UpdateBriefingTypeRequest.ValidateAll() if the
-// designated constraints aren't met.
-type UpdateBriefingTypeRequestMultiError []error
+// MigrateMemberAttributeResponseMultiError is an error wrapping multiple
+// validation errors returned by MigrateMemberAttributeResponse.ValidateAll()
+// if the designated constraints aren't met.
+type MigrateMemberAttributeResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m UpdateBriefingTypeRequestMultiError) Error() string {
+func (m MigrateMemberAttributeResponseMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -4381,11 +4396,12 @@ func (m UpdateBriefingTypeRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m UpdateBriefingTypeRequestMultiError) AllErrors() []error { return m }
+func (m MigrateMemberAttributeResponseMultiError) AllErrors() []error { return m }
 
-// UpdateBriefingTypeRequestValidationError is the validation error returned by
-// UpdateBriefingTypeRequest.Validate if the designated constraints aren't met.
-type UpdateBriefingTypeRequestValidationError struct {
+// MigrateMemberAttributeResponseValidationError is the validation error
+// returned by MigrateMemberAttributeResponse.Validate if the designated
+// constraints aren't met.
+type MigrateMemberAttributeResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -4393,24 +4409,24 @@ type UpdateBriefingTypeRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e UpdateBriefingTypeRequestValidationError) Field() string { return e.field }
+func (e MigrateMemberAttributeResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UpdateBriefingTypeRequestValidationError) Reason() string { return e.reason }
+func (e MigrateMemberAttributeResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UpdateBriefingTypeRequestValidationError) Cause() error { return e.cause }
+func (e MigrateMemberAttributeResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UpdateBriefingTypeRequestValidationError) Key() bool { return e.key }
+func (e MigrateMemberAttributeResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UpdateBriefingTypeRequestValidationError) ErrorName() string {
-	return "UpdateBriefingTypeRequestValidationError"
+func (e MigrateMemberAttributeResponseValidationError) ErrorName() string {
+	return "MigrateMemberAttributeResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e UpdateBriefingTypeRequestValidationError) Error() string {
+func (e MigrateMemberAttributeResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -4422,14 +4438,14 @@ func (e UpdateBriefingTypeRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUpdateBriefingTypeRequest.%s: %s%s",
+		"invalid %sMigrateMemberAttributeResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UpdateBriefingTypeRequestValidationError{}
+var _ error = MigrateMemberAttributeResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -4437,46 +4453,52 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UpdateBriefingTypeRequestValidationError{}
+} = MigrateMemberAttributeResponseValidationError{}
 
-// Validate checks the field values on DeleteBriefingTypeRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on MemberAttributeMigrationIssue with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *DeleteBriefingTypeRequest) Validate() error {
+func (m *MemberAttributeMigrationIssue) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on DeleteBriefingTypeRequest with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// DeleteBriefingTypeRequestMultiError, or nil if none found.
-func (m *DeleteBriefingTypeRequest) ValidateAll() error {
+// ValidateAll checks the field values on MemberAttributeMigrationIssue with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// MemberAttributeMigrationIssueMultiError, or nil if none found.
+func (m *MemberAttributeMigrationIssue) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *DeleteBriefingTypeRequest) validate(all bool) error {
+func (m *MemberAttributeMigrationIssue) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Id
+	// no validation rules for MemberId
+
+	// no validation rules for MemberName
+
+	// no validation rules for Value
+
+	// no validation rules for Description
 
 	if len(errors) > 0 {
-		return DeleteBriefingTypeRequestMultiError(errors)
+		return MemberAttributeMigrationIssueMultiError(errors)
 	}
 
 	return nil
 }
 
-// DeleteBriefingTypeRequestMultiError is an error wrapping multiple validation
-// errors returned by DeleteBriefingTypeRequest.ValidateAll() if the
-// designated constraints aren't met.
-type DeleteBriefingTypeRequestMultiError []error
+// MemberAttributeMigrationIssueMultiError is an error wrapping multiple
+// validation errors returned by MemberAttributeMigrationIssue.ValidateAll()
+// if the designated constraints aren't met.
+type MemberAttributeMigrationIssueMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m DeleteBriefingTypeRequestMultiError) Error() string {
+func (m MemberAttributeMigrationIssueMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -4485,11 +4507,12 @@ func (m DeleteBriefingTypeRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m DeleteBriefingTypeRequestMultiError) AllErrors() []error { return m }
+func (m MemberAttributeMigrationIssueMultiError) AllErrors() []error { return m }
 
-// DeleteBriefingTypeRequestValidationError is the validation error returned by
-// DeleteBriefingTypeRequest.Validate if the designated constraints aren't met.
-type DeleteBriefingTypeRequestValidationError struct {
+// MemberAttributeMigrationIssueValidationError is the validation error
+// returned by MemberAttributeMigrationIssue.Validate if the designated
+// constraints aren't met.
+type MemberAttributeMigrationIssueValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -4497,24 +4520,24 @@ type DeleteBriefingTypeRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e DeleteBriefingTypeRequestValidationError) Field() string { return e.field }
+func (e MemberAttributeMigrationIssueValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DeleteBriefingTypeRequestValidationError) Reason() string { return e.reason }
+func (e MemberAttributeMigrationIssueValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DeleteBriefingTypeRequestValidationError) Cause() error { return e.cause }
+func (e MemberAttributeMigrationIssueValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DeleteBriefingTypeRequestValidationError) Key() bool { return e.key }
+func (e MemberAttributeMigrationIssueValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DeleteBriefingTypeRequestValidationError) ErrorName() string {
-	return "DeleteBriefingTypeRequestValidationError"
+func (e MemberAttributeMigrationIssueValidationError) ErrorName() string {
+	return "MemberAttributeMigrationIssueValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e DeleteBriefingTypeRequestValidationError) Error() string {
+func (e MemberAttributeMigrationIssueValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -4526,14 +4549,14 @@ func (e DeleteBriefingTypeRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDeleteBriefingTypeRequest.%s: %s%s",
+		"invalid %sMemberAttributeMigrationIssue.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DeleteBriefingTypeRequestValidationError{}
+var _ error = MemberAttributeMigrationIssueValidationError{}
 
 var _ interface {
 	Field() string
@@ -4541,24 +4564,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DeleteBriefingTypeRequestValidationError{}
+} = MemberAttributeMigrationIssueValidationError{}
 
-// Validate checks the field values on Briefing with the rules defined in the
-// proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *Briefing) Validate() error {
+// Validate checks the field values on MemberRelationship with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *MemberRelationship) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on Briefing with the rules defined in
-// the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in BriefingMultiError, or nil
-// if none found.
-func (m *Briefing) ValidateAll() error {
+// ValidateAll checks the field values on MemberRelationship with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// MemberRelationshipMultiError, or nil if none found.
+func (m *MemberRelationship) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *Briefing) validate(all bool) error {
+func (m *MemberRelationship) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -4567,21 +4590,36 @@ func (m *Briefing) validate(all bool) error {
 
 	// no validation rules for Id
 
-	// no validation rules for BriefingType
+	// no validation rules for MemberId
+
+	// no validation rules for Type
+
+	// no validation rules for Name
+
+	// no validation rules for Email
+
+	// no validation rules for Phone
+
+	// no validation rules for Description
+
+	if m.RelatedMemberId != nil {
+		// no validation rules for RelatedMemberId
+	}
 
 	if len(errors) > 0 {
-		return BriefingMultiError(errors)
+		return MemberRelationshipMultiError(errors)
 	}
 
 	return nil
 }
 
-// BriefingMultiError is an error wrapping multiple validation errors returned
-// by Briefing.ValidateAll() if the designated constraints aren't met.
-type BriefingMultiError []error
+// MemberRelationshipMultiError is an error wrapping multiple validation errors
+// returned by MemberRelationship.ValidateAll() if the designated constraints
+// aren't met.
+type MemberRelationshipMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m BriefingMultiError) Error() string {
+func (m MemberRelationshipMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -4590,11 +4628,11 @@ func (m BriefingMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m BriefingMultiError) AllErrors() []error { return m }
+func (m MemberRelationshipMultiError) AllErrors() []error { return m }
 
-// BriefingValidationError is the validation error returned by
-// Briefing.Validate if the designated constraints aren't met.
-type BriefingValidationError struct {
+// MemberRelationshipValidationError is the validation error returned by
+// MemberRelationship.Validate if the designated constraints aren't met.
+type MemberRelationshipValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -4602,22 +4640,24 @@ type BriefingValidationError struct {
 }
 
 // Field function returns field value.
-func (e BriefingValidationError) Field() string { return e.field }
+func (e MemberRelationshipValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e BriefingValidationError) Reason() string { return e.reason }
+func (e MemberRelationshipValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e BriefingValidationError) Cause() error { return e.cause }
+func (e MemberRelationshipValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e BriefingValidationError) Key() bool { return e.key }
+func (e MemberRelationshipValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e BriefingValidationError) ErrorName() string { return "BriefingValidationError" }
+func (e MemberRelationshipValidationError) ErrorName() string {
+	return "MemberRelationshipValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e BriefingValidationError) Error() string {
+func (e MemberRelationshipValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -4629,14 +4669,14 @@ func (e BriefingValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sBriefing.%s: %s%s",
+		"invalid %sMemberRelationship.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = BriefingValidationError{}
+var _ error = MemberRelationshipValidationError{}
 
 var _ interface {
 	Field() string
@@ -4644,55 +4684,53 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = BriefingValidationError{}
+} = MemberRelationshipValidationError{}
 
-// Validate checks the field values on CreateBriefingRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on CreateMemberRelationshipRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *CreateBriefingRequest) Validate() error {
+func (m *CreateMemberRelationshipRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CreateBriefingRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// CreateBriefingRequestMultiError, or nil if none found.
-func (m *CreateBriefingRequest) ValidateAll() error {
+// ValidateAll checks the field values on CreateMemberRelationshipRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// CreateMemberRelationshipRequestMultiError, or nil if none found.
+func (m *CreateMemberRelationshipRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CreateBriefingRequest) validate(all bool) error {
+func (m *CreateMemberRelationshipRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for BriefingId
-
 	if all {
-		switch v := interface{}(m.GetBriefing()).(type) {
+		switch v := interface{}(m.GetRelationship()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, CreateBriefingRequestValidationError{
-					field:  "Briefing",
+				errors = append(errors, CreateMemberRelationshipRequestValidationError{
+					field:  "Relationship",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, CreateBriefingRequestValidationError{
-					field:  "Briefing",
+				errors = append(errors, CreateMemberRelationshipRequestValidationError{
+					field:  "Relationship",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetBriefing()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetRelationship()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return CreateBriefingRequestValidationError{
-				field:  "Briefing",
+			return CreateMemberRelationshipRequestValidationError{
+				field:  "Relationship",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
@@ -4700,19 +4738,19 @@ func (m *CreateBriefingRequest) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return CreateBriefingRequestMultiError(errors)
+		return CreateMemberRelationshipRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// CreateBriefingRequestMultiError is an error wrapping multiple validation
-// errors returned by CreateBriefingRequest.ValidateAll() if the designated
-// constraints aren't met.
-type CreateBriefingRequestMultiError []error
+// CreateMemberRelationshipRequestMultiError is an error wrapping multiple
+// validation errors returned by CreateMemberRelationshipRequest.ValidateAll()
+// if the designated constraints aren't met.
+type CreateMemberRelationshipRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CreateBriefingRequestMultiError) Error() string {
+func (m CreateMemberRelationshipRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -4721,11 +4759,12 @@ func (m CreateBriefingRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CreateBriefingRequestMultiError) AllErrors() []error { return m }
+func (m CreateMemberRelationshipRequestMultiError) AllErrors() []error { return m }
 
-// CreateBriefingRequestValidationError is the validation error returned by
-// CreateBriefingRequest.Validate if the designated constraints aren't met.
-type CreateBriefingRequestValidationError struct {
+// CreateMemberRelationshipRequestValidationError is the validation error
+// returned by CreateMemberRelationshipRequest.Validate if the designated
+// constraints aren't met.
+type CreateMemberRelationshipRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -4733,24 +4772,24 @@ type CreateBriefingRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e CreateBriefingRequestValidationError) Field() string { return e.field }
+func (e CreateMemberRelationshipRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CreateBriefingRequestValidationError) Reason() string { return e.reason }
+func (e CreateMemberRelationshipRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CreateBriefingRequestValidationError) Cause() error { return e.cause }
+func (e CreateMemberRelationshipRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CreateBriefingRequestValidationError) Key() bool { return e.key }
+func (e CreateMemberRelationshipRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CreateBriefingRequestValidationError) ErrorName() string {
-	return "CreateBriefingRequestValidationError"
+func (e CreateMemberRelationshipRequestValidationError) ErrorName() string {
+	return "CreateMemberRelationshipRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e CreateBriefingRequestValidationError) Error() string {
+func (e CreateMemberRelationshipRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -4762,14 +4801,14 @@ func (e CreateBriefingRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCreateBriefingRequest.%s: %s%s",
+		"invalid %sCreateMemberRelationshipRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CreateBriefingRequestValidationError{}
+var _ error = CreateMemberRelationshipRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -4777,46 +4816,46 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CreateBriefingRequestValidationError{}
+} = CreateMemberRelationshipRequestValidationError{}
 
-// Validate checks the field values on GetBriefingRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on ListMemberRelationshipsRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetBriefingRequest) Validate() error {
+func (m *ListMemberRelationshipsRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetBriefingRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// GetBriefingRequestMultiError, or nil if none found.
-func (m *GetBriefingRequest) ValidateAll() error {
+// ValidateAll checks the field values on ListMemberRelationshipsRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// ListMemberRelationshipsRequestMultiError, or nil if none found.
+func (m *ListMemberRelationshipsRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetBriefingRequest) validate(all bool) error {
+func (m *ListMemberRelationshipsRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Id
+	// no validation rules for MemberId
 
 	if len(errors) > 0 {
-		return GetBriefingRequestMultiError(errors)
+		return ListMemberRelationshipsRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetBriefingRequestMultiError is an error wrapping multiple validation errors
-// returned by GetBriefingRequest.ValidateAll() if the designated constraints
-// aren't met.
-type GetBriefingRequestMultiError []error
+// ListMemberRelationshipsRequestMultiError is an error wrapping multiple
+// validation errors returned by ListMemberRelationshipsRequest.ValidateAll()
+// if the designated constraints aren't met.
+type ListMemberRelationshipsRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetBriefingRequestMultiError) Error() string {
+func (m ListMemberRelationshipsRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -4825,11 +4864,12 @@ func (m GetBriefingRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetBriefingRequestMultiError) AllErrors() []error { return m }
+func (m ListMemberRelationshipsRequestMultiError) AllErrors() []error { return m }
 
-// GetBriefingRequestValidationError is the validation error returned by
-// GetBriefingRequest.Validate if the designated constraints aren't met.
-type GetBriefingRequestValidationError struct {
+// ListMemberRelationshipsRequestValidationError is the validation error
+// returned by ListMemberRelationshipsRequest.Validate if the designated
+// constraints aren't met.
+type ListMemberRelationshipsRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -4837,24 +4877,24 @@ type GetBriefingRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetBriefingRequestValidationError) Field() string { return e.field }
+func (e ListMemberRelationshipsRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetBriefingRequestValidationError) Reason() string { return e.reason }
+func (e ListMemberRelationshipsRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetBriefingRequestValidationError) Cause() error { return e.cause }
+func (e ListMemberRelationshipsRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetBriefingRequestValidationError) Key() bool { return e.key }
+func (e ListMemberRelationshipsRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetBriefingRequestValidationError) ErrorName() string {
-	return "GetBriefingRequestValidationError"
+func (e ListMemberRelationshipsRequestValidationError) ErrorName() string {
+	return "ListMemberRelationshipsRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetBriefingRequestValidationError) Error() string {
+func (e ListMemberRelationshipsRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -4866,14 +4906,14 @@ func (e GetBriefingRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetBriefingRequest.%s: %s%s",
+		"invalid %sListMemberRelationshipsRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetBriefingRequestValidationError{}
+var _ error = ListMemberRelationshipsRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -4881,48 +4921,78 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetBriefingRequestValidationError{}
+} = ListMemberRelationshipsRequestValidationError{}
 
-// Validate checks the field values on ListBriefingsRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on ListMemberRelationshipsResponse with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ListBriefingsRequest) Validate() error {
+func (m *ListMemberRelationshipsResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ListBriefingsRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// ListBriefingsRequestMultiError, or nil if none found.
-func (m *ListBriefingsRequest) ValidateAll() error {
+// ValidateAll checks the field values on ListMemberRelationshipsResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// ListMemberRelationshipsResponseMultiError, or nil if none found.
+func (m *ListMemberRelationshipsResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ListBriefingsRequest) validate(all bool) error {
+func (m *ListMemberRelationshipsResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for PageSize
+	for idx, item := range m.GetRelationships() {
+		_, _ = idx, item
 
-	// no validation rules for PageToken
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListMemberRelationshipsResponseValidationError{
+						field:  fmt.Sprintf("Relationships[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListMemberRelationshipsResponseValidationError{
+						field:  fmt.Sprintf("Relationships[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListMemberRelationshipsResponseValidationError{
+					field:  fmt.Sprintf("Relationships[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
 
 	if len(errors) > 0 {
-		return ListBriefingsRequestMultiError(errors)
+		return ListMemberRelationshipsResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// ListBriefingsRequestMultiError is an error wrapping multiple validation
-// errors returned by ListBriefingsRequest.ValidateAll() if the designated
-// constraints aren't met.
-type ListBriefingsRequestMultiError []error
+// ListMemberRelationshipsResponseMultiError is an error wrapping multiple
+// validation errors returned by ListMemberRelationshipsResponse.ValidateAll()
+// if the designated constraints aren't met.
+type ListMemberRelationshipsResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ListBriefingsRequestMultiError) Error() string {
+func (m ListMemberRelationshipsResponseMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -4931,11 +5001,12 @@ func (m ListBriefingsRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ListBriefingsRequestMultiError) AllErrors() []error { return m }
+func (m ListMemberRelationshipsResponseMultiError) AllErrors() []error { return m }
 
-// ListBriefingsRequestValidationError is the validation error returned by
-// ListBriefingsRequest.Validate if the designated constraints aren't met.
-type ListBriefingsRequestValidationError struct {
+// ListMemberRelationshipsResponseValidationError is the validation error
+// returned by ListMemberRelationshipsResponse.Validate if the designated
+// constraints aren't met.
+type ListMemberRelationshipsResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -4943,24 +5014,24 @@ type ListBriefingsRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListBriefingsRequestValidationError) Field() string { return e.field }
+func (e ListMemberRelationshipsResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListBriefingsRequestValidationError) Reason() string { return e.reason }
+func (e ListMemberRelationshipsResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListBriefingsRequestValidationError) Cause() error { return e.cause }
+func (e ListMemberRelationshipsResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListBriefingsRequestValidationError) Key() bool { return e.key }
+func (e ListMemberRelationshipsResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListBriefingsRequestValidationError) ErrorName() string {
-	return "ListBriefingsRequestValidationError"
+func (e ListMemberRelationshipsResponseValidationError) ErrorName() string {
+	return "ListMemberRelationshipsResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ListBriefingsRequestValidationError) Error() string {
+func (e ListMemberRelationshipsResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -4972,14 +5043,14 @@ func (e ListBriefingsRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListBriefingsRequest.%s: %s%s",
+		"invalid %sListMemberRelationshipsResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListBriefingsRequestValidationError{}
+var _ error = ListMemberRelationshipsResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -4987,193 +5058,55 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListBriefingsRequestValidationError{}
+} = ListMemberRelationshipsResponseValidationError{}
 
-// Validate checks the field values on ListBriefingsResponse with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on UpdateMemberRelationshipRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ListBriefingsResponse) Validate() error {
+func (m *UpdateMemberRelationshipRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ListBriefingsResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// ListBriefingsResponseMultiError, or nil if none found.
-func (m *ListBriefingsResponse) ValidateAll() error {
+// ValidateAll checks the field values on UpdateMemberRelationshipRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// UpdateMemberRelationshipRequestMultiError, or nil if none found.
+func (m *UpdateMemberRelationshipRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ListBriefingsResponse) validate(all bool) error {
+func (m *UpdateMemberRelationshipRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetBriefings() {
-		_, _ = idx, item
-
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, ListBriefingsResponseValidationError{
-						field:  fmt.Sprintf("Briefings[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, ListBriefingsResponseValidationError{
-						field:  fmt.Sprintf("Briefings[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
+	if all {
+		switch v := interface{}(m.GetRelationship()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateMemberRelationshipRequestValidationError{
+					field:  "Relationship",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
 			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				return ListBriefingsResponseValidationError{
-					field:  fmt.Sprintf("Briefings[%v]", idx),
+				errors = append(errors, UpdateMemberRelationshipRequestValidationError{
+					field:  "Relationship",
 					reason: "embedded message failed validation",
 					cause:  err,
-				}
-			}
-		}
-
-	}
-
-	// no validation rules for NextPageToken
-
-	if len(errors) > 0 {
-		return ListBriefingsResponseMultiError(errors)
-	}
-
-	return nil
-}
-
-// ListBriefingsResponseMultiError is an error wrapping multiple validation
-// errors returned by ListBriefingsResponse.ValidateAll() if the designated
-// constraints aren't met.
-type ListBriefingsResponseMultiError []error
-
-// Error returns a concatenation of all the error messages it wraps.
-func (m ListBriefingsResponseMultiError) Error() string {
-	msgs := make([]string, 0, len(m))
-	for _, err := range m {
-		msgs = append(msgs, err.Error())
-	}
-	return strings.Join(msgs, "; ")
-}
-
-// AllErrors returns a list of validation violation errors.
-func (m ListBriefingsResponseMultiError) AllErrors() []error { return m }
-
-// ListBriefingsResponseValidationError is the validation error returned by
-// ListBriefingsResponse.Validate if the designated constraints aren't met.
-type ListBriefingsResponseValidationError struct {
-	field  string
-	reason string
-	cause  error
-	key    bool
-}
-
-// Field function returns field value.
-func (e ListBriefingsResponseValidationError) Field() string { return e.field }
-
-// Reason function returns reason value.
-func (e ListBriefingsResponseValidationError) Reason() string { return e.reason }
-
-// Cause function returns cause value.
-func (e ListBriefingsResponseValidationError) Cause() error { return e.cause }
-
-// Key function returns key value.
-func (e ListBriefingsResponseValidationError) Key() bool { return e.key }
-
-// ErrorName returns error name.
-func (e ListBriefingsResponseValidationError) ErrorName() string {
-	return "ListBriefingsResponseValidationError"
-}
-
-// Error satisfies the builtin error interface
-func (e ListBriefingsResponseValidationError) Error() string {
-	cause := ""
-	if e.cause != nil {
-		cause = fmt.Sprintf(" | caused by: %v", e.cause)
-	}
-
-	key := ""
-	if e.key {
-		key = "key for "
-	}
-
-	return fmt.Sprintf(
-		"invalid %sListBriefingsResponse.%s: %s%s",
-		key,
-		e.field,
-		e.reason,
-		cause)
-}
-
-var _ error = ListBriefingsResponseValidationError{}
-
-var _ interface {
-	Field() string
-	Reason() string
-	Key() bool
-	Cause() error
-	ErrorName() string
-} = ListBriefingsResponseValidationError{}
-
-// Validate checks the field values on UpdateBriefingRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *UpdateBriefingRequest) Validate() error {
-	return m.validate(false)
-}
-
-// ValidateAll checks the field values on UpdateBriefingRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// UpdateBriefingRequestMultiError, or nil if none found.
-func (m *UpdateBriefingRequest) ValidateAll() error {
-	return m.validate(true)
-}
-
-func (m *UpdateBriefingRequest) validate(all bool) error {
-	if m == nil {
-		return nil
-	}
-
-	var errors []error
-
-	if all {
-		switch v := interface{}(m.GetBriefing()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, UpdateBriefingRequestValidationError{
-					field:  "Briefing",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, UpdateBriefingRequestValidationError{
-					field:  "Briefing",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetBriefing()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return UpdateBriefingRequestValidationError{
-				field:  "Briefing",
-				reason: "embedded message failed validation",
-				cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRelationship()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateMemberRelationshipRequestValidationError{
+				field:  "Relationship",
+				reason: "embedded message failed validation",
+				cause:  err,
 			}
 		}
 	}
@@ -5182,7 +5115,7 @@ func (m *UpdateBriefingRequest) validate(all bool) error {
 		switch v := interface{}(m.GetFieldMask()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, UpdateBriefingRequestValidationError{
+				errors = append(errors, UpdateMemberRelationshipRequestValidationError{
 					field:  "FieldMask",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -5190,7 +5123,7 @@ func (m *UpdateBriefingRequest) validate(all bool) error {
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, UpdateBriefingRequestValidationError{
+				errors = append(errors, UpdateMemberRelationshipRequestValidationError{
 					field:  "FieldMask",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -5199,7 +5132,7 @@ func (m *UpdateBriefingRequest) validate(all bool) error {
 		}
 	} else if v, ok := interface{}(m.GetFieldMask()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return UpdateBriefingRequestValidationError{
+			return UpdateMemberRelationshipRequestValidationError{
 				field:  "FieldMask",
 				reason: "embedded message failed validation",
 				cause:  err,
@@ -5208,19 +5141,19 @@ func (m *UpdateBriefingRequest) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return UpdateBriefingRequestMultiError(errors)
+		return UpdateMemberRelationshipRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// UpdateBriefingRequestMultiError is an error wrapping multiple validation
-// errors returned by UpdateBriefingRequest.ValidateAll() if the designated
-// constraints aren't met.
-type UpdateBriefingRequestMultiError []error
+// UpdateMemberRelationshipRequestMultiError is an error wrapping multiple
+// validation errors returned by UpdateMemberRelationshipRequest.ValidateAll()
+// if the designated constraints aren't met.
+type UpdateMemberRelationshipRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m UpdateBriefingRequestMultiError) Error() string {
+func (m UpdateMemberRelationshipRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -5229,11 +5162,12 @@ func (m UpdateBriefingRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m UpdateBriefingRequestMultiError) AllErrors() []error { return m }
+func (m UpdateMemberRelationshipRequestMultiError) AllErrors() []error { return m }
 
-// UpdateBriefingRequestValidationError is the validation error returned by
-// UpdateBriefingRequest.Validate if the designated constraints aren't met.
-type UpdateBriefingRequestValidationError struct {
+// UpdateMemberRelationshipRequestValidationError is the validation error
+// returned by UpdateMemberRelationshipRequest.Validate if the designated
+// constraints aren't met.
+type UpdateMemberRelationshipRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -5241,24 +5175,24 @@ type UpdateBriefingRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e UpdateBriefingRequestValidationError) Field() string { return e.field }
+func (e UpdateMemberRelationshipRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UpdateBriefingRequestValidationError) Reason() string { return e.reason }
+func (e UpdateMemberRelationshipRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UpdateBriefingRequestValidationError) Cause() error { return e.cause }
+func (e UpdateMemberRelationshipRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UpdateBriefingRequestValidationError) Key() bool { return e.key }
+func (e UpdateMemberRelationshipRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UpdateBriefingRequestValidationError) ErrorName() string {
-	return "UpdateBriefingRequestValidationError"
+func (e UpdateMemberRelationshipRequestValidationError) ErrorName() string {
+	return "UpdateMemberRelationshipRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e UpdateBriefingRequestValidationError) Error() string {
+func (e UpdateMemberRelationshipRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -5270,14 +5204,14 @@ func (e UpdateBriefingRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUpdateBriefingRequest.%s: %s%s",
+		"invalid %sUpdateMemberRelationshipRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UpdateBriefingRequestValidationError{}
+var _ error = UpdateMemberRelationshipRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -5285,46 +5219,48 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UpdateBriefingRequestValidationError{}
+} = UpdateMemberRelationshipRequestValidationError{}
 
-// Validate checks the field values on DeleteBriefingRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on DeleteMemberRelationshipRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *DeleteBriefingRequest) Validate() error {
+func (m *DeleteMemberRelationshipRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on DeleteBriefingRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// DeleteBriefingRequestMultiError, or nil if none found.
-func (m *DeleteBriefingRequest) ValidateAll() error {
+// ValidateAll checks the field values on DeleteMemberRelationshipRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// DeleteMemberRelationshipRequestMultiError, or nil if none found.
+func (m *DeleteMemberRelationshipRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *DeleteBriefingRequest) validate(all bool) error {
+func (m *DeleteMemberRelationshipRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	// no validation rules for MemberId
+
 	// no validation rules for Id
 
 	if len(errors) > 0 {
-		return DeleteBriefingRequestMultiError(errors)
+		return DeleteMemberRelationshipRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// DeleteBriefingRequestMultiError is an error wrapping multiple validation
-// errors returned by DeleteBriefingRequest.ValidateAll() if the designated
-// constraints aren't met.
-type DeleteBriefingRequestMultiError []error
+// DeleteMemberRelationshipRequestMultiError is an error wrapping multiple
+// validation errors returned by DeleteMemberRelationshipRequest.ValidateAll()
+// if the designated constraints aren't met.
+type DeleteMemberRelationshipRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m DeleteBriefingRequestMultiError) Error() string {
+func (m DeleteMemberRelationshipRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -5333,11 +5269,12 @@ func (m DeleteBriefingRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m DeleteBriefingRequestMultiError) AllErrors() []error { return m }
+func (m DeleteMemberRelationshipRequestMultiError) AllErrors() []error { return m }
 
-// DeleteBriefingRequestValidationError is the validation error returned by
-// DeleteBriefingRequest.Validate if the designated constraints aren't met.
-type DeleteBriefingRequestValidationError struct {
+// DeleteMemberRelationshipRequestValidationError is the validation error
+// returned by DeleteMemberRelationshipRequest.Validate if the designated
+// constraints aren't met.
+type DeleteMemberRelationshipRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -5345,24 +5282,24 @@ type DeleteBriefingRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e DeleteBriefingRequestValidationError) Field() string { return e.field }
+func (e DeleteMemberRelationshipRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DeleteBriefingRequestValidationError) Reason() string { return e.reason }
+func (e DeleteMemberRelationshipRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DeleteBriefingRequestValidationError) Cause() error { return e.cause }
+func (e DeleteMemberRelationshipRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DeleteBriefingRequestValidationError) Key() bool { return e.key }
+func (e DeleteMemberRelationshipRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DeleteBriefingRequestValidationError) ErrorName() string {
-	return "DeleteBriefingRequestValidationError"
+func (e DeleteMemberRelationshipRequestValidationError) ErrorName() string {
+	return "DeleteMemberRelationshipRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e DeleteBriefingRequestValidationError) Error() string {
+func (e DeleteMemberRelationshipRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -5374,14 +5311,14 @@ func (e DeleteBriefingRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDeleteBriefingRequest.%s: %s%s",
+		"invalid %sDeleteMemberRelationshipRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DeleteBriefingRequestValidationError{}
+var _ error = DeleteMemberRelationshipRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -5389,24 +5326,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DeleteBriefingRequestValidationError{}
+} = DeleteMemberRelationshipRequestValidationError{}
 
-// Validate checks the field values on Presence with the rules defined in the
-// proto definition for this message. If any rules are violated, the first
+// Validate checks the field values on MemberConsent with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
-func (m *Presence) Validate() error {
+func (m *MemberConsent) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on Presence with the rules defined in
-// the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in PresenceMultiError, or nil
-// if none found.
-func (m *Presence) ValidateAll() error {
+// ValidateAll checks the field values on MemberConsent with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in MemberConsentMultiError, or
+// nil if none found.
+func (m *MemberConsent) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *Presence) validate(all bool) error {
+func (m *MemberConsent) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -5417,29 +5354,33 @@ func (m *Presence) validate(all bool) error {
 
 	// no validation rules for MemberId
 
+	// no validation rules for Purpose
+
+	// no validation rules for GivenBy
+
 	if all {
-		switch v := interface{}(m.GetCheckinTime()).(type) {
+		switch v := interface{}(m.GetConsentTime()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, PresenceValidationError{
-					field:  "CheckinTime",
+				errors = append(errors, MemberConsentValidationError{
+					field:  "ConsentTime",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, PresenceValidationError{
-					field:  "CheckinTime",
+				errors = append(errors, MemberConsentValidationError{
+					field:  "ConsentTime",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetCheckinTime()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetConsentTime()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return PresenceValidationError{
-				field:  "CheckinTime",
+			return MemberConsentValidationError{
+				field:  "ConsentTime",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
@@ -5447,47 +5388,52 @@ func (m *Presence) validate(all bool) error {
 	}
 
 	if all {
-		switch v := interface{}(m.GetCheckoutTime()).(type) {
+		switch v := interface{}(m.GetRevokeTime()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, PresenceValidationError{
-					field:  "CheckoutTime",
+				errors = append(errors, MemberConsentValidationError{
+					field:  "RevokeTime",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, PresenceValidationError{
-					field:  "CheckoutTime",
+				errors = append(errors, MemberConsentValidationError{
+					field:  "RevokeTime",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetCheckoutTime()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetRevokeTime()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return PresenceValidationError{
-				field:  "CheckoutTime",
+			return MemberConsentValidationError{
+				field:  "RevokeTime",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
 		}
 	}
 
+	if m.RelationshipId != nil {
+		// no validation rules for RelationshipId
+	}
+
 	if len(errors) > 0 {
-		return PresenceMultiError(errors)
+		return MemberConsentMultiError(errors)
 	}
 
 	return nil
 }
 
-// PresenceMultiError is an error wrapping multiple validation errors returned
-// by Presence.ValidateAll() if the designated constraints aren't met.
-type PresenceMultiError []error
+// MemberConsentMultiError is an error wrapping multiple validation errors
+// returned by MemberConsent.ValidateAll() if the designated constraints
+// aren't met.
+type MemberConsentMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m PresenceMultiError) Error() string {
+func (m MemberConsentMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -5496,11 +5442,11 @@ func (m PresenceMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m PresenceMultiError) AllErrors() []error { return m }
+func (m MemberConsentMultiError) AllErrors() []error { return m }
 
-// PresenceValidationError is the validation error returned by
-// Presence.Validate if the designated constraints aren't met.
-type PresenceValidationError struct {
+// MemberConsentValidationError is the validation error returned by
+// MemberConsent.Validate if the designated constraints aren't met.
+type MemberConsentValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -5508,22 +5454,22 @@ type PresenceValidationError struct {
 }
 
 // Field function returns field value.
-func (e PresenceValidationError) Field() string { return e.field }
+func (e MemberConsentValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e PresenceValidationError) Reason() string { return e.reason }
+func (e MemberConsentValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e PresenceValidationError) Cause() error { return e.cause }
+func (e MemberConsentValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e PresenceValidationError) Key() bool { return e.key }
+func (e MemberConsentValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e PresenceValidationError) ErrorName() string { return "PresenceValidationError" }
+func (e MemberConsentValidationError) ErrorName() string { return "MemberConsentValidationError" }
 
 // Error satisfies the builtin error interface
-func (e PresenceValidationError) Error() string {
+func (e MemberConsentValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -5535,14 +5481,14 @@ func (e PresenceValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sPresence.%s: %s%s",
+		"invalid %sMemberConsent.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = PresenceValidationError{}
+var _ error = MemberConsentValidationError{}
 
 var _ interface {
 	Field() string
@@ -5550,166 +5496,291 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = PresenceValidationError{}
+} = MemberConsentValidationError{}
 
-// Validate checks the field values on ListPresencesRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on CreateMemberConsentRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ListPresencesRequest) Validate() error {
+func (m *CreateMemberConsentRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ListPresencesRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on CreateMemberConsentRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ListPresencesRequestMultiError, or nil if none found.
-func (m *ListPresencesRequest) ValidateAll() error {
+// CreateMemberConsentRequestMultiError, or nil if none found.
+func (m *CreateMemberConsentRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ListPresencesRequest) validate(all bool) error {
+func (m *CreateMemberConsentRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for PageSize
-
-	// no validation rules for PageToken
-
-	// no validation rules for SortBy
-
-	// no validation rules for SortDirection
-
-	if m.MemberId != nil {
-		// no validation rules for MemberId
-	}
-
-	if m.CheckinTimeAfter != nil {
-
-		if all {
-			switch v := interface{}(m.GetCheckinTimeAfter()).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, ListPresencesRequestValidationError{
-						field:  "CheckinTimeAfter",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, ListPresencesRequestValidationError{
-						field:  "CheckinTimeAfter",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
+	if all {
+		switch v := interface{}(m.GetConsent()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateMemberConsentRequestValidationError{
+					field:  "Consent",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
 			}
-		} else if v, ok := interface{}(m.GetCheckinTimeAfter()).(interface{ Validate() error }); ok {
+		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				return ListPresencesRequestValidationError{
-					field:  "CheckinTimeAfter",
+				errors = append(errors, CreateMemberConsentRequestValidationError{
+					field:  "Consent",
 					reason: "embedded message failed validation",
 					cause:  err,
-				}
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetConsent()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateMemberConsentRequestValidationError{
+				field:  "Consent",
+				reason: "embedded message failed validation",
+				cause:  err,
 			}
 		}
+	}
 
+	if len(errors) > 0 {
+		return CreateMemberConsentRequestMultiError(errors)
 	}
 
-	if m.CheckinTimeBefore != nil {
+	return nil
+}
 
-		if all {
-			switch v := interface{}(m.GetCheckinTimeBefore()).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, ListPresencesRequestValidationError{
-						field:  "CheckinTimeBefore",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, ListPresencesRequestValidationError{
-						field:  "CheckinTimeBefore",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(m.GetCheckinTimeBefore()).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return ListPresencesRequestValidationError{
-					field:  "CheckinTimeBefore",
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
+// CreateMemberConsentRequestMultiError is an error wrapping multiple
+// validation errors returned by CreateMemberConsentRequest.ValidateAll() if
+// the designated constraints aren't met.
+type CreateMemberConsentRequestMultiError []error
 
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateMemberConsentRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
 	}
+	return strings.Join(msgs, "; ")
+}
 
-	if m.CheckoutTimeAfter != nil {
+// AllErrors returns a list of validation violation errors.
+func (m CreateMemberConsentRequestMultiError) AllErrors() []error { return m }
 
-		if all {
-			switch v := interface{}(m.GetCheckoutTimeAfter()).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, ListPresencesRequestValidationError{
-						field:  "CheckoutTimeAfter",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, ListPresencesRequestValidationError{
-						field:  "CheckoutTimeAfter",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(m.GetCheckoutTimeAfter()).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return ListPresencesRequestValidationError{
-					field:  "CheckoutTimeAfter",
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
+// CreateMemberConsentRequestValidationError is the validation error returned
+// by CreateMemberConsentRequest.Validate if the designated constraints aren't met.
+type CreateMemberConsentRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateMemberConsentRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateMemberConsentRequestValidationError) Reason() string { return e.reason }
 
+// Cause function returns cause value.
+func (e CreateMemberConsentRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateMemberConsentRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateMemberConsentRequestValidationError) ErrorName() string {
+	return "CreateMemberConsentRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateMemberConsentRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
 	}
 
-	if m.CheckoutTimeBefore != nil {
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateMemberConsentRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateMemberConsentRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateMemberConsentRequestValidationError{}
+
+// Validate checks the field values on ListMemberConsentsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListMemberConsentsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListMemberConsentsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListMemberConsentsRequestMultiError, or nil if none found.
+func (m *ListMemberConsentsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListMemberConsentsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for MemberId
+
+	if len(errors) > 0 {
+		return ListMemberConsentsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListMemberConsentsRequestMultiError is an error wrapping multiple validation
+// errors returned by ListMemberConsentsRequest.ValidateAll() if the
+// designated constraints aren't met.
+type ListMemberConsentsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListMemberConsentsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListMemberConsentsRequestMultiError) AllErrors() []error { return m }
+
+// ListMemberConsentsRequestValidationError is the validation error returned by
+// ListMemberConsentsRequest.Validate if the designated constraints aren't met.
+type ListMemberConsentsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListMemberConsentsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListMemberConsentsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListMemberConsentsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListMemberConsentsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListMemberConsentsRequestValidationError) ErrorName() string {
+	return "ListMemberConsentsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListMemberConsentsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListMemberConsentsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListMemberConsentsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListMemberConsentsRequestValidationError{}
+
+// Validate checks the field values on ListMemberConsentsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListMemberConsentsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListMemberConsentsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListMemberConsentsResponseMultiError, or nil if none found.
+func (m *ListMemberConsentsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListMemberConsentsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetConsents() {
+		_, _ = idx, item
 
 		if all {
-			switch v := interface{}(m.GetCheckoutTimeBefore()).(type) {
+			switch v := interface{}(item).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, ListPresencesRequestValidationError{
-						field:  "CheckoutTimeBefore",
+					errors = append(errors, ListMemberConsentsResponseValidationError{
+						field:  fmt.Sprintf("Consents[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
-					errors = append(errors, ListPresencesRequestValidationError{
-						field:  "CheckoutTimeBefore",
+					errors = append(errors, ListMemberConsentsResponseValidationError{
+						field:  fmt.Sprintf("Consents[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			}
-		} else if v, ok := interface{}(m.GetCheckoutTimeBefore()).(interface{ Validate() error }); ok {
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				return ListPresencesRequestValidationError{
-					field:  "CheckoutTimeBefore",
+				return ListMemberConsentsResponseValidationError{
+					field:  fmt.Sprintf("Consents[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
 				}
@@ -5719,19 +5790,21003 @@ func (m *ListPresencesRequest) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return ListPresencesRequestMultiError(errors)
+		return ListMemberConsentsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListMemberConsentsResponseMultiError is an error wrapping multiple
+// validation errors returned by ListMemberConsentsResponse.ValidateAll() if
+// the designated constraints aren't met.
+type ListMemberConsentsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListMemberConsentsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListMemberConsentsResponseMultiError) AllErrors() []error { return m }
+
+// ListMemberConsentsResponseValidationError is the validation error returned
+// by ListMemberConsentsResponse.Validate if the designated constraints aren't met.
+type ListMemberConsentsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListMemberConsentsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListMemberConsentsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListMemberConsentsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListMemberConsentsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListMemberConsentsResponseValidationError) ErrorName() string {
+	return "ListMemberConsentsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListMemberConsentsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListMemberConsentsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListMemberConsentsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListMemberConsentsResponseValidationError{}
+
+// Validate checks the field values on RevokeMemberConsentRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RevokeMemberConsentRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RevokeMemberConsentRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RevokeMemberConsentRequestMultiError, or nil if none found.
+func (m *RevokeMemberConsentRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RevokeMemberConsentRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for MemberId
+
+	// no validation rules for Id
+
+	if len(errors) > 0 {
+		return RevokeMemberConsentRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// RevokeMemberConsentRequestMultiError is an error wrapping multiple
+// validation errors returned by RevokeMemberConsentRequest.ValidateAll() if
+// the designated constraints aren't met.
+type RevokeMemberConsentRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RevokeMemberConsentRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RevokeMemberConsentRequestMultiError) AllErrors() []error { return m }
+
+// RevokeMemberConsentRequestValidationError is the validation error returned
+// by RevokeMemberConsentRequest.Validate if the designated constraints aren't met.
+type RevokeMemberConsentRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RevokeMemberConsentRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RevokeMemberConsentRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RevokeMemberConsentRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RevokeMemberConsentRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RevokeMemberConsentRequestValidationError) ErrorName() string {
+	return "RevokeMemberConsentRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RevokeMemberConsentRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRevokeMemberConsentRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RevokeMemberConsentRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RevokeMemberConsentRequestValidationError{}
+
+// Validate checks the field values on MemberChangeRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *MemberChangeRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MemberChangeRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// MemberChangeRequestMultiError, or nil if none found.
+func (m *MemberChangeRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MemberChangeRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for MemberId
+
+	if all {
+		switch v := interface{}(m.GetMember()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, MemberChangeRequestValidationError{
+					field:  "Member",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, MemberChangeRequestValidationError{
+					field:  "Member",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetMember()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return MemberChangeRequestValidationError{
+				field:  "Member",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetFieldMask()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, MemberChangeRequestValidationError{
+					field:  "FieldMask",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, MemberChangeRequestValidationError{
+					field:  "FieldMask",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetFieldMask()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return MemberChangeRequestValidationError{
+				field:  "FieldMask",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for State
+
+	if all {
+		switch v := interface{}(m.GetCreateTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, MemberChangeRequestValidationError{
+					field:  "CreateTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, MemberChangeRequestValidationError{
+					field:  "CreateTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreateTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return MemberChangeRequestValidationError{
+				field:  "CreateTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for RequestedBy
+
+	if all {
+		switch v := interface{}(m.GetDecideTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, MemberChangeRequestValidationError{
+					field:  "DecideTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, MemberChangeRequestValidationError{
+					field:  "DecideTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDecideTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return MemberChangeRequestValidationError{
+				field:  "DecideTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for DecidedBy
+
+	// no validation rules for Comment
+
+	if len(errors) > 0 {
+		return MemberChangeRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// MemberChangeRequestMultiError is an error wrapping multiple validation
+// errors returned by MemberChangeRequest.ValidateAll() if the designated
+// constraints aren't met.
+type MemberChangeRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MemberChangeRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MemberChangeRequestMultiError) AllErrors() []error { return m }
+
+// MemberChangeRequestValidationError is the validation error returned by
+// MemberChangeRequest.Validate if the designated constraints aren't met.
+type MemberChangeRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MemberChangeRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MemberChangeRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MemberChangeRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MemberChangeRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MemberChangeRequestValidationError) ErrorName() string {
+	return "MemberChangeRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e MemberChangeRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMemberChangeRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MemberChangeRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MemberChangeRequestValidationError{}
+
+// Validate checks the field values on ListMemberChangeRequestsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListMemberChangeRequestsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListMemberChangeRequestsRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// ListMemberChangeRequestsRequestMultiError, or nil if none found.
+func (m *ListMemberChangeRequestsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListMemberChangeRequestsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for PageSize
+
+	// no validation rules for PageToken
+
+	// no validation rules for State
+
+	if m.MemberId != nil {
+		// no validation rules for MemberId
+	}
+
+	if len(errors) > 0 {
+		return ListMemberChangeRequestsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListMemberChangeRequestsRequestMultiError is an error wrapping multiple
+// validation errors returned by ListMemberChangeRequestsRequest.ValidateAll()
+// if the designated constraints aren't met.
+type ListMemberChangeRequestsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListMemberChangeRequestsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListMemberChangeRequestsRequestMultiError) AllErrors() []error { return m }
+
+// ListMemberChangeRequestsRequestValidationError is the validation error
+// returned by ListMemberChangeRequestsRequest.Validate if the designated
+// constraints aren't met.
+type ListMemberChangeRequestsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListMemberChangeRequestsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListMemberChangeRequestsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListMemberChangeRequestsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListMemberChangeRequestsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListMemberChangeRequestsRequestValidationError) ErrorName() string {
+	return "ListMemberChangeRequestsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListMemberChangeRequestsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListMemberChangeRequestsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListMemberChangeRequestsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListMemberChangeRequestsRequestValidationError{}
+
+// Validate checks the field values on ListMemberChangeRequestsResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *ListMemberChangeRequestsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListMemberChangeRequestsResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// ListMemberChangeRequestsResponseMultiError, or nil if none found.
+func (m *ListMemberChangeRequestsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListMemberChangeRequestsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetChangeRequests() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListMemberChangeRequestsResponseValidationError{
+						field:  fmt.Sprintf("ChangeRequests[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListMemberChangeRequestsResponseValidationError{
+						field:  fmt.Sprintf("ChangeRequests[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListMemberChangeRequestsResponseValidationError{
+					field:  fmt.Sprintf("ChangeRequests[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for NextPageToken
+
+	if len(errors) > 0 {
+		return ListMemberChangeRequestsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListMemberChangeRequestsResponseMultiError is an error wrapping multiple
+// validation errors returned by
+// ListMemberChangeRequestsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ListMemberChangeRequestsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListMemberChangeRequestsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListMemberChangeRequestsResponseMultiError) AllErrors() []error { return m }
+
+// ListMemberChangeRequestsResponseValidationError is the validation error
+// returned by ListMemberChangeRequestsResponse.Validate if the designated
+// constraints aren't met.
+type ListMemberChangeRequestsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListMemberChangeRequestsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListMemberChangeRequestsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListMemberChangeRequestsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListMemberChangeRequestsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListMemberChangeRequestsResponseValidationError) ErrorName() string {
+	return "ListMemberChangeRequestsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListMemberChangeRequestsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListMemberChangeRequestsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListMemberChangeRequestsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListMemberChangeRequestsResponseValidationError{}
+
+// Validate checks the field values on MemberChangeRequestPageToken with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *MemberChangeRequestPageToken) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MemberChangeRequestPageToken with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// MemberChangeRequestPageTokenMultiError, or nil if none found.
+func (m *MemberChangeRequestPageToken) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MemberChangeRequestPageToken) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Offset
+
+	if len(errors) > 0 {
+		return MemberChangeRequestPageTokenMultiError(errors)
+	}
+
+	return nil
+}
+
+// MemberChangeRequestPageTokenMultiError is an error wrapping multiple
+// validation errors returned by MemberChangeRequestPageToken.ValidateAll() if
+// the designated constraints aren't met.
+type MemberChangeRequestPageTokenMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MemberChangeRequestPageTokenMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MemberChangeRequestPageTokenMultiError) AllErrors() []error { return m }
+
+// MemberChangeRequestPageTokenValidationError is the validation error returned
+// by MemberChangeRequestPageToken.Validate if the designated constraints
+// aren't met.
+type MemberChangeRequestPageTokenValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MemberChangeRequestPageTokenValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MemberChangeRequestPageTokenValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MemberChangeRequestPageTokenValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MemberChangeRequestPageTokenValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MemberChangeRequestPageTokenValidationError) ErrorName() string {
+	return "MemberChangeRequestPageTokenValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e MemberChangeRequestPageTokenValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMemberChangeRequestPageToken.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MemberChangeRequestPageTokenValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MemberChangeRequestPageTokenValidationError{}
+
+// Validate checks the field values on DecideMemberChangeRequestRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *DecideMemberChangeRequestRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DecideMemberChangeRequestRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// DecideMemberChangeRequestRequestMultiError, or nil if none found.
+func (m *DecideMemberChangeRequestRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DecideMemberChangeRequestRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Comment
+
+	if len(errors) > 0 {
+		return DecideMemberChangeRequestRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DecideMemberChangeRequestRequestMultiError is an error wrapping multiple
+// validation errors returned by
+// DecideMemberChangeRequestRequest.ValidateAll() if the designated
+// constraints aren't met.
+type DecideMemberChangeRequestRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DecideMemberChangeRequestRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DecideMemberChangeRequestRequestMultiError) AllErrors() []error { return m }
+
+// DecideMemberChangeRequestRequestValidationError is the validation error
+// returned by DecideMemberChangeRequestRequest.Validate if the designated
+// constraints aren't met.
+type DecideMemberChangeRequestRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DecideMemberChangeRequestRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DecideMemberChangeRequestRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DecideMemberChangeRequestRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DecideMemberChangeRequestRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DecideMemberChangeRequestRequestValidationError) ErrorName() string {
+	return "DecideMemberChangeRequestRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DecideMemberChangeRequestRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDecideMemberChangeRequestRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DecideMemberChangeRequestRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DecideMemberChangeRequestRequestValidationError{}
+
+// Validate checks the field values on MemberAttribute with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *MemberAttribute) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MemberAttribute with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// MemberAttributeMultiError, or nil if none found.
+func (m *MemberAttribute) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MemberAttribute) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for TechnicalName
+
+	// no validation rules for DisplayName
+
+	// no validation rules for Type
+
+	// no validation rules for Description
+
+	// no validation rules for Required
+
+	// no validation rules for Unique
+
+	// no validation rules for Pattern
+
+	// no validation rules for Sensitivity
+
+	if m.Min != nil {
+		// no validation rules for Min
+	}
+
+	if m.Max != nil {
+		// no validation rules for Max
+	}
+
+	if len(errors) > 0 {
+		return MemberAttributeMultiError(errors)
+	}
+
+	return nil
+}
+
+// MemberAttributeMultiError is an error wrapping multiple validation errors
+// returned by MemberAttribute.ValidateAll() if the designated constraints
+// aren't met.
+type MemberAttributeMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MemberAttributeMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MemberAttributeMultiError) AllErrors() []error { return m }
+
+// MemberAttributeValidationError is the validation error returned by
+// MemberAttribute.Validate if the designated constraints aren't met.
+type MemberAttributeValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MemberAttributeValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MemberAttributeValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MemberAttributeValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MemberAttributeValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MemberAttributeValidationError) ErrorName() string { return "MemberAttributeValidationError" }
+
+// Error satisfies the builtin error interface
+func (e MemberAttributeValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMemberAttribute.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MemberAttributeValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MemberAttributeValidationError{}
+
+// Validate checks the field values on MemberAttributePageToken with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *MemberAttributePageToken) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MemberAttributePageToken with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// MemberAttributePageTokenMultiError, or nil if none found.
+func (m *MemberAttributePageToken) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MemberAttributePageToken) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Field
+
+	// no validation rules for LastValue
+
+	// no validation rules for Direction
+
+	// no validation rules for LastId
+
+	if len(errors) > 0 {
+		return MemberAttributePageTokenMultiError(errors)
+	}
+
+	return nil
+}
+
+// MemberAttributePageTokenMultiError is an error wrapping multiple validation
+// errors returned by MemberAttributePageToken.ValidateAll() if the designated
+// constraints aren't met.
+type MemberAttributePageTokenMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MemberAttributePageTokenMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MemberAttributePageTokenMultiError) AllErrors() []error { return m }
+
+// MemberAttributePageTokenValidationError is the validation error returned by
+// MemberAttributePageToken.Validate if the designated constraints aren't met.
+type MemberAttributePageTokenValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MemberAttributePageTokenValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MemberAttributePageTokenValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MemberAttributePageTokenValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MemberAttributePageTokenValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MemberAttributePageTokenValidationError) ErrorName() string {
+	return "MemberAttributePageTokenValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e MemberAttributePageTokenValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMemberAttributePageToken.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MemberAttributePageTokenValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MemberAttributePageTokenValidationError{}
+
+// Validate checks the field values on Card with the rules defined in the proto
+// definition for this message. If any rules are violated, the first error
+// encountered is returned, or nil if there are no violations.
+func (m *Card) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Card with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in CardMultiError, or nil if none found.
+func (m *Card) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Card) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for MemberId
+
+	// no validation rules for RfidValue
+
+	if all {
+		switch v := interface{}(m.GetValidFrom()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CardValidationError{
+					field:  "ValidFrom",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CardValidationError{
+					field:  "ValidFrom",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetValidFrom()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CardValidationError{
+				field:  "ValidFrom",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetValidTo()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CardValidationError{
+					field:  "ValidTo",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CardValidationError{
+					field:  "ValidTo",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetValidTo()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CardValidationError{
+				field:  "ValidTo",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CardMultiError(errors)
+	}
+
+	return nil
+}
+
+// CardMultiError is an error wrapping multiple validation errors returned by
+// Card.ValidateAll() if the designated constraints aren't met.
+type CardMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CardMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CardMultiError) AllErrors() []error { return m }
+
+// CardValidationError is the validation error returned by Card.Validate if the
+// designated constraints aren't met.
+type CardValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CardValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CardValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CardValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CardValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CardValidationError) ErrorName() string { return "CardValidationError" }
+
+// Error satisfies the builtin error interface
+func (e CardValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCard.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CardValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CardValidationError{}
+
+// Validate checks the field values on CardPageToken with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *CardPageToken) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CardPageToken with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in CardPageTokenMultiError, or
+// nil if none found.
+func (m *CardPageToken) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CardPageToken) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Field
+
+	// no validation rules for LastValue
+
+	// no validation rules for Direction
+
+	// no validation rules for LastId
+
+	if len(errors) > 0 {
+		return CardPageTokenMultiError(errors)
+	}
+
+	return nil
+}
+
+// CardPageTokenMultiError is an error wrapping multiple validation errors
+// returned by CardPageToken.ValidateAll() if the designated constraints
+// aren't met.
+type CardPageTokenMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CardPageTokenMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CardPageTokenMultiError) AllErrors() []error { return m }
+
+// CardPageTokenValidationError is the validation error returned by
+// CardPageToken.Validate if the designated constraints aren't met.
+type CardPageTokenValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CardPageTokenValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CardPageTokenValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CardPageTokenValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CardPageTokenValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CardPageTokenValidationError) ErrorName() string { return "CardPageTokenValidationError" }
+
+// Error satisfies the builtin error interface
+func (e CardPageTokenValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCardPageToken.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CardPageTokenValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CardPageTokenValidationError{}
+
+// Validate checks the field values on CreateCardRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *CreateCardRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateCardRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateCardRequestMultiError, or nil if none found.
+func (m *CreateCardRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateCardRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for CardId
+
+	if all {
+		switch v := interface{}(m.GetCard()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateCardRequestValidationError{
+					field:  "Card",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateCardRequestValidationError{
+					field:  "Card",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCard()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateCardRequestValidationError{
+				field:  "Card",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CreateCardRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateCardRequestMultiError is an error wrapping multiple validation errors
+// returned by CreateCardRequest.ValidateAll() if the designated constraints
+// aren't met.
+type CreateCardRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateCardRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateCardRequestMultiError) AllErrors() []error { return m }
+
+// CreateCardRequestValidationError is the validation error returned by
+// CreateCardRequest.Validate if the designated constraints aren't met.
+type CreateCardRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateCardRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateCardRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateCardRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateCardRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateCardRequestValidationError) ErrorName() string {
+	return "CreateCardRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateCardRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateCardRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateCardRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateCardRequestValidationError{}
+
+// Validate checks the field values on GetCardRequest with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *GetCardRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetCardRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in GetCardRequestMultiError,
+// or nil if none found.
+func (m *GetCardRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetCardRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if len(errors) > 0 {
+		return GetCardRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetCardRequestMultiError is an error wrapping multiple validation errors
+// returned by GetCardRequest.ValidateAll() if the designated constraints
+// aren't met.
+type GetCardRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetCardRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetCardRequestMultiError) AllErrors() []error { return m }
+
+// GetCardRequestValidationError is the validation error returned by
+// GetCardRequest.Validate if the designated constraints aren't met.
+type GetCardRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetCardRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetCardRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetCardRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetCardRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetCardRequestValidationError) ErrorName() string { return "GetCardRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GetCardRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetCardRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetCardRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetCardRequestValidationError{}
+
+// Validate checks the field values on ListCardsRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ListCardsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListCardsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListCardsRequestMultiError, or nil if none found.
+func (m *ListCardsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListCardsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for PageSize
+
+	// no validation rules for PageToken
+
+	// no validation rules for SortBy
+
+	// no validation rules for SortDirection
+
+	// no validation rules for MemberId
+
+	if all {
+		switch v := interface{}(m.GetValidOn()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ListCardsRequestValidationError{
+					field:  "ValidOn",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ListCardsRequestValidationError{
+					field:  "ValidOn",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetValidOn()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ListCardsRequestValidationError{
+				field:  "ValidOn",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for RfidValue
+
+	if len(errors) > 0 {
+		return ListCardsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListCardsRequestMultiError is an error wrapping multiple validation errors
+// returned by ListCardsRequest.ValidateAll() if the designated constraints
+// aren't met.
+type ListCardsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListCardsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListCardsRequestMultiError) AllErrors() []error { return m }
+
+// ListCardsRequestValidationError is the validation error returned by
+// ListCardsRequest.Validate if the designated constraints aren't met.
+type ListCardsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListCardsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListCardsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListCardsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListCardsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListCardsRequestValidationError) ErrorName() string { return "ListCardsRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ListCardsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListCardsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListCardsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListCardsRequestValidationError{}
+
+// Validate checks the field values on ListCardsResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ListCardsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListCardsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListCardsResponseMultiError, or nil if none found.
+func (m *ListCardsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListCardsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetCards() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListCardsResponseValidationError{
+						field:  fmt.Sprintf("Cards[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListCardsResponseValidationError{
+						field:  fmt.Sprintf("Cards[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListCardsResponseValidationError{
+					field:  fmt.Sprintf("Cards[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for NextPageToken
+
+	if len(errors) > 0 {
+		return ListCardsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListCardsResponseMultiError is an error wrapping multiple validation errors
+// returned by ListCardsResponse.ValidateAll() if the designated constraints
+// aren't met.
+type ListCardsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListCardsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListCardsResponseMultiError) AllErrors() []error { return m }
+
+// ListCardsResponseValidationError is the validation error returned by
+// ListCardsResponse.Validate if the designated constraints aren't met.
+type ListCardsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListCardsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListCardsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListCardsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListCardsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListCardsResponseValidationError) ErrorName() string {
+	return "ListCardsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListCardsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListCardsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListCardsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListCardsResponseValidationError{}
+
+// Validate checks the field values on ExportCardsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ExportCardsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ExportCardsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ExportCardsRequestMultiError, or nil if none found.
+func (m *ExportCardsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ExportCardsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetFilter()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ExportCardsRequestValidationError{
+					field:  "Filter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ExportCardsRequestValidationError{
+					field:  "Filter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetFilter()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ExportCardsRequestValidationError{
+				field:  "Filter",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Format
+
+	if len(errors) > 0 {
+		return ExportCardsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ExportCardsRequestMultiError is an error wrapping multiple validation errors
+// returned by ExportCardsRequest.ValidateAll() if the designated constraints
+// aren't met.
+type ExportCardsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ExportCardsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ExportCardsRequestMultiError) AllErrors() []error { return m }
+
+// ExportCardsRequestValidationError is the validation error returned by
+// ExportCardsRequest.Validate if the designated constraints aren't met.
+type ExportCardsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ExportCardsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ExportCardsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ExportCardsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ExportCardsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ExportCardsRequestValidationError) ErrorName() string {
+	return "ExportCardsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ExportCardsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sExportCardsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ExportCardsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ExportCardsRequestValidationError{}
+
+// Validate checks the field values on UpdateCardRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *UpdateCardRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateCardRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateCardRequestMultiError, or nil if none found.
+func (m *UpdateCardRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateCardRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetCard()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateCardRequestValidationError{
+					field:  "Card",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateCardRequestValidationError{
+					field:  "Card",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCard()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateCardRequestValidationError{
+				field:  "Card",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetFieldMask()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateCardRequestValidationError{
+					field:  "FieldMask",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateCardRequestValidationError{
+					field:  "FieldMask",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetFieldMask()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateCardRequestValidationError{
+				field:  "FieldMask",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return UpdateCardRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateCardRequestMultiError is an error wrapping multiple validation errors
+// returned by UpdateCardRequest.ValidateAll() if the designated constraints
+// aren't met.
+type UpdateCardRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateCardRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateCardRequestMultiError) AllErrors() []error { return m }
+
+// UpdateCardRequestValidationError is the validation error returned by
+// UpdateCardRequest.Validate if the designated constraints aren't met.
+type UpdateCardRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateCardRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateCardRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateCardRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateCardRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateCardRequestValidationError) ErrorName() string {
+	return "UpdateCardRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateCardRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateCardRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateCardRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateCardRequestValidationError{}
+
+// Validate checks the field values on DeleteCardRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *DeleteCardRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteCardRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteCardRequestMultiError, or nil if none found.
+func (m *DeleteCardRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteCardRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if len(errors) > 0 {
+		return DeleteCardRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteCardRequestMultiError is an error wrapping multiple validation errors
+// returned by DeleteCardRequest.ValidateAll() if the designated constraints
+// aren't met.
+type DeleteCardRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteCardRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteCardRequestMultiError) AllErrors() []error { return m }
+
+// DeleteCardRequestValidationError is the validation error returned by
+// DeleteCardRequest.Validate if the designated constraints aren't met.
+type DeleteCardRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteCardRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteCardRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteCardRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteCardRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteCardRequestValidationError) ErrorName() string {
+	return "DeleteCardRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteCardRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteCardRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteCardRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteCardRequestValidationError{}
+
+// Validate checks the field values on BriefingType with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *BriefingType) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BriefingType with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in BriefingTypeMultiError, or
+// nil if none found.
+func (m *BriefingType) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BriefingType) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for DisplayName
+
+	// no validation rules for Description
+
+	if all {
+		switch v := interface{}(m.GetExpiresAfter()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, BriefingTypeValidationError{
+					field:  "ExpiresAfter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, BriefingTypeValidationError{
+					field:  "ExpiresAfter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetExpiresAfter()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return BriefingTypeValidationError{
+				field:  "ExpiresAfter",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return BriefingTypeMultiError(errors)
+	}
+
+	return nil
+}
+
+// BriefingTypeMultiError is an error wrapping multiple validation errors
+// returned by BriefingType.ValidateAll() if the designated constraints aren't met.
+type BriefingTypeMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BriefingTypeMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BriefingTypeMultiError) AllErrors() []error { return m }
+
+// BriefingTypeValidationError is the validation error returned by
+// BriefingType.Validate if the designated constraints aren't met.
+type BriefingTypeValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BriefingTypeValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BriefingTypeValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BriefingTypeValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BriefingTypeValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BriefingTypeValidationError) ErrorName() string { return "BriefingTypeValidationError" }
+
+// Error satisfies the builtin error interface
+func (e BriefingTypeValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBriefingType.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BriefingTypeValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BriefingTypeValidationError{}
+
+// Validate checks the field values on BriefingPageToken with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *BriefingPageToken) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BriefingPageToken with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// BriefingPageTokenMultiError, or nil if none found.
+func (m *BriefingPageToken) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BriefingPageToken) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for LastId
+
+	if len(errors) > 0 {
+		return BriefingPageTokenMultiError(errors)
+	}
+
+	return nil
+}
+
+// BriefingPageTokenMultiError is an error wrapping multiple validation errors
+// returned by BriefingPageToken.ValidateAll() if the designated constraints
+// aren't met.
+type BriefingPageTokenMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BriefingPageTokenMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BriefingPageTokenMultiError) AllErrors() []error { return m }
+
+// BriefingPageTokenValidationError is the validation error returned by
+// BriefingPageToken.Validate if the designated constraints aren't met.
+type BriefingPageTokenValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BriefingPageTokenValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BriefingPageTokenValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BriefingPageTokenValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BriefingPageTokenValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BriefingPageTokenValidationError) ErrorName() string {
+	return "BriefingPageTokenValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e BriefingPageTokenValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBriefingPageToken.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BriefingPageTokenValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BriefingPageTokenValidationError{}
+
+// Validate checks the field values on CreateBriefingTypeRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateBriefingTypeRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateBriefingTypeRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateBriefingTypeRequestMultiError, or nil if none found.
+func (m *CreateBriefingTypeRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateBriefingTypeRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for BriefingTypeId
+
+	if all {
+		switch v := interface{}(m.GetBriefingType()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateBriefingTypeRequestValidationError{
+					field:  "BriefingType",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateBriefingTypeRequestValidationError{
+					field:  "BriefingType",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetBriefingType()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateBriefingTypeRequestValidationError{
+				field:  "BriefingType",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CreateBriefingTypeRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateBriefingTypeRequestMultiError is an error wrapping multiple validation
+// errors returned by CreateBriefingTypeRequest.ValidateAll() if the
+// designated constraints aren't met.
+type CreateBriefingTypeRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateBriefingTypeRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateBriefingTypeRequestMultiError) AllErrors() []error { return m }
+
+// CreateBriefingTypeRequestValidationError is the validation error returned by
+// CreateBriefingTypeRequest.Validate if the designated constraints aren't met.
+type CreateBriefingTypeRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateBriefingTypeRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateBriefingTypeRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateBriefingTypeRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateBriefingTypeRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateBriefingTypeRequestValidationError) ErrorName() string {
+	return "CreateBriefingTypeRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateBriefingTypeRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateBriefingTypeRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateBriefingTypeRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateBriefingTypeRequestValidationError{}
+
+// Validate checks the field values on GetBriefingTypeRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetBriefingTypeRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetBriefingTypeRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetBriefingTypeRequestMultiError, or nil if none found.
+func (m *GetBriefingTypeRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetBriefingTypeRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if len(errors) > 0 {
+		return GetBriefingTypeRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetBriefingTypeRequestMultiError is an error wrapping multiple validation
+// errors returned by GetBriefingTypeRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetBriefingTypeRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetBriefingTypeRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetBriefingTypeRequestMultiError) AllErrors() []error { return m }
+
+// GetBriefingTypeRequestValidationError is the validation error returned by
+// GetBriefingTypeRequest.Validate if the designated constraints aren't met.
+type GetBriefingTypeRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetBriefingTypeRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetBriefingTypeRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetBriefingTypeRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetBriefingTypeRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetBriefingTypeRequestValidationError) ErrorName() string {
+	return "GetBriefingTypeRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetBriefingTypeRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetBriefingTypeRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetBriefingTypeRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetBriefingTypeRequestValidationError{}
+
+// Validate checks the field values on ListBriefingTypesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListBriefingTypesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListBriefingTypesRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListBriefingTypesRequestMultiError, or nil if none found.
+func (m *ListBriefingTypesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListBriefingTypesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for PageSize
+
+	// no validation rules for PageToken
+
+	if len(errors) > 0 {
+		return ListBriefingTypesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListBriefingTypesRequestMultiError is an error wrapping multiple validation
+// errors returned by ListBriefingTypesRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListBriefingTypesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListBriefingTypesRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListBriefingTypesRequestMultiError) AllErrors() []error { return m }
+
+// ListBriefingTypesRequestValidationError is the validation error returned by
+// ListBriefingTypesRequest.Validate if the designated constraints aren't met.
+type ListBriefingTypesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListBriefingTypesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListBriefingTypesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListBriefingTypesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListBriefingTypesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListBriefingTypesRequestValidationError) ErrorName() string {
+	return "ListBriefingTypesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListBriefingTypesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListBriefingTypesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListBriefingTypesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListBriefingTypesRequestValidationError{}
+
+// Validate checks the field values on ListBriefingTypesResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListBriefingTypesResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListBriefingTypesResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListBriefingTypesResponseMultiError, or nil if none found.
+func (m *ListBriefingTypesResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListBriefingTypesResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetBriefingTypes() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListBriefingTypesResponseValidationError{
+						field:  fmt.Sprintf("BriefingTypes[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListBriefingTypesResponseValidationError{
+						field:  fmt.Sprintf("BriefingTypes[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListBriefingTypesResponseValidationError{
+					field:  fmt.Sprintf("BriefingTypes[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for NextPageToken
+
+	if len(errors) > 0 {
+		return ListBriefingTypesResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListBriefingTypesResponseMultiError is an error wrapping multiple validation
+// errors returned by ListBriefingTypesResponse.ValidateAll() if the
+// designated constraints aren't met.
+type ListBriefingTypesResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListBriefingTypesResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListBriefingTypesResponseMultiError) AllErrors() []error { return m }
+
+// ListBriefingTypesResponseValidationError is the validation error returned by
+// ListBriefingTypesResponse.Validate if the designated constraints aren't met.
+type ListBriefingTypesResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListBriefingTypesResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListBriefingTypesResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListBriefingTypesResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListBriefingTypesResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListBriefingTypesResponseValidationError) ErrorName() string {
+	return "ListBriefingTypesResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListBriefingTypesResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListBriefingTypesResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListBriefingTypesResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListBriefingTypesResponseValidationError{}
+
+// Validate checks the field values on UpdateBriefingTypeRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateBriefingTypeRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateBriefingTypeRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateBriefingTypeRequestMultiError, or nil if none found.
+func (m *UpdateBriefingTypeRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateBriefingTypeRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetBriefingType()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateBriefingTypeRequestValidationError{
+					field:  "BriefingType",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateBriefingTypeRequestValidationError{
+					field:  "BriefingType",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetBriefingType()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateBriefingTypeRequestValidationError{
+				field:  "BriefingType",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetFieldMask()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateBriefingTypeRequestValidationError{
+					field:  "FieldMask",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateBriefingTypeRequestValidationError{
+					field:  "FieldMask",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetFieldMask()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateBriefingTypeRequestValidationError{
+				field:  "FieldMask",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return UpdateBriefingTypeRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateBriefingTypeRequestMultiError is an error wrapping multiple validation
+// errors returned by UpdateBriefingTypeRequest.ValidateAll() if the
+// designated constraints aren't met.
+type UpdateBriefingTypeRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateBriefingTypeRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateBriefingTypeRequestMultiError) AllErrors() []error { return m }
+
+// UpdateBriefingTypeRequestValidationError is the validation error returned by
+// UpdateBriefingTypeRequest.Validate if the designated constraints aren't met.
+type UpdateBriefingTypeRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateBriefingTypeRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateBriefingTypeRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateBriefingTypeRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateBriefingTypeRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateBriefingTypeRequestValidationError) ErrorName() string {
+	return "UpdateBriefingTypeRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateBriefingTypeRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateBriefingTypeRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateBriefingTypeRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateBriefingTypeRequestValidationError{}
+
+// Validate checks the field values on DeleteBriefingTypeRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteBriefingTypeRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteBriefingTypeRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteBriefingTypeRequestMultiError, or nil if none found.
+func (m *DeleteBriefingTypeRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteBriefingTypeRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if len(errors) > 0 {
+		return DeleteBriefingTypeRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteBriefingTypeRequestMultiError is an error wrapping multiple validation
+// errors returned by DeleteBriefingTypeRequest.ValidateAll() if the
+// designated constraints aren't met.
+type DeleteBriefingTypeRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteBriefingTypeRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteBriefingTypeRequestMultiError) AllErrors() []error { return m }
+
+// DeleteBriefingTypeRequestValidationError is the validation error returned by
+// DeleteBriefingTypeRequest.Validate if the designated constraints aren't met.
+type DeleteBriefingTypeRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteBriefingTypeRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteBriefingTypeRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteBriefingTypeRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteBriefingTypeRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteBriefingTypeRequestValidationError) ErrorName() string {
+	return "DeleteBriefingTypeRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteBriefingTypeRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteBriefingTypeRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteBriefingTypeRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteBriefingTypeRequestValidationError{}
+
+// Validate checks the field values on Briefing with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Briefing) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Briefing with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in BriefingMultiError, or nil
+// if none found.
+func (m *Briefing) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Briefing) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for BriefingType
+
+	// no validation rules for MemberId
+
+	if all {
+		switch v := interface{}(m.GetBriefingTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, BriefingValidationError{
+					field:  "BriefingTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, BriefingValidationError{
+					field:  "BriefingTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetBriefingTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return BriefingValidationError{
+				field:  "BriefingTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetExpiryTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, BriefingValidationError{
+					field:  "ExpiryTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, BriefingValidationError{
+					field:  "ExpiryTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetExpiryTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return BriefingValidationError{
+				field:  "ExpiryTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return BriefingMultiError(errors)
+	}
+
+	return nil
+}
+
+// BriefingMultiError is an error wrapping multiple validation errors returned
+// by Briefing.ValidateAll() if the designated constraints aren't met.
+type BriefingMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BriefingMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BriefingMultiError) AllErrors() []error { return m }
+
+// BriefingValidationError is the validation error returned by
+// Briefing.Validate if the designated constraints aren't met.
+type BriefingValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BriefingValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BriefingValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BriefingValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BriefingValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BriefingValidationError) ErrorName() string { return "BriefingValidationError" }
+
+// Error satisfies the builtin error interface
+func (e BriefingValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBriefing.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BriefingValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BriefingValidationError{}
+
+// Validate checks the field values on CreateBriefingRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateBriefingRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateBriefingRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateBriefingRequestMultiError, or nil if none found.
+func (m *CreateBriefingRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateBriefingRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for BriefingId
+
+	if all {
+		switch v := interface{}(m.GetBriefing()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateBriefingRequestValidationError{
+					field:  "Briefing",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateBriefingRequestValidationError{
+					field:  "Briefing",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetBriefing()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateBriefingRequestValidationError{
+				field:  "Briefing",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CreateBriefingRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateBriefingRequestMultiError is an error wrapping multiple validation
+// errors returned by CreateBriefingRequest.ValidateAll() if the designated
+// constraints aren't met.
+type CreateBriefingRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateBriefingRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateBriefingRequestMultiError) AllErrors() []error { return m }
+
+// CreateBriefingRequestValidationError is the validation error returned by
+// CreateBriefingRequest.Validate if the designated constraints aren't met.
+type CreateBriefingRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateBriefingRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateBriefingRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateBriefingRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateBriefingRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateBriefingRequestValidationError) ErrorName() string {
+	return "CreateBriefingRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateBriefingRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateBriefingRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateBriefingRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateBriefingRequestValidationError{}
+
+// Validate checks the field values on GetBriefingRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetBriefingRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetBriefingRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetBriefingRequestMultiError, or nil if none found.
+func (m *GetBriefingRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetBriefingRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if len(errors) > 0 {
+		return GetBriefingRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetBriefingRequestMultiError is an error wrapping multiple validation errors
+// returned by GetBriefingRequest.ValidateAll() if the designated constraints
+// aren't met.
+type GetBriefingRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetBriefingRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetBriefingRequestMultiError) AllErrors() []error { return m }
+
+// GetBriefingRequestValidationError is the validation error returned by
+// GetBriefingRequest.Validate if the designated constraints aren't met.
+type GetBriefingRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetBriefingRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetBriefingRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetBriefingRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetBriefingRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetBriefingRequestValidationError) ErrorName() string {
+	return "GetBriefingRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetBriefingRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetBriefingRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetBriefingRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetBriefingRequestValidationError{}
+
+// Validate checks the field values on ListBriefingsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListBriefingsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListBriefingsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListBriefingsRequestMultiError, or nil if none found.
+func (m *ListBriefingsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListBriefingsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for PageSize
+
+	// no validation rules for PageToken
+
+	// no validation rules for ValidOnly
+
+	if m.MemberId != nil {
+		// no validation rules for MemberId
+	}
+
+	if m.BriefingType != nil {
+		// no validation rules for BriefingType
+	}
+
+	if len(errors) > 0 {
+		return ListBriefingsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListBriefingsRequestMultiError is an error wrapping multiple validation
+// errors returned by ListBriefingsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListBriefingsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListBriefingsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListBriefingsRequestMultiError) AllErrors() []error { return m }
+
+// ListBriefingsRequestValidationError is the validation error returned by
+// ListBriefingsRequest.Validate if the designated constraints aren't met.
+type ListBriefingsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListBriefingsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListBriefingsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListBriefingsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListBriefingsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListBriefingsRequestValidationError) ErrorName() string {
+	return "ListBriefingsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListBriefingsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListBriefingsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListBriefingsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListBriefingsRequestValidationError{}
+
+// Validate checks the field values on ListBriefingsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListBriefingsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListBriefingsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListBriefingsResponseMultiError, or nil if none found.
+func (m *ListBriefingsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListBriefingsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetBriefings() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListBriefingsResponseValidationError{
+						field:  fmt.Sprintf("Briefings[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListBriefingsResponseValidationError{
+						field:  fmt.Sprintf("Briefings[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListBriefingsResponseValidationError{
+					field:  fmt.Sprintf("Briefings[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for NextPageToken
+
+	if len(errors) > 0 {
+		return ListBriefingsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListBriefingsResponseMultiError is an error wrapping multiple validation
+// errors returned by ListBriefingsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ListBriefingsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListBriefingsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListBriefingsResponseMultiError) AllErrors() []error { return m }
+
+// ListBriefingsResponseValidationError is the validation error returned by
+// ListBriefingsResponse.Validate if the designated constraints aren't met.
+type ListBriefingsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListBriefingsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListBriefingsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListBriefingsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListBriefingsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListBriefingsResponseValidationError) ErrorName() string {
+	return "ListBriefingsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListBriefingsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListBriefingsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListBriefingsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListBriefingsResponseValidationError{}
+
+// Validate checks the field values on UpdateBriefingRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateBriefingRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateBriefingRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateBriefingRequestMultiError, or nil if none found.
+func (m *UpdateBriefingRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateBriefingRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetBriefing()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateBriefingRequestValidationError{
+					field:  "Briefing",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateBriefingRequestValidationError{
+					field:  "Briefing",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetBriefing()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateBriefingRequestValidationError{
+				field:  "Briefing",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetFieldMask()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateBriefingRequestValidationError{
+					field:  "FieldMask",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateBriefingRequestValidationError{
+					field:  "FieldMask",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetFieldMask()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateBriefingRequestValidationError{
+				field:  "FieldMask",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return UpdateBriefingRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateBriefingRequestMultiError is an error wrapping multiple validation
+// errors returned by UpdateBriefingRequest.ValidateAll() if the designated
+// constraints aren't met.
+type UpdateBriefingRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateBriefingRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateBriefingRequestMultiError) AllErrors() []error { return m }
+
+// UpdateBriefingRequestValidationError is the validation error returned by
+// UpdateBriefingRequest.Validate if the designated constraints aren't met.
+type UpdateBriefingRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateBriefingRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateBriefingRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateBriefingRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateBriefingRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateBriefingRequestValidationError) ErrorName() string {
+	return "UpdateBriefingRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateBriefingRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateBriefingRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateBriefingRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateBriefingRequestValidationError{}
+
+// Validate checks the field values on DeleteBriefingRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteBriefingRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteBriefingRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteBriefingRequestMultiError, or nil if none found.
+func (m *DeleteBriefingRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteBriefingRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if len(errors) > 0 {
+		return DeleteBriefingRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteBriefingRequestMultiError is an error wrapping multiple validation
+// errors returned by DeleteBriefingRequest.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteBriefingRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteBriefingRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteBriefingRequestMultiError) AllErrors() []error { return m }
+
+// DeleteBriefingRequestValidationError is the validation error returned by
+// DeleteBriefingRequest.Validate if the designated constraints aren't met.
+type DeleteBriefingRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteBriefingRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteBriefingRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteBriefingRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteBriefingRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteBriefingRequestValidationError) ErrorName() string {
+	return "DeleteBriefingRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteBriefingRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteBriefingRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteBriefingRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteBriefingRequestValidationError{}
+
+// Validate checks the field values on Presence with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Presence) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Presence with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in PresenceMultiError, or nil
+// if none found.
+func (m *Presence) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Presence) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for MemberId
+
+	if all {
+		switch v := interface{}(m.GetCheckinTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PresenceValidationError{
+					field:  "CheckinTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PresenceValidationError{
+					field:  "CheckinTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCheckinTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PresenceValidationError{
+				field:  "CheckinTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetCheckoutTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PresenceValidationError{
+					field:  "CheckoutTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PresenceValidationError{
+					field:  "CheckoutTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCheckoutTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PresenceValidationError{
+				field:  "CheckoutTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for AutoClosed
+
+	if m.TerminalId != nil {
+		// no validation rules for TerminalId
+	}
+
+	if len(errors) > 0 {
+		return PresenceMultiError(errors)
+	}
+
+	return nil
+}
+
+// PresenceMultiError is an error wrapping multiple validation errors returned
+// by Presence.ValidateAll() if the designated constraints aren't met.
+type PresenceMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PresenceMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PresenceMultiError) AllErrors() []error { return m }
+
+// PresenceValidationError is the validation error returned by
+// Presence.Validate if the designated constraints aren't met.
+type PresenceValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PresenceValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PresenceValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PresenceValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PresenceValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PresenceValidationError) ErrorName() string { return "PresenceValidationError" }
+
+// Error satisfies the builtin error interface
+func (e PresenceValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPresence.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PresenceValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PresenceValidationError{}
+
+// Validate checks the field values on ListPresencesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListPresencesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListPresencesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListPresencesRequestMultiError, or nil if none found.
+func (m *ListPresencesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListPresencesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for PageSize
+
+	// no validation rules for PageToken
+
+	// no validation rules for SortBy
+
+	// no validation rules for SortDirection
+
+	if m.MemberId != nil {
+		// no validation rules for MemberId
+	}
+
+	if m.CheckinTimeAfter != nil {
+
+		if all {
+			switch v := interface{}(m.GetCheckinTimeAfter()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListPresencesRequestValidationError{
+						field:  "CheckinTimeAfter",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListPresencesRequestValidationError{
+						field:  "CheckinTimeAfter",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetCheckinTimeAfter()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListPresencesRequestValidationError{
+					field:  "CheckinTimeAfter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if m.CheckinTimeBefore != nil {
+
+		if all {
+			switch v := interface{}(m.GetCheckinTimeBefore()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListPresencesRequestValidationError{
+						field:  "CheckinTimeBefore",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListPresencesRequestValidationError{
+						field:  "CheckinTimeBefore",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetCheckinTimeBefore()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListPresencesRequestValidationError{
+					field:  "CheckinTimeBefore",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if m.CheckoutTimeAfter != nil {
+
+		if all {
+			switch v := interface{}(m.GetCheckoutTimeAfter()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListPresencesRequestValidationError{
+						field:  "CheckoutTimeAfter",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListPresencesRequestValidationError{
+						field:  "CheckoutTimeAfter",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetCheckoutTimeAfter()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListPresencesRequestValidationError{
+					field:  "CheckoutTimeAfter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if m.CheckoutTimeBefore != nil {
+
+		if all {
+			switch v := interface{}(m.GetCheckoutTimeBefore()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListPresencesRequestValidationError{
+						field:  "CheckoutTimeBefore",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListPresencesRequestValidationError{
+						field:  "CheckoutTimeBefore",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetCheckoutTimeBefore()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListPresencesRequestValidationError{
+					field:  "CheckoutTimeBefore",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListPresencesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListPresencesRequestMultiError is an error wrapping multiple validation
+// errors returned by ListPresencesRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListPresencesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListPresencesRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListPresencesRequestMultiError) AllErrors() []error { return m }
+
+// ListPresencesRequestValidationError is the validation error returned by
+// ListPresencesRequest.Validate if the designated constraints aren't met.
+type ListPresencesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListPresencesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListPresencesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListPresencesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListPresencesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListPresencesRequestValidationError) ErrorName() string {
+	return "ListPresencesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListPresencesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListPresencesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListPresencesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListPresencesRequestValidationError{}
+
+// Validate checks the field values on ListPresencesResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListPresencesResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListPresencesResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListPresencesResponseMultiError, or nil if none found.
+func (m *ListPresencesResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListPresencesResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetPresence() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListPresencesResponseValidationError{
+						field:  fmt.Sprintf("Presence[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListPresencesResponseValidationError{
+						field:  fmt.Sprintf("Presence[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListPresencesResponseValidationError{
+					field:  fmt.Sprintf("Presence[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for NextPageToken
+
+	if len(errors) > 0 {
+		return ListPresencesResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListPresencesResponseMultiError is an error wrapping multiple validation
+// errors returned by ListPresencesResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ListPresencesResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListPresencesResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListPresencesResponseMultiError) AllErrors() []error { return m }
+
+// ListPresencesResponseValidationError is the validation error returned by
+// ListPresencesResponse.Validate if the designated constraints aren't met.
+type ListPresencesResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListPresencesResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListPresencesResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListPresencesResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListPresencesResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListPresencesResponseValidationError) ErrorName() string {
+	return "ListPresencesResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListPresencesResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListPresencesResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListPresencesResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListPresencesResponseValidationError{}
+
+// Validate checks the field values on ExportPresencesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ExportPresencesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ExportPresencesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ExportPresencesRequestMultiError, or nil if none found.
+func (m *ExportPresencesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ExportPresencesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetFilter()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ExportPresencesRequestValidationError{
+					field:  "Filter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ExportPresencesRequestValidationError{
+					field:  "Filter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetFilter()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ExportPresencesRequestValidationError{
+				field:  "Filter",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Format
+
+	if len(errors) > 0 {
+		return ExportPresencesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ExportPresencesRequestMultiError is an error wrapping multiple validation
+// errors returned by ExportPresencesRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ExportPresencesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ExportPresencesRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ExportPresencesRequestMultiError) AllErrors() []error { return m }
+
+// ExportPresencesRequestValidationError is the validation error returned by
+// ExportPresencesRequest.Validate if the designated constraints aren't met.
+type ExportPresencesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ExportPresencesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ExportPresencesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ExportPresencesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ExportPresencesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ExportPresencesRequestValidationError) ErrorName() string {
+	return "ExportPresencesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ExportPresencesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sExportPresencesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ExportPresencesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ExportPresencesRequestValidationError{}
+
+// Validate checks the field values on PresencePageToken with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *PresencePageToken) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PresencePageToken with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PresencePageTokenMultiError, or nil if none found.
+func (m *PresencePageToken) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PresencePageToken) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Field
+
+	// no validation rules for LastValue
+
+	// no validation rules for Direction
+
+	// no validation rules for LastId
+
+	if len(errors) > 0 {
+		return PresencePageTokenMultiError(errors)
+	}
+
+	return nil
+}
+
+// PresencePageTokenMultiError is an error wrapping multiple validation errors
+// returned by PresencePageToken.ValidateAll() if the designated constraints
+// aren't met.
+type PresencePageTokenMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PresencePageTokenMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PresencePageTokenMultiError) AllErrors() []error { return m }
+
+// PresencePageTokenValidationError is the validation error returned by
+// PresencePageToken.Validate if the designated constraints aren't met.
+type PresencePageTokenValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PresencePageTokenValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PresencePageTokenValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PresencePageTokenValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PresencePageTokenValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PresencePageTokenValidationError) ErrorName() string {
+	return "PresencePageTokenValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PresencePageTokenValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPresencePageToken.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PresencePageTokenValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PresencePageTokenValidationError{}
+
+// Validate checks the field values on CheckinRequest with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *CheckinRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CheckinRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in CheckinRequestMultiError,
+// or nil if none found.
+func (m *CheckinRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CheckinRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for MemberId
+
+	if len(errors) > 0 {
+		return CheckinRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CheckinRequestMultiError is an error wrapping multiple validation errors
+// returned by CheckinRequest.ValidateAll() if the designated constraints
+// aren't met.
+type CheckinRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CheckinRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CheckinRequestMultiError) AllErrors() []error { return m }
+
+// CheckinRequestValidationError is the validation error returned by
+// CheckinRequest.Validate if the designated constraints aren't met.
+type CheckinRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CheckinRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CheckinRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CheckinRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CheckinRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CheckinRequestValidationError) ErrorName() string { return "CheckinRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e CheckinRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCheckinRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CheckinRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CheckinRequestValidationError{}
+
+// Validate checks the field values on CheckoutRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *CheckoutRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CheckoutRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CheckoutRequestMultiError, or nil if none found.
+func (m *CheckoutRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CheckoutRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for MemberId
+
+	if len(errors) > 0 {
+		return CheckoutRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CheckoutRequestMultiError is an error wrapping multiple validation errors
+// returned by CheckoutRequest.ValidateAll() if the designated constraints
+// aren't met.
+type CheckoutRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CheckoutRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CheckoutRequestMultiError) AllErrors() []error { return m }
+
+// CheckoutRequestValidationError is the validation error returned by
+// CheckoutRequest.Validate if the designated constraints aren't met.
+type CheckoutRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CheckoutRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CheckoutRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CheckoutRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CheckoutRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CheckoutRequestValidationError) ErrorName() string { return "CheckoutRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e CheckoutRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCheckoutRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CheckoutRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CheckoutRequestValidationError{}
+
+// Validate checks the field values on TogglePresenceRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *TogglePresenceRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TogglePresenceRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// TogglePresenceRequestMultiError, or nil if none found.
+func (m *TogglePresenceRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TogglePresenceRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for MemberId
+
+	if len(errors) > 0 {
+		return TogglePresenceRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// TogglePresenceRequestMultiError is an error wrapping multiple validation
+// errors returned by TogglePresenceRequest.ValidateAll() if the designated
+// constraints aren't met.
+type TogglePresenceRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TogglePresenceRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TogglePresenceRequestMultiError) AllErrors() []error { return m }
+
+// TogglePresenceRequestValidationError is the validation error returned by
+// TogglePresenceRequest.Validate if the designated constraints aren't met.
+type TogglePresenceRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TogglePresenceRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TogglePresenceRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TogglePresenceRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TogglePresenceRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TogglePresenceRequestValidationError) ErrorName() string {
+	return "TogglePresenceRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e TogglePresenceRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTogglePresenceRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TogglePresenceRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TogglePresenceRequestValidationError{}
+
+// Validate checks the field values on TogglePresenceResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *TogglePresenceResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TogglePresenceResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// TogglePresenceResponseMultiError, or nil if none found.
+func (m *TogglePresenceResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TogglePresenceResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetPresence()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, TogglePresenceResponseValidationError{
+					field:  "Presence",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, TogglePresenceResponseValidationError{
+					field:  "Presence",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPresence()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return TogglePresenceResponseValidationError{
+				field:  "Presence",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Action
+
+	if len(errors) > 0 {
+		return TogglePresenceResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// TogglePresenceResponseMultiError is an error wrapping multiple validation
+// errors returned by TogglePresenceResponse.ValidateAll() if the designated
+// constraints aren't met.
+type TogglePresenceResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TogglePresenceResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TogglePresenceResponseMultiError) AllErrors() []error { return m }
+
+// TogglePresenceResponseValidationError is the validation error returned by
+// TogglePresenceResponse.Validate if the designated constraints aren't met.
+type TogglePresenceResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TogglePresenceResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TogglePresenceResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TogglePresenceResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TogglePresenceResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TogglePresenceResponseValidationError) ErrorName() string {
+	return "TogglePresenceResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e TogglePresenceResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTogglePresenceResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TogglePresenceResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TogglePresenceResponseValidationError{}
+
+// Validate checks the field values on CheckinByCardRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CheckinByCardRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CheckinByCardRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CheckinByCardRequestMultiError, or nil if none found.
+func (m *CheckinByCardRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CheckinByCardRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for RfidValue
+
+	// no validation rules for TerminalId
+
+	if len(errors) > 0 {
+		return CheckinByCardRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CheckinByCardRequestMultiError is an error wrapping multiple validation
+// errors returned by CheckinByCardRequest.ValidateAll() if the designated
+// constraints aren't met.
+type CheckinByCardRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CheckinByCardRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CheckinByCardRequestMultiError) AllErrors() []error { return m }
+
+// CheckinByCardRequestValidationError is the validation error returned by
+// CheckinByCardRequest.Validate if the designated constraints aren't met.
+type CheckinByCardRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CheckinByCardRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CheckinByCardRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CheckinByCardRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CheckinByCardRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CheckinByCardRequestValidationError) ErrorName() string {
+	return "CheckinByCardRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CheckinByCardRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCheckinByCardRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CheckinByCardRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CheckinByCardRequestValidationError{}
+
+// Validate checks the field values on UpdatePresenceRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdatePresenceRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdatePresenceRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdatePresenceRequestMultiError, or nil if none found.
+func (m *UpdatePresenceRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdatePresenceRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetPresence()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdatePresenceRequestValidationError{
+					field:  "Presence",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdatePresenceRequestValidationError{
+					field:  "Presence",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPresence()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdatePresenceRequestValidationError{
+				field:  "Presence",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetFieldMask()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdatePresenceRequestValidationError{
+					field:  "FieldMask",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdatePresenceRequestValidationError{
+					field:  "FieldMask",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetFieldMask()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdatePresenceRequestValidationError{
+				field:  "FieldMask",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return UpdatePresenceRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdatePresenceRequestMultiError is an error wrapping multiple validation
+// errors returned by UpdatePresenceRequest.ValidateAll() if the designated
+// constraints aren't met.
+type UpdatePresenceRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdatePresenceRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdatePresenceRequestMultiError) AllErrors() []error { return m }
+
+// UpdatePresenceRequestValidationError is the validation error returned by
+// UpdatePresenceRequest.Validate if the designated constraints aren't met.
+type UpdatePresenceRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdatePresenceRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdatePresenceRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdatePresenceRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdatePresenceRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdatePresenceRequestValidationError) ErrorName() string {
+	return "UpdatePresenceRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdatePresenceRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdatePresenceRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdatePresenceRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdatePresenceRequestValidationError{}
+
+// Validate checks the field values on DeletePresenceRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeletePresenceRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeletePresenceRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeletePresenceRequestMultiError, or nil if none found.
+func (m *DeletePresenceRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeletePresenceRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if len(errors) > 0 {
+		return DeletePresenceRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeletePresenceRequestMultiError is an error wrapping multiple validation
+// errors returned by DeletePresenceRequest.ValidateAll() if the designated
+// constraints aren't met.
+type DeletePresenceRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeletePresenceRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeletePresenceRequestMultiError) AllErrors() []error { return m }
+
+// DeletePresenceRequestValidationError is the validation error returned by
+// DeletePresenceRequest.Validate if the designated constraints aren't met.
+type DeletePresenceRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeletePresenceRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeletePresenceRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeletePresenceRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeletePresenceRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeletePresenceRequestValidationError) ErrorName() string {
+	return "DeletePresenceRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeletePresenceRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeletePresenceRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeletePresenceRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeletePresenceRequestValidationError{}
+
+// Validate checks the field values on Event with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Event) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Event with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in EventMultiError, or nil if none found.
+func (m *Event) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Event) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Title
+
+	// no validation rules for Description
+
+	if all {
+		switch v := interface{}(m.GetStartTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, EventValidationError{
+					field:  "StartTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, EventValidationError{
+					field:  "StartTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetStartTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return EventValidationError{
+				field:  "StartTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetEndTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, EventValidationError{
+					field:  "EndTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, EventValidationError{
+					field:  "EndTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetEndTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return EventValidationError{
+				field:  "EndTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Location
+
+	// no validation rules for Capacity
+
+	// no validation rules for RegisteredCount
+
+	// no validation rules for WaitlistCount
+
+	if len(errors) > 0 {
+		return EventMultiError(errors)
+	}
+
+	return nil
+}
+
+// EventMultiError is an error wrapping multiple validation errors returned by
+// Event.ValidateAll() if the designated constraints aren't met.
+type EventMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m EventMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m EventMultiError) AllErrors() []error { return m }
+
+// EventValidationError is the validation error returned by Event.Validate if
+// the designated constraints aren't met.
+type EventValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e EventValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e EventValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e EventValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e EventValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e EventValidationError) ErrorName() string { return "EventValidationError" }
+
+// Error satisfies the builtin error interface
+func (e EventValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sEvent.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = EventValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = EventValidationError{}
+
+// Validate checks the field values on EventPageToken with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *EventPageToken) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on EventPageToken with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in EventPageTokenMultiError,
+// or nil if none found.
+func (m *EventPageToken) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *EventPageToken) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Field
+
+	// no validation rules for LastValue
+
+	// no validation rules for Direction
+
+	// no validation rules for LastId
+
+	if len(errors) > 0 {
+		return EventPageTokenMultiError(errors)
+	}
+
+	return nil
+}
+
+// EventPageTokenMultiError is an error wrapping multiple validation errors
+// returned by EventPageToken.ValidateAll() if the designated constraints
+// aren't met.
+type EventPageTokenMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m EventPageTokenMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m EventPageTokenMultiError) AllErrors() []error { return m }
+
+// EventPageTokenValidationError is the validation error returned by
+// EventPageToken.Validate if the designated constraints aren't met.
+type EventPageTokenValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e EventPageTokenValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e EventPageTokenValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e EventPageTokenValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e EventPageTokenValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e EventPageTokenValidationError) ErrorName() string { return "EventPageTokenValidationError" }
+
+// Error satisfies the builtin error interface
+func (e EventPageTokenValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sEventPageToken.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = EventPageTokenValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = EventPageTokenValidationError{}
+
+// Validate checks the field values on CreateEventRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateEventRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateEventRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateEventRequestMultiError, or nil if none found.
+func (m *CreateEventRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateEventRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for EventId
+
+	if all {
+		switch v := interface{}(m.GetEvent()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateEventRequestValidationError{
+					field:  "Event",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateEventRequestValidationError{
+					field:  "Event",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetEvent()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateEventRequestValidationError{
+				field:  "Event",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CreateEventRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateEventRequestMultiError is an error wrapping multiple validation errors
+// returned by CreateEventRequest.ValidateAll() if the designated constraints
+// aren't met.
+type CreateEventRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateEventRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateEventRequestMultiError) AllErrors() []error { return m }
+
+// CreateEventRequestValidationError is the validation error returned by
+// CreateEventRequest.Validate if the designated constraints aren't met.
+type CreateEventRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateEventRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateEventRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateEventRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateEventRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateEventRequestValidationError) ErrorName() string {
+	return "CreateEventRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateEventRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateEventRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateEventRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateEventRequestValidationError{}
+
+// Validate checks the field values on GetEventRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GetEventRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetEventRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetEventRequestMultiError, or nil if none found.
+func (m *GetEventRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetEventRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if len(errors) > 0 {
+		return GetEventRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetEventRequestMultiError is an error wrapping multiple validation errors
+// returned by GetEventRequest.ValidateAll() if the designated constraints
+// aren't met.
+type GetEventRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetEventRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetEventRequestMultiError) AllErrors() []error { return m }
+
+// GetEventRequestValidationError is the validation error returned by
+// GetEventRequest.Validate if the designated constraints aren't met.
+type GetEventRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetEventRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetEventRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetEventRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetEventRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetEventRequestValidationError) ErrorName() string { return "GetEventRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GetEventRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetEventRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetEventRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetEventRequestValidationError{}
+
+// Validate checks the field values on ListEventsRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ListEventsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListEventsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListEventsRequestMultiError, or nil if none found.
+func (m *ListEventsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListEventsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for PageSize
+
+	// no validation rules for PageToken
+
+	// no validation rules for SortBy
+
+	// no validation rules for SortDirection
+
+	if m.StartTimeAfter != nil {
+
+		if all {
+			switch v := interface{}(m.GetStartTimeAfter()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListEventsRequestValidationError{
+						field:  "StartTimeAfter",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListEventsRequestValidationError{
+						field:  "StartTimeAfter",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetStartTimeAfter()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListEventsRequestValidationError{
+					field:  "StartTimeAfter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if m.StartTimeBefore != nil {
+
+		if all {
+			switch v := interface{}(m.GetStartTimeBefore()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListEventsRequestValidationError{
+						field:  "StartTimeBefore",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListEventsRequestValidationError{
+						field:  "StartTimeBefore",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetStartTimeBefore()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListEventsRequestValidationError{
+					field:  "StartTimeBefore",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListEventsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListEventsRequestMultiError is an error wrapping multiple validation errors
+// returned by ListEventsRequest.ValidateAll() if the designated constraints
+// aren't met.
+type ListEventsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListEventsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListEventsRequestMultiError) AllErrors() []error { return m }
+
+// ListEventsRequestValidationError is the validation error returned by
+// ListEventsRequest.Validate if the designated constraints aren't met.
+type ListEventsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListEventsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListEventsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListEventsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListEventsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListEventsRequestValidationError) ErrorName() string {
+	return "ListEventsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListEventsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListEventsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListEventsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListEventsRequestValidationError{}
+
+// Validate checks the field values on ListEventsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListEventsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListEventsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListEventsResponseMultiError, or nil if none found.
+func (m *ListEventsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListEventsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetEvents() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListEventsResponseValidationError{
+						field:  fmt.Sprintf("Events[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListEventsResponseValidationError{
+						field:  fmt.Sprintf("Events[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListEventsResponseValidationError{
+					field:  fmt.Sprintf("Events[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for NextPageToken
+
+	if len(errors) > 0 {
+		return ListEventsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListEventsResponseMultiError is an error wrapping multiple validation errors
+// returned by ListEventsResponse.ValidateAll() if the designated constraints
+// aren't met.
+type ListEventsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListEventsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListEventsResponseMultiError) AllErrors() []error { return m }
+
+// ListEventsResponseValidationError is the validation error returned by
+// ListEventsResponse.Validate if the designated constraints aren't met.
+type ListEventsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListEventsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListEventsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListEventsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListEventsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListEventsResponseValidationError) ErrorName() string {
+	return "ListEventsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListEventsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListEventsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListEventsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListEventsResponseValidationError{}
+
+// Validate checks the field values on UpdateEventRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateEventRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateEventRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateEventRequestMultiError, or nil if none found.
+func (m *UpdateEventRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateEventRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetEvent()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateEventRequestValidationError{
+					field:  "Event",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateEventRequestValidationError{
+					field:  "Event",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetEvent()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateEventRequestValidationError{
+				field:  "Event",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetFieldMask()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateEventRequestValidationError{
+					field:  "FieldMask",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateEventRequestValidationError{
+					field:  "FieldMask",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetFieldMask()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateEventRequestValidationError{
+				field:  "FieldMask",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return UpdateEventRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateEventRequestMultiError is an error wrapping multiple validation errors
+// returned by UpdateEventRequest.ValidateAll() if the designated constraints
+// aren't met.
+type UpdateEventRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateEventRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateEventRequestMultiError) AllErrors() []error { return m }
+
+// UpdateEventRequestValidationError is the validation error returned by
+// UpdateEventRequest.Validate if the designated constraints aren't met.
+type UpdateEventRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateEventRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateEventRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateEventRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateEventRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateEventRequestValidationError) ErrorName() string {
+	return "UpdateEventRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateEventRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateEventRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateEventRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateEventRequestValidationError{}
+
+// Validate checks the field values on DeleteEventRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteEventRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteEventRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteEventRequestMultiError, or nil if none found.
+func (m *DeleteEventRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteEventRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if len(errors) > 0 {
+		return DeleteEventRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteEventRequestMultiError is an error wrapping multiple validation errors
+// returned by DeleteEventRequest.ValidateAll() if the designated constraints
+// aren't met.
+type DeleteEventRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteEventRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteEventRequestMultiError) AllErrors() []error { return m }
+
+// DeleteEventRequestValidationError is the validation error returned by
+// DeleteEventRequest.Validate if the designated constraints aren't met.
+type DeleteEventRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteEventRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteEventRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteEventRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteEventRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteEventRequestValidationError) ErrorName() string {
+	return "DeleteEventRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteEventRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteEventRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteEventRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteEventRequestValidationError{}
+
+// Validate checks the field values on EventRegistration with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *EventRegistration) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on EventRegistration with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// EventRegistrationMultiError, or nil if none found.
+func (m *EventRegistration) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *EventRegistration) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for EventId
+
+	// no validation rules for MemberId
+
+	// no validation rules for Status
+
+	if all {
+		switch v := interface{}(m.GetRegistrationTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, EventRegistrationValidationError{
+					field:  "RegistrationTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, EventRegistrationValidationError{
+					field:  "RegistrationTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRegistrationTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return EventRegistrationValidationError{
+				field:  "RegistrationTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetCancellationTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, EventRegistrationValidationError{
+					field:  "CancellationTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, EventRegistrationValidationError{
+					field:  "CancellationTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCancellationTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return EventRegistrationValidationError{
+				field:  "CancellationTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Attended
+
+	if len(errors) > 0 {
+		return EventRegistrationMultiError(errors)
+	}
+
+	return nil
+}
+
+// EventRegistrationMultiError is an error wrapping multiple validation errors
+// returned by EventRegistration.ValidateAll() if the designated constraints
+// aren't met.
+type EventRegistrationMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m EventRegistrationMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m EventRegistrationMultiError) AllErrors() []error { return m }
+
+// EventRegistrationValidationError is the validation error returned by
+// EventRegistration.Validate if the designated constraints aren't met.
+type EventRegistrationValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e EventRegistrationValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e EventRegistrationValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e EventRegistrationValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e EventRegistrationValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e EventRegistrationValidationError) ErrorName() string {
+	return "EventRegistrationValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e EventRegistrationValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sEventRegistration.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = EventRegistrationValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = EventRegistrationValidationError{}
+
+// Validate checks the field values on EventRegistrationPageToken with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *EventRegistrationPageToken) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on EventRegistrationPageToken with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// EventRegistrationPageTokenMultiError, or nil if none found.
+func (m *EventRegistrationPageToken) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *EventRegistrationPageToken) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetLastRegistrationTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, EventRegistrationPageTokenValidationError{
+					field:  "LastRegistrationTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, EventRegistrationPageTokenValidationError{
+					field:  "LastRegistrationTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetLastRegistrationTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return EventRegistrationPageTokenValidationError{
+				field:  "LastRegistrationTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for LastId
+
+	if len(errors) > 0 {
+		return EventRegistrationPageTokenMultiError(errors)
+	}
+
+	return nil
+}
+
+// EventRegistrationPageTokenMultiError is an error wrapping multiple
+// validation errors returned by EventRegistrationPageToken.ValidateAll() if
+// the designated constraints aren't met.
+type EventRegistrationPageTokenMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m EventRegistrationPageTokenMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m EventRegistrationPageTokenMultiError) AllErrors() []error { return m }
+
+// EventRegistrationPageTokenValidationError is the validation error returned
+// by EventRegistrationPageToken.Validate if the designated constraints aren't met.
+type EventRegistrationPageTokenValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e EventRegistrationPageTokenValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e EventRegistrationPageTokenValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e EventRegistrationPageTokenValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e EventRegistrationPageTokenValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e EventRegistrationPageTokenValidationError) ErrorName() string {
+	return "EventRegistrationPageTokenValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e EventRegistrationPageTokenValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sEventRegistrationPageToken.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = EventRegistrationPageTokenValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = EventRegistrationPageTokenValidationError{}
+
+// Validate checks the field values on RegisterForEventRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RegisterForEventRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RegisterForEventRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RegisterForEventRequestMultiError, or nil if none found.
+func (m *RegisterForEventRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RegisterForEventRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for EventId
+
+	// no validation rules for MemberId
+
+	if len(errors) > 0 {
+		return RegisterForEventRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// RegisterForEventRequestMultiError is an error wrapping multiple validation
+// errors returned by RegisterForEventRequest.ValidateAll() if the designated
+// constraints aren't met.
+type RegisterForEventRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RegisterForEventRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RegisterForEventRequestMultiError) AllErrors() []error { return m }
+
+// RegisterForEventRequestValidationError is the validation error returned by
+// RegisterForEventRequest.Validate if the designated constraints aren't met.
+type RegisterForEventRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RegisterForEventRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RegisterForEventRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RegisterForEventRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RegisterForEventRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RegisterForEventRequestValidationError) ErrorName() string {
+	return "RegisterForEventRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RegisterForEventRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRegisterForEventRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RegisterForEventRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RegisterForEventRequestValidationError{}
+
+// Validate checks the field values on CancelEventRegistrationRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CancelEventRegistrationRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CancelEventRegistrationRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// CancelEventRegistrationRequestMultiError, or nil if none found.
+func (m *CancelEventRegistrationRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CancelEventRegistrationRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for EventId
+
+	// no validation rules for Id
+
+	if len(errors) > 0 {
+		return CancelEventRegistrationRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CancelEventRegistrationRequestMultiError is an error wrapping multiple
+// validation errors returned by CancelEventRegistrationRequest.ValidateAll()
+// if the designated constraints aren't met.
+type CancelEventRegistrationRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CancelEventRegistrationRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CancelEventRegistrationRequestMultiError) AllErrors() []error { return m }
+
+// CancelEventRegistrationRequestValidationError is the validation error
+// returned by CancelEventRegistrationRequest.Validate if the designated
+// constraints aren't met.
+type CancelEventRegistrationRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CancelEventRegistrationRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CancelEventRegistrationRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CancelEventRegistrationRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CancelEventRegistrationRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CancelEventRegistrationRequestValidationError) ErrorName() string {
+	return "CancelEventRegistrationRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CancelEventRegistrationRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCancelEventRegistrationRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CancelEventRegistrationRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CancelEventRegistrationRequestValidationError{}
+
+// Validate checks the field values on ListEventRegistrationsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListEventRegistrationsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListEventRegistrationsRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// ListEventRegistrationsRequestMultiError, or nil if none found.
+func (m *ListEventRegistrationsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListEventRegistrationsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for EventId
+
+	// no validation rules for PageSize
+
+	// no validation rules for PageToken
+
+	if m.Status != nil {
+		// no validation rules for Status
+	}
+
+	if m.MemberId != nil {
+		// no validation rules for MemberId
+	}
+
+	if len(errors) > 0 {
+		return ListEventRegistrationsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListEventRegistrationsRequestMultiError is an error wrapping multiple
+// validation errors returned by ListEventRegistrationsRequest.ValidateAll()
+// if the designated constraints aren't met.
+type ListEventRegistrationsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListEventRegistrationsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListEventRegistrationsRequestMultiError) AllErrors() []error { return m }
+
+// ListEventRegistrationsRequestValidationError is the validation error
+// returned by ListEventRegistrationsRequest.Validate if the designated
+// constraints aren't met.
+type ListEventRegistrationsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListEventRegistrationsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListEventRegistrationsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListEventRegistrationsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListEventRegistrationsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListEventRegistrationsRequestValidationError) ErrorName() string {
+	return "ListEventRegistrationsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListEventRegistrationsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListEventRegistrationsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListEventRegistrationsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListEventRegistrationsRequestValidationError{}
+
+// Validate checks the field values on ListEventRegistrationsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListEventRegistrationsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListEventRegistrationsResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// ListEventRegistrationsResponseMultiError, or nil if none found.
+func (m *ListEventRegistrationsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListEventRegistrationsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetRegistrations() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListEventRegistrationsResponseValidationError{
+						field:  fmt.Sprintf("Registrations[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListEventRegistrationsResponseValidationError{
+						field:  fmt.Sprintf("Registrations[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListEventRegistrationsResponseValidationError{
+					field:  fmt.Sprintf("Registrations[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for NextPageToken
+
+	if len(errors) > 0 {
+		return ListEventRegistrationsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListEventRegistrationsResponseMultiError is an error wrapping multiple
+// validation errors returned by ListEventRegistrationsResponse.ValidateAll()
+// if the designated constraints aren't met.
+type ListEventRegistrationsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListEventRegistrationsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListEventRegistrationsResponseMultiError) AllErrors() []error { return m }
+
+// ListEventRegistrationsResponseValidationError is the validation error
+// returned by ListEventRegistrationsResponse.Validate if the designated
+// constraints aren't met.
+type ListEventRegistrationsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListEventRegistrationsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListEventRegistrationsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListEventRegistrationsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListEventRegistrationsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListEventRegistrationsResponseValidationError) ErrorName() string {
+	return "ListEventRegistrationsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListEventRegistrationsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListEventRegistrationsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListEventRegistrationsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListEventRegistrationsResponseValidationError{}
+
+// Validate checks the field values on MarkEventAttendanceRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *MarkEventAttendanceRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MarkEventAttendanceRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// MarkEventAttendanceRequestMultiError, or nil if none found.
+func (m *MarkEventAttendanceRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MarkEventAttendanceRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for EventId
+
+	// no validation rules for Id
+
+	// no validation rules for Attended
+
+	if len(errors) > 0 {
+		return MarkEventAttendanceRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// MarkEventAttendanceRequestMultiError is an error wrapping multiple
+// validation errors returned by MarkEventAttendanceRequest.ValidateAll() if
+// the designated constraints aren't met.
+type MarkEventAttendanceRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MarkEventAttendanceRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MarkEventAttendanceRequestMultiError) AllErrors() []error { return m }
+
+// MarkEventAttendanceRequestValidationError is the validation error returned
+// by MarkEventAttendanceRequest.Validate if the designated constraints aren't met.
+type MarkEventAttendanceRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MarkEventAttendanceRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MarkEventAttendanceRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MarkEventAttendanceRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MarkEventAttendanceRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MarkEventAttendanceRequestValidationError) ErrorName() string {
+	return "MarkEventAttendanceRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e MarkEventAttendanceRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMarkEventAttendanceRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MarkEventAttendanceRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MarkEventAttendanceRequestValidationError{}
+
+// Validate checks the field values on Item with the rules defined in the proto
+// definition for this message. If any rules are violated, the first error
+// encountered is returned, or nil if there are no violations.
+func (m *Item) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Item with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in ItemMultiError, or nil if none found.
+func (m *Item) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Item) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Name
+
+	// no validation rules for Description
+
+	// no validation rules for RfidValue
+
+	// no validation rules for Barcode
+
+	// no validation rules for ConditionNotes
+
+	// no validation rules for Available
+
+	if len(errors) > 0 {
+		return ItemMultiError(errors)
+	}
+
+	return nil
+}
+
+// ItemMultiError is an error wrapping multiple validation errors returned by
+// Item.ValidateAll() if the designated constraints aren't met.
+type ItemMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ItemMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ItemMultiError) AllErrors() []error { return m }
+
+// ItemValidationError is the validation error returned by Item.Validate if the
+// designated constraints aren't met.
+type ItemValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ItemValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ItemValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ItemValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ItemValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ItemValidationError) ErrorName() string { return "ItemValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ItemValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sItem.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ItemValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ItemValidationError{}
+
+// Validate checks the field values on ItemPageToken with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ItemPageToken) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ItemPageToken with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ItemPageTokenMultiError, or
+// nil if none found.
+func (m *ItemPageToken) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ItemPageToken) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Field
+
+	// no validation rules for LastValue
+
+	// no validation rules for Direction
+
+	// no validation rules for LastId
+
+	if len(errors) > 0 {
+		return ItemPageTokenMultiError(errors)
+	}
+
+	return nil
+}
+
+// ItemPageTokenMultiError is an error wrapping multiple validation errors
+// returned by ItemPageToken.ValidateAll() if the designated constraints
+// aren't met.
+type ItemPageTokenMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ItemPageTokenMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ItemPageTokenMultiError) AllErrors() []error { return m }
+
+// ItemPageTokenValidationError is the validation error returned by
+// ItemPageToken.Validate if the designated constraints aren't met.
+type ItemPageTokenValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ItemPageTokenValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ItemPageTokenValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ItemPageTokenValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ItemPageTokenValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ItemPageTokenValidationError) ErrorName() string { return "ItemPageTokenValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ItemPageTokenValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sItemPageToken.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ItemPageTokenValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ItemPageTokenValidationError{}
+
+// Validate checks the field values on CreateItemRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *CreateItemRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateItemRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateItemRequestMultiError, or nil if none found.
+func (m *CreateItemRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateItemRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ItemId
+
+	if all {
+		switch v := interface{}(m.GetItem()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateItemRequestValidationError{
+					field:  "Item",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateItemRequestValidationError{
+					field:  "Item",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetItem()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateItemRequestValidationError{
+				field:  "Item",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CreateItemRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateItemRequestMultiError is an error wrapping multiple validation errors
+// returned by CreateItemRequest.ValidateAll() if the designated constraints
+// aren't met.
+type CreateItemRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateItemRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateItemRequestMultiError) AllErrors() []error { return m }
+
+// CreateItemRequestValidationError is the validation error returned by
+// CreateItemRequest.Validate if the designated constraints aren't met.
+type CreateItemRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateItemRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateItemRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateItemRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateItemRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateItemRequestValidationError) ErrorName() string {
+	return "CreateItemRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateItemRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateItemRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateItemRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateItemRequestValidationError{}
+
+// Validate checks the field values on GetItemRequest with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *GetItemRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetItemRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in GetItemRequestMultiError,
+// or nil if none found.
+func (m *GetItemRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetItemRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if len(errors) > 0 {
+		return GetItemRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetItemRequestMultiError is an error wrapping multiple validation errors
+// returned by GetItemRequest.ValidateAll() if the designated constraints
+// aren't met.
+type GetItemRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetItemRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetItemRequestMultiError) AllErrors() []error { return m }
+
+// GetItemRequestValidationError is the validation error returned by
+// GetItemRequest.Validate if the designated constraints aren't met.
+type GetItemRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetItemRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetItemRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetItemRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetItemRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetItemRequestValidationError) ErrorName() string { return "GetItemRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GetItemRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetItemRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetItemRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetItemRequestValidationError{}
+
+// Validate checks the field values on ListItemsRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ListItemsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListItemsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListItemsRequestMultiError, or nil if none found.
+func (m *ListItemsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListItemsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for PageSize
+
+	// no validation rules for PageToken
+
+	// no validation rules for SortBy
+
+	// no validation rules for SortDirection
+
+	if m.Available != nil {
+		// no validation rules for Available
+	}
+
+	if len(errors) > 0 {
+		return ListItemsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListItemsRequestMultiError is an error wrapping multiple validation errors
+// returned by ListItemsRequest.ValidateAll() if the designated constraints
+// aren't met.
+type ListItemsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListItemsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListItemsRequestMultiError) AllErrors() []error { return m }
+
+// ListItemsRequestValidationError is the validation error returned by
+// ListItemsRequest.Validate if the designated constraints aren't met.
+type ListItemsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListItemsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListItemsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListItemsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListItemsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListItemsRequestValidationError) ErrorName() string { return "ListItemsRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ListItemsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListItemsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListItemsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListItemsRequestValidationError{}
+
+// Validate checks the field values on ListItemsResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ListItemsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListItemsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListItemsResponseMultiError, or nil if none found.
+func (m *ListItemsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListItemsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetItems() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListItemsResponseValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListItemsResponseValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListItemsResponseValidationError{
+					field:  fmt.Sprintf("Items[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for NextPageToken
+
+	if len(errors) > 0 {
+		return ListItemsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListItemsResponseMultiError is an error wrapping multiple validation errors
+// returned by ListItemsResponse.ValidateAll() if the designated constraints
+// aren't met.
+type ListItemsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListItemsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListItemsResponseMultiError) AllErrors() []error { return m }
+
+// ListItemsResponseValidationError is the validation error returned by
+// ListItemsResponse.Validate if the designated constraints aren't met.
+type ListItemsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListItemsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListItemsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListItemsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListItemsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListItemsResponseValidationError) ErrorName() string {
+	return "ListItemsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListItemsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListItemsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListItemsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListItemsResponseValidationError{}
+
+// Validate checks the field values on UpdateItemRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *UpdateItemRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateItemRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateItemRequestMultiError, or nil if none found.
+func (m *UpdateItemRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateItemRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetItem()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateItemRequestValidationError{
+					field:  "Item",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateItemRequestValidationError{
+					field:  "Item",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetItem()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateItemRequestValidationError{
+				field:  "Item",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetFieldMask()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateItemRequestValidationError{
+					field:  "FieldMask",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateItemRequestValidationError{
+					field:  "FieldMask",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetFieldMask()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateItemRequestValidationError{
+				field:  "FieldMask",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return UpdateItemRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateItemRequestMultiError is an error wrapping multiple validation errors
+// returned by UpdateItemRequest.ValidateAll() if the designated constraints
+// aren't met.
+type UpdateItemRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateItemRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateItemRequestMultiError) AllErrors() []error { return m }
+
+// UpdateItemRequestValidationError is the validation error returned by
+// UpdateItemRequest.Validate if the designated constraints aren't met.
+type UpdateItemRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateItemRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateItemRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateItemRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateItemRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateItemRequestValidationError) ErrorName() string {
+	return "UpdateItemRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateItemRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateItemRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateItemRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateItemRequestValidationError{}
+
+// Validate checks the field values on DeleteItemRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *DeleteItemRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteItemRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteItemRequestMultiError, or nil if none found.
+func (m *DeleteItemRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteItemRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if len(errors) > 0 {
+		return DeleteItemRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteItemRequestMultiError is an error wrapping multiple validation errors
+// returned by DeleteItemRequest.ValidateAll() if the designated constraints
+// aren't met.
+type DeleteItemRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteItemRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteItemRequestMultiError) AllErrors() []error { return m }
+
+// DeleteItemRequestValidationError is the validation error returned by
+// DeleteItemRequest.Validate if the designated constraints aren't met.
+type DeleteItemRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteItemRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteItemRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteItemRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteItemRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteItemRequestValidationError) ErrorName() string {
+	return "DeleteItemRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteItemRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteItemRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteItemRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteItemRequestValidationError{}
+
+// Validate checks the field values on Loan with the rules defined in the proto
+// definition for this message. If any rules are violated, the first error
+// encountered is returned, or nil if there are no violations.
+func (m *Loan) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Loan with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in LoanMultiError, or nil if none found.
+func (m *Loan) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Loan) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for ItemId
+
+	// no validation rules for MemberId
+
+	if all {
+		switch v := interface{}(m.GetLendTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, LoanValidationError{
+					field:  "LendTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, LoanValidationError{
+					field:  "LendTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetLendTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return LoanValidationError{
+				field:  "LendTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetExpectedReturnTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, LoanValidationError{
+					field:  "ExpectedReturnTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, LoanValidationError{
+					field:  "ExpectedReturnTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetExpectedReturnTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return LoanValidationError{
+				field:  "ExpectedReturnTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetReturnTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, LoanValidationError{
+					field:  "ReturnTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, LoanValidationError{
+					field:  "ReturnTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetReturnTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return LoanValidationError{
+				field:  "ReturnTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for ReturnConditionNotes
+
+	// no validation rules for Overdue
+
+	if m.TerminalId != nil {
+		// no validation rules for TerminalId
+	}
+
+	if len(errors) > 0 {
+		return LoanMultiError(errors)
+	}
+
+	return nil
+}
+
+// LoanMultiError is an error wrapping multiple validation errors returned by
+// Loan.ValidateAll() if the designated constraints aren't met.
+type LoanMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m LoanMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m LoanMultiError) AllErrors() []error { return m }
+
+// LoanValidationError is the validation error returned by Loan.Validate if the
+// designated constraints aren't met.
+type LoanValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e LoanValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e LoanValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e LoanValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e LoanValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e LoanValidationError) ErrorName() string { return "LoanValidationError" }
+
+// Error satisfies the builtin error interface
+func (e LoanValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sLoan.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = LoanValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = LoanValidationError{}
+
+// Validate checks the field values on LoanPageToken with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *LoanPageToken) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on LoanPageToken with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in LoanPageTokenMultiError, or
+// nil if none found.
+func (m *LoanPageToken) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *LoanPageToken) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetLastLendTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, LoanPageTokenValidationError{
+					field:  "LastLendTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, LoanPageTokenValidationError{
+					field:  "LastLendTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetLastLendTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return LoanPageTokenValidationError{
+				field:  "LastLendTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for LastId
+
+	if len(errors) > 0 {
+		return LoanPageTokenMultiError(errors)
+	}
+
+	return nil
+}
+
+// LoanPageTokenMultiError is an error wrapping multiple validation errors
+// returned by LoanPageToken.ValidateAll() if the designated constraints
+// aren't met.
+type LoanPageTokenMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m LoanPageTokenMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m LoanPageTokenMultiError) AllErrors() []error { return m }
+
+// LoanPageTokenValidationError is the validation error returned by
+// LoanPageToken.Validate if the designated constraints aren't met.
+type LoanPageTokenValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e LoanPageTokenValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e LoanPageTokenValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e LoanPageTokenValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e LoanPageTokenValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e LoanPageTokenValidationError) ErrorName() string { return "LoanPageTokenValidationError" }
+
+// Error satisfies the builtin error interface
+func (e LoanPageTokenValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sLoanPageToken.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = LoanPageTokenValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = LoanPageTokenValidationError{}
+
+// Validate checks the field values on LendItemRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *LendItemRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on LendItemRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// LendItemRequestMultiError, or nil if none found.
+func (m *LendItemRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *LendItemRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ItemId
+
+	// no validation rules for MemberId
+
+	if all {
+		switch v := interface{}(m.GetExpectedReturnTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, LendItemRequestValidationError{
+					field:  "ExpectedReturnTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, LendItemRequestValidationError{
+					field:  "ExpectedReturnTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetExpectedReturnTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return LendItemRequestValidationError{
+				field:  "ExpectedReturnTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return LendItemRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// LendItemRequestMultiError is an error wrapping multiple validation errors
+// returned by LendItemRequest.ValidateAll() if the designated constraints
+// aren't met.
+type LendItemRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m LendItemRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m LendItemRequestMultiError) AllErrors() []error { return m }
+
+// LendItemRequestValidationError is the validation error returned by
+// LendItemRequest.Validate if the designated constraints aren't met.
+type LendItemRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e LendItemRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e LendItemRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e LendItemRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e LendItemRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e LendItemRequestValidationError) ErrorName() string { return "LendItemRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e LendItemRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sLendItemRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = LendItemRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = LendItemRequestValidationError{}
+
+// Validate checks the field values on LendItemByScanRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *LendItemByScanRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on LendItemByScanRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// LendItemByScanRequestMultiError, or nil if none found.
+func (m *LendItemByScanRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *LendItemByScanRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for MemberRfidValue
+
+	// no validation rules for TerminalId
+
+	if all {
+		switch v := interface{}(m.GetExpectedReturnTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, LendItemByScanRequestValidationError{
+					field:  "ExpectedReturnTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, LendItemByScanRequestValidationError{
+					field:  "ExpectedReturnTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetExpectedReturnTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return LendItemByScanRequestValidationError{
+				field:  "ExpectedReturnTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	switch v := m.ItemTag.(type) {
+	case *LendItemByScanRequest_ItemRfidValue:
+		if v == nil {
+			err := LendItemByScanRequestValidationError{
+				field:  "ItemTag",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+		// no validation rules for ItemRfidValue
+	case *LendItemByScanRequest_ItemBarcode:
+		if v == nil {
+			err := LendItemByScanRequestValidationError{
+				field:  "ItemTag",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+		// no validation rules for ItemBarcode
+	default:
+		_ = v // ensures v is used
+	}
+
+	if len(errors) > 0 {
+		return LendItemByScanRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// LendItemByScanRequestMultiError is an error wrapping multiple validation
+// errors returned by LendItemByScanRequest.ValidateAll() if the designated
+// constraints aren't met.
+type LendItemByScanRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m LendItemByScanRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m LendItemByScanRequestMultiError) AllErrors() []error { return m }
+
+// LendItemByScanRequestValidationError is the validation error returned by
+// LendItemByScanRequest.Validate if the designated constraints aren't met.
+type LendItemByScanRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e LendItemByScanRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e LendItemByScanRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e LendItemByScanRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e LendItemByScanRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e LendItemByScanRequestValidationError) ErrorName() string {
+	return "LendItemByScanRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e LendItemByScanRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sLendItemByScanRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = LendItemByScanRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = LendItemByScanRequestValidationError{}
+
+// Validate checks the field values on ReturnItemRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ReturnItemRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ReturnItemRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ReturnItemRequestMultiError, or nil if none found.
+func (m *ReturnItemRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ReturnItemRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for ConditionNotes
+
+	if len(errors) > 0 {
+		return ReturnItemRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ReturnItemRequestMultiError is an error wrapping multiple validation errors
+// returned by ReturnItemRequest.ValidateAll() if the designated constraints
+// aren't met.
+type ReturnItemRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReturnItemRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReturnItemRequestMultiError) AllErrors() []error { return m }
+
+// ReturnItemRequestValidationError is the validation error returned by
+// ReturnItemRequest.Validate if the designated constraints aren't met.
+type ReturnItemRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReturnItemRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReturnItemRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReturnItemRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReturnItemRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReturnItemRequestValidationError) ErrorName() string {
+	return "ReturnItemRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ReturnItemRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReturnItemRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReturnItemRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReturnItemRequestValidationError{}
+
+// Validate checks the field values on ReturnItemByScanRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ReturnItemByScanRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ReturnItemByScanRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ReturnItemByScanRequestMultiError, or nil if none found.
+func (m *ReturnItemByScanRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ReturnItemByScanRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ConditionNotes
+
+	switch v := m.ItemTag.(type) {
+	case *ReturnItemByScanRequest_ItemRfidValue:
+		if v == nil {
+			err := ReturnItemByScanRequestValidationError{
+				field:  "ItemTag",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+		// no validation rules for ItemRfidValue
+	case *ReturnItemByScanRequest_ItemBarcode:
+		if v == nil {
+			err := ReturnItemByScanRequestValidationError{
+				field:  "ItemTag",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+		// no validation rules for ItemBarcode
+	default:
+		_ = v // ensures v is used
+	}
+
+	if len(errors) > 0 {
+		return ReturnItemByScanRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ReturnItemByScanRequestMultiError is an error wrapping multiple validation
+// errors returned by ReturnItemByScanRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ReturnItemByScanRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReturnItemByScanRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReturnItemByScanRequestMultiError) AllErrors() []error { return m }
+
+// ReturnItemByScanRequestValidationError is the validation error returned by
+// ReturnItemByScanRequest.Validate if the designated constraints aren't met.
+type ReturnItemByScanRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReturnItemByScanRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReturnItemByScanRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReturnItemByScanRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReturnItemByScanRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReturnItemByScanRequestValidationError) ErrorName() string {
+	return "ReturnItemByScanRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ReturnItemByScanRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReturnItemByScanRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReturnItemByScanRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReturnItemByScanRequestValidationError{}
+
+// Validate checks the field values on GetLoanRequest with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *GetLoanRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetLoanRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in GetLoanRequestMultiError,
+// or nil if none found.
+func (m *GetLoanRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetLoanRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if len(errors) > 0 {
+		return GetLoanRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetLoanRequestMultiError is an error wrapping multiple validation errors
+// returned by GetLoanRequest.ValidateAll() if the designated constraints
+// aren't met.
+type GetLoanRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetLoanRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetLoanRequestMultiError) AllErrors() []error { return m }
+
+// GetLoanRequestValidationError is the validation error returned by
+// GetLoanRequest.Validate if the designated constraints aren't met.
+type GetLoanRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetLoanRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetLoanRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetLoanRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetLoanRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetLoanRequestValidationError) ErrorName() string { return "GetLoanRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GetLoanRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetLoanRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetLoanRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetLoanRequestValidationError{}
+
+// Validate checks the field values on ListLoansRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ListLoansRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListLoansRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListLoansRequestMultiError, or nil if none found.
+func (m *ListLoansRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListLoansRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for PageSize
+
+	// no validation rules for PageToken
+
+	// no validation rules for Open
+
+	// no validation rules for Overdue
+
+	if m.ItemId != nil {
+		// no validation rules for ItemId
+	}
+
+	if m.MemberId != nil {
+		// no validation rules for MemberId
+	}
+
+	if len(errors) > 0 {
+		return ListLoansRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListLoansRequestMultiError is an error wrapping multiple validation errors
+// returned by ListLoansRequest.ValidateAll() if the designated constraints
+// aren't met.
+type ListLoansRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListLoansRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListLoansRequestMultiError) AllErrors() []error { return m }
+
+// ListLoansRequestValidationError is the validation error returned by
+// ListLoansRequest.Validate if the designated constraints aren't met.
+type ListLoansRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListLoansRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListLoansRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListLoansRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListLoansRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListLoansRequestValidationError) ErrorName() string { return "ListLoansRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ListLoansRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListLoansRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListLoansRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListLoansRequestValidationError{}
+
+// Validate checks the field values on ListLoansResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ListLoansResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListLoansResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListLoansResponseMultiError, or nil if none found.
+func (m *ListLoansResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListLoansResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetLoans() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListLoansResponseValidationError{
+						field:  fmt.Sprintf("Loans[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListLoansResponseValidationError{
+						field:  fmt.Sprintf("Loans[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListLoansResponseValidationError{
+					field:  fmt.Sprintf("Loans[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for NextPageToken
+
+	if len(errors) > 0 {
+		return ListLoansResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListLoansResponseMultiError is an error wrapping multiple validation errors
+// returned by ListLoansResponse.ValidateAll() if the designated constraints
+// aren't met.
+type ListLoansResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListLoansResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListLoansResponseMultiError) AllErrors() []error { return m }
+
+// ListLoansResponseValidationError is the validation error returned by
+// ListLoansResponse.Validate if the designated constraints aren't met.
+type ListLoansResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListLoansResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListLoansResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListLoansResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListLoansResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListLoansResponseValidationError) ErrorName() string {
+	return "ListLoansResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListLoansResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListLoansResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListLoansResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListLoansResponseValidationError{}
+
+// Validate checks the field values on Machine with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Machine) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Machine with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in MachineMultiError, or nil if none found.
+func (m *Machine) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Machine) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Name
+
+	// no validation rules for Description
+
+	// no validation rules for Location
+
+	// no validation rules for PricePerMinuteCents
+
+	if all {
+		switch v := interface{}(m.GetSessionTimeout()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, MachineValidationError{
+					field:  "SessionTimeout",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, MachineValidationError{
+					field:  "SessionTimeout",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSessionTimeout()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return MachineValidationError{
+				field:  "SessionTimeout",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return MachineMultiError(errors)
+	}
+
+	return nil
+}
+
+// MachineMultiError is an error wrapping multiple validation errors returned
+// by Machine.ValidateAll() if the designated constraints aren't met.
+type MachineMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MachineMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MachineMultiError) AllErrors() []error { return m }
+
+// MachineValidationError is the validation error returned by Machine.Validate
+// if the designated constraints aren't met.
+type MachineValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MachineValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MachineValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MachineValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MachineValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MachineValidationError) ErrorName() string { return "MachineValidationError" }
+
+// Error satisfies the builtin error interface
+func (e MachineValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMachine.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MachineValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MachineValidationError{}
+
+// Validate checks the field values on MachinePageToken with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *MachinePageToken) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MachinePageToken with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// MachinePageTokenMultiError, or nil if none found.
+func (m *MachinePageToken) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MachinePageToken) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for LastName
+
+	// no validation rules for LastId
+
+	if len(errors) > 0 {
+		return MachinePageTokenMultiError(errors)
+	}
+
+	return nil
+}
+
+// MachinePageTokenMultiError is an error wrapping multiple validation errors
+// returned by MachinePageToken.ValidateAll() if the designated constraints
+// aren't met.
+type MachinePageTokenMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MachinePageTokenMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MachinePageTokenMultiError) AllErrors() []error { return m }
+
+// MachinePageTokenValidationError is the validation error returned by
+// MachinePageToken.Validate if the designated constraints aren't met.
+type MachinePageTokenValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MachinePageTokenValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MachinePageTokenValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MachinePageTokenValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MachinePageTokenValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MachinePageTokenValidationError) ErrorName() string { return "MachinePageTokenValidationError" }
+
+// Error satisfies the builtin error interface
+func (e MachinePageTokenValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMachinePageToken.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MachinePageTokenValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MachinePageTokenValidationError{}
+
+// Validate checks the field values on CreateMachineRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateMachineRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateMachineRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateMachineRequestMultiError, or nil if none found.
+func (m *CreateMachineRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateMachineRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for MachineId
+
+	if all {
+		switch v := interface{}(m.GetMachine()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateMachineRequestValidationError{
+					field:  "Machine",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateMachineRequestValidationError{
+					field:  "Machine",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetMachine()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateMachineRequestValidationError{
+				field:  "Machine",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CreateMachineRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateMachineRequestMultiError is an error wrapping multiple validation
+// errors returned by CreateMachineRequest.ValidateAll() if the designated
+// constraints aren't met.
+type CreateMachineRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateMachineRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateMachineRequestMultiError) AllErrors() []error { return m }
+
+// CreateMachineRequestValidationError is the validation error returned by
+// CreateMachineRequest.Validate if the designated constraints aren't met.
+type CreateMachineRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateMachineRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateMachineRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateMachineRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateMachineRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateMachineRequestValidationError) ErrorName() string {
+	return "CreateMachineRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateMachineRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateMachineRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateMachineRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateMachineRequestValidationError{}
+
+// Validate checks the field values on GetMachineRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GetMachineRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetMachineRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetMachineRequestMultiError, or nil if none found.
+func (m *GetMachineRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetMachineRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if len(errors) > 0 {
+		return GetMachineRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetMachineRequestMultiError is an error wrapping multiple validation errors
+// returned by GetMachineRequest.ValidateAll() if the designated constraints
+// aren't met.
+type GetMachineRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetMachineRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetMachineRequestMultiError) AllErrors() []error { return m }
+
+// GetMachineRequestValidationError is the validation error returned by
+// GetMachineRequest.Validate if the designated constraints aren't met.
+type GetMachineRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetMachineRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetMachineRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetMachineRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetMachineRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetMachineRequestValidationError) ErrorName() string {
+	return "GetMachineRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetMachineRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetMachineRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetMachineRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetMachineRequestValidationError{}
+
+// Validate checks the field values on ListMachinesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListMachinesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListMachinesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListMachinesRequestMultiError, or nil if none found.
+func (m *ListMachinesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListMachinesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for PageSize
+
+	// no validation rules for PageToken
+
+	if len(errors) > 0 {
+		return ListMachinesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListMachinesRequestMultiError is an error wrapping multiple validation
+// errors returned by ListMachinesRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListMachinesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListMachinesRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListMachinesRequestMultiError) AllErrors() []error { return m }
+
+// ListMachinesRequestValidationError is the validation error returned by
+// ListMachinesRequest.Validate if the designated constraints aren't met.
+type ListMachinesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListMachinesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListMachinesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListMachinesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListMachinesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListMachinesRequestValidationError) ErrorName() string {
+	return "ListMachinesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListMachinesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListMachinesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListMachinesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListMachinesRequestValidationError{}
+
+// Validate checks the field values on ListMachinesResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListMachinesResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListMachinesResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListMachinesResponseMultiError, or nil if none found.
+func (m *ListMachinesResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListMachinesResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetMachines() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListMachinesResponseValidationError{
+						field:  fmt.Sprintf("Machines[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListMachinesResponseValidationError{
+						field:  fmt.Sprintf("Machines[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListMachinesResponseValidationError{
+					field:  fmt.Sprintf("Machines[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for NextPageToken
+
+	if len(errors) > 0 {
+		return ListMachinesResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListMachinesResponseMultiError is an error wrapping multiple validation
+// errors returned by ListMachinesResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ListMachinesResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListMachinesResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListMachinesResponseMultiError) AllErrors() []error { return m }
+
+// ListMachinesResponseValidationError is the validation error returned by
+// ListMachinesResponse.Validate if the designated constraints aren't met.
+type ListMachinesResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListMachinesResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListMachinesResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListMachinesResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListMachinesResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListMachinesResponseValidationError) ErrorName() string {
+	return "ListMachinesResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListMachinesResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListMachinesResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListMachinesResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListMachinesResponseValidationError{}
+
+// Validate checks the field values on UpdateMachineRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateMachineRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateMachineRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateMachineRequestMultiError, or nil if none found.
+func (m *UpdateMachineRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateMachineRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetMachine()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateMachineRequestValidationError{
+					field:  "Machine",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateMachineRequestValidationError{
+					field:  "Machine",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetMachine()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateMachineRequestValidationError{
+				field:  "Machine",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetFieldMask()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateMachineRequestValidationError{
+					field:  "FieldMask",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateMachineRequestValidationError{
+					field:  "FieldMask",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetFieldMask()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateMachineRequestValidationError{
+				field:  "FieldMask",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return UpdateMachineRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateMachineRequestMultiError is an error wrapping multiple validation
+// errors returned by UpdateMachineRequest.ValidateAll() if the designated
+// constraints aren't met.
+type UpdateMachineRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateMachineRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateMachineRequestMultiError) AllErrors() []error { return m }
+
+// UpdateMachineRequestValidationError is the validation error returned by
+// UpdateMachineRequest.Validate if the designated constraints aren't met.
+type UpdateMachineRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateMachineRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateMachineRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateMachineRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateMachineRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateMachineRequestValidationError) ErrorName() string {
+	return "UpdateMachineRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateMachineRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateMachineRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateMachineRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateMachineRequestValidationError{}
+
+// Validate checks the field values on DeleteMachineRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteMachineRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteMachineRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteMachineRequestMultiError, or nil if none found.
+func (m *DeleteMachineRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteMachineRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if len(errors) > 0 {
+		return DeleteMachineRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteMachineRequestMultiError is an error wrapping multiple validation
+// errors returned by DeleteMachineRequest.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteMachineRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteMachineRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteMachineRequestMultiError) AllErrors() []error { return m }
+
+// DeleteMachineRequestValidationError is the validation error returned by
+// DeleteMachineRequest.Validate if the designated constraints aren't met.
+type DeleteMachineRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteMachineRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteMachineRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteMachineRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteMachineRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteMachineRequestValidationError) ErrorName() string {
+	return "DeleteMachineRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteMachineRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteMachineRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteMachineRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteMachineRequestValidationError{}
+
+// Validate checks the field values on UsageSession with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *UsageSession) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UsageSession with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in UsageSessionMultiError, or
+// nil if none found.
+func (m *UsageSession) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UsageSession) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for MachineId
+
+	// no validation rules for MemberId
+
+	if all {
+		switch v := interface{}(m.GetStartTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UsageSessionValidationError{
+					field:  "StartTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UsageSessionValidationError{
+					field:  "StartTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetStartTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UsageSessionValidationError{
+				field:  "StartTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for AutoStopped
+
+	// no validation rules for PricePerMinuteCents
+
+	// no validation rules for PriceCents
+
+	if m.EndTime != nil {
+
+		if all {
+			switch v := interface{}(m.GetEndTime()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, UsageSessionValidationError{
+						field:  "EndTime",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, UsageSessionValidationError{
+						field:  "EndTime",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetEndTime()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return UsageSessionValidationError{
+					field:  "EndTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return UsageSessionMultiError(errors)
+	}
+
+	return nil
+}
+
+// UsageSessionMultiError is an error wrapping multiple validation errors
+// returned by UsageSession.ValidateAll() if the designated constraints aren't met.
+type UsageSessionMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UsageSessionMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UsageSessionMultiError) AllErrors() []error { return m }
+
+// UsageSessionValidationError is the validation error returned by
+// UsageSession.Validate if the designated constraints aren't met.
+type UsageSessionValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UsageSessionValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UsageSessionValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UsageSessionValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UsageSessionValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UsageSessionValidationError) ErrorName() string { return "UsageSessionValidationError" }
+
+// Error satisfies the builtin error interface
+func (e UsageSessionValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUsageSession.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UsageSessionValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UsageSessionValidationError{}
+
+// Validate checks the field values on StartUsageSessionRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *StartUsageSessionRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on StartUsageSessionRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// StartUsageSessionRequestMultiError, or nil if none found.
+func (m *StartUsageSessionRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *StartUsageSessionRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for MachineId
+
+	switch v := m.Member.(type) {
+	case *StartUsageSessionRequest_MemberId:
+		if v == nil {
+			err := StartUsageSessionRequestValidationError{
+				field:  "Member",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+		// no validation rules for MemberId
+	case *StartUsageSessionRequest_RfidValue:
+		if v == nil {
+			err := StartUsageSessionRequestValidationError{
+				field:  "Member",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+		// no validation rules for RfidValue
+	default:
+		_ = v // ensures v is used
+	}
+
+	if len(errors) > 0 {
+		return StartUsageSessionRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// StartUsageSessionRequestMultiError is an error wrapping multiple validation
+// errors returned by StartUsageSessionRequest.ValidateAll() if the designated
+// constraints aren't met.
+type StartUsageSessionRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m StartUsageSessionRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m StartUsageSessionRequestMultiError) AllErrors() []error { return m }
+
+// StartUsageSessionRequestValidationError is the validation error returned by
+// StartUsageSessionRequest.Validate if the designated constraints aren't met.
+type StartUsageSessionRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e StartUsageSessionRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e StartUsageSessionRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e StartUsageSessionRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e StartUsageSessionRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e StartUsageSessionRequestValidationError) ErrorName() string {
+	return "StartUsageSessionRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e StartUsageSessionRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sStartUsageSessionRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = StartUsageSessionRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = StartUsageSessionRequestValidationError{}
+
+// Validate checks the field values on StopUsageSessionRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *StopUsageSessionRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on StopUsageSessionRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// StopUsageSessionRequestMultiError, or nil if none found.
+func (m *StopUsageSessionRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *StopUsageSessionRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for MachineId
+
+	if len(errors) > 0 {
+		return StopUsageSessionRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// StopUsageSessionRequestMultiError is an error wrapping multiple validation
+// errors returned by StopUsageSessionRequest.ValidateAll() if the designated
+// constraints aren't met.
+type StopUsageSessionRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m StopUsageSessionRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m StopUsageSessionRequestMultiError) AllErrors() []error { return m }
+
+// StopUsageSessionRequestValidationError is the validation error returned by
+// StopUsageSessionRequest.Validate if the designated constraints aren't met.
+type StopUsageSessionRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e StopUsageSessionRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e StopUsageSessionRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e StopUsageSessionRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e StopUsageSessionRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e StopUsageSessionRequestValidationError) ErrorName() string {
+	return "StopUsageSessionRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e StopUsageSessionRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sStopUsageSessionRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = StopUsageSessionRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = StopUsageSessionRequestValidationError{}
+
+// Validate checks the field values on UsageSessionPageToken with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UsageSessionPageToken) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UsageSessionPageToken with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UsageSessionPageTokenMultiError, or nil if none found.
+func (m *UsageSessionPageToken) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UsageSessionPageToken) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetLastStartTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UsageSessionPageTokenValidationError{
+					field:  "LastStartTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UsageSessionPageTokenValidationError{
+					field:  "LastStartTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetLastStartTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UsageSessionPageTokenValidationError{
+				field:  "LastStartTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for LastId
+
+	if len(errors) > 0 {
+		return UsageSessionPageTokenMultiError(errors)
+	}
+
+	return nil
+}
+
+// UsageSessionPageTokenMultiError is an error wrapping multiple validation
+// errors returned by UsageSessionPageToken.ValidateAll() if the designated
+// constraints aren't met.
+type UsageSessionPageTokenMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UsageSessionPageTokenMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UsageSessionPageTokenMultiError) AllErrors() []error { return m }
+
+// UsageSessionPageTokenValidationError is the validation error returned by
+// UsageSessionPageToken.Validate if the designated constraints aren't met.
+type UsageSessionPageTokenValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UsageSessionPageTokenValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UsageSessionPageTokenValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UsageSessionPageTokenValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UsageSessionPageTokenValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UsageSessionPageTokenValidationError) ErrorName() string {
+	return "UsageSessionPageTokenValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UsageSessionPageTokenValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUsageSessionPageToken.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UsageSessionPageTokenValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UsageSessionPageTokenValidationError{}
+
+// Validate checks the field values on ListUsageSessionsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListUsageSessionsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListUsageSessionsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListUsageSessionsRequestMultiError, or nil if none found.
+func (m *ListUsageSessionsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListUsageSessionsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for PageSize
+
+	// no validation rules for PageToken
+
+	// no validation rules for RunningOnly
+
+	if m.MachineId != nil {
+		// no validation rules for MachineId
+	}
+
+	if m.MemberId != nil {
+		// no validation rules for MemberId
+	}
+
+	if len(errors) > 0 {
+		return ListUsageSessionsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListUsageSessionsRequestMultiError is an error wrapping multiple validation
+// errors returned by ListUsageSessionsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListUsageSessionsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListUsageSessionsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListUsageSessionsRequestMultiError) AllErrors() []error { return m }
+
+// ListUsageSessionsRequestValidationError is the validation error returned by
+// ListUsageSessionsRequest.Validate if the designated constraints aren't met.
+type ListUsageSessionsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListUsageSessionsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListUsageSessionsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListUsageSessionsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListUsageSessionsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListUsageSessionsRequestValidationError) ErrorName() string {
+	return "ListUsageSessionsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListUsageSessionsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListUsageSessionsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListUsageSessionsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListUsageSessionsRequestValidationError{}
+
+// Validate checks the field values on ListUsageSessionsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListUsageSessionsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListUsageSessionsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListUsageSessionsResponseMultiError, or nil if none found.
+func (m *ListUsageSessionsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListUsageSessionsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetSessions() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListUsageSessionsResponseValidationError{
+						field:  fmt.Sprintf("Sessions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListUsageSessionsResponseValidationError{
+						field:  fmt.Sprintf("Sessions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListUsageSessionsResponseValidationError{
+					field:  fmt.Sprintf("Sessions[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for NextPageToken
+
+	if len(errors) > 0 {
+		return ListUsageSessionsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListUsageSessionsResponseMultiError is an error wrapping multiple validation
+// errors returned by ListUsageSessionsResponse.ValidateAll() if the
+// designated constraints aren't met.
+type ListUsageSessionsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListUsageSessionsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListUsageSessionsResponseMultiError) AllErrors() []error { return m }
+
+// ListUsageSessionsResponseValidationError is the validation error returned by
+// ListUsageSessionsResponse.Validate if the designated constraints aren't met.
+type ListUsageSessionsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListUsageSessionsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListUsageSessionsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListUsageSessionsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListUsageSessionsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListUsageSessionsResponseValidationError) ErrorName() string {
+	return "ListUsageSessionsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListUsageSessionsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListUsageSessionsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListUsageSessionsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListUsageSessionsResponseValidationError{}
+
+// Validate checks the field values on CheckAccessRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CheckAccessRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CheckAccessRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CheckAccessRequestMultiError, or nil if none found.
+func (m *CheckAccessRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CheckAccessRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for RfidValue
+
+	// no validation rules for MachineId
+
+	if len(errors) > 0 {
+		return CheckAccessRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CheckAccessRequestMultiError is an error wrapping multiple validation errors
+// returned by CheckAccessRequest.ValidateAll() if the designated constraints
+// aren't met.
+type CheckAccessRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CheckAccessRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CheckAccessRequestMultiError) AllErrors() []error { return m }
+
+// CheckAccessRequestValidationError is the validation error returned by
+// CheckAccessRequest.Validate if the designated constraints aren't met.
+type CheckAccessRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CheckAccessRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CheckAccessRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CheckAccessRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CheckAccessRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CheckAccessRequestValidationError) ErrorName() string {
+	return "CheckAccessRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CheckAccessRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCheckAccessRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CheckAccessRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CheckAccessRequestValidationError{}
+
+// Validate checks the field values on AccessDecision with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *AccessDecision) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AccessDecision with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in AccessDecisionMultiError,
+// or nil if none found.
+func (m *AccessDecision) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AccessDecision) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if all {
+		switch v := interface{}(m.GetDecisionTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AccessDecisionValidationError{
+					field:  "DecisionTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AccessDecisionValidationError{
+					field:  "DecisionTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDecisionTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AccessDecisionValidationError{
+				field:  "DecisionTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for MachineId
+
+	// no validation rules for Allowed
+
+	// no validation rules for Reason
+
+	if m.MemberId != nil {
+		// no validation rules for MemberId
+	}
+
+	if len(errors) > 0 {
+		return AccessDecisionMultiError(errors)
+	}
+
+	return nil
+}
+
+// AccessDecisionMultiError is an error wrapping multiple validation errors
+// returned by AccessDecision.ValidateAll() if the designated constraints
+// aren't met.
+type AccessDecisionMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AccessDecisionMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AccessDecisionMultiError) AllErrors() []error { return m }
+
+// AccessDecisionValidationError is the validation error returned by
+// AccessDecision.Validate if the designated constraints aren't met.
+type AccessDecisionValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AccessDecisionValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AccessDecisionValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AccessDecisionValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AccessDecisionValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AccessDecisionValidationError) ErrorName() string { return "AccessDecisionValidationError" }
+
+// Error satisfies the builtin error interface
+func (e AccessDecisionValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAccessDecision.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AccessDecisionValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AccessDecisionValidationError{}
+
+// Validate checks the field values on AccessDecisionPageToken with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AccessDecisionPageToken) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AccessDecisionPageToken with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AccessDecisionPageTokenMultiError, or nil if none found.
+func (m *AccessDecisionPageToken) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AccessDecisionPageToken) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetLastDecisionTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AccessDecisionPageTokenValidationError{
+					field:  "LastDecisionTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AccessDecisionPageTokenValidationError{
+					field:  "LastDecisionTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetLastDecisionTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AccessDecisionPageTokenValidationError{
+				field:  "LastDecisionTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for LastId
+
+	if len(errors) > 0 {
+		return AccessDecisionPageTokenMultiError(errors)
+	}
+
+	return nil
+}
+
+// AccessDecisionPageTokenMultiError is an error wrapping multiple validation
+// errors returned by AccessDecisionPageToken.ValidateAll() if the designated
+// constraints aren't met.
+type AccessDecisionPageTokenMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AccessDecisionPageTokenMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AccessDecisionPageTokenMultiError) AllErrors() []error { return m }
+
+// AccessDecisionPageTokenValidationError is the validation error returned by
+// AccessDecisionPageToken.Validate if the designated constraints aren't met.
+type AccessDecisionPageTokenValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AccessDecisionPageTokenValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AccessDecisionPageTokenValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AccessDecisionPageTokenValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AccessDecisionPageTokenValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AccessDecisionPageTokenValidationError) ErrorName() string {
+	return "AccessDecisionPageTokenValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AccessDecisionPageTokenValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAccessDecisionPageToken.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AccessDecisionPageTokenValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AccessDecisionPageTokenValidationError{}
+
+// Validate checks the field values on ListAccessDecisionsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListAccessDecisionsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListAccessDecisionsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListAccessDecisionsRequestMultiError, or nil if none found.
+func (m *ListAccessDecisionsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListAccessDecisionsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for PageSize
+
+	// no validation rules for PageToken
+
+	if m.MachineId != nil {
+		// no validation rules for MachineId
+	}
+
+	if m.MemberId != nil {
+		// no validation rules for MemberId
+	}
+
+	if m.Allowed != nil {
+		// no validation rules for Allowed
+	}
+
+	if len(errors) > 0 {
+		return ListAccessDecisionsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListAccessDecisionsRequestMultiError is an error wrapping multiple
+// validation errors returned by ListAccessDecisionsRequest.ValidateAll() if
+// the designated constraints aren't met.
+type ListAccessDecisionsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListAccessDecisionsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListAccessDecisionsRequestMultiError) AllErrors() []error { return m }
+
+// ListAccessDecisionsRequestValidationError is the validation error returned
+// by ListAccessDecisionsRequest.Validate if the designated constraints aren't met.
+type ListAccessDecisionsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListAccessDecisionsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListAccessDecisionsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListAccessDecisionsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListAccessDecisionsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListAccessDecisionsRequestValidationError) ErrorName() string {
+	return "ListAccessDecisionsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListAccessDecisionsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListAccessDecisionsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListAccessDecisionsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListAccessDecisionsRequestValidationError{}
+
+// Validate checks the field values on ListAccessDecisionsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListAccessDecisionsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListAccessDecisionsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListAccessDecisionsResponseMultiError, or nil if none found.
+func (m *ListAccessDecisionsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListAccessDecisionsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetDecisions() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListAccessDecisionsResponseValidationError{
+						field:  fmt.Sprintf("Decisions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListAccessDecisionsResponseValidationError{
+						field:  fmt.Sprintf("Decisions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListAccessDecisionsResponseValidationError{
+					field:  fmt.Sprintf("Decisions[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for NextPageToken
+
+	if len(errors) > 0 {
+		return ListAccessDecisionsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListAccessDecisionsResponseMultiError is an error wrapping multiple
+// validation errors returned by ListAccessDecisionsResponse.ValidateAll() if
+// the designated constraints aren't met.
+type ListAccessDecisionsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListAccessDecisionsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListAccessDecisionsResponseMultiError) AllErrors() []error { return m }
+
+// ListAccessDecisionsResponseValidationError is the validation error returned
+// by ListAccessDecisionsResponse.Validate if the designated constraints
+// aren't met.
+type ListAccessDecisionsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListAccessDecisionsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListAccessDecisionsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListAccessDecisionsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListAccessDecisionsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListAccessDecisionsResponseValidationError) ErrorName() string {
+	return "ListAccessDecisionsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListAccessDecisionsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListAccessDecisionsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListAccessDecisionsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListAccessDecisionsResponseValidationError{}
+
+// Validate checks the field values on MembershipPlan with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *MembershipPlan) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MembershipPlan with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in MembershipPlanMultiError,
+// or nil if none found.
+func (m *MembershipPlan) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MembershipPlan) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for DisplayName
+
+	// no validation rules for Description
+
+	// no validation rules for PriceCents
+
+	// no validation rules for BillingInterval
+
+	if m.ReducedPriceCents != nil {
+		// no validation rules for ReducedPriceCents
+	}
+
+	if len(errors) > 0 {
+		return MembershipPlanMultiError(errors)
+	}
+
+	return nil
+}
+
+// MembershipPlanMultiError is an error wrapping multiple validation errors
+// returned by MembershipPlan.ValidateAll() if the designated constraints
+// aren't met.
+type MembershipPlanMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MembershipPlanMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MembershipPlanMultiError) AllErrors() []error { return m }
+
+// MembershipPlanValidationError is the validation error returned by
+// MembershipPlan.Validate if the designated constraints aren't met.
+type MembershipPlanValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MembershipPlanValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MembershipPlanValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MembershipPlanValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MembershipPlanValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MembershipPlanValidationError) ErrorName() string { return "MembershipPlanValidationError" }
+
+// Error satisfies the builtin error interface
+func (e MembershipPlanValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMembershipPlan.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MembershipPlanValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MembershipPlanValidationError{}
+
+// Validate checks the field values on MembershipPlanPageToken with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *MembershipPlanPageToken) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MembershipPlanPageToken with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// MembershipPlanPageTokenMultiError, or nil if none found.
+func (m *MembershipPlanPageToken) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MembershipPlanPageToken) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for LastDisplayName
+
+	// no validation rules for LastId
+
+	if len(errors) > 0 {
+		return MembershipPlanPageTokenMultiError(errors)
+	}
+
+	return nil
+}
+
+// MembershipPlanPageTokenMultiError is an error wrapping multiple validation
+// errors returned by MembershipPlanPageToken.ValidateAll() if the designated
+// constraints aren't met.
+type MembershipPlanPageTokenMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MembershipPlanPageTokenMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MembershipPlanPageTokenMultiError) AllErrors() []error { return m }
+
+// MembershipPlanPageTokenValidationError is the validation error returned by
+// MembershipPlanPageToken.Validate if the designated constraints aren't met.
+type MembershipPlanPageTokenValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MembershipPlanPageTokenValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MembershipPlanPageTokenValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MembershipPlanPageTokenValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MembershipPlanPageTokenValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MembershipPlanPageTokenValidationError) ErrorName() string {
+	return "MembershipPlanPageTokenValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e MembershipPlanPageTokenValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMembershipPlanPageToken.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MembershipPlanPageTokenValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MembershipPlanPageTokenValidationError{}
+
+// Validate checks the field values on CreateMembershipPlanRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateMembershipPlanRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateMembershipPlanRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateMembershipPlanRequestMultiError, or nil if none found.
+func (m *CreateMembershipPlanRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateMembershipPlanRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for MembershipPlanId
+
+	if all {
+		switch v := interface{}(m.GetMembershipPlan()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateMembershipPlanRequestValidationError{
+					field:  "MembershipPlan",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateMembershipPlanRequestValidationError{
+					field:  "MembershipPlan",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetMembershipPlan()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateMembershipPlanRequestValidationError{
+				field:  "MembershipPlan",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CreateMembershipPlanRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateMembershipPlanRequestMultiError is an error wrapping multiple
+// validation errors returned by CreateMembershipPlanRequest.ValidateAll() if
+// the designated constraints aren't met.
+type CreateMembershipPlanRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateMembershipPlanRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateMembershipPlanRequestMultiError) AllErrors() []error { return m }
+
+// CreateMembershipPlanRequestValidationError is the validation error returned
+// by CreateMembershipPlanRequest.Validate if the designated constraints
+// aren't met.
+type CreateMembershipPlanRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateMembershipPlanRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateMembershipPlanRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateMembershipPlanRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateMembershipPlanRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateMembershipPlanRequestValidationError) ErrorName() string {
+	return "CreateMembershipPlanRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateMembershipPlanRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateMembershipPlanRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateMembershipPlanRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateMembershipPlanRequestValidationError{}
+
+// Validate checks the field values on GetMembershipPlanRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetMembershipPlanRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetMembershipPlanRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetMembershipPlanRequestMultiError, or nil if none found.
+func (m *GetMembershipPlanRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetMembershipPlanRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if len(errors) > 0 {
+		return GetMembershipPlanRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetMembershipPlanRequestMultiError is an error wrapping multiple validation
+// errors returned by GetMembershipPlanRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetMembershipPlanRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetMembershipPlanRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetMembershipPlanRequestMultiError) AllErrors() []error { return m }
+
+// GetMembershipPlanRequestValidationError is the validation error returned by
+// GetMembershipPlanRequest.Validate if the designated constraints aren't met.
+type GetMembershipPlanRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetMembershipPlanRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetMembershipPlanRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetMembershipPlanRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetMembershipPlanRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetMembershipPlanRequestValidationError) ErrorName() string {
+	return "GetMembershipPlanRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetMembershipPlanRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetMembershipPlanRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetMembershipPlanRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetMembershipPlanRequestValidationError{}
+
+// Validate checks the field values on ListMembershipPlansRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListMembershipPlansRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListMembershipPlansRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListMembershipPlansRequestMultiError, or nil if none found.
+func (m *ListMembershipPlansRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListMembershipPlansRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for PageSize
+
+	// no validation rules for PageToken
+
+	if len(errors) > 0 {
+		return ListMembershipPlansRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListMembershipPlansRequestMultiError is an error wrapping multiple
+// validation errors returned by ListMembershipPlansRequest.ValidateAll() if
+// the designated constraints aren't met.
+type ListMembershipPlansRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListMembershipPlansRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListMembershipPlansRequestMultiError) AllErrors() []error { return m }
+
+// ListMembershipPlansRequestValidationError is the validation error returned
+// by ListMembershipPlansRequest.Validate if the designated constraints aren't met.
+type ListMembershipPlansRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListMembershipPlansRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListMembershipPlansRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListMembershipPlansRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListMembershipPlansRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListMembershipPlansRequestValidationError) ErrorName() string {
+	return "ListMembershipPlansRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListMembershipPlansRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListMembershipPlansRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListMembershipPlansRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListMembershipPlansRequestValidationError{}
+
+// Validate checks the field values on ListMembershipPlansResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListMembershipPlansResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListMembershipPlansResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListMembershipPlansResponseMultiError, or nil if none found.
+func (m *ListMembershipPlansResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListMembershipPlansResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetMembershipPlans() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListMembershipPlansResponseValidationError{
+						field:  fmt.Sprintf("MembershipPlans[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListMembershipPlansResponseValidationError{
+						field:  fmt.Sprintf("MembershipPlans[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListMembershipPlansResponseValidationError{
+					field:  fmt.Sprintf("MembershipPlans[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for NextPageToken
+
+	if len(errors) > 0 {
+		return ListMembershipPlansResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListMembershipPlansResponseMultiError is an error wrapping multiple
+// validation errors returned by ListMembershipPlansResponse.ValidateAll() if
+// the designated constraints aren't met.
+type ListMembershipPlansResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListMembershipPlansResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListMembershipPlansResponseMultiError) AllErrors() []error { return m }
+
+// ListMembershipPlansResponseValidationError is the validation error returned
+// by ListMembershipPlansResponse.Validate if the designated constraints
+// aren't met.
+type ListMembershipPlansResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListMembershipPlansResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListMembershipPlansResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListMembershipPlansResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListMembershipPlansResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListMembershipPlansResponseValidationError) ErrorName() string {
+	return "ListMembershipPlansResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListMembershipPlansResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListMembershipPlansResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListMembershipPlansResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListMembershipPlansResponseValidationError{}
+
+// Validate checks the field values on UpdateMembershipPlanRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateMembershipPlanRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateMembershipPlanRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateMembershipPlanRequestMultiError, or nil if none found.
+func (m *UpdateMembershipPlanRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateMembershipPlanRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetMembershipPlan()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateMembershipPlanRequestValidationError{
+					field:  "MembershipPlan",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateMembershipPlanRequestValidationError{
+					field:  "MembershipPlan",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetMembershipPlan()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateMembershipPlanRequestValidationError{
+				field:  "MembershipPlan",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetFieldMask()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateMembershipPlanRequestValidationError{
+					field:  "FieldMask",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateMembershipPlanRequestValidationError{
+					field:  "FieldMask",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetFieldMask()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateMembershipPlanRequestValidationError{
+				field:  "FieldMask",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return UpdateMembershipPlanRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateMembershipPlanRequestMultiError is an error wrapping multiple
+// validation errors returned by UpdateMembershipPlanRequest.ValidateAll() if
+// the designated constraints aren't met.
+type UpdateMembershipPlanRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateMembershipPlanRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateMembershipPlanRequestMultiError) AllErrors() []error { return m }
+
+// UpdateMembershipPlanRequestValidationError is the validation error returned
+// by UpdateMembershipPlanRequest.Validate if the designated constraints
+// aren't met.
+type UpdateMembershipPlanRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateMembershipPlanRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateMembershipPlanRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateMembershipPlanRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateMembershipPlanRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateMembershipPlanRequestValidationError) ErrorName() string {
+	return "UpdateMembershipPlanRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateMembershipPlanRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateMembershipPlanRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateMembershipPlanRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateMembershipPlanRequestValidationError{}
+
+// Validate checks the field values on DeleteMembershipPlanRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteMembershipPlanRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteMembershipPlanRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteMembershipPlanRequestMultiError, or nil if none found.
+func (m *DeleteMembershipPlanRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteMembershipPlanRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if len(errors) > 0 {
+		return DeleteMembershipPlanRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteMembershipPlanRequestMultiError is an error wrapping multiple
+// validation errors returned by DeleteMembershipPlanRequest.ValidateAll() if
+// the designated constraints aren't met.
+type DeleteMembershipPlanRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteMembershipPlanRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteMembershipPlanRequestMultiError) AllErrors() []error { return m }
+
+// DeleteMembershipPlanRequestValidationError is the validation error returned
+// by DeleteMembershipPlanRequest.Validate if the designated constraints
+// aren't met.
+type DeleteMembershipPlanRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteMembershipPlanRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteMembershipPlanRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteMembershipPlanRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteMembershipPlanRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteMembershipPlanRequestValidationError) ErrorName() string {
+	return "DeleteMembershipPlanRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteMembershipPlanRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteMembershipPlanRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteMembershipPlanRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteMembershipPlanRequestValidationError{}
+
+// Validate checks the field values on PlanAssignment with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *PlanAssignment) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PlanAssignment with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in PlanAssignmentMultiError,
+// or nil if none found.
+func (m *PlanAssignment) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PlanAssignment) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for MemberId
+
+	// no validation rules for PlanId
+
+	if all {
+		switch v := interface{}(m.GetStartTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PlanAssignmentValidationError{
+					field:  "StartTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PlanAssignmentValidationError{
+					field:  "StartTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetStartTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PlanAssignmentValidationError{
+				field:  "StartTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetBilledUntil()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PlanAssignmentValidationError{
+					field:  "BilledUntil",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PlanAssignmentValidationError{
+					field:  "BilledUntil",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetBilledUntil()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PlanAssignmentValidationError{
+				field:  "BilledUntil",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if m.EndTime != nil {
+
+		if all {
+			switch v := interface{}(m.GetEndTime()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, PlanAssignmentValidationError{
+						field:  "EndTime",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, PlanAssignmentValidationError{
+						field:  "EndTime",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetEndTime()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return PlanAssignmentValidationError{
+					field:  "EndTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return PlanAssignmentMultiError(errors)
+	}
+
+	return nil
+}
+
+// PlanAssignmentMultiError is an error wrapping multiple validation errors
+// returned by PlanAssignment.ValidateAll() if the designated constraints
+// aren't met.
+type PlanAssignmentMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PlanAssignmentMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PlanAssignmentMultiError) AllErrors() []error { return m }
+
+// PlanAssignmentValidationError is the validation error returned by
+// PlanAssignment.Validate if the designated constraints aren't met.
+type PlanAssignmentValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PlanAssignmentValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PlanAssignmentValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PlanAssignmentValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PlanAssignmentValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PlanAssignmentValidationError) ErrorName() string { return "PlanAssignmentValidationError" }
+
+// Error satisfies the builtin error interface
+func (e PlanAssignmentValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPlanAssignment.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PlanAssignmentValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PlanAssignmentValidationError{}
+
+// Validate checks the field values on PlanAssignmentPageToken with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *PlanAssignmentPageToken) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PlanAssignmentPageToken with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PlanAssignmentPageTokenMultiError, or nil if none found.
+func (m *PlanAssignmentPageToken) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PlanAssignmentPageToken) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetLastStartTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PlanAssignmentPageTokenValidationError{
+					field:  "LastStartTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PlanAssignmentPageTokenValidationError{
+					field:  "LastStartTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetLastStartTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PlanAssignmentPageTokenValidationError{
+				field:  "LastStartTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for LastId
+
+	if len(errors) > 0 {
+		return PlanAssignmentPageTokenMultiError(errors)
+	}
+
+	return nil
+}
+
+// PlanAssignmentPageTokenMultiError is an error wrapping multiple validation
+// errors returned by PlanAssignmentPageToken.ValidateAll() if the designated
+// constraints aren't met.
+type PlanAssignmentPageTokenMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PlanAssignmentPageTokenMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PlanAssignmentPageTokenMultiError) AllErrors() []error { return m }
+
+// PlanAssignmentPageTokenValidationError is the validation error returned by
+// PlanAssignmentPageToken.Validate if the designated constraints aren't met.
+type PlanAssignmentPageTokenValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PlanAssignmentPageTokenValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PlanAssignmentPageTokenValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PlanAssignmentPageTokenValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PlanAssignmentPageTokenValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PlanAssignmentPageTokenValidationError) ErrorName() string {
+	return "PlanAssignmentPageTokenValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PlanAssignmentPageTokenValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPlanAssignmentPageToken.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PlanAssignmentPageTokenValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PlanAssignmentPageTokenValidationError{}
+
+// Validate checks the field values on AssignMembershipPlanRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AssignMembershipPlanRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AssignMembershipPlanRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AssignMembershipPlanRequestMultiError, or nil if none found.
+func (m *AssignMembershipPlanRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AssignMembershipPlanRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetPlanAssignment()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AssignMembershipPlanRequestValidationError{
+					field:  "PlanAssignment",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AssignMembershipPlanRequestValidationError{
+					field:  "PlanAssignment",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPlanAssignment()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AssignMembershipPlanRequestValidationError{
+				field:  "PlanAssignment",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return AssignMembershipPlanRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AssignMembershipPlanRequestMultiError is an error wrapping multiple
+// validation errors returned by AssignMembershipPlanRequest.ValidateAll() if
+// the designated constraints aren't met.
+type AssignMembershipPlanRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AssignMembershipPlanRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AssignMembershipPlanRequestMultiError) AllErrors() []error { return m }
+
+// AssignMembershipPlanRequestValidationError is the validation error returned
+// by AssignMembershipPlanRequest.Validate if the designated constraints
+// aren't met.
+type AssignMembershipPlanRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AssignMembershipPlanRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AssignMembershipPlanRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AssignMembershipPlanRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AssignMembershipPlanRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AssignMembershipPlanRequestValidationError) ErrorName() string {
+	return "AssignMembershipPlanRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AssignMembershipPlanRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAssignMembershipPlanRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AssignMembershipPlanRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AssignMembershipPlanRequestValidationError{}
+
+// Validate checks the field values on ListPlanAssignmentsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListPlanAssignmentsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListPlanAssignmentsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListPlanAssignmentsRequestMultiError, or nil if none found.
+func (m *ListPlanAssignmentsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListPlanAssignmentsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for PageSize
+
+	// no validation rules for PageToken
+
+	if m.MemberId != nil {
+		// no validation rules for MemberId
+	}
+
+	if m.PlanId != nil {
+		// no validation rules for PlanId
+	}
+
+	if len(errors) > 0 {
+		return ListPlanAssignmentsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListPlanAssignmentsRequestMultiError is an error wrapping multiple
+// validation errors returned by ListPlanAssignmentsRequest.ValidateAll() if
+// the designated constraints aren't met.
+type ListPlanAssignmentsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListPlanAssignmentsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListPlanAssignmentsRequestMultiError) AllErrors() []error { return m }
+
+// ListPlanAssignmentsRequestValidationError is the validation error returned
+// by ListPlanAssignmentsRequest.Validate if the designated constraints aren't met.
+type ListPlanAssignmentsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListPlanAssignmentsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListPlanAssignmentsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListPlanAssignmentsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListPlanAssignmentsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListPlanAssignmentsRequestValidationError) ErrorName() string {
+	return "ListPlanAssignmentsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListPlanAssignmentsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListPlanAssignmentsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListPlanAssignmentsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListPlanAssignmentsRequestValidationError{}
+
+// Validate checks the field values on ListPlanAssignmentsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListPlanAssignmentsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListPlanAssignmentsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListPlanAssignmentsResponseMultiError, or nil if none found.
+func (m *ListPlanAssignmentsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListPlanAssignmentsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetPlanAssignments() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListPlanAssignmentsResponseValidationError{
+						field:  fmt.Sprintf("PlanAssignments[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListPlanAssignmentsResponseValidationError{
+						field:  fmt.Sprintf("PlanAssignments[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListPlanAssignmentsResponseValidationError{
+					field:  fmt.Sprintf("PlanAssignments[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for NextPageToken
+
+	if len(errors) > 0 {
+		return ListPlanAssignmentsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListPlanAssignmentsResponseMultiError is an error wrapping multiple
+// validation errors returned by ListPlanAssignmentsResponse.ValidateAll() if
+// the designated constraints aren't met.
+type ListPlanAssignmentsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListPlanAssignmentsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListPlanAssignmentsResponseMultiError) AllErrors() []error { return m }
+
+// ListPlanAssignmentsResponseValidationError is the validation error returned
+// by ListPlanAssignmentsResponse.Validate if the designated constraints
+// aren't met.
+type ListPlanAssignmentsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListPlanAssignmentsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListPlanAssignmentsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListPlanAssignmentsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListPlanAssignmentsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListPlanAssignmentsResponseValidationError) ErrorName() string {
+	return "ListPlanAssignmentsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListPlanAssignmentsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListPlanAssignmentsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListPlanAssignmentsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListPlanAssignmentsResponseValidationError{}
+
+// Validate checks the field values on EndPlanAssignmentRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *EndPlanAssignmentRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on EndPlanAssignmentRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// EndPlanAssignmentRequestMultiError, or nil if none found.
+func (m *EndPlanAssignmentRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *EndPlanAssignmentRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if all {
+		switch v := interface{}(m.GetEndTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, EndPlanAssignmentRequestValidationError{
+					field:  "EndTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, EndPlanAssignmentRequestValidationError{
+					field:  "EndTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetEndTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return EndPlanAssignmentRequestValidationError{
+				field:  "EndTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return EndPlanAssignmentRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// EndPlanAssignmentRequestMultiError is an error wrapping multiple validation
+// errors returned by EndPlanAssignmentRequest.ValidateAll() if the designated
+// constraints aren't met.
+type EndPlanAssignmentRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m EndPlanAssignmentRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m EndPlanAssignmentRequestMultiError) AllErrors() []error { return m }
+
+// EndPlanAssignmentRequestValidationError is the validation error returned by
+// EndPlanAssignmentRequest.Validate if the designated constraints aren't met.
+type EndPlanAssignmentRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e EndPlanAssignmentRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e EndPlanAssignmentRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e EndPlanAssignmentRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e EndPlanAssignmentRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e EndPlanAssignmentRequestValidationError) ErrorName() string {
+	return "EndPlanAssignmentRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e EndPlanAssignmentRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sEndPlanAssignmentRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = EndPlanAssignmentRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = EndPlanAssignmentRequestValidationError{}
+
+// Validate checks the field values on Invoice with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Invoice) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Invoice with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in InvoiceMultiError, or nil if none found.
+func (m *Invoice) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Invoice) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for MemberId
+
+	// no validation rules for PlanAssignmentId
+
+	// no validation rules for PlanId
+
+	if all {
+		switch v := interface{}(m.GetPeriodStart()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, InvoiceValidationError{
+					field:  "PeriodStart",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, InvoiceValidationError{
+					field:  "PeriodStart",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPeriodStart()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return InvoiceValidationError{
+				field:  "PeriodStart",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetPeriodEnd()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, InvoiceValidationError{
+					field:  "PeriodEnd",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, InvoiceValidationError{
+					field:  "PeriodEnd",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPeriodEnd()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return InvoiceValidationError{
+				field:  "PeriodEnd",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for AmountCents
+
+	// no validation rules for PaidCents
+
+	if all {
+		switch v := interface{}(m.GetIssueTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, InvoiceValidationError{
+					field:  "IssueTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, InvoiceValidationError{
+					field:  "IssueTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetIssueTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return InvoiceValidationError{
+				field:  "IssueTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Status
+
+	if len(errors) > 0 {
+		return InvoiceMultiError(errors)
+	}
+
+	return nil
+}
+
+// InvoiceMultiError is an error wrapping multiple validation errors returned
+// by Invoice.ValidateAll() if the designated constraints aren't met.
+type InvoiceMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m InvoiceMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m InvoiceMultiError) AllErrors() []error { return m }
+
+// InvoiceValidationError is the validation error returned by Invoice.Validate
+// if the designated constraints aren't met.
+type InvoiceValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e InvoiceValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e InvoiceValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e InvoiceValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e InvoiceValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e InvoiceValidationError) ErrorName() string { return "InvoiceValidationError" }
+
+// Error satisfies the builtin error interface
+func (e InvoiceValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sInvoice.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = InvoiceValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = InvoiceValidationError{}
+
+// Validate checks the field values on InvoicePageToken with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *InvoicePageToken) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on InvoicePageToken with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// InvoicePageTokenMultiError, or nil if none found.
+func (m *InvoicePageToken) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *InvoicePageToken) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetLastPeriodStart()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, InvoicePageTokenValidationError{
+					field:  "LastPeriodStart",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, InvoicePageTokenValidationError{
+					field:  "LastPeriodStart",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetLastPeriodStart()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return InvoicePageTokenValidationError{
+				field:  "LastPeriodStart",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for LastId
+
+	if len(errors) > 0 {
+		return InvoicePageTokenMultiError(errors)
+	}
+
+	return nil
+}
+
+// InvoicePageTokenMultiError is an error wrapping multiple validation errors
+// returned by InvoicePageToken.ValidateAll() if the designated constraints
+// aren't met.
+type InvoicePageTokenMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m InvoicePageTokenMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m InvoicePageTokenMultiError) AllErrors() []error { return m }
+
+// InvoicePageTokenValidationError is the validation error returned by
+// InvoicePageToken.Validate if the designated constraints aren't met.
+type InvoicePageTokenValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e InvoicePageTokenValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e InvoicePageTokenValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e InvoicePageTokenValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e InvoicePageTokenValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e InvoicePageTokenValidationError) ErrorName() string { return "InvoicePageTokenValidationError" }
+
+// Error satisfies the builtin error interface
+func (e InvoicePageTokenValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sInvoicePageToken.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = InvoicePageTokenValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = InvoicePageTokenValidationError{}
+
+// Validate checks the field values on GenerateInvoicesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GenerateInvoicesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GenerateInvoicesRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GenerateInvoicesRequestMultiError, or nil if none found.
+func (m *GenerateInvoicesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GenerateInvoicesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetUntil()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GenerateInvoicesRequestValidationError{
+					field:  "Until",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GenerateInvoicesRequestValidationError{
+					field:  "Until",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUntil()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GenerateInvoicesRequestValidationError{
+				field:  "Until",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GenerateInvoicesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GenerateInvoicesRequestMultiError is an error wrapping multiple validation
+// errors returned by GenerateInvoicesRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GenerateInvoicesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GenerateInvoicesRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GenerateInvoicesRequestMultiError) AllErrors() []error { return m }
+
+// GenerateInvoicesRequestValidationError is the validation error returned by
+// GenerateInvoicesRequest.Validate if the designated constraints aren't met.
+type GenerateInvoicesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GenerateInvoicesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GenerateInvoicesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GenerateInvoicesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GenerateInvoicesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GenerateInvoicesRequestValidationError) ErrorName() string {
+	return "GenerateInvoicesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GenerateInvoicesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGenerateInvoicesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GenerateInvoicesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GenerateInvoicesRequestValidationError{}
+
+// Validate checks the field values on GenerateInvoicesResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GenerateInvoicesResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GenerateInvoicesResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GenerateInvoicesResponseMultiError, or nil if none found.
+func (m *GenerateInvoicesResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GenerateInvoicesResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetInvoices() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GenerateInvoicesResponseValidationError{
+						field:  fmt.Sprintf("Invoices[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GenerateInvoicesResponseValidationError{
+						field:  fmt.Sprintf("Invoices[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GenerateInvoicesResponseValidationError{
+					field:  fmt.Sprintf("Invoices[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return GenerateInvoicesResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GenerateInvoicesResponseMultiError is an error wrapping multiple validation
+// errors returned by GenerateInvoicesResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GenerateInvoicesResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GenerateInvoicesResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GenerateInvoicesResponseMultiError) AllErrors() []error { return m }
+
+// GenerateInvoicesResponseValidationError is the validation error returned by
+// GenerateInvoicesResponse.Validate if the designated constraints aren't met.
+type GenerateInvoicesResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GenerateInvoicesResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GenerateInvoicesResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GenerateInvoicesResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GenerateInvoicesResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GenerateInvoicesResponseValidationError) ErrorName() string {
+	return "GenerateInvoicesResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GenerateInvoicesResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGenerateInvoicesResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GenerateInvoicesResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GenerateInvoicesResponseValidationError{}
+
+// Validate checks the field values on ListInvoicesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListInvoicesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListInvoicesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListInvoicesRequestMultiError, or nil if none found.
+func (m *ListInvoicesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListInvoicesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for PageSize
+
+	// no validation rules for PageToken
+
+	if m.MemberId != nil {
+		// no validation rules for MemberId
+	}
+
+	if m.Status != nil {
+		// no validation rules for Status
+	}
+
+	if len(errors) > 0 {
+		return ListInvoicesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListInvoicesRequestMultiError is an error wrapping multiple validation
+// errors returned by ListInvoicesRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListInvoicesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListInvoicesRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListInvoicesRequestMultiError) AllErrors() []error { return m }
+
+// ListInvoicesRequestValidationError is the validation error returned by
+// ListInvoicesRequest.Validate if the designated constraints aren't met.
+type ListInvoicesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListInvoicesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListInvoicesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListInvoicesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListInvoicesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListInvoicesRequestValidationError) ErrorName() string {
+	return "ListInvoicesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListInvoicesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListInvoicesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListInvoicesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListInvoicesRequestValidationError{}
+
+// Validate checks the field values on ListInvoicesResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListInvoicesResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListInvoicesResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListInvoicesResponseMultiError, or nil if none found.
+func (m *ListInvoicesResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListInvoicesResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetInvoices() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListInvoicesResponseValidationError{
+						field:  fmt.Sprintf("Invoices[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListInvoicesResponseValidationError{
+						field:  fmt.Sprintf("Invoices[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListInvoicesResponseValidationError{
+					field:  fmt.Sprintf("Invoices[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for NextPageToken
+
+	if len(errors) > 0 {
+		return ListInvoicesResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListInvoicesResponseMultiError is an error wrapping multiple validation
+// errors returned by ListInvoicesResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ListInvoicesResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListInvoicesResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListInvoicesResponseMultiError) AllErrors() []error { return m }
+
+// ListInvoicesResponseValidationError is the validation error returned by
+// ListInvoicesResponse.Validate if the designated constraints aren't met.
+type ListInvoicesResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListInvoicesResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListInvoicesResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListInvoicesResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListInvoicesResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListInvoicesResponseValidationError) ErrorName() string {
+	return "ListInvoicesResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListInvoicesResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListInvoicesResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListInvoicesResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListInvoicesResponseValidationError{}
+
+// Validate checks the field values on CancelInvoiceRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CancelInvoiceRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CancelInvoiceRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CancelInvoiceRequestMultiError, or nil if none found.
+func (m *CancelInvoiceRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CancelInvoiceRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if len(errors) > 0 {
+		return CancelInvoiceRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CancelInvoiceRequestMultiError is an error wrapping multiple validation
+// errors returned by CancelInvoiceRequest.ValidateAll() if the designated
+// constraints aren't met.
+type CancelInvoiceRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CancelInvoiceRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CancelInvoiceRequestMultiError) AllErrors() []error { return m }
+
+// CancelInvoiceRequestValidationError is the validation error returned by
+// CancelInvoiceRequest.Validate if the designated constraints aren't met.
+type CancelInvoiceRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CancelInvoiceRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CancelInvoiceRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CancelInvoiceRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CancelInvoiceRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CancelInvoiceRequestValidationError) ErrorName() string {
+	return "CancelInvoiceRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CancelInvoiceRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCancelInvoiceRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CancelInvoiceRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CancelInvoiceRequestValidationError{}
+
+// Validate checks the field values on Payment with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Payment) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Payment with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in PaymentMultiError, or nil if none found.
+func (m *Payment) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Payment) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for MemberId
+
+	// no validation rules for AmountCents
+
+	if all {
+		switch v := interface{}(m.GetPaymentTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PaymentValidationError{
+					field:  "PaymentTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PaymentValidationError{
+					field:  "PaymentTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPaymentTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PaymentValidationError{
+				field:  "PaymentTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Reference
+
+	if m.InvoiceId != nil {
+		// no validation rules for InvoiceId
+	}
+
+	if len(errors) > 0 {
+		return PaymentMultiError(errors)
+	}
+
+	return nil
+}
+
+// PaymentMultiError is an error wrapping multiple validation errors returned
+// by Payment.ValidateAll() if the designated constraints aren't met.
+type PaymentMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PaymentMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PaymentMultiError) AllErrors() []error { return m }
+
+// PaymentValidationError is the validation error returned by Payment.Validate
+// if the designated constraints aren't met.
+type PaymentValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PaymentValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PaymentValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PaymentValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PaymentValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PaymentValidationError) ErrorName() string { return "PaymentValidationError" }
+
+// Error satisfies the builtin error interface
+func (e PaymentValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPayment.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PaymentValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PaymentValidationError{}
+
+// Validate checks the field values on PaymentPageToken with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *PaymentPageToken) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PaymentPageToken with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PaymentPageTokenMultiError, or nil if none found.
+func (m *PaymentPageToken) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PaymentPageToken) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetLastPaymentTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PaymentPageTokenValidationError{
+					field:  "LastPaymentTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PaymentPageTokenValidationError{
+					field:  "LastPaymentTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetLastPaymentTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PaymentPageTokenValidationError{
+				field:  "LastPaymentTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for LastId
+
+	if len(errors) > 0 {
+		return PaymentPageTokenMultiError(errors)
+	}
+
+	return nil
+}
+
+// PaymentPageTokenMultiError is an error wrapping multiple validation errors
+// returned by PaymentPageToken.ValidateAll() if the designated constraints
+// aren't met.
+type PaymentPageTokenMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PaymentPageTokenMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PaymentPageTokenMultiError) AllErrors() []error { return m }
+
+// PaymentPageTokenValidationError is the validation error returned by
+// PaymentPageToken.Validate if the designated constraints aren't met.
+type PaymentPageTokenValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PaymentPageTokenValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PaymentPageTokenValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PaymentPageTokenValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PaymentPageTokenValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PaymentPageTokenValidationError) ErrorName() string { return "PaymentPageTokenValidationError" }
+
+// Error satisfies the builtin error interface
+func (e PaymentPageTokenValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPaymentPageToken.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PaymentPageTokenValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PaymentPageTokenValidationError{}
+
+// Validate checks the field values on RecordPaymentRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RecordPaymentRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RecordPaymentRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RecordPaymentRequestMultiError, or nil if none found.
+func (m *RecordPaymentRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RecordPaymentRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for PaymentId
+
+	if all {
+		switch v := interface{}(m.GetPayment()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RecordPaymentRequestValidationError{
+					field:  "Payment",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RecordPaymentRequestValidationError{
+					field:  "Payment",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPayment()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RecordPaymentRequestValidationError{
+				field:  "Payment",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return RecordPaymentRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// RecordPaymentRequestMultiError is an error wrapping multiple validation
+// errors returned by RecordPaymentRequest.ValidateAll() if the designated
+// constraints aren't met.
+type RecordPaymentRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RecordPaymentRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RecordPaymentRequestMultiError) AllErrors() []error { return m }
+
+// RecordPaymentRequestValidationError is the validation error returned by
+// RecordPaymentRequest.Validate if the designated constraints aren't met.
+type RecordPaymentRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RecordPaymentRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RecordPaymentRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RecordPaymentRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RecordPaymentRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RecordPaymentRequestValidationError) ErrorName() string {
+	return "RecordPaymentRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RecordPaymentRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRecordPaymentRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RecordPaymentRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RecordPaymentRequestValidationError{}
+
+// Validate checks the field values on ListPaymentsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListPaymentsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListPaymentsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListPaymentsRequestMultiError, or nil if none found.
+func (m *ListPaymentsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListPaymentsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for PageSize
+
+	// no validation rules for PageToken
+
+	if m.MemberId != nil {
+		// no validation rules for MemberId
+	}
+
+	if m.InvoiceId != nil {
+		// no validation rules for InvoiceId
+	}
+
+	if len(errors) > 0 {
+		return ListPaymentsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListPaymentsRequestMultiError is an error wrapping multiple validation
+// errors returned by ListPaymentsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListPaymentsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListPaymentsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListPaymentsRequestMultiError) AllErrors() []error { return m }
+
+// ListPaymentsRequestValidationError is the validation error returned by
+// ListPaymentsRequest.Validate if the designated constraints aren't met.
+type ListPaymentsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListPaymentsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListPaymentsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListPaymentsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListPaymentsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListPaymentsRequestValidationError) ErrorName() string {
+	return "ListPaymentsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListPaymentsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListPaymentsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListPaymentsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListPaymentsRequestValidationError{}
+
+// Validate checks the field values on ListPaymentsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListPaymentsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListPaymentsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListPaymentsResponseMultiError, or nil if none found.
+func (m *ListPaymentsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListPaymentsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetPayments() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListPaymentsResponseValidationError{
+						field:  fmt.Sprintf("Payments[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListPaymentsResponseValidationError{
+						field:  fmt.Sprintf("Payments[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListPaymentsResponseValidationError{
+					field:  fmt.Sprintf("Payments[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for NextPageToken
+
+	if len(errors) > 0 {
+		return ListPaymentsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListPaymentsResponseMultiError is an error wrapping multiple validation
+// errors returned by ListPaymentsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ListPaymentsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListPaymentsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListPaymentsResponseMultiError) AllErrors() []error { return m }
+
+// ListPaymentsResponseValidationError is the validation error returned by
+// ListPaymentsResponse.Validate if the designated constraints aren't met.
+type ListPaymentsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListPaymentsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListPaymentsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListPaymentsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListPaymentsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListPaymentsResponseValidationError) ErrorName() string {
+	return "ListPaymentsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListPaymentsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListPaymentsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListPaymentsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListPaymentsResponseValidationError{}
+
+// Validate checks the field values on DeletePaymentRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeletePaymentRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeletePaymentRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeletePaymentRequestMultiError, or nil if none found.
+func (m *DeletePaymentRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeletePaymentRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if len(errors) > 0 {
+		return DeletePaymentRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeletePaymentRequestMultiError is an error wrapping multiple validation
+// errors returned by DeletePaymentRequest.ValidateAll() if the designated
+// constraints aren't met.
+type DeletePaymentRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeletePaymentRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeletePaymentRequestMultiError) AllErrors() []error { return m }
+
+// DeletePaymentRequestValidationError is the validation error returned by
+// DeletePaymentRequest.Validate if the designated constraints aren't met.
+type DeletePaymentRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeletePaymentRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeletePaymentRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeletePaymentRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeletePaymentRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeletePaymentRequestValidationError) ErrorName() string {
+	return "DeletePaymentRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeletePaymentRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeletePaymentRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeletePaymentRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeletePaymentRequestValidationError{}
+
+// Validate checks the field values on Balance with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Balance) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Balance with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in BalanceMultiError, or nil if none found.
+func (m *Balance) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Balance) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for MemberId
+
+	// no validation rules for InvoicedCents
+
+	// no validation rules for PaidCents
+
+	// no validation rules for OutstandingCents
+
+	if len(errors) > 0 {
+		return BalanceMultiError(errors)
+	}
+
+	return nil
+}
+
+// BalanceMultiError is an error wrapping multiple validation errors returned
+// by Balance.ValidateAll() if the designated constraints aren't met.
+type BalanceMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BalanceMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BalanceMultiError) AllErrors() []error { return m }
+
+// BalanceValidationError is the validation error returned by Balance.Validate
+// if the designated constraints aren't met.
+type BalanceValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BalanceValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BalanceValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BalanceValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BalanceValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BalanceValidationError) ErrorName() string { return "BalanceValidationError" }
+
+// Error satisfies the builtin error interface
+func (e BalanceValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBalance.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BalanceValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BalanceValidationError{}
+
+// Validate checks the field values on BalancePageToken with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *BalancePageToken) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BalancePageToken with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// BalancePageTokenMultiError, or nil if none found.
+func (m *BalancePageToken) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BalancePageToken) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for LastMemberId
+
+	if len(errors) > 0 {
+		return BalancePageTokenMultiError(errors)
+	}
+
+	return nil
+}
+
+// BalancePageTokenMultiError is an error wrapping multiple validation errors
+// returned by BalancePageToken.ValidateAll() if the designated constraints
+// aren't met.
+type BalancePageTokenMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BalancePageTokenMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BalancePageTokenMultiError) AllErrors() []error { return m }
+
+// BalancePageTokenValidationError is the validation error returned by
+// BalancePageToken.Validate if the designated constraints aren't met.
+type BalancePageTokenValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BalancePageTokenValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BalancePageTokenValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BalancePageTokenValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BalancePageTokenValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BalancePageTokenValidationError) ErrorName() string { return "BalancePageTokenValidationError" }
+
+// Error satisfies the builtin error interface
+func (e BalancePageTokenValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBalancePageToken.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BalancePageTokenValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BalancePageTokenValidationError{}
+
+// Validate checks the field values on ListBalancesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListBalancesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListBalancesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListBalancesRequestMultiError, or nil if none found.
+func (m *ListBalancesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListBalancesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for PageSize
+
+	// no validation rules for PageToken
+
+	// no validation rules for OutstandingOnly
+
+	if m.MemberId != nil {
+		// no validation rules for MemberId
+	}
+
+	if len(errors) > 0 {
+		return ListBalancesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListBalancesRequestMultiError is an error wrapping multiple validation
+// errors returned by ListBalancesRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListBalancesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListBalancesRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListBalancesRequestMultiError) AllErrors() []error { return m }
+
+// ListBalancesRequestValidationError is the validation error returned by
+// ListBalancesRequest.Validate if the designated constraints aren't met.
+type ListBalancesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListBalancesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListBalancesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListBalancesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListBalancesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListBalancesRequestValidationError) ErrorName() string {
+	return "ListBalancesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListBalancesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListBalancesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListBalancesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListBalancesRequestValidationError{}
+
+// Validate checks the field values on SepaMandate with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *SepaMandate) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SepaMandate with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in SepaMandateMultiError, or
+// nil if none found.
+func (m *SepaMandate) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SepaMandate) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for MemberId
+
+	// no validation rules for AccountHolder
+
+	// no validation rules for Iban
+
+	// no validation rules for Bic
+
+	// no validation rules for MandateReference
+
+	if all {
+		switch v := interface{}(m.GetMandateDate()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SepaMandateValidationError{
+					field:  "MandateDate",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SepaMandateValidationError{
+					field:  "MandateDate",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetMandateDate()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SepaMandateValidationError{
+				field:  "MandateDate",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return SepaMandateMultiError(errors)
+	}
+
+	return nil
+}
+
+// SepaMandateMultiError is an error wrapping multiple validation errors
+// returned by SepaMandate.ValidateAll() if the designated constraints aren't met.
+type SepaMandateMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SepaMandateMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SepaMandateMultiError) AllErrors() []error { return m }
+
+// SepaMandateValidationError is the validation error returned by
+// SepaMandate.Validate if the designated constraints aren't met.
+type SepaMandateValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SepaMandateValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SepaMandateValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SepaMandateValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SepaMandateValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SepaMandateValidationError) ErrorName() string { return "SepaMandateValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SepaMandateValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSepaMandate.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SepaMandateValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SepaMandateValidationError{}
+
+// Validate checks the field values on SetSepaMandateRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SetSepaMandateRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SetSepaMandateRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SetSepaMandateRequestMultiError, or nil if none found.
+func (m *SetSepaMandateRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SetSepaMandateRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetSepaMandate()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SetSepaMandateRequestValidationError{
+					field:  "SepaMandate",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SetSepaMandateRequestValidationError{
+					field:  "SepaMandate",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSepaMandate()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SetSepaMandateRequestValidationError{
+				field:  "SepaMandate",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return SetSepaMandateRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SetSepaMandateRequestMultiError is an error wrapping multiple validation
+// errors returned by SetSepaMandateRequest.ValidateAll() if the designated
+// constraints aren't met.
+type SetSepaMandateRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SetSepaMandateRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SetSepaMandateRequestMultiError) AllErrors() []error { return m }
+
+// SetSepaMandateRequestValidationError is the validation error returned by
+// SetSepaMandateRequest.Validate if the designated constraints aren't met.
+type SetSepaMandateRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SetSepaMandateRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SetSepaMandateRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SetSepaMandateRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SetSepaMandateRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SetSepaMandateRequestValidationError) ErrorName() string {
+	return "SetSepaMandateRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SetSepaMandateRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSetSepaMandateRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SetSepaMandateRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SetSepaMandateRequestValidationError{}
+
+// Validate checks the field values on GetSepaMandateRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetSepaMandateRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetSepaMandateRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetSepaMandateRequestMultiError, or nil if none found.
+func (m *GetSepaMandateRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetSepaMandateRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for MemberId
+
+	if len(errors) > 0 {
+		return GetSepaMandateRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetSepaMandateRequestMultiError is an error wrapping multiple validation
+// errors returned by GetSepaMandateRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetSepaMandateRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetSepaMandateRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetSepaMandateRequestMultiError) AllErrors() []error { return m }
+
+// GetSepaMandateRequestValidationError is the validation error returned by
+// GetSepaMandateRequest.Validate if the designated constraints aren't met.
+type GetSepaMandateRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetSepaMandateRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetSepaMandateRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetSepaMandateRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetSepaMandateRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetSepaMandateRequestValidationError) ErrorName() string {
+	return "GetSepaMandateRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetSepaMandateRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetSepaMandateRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetSepaMandateRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetSepaMandateRequestValidationError{}
+
+// Validate checks the field values on DeleteSepaMandateRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteSepaMandateRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteSepaMandateRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteSepaMandateRequestMultiError, or nil if none found.
+func (m *DeleteSepaMandateRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteSepaMandateRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for MemberId
+
+	if len(errors) > 0 {
+		return DeleteSepaMandateRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteSepaMandateRequestMultiError is an error wrapping multiple validation
+// errors returned by DeleteSepaMandateRequest.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteSepaMandateRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteSepaMandateRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteSepaMandateRequestMultiError) AllErrors() []error { return m }
+
+// DeleteSepaMandateRequestValidationError is the validation error returned by
+// DeleteSepaMandateRequest.Validate if the designated constraints aren't met.
+type DeleteSepaMandateRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteSepaMandateRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteSepaMandateRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteSepaMandateRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteSepaMandateRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteSepaMandateRequestValidationError) ErrorName() string {
+	return "DeleteSepaMandateRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteSepaMandateRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteSepaMandateRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteSepaMandateRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteSepaMandateRequestValidationError{}
+
+// Validate checks the field values on ExportSepaDirectDebitRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ExportSepaDirectDebitRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ExportSepaDirectDebitRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ExportSepaDirectDebitRequestMultiError, or nil if none found.
+func (m *ExportSepaDirectDebitRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ExportSepaDirectDebitRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetPeriodStart()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ExportSepaDirectDebitRequestValidationError{
+					field:  "PeriodStart",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ExportSepaDirectDebitRequestValidationError{
+					field:  "PeriodStart",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPeriodStart()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ExportSepaDirectDebitRequestValidationError{
+				field:  "PeriodStart",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetPeriodEnd()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ExportSepaDirectDebitRequestValidationError{
+					field:  "PeriodEnd",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ExportSepaDirectDebitRequestValidationError{
+					field:  "PeriodEnd",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPeriodEnd()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ExportSepaDirectDebitRequestValidationError{
+				field:  "PeriodEnd",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetCollectionDate()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ExportSepaDirectDebitRequestValidationError{
+					field:  "CollectionDate",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ExportSepaDirectDebitRequestValidationError{
+					field:  "CollectionDate",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCollectionDate()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ExportSepaDirectDebitRequestValidationError{
+				field:  "CollectionDate",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for IncludeExported
+
+	if len(errors) > 0 {
+		return ExportSepaDirectDebitRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ExportSepaDirectDebitRequestMultiError is an error wrapping multiple
+// validation errors returned by ExportSepaDirectDebitRequest.ValidateAll() if
+// the designated constraints aren't met.
+type ExportSepaDirectDebitRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ExportSepaDirectDebitRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ExportSepaDirectDebitRequestMultiError) AllErrors() []error { return m }
+
+// ExportSepaDirectDebitRequestValidationError is the validation error returned
+// by ExportSepaDirectDebitRequest.Validate if the designated constraints
+// aren't met.
+type ExportSepaDirectDebitRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ExportSepaDirectDebitRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ExportSepaDirectDebitRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ExportSepaDirectDebitRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ExportSepaDirectDebitRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ExportSepaDirectDebitRequestValidationError) ErrorName() string {
+	return "ExportSepaDirectDebitRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ExportSepaDirectDebitRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sExportSepaDirectDebitRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ExportSepaDirectDebitRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ExportSepaDirectDebitRequestValidationError{}
+
+// Validate checks the field values on SepaExportIssue with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *SepaExportIssue) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SepaExportIssue with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SepaExportIssueMultiError, or nil if none found.
+func (m *SepaExportIssue) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SepaExportIssue) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for MemberId
+
+	// no validation rules for Field
+
+	// no validation rules for Description
+
+	if len(errors) > 0 {
+		return SepaExportIssueMultiError(errors)
+	}
+
+	return nil
+}
+
+// SepaExportIssueMultiError is an error wrapping multiple validation errors
+// returned by SepaExportIssue.ValidateAll() if the designated constraints
+// aren't met.
+type SepaExportIssueMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SepaExportIssueMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SepaExportIssueMultiError) AllErrors() []error { return m }
+
+// SepaExportIssueValidationError is the validation error returned by
+// SepaExportIssue.Validate if the designated constraints aren't met.
+type SepaExportIssueValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SepaExportIssueValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SepaExportIssueValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SepaExportIssueValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SepaExportIssueValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SepaExportIssueValidationError) ErrorName() string { return "SepaExportIssueValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SepaExportIssueValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSepaExportIssue.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SepaExportIssueValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SepaExportIssueValidationError{}
+
+// Validate checks the field values on SepaDirectDebitExport with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SepaDirectDebitExport) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SepaDirectDebitExport with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SepaDirectDebitExportMultiError, or nil if none found.
+func (m *SepaDirectDebitExport) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SepaDirectDebitExport) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Document
+
+	// no validation rules for Transactions
+
+	// no validation rules for TotalCents
+
+	for idx, item := range m.GetIssues() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SepaDirectDebitExportValidationError{
+						field:  fmt.Sprintf("Issues[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SepaDirectDebitExportValidationError{
+						field:  fmt.Sprintf("Issues[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SepaDirectDebitExportValidationError{
+					field:  fmt.Sprintf("Issues[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for ExportId
+
+	if len(errors) > 0 {
+		return SepaDirectDebitExportMultiError(errors)
+	}
+
+	return nil
+}
+
+// SepaDirectDebitExportMultiError is an error wrapping multiple validation
+// errors returned by SepaDirectDebitExport.ValidateAll() if the designated
+// constraints aren't met.
+type SepaDirectDebitExportMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SepaDirectDebitExportMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SepaDirectDebitExportMultiError) AllErrors() []error { return m }
+
+// SepaDirectDebitExportValidationError is the validation error returned by
+// SepaDirectDebitExport.Validate if the designated constraints aren't met.
+type SepaDirectDebitExportValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SepaDirectDebitExportValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SepaDirectDebitExportValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SepaDirectDebitExportValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SepaDirectDebitExportValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SepaDirectDebitExportValidationError) ErrorName() string {
+	return "SepaDirectDebitExportValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SepaDirectDebitExportValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSepaDirectDebitExport.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SepaDirectDebitExportValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SepaDirectDebitExportValidationError{}
+
+// Validate checks the field values on ListBalancesResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListBalancesResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListBalancesResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListBalancesResponseMultiError, or nil if none found.
+func (m *ListBalancesResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListBalancesResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetBalances() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListBalancesResponseValidationError{
+						field:  fmt.Sprintf("Balances[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListBalancesResponseValidationError{
+						field:  fmt.Sprintf("Balances[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListBalancesResponseValidationError{
+					field:  fmt.Sprintf("Balances[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for NextPageToken
+
+	if len(errors) > 0 {
+		return ListBalancesResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListBalancesResponseMultiError is an error wrapping multiple validation
+// errors returned by ListBalancesResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ListBalancesResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListBalancesResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListBalancesResponseMultiError) AllErrors() []error { return m }
+
+// ListBalancesResponseValidationError is the validation error returned by
+// ListBalancesResponse.Validate if the designated constraints aren't met.
+type ListBalancesResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListBalancesResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListBalancesResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListBalancesResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListBalancesResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListBalancesResponseValidationError) ErrorName() string {
+	return "ListBalancesResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListBalancesResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListBalancesResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListBalancesResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListBalancesResponseValidationError{}
+
+// Validate checks the field values on GetMyProfileRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetMyProfileRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetMyProfileRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetMyProfileRequestMultiError, or nil if none found.
+func (m *GetMyProfileRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetMyProfileRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return GetMyProfileRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetMyProfileRequestMultiError is an error wrapping multiple validation
+// errors returned by GetMyProfileRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetMyProfileRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetMyProfileRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetMyProfileRequestMultiError) AllErrors() []error { return m }
+
+// GetMyProfileRequestValidationError is the validation error returned by
+// GetMyProfileRequest.Validate if the designated constraints aren't met.
+type GetMyProfileRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetMyProfileRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetMyProfileRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetMyProfileRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetMyProfileRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetMyProfileRequestValidationError) ErrorName() string {
+	return "GetMyProfileRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetMyProfileRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetMyProfileRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetMyProfileRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetMyProfileRequestValidationError{}
+
+// Validate checks the field values on UpdateMyProfileRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateMyProfileRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateMyProfileRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateMyProfileRequestMultiError, or nil if none found.
+func (m *UpdateMyProfileRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateMyProfileRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetMember()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateMyProfileRequestValidationError{
+					field:  "Member",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateMyProfileRequestValidationError{
+					field:  "Member",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetMember()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateMyProfileRequestValidationError{
+				field:  "Member",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetFieldMask()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateMyProfileRequestValidationError{
+					field:  "FieldMask",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateMyProfileRequestValidationError{
+					field:  "FieldMask",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetFieldMask()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateMyProfileRequestValidationError{
+				field:  "FieldMask",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return UpdateMyProfileRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateMyProfileRequestMultiError is an error wrapping multiple validation
+// errors returned by UpdateMyProfileRequest.ValidateAll() if the designated
+// constraints aren't met.
+type UpdateMyProfileRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateMyProfileRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateMyProfileRequestMultiError) AllErrors() []error { return m }
+
+// UpdateMyProfileRequestValidationError is the validation error returned by
+// UpdateMyProfileRequest.Validate if the designated constraints aren't met.
+type UpdateMyProfileRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateMyProfileRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateMyProfileRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateMyProfileRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateMyProfileRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateMyProfileRequestValidationError) ErrorName() string {
+	return "UpdateMyProfileRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateMyProfileRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateMyProfileRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateMyProfileRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateMyProfileRequestValidationError{}
+
+// Validate checks the field values on ListMyPresencesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListMyPresencesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListMyPresencesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListMyPresencesRequestMultiError, or nil if none found.
+func (m *ListMyPresencesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListMyPresencesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for PageSize
+
+	// no validation rules for PageToken
+
+	if len(errors) > 0 {
+		return ListMyPresencesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListMyPresencesRequestMultiError is an error wrapping multiple validation
+// errors returned by ListMyPresencesRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListMyPresencesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListMyPresencesRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListMyPresencesRequestMultiError) AllErrors() []error { return m }
+
+// ListMyPresencesRequestValidationError is the validation error returned by
+// ListMyPresencesRequest.Validate if the designated constraints aren't met.
+type ListMyPresencesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListMyPresencesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListMyPresencesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListMyPresencesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListMyPresencesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListMyPresencesRequestValidationError) ErrorName() string {
+	return "ListMyPresencesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListMyPresencesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListMyPresencesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListMyPresencesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListMyPresencesRequestValidationError{}
+
+// Validate checks the field values on ListMyCardsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListMyCardsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListMyCardsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListMyCardsRequestMultiError, or nil if none found.
+func (m *ListMyCardsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListMyCardsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for PageSize
+
+	// no validation rules for PageToken
+
+	if len(errors) > 0 {
+		return ListMyCardsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListMyCardsRequestMultiError is an error wrapping multiple validation errors
+// returned by ListMyCardsRequest.ValidateAll() if the designated constraints
+// aren't met.
+type ListMyCardsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListMyCardsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListMyCardsRequestMultiError) AllErrors() []error { return m }
+
+// ListMyCardsRequestValidationError is the validation error returned by
+// ListMyCardsRequest.Validate if the designated constraints aren't met.
+type ListMyCardsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListMyCardsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListMyCardsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListMyCardsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListMyCardsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListMyCardsRequestValidationError) ErrorName() string {
+	return "ListMyCardsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListMyCardsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListMyCardsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListMyCardsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListMyCardsRequestValidationError{}
+
+// Validate checks the field values on ListMyBriefingsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListMyBriefingsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListMyBriefingsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListMyBriefingsRequestMultiError, or nil if none found.
+func (m *ListMyBriefingsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListMyBriefingsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for PageSize
+
+	// no validation rules for PageToken
+
+	// no validation rules for ValidOnly
+
+	if len(errors) > 0 {
+		return ListMyBriefingsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListMyBriefingsRequestMultiError is an error wrapping multiple validation
+// errors returned by ListMyBriefingsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListMyBriefingsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListMyBriefingsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListMyBriefingsRequestMultiError) AllErrors() []error { return m }
+
+// ListMyBriefingsRequestValidationError is the validation error returned by
+// ListMyBriefingsRequest.Validate if the designated constraints aren't met.
+type ListMyBriefingsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListMyBriefingsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListMyBriefingsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListMyBriefingsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListMyBriefingsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListMyBriefingsRequestValidationError) ErrorName() string {
+	return "ListMyBriefingsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListMyBriefingsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListMyBriefingsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListMyBriefingsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListMyBriefingsRequestValidationError{}
+
+// Validate checks the field values on CreateMyChangeRequestRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateMyChangeRequestRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateMyChangeRequestRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateMyChangeRequestRequestMultiError, or nil if none found.
+func (m *CreateMyChangeRequestRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateMyChangeRequestRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetMember()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateMyChangeRequestRequestValidationError{
+					field:  "Member",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateMyChangeRequestRequestValidationError{
+					field:  "Member",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetMember()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateMyChangeRequestRequestValidationError{
+				field:  "Member",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetFieldMask()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateMyChangeRequestRequestValidationError{
+					field:  "FieldMask",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateMyChangeRequestRequestValidationError{
+					field:  "FieldMask",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetFieldMask()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateMyChangeRequestRequestValidationError{
+				field:  "FieldMask",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CreateMyChangeRequestRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateMyChangeRequestRequestMultiError is an error wrapping multiple
+// validation errors returned by CreateMyChangeRequestRequest.ValidateAll() if
+// the designated constraints aren't met.
+type CreateMyChangeRequestRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateMyChangeRequestRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateMyChangeRequestRequestMultiError) AllErrors() []error { return m }
+
+// CreateMyChangeRequestRequestValidationError is the validation error returned
+// by CreateMyChangeRequestRequest.Validate if the designated constraints
+// aren't met.
+type CreateMyChangeRequestRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateMyChangeRequestRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateMyChangeRequestRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateMyChangeRequestRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateMyChangeRequestRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateMyChangeRequestRequestValidationError) ErrorName() string {
+	return "CreateMyChangeRequestRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateMyChangeRequestRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateMyChangeRequestRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateMyChangeRequestRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateMyChangeRequestRequestValidationError{}
+
+// Validate checks the field values on ListMyChangeRequestsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListMyChangeRequestsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListMyChangeRequestsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListMyChangeRequestsRequestMultiError, or nil if none found.
+func (m *ListMyChangeRequestsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListMyChangeRequestsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for PageSize
+
+	// no validation rules for PageToken
+
+	if len(errors) > 0 {
+		return ListMyChangeRequestsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListMyChangeRequestsRequestMultiError is an error wrapping multiple
+// validation errors returned by ListMyChangeRequestsRequest.ValidateAll() if
+// the designated constraints aren't met.
+type ListMyChangeRequestsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListMyChangeRequestsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListMyChangeRequestsRequestMultiError) AllErrors() []error { return m }
+
+// ListMyChangeRequestsRequestValidationError is the validation error returned
+// by ListMyChangeRequestsRequest.Validate if the designated constraints
+// aren't met.
+type ListMyChangeRequestsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListMyChangeRequestsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListMyChangeRequestsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListMyChangeRequestsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListMyChangeRequestsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListMyChangeRequestsRequestValidationError) ErrorName() string {
+	return "ListMyChangeRequestsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListMyChangeRequestsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListMyChangeRequestsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListMyChangeRequestsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListMyChangeRequestsRequestValidationError{}
+
+// Validate checks the field values on GetPresenceReportRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetPresenceReportRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetPresenceReportRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetPresenceReportRequestMultiError, or nil if none found.
+func (m *GetPresenceReportRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetPresenceReportRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetStartTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetPresenceReportRequestValidationError{
+					field:  "StartTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetPresenceReportRequestValidationError{
+					field:  "StartTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetStartTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetPresenceReportRequestValidationError{
+				field:  "StartTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetEndTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetPresenceReportRequestValidationError{
+					field:  "EndTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetPresenceReportRequestValidationError{
+					field:  "EndTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetEndTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetPresenceReportRequestValidationError{
+				field:  "EndTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Bucket
+
+	// no validation rules for TimeZone
+
+	if len(errors) > 0 {
+		return GetPresenceReportRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetPresenceReportRequestMultiError is an error wrapping multiple validation
+// errors returned by GetPresenceReportRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetPresenceReportRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetPresenceReportRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetPresenceReportRequestMultiError) AllErrors() []error { return m }
+
+// GetPresenceReportRequestValidationError is the validation error returned by
+// GetPresenceReportRequest.Validate if the designated constraints aren't met.
+type GetPresenceReportRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetPresenceReportRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetPresenceReportRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetPresenceReportRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetPresenceReportRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetPresenceReportRequestValidationError) ErrorName() string {
+	return "GetPresenceReportRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetPresenceReportRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetPresenceReportRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetPresenceReportRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetPresenceReportRequestValidationError{}
+
+// Validate checks the field values on PresenceReport with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *PresenceReport) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PresenceReport with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in PresenceReportMultiError,
+// or nil if none found.
+func (m *PresenceReport) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PresenceReport) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetStartTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PresenceReportValidationError{
+					field:  "StartTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PresenceReportValidationError{
+					field:  "StartTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetStartTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PresenceReportValidationError{
+				field:  "StartTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetEndTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PresenceReportValidationError{
+					field:  "EndTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PresenceReportValidationError{
+					field:  "EndTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetEndTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PresenceReportValidationError{
+				field:  "EndTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Bucket
+
+	for idx, item := range m.GetBuckets() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, PresenceReportValidationError{
+						field:  fmt.Sprintf("Buckets[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, PresenceReportValidationError{
+						field:  fmt.Sprintf("Buckets[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return PresenceReportValidationError{
+					field:  fmt.Sprintf("Buckets[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if all {
+		switch v := interface{}(m.GetTotal()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PresenceReportValidationError{
+					field:  "Total",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PresenceReportValidationError{
+					field:  "Total",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTotal()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PresenceReportValidationError{
+				field:  "Total",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return PresenceReportMultiError(errors)
+	}
+
+	return nil
+}
+
+// PresenceReportMultiError is an error wrapping multiple validation errors
+// returned by PresenceReport.ValidateAll() if the designated constraints
+// aren't met.
+type PresenceReportMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PresenceReportMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PresenceReportMultiError) AllErrors() []error { return m }
+
+// PresenceReportValidationError is the validation error returned by
+// PresenceReport.Validate if the designated constraints aren't met.
+type PresenceReportValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PresenceReportValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PresenceReportValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PresenceReportValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PresenceReportValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PresenceReportValidationError) ErrorName() string { return "PresenceReportValidationError" }
+
+// Error satisfies the builtin error interface
+func (e PresenceReportValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPresenceReport.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PresenceReportValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PresenceReportValidationError{}
+
+// Validate checks the field values on PresenceStatistics with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *PresenceStatistics) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PresenceStatistics with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PresenceStatisticsMultiError, or nil if none found.
+func (m *PresenceStatistics) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PresenceStatistics) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetStartTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PresenceStatisticsValidationError{
+					field:  "StartTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PresenceStatisticsValidationError{
+					field:  "StartTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetStartTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PresenceStatisticsValidationError{
+				field:  "StartTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetEndTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PresenceStatisticsValidationError{
+					field:  "EndTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PresenceStatisticsValidationError{
+					field:  "EndTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetEndTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PresenceStatisticsValidationError{
+				field:  "EndTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for UniqueVisitors
+
+	// no validation rules for Visits
+
+	// no validation rules for TotalHours
+
+	// no validation rules for PeakOccupancy
+
+	for idx, item := range m.GetAgeCategories() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, PresenceStatisticsValidationError{
+						field:  fmt.Sprintf("AgeCategories[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, PresenceStatisticsValidationError{
+						field:  fmt.Sprintf("AgeCategories[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return PresenceStatisticsValidationError{
+					field:  fmt.Sprintf("AgeCategories[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetTags() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, PresenceStatisticsValidationError{
+						field:  fmt.Sprintf("Tags[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, PresenceStatisticsValidationError{
+						field:  fmt.Sprintf("Tags[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return PresenceStatisticsValidationError{
+					field:  fmt.Sprintf("Tags[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return PresenceStatisticsMultiError(errors)
+	}
+
+	return nil
+}
+
+// PresenceStatisticsMultiError is an error wrapping multiple validation errors
+// returned by PresenceStatistics.ValidateAll() if the designated constraints
+// aren't met.
+type PresenceStatisticsMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PresenceStatisticsMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PresenceStatisticsMultiError) AllErrors() []error { return m }
+
+// PresenceStatisticsValidationError is the validation error returned by
+// PresenceStatistics.Validate if the designated constraints aren't met.
+type PresenceStatisticsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PresenceStatisticsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PresenceStatisticsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PresenceStatisticsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PresenceStatisticsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PresenceStatisticsValidationError) ErrorName() string {
+	return "PresenceStatisticsValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PresenceStatisticsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPresenceStatistics.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PresenceStatisticsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PresenceStatisticsValidationError{}
+
+// Validate checks the field values on AgeCategoryStatistics with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AgeCategoryStatistics) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AgeCategoryStatistics with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AgeCategoryStatisticsMultiError, or nil if none found.
+func (m *AgeCategoryStatistics) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AgeCategoryStatistics) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for AgeCategory
+
+	// no validation rules for UniqueVisitors
+
+	// no validation rules for Visits
+
+	// no validation rules for TotalHours
+
+	if len(errors) > 0 {
+		return AgeCategoryStatisticsMultiError(errors)
+	}
+
+	return nil
+}
+
+// AgeCategoryStatisticsMultiError is an error wrapping multiple validation
+// errors returned by AgeCategoryStatistics.ValidateAll() if the designated
+// constraints aren't met.
+type AgeCategoryStatisticsMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AgeCategoryStatisticsMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AgeCategoryStatisticsMultiError) AllErrors() []error { return m }
+
+// AgeCategoryStatisticsValidationError is the validation error returned by
+// AgeCategoryStatistics.Validate if the designated constraints aren't met.
+type AgeCategoryStatisticsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AgeCategoryStatisticsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AgeCategoryStatisticsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AgeCategoryStatisticsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AgeCategoryStatisticsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AgeCategoryStatisticsValidationError) ErrorName() string {
+	return "AgeCategoryStatisticsValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AgeCategoryStatisticsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAgeCategoryStatistics.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AgeCategoryStatisticsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AgeCategoryStatisticsValidationError{}
+
+// Validate checks the field values on TagStatistics with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *TagStatistics) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TagStatistics with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in TagStatisticsMultiError, or
+// nil if none found.
+func (m *TagStatistics) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TagStatistics) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Tag
+
+	// no validation rules for UniqueVisitors
+
+	// no validation rules for Visits
+
+	// no validation rules for TotalHours
+
+	if len(errors) > 0 {
+		return TagStatisticsMultiError(errors)
+	}
+
+	return nil
+}
+
+// TagStatisticsMultiError is an error wrapping multiple validation errors
+// returned by TagStatistics.ValidateAll() if the designated constraints
+// aren't met.
+type TagStatisticsMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TagStatisticsMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TagStatisticsMultiError) AllErrors() []error { return m }
+
+// TagStatisticsValidationError is the validation error returned by
+// TagStatistics.Validate if the designated constraints aren't met.
+type TagStatisticsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TagStatisticsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TagStatisticsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TagStatisticsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TagStatisticsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TagStatisticsValidationError) ErrorName() string { return "TagStatisticsValidationError" }
+
+// Error satisfies the builtin error interface
+func (e TagStatisticsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTagStatistics.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TagStatisticsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TagStatisticsValidationError{}
+
+// Validate checks the field values on GetMachineUsageReportRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetMachineUsageReportRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetMachineUsageReportRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetMachineUsageReportRequestMultiError, or nil if none found.
+func (m *GetMachineUsageReportRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetMachineUsageReportRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetStartTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetMachineUsageReportRequestValidationError{
+					field:  "StartTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetMachineUsageReportRequestValidationError{
+					field:  "StartTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetStartTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetMachineUsageReportRequestValidationError{
+				field:  "StartTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetEndTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetMachineUsageReportRequestValidationError{
+					field:  "EndTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetMachineUsageReportRequestValidationError{
+					field:  "EndTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetEndTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetMachineUsageReportRequestValidationError{
+				field:  "EndTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for GroupBy
+
+	if len(errors) > 0 {
+		return GetMachineUsageReportRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetMachineUsageReportRequestMultiError is an error wrapping multiple
+// validation errors returned by GetMachineUsageReportRequest.ValidateAll() if
+// the designated constraints aren't met.
+type GetMachineUsageReportRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetMachineUsageReportRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetMachineUsageReportRequestMultiError) AllErrors() []error { return m }
+
+// GetMachineUsageReportRequestValidationError is the validation error returned
+// by GetMachineUsageReportRequest.Validate if the designated constraints
+// aren't met.
+type GetMachineUsageReportRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetMachineUsageReportRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetMachineUsageReportRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetMachineUsageReportRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetMachineUsageReportRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetMachineUsageReportRequestValidationError) ErrorName() string {
+	return "GetMachineUsageReportRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetMachineUsageReportRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetMachineUsageReportRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetMachineUsageReportRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetMachineUsageReportRequestValidationError{}
+
+// Validate checks the field values on MachineUsageReport with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *MachineUsageReport) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MachineUsageReport with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// MachineUsageReportMultiError, or nil if none found.
+func (m *MachineUsageReport) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MachineUsageReport) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetStartTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, MachineUsageReportValidationError{
+					field:  "StartTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, MachineUsageReportValidationError{
+					field:  "StartTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetStartTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return MachineUsageReportValidationError{
+				field:  "StartTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetEndTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, MachineUsageReportValidationError{
+					field:  "EndTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, MachineUsageReportValidationError{
+					field:  "EndTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetEndTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return MachineUsageReportValidationError{
+				field:  "EndTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for GroupBy
+
+	for idx, item := range m.GetEntries() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, MachineUsageReportValidationError{
+						field:  fmt.Sprintf("Entries[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, MachineUsageReportValidationError{
+						field:  fmt.Sprintf("Entries[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return MachineUsageReportValidationError{
+					field:  fmt.Sprintf("Entries[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if all {
+		switch v := interface{}(m.GetTotal()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, MachineUsageReportValidationError{
+					field:  "Total",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, MachineUsageReportValidationError{
+					field:  "Total",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTotal()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return MachineUsageReportValidationError{
+				field:  "Total",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return MachineUsageReportMultiError(errors)
+	}
+
+	return nil
+}
+
+// MachineUsageReportMultiError is an error wrapping multiple validation errors
+// returned by MachineUsageReport.ValidateAll() if the designated constraints
+// aren't met.
+type MachineUsageReportMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MachineUsageReportMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MachineUsageReportMultiError) AllErrors() []error { return m }
+
+// MachineUsageReportValidationError is the validation error returned by
+// MachineUsageReport.Validate if the designated constraints aren't met.
+type MachineUsageReportValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MachineUsageReportValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MachineUsageReportValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MachineUsageReportValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MachineUsageReportValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MachineUsageReportValidationError) ErrorName() string {
+	return "MachineUsageReportValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e MachineUsageReportValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMachineUsageReport.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MachineUsageReportValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MachineUsageReportValidationError{}
+
+// Validate checks the field values on UsageStatistics with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *UsageStatistics) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UsageStatistics with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UsageStatisticsMultiError, or nil if none found.
+func (m *UsageStatistics) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UsageStatistics) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for MemberId
+
+	// no validation rules for MachineId
+
+	// no validation rules for Sessions
+
+	// no validation rules for TotalMinutes
+
+	// no validation rules for TotalPriceCents
+
+	if len(errors) > 0 {
+		return UsageStatisticsMultiError(errors)
+	}
+
+	return nil
+}
+
+// UsageStatisticsMultiError is an error wrapping multiple validation errors
+// returned by UsageStatistics.ValidateAll() if the designated constraints
+// aren't met.
+type UsageStatisticsMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UsageStatisticsMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UsageStatisticsMultiError) AllErrors() []error { return m }
+
+// UsageStatisticsValidationError is the validation error returned by
+// UsageStatistics.Validate if the designated constraints aren't met.
+type UsageStatisticsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UsageStatisticsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UsageStatisticsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UsageStatisticsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UsageStatisticsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UsageStatisticsValidationError) ErrorName() string { return "UsageStatisticsValidationError" }
+
+// Error satisfies the builtin error interface
+func (e UsageStatisticsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUsageStatistics.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UsageStatisticsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UsageStatisticsValidationError{}
+
+// Validate checks the field values on Application with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Application) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Application with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ApplicationMultiError, or
+// nil if none found.
+func (m *Application) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Application) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Name
+
+	// no validation rules for Email
+
+	// no validation rules for Phone
+
+	// no validation rules for Message
+
+	// no validation rules for State
+
+	if all {
+		switch v := interface{}(m.GetCreateTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ApplicationValidationError{
+					field:  "CreateTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ApplicationValidationError{
+					field:  "CreateTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreateTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ApplicationValidationError{
+				field:  "CreateTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetDecideTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ApplicationValidationError{
+					field:  "DecideTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ApplicationValidationError{
+					field:  "DecideTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDecideTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ApplicationValidationError{
+				field:  "DecideTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for DecidedBy
+
+	// no validation rules for Comment
+
+	if m.BirthDate != nil {
+		// no validation rules for BirthDate
+	}
+
+	if m.MemberId != nil {
+		// no validation rules for MemberId
+	}
+
+	if len(errors) > 0 {
+		return ApplicationMultiError(errors)
+	}
+
+	return nil
+}
+
+// ApplicationMultiError is an error wrapping multiple validation errors
+// returned by Application.ValidateAll() if the designated constraints aren't met.
+type ApplicationMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ApplicationMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ApplicationMultiError) AllErrors() []error { return m }
+
+// ApplicationValidationError is the validation error returned by
+// Application.Validate if the designated constraints aren't met.
+type ApplicationValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ApplicationValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ApplicationValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ApplicationValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ApplicationValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ApplicationValidationError) ErrorName() string { return "ApplicationValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ApplicationValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sApplication.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ApplicationValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ApplicationValidationError{}
+
+// Validate checks the field values on SubmitApplicationRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SubmitApplicationRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SubmitApplicationRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SubmitApplicationRequestMultiError, or nil if none found.
+func (m *SubmitApplicationRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SubmitApplicationRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Name
+
+	// no validation rules for Email
+
+	// no validation rules for Phone
+
+	// no validation rules for Message
+
+	// no validation rules for Website
+
+	if m.BirthDate != nil {
+		// no validation rules for BirthDate
+	}
+
+	if len(errors) > 0 {
+		return SubmitApplicationRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SubmitApplicationRequestMultiError is an error wrapping multiple validation
+// errors returned by SubmitApplicationRequest.ValidateAll() if the designated
+// constraints aren't met.
+type SubmitApplicationRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SubmitApplicationRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SubmitApplicationRequestMultiError) AllErrors() []error { return m }
+
+// SubmitApplicationRequestValidationError is the validation error returned by
+// SubmitApplicationRequest.Validate if the designated constraints aren't met.
+type SubmitApplicationRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SubmitApplicationRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SubmitApplicationRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SubmitApplicationRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SubmitApplicationRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SubmitApplicationRequestValidationError) ErrorName() string {
+	return "SubmitApplicationRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SubmitApplicationRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSubmitApplicationRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SubmitApplicationRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SubmitApplicationRequestValidationError{}
+
+// Validate checks the field values on ListApplicationsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListApplicationsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListApplicationsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListApplicationsRequestMultiError, or nil if none found.
+func (m *ListApplicationsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListApplicationsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for PageSize
+
+	// no validation rules for PageToken
+
+	// no validation rules for State
+
+	if len(errors) > 0 {
+		return ListApplicationsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListApplicationsRequestMultiError is an error wrapping multiple validation
+// errors returned by ListApplicationsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListApplicationsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListApplicationsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListApplicationsRequestMultiError) AllErrors() []error { return m }
+
+// ListApplicationsRequestValidationError is the validation error returned by
+// ListApplicationsRequest.Validate if the designated constraints aren't met.
+type ListApplicationsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListApplicationsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListApplicationsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListApplicationsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListApplicationsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListApplicationsRequestValidationError) ErrorName() string {
+	return "ListApplicationsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListApplicationsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListApplicationsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListApplicationsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListApplicationsRequestValidationError{}
+
+// Validate checks the field values on ListApplicationsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListApplicationsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListApplicationsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListApplicationsResponseMultiError, or nil if none found.
+func (m *ListApplicationsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListApplicationsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetApplications() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListApplicationsResponseValidationError{
+						field:  fmt.Sprintf("Applications[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListApplicationsResponseValidationError{
+						field:  fmt.Sprintf("Applications[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListApplicationsResponseValidationError{
+					field:  fmt.Sprintf("Applications[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for NextPageToken
+
+	if len(errors) > 0 {
+		return ListApplicationsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListApplicationsResponseMultiError is an error wrapping multiple validation
+// errors returned by ListApplicationsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ListApplicationsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListApplicationsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListApplicationsResponseMultiError) AllErrors() []error { return m }
+
+// ListApplicationsResponseValidationError is the validation error returned by
+// ListApplicationsResponse.Validate if the designated constraints aren't met.
+type ListApplicationsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListApplicationsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListApplicationsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListApplicationsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListApplicationsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListApplicationsResponseValidationError) ErrorName() string {
+	return "ListApplicationsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListApplicationsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListApplicationsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListApplicationsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListApplicationsResponseValidationError{}
+
+// Validate checks the field values on ApplicationPageToken with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ApplicationPageToken) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ApplicationPageToken with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ApplicationPageTokenMultiError, or nil if none found.
+func (m *ApplicationPageToken) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ApplicationPageToken) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Offset
+
+	if len(errors) > 0 {
+		return ApplicationPageTokenMultiError(errors)
+	}
+
+	return nil
+}
+
+// ApplicationPageTokenMultiError is an error wrapping multiple validation
+// errors returned by ApplicationPageToken.ValidateAll() if the designated
+// constraints aren't met.
+type ApplicationPageTokenMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ApplicationPageTokenMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ApplicationPageTokenMultiError) AllErrors() []error { return m }
+
+// ApplicationPageTokenValidationError is the validation error returned by
+// ApplicationPageToken.Validate if the designated constraints aren't met.
+type ApplicationPageTokenValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ApplicationPageTokenValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ApplicationPageTokenValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ApplicationPageTokenValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ApplicationPageTokenValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ApplicationPageTokenValidationError) ErrorName() string {
+	return "ApplicationPageTokenValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ApplicationPageTokenValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sApplicationPageToken.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ApplicationPageTokenValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ApplicationPageTokenValidationError{}
+
+// Validate checks the field values on AcceptApplicationRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AcceptApplicationRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AcceptApplicationRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AcceptApplicationRequestMultiError, or nil if none found.
+func (m *AcceptApplicationRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AcceptApplicationRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if all {
+		switch v := interface{}(m.GetMembershipStart()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AcceptApplicationRequestValidationError{
+					field:  "MembershipStart",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AcceptApplicationRequestValidationError{
+					field:  "MembershipStart",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetMembershipStart()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AcceptApplicationRequestValidationError{
+				field:  "MembershipStart",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for AgeCategory
+
+	// no validation rules for AdditionalAttributes
+
+	for idx, item := range m.GetRelationships() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AcceptApplicationRequestValidationError{
+						field:  fmt.Sprintf("Relationships[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AcceptApplicationRequestValidationError{
+						field:  fmt.Sprintf("Relationships[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AcceptApplicationRequestValidationError{
+					field:  fmt.Sprintf("Relationships[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Comment
+
+	if len(errors) > 0 {
+		return AcceptApplicationRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AcceptApplicationRequestMultiError is an error wrapping multiple validation
+// errors returned by AcceptApplicationRequest.ValidateAll() if the designated
+// constraints aren't met.
+type AcceptApplicationRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AcceptApplicationRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AcceptApplicationRequestMultiError) AllErrors() []error { return m }
+
+// AcceptApplicationRequestValidationError is the validation error returned by
+// AcceptApplicationRequest.Validate if the designated constraints aren't met.
+type AcceptApplicationRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AcceptApplicationRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AcceptApplicationRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AcceptApplicationRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AcceptApplicationRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AcceptApplicationRequestValidationError) ErrorName() string {
+	return "AcceptApplicationRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AcceptApplicationRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAcceptApplicationRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AcceptApplicationRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AcceptApplicationRequestValidationError{}
+
+// Validate checks the field values on RejectApplicationRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RejectApplicationRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RejectApplicationRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RejectApplicationRequestMultiError, or nil if none found.
+func (m *RejectApplicationRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RejectApplicationRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Comment
+
+	if len(errors) > 0 {
+		return RejectApplicationRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// RejectApplicationRequestMultiError is an error wrapping multiple validation
+// errors returned by RejectApplicationRequest.ValidateAll() if the designated
+// constraints aren't met.
+type RejectApplicationRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RejectApplicationRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RejectApplicationRequestMultiError) AllErrors() []error { return m }
+
+// RejectApplicationRequestValidationError is the validation error returned by
+// RejectApplicationRequest.Validate if the designated constraints aren't met.
+type RejectApplicationRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RejectApplicationRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RejectApplicationRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RejectApplicationRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RejectApplicationRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RejectApplicationRequestValidationError) ErrorName() string {
+	return "RejectApplicationRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RejectApplicationRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRejectApplicationRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RejectApplicationRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RejectApplicationRequestValidationError{}
+
+// Validate checks the field values on MemberNote with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *MemberNote) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MemberNote with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in MemberNoteMultiError, or
+// nil if none found.
+func (m *MemberNote) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MemberNote) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for MemberId
+
+	// no validation rules for Text
+
+	// no validation rules for Author
+
+	// no validation rules for AuthorName
+
+	if all {
+		switch v := interface{}(m.GetCreateTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, MemberNoteValidationError{
+					field:  "CreateTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, MemberNoteValidationError{
+					field:  "CreateTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreateTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return MemberNoteValidationError{
+				field:  "CreateTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetUpdateTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, MemberNoteValidationError{
+					field:  "UpdateTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, MemberNoteValidationError{
+					field:  "UpdateTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUpdateTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return MemberNoteValidationError{
+				field:  "UpdateTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Pinned
+
+	// no validation rules for Visibility
+
+	if len(errors) > 0 {
+		return MemberNoteMultiError(errors)
+	}
+
+	return nil
+}
+
+// MemberNoteMultiError is an error wrapping multiple validation errors
+// returned by MemberNote.ValidateAll() if the designated constraints aren't met.
+type MemberNoteMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MemberNoteMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MemberNoteMultiError) AllErrors() []error { return m }
+
+// MemberNoteValidationError is the validation error returned by
+// MemberNote.Validate if the designated constraints aren't met.
+type MemberNoteValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MemberNoteValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MemberNoteValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MemberNoteValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MemberNoteValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MemberNoteValidationError) ErrorName() string { return "MemberNoteValidationError" }
+
+// Error satisfies the builtin error interface
+func (e MemberNoteValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMemberNote.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MemberNoteValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MemberNoteValidationError{}
+
+// Validate checks the field values on CreateMemberNoteRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateMemberNoteRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateMemberNoteRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateMemberNoteRequestMultiError, or nil if none found.
+func (m *CreateMemberNoteRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateMemberNoteRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetNote()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateMemberNoteRequestValidationError{
+					field:  "Note",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateMemberNoteRequestValidationError{
+					field:  "Note",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetNote()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateMemberNoteRequestValidationError{
+				field:  "Note",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CreateMemberNoteRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateMemberNoteRequestMultiError is an error wrapping multiple validation
+// errors returned by CreateMemberNoteRequest.ValidateAll() if the designated
+// constraints aren't met.
+type CreateMemberNoteRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateMemberNoteRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateMemberNoteRequestMultiError) AllErrors() []error { return m }
+
+// CreateMemberNoteRequestValidationError is the validation error returned by
+// CreateMemberNoteRequest.Validate if the designated constraints aren't met.
+type CreateMemberNoteRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateMemberNoteRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateMemberNoteRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateMemberNoteRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateMemberNoteRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateMemberNoteRequestValidationError) ErrorName() string {
+	return "CreateMemberNoteRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateMemberNoteRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateMemberNoteRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateMemberNoteRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateMemberNoteRequestValidationError{}
+
+// Validate checks the field values on ListMemberNotesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListMemberNotesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListMemberNotesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListMemberNotesRequestMultiError, or nil if none found.
+func (m *ListMemberNotesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListMemberNotesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for MemberId
+
+	// no validation rules for PageSize
+
+	// no validation rules for PageToken
+
+	// no validation rules for PinnedOnly
+
+	if len(errors) > 0 {
+		return ListMemberNotesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListMemberNotesRequestMultiError is an error wrapping multiple validation
+// errors returned by ListMemberNotesRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListMemberNotesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListMemberNotesRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListMemberNotesRequestMultiError) AllErrors() []error { return m }
+
+// ListMemberNotesRequestValidationError is the validation error returned by
+// ListMemberNotesRequest.Validate if the designated constraints aren't met.
+type ListMemberNotesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListMemberNotesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListMemberNotesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListMemberNotesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListMemberNotesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListMemberNotesRequestValidationError) ErrorName() string {
+	return "ListMemberNotesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListMemberNotesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListMemberNotesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListMemberNotesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListMemberNotesRequestValidationError{}
+
+// Validate checks the field values on ListMemberNotesResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListMemberNotesResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListMemberNotesResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListMemberNotesResponseMultiError, or nil if none found.
+func (m *ListMemberNotesResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListMemberNotesResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetNotes() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListMemberNotesResponseValidationError{
+						field:  fmt.Sprintf("Notes[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListMemberNotesResponseValidationError{
+						field:  fmt.Sprintf("Notes[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListMemberNotesResponseValidationError{
+					field:  fmt.Sprintf("Notes[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for NextPageToken
+
+	if len(errors) > 0 {
+		return ListMemberNotesResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListMemberNotesResponseMultiError is an error wrapping multiple validation
+// errors returned by ListMemberNotesResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ListMemberNotesResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListMemberNotesResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListMemberNotesResponseMultiError) AllErrors() []error { return m }
+
+// ListMemberNotesResponseValidationError is the validation error returned by
+// ListMemberNotesResponse.Validate if the designated constraints aren't met.
+type ListMemberNotesResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListMemberNotesResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListMemberNotesResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListMemberNotesResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListMemberNotesResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListMemberNotesResponseValidationError) ErrorName() string {
+	return "ListMemberNotesResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListMemberNotesResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListMemberNotesResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListMemberNotesResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListMemberNotesResponseValidationError{}
+
+// Validate checks the field values on MemberNotePageToken with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *MemberNotePageToken) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MemberNotePageToken with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// MemberNotePageTokenMultiError, or nil if none found.
+func (m *MemberNotePageToken) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MemberNotePageToken) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Offset
+
+	if len(errors) > 0 {
+		return MemberNotePageTokenMultiError(errors)
 	}
 
 	return nil
 }
 
-// ListPresencesRequestMultiError is an error wrapping multiple validation
-// errors returned by ListPresencesRequest.ValidateAll() if the designated
+// MemberNotePageTokenMultiError is an error wrapping multiple validation
+// errors returned by MemberNotePageToken.ValidateAll() if the designated
 // constraints aren't met.
-type ListPresencesRequestMultiError []error
+type MemberNotePageTokenMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ListPresencesRequestMultiError) Error() string {
+func (m MemberNotePageTokenMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -5740,11 +26795,11 @@ func (m ListPresencesRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ListPresencesRequestMultiError) AllErrors() []error { return m }
+func (m MemberNotePageTokenMultiError) AllErrors() []error { return m }
 
-// ListPresencesRequestValidationError is the validation error returned by
-// ListPresencesRequest.Validate if the designated constraints aren't met.
-type ListPresencesRequestValidationError struct {
+// MemberNotePageTokenValidationError is the validation error returned by
+// MemberNotePageToken.Validate if the designated constraints aren't met.
+type MemberNotePageTokenValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -5752,24 +26807,24 @@ type ListPresencesRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListPresencesRequestValidationError) Field() string { return e.field }
+func (e MemberNotePageTokenValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListPresencesRequestValidationError) Reason() string { return e.reason }
+func (e MemberNotePageTokenValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListPresencesRequestValidationError) Cause() error { return e.cause }
+func (e MemberNotePageTokenValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListPresencesRequestValidationError) Key() bool { return e.key }
+func (e MemberNotePageTokenValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListPresencesRequestValidationError) ErrorName() string {
-	return "ListPresencesRequestValidationError"
+func (e MemberNotePageTokenValidationError) ErrorName() string {
+	return "MemberNotePageTokenValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ListPresencesRequestValidationError) Error() string {
+func (e MemberNotePageTokenValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -5781,14 +26836,14 @@ func (e ListPresencesRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListPresencesRequest.%s: %s%s",
+		"invalid %sMemberNotePageToken.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListPresencesRequestValidationError{}
+var _ error = MemberNotePageTokenValidationError{}
 
 var _ interface {
 	Field() string
@@ -5796,80 +26851,102 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListPresencesRequestValidationError{}
+} = MemberNotePageTokenValidationError{}
 
-// Validate checks the field values on ListPresencesResponse with the rules
+// Validate checks the field values on UpdateMemberNoteRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ListPresencesResponse) Validate() error {
+func (m *UpdateMemberNoteRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ListPresencesResponse with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on UpdateMemberNoteRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ListPresencesResponseMultiError, or nil if none found.
-func (m *ListPresencesResponse) ValidateAll() error {
+// UpdateMemberNoteRequestMultiError, or nil if none found.
+func (m *UpdateMemberNoteRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ListPresencesResponse) validate(all bool) error {
+func (m *UpdateMemberNoteRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetPresence() {
-		_, _ = idx, item
-
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, ListPresencesResponseValidationError{
-						field:  fmt.Sprintf("Presence[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, ListPresencesResponseValidationError{
-						field:  fmt.Sprintf("Presence[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
+	if all {
+		switch v := interface{}(m.GetNote()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateMemberNoteRequestValidationError{
+					field:  "Note",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
 			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				return ListPresencesResponseValidationError{
-					field:  fmt.Sprintf("Presence[%v]", idx),
+				errors = append(errors, UpdateMemberNoteRequestValidationError{
+					field:  "Note",
 					reason: "embedded message failed validation",
 					cause:  err,
-				}
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetNote()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateMemberNoteRequestValidationError{
+				field:  "Note",
+				reason: "embedded message failed validation",
+				cause:  err,
 			}
 		}
-
 	}
 
-	// no validation rules for NextPageToken
+	if all {
+		switch v := interface{}(m.GetFieldMask()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateMemberNoteRequestValidationError{
+					field:  "FieldMask",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateMemberNoteRequestValidationError{
+					field:  "FieldMask",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetFieldMask()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateMemberNoteRequestValidationError{
+				field:  "FieldMask",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
 
 	if len(errors) > 0 {
-		return ListPresencesResponseMultiError(errors)
+		return UpdateMemberNoteRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// ListPresencesResponseMultiError is an error wrapping multiple validation
-// errors returned by ListPresencesResponse.ValidateAll() if the designated
+// UpdateMemberNoteRequestMultiError is an error wrapping multiple validation
+// errors returned by UpdateMemberNoteRequest.ValidateAll() if the designated
 // constraints aren't met.
-type ListPresencesResponseMultiError []error
+type UpdateMemberNoteRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ListPresencesResponseMultiError) Error() string {
+func (m UpdateMemberNoteRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -5878,11 +26955,11 @@ func (m ListPresencesResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ListPresencesResponseMultiError) AllErrors() []error { return m }
+func (m UpdateMemberNoteRequestMultiError) AllErrors() []error { return m }
 
-// ListPresencesResponseValidationError is the validation error returned by
-// ListPresencesResponse.Validate if the designated constraints aren't met.
-type ListPresencesResponseValidationError struct {
+// UpdateMemberNoteRequestValidationError is the validation error returned by
+// UpdateMemberNoteRequest.Validate if the designated constraints aren't met.
+type UpdateMemberNoteRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -5890,24 +26967,24 @@ type ListPresencesResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListPresencesResponseValidationError) Field() string { return e.field }
+func (e UpdateMemberNoteRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListPresencesResponseValidationError) Reason() string { return e.reason }
+func (e UpdateMemberNoteRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListPresencesResponseValidationError) Cause() error { return e.cause }
+func (e UpdateMemberNoteRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListPresencesResponseValidationError) Key() bool { return e.key }
+func (e UpdateMemberNoteRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListPresencesResponseValidationError) ErrorName() string {
-	return "ListPresencesResponseValidationError"
+func (e UpdateMemberNoteRequestValidationError) ErrorName() string {
+	return "UpdateMemberNoteRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ListPresencesResponseValidationError) Error() string {
+func (e UpdateMemberNoteRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -5919,14 +26996,14 @@ func (e ListPresencesResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListPresencesResponse.%s: %s%s",
+		"invalid %sUpdateMemberNoteRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListPresencesResponseValidationError{}
+var _ error = UpdateMemberNoteRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -5934,52 +27011,48 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListPresencesResponseValidationError{}
+} = UpdateMemberNoteRequestValidationError{}
 
-// Validate checks the field values on PresencePageToken with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *PresencePageToken) Validate() error {
+// Validate checks the field values on DeleteMemberNoteRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteMemberNoteRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on PresencePageToken with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on DeleteMemberNoteRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// PresencePageTokenMultiError, or nil if none found.
-func (m *PresencePageToken) ValidateAll() error {
+// DeleteMemberNoteRequestMultiError, or nil if none found.
+func (m *DeleteMemberNoteRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *PresencePageToken) validate(all bool) error {
+func (m *DeleteMemberNoteRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Field
-
-	// no validation rules for LastValue
-
-	// no validation rules for Direction
+	// no validation rules for MemberId
 
-	// no validation rules for LastId
+	// no validation rules for Id
 
 	if len(errors) > 0 {
-		return PresencePageTokenMultiError(errors)
+		return DeleteMemberNoteRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// PresencePageTokenMultiError is an error wrapping multiple validation errors
-// returned by PresencePageToken.ValidateAll() if the designated constraints
-// aren't met.
-type PresencePageTokenMultiError []error
+// DeleteMemberNoteRequestMultiError is an error wrapping multiple validation
+// errors returned by DeleteMemberNoteRequest.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteMemberNoteRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m PresencePageTokenMultiError) Error() string {
+func (m DeleteMemberNoteRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -5988,11 +27061,11 @@ func (m PresencePageTokenMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m PresencePageTokenMultiError) AllErrors() []error { return m }
+func (m DeleteMemberNoteRequestMultiError) AllErrors() []error { return m }
 
-// PresencePageTokenValidationError is the validation error returned by
-// PresencePageToken.Validate if the designated constraints aren't met.
-type PresencePageTokenValidationError struct {
+// DeleteMemberNoteRequestValidationError is the validation error returned by
+// DeleteMemberNoteRequest.Validate if the designated constraints aren't met.
+type DeleteMemberNoteRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -6000,24 +27073,24 @@ type PresencePageTokenValidationError struct {
 }
 
 // Field function returns field value.
-func (e PresencePageTokenValidationError) Field() string { return e.field }
+func (e DeleteMemberNoteRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e PresencePageTokenValidationError) Reason() string { return e.reason }
+func (e DeleteMemberNoteRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e PresencePageTokenValidationError) Cause() error { return e.cause }
+func (e DeleteMemberNoteRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e PresencePageTokenValidationError) Key() bool { return e.key }
+func (e DeleteMemberNoteRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e PresencePageTokenValidationError) ErrorName() string {
-	return "PresencePageTokenValidationError"
+func (e DeleteMemberNoteRequestValidationError) ErrorName() string {
+	return "DeleteMemberNoteRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e PresencePageTokenValidationError) Error() string {
+func (e DeleteMemberNoteRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -6029,14 +27102,14 @@ func (e PresencePageTokenValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sPresencePageToken.%s: %s%s",
+		"invalid %sDeleteMemberNoteRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = PresencePageTokenValidationError{}
+var _ error = DeleteMemberNoteRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -6044,46 +27117,201 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = PresencePageTokenValidationError{}
+} = DeleteMemberNoteRequestValidationError{}
 
-// Validate checks the field values on CheckinRequest with the rules defined in
-// the proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *CheckinRequest) Validate() error {
+// Validate checks the field values on MemberTimelineEntry with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *MemberTimelineEntry) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CheckinRequest with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in CheckinRequestMultiError,
-// or nil if none found.
-func (m *CheckinRequest) ValidateAll() error {
-	return m.validate(true)
-}
+// ValidateAll checks the field values on MemberTimelineEntry with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// MemberTimelineEntryMultiError, or nil if none found.
+func (m *MemberTimelineEntry) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MemberTimelineEntry) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, MemberTimelineEntryValidationError{
+					field:  "Time",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, MemberTimelineEntryValidationError{
+					field:  "Time",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return MemberTimelineEntryValidationError{
+				field:  "Time",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	switch v := m.Entry.(type) {
+	case *MemberTimelineEntry_Note:
+		if v == nil {
+			err := MemberTimelineEntryValidationError{
+				field:  "Entry",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetNote()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, MemberTimelineEntryValidationError{
+						field:  "Note",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, MemberTimelineEntryValidationError{
+						field:  "Note",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetNote()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return MemberTimelineEntryValidationError{
+					field:  "Note",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *MemberTimelineEntry_Card:
+		if v == nil {
+			err := MemberTimelineEntryValidationError{
+				field:  "Entry",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetCard()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, MemberTimelineEntryValidationError{
+						field:  "Card",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, MemberTimelineEntryValidationError{
+						field:  "Card",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetCard()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return MemberTimelineEntryValidationError{
+					field:  "Card",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
 
-func (m *CheckinRequest) validate(all bool) error {
-	if m == nil {
-		return nil
-	}
+	case *MemberTimelineEntry_Briefing:
+		if v == nil {
+			err := MemberTimelineEntryValidationError{
+				field:  "Entry",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
 
-	var errors []error
+		if all {
+			switch v := interface{}(m.GetBriefing()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, MemberTimelineEntryValidationError{
+						field:  "Briefing",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, MemberTimelineEntryValidationError{
+						field:  "Briefing",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetBriefing()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return MemberTimelineEntryValidationError{
+					field:  "Briefing",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
 
-	// no validation rules for MemberId
+	default:
+		_ = v // ensures v is used
+	}
 
 	if len(errors) > 0 {
-		return CheckinRequestMultiError(errors)
+		return MemberTimelineEntryMultiError(errors)
 	}
 
 	return nil
 }
 
-// CheckinRequestMultiError is an error wrapping multiple validation errors
-// returned by CheckinRequest.ValidateAll() if the designated constraints
-// aren't met.
-type CheckinRequestMultiError []error
+// MemberTimelineEntryMultiError is an error wrapping multiple validation
+// errors returned by MemberTimelineEntry.ValidateAll() if the designated
+// constraints aren't met.
+type MemberTimelineEntryMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CheckinRequestMultiError) Error() string {
+func (m MemberTimelineEntryMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -6092,11 +27320,11 @@ func (m CheckinRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CheckinRequestMultiError) AllErrors() []error { return m }
+func (m MemberTimelineEntryMultiError) AllErrors() []error { return m }
 
-// CheckinRequestValidationError is the validation error returned by
-// CheckinRequest.Validate if the designated constraints aren't met.
-type CheckinRequestValidationError struct {
+// MemberTimelineEntryValidationError is the validation error returned by
+// MemberTimelineEntry.Validate if the designated constraints aren't met.
+type MemberTimelineEntryValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -6104,22 +27332,24 @@ type CheckinRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e CheckinRequestValidationError) Field() string { return e.field }
+func (e MemberTimelineEntryValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CheckinRequestValidationError) Reason() string { return e.reason }
+func (e MemberTimelineEntryValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CheckinRequestValidationError) Cause() error { return e.cause }
+func (e MemberTimelineEntryValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CheckinRequestValidationError) Key() bool { return e.key }
+func (e MemberTimelineEntryValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CheckinRequestValidationError) ErrorName() string { return "CheckinRequestValidationError" }
+func (e MemberTimelineEntryValidationError) ErrorName() string {
+	return "MemberTimelineEntryValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e CheckinRequestValidationError) Error() string {
+func (e MemberTimelineEntryValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -6131,14 +27361,14 @@ func (e CheckinRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCheckinRequest.%s: %s%s",
+		"invalid %sMemberTimelineEntry.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CheckinRequestValidationError{}
+var _ error = MemberTimelineEntryValidationError{}
 
 var _ interface {
 	Field() string
@@ -6146,24 +27376,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CheckinRequestValidationError{}
+} = MemberTimelineEntryValidationError{}
 
-// Validate checks the field values on CheckoutRequest with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *CheckoutRequest) Validate() error {
+// Validate checks the field values on ListMemberTimelineRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListMemberTimelineRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CheckoutRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on ListMemberTimelineRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// CheckoutRequestMultiError, or nil if none found.
-func (m *CheckoutRequest) ValidateAll() error {
+// ListMemberTimelineRequestMultiError, or nil if none found.
+func (m *ListMemberTimelineRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CheckoutRequest) validate(all bool) error {
+func (m *ListMemberTimelineRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -6172,20 +27402,24 @@ func (m *CheckoutRequest) validate(all bool) error {
 
 	// no validation rules for MemberId
 
+	// no validation rules for PageSize
+
+	// no validation rules for PageToken
+
 	if len(errors) > 0 {
-		return CheckoutRequestMultiError(errors)
+		return ListMemberTimelineRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// CheckoutRequestMultiError is an error wrapping multiple validation errors
-// returned by CheckoutRequest.ValidateAll() if the designated constraints
-// aren't met.
-type CheckoutRequestMultiError []error
+// ListMemberTimelineRequestMultiError is an error wrapping multiple validation
+// errors returned by ListMemberTimelineRequest.ValidateAll() if the
+// designated constraints aren't met.
+type ListMemberTimelineRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CheckoutRequestMultiError) Error() string {
+func (m ListMemberTimelineRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -6194,11 +27428,11 @@ func (m CheckoutRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CheckoutRequestMultiError) AllErrors() []error { return m }
+func (m ListMemberTimelineRequestMultiError) AllErrors() []error { return m }
 
-// CheckoutRequestValidationError is the validation error returned by
-// CheckoutRequest.Validate if the designated constraints aren't met.
-type CheckoutRequestValidationError struct {
+// ListMemberTimelineRequestValidationError is the validation error returned by
+// ListMemberTimelineRequest.Validate if the designated constraints aren't met.
+type ListMemberTimelineRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -6206,22 +27440,24 @@ type CheckoutRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e CheckoutRequestValidationError) Field() string { return e.field }
+func (e ListMemberTimelineRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CheckoutRequestValidationError) Reason() string { return e.reason }
+func (e ListMemberTimelineRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CheckoutRequestValidationError) Cause() error { return e.cause }
+func (e ListMemberTimelineRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CheckoutRequestValidationError) Key() bool { return e.key }
+func (e ListMemberTimelineRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CheckoutRequestValidationError) ErrorName() string { return "CheckoutRequestValidationError" }
+func (e ListMemberTimelineRequestValidationError) ErrorName() string {
+	return "ListMemberTimelineRequestValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e CheckoutRequestValidationError) Error() string {
+func (e ListMemberTimelineRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -6233,14 +27469,14 @@ func (e CheckoutRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCheckoutRequest.%s: %s%s",
+		"invalid %sListMemberTimelineRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CheckoutRequestValidationError{}
+var _ error = ListMemberTimelineRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -6248,102 +27484,80 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CheckoutRequestValidationError{}
+} = ListMemberTimelineRequestValidationError{}
 
-// Validate checks the field values on UpdatePresenceRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on ListMemberTimelineResponse with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *UpdatePresenceRequest) Validate() error {
+func (m *ListMemberTimelineResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on UpdatePresenceRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on ListMemberTimelineResponse with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// UpdatePresenceRequestMultiError, or nil if none found.
-func (m *UpdatePresenceRequest) ValidateAll() error {
+// ListMemberTimelineResponseMultiError, or nil if none found.
+func (m *ListMemberTimelineResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *UpdatePresenceRequest) validate(all bool) error {
+func (m *ListMemberTimelineResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if all {
-		switch v := interface{}(m.GetPresence()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, UpdatePresenceRequestValidationError{
-					field:  "Presence",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, UpdatePresenceRequestValidationError{
-					field:  "Presence",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetPresence()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return UpdatePresenceRequestValidationError{
-				field:  "Presence",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
+	for idx, item := range m.GetEntries() {
+		_, _ = idx, item
 
-	if all {
-		switch v := interface{}(m.GetFieldMask()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, UpdatePresenceRequestValidationError{
-					field:  "FieldMask",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListMemberTimelineResponseValidationError{
+						field:  fmt.Sprintf("Entries[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListMemberTimelineResponseValidationError{
+						field:  fmt.Sprintf("Entries[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
 			}
-		case interface{ Validate() error }:
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				errors = append(errors, UpdatePresenceRequestValidationError{
-					field:  "FieldMask",
+				return ListMemberTimelineResponseValidationError{
+					field:  fmt.Sprintf("Entries[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetFieldMask()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return UpdatePresenceRequestValidationError{
-				field:  "FieldMask",
-				reason: "embedded message failed validation",
-				cause:  err,
+				}
 			}
 		}
+
 	}
 
+	// no validation rules for NextPageToken
+
 	if len(errors) > 0 {
-		return UpdatePresenceRequestMultiError(errors)
+		return ListMemberTimelineResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// UpdatePresenceRequestMultiError is an error wrapping multiple validation
-// errors returned by UpdatePresenceRequest.ValidateAll() if the designated
-// constraints aren't met.
-type UpdatePresenceRequestMultiError []error
+// ListMemberTimelineResponseMultiError is an error wrapping multiple
+// validation errors returned by ListMemberTimelineResponse.ValidateAll() if
+// the designated constraints aren't met.
+type ListMemberTimelineResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m UpdatePresenceRequestMultiError) Error() string {
+func (m ListMemberTimelineResponseMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -6352,11 +27566,11 @@ func (m UpdatePresenceRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m UpdatePresenceRequestMultiError) AllErrors() []error { return m }
+func (m ListMemberTimelineResponseMultiError) AllErrors() []error { return m }
 
-// UpdatePresenceRequestValidationError is the validation error returned by
-// UpdatePresenceRequest.Validate if the designated constraints aren't met.
-type UpdatePresenceRequestValidationError struct {
+// ListMemberTimelineResponseValidationError is the validation error returned
+// by ListMemberTimelineResponse.Validate if the designated constraints aren't met.
+type ListMemberTimelineResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -6364,24 +27578,24 @@ type UpdatePresenceRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e UpdatePresenceRequestValidationError) Field() string { return e.field }
+func (e ListMemberTimelineResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UpdatePresenceRequestValidationError) Reason() string { return e.reason }
+func (e ListMemberTimelineResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UpdatePresenceRequestValidationError) Cause() error { return e.cause }
+func (e ListMemberTimelineResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UpdatePresenceRequestValidationError) Key() bool { return e.key }
+func (e ListMemberTimelineResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UpdatePresenceRequestValidationError) ErrorName() string {
-	return "UpdatePresenceRequestValidationError"
+func (e ListMemberTimelineResponseValidationError) ErrorName() string {
+	return "ListMemberTimelineResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e UpdatePresenceRequestValidationError) Error() string {
+func (e ListMemberTimelineResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -6393,14 +27607,14 @@ func (e UpdatePresenceRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUpdatePresenceRequest.%s: %s%s",
+		"invalid %sListMemberTimelineResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UpdatePresenceRequestValidationError{}
+var _ error = ListMemberTimelineResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -6408,46 +27622,46 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UpdatePresenceRequestValidationError{}
+} = ListMemberTimelineResponseValidationError{}
 
-// Validate checks the field values on DeletePresenceRequest with the rules
+// Validate checks the field values on MemberTimelinePageToken with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *DeletePresenceRequest) Validate() error {
+func (m *MemberTimelinePageToken) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on DeletePresenceRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on MemberTimelinePageToken with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// DeletePresenceRequestMultiError, or nil if none found.
-func (m *DeletePresenceRequest) ValidateAll() error {
+// MemberTimelinePageTokenMultiError, or nil if none found.
+func (m *MemberTimelinePageToken) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *DeletePresenceRequest) validate(all bool) error {
+func (m *MemberTimelinePageToken) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Id
+	// no validation rules for Offset
 
 	if len(errors) > 0 {
-		return DeletePresenceRequestMultiError(errors)
+		return MemberTimelinePageTokenMultiError(errors)
 	}
 
 	return nil
 }
 
-// DeletePresenceRequestMultiError is an error wrapping multiple validation
-// errors returned by DeletePresenceRequest.ValidateAll() if the designated
+// MemberTimelinePageTokenMultiError is an error wrapping multiple validation
+// errors returned by MemberTimelinePageToken.ValidateAll() if the designated
 // constraints aren't met.
-type DeletePresenceRequestMultiError []error
+type MemberTimelinePageTokenMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m DeletePresenceRequestMultiError) Error() string {
+func (m MemberTimelinePageTokenMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -6456,11 +27670,11 @@ func (m DeletePresenceRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m DeletePresenceRequestMultiError) AllErrors() []error { return m }
+func (m MemberTimelinePageTokenMultiError) AllErrors() []error { return m }
 
-// DeletePresenceRequestValidationError is the validation error returned by
-// DeletePresenceRequest.Validate if the designated constraints aren't met.
-type DeletePresenceRequestValidationError struct {
+// MemberTimelinePageTokenValidationError is the validation error returned by
+// MemberTimelinePageToken.Validate if the designated constraints aren't met.
+type MemberTimelinePageTokenValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -6468,24 +27682,24 @@ type DeletePresenceRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e DeletePresenceRequestValidationError) Field() string { return e.field }
+func (e MemberTimelinePageTokenValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DeletePresenceRequestValidationError) Reason() string { return e.reason }
+func (e MemberTimelinePageTokenValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DeletePresenceRequestValidationError) Cause() error { return e.cause }
+func (e MemberTimelinePageTokenValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DeletePresenceRequestValidationError) Key() bool { return e.key }
+func (e MemberTimelinePageTokenValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DeletePresenceRequestValidationError) ErrorName() string {
-	return "DeletePresenceRequestValidationError"
+func (e MemberTimelinePageTokenValidationError) ErrorName() string {
+	return "MemberTimelinePageTokenValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e DeletePresenceRequestValidationError) Error() string {
+func (e MemberTimelinePageTokenValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -6497,14 +27711,14 @@ func (e DeletePresenceRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDeletePresenceRequest.%s: %s%s",
+		"invalid %sMemberTimelinePageToken.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DeletePresenceRequestValidationError{}
+var _ error = MemberTimelinePageTokenValidationError{}
 
 var _ interface {
 	Field() string
@@ -6512,7 +27726,7 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DeletePresenceRequestValidationError{}
+} = MemberTimelinePageTokenValidationError{}
 
 // Validate checks the field values on LoginRequest with the rules defined in
 // the proto definition for this message. If any rules are violated, the first
@@ -8,6 +8,7 @@ package pb
 
 import (
 	context "context"
+	httpbody "google.golang.org/genproto/googleapis/api/httpbody"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
@@ -20,17 +21,36 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	MemberService_CreateMember_FullMethodName          = "/ourspace_backend.proto.MemberService/CreateMember"
-	MemberService_GetMember_FullMethodName             = "/ourspace_backend.proto.MemberService/GetMember"
-	MemberService_ListMembers_FullMethodName           = "/ourspace_backend.proto.MemberService/ListMembers"
-	MemberService_UpdateMember_FullMethodName          = "/ourspace_backend.proto.MemberService/UpdateMember"
-	MemberService_DeleteMember_FullMethodName          = "/ourspace_backend.proto.MemberService/DeleteMember"
-	MemberService_ListMemberTags_FullMethodName        = "/ourspace_backend.proto.MemberService/ListMemberTags"
-	MemberService_CreateMemberAttribute_FullMethodName = "/ourspace_backend.proto.MemberService/CreateMemberAttribute"
-	MemberService_GetMemberAttribute_FullMethodName    = "/ourspace_backend.proto.MemberService/GetMemberAttribute"
-	MemberService_ListMemberAttributes_FullMethodName  = "/ourspace_backend.proto.MemberService/ListMemberAttributes"
-	MemberService_UpdateMemberAttribute_FullMethodName = "/ourspace_backend.proto.MemberService/UpdateMemberAttribute"
-	MemberService_DeleteMemberAttribute_FullMethodName = "/ourspace_backend.proto.MemberService/DeleteMemberAttribute"
+	MemberService_CreateMember_FullMethodName               = "/ourspace_backend.proto.MemberService/CreateMember"
+	MemberService_GetMember_FullMethodName                  = "/ourspace_backend.proto.MemberService/GetMember"
+	MemberService_ListMembers_FullMethodName                = "/ourspace_backend.proto.MemberService/ListMembers"
+	MemberService_SearchMembers_FullMethodName              = "/ourspace_backend.proto.MemberService/SearchMembers"
+	MemberService_ExportMembers_FullMethodName              = "/ourspace_backend.proto.MemberService/ExportMembers"
+	MemberService_UpdateMember_FullMethodName               = "/ourspace_backend.proto.MemberService/UpdateMember"
+	MemberService_DeleteMember_FullMethodName               = "/ourspace_backend.proto.MemberService/DeleteMember"
+	MemberService_ListMemberTags_FullMethodName             = "/ourspace_backend.proto.MemberService/ListMemberTags"
+	MemberService_CreateMemberTag_FullMethodName            = "/ourspace_backend.proto.MemberService/CreateMemberTag"
+	MemberService_UpdateMemberTag_FullMethodName            = "/ourspace_backend.proto.MemberService/UpdateMemberTag"
+	MemberService_DeleteMemberTag_FullMethodName            = "/ourspace_backend.proto.MemberService/DeleteMemberTag"
+	MemberService_RenameMemberTag_FullMethodName            = "/ourspace_backend.proto.MemberService/RenameMemberTag"
+	MemberService_MergeMemberTags_FullMethodName            = "/ourspace_backend.proto.MemberService/MergeMemberTags"
+	MemberService_ImportMembers_FullMethodName              = "/ourspace_backend.proto.MemberService/ImportMembers"
+	MemberService_CreateMemberAttribute_FullMethodName      = "/ourspace_backend.proto.MemberService/CreateMemberAttribute"
+	MemberService_GetMemberAttribute_FullMethodName         = "/ourspace_backend.proto.MemberService/GetMemberAttribute"
+	MemberService_ListMemberAttributes_FullMethodName       = "/ourspace_backend.proto.MemberService/ListMemberAttributes"
+	MemberService_UpdateMemberAttribute_FullMethodName      = "/ourspace_backend.proto.MemberService/UpdateMemberAttribute"
+	MemberService_DeleteMemberAttribute_FullMethodName      = "/ourspace_backend.proto.MemberService/DeleteMemberAttribute"
+	MemberService_MigrateMemberAttribute_FullMethodName     = "/ourspace_backend.proto.MemberService/MigrateMemberAttribute"
+	MemberService_CreateMemberRelationship_FullMethodName   = "/ourspace_backend.proto.MemberService/CreateMemberRelationship"
+	MemberService_ListMemberRelationships_FullMethodName    = "/ourspace_backend.proto.MemberService/ListMemberRelationships"
+	MemberService_UpdateMemberRelationship_FullMethodName   = "/ourspace_backend.proto.MemberService/UpdateMemberRelationship"
+	MemberService_DeleteMemberRelationship_FullMethodName   = "/ourspace_backend.proto.MemberService/DeleteMemberRelationship"
+	MemberService_CreateMemberConsent_FullMethodName        = "/ourspace_backend.proto.MemberService/CreateMemberConsent"
+	MemberService_ListMemberConsents_FullMethodName         = "/ourspace_backend.proto.MemberService/ListMemberConsents"
+	MemberService_RevokeMemberConsent_FullMethodName        = "/ourspace_backend.proto.MemberService/RevokeMemberConsent"
+	MemberService_ListMemberChangeRequests_FullMethodName   = "/ourspace_backend.proto.MemberService/ListMemberChangeRequests"
+	MemberService_ApproveMemberChangeRequest_FullMethodName = "/ourspace_backend.proto.MemberService/ApproveMemberChangeRequest"
+	MemberService_RejectMemberChangeRequest_FullMethodName  = "/ourspace_backend.proto.MemberService/RejectMemberChangeRequest"
 )
 
 // MemberServiceClient is the client API for MemberService service.
@@ -40,14 +60,33 @@ type MemberServiceClient interface {
 	CreateMember(ctx context.Context, in *CreateMemberRequest, opts ...grpc.CallOption) (*Member, error)
 	GetMember(ctx context.Context, in *GetMemberRequest, opts ...grpc.CallOption) (*Member, error)
 	ListMembers(ctx context.Context, in *ListMembersRequest, opts ...grpc.CallOption) (*ListMembersResponse, error)
+	SearchMembers(ctx context.Context, in *SearchMembersRequest, opts ...grpc.CallOption) (*SearchMembersResponse, error)
+	ExportMembers(ctx context.Context, in *ExportMembersRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[httpbody.HttpBody], error)
 	UpdateMember(ctx context.Context, in *UpdateMemberRequest, opts ...grpc.CallOption) (*Member, error)
 	DeleteMember(ctx context.Context, in *DeleteMemberRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	ListMemberTags(ctx context.Context, in *ListMemberTagsRequest, opts ...grpc.CallOption) (*ListMemberTagsResponse, error)
+	CreateMemberTag(ctx context.Context, in *CreateMemberTagRequest, opts ...grpc.CallOption) (*MemberTag, error)
+	UpdateMemberTag(ctx context.Context, in *UpdateMemberTagRequest, opts ...grpc.CallOption) (*MemberTag, error)
+	DeleteMemberTag(ctx context.Context, in *DeleteMemberTagRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	RenameMemberTag(ctx context.Context, in *RenameMemberTagRequest, opts ...grpc.CallOption) (*MemberTag, error)
+	MergeMemberTags(ctx context.Context, in *MergeMemberTagsRequest, opts ...grpc.CallOption) (*MemberTag, error)
+	ImportMembers(ctx context.Context, in *ImportMembersRequest, opts ...grpc.CallOption) (*ImportMembersResponse, error)
 	CreateMemberAttribute(ctx context.Context, in *CreateMemberAttributeRequest, opts ...grpc.CallOption) (*MemberAttribute, error)
 	GetMemberAttribute(ctx context.Context, in *GetMemberAttributeRequest, opts ...grpc.CallOption) (*MemberAttribute, error)
 	ListMemberAttributes(ctx context.Context, in *ListMemberAttributesRequest, opts ...grpc.CallOption) (*ListMemberAttributesResponse, error)
 	UpdateMemberAttribute(ctx context.Context, in *UpdateMemberAttributeRequest, opts ...grpc.CallOption) (*MemberAttribute, error)
 	DeleteMemberAttribute(ctx context.Context, in *DeleteMemberAttributeRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	MigrateMemberAttribute(ctx context.Context, in *MigrateMemberAttributeRequest, opts ...grpc.CallOption) (*MigrateMemberAttributeResponse, error)
+	CreateMemberRelationship(ctx context.Context, in *CreateMemberRelationshipRequest, opts ...grpc.CallOption) (*MemberRelationship, error)
+	ListMemberRelationships(ctx context.Context, in *ListMemberRelationshipsRequest, opts ...grpc.CallOption) (*ListMemberRelationshipsResponse, error)
+	UpdateMemberRelationship(ctx context.Context, in *UpdateMemberRelationshipRequest, opts ...grpc.CallOption) (*MemberRelationship, error)
+	DeleteMemberRelationship(ctx context.Context, in *DeleteMemberRelationshipRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	CreateMemberConsent(ctx context.Context, in *CreateMemberConsentRequest, opts ...grpc.CallOption) (*MemberConsent, error)
+	ListMemberConsents(ctx context.Context, in *ListMemberConsentsRequest, opts ...grpc.CallOption) (*ListMemberConsentsResponse, error)
+	RevokeMemberConsent(ctx context.Context, in *RevokeMemberConsentRequest, opts ...grpc.CallOption) (*MemberConsent, error)
+	ListMemberChangeRequests(ctx context.Context, in *ListMemberChangeRequestsRequest, opts ...grpc.CallOption) (*ListMemberChangeRequestsResponse, error)
+	ApproveMemberChangeRequest(ctx context.Context, in *DecideMemberChangeRequestRequest, opts ...grpc.CallOption) (*MemberChangeRequest, error)
+	RejectMemberChangeRequest(ctx context.Context, in *DecideMemberChangeRequestRequest, opts ...grpc.CallOption) (*MemberChangeRequest, error)
 }
 
 type memberServiceClient struct {
@@ -88,6 +127,35 @@ func (c *memberServiceClient) ListMembers(ctx context.Context, in *ListMembersRe
 	return out, nil
 }
 
+func (c *memberServiceClient) SearchMembers(ctx context.Context, in *SearchMembersRequest, opts ...grpc.CallOption) (*SearchMembersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SearchMembersResponse)
+	err := c.cc.Invoke(ctx, MemberService_SearchMembers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *memberServiceClient) ExportMembers(ctx context.Context, in *ExportMembersRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[httpbody.HttpBody], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &MemberService_ServiceDesc.Streams[0], MemberService_ExportMembers_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ExportMembersRequest, httpbody.HttpBody]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type MemberService_ExportMembersClient = grpc.ServerStreamingClient[httpbody.HttpBody]
+
 func (c *memberServiceClient) UpdateMember(ctx context.Context, in *UpdateMemberRequest, opts ...grpc.CallOption) (*Member, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(Member)
@@ -118,6 +186,66 @@ func (c *memberServiceClient) ListMemberTags(ctx context.Context, in *ListMember
 	return out, nil
 }
 
+func (c *memberServiceClient) CreateMemberTag(ctx context.Context, in *CreateMemberTagRequest, opts ...grpc.CallOption) (*MemberTag, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MemberTag)
+	err := c.cc.Invoke(ctx, MemberService_CreateMemberTag_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *memberServiceClient) UpdateMemberTag(ctx context.Context, in *UpdateMemberTagRequest, opts ...grpc.CallOption) (*MemberTag, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MemberTag)
+	err := c.cc.Invoke(ctx, MemberService_UpdateMemberTag_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *memberServiceClient) DeleteMemberTag(ctx context.Context, in *DeleteMemberTagRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, MemberService_DeleteMemberTag_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *memberServiceClient) RenameMemberTag(ctx context.Context, in *RenameMemberTagRequest, opts ...grpc.CallOption) (*MemberTag, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MemberTag)
+	err := c.cc.Invoke(ctx, MemberService_RenameMemberTag_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *memberServiceClient) MergeMemberTags(ctx context.Context, in *MergeMemberTagsRequest, opts ...grpc.CallOption) (*MemberTag, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MemberTag)
+	err := c.cc.Invoke(ctx, MemberService_MergeMemberTags_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *memberServiceClient) ImportMembers(ctx context.Context, in *ImportMembersRequest, opts ...grpc.CallOption) (*ImportMembersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ImportMembersResponse)
+	err := c.cc.Invoke(ctx, MemberService_ImportMembers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *memberServiceClient) CreateMemberAttribute(ctx context.Context, in *CreateMemberAttributeRequest, opts ...grpc.CallOption) (*MemberAttribute, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(MemberAttribute)
@@ -168,6 +296,116 @@ func (c *memberServiceClient) DeleteMemberAttribute(ctx context.Context, in *Del
 	return out, nil
 }
 
+func (c *memberServiceClient) MigrateMemberAttribute(ctx context.Context, in *MigrateMemberAttributeRequest, opts ...grpc.CallOption) (*MigrateMemberAttributeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MigrateMemberAttributeResponse)
+	err := c.cc.Invoke(ctx, MemberService_MigrateMemberAttribute_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *memberServiceClient) CreateMemberRelationship(ctx context.Context, in *CreateMemberRelationshipRequest, opts ...grpc.CallOption) (*MemberRelationship, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MemberRelationship)
+	err := c.cc.Invoke(ctx, MemberService_CreateMemberRelationship_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *memberServiceClient) ListMemberRelationships(ctx context.Context, in *ListMemberRelationshipsRequest, opts ...grpc.CallOption) (*ListMemberRelationshipsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListMemberRelationshipsResponse)
+	err := c.cc.Invoke(ctx, MemberService_ListMemberRelationships_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *memberServiceClient) UpdateMemberRelationship(ctx context.Context, in *UpdateMemberRelationshipRequest, opts ...grpc.CallOption) (*MemberRelationship, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MemberRelationship)
+	err := c.cc.Invoke(ctx, MemberService_UpdateMemberRelationship_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *memberServiceClient) DeleteMemberRelationship(ctx context.Context, in *DeleteMemberRelationshipRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, MemberService_DeleteMemberRelationship_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *memberServiceClient) CreateMemberConsent(ctx context.Context, in *CreateMemberConsentRequest, opts ...grpc.CallOption) (*MemberConsent, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MemberConsent)
+	err := c.cc.Invoke(ctx, MemberService_CreateMemberConsent_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *memberServiceClient) ListMemberConsents(ctx context.Context, in *ListMemberConsentsRequest, opts ...grpc.CallOption) (*ListMemberConsentsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListMemberConsentsResponse)
+	err := c.cc.Invoke(ctx, MemberService_ListMemberConsents_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *memberServiceClient) RevokeMemberConsent(ctx context.Context, in *RevokeMemberConsentRequest, opts ...grpc.CallOption) (*MemberConsent, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MemberConsent)
+	err := c.cc.Invoke(ctx, MemberService_RevokeMemberConsent_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *memberServiceClient) ListMemberChangeRequests(ctx context.Context, in *ListMemberChangeRequestsRequest, opts ...grpc.CallOption) (*ListMemberChangeRequestsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListMemberChangeRequestsResponse)
+	err := c.cc.Invoke(ctx, MemberService_ListMemberChangeRequests_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *memberServiceClient) ApproveMemberChangeRequest(ctx context.Context, in *DecideMemberChangeRequestRequest, opts ...grpc.CallOption) (*MemberChangeRequest, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MemberChangeRequest)
+	err := c.cc.Invoke(ctx, MemberService_ApproveMemberChangeRequest_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *memberServiceClient) RejectMemberChangeRequest(ctx context.Context, in *DecideMemberChangeRequestRequest, opts ...grpc.CallOption) (*MemberChangeRequest, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MemberChangeRequest)
+	err := c.cc.Invoke(ctx, MemberService_RejectMemberChangeRequest_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MemberServiceServer is the server API for MemberService service.
 // All implementations must embed UnimplementedMemberServiceServer
 // for forward compatibility.
@@ -175,14 +413,33 @@ type MemberServiceServer interface {
 	CreateMember(context.Context, *CreateMemberRequest) (*Member, error)
 	GetMember(context.Context, *GetMemberRequest) (*Member, error)
 	ListMembers(context.Context, *ListMembersRequest) (*ListMembersResponse, error)
+	SearchMembers(context.Context, *SearchMembersRequest) (*SearchMembersResponse, error)
+	ExportMembers(*ExportMembersRequest, grpc.ServerStreamingServer[httpbody.HttpBody]) error
 	UpdateMember(context.Context, *UpdateMemberRequest) (*Member, error)
 	DeleteMember(context.Context, *DeleteMemberRequest) (*emptypb.Empty, error)
 	ListMemberTags(context.Context, *ListMemberTagsRequest) (*ListMemberTagsResponse, error)
+	CreateMemberTag(context.Context, *CreateMemberTagRequest) (*MemberTag, error)
+	UpdateMemberTag(context.Context, *UpdateMemberTagRequest) (*MemberTag, error)
+	DeleteMemberTag(context.Context, *DeleteMemberTagRequest) (*emptypb.Empty, error)
+	RenameMemberTag(context.Context, *RenameMemberTagRequest) (*MemberTag, error)
+	MergeMemberTags(context.Context, *MergeMemberTagsRequest) (*MemberTag, error)
+	ImportMembers(context.Context, *ImportMembersRequest) (*ImportMembersResponse, error)
 	CreateMemberAttribute(context.Context, *CreateMemberAttributeRequest) (*MemberAttribute, error)
 	GetMemberAttribute(context.Context, *GetMemberAttributeRequest) (*MemberAttribute, error)
 	ListMemberAttributes(context.Context, *ListMemberAttributesRequest) (*ListMemberAttributesResponse, error)
 	UpdateMemberAttribute(context.Context, *UpdateMemberAttributeRequest) (*MemberAttribute, error)
 	DeleteMemberAttribute(context.Context, *DeleteMemberAttributeRequest) (*emptypb.Empty, error)
+	MigrateMemberAttribute(context.Context, *MigrateMemberAttributeRequest) (*MigrateMemberAttributeResponse, error)
+	CreateMemberRelationship(context.Context, *CreateMemberRelationshipRequest) (*MemberRelationship, error)
+	ListMemberRelationships(context.Context, *ListMemberRelationshipsRequest) (*ListMemberRelationshipsResponse, error)
+	UpdateMemberRelationship(context.Context, *UpdateMemberRelationshipRequest) (*MemberRelationship, error)
+	DeleteMemberRelationship(context.Context, *DeleteMemberRelationshipRequest) (*emptypb.Empty, error)
+	CreateMemberConsent(context.Context, *CreateMemberConsentRequest) (*MemberConsent, error)
+	ListMemberConsents(context.Context, *ListMemberConsentsRequest) (*ListMemberConsentsResponse, error)
+	RevokeMemberConsent(context.Context, *RevokeMemberConsentRequest) (*MemberConsent, error)
+	ListMemberChangeRequests(context.Context, *ListMemberChangeRequestsRequest) (*ListMemberChangeRequestsResponse, error)
+	ApproveMemberChangeRequest(context.Context, *DecideMemberChangeRequestRequest) (*MemberChangeRequest, error)
+	RejectMemberChangeRequest(context.Context, *DecideMemberChangeRequestRequest) (*MemberChangeRequest, error)
 	mustEmbedUnimplementedMemberServiceServer()
 }
 
@@ -202,6 +459,12 @@ func (UnimplementedMemberServiceServer) GetMember(context.Context, *GetMemberReq
 func (UnimplementedMemberServiceServer) ListMembers(context.Context, *ListMembersRequest) (*ListMembersResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListMembers not implemented")
 }
+func (UnimplementedMemberServiceServer) SearchMembers(context.Context, *SearchMembersRequest) (*SearchMembersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchMembers not implemented")
+}
+func (UnimplementedMemberServiceServer) ExportMembers(*ExportMembersRequest, grpc.ServerStreamingServer[httpbody.HttpBody]) error {
+	return status.Errorf(codes.Unimplemented, "method ExportMembers not implemented")
+}
 func (UnimplementedMemberServiceServer) UpdateMember(context.Context, *UpdateMemberRequest) (*Member, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateMember not implemented")
 }
@@ -211,6 +474,24 @@ func (UnimplementedMemberServiceServer) DeleteMember(context.Context, *DeleteMem
 func (UnimplementedMemberServiceServer) ListMemberTags(context.Context, *ListMemberTagsRequest) (*ListMemberTagsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListMemberTags not implemented")
 }
+func (UnimplementedMemberServiceServer) CreateMemberTag(context.Context, *CreateMemberTagRequest) (*MemberTag, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateMemberTag not implemented")
+}
+func (UnimplementedMemberServiceServer) UpdateMemberTag(context.Context, *UpdateMemberTagRequest) (*MemberTag, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateMemberTag not implemented")
+}
+func (UnimplementedMemberServiceServer) DeleteMemberTag(context.Context, *DeleteMemberTagRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteMemberTag not implemented")
+}
+func (UnimplementedMemberServiceServer) RenameMemberTag(context.Context, *RenameMemberTagRequest) (*MemberTag, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RenameMemberTag not implemented")
+}
+func (UnimplementedMemberServiceServer) MergeMemberTags(context.Context, *MergeMemberTagsRequest) (*MemberTag, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MergeMemberTags not implemented")
+}
+func (UnimplementedMemberServiceServer) ImportMembers(context.Context, *ImportMembersRequest) (*ImportMembersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportMembers not implemented")
+}
 func (UnimplementedMemberServiceServer) CreateMemberAttribute(context.Context, *CreateMemberAttributeRequest) (*MemberAttribute, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateMemberAttribute not implemented")
 }
@@ -226,6 +507,39 @@ func (UnimplementedMemberServiceServer) UpdateMemberAttribute(context.Context, *
 func (UnimplementedMemberServiceServer) DeleteMemberAttribute(context.Context, *DeleteMemberAttributeRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteMemberAttribute not implemented")
 }
+func (UnimplementedMemberServiceServer) MigrateMemberAttribute(context.Context, *MigrateMemberAttributeRequest) (*MigrateMemberAttributeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MigrateMemberAttribute not implemented")
+}
+func (UnimplementedMemberServiceServer) CreateMemberRelationship(context.Context, *CreateMemberRelationshipRequest) (*MemberRelationship, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateMemberRelationship not implemented")
+}
+func (UnimplementedMemberServiceServer) ListMemberRelationships(context.Context, *ListMemberRelationshipsRequest) (*ListMemberRelationshipsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListMemberRelationships not implemented")
+}
+func (UnimplementedMemberServiceServer) UpdateMemberRelationship(context.Context, *UpdateMemberRelationshipRequest) (*MemberRelationship, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateMemberRelationship not implemented")
+}
+func (UnimplementedMemberServiceServer) DeleteMemberRelationship(context.Context, *DeleteMemberRelationshipRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteMemberRelationship not implemented")
+}
+func (UnimplementedMemberServiceServer) CreateMemberConsent(context.Context, *CreateMemberConsentRequest) (*MemberConsent, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateMemberConsent not implemented")
+}
+func (UnimplementedMemberServiceServer) ListMemberConsents(context.Context, *ListMemberConsentsRequest) (*ListMemberConsentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListMemberConsents not implemented")
+}
+func (UnimplementedMemberServiceServer) RevokeMemberConsent(context.Context, *RevokeMemberConsentRequest) (*MemberConsent, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeMemberConsent not implemented")
+}
+func (UnimplementedMemberServiceServer) ListMemberChangeRequests(context.Context, *ListMemberChangeRequestsRequest) (*ListMemberChangeRequestsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListMemberChangeRequests not implemented")
+}
+func (UnimplementedMemberServiceServer) ApproveMemberChangeRequest(context.Context, *DecideMemberChangeRequestRequest) (*MemberChangeRequest, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApproveMemberChangeRequest not implemented")
+}
+func (UnimplementedMemberServiceServer) RejectMemberChangeRequest(context.Context, *DecideMemberChangeRequestRequest) (*MemberChangeRequest, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RejectMemberChangeRequest not implemented")
+}
 func (UnimplementedMemberServiceServer) mustEmbedUnimplementedMemberServiceServer() {}
 func (UnimplementedMemberServiceServer) testEmbeddedByValue()                       {}
 
@@ -301,6 +615,35 @@ func _MemberService_ListMembers_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MemberService_SearchMembers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchMembersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemberServiceServer).SearchMembers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemberService_SearchMembers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemberServiceServer).SearchMembers(ctx, req.(*SearchMembersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MemberService_ExportMembers_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportMembersRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(MemberServiceServer).ExportMembers(m, &grpc.GenericServerStream[ExportMembersRequest, httpbody.HttpBody]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type MemberService_ExportMembersServer = grpc.ServerStreamingServer[httpbody.HttpBody]
+
 func _MemberService_UpdateMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(UpdateMemberRequest)
 	if err := dec(in); err != nil {
@@ -355,6 +698,114 @@ func _MemberService_ListMemberTags_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MemberService_CreateMemberTag_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateMemberTagRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemberServiceServer).CreateMemberTag(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemberService_CreateMemberTag_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemberServiceServer).CreateMemberTag(ctx, req.(*CreateMemberTagRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MemberService_UpdateMemberTag_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateMemberTagRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemberServiceServer).UpdateMemberTag(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemberService_UpdateMemberTag_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemberServiceServer).UpdateMemberTag(ctx, req.(*UpdateMemberTagRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MemberService_DeleteMemberTag_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteMemberTagRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemberServiceServer).DeleteMemberTag(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemberService_DeleteMemberTag_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemberServiceServer).DeleteMemberTag(ctx, req.(*DeleteMemberTagRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MemberService_RenameMemberTag_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RenameMemberTagRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemberServiceServer).RenameMemberTag(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemberService_RenameMemberTag_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemberServiceServer).RenameMemberTag(ctx, req.(*RenameMemberTagRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MemberService_MergeMemberTags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MergeMemberTagsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemberServiceServer).MergeMemberTags(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemberService_MergeMemberTags_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemberServiceServer).MergeMemberTags(ctx, req.(*MergeMemberTagsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MemberService_ImportMembers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportMembersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemberServiceServer).ImportMembers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemberService_ImportMembers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemberServiceServer).ImportMembers(ctx, req.(*ImportMembersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _MemberService_CreateMemberAttribute_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CreateMemberAttributeRequest)
 	if err := dec(in); err != nil {
@@ -445,9 +896,207 @@ func _MemberService_DeleteMemberAttribute_Handler(srv interface{}, ctx context.C
 	return interceptor(ctx, in, info, handler)
 }
 
-// MemberService_ServiceDesc is the grpc.ServiceDesc for MemberService service.
-// It's only intended for direct use with grpc.RegisterService,
-// and not to be introspected or modified (even as a copy)
+func _MemberService_MigrateMemberAttribute_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MigrateMemberAttributeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemberServiceServer).MigrateMemberAttribute(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemberService_MigrateMemberAttribute_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemberServiceServer).MigrateMemberAttribute(ctx, req.(*MigrateMemberAttributeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MemberService_CreateMemberRelationship_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateMemberRelationshipRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemberServiceServer).CreateMemberRelationship(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemberService_CreateMemberRelationship_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemberServiceServer).CreateMemberRelationship(ctx, req.(*CreateMemberRelationshipRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MemberService_ListMemberRelationships_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMemberRelationshipsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemberServiceServer).ListMemberRelationships(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemberService_ListMemberRelationships_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemberServiceServer).ListMemberRelationships(ctx, req.(*ListMemberRelationshipsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MemberService_UpdateMemberRelationship_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateMemberRelationshipRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemberServiceServer).UpdateMemberRelationship(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemberService_UpdateMemberRelationship_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemberServiceServer).UpdateMemberRelationship(ctx, req.(*UpdateMemberRelationshipRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MemberService_DeleteMemberRelationship_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteMemberRelationshipRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemberServiceServer).DeleteMemberRelationship(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemberService_DeleteMemberRelationship_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemberServiceServer).DeleteMemberRelationship(ctx, req.(*DeleteMemberRelationshipRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MemberService_CreateMemberConsent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateMemberConsentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemberServiceServer).CreateMemberConsent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemberService_CreateMemberConsent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemberServiceServer).CreateMemberConsent(ctx, req.(*CreateMemberConsentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MemberService_ListMemberConsents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMemberConsentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemberServiceServer).ListMemberConsents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemberService_ListMemberConsents_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemberServiceServer).ListMemberConsents(ctx, req.(*ListMemberConsentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MemberService_RevokeMemberConsent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeMemberConsentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemberServiceServer).RevokeMemberConsent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemberService_RevokeMemberConsent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemberServiceServer).RevokeMemberConsent(ctx, req.(*RevokeMemberConsentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MemberService_ListMemberChangeRequests_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMemberChangeRequestsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemberServiceServer).ListMemberChangeRequests(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemberService_ListMemberChangeRequests_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemberServiceServer).ListMemberChangeRequests(ctx, req.(*ListMemberChangeRequestsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MemberService_ApproveMemberChangeRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DecideMemberChangeRequestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemberServiceServer).ApproveMemberChangeRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemberService_ApproveMemberChangeRequest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemberServiceServer).ApproveMemberChangeRequest(ctx, req.(*DecideMemberChangeRequestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MemberService_RejectMemberChangeRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DecideMemberChangeRequestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemberServiceServer).RejectMemberChangeRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemberService_RejectMemberChangeRequest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemberServiceServer).RejectMemberChangeRequest(ctx, req.(*DecideMemberChangeRequestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// MemberService_ServiceDesc is the grpc.ServiceDesc for MemberService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
 var MemberService_ServiceDesc = grpc.ServiceDesc{
 	ServiceName: "ourspace_backend.proto.MemberService",
 	HandlerType: (*MemberServiceServer)(nil),
@@ -464,6 +1113,10 @@ var MemberService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListMembers",
 			Handler:    _MemberService_ListMembers_Handler,
 		},
+		{
+			MethodName: "SearchMembers",
+			Handler:    _MemberService_SearchMembers_Handler,
+		},
 		{
 			MethodName: "UpdateMember",
 			Handler:    _MemberService_UpdateMember_Handler,
@@ -476,6 +1129,30 @@ var MemberService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListMemberTags",
 			Handler:    _MemberService_ListMemberTags_Handler,
 		},
+		{
+			MethodName: "CreateMemberTag",
+			Handler:    _MemberService_CreateMemberTag_Handler,
+		},
+		{
+			MethodName: "UpdateMemberTag",
+			Handler:    _MemberService_UpdateMemberTag_Handler,
+		},
+		{
+			MethodName: "DeleteMemberTag",
+			Handler:    _MemberService_DeleteMemberTag_Handler,
+		},
+		{
+			MethodName: "RenameMemberTag",
+			Handler:    _MemberService_RenameMemberTag_Handler,
+		},
+		{
+			MethodName: "MergeMemberTags",
+			Handler:    _MemberService_MergeMemberTags_Handler,
+		},
+		{
+			MethodName: "ImportMembers",
+			Handler:    _MemberService_ImportMembers_Handler,
+		},
 		{
 			MethodName: "CreateMemberAttribute",
 			Handler:    _MemberService_CreateMemberAttribute_Handler,
@@ -496,17 +1173,68 @@ var MemberService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteMemberAttribute",
 			Handler:    _MemberService_DeleteMemberAttribute_Handler,
 		},
+		{
+			MethodName: "MigrateMemberAttribute",
+			Handler:    _MemberService_MigrateMemberAttribute_Handler,
+		},
+		{
+			MethodName: "CreateMemberRelationship",
+			Handler:    _MemberService_CreateMemberRelationship_Handler,
+		},
+		{
+			MethodName: "ListMemberRelationships",
+			Handler:    _MemberService_ListMemberRelationships_Handler,
+		},
+		{
+			MethodName: "UpdateMemberRelationship",
+			Handler:    _MemberService_UpdateMemberRelationship_Handler,
+		},
+		{
+			MethodName: "DeleteMemberRelationship",
+			Handler:    _MemberService_DeleteMemberRelationship_Handler,
+		},
+		{
+			MethodName: "CreateMemberConsent",
+			Handler:    _MemberService_CreateMemberConsent_Handler,
+		},
+		{
+			MethodName: "ListMemberConsents",
+			Handler:    _MemberService_ListMemberConsents_Handler,
+		},
+		{
+			MethodName: "RevokeMemberConsent",
+			Handler:    _MemberService_RevokeMemberConsent_Handler,
+		},
+		{
+			MethodName: "ListMemberChangeRequests",
+			Handler:    _MemberService_ListMemberChangeRequests_Handler,
+		},
+		{
+			MethodName: "ApproveMemberChangeRequest",
+			Handler:    _MemberService_ApproveMemberChangeRequest_Handler,
+		},
+		{
+			MethodName: "RejectMemberChangeRequest",
+			Handler:    _MemberService_RejectMemberChangeRequest_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ExportMembers",
+			Handler:       _MemberService_ExportMembers_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "ourspace-backend/proto/api.proto",
 }
 
 const (
-	CardService_CreateCard_FullMethodName = "/ourspace_backend.proto.CardService/CreateCard"
-	CardService_GetCard_FullMethodName    = "/ourspace_backend.proto.CardService/GetCard"
-	CardService_ListCards_FullMethodName  = "/ourspace_backend.proto.CardService/ListCards"
-	CardService_UpdateCard_FullMethodName = "/ourspace_backend.proto.CardService/UpdateCard"
-	CardService_DeleteCard_FullMethodName = "/ourspace_backend.proto.CardService/DeleteCard"
+	CardService_CreateCard_FullMethodName  = "/ourspace_backend.proto.CardService/CreateCard"
+	CardService_GetCard_FullMethodName     = "/ourspace_backend.proto.CardService/GetCard"
+	CardService_ListCards_FullMethodName   = "/ourspace_backend.proto.CardService/ListCards"
+	CardService_ExportCards_FullMethodName = "/ourspace_backend.proto.CardService/ExportCards"
+	CardService_UpdateCard_FullMethodName  = "/ourspace_backend.proto.CardService/UpdateCard"
+	CardService_DeleteCard_FullMethodName  = "/ourspace_backend.proto.CardService/DeleteCard"
 )
 
 // CardServiceClient is the client API for CardService service.
@@ -516,6 +1244,7 @@ type CardServiceClient interface {
 	CreateCard(ctx context.Context, in *CreateCardRequest, opts ...grpc.CallOption) (*Card, error)
 	GetCard(ctx context.Context, in *GetCardRequest, opts ...grpc.CallOption) (*Card, error)
 	ListCards(ctx context.Context, in *ListCardsRequest, opts ...grpc.CallOption) (*ListCardsResponse, error)
+	ExportCards(ctx context.Context, in *ExportCardsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[httpbody.HttpBody], error)
 	UpdateCard(ctx context.Context, in *UpdateCardRequest, opts ...grpc.CallOption) (*Card, error)
 	DeleteCard(ctx context.Context, in *DeleteCardRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 }
@@ -558,6 +1287,25 @@ func (c *cardServiceClient) ListCards(ctx context.Context, in *ListCardsRequest,
 	return out, nil
 }
 
+func (c *cardServiceClient) ExportCards(ctx context.Context, in *ExportCardsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[httpbody.HttpBody], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &CardService_ServiceDesc.Streams[0], CardService_ExportCards_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ExportCardsRequest, httpbody.HttpBody]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type CardService_ExportCardsClient = grpc.ServerStreamingClient[httpbody.HttpBody]
+
 func (c *cardServiceClient) UpdateCard(ctx context.Context, in *UpdateCardRequest, opts ...grpc.CallOption) (*Card, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(Card)
@@ -585,6 +1333,7 @@ type CardServiceServer interface {
 	CreateCard(context.Context, *CreateCardRequest) (*Card, error)
 	GetCard(context.Context, *GetCardRequest) (*Card, error)
 	ListCards(context.Context, *ListCardsRequest) (*ListCardsResponse, error)
+	ExportCards(*ExportCardsRequest, grpc.ServerStreamingServer[httpbody.HttpBody]) error
 	UpdateCard(context.Context, *UpdateCardRequest) (*Card, error)
 	DeleteCard(context.Context, *DeleteCardRequest) (*emptypb.Empty, error)
 	mustEmbedUnimplementedCardServiceServer()
@@ -606,6 +1355,9 @@ func (UnimplementedCardServiceServer) GetCard(context.Context, *GetCardRequest)
 func (UnimplementedCardServiceServer) ListCards(context.Context, *ListCardsRequest) (*ListCardsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListCards not implemented")
 }
+func (UnimplementedCardServiceServer) ExportCards(*ExportCardsRequest, grpc.ServerStreamingServer[httpbody.HttpBody]) error {
+	return status.Errorf(codes.Unimplemented, "method ExportCards not implemented")
+}
 func (UnimplementedCardServiceServer) UpdateCard(context.Context, *UpdateCardRequest) (*Card, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateCard not implemented")
 }
@@ -687,6 +1439,17 @@ func _CardService_ListCards_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _CardService_ExportCards_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportCardsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(CardServiceServer).ExportCards(m, &grpc.GenericServerStream[ExportCardsRequest, httpbody.HttpBody]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type CardService_ExportCardsServer = grpc.ServerStreamingServer[httpbody.HttpBody]
+
 func _CardService_UpdateCard_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(UpdateCardRequest)
 	if err := dec(in); err != nil {
@@ -751,7 +1514,13 @@ var CardService_ServiceDesc = grpc.ServiceDesc{
 			Handler:    _CardService_DeleteCard_Handler,
 		},
 	},
-	Streams:  []grpc.StreamDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ExportCards",
+			Handler:       _CardService_ExportCards_Handler,
+			ServerStreams: true,
+		},
+	},
 	Metadata: "ourspace-backend/proto/api.proto",
 }
 
@@ -1202,11 +1971,14 @@ var BriefingService_ServiceDesc = grpc.ServiceDesc{
 }
 
 const (
-	PresenceService_ListPresences_FullMethodName  = "/ourspace_backend.proto.PresenceService/ListPresences"
-	PresenceService_Checkin_FullMethodName        = "/ourspace_backend.proto.PresenceService/Checkin"
-	PresenceService_Checkout_FullMethodName       = "/ourspace_backend.proto.PresenceService/Checkout"
-	PresenceService_UpdatePresence_FullMethodName = "/ourspace_backend.proto.PresenceService/UpdatePresence"
-	PresenceService_DeletePresence_FullMethodName = "/ourspace_backend.proto.PresenceService/DeletePresence"
+	PresenceService_ListPresences_FullMethodName   = "/ourspace_backend.proto.PresenceService/ListPresences"
+	PresenceService_ExportPresences_FullMethodName = "/ourspace_backend.proto.PresenceService/ExportPresences"
+	PresenceService_Checkin_FullMethodName         = "/ourspace_backend.proto.PresenceService/Checkin"
+	PresenceService_Checkout_FullMethodName        = "/ourspace_backend.proto.PresenceService/Checkout"
+	PresenceService_TogglePresence_FullMethodName  = "/ourspace_backend.proto.PresenceService/TogglePresence"
+	PresenceService_CheckinByCard_FullMethodName   = "/ourspace_backend.proto.PresenceService/CheckinByCard"
+	PresenceService_UpdatePresence_FullMethodName  = "/ourspace_backend.proto.PresenceService/UpdatePresence"
+	PresenceService_DeletePresence_FullMethodName  = "/ourspace_backend.proto.PresenceService/DeletePresence"
 )
 
 // PresenceServiceClient is the client API for PresenceService service.
@@ -1214,8 +1986,11 @@ const (
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type PresenceServiceClient interface {
 	ListPresences(ctx context.Context, in *ListPresencesRequest, opts ...grpc.CallOption) (*ListPresencesResponse, error)
+	ExportPresences(ctx context.Context, in *ExportPresencesRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[httpbody.HttpBody], error)
 	Checkin(ctx context.Context, in *CheckinRequest, opts ...grpc.CallOption) (*Presence, error)
 	Checkout(ctx context.Context, in *CheckoutRequest, opts ...grpc.CallOption) (*Presence, error)
+	TogglePresence(ctx context.Context, in *TogglePresenceRequest, opts ...grpc.CallOption) (*TogglePresenceResponse, error)
+	CheckinByCard(ctx context.Context, in *CheckinByCardRequest, opts ...grpc.CallOption) (*TogglePresenceResponse, error)
 	UpdatePresence(ctx context.Context, in *UpdatePresenceRequest, opts ...grpc.CallOption) (*Presence, error)
 	DeletePresence(ctx context.Context, in *DeletePresenceRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 }
@@ -1238,6 +2013,25 @@ func (c *presenceServiceClient) ListPresences(ctx context.Context, in *ListPrese
 	return out, nil
 }
 
+func (c *presenceServiceClient) ExportPresences(ctx context.Context, in *ExportPresencesRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[httpbody.HttpBody], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &PresenceService_ServiceDesc.Streams[0], PresenceService_ExportPresences_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ExportPresencesRequest, httpbody.HttpBody]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type PresenceService_ExportPresencesClient = grpc.ServerStreamingClient[httpbody.HttpBody]
+
 func (c *presenceServiceClient) Checkin(ctx context.Context, in *CheckinRequest, opts ...grpc.CallOption) (*Presence, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(Presence)
@@ -1258,6 +2052,26 @@ func (c *presenceServiceClient) Checkout(ctx context.Context, in *CheckoutReques
 	return out, nil
 }
 
+func (c *presenceServiceClient) TogglePresence(ctx context.Context, in *TogglePresenceRequest, opts ...grpc.CallOption) (*TogglePresenceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TogglePresenceResponse)
+	err := c.cc.Invoke(ctx, PresenceService_TogglePresence_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *presenceServiceClient) CheckinByCard(ctx context.Context, in *CheckinByCardRequest, opts ...grpc.CallOption) (*TogglePresenceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TogglePresenceResponse)
+	err := c.cc.Invoke(ctx, PresenceService_CheckinByCard_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *presenceServiceClient) UpdatePresence(ctx context.Context, in *UpdatePresenceRequest, opts ...grpc.CallOption) (*Presence, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(Presence)
@@ -1283,8 +2097,11 @@ func (c *presenceServiceClient) DeletePresence(ctx context.Context, in *DeletePr
 // for forward compatibility.
 type PresenceServiceServer interface {
 	ListPresences(context.Context, *ListPresencesRequest) (*ListPresencesResponse, error)
+	ExportPresences(*ExportPresencesRequest, grpc.ServerStreamingServer[httpbody.HttpBody]) error
 	Checkin(context.Context, *CheckinRequest) (*Presence, error)
 	Checkout(context.Context, *CheckoutRequest) (*Presence, error)
+	TogglePresence(context.Context, *TogglePresenceRequest) (*TogglePresenceResponse, error)
+	CheckinByCard(context.Context, *CheckinByCardRequest) (*TogglePresenceResponse, error)
 	UpdatePresence(context.Context, *UpdatePresenceRequest) (*Presence, error)
 	DeletePresence(context.Context, *DeletePresenceRequest) (*emptypb.Empty, error)
 	mustEmbedUnimplementedPresenceServiceServer()
@@ -1300,12 +2117,21 @@ type UnimplementedPresenceServiceServer struct{}
 func (UnimplementedPresenceServiceServer) ListPresences(context.Context, *ListPresencesRequest) (*ListPresencesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListPresences not implemented")
 }
+func (UnimplementedPresenceServiceServer) ExportPresences(*ExportPresencesRequest, grpc.ServerStreamingServer[httpbody.HttpBody]) error {
+	return status.Errorf(codes.Unimplemented, "method ExportPresences not implemented")
+}
 func (UnimplementedPresenceServiceServer) Checkin(context.Context, *CheckinRequest) (*Presence, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Checkin not implemented")
 }
 func (UnimplementedPresenceServiceServer) Checkout(context.Context, *CheckoutRequest) (*Presence, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Checkout not implemented")
 }
+func (UnimplementedPresenceServiceServer) TogglePresence(context.Context, *TogglePresenceRequest) (*TogglePresenceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TogglePresence not implemented")
+}
+func (UnimplementedPresenceServiceServer) CheckinByCard(context.Context, *CheckinByCardRequest) (*TogglePresenceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckinByCard not implemented")
+}
 func (UnimplementedPresenceServiceServer) UpdatePresence(context.Context, *UpdatePresenceRequest) (*Presence, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdatePresence not implemented")
 }
@@ -1351,6 +2177,17 @@ func _PresenceService_ListPresences_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _PresenceService_ExportPresences_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportPresencesRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(PresenceServiceServer).ExportPresences(m, &grpc.GenericServerStream[ExportPresencesRequest, httpbody.HttpBody]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type PresenceService_ExportPresencesServer = grpc.ServerStreamingServer[httpbody.HttpBody]
+
 func _PresenceService_Checkin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CheckinRequest)
 	if err := dec(in); err != nil {
@@ -1387,50 +2224,86 @@ func _PresenceService_Checkout_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
-func _PresenceService_UpdatePresence_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdatePresenceRequest)
+func _PresenceService_TogglePresence_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TogglePresenceRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(PresenceServiceServer).UpdatePresence(ctx, in)
+		return srv.(PresenceServiceServer).TogglePresence(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: PresenceService_UpdatePresence_FullMethodName,
+		FullMethod: PresenceService_TogglePresence_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PresenceServiceServer).UpdatePresence(ctx, req.(*UpdatePresenceRequest))
+		return srv.(PresenceServiceServer).TogglePresence(ctx, req.(*TogglePresenceRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _PresenceService_DeletePresence_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeletePresenceRequest)
+func _PresenceService_CheckinByCard_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckinByCardRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(PresenceServiceServer).DeletePresence(ctx, in)
+		return srv.(PresenceServiceServer).CheckinByCard(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: PresenceService_DeletePresence_FullMethodName,
+		FullMethod: PresenceService_CheckinByCard_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PresenceServiceServer).DeletePresence(ctx, req.(*DeletePresenceRequest))
+		return srv.(PresenceServiceServer).CheckinByCard(ctx, req.(*CheckinByCardRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-// PresenceService_ServiceDesc is the grpc.ServiceDesc for PresenceService service.
-// It's only intended for direct use with grpc.RegisterService,
-// and not to be introspected or modified (even as a copy)
-var PresenceService_ServiceDesc = grpc.ServiceDesc{
-	ServiceName: "ourspace_backend.proto.PresenceService",
-	HandlerType: (*PresenceServiceServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
+func _PresenceService_UpdatePresence_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdatePresenceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PresenceServiceServer).UpdatePresence(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PresenceService_UpdatePresence_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PresenceServiceServer).UpdatePresence(ctx, req.(*UpdatePresenceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PresenceService_DeletePresence_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeletePresenceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PresenceServiceServer).DeletePresence(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PresenceService_DeletePresence_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PresenceServiceServer).DeletePresence(ctx, req.(*DeletePresenceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// PresenceService_ServiceDesc is the grpc.ServiceDesc for PresenceService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var PresenceService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "ourspace_backend.proto.PresenceService",
+	HandlerType: (*PresenceServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
 			MethodName: "ListPresences",
 			Handler:    _PresenceService_ListPresences_Handler,
 		},
@@ -1442,6 +2315,14 @@ var PresenceService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Checkout",
 			Handler:    _PresenceService_Checkout_Handler,
 		},
+		{
+			MethodName: "TogglePresence",
+			Handler:    _PresenceService_TogglePresence_Handler,
+		},
+		{
+			MethodName: "CheckinByCard",
+			Handler:    _PresenceService_CheckinByCard_Handler,
+		},
 		{
 			MethodName: "UpdatePresence",
 			Handler:    _PresenceService_UpdatePresence_Handler,
@@ -1451,6 +2332,3220 @@ var PresenceService_ServiceDesc = grpc.ServiceDesc{
 			Handler:    _PresenceService_DeletePresence_Handler,
 		},
 	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ExportPresences",
+			Handler:       _PresenceService_ExportPresences_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "ourspace-backend/proto/api.proto",
+}
+
+const (
+	EventService_CreateEvent_FullMethodName             = "/ourspace_backend.proto.EventService/CreateEvent"
+	EventService_GetEvent_FullMethodName                = "/ourspace_backend.proto.EventService/GetEvent"
+	EventService_ListEvents_FullMethodName              = "/ourspace_backend.proto.EventService/ListEvents"
+	EventService_UpdateEvent_FullMethodName             = "/ourspace_backend.proto.EventService/UpdateEvent"
+	EventService_DeleteEvent_FullMethodName             = "/ourspace_backend.proto.EventService/DeleteEvent"
+	EventService_RegisterForEvent_FullMethodName        = "/ourspace_backend.proto.EventService/RegisterForEvent"
+	EventService_CancelEventRegistration_FullMethodName = "/ourspace_backend.proto.EventService/CancelEventRegistration"
+	EventService_ListEventRegistrations_FullMethodName  = "/ourspace_backend.proto.EventService/ListEventRegistrations"
+	EventService_MarkEventAttendance_FullMethodName     = "/ourspace_backend.proto.EventService/MarkEventAttendance"
+)
+
+// EventServiceClient is the client API for EventService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type EventServiceClient interface {
+	CreateEvent(ctx context.Context, in *CreateEventRequest, opts ...grpc.CallOption) (*Event, error)
+	GetEvent(ctx context.Context, in *GetEventRequest, opts ...grpc.CallOption) (*Event, error)
+	ListEvents(ctx context.Context, in *ListEventsRequest, opts ...grpc.CallOption) (*ListEventsResponse, error)
+	UpdateEvent(ctx context.Context, in *UpdateEventRequest, opts ...grpc.CallOption) (*Event, error)
+	DeleteEvent(ctx context.Context, in *DeleteEventRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	RegisterForEvent(ctx context.Context, in *RegisterForEventRequest, opts ...grpc.CallOption) (*EventRegistration, error)
+	CancelEventRegistration(ctx context.Context, in *CancelEventRegistrationRequest, opts ...grpc.CallOption) (*EventRegistration, error)
+	ListEventRegistrations(ctx context.Context, in *ListEventRegistrationsRequest, opts ...grpc.CallOption) (*ListEventRegistrationsResponse, error)
+	MarkEventAttendance(ctx context.Context, in *MarkEventAttendanceRequest, opts ...grpc.CallOption) (*EventRegistration, error)
+}
+
+type eventServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewEventServiceClient(cc grpc.ClientConnInterface) EventServiceClient {
+	return &eventServiceClient{cc}
+}
+
+func (c *eventServiceClient) CreateEvent(ctx context.Context, in *CreateEventRequest, opts ...grpc.CallOption) (*Event, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Event)
+	err := c.cc.Invoke(ctx, EventService_CreateEvent_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventServiceClient) GetEvent(ctx context.Context, in *GetEventRequest, opts ...grpc.CallOption) (*Event, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Event)
+	err := c.cc.Invoke(ctx, EventService_GetEvent_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventServiceClient) ListEvents(ctx context.Context, in *ListEventsRequest, opts ...grpc.CallOption) (*ListEventsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListEventsResponse)
+	err := c.cc.Invoke(ctx, EventService_ListEvents_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventServiceClient) UpdateEvent(ctx context.Context, in *UpdateEventRequest, opts ...grpc.CallOption) (*Event, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Event)
+	err := c.cc.Invoke(ctx, EventService_UpdateEvent_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventServiceClient) DeleteEvent(ctx context.Context, in *DeleteEventRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, EventService_DeleteEvent_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventServiceClient) RegisterForEvent(ctx context.Context, in *RegisterForEventRequest, opts ...grpc.CallOption) (*EventRegistration, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EventRegistration)
+	err := c.cc.Invoke(ctx, EventService_RegisterForEvent_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventServiceClient) CancelEventRegistration(ctx context.Context, in *CancelEventRegistrationRequest, opts ...grpc.CallOption) (*EventRegistration, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EventRegistration)
+	err := c.cc.Invoke(ctx, EventService_CancelEventRegistration_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventServiceClient) ListEventRegistrations(ctx context.Context, in *ListEventRegistrationsRequest, opts ...grpc.CallOption) (*ListEventRegistrationsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListEventRegistrationsResponse)
+	err := c.cc.Invoke(ctx, EventService_ListEventRegistrations_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventServiceClient) MarkEventAttendance(ctx context.Context, in *MarkEventAttendanceRequest, opts ...grpc.CallOption) (*EventRegistration, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EventRegistration)
+	err := c.cc.Invoke(ctx, EventService_MarkEventAttendance_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// EventServiceServer is the server API for EventService service.
+// All implementations must embed UnimplementedEventServiceServer
+// for forward compatibility.
+type EventServiceServer interface {
+	CreateEvent(context.Context, *CreateEventRequest) (*Event, error)
+	GetEvent(context.Context, *GetEventRequest) (*Event, error)
+	ListEvents(context.Context, *ListEventsRequest) (*ListEventsResponse, error)
+	UpdateEvent(context.Context, *UpdateEventRequest) (*Event, error)
+	DeleteEvent(context.Context, *DeleteEventRequest) (*emptypb.Empty, error)
+	RegisterForEvent(context.Context, *RegisterForEventRequest) (*EventRegistration, error)
+	CancelEventRegistration(context.Context, *CancelEventRegistrationRequest) (*EventRegistration, error)
+	ListEventRegistrations(context.Context, *ListEventRegistrationsRequest) (*ListEventRegistrationsResponse, error)
+	MarkEventAttendance(context.Context, *MarkEventAttendanceRequest) (*EventRegistration, error)
+	mustEmbedUnimplementedEventServiceServer()
+}
+
+// UnimplementedEventServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedEventServiceServer struct{}
+
+func (UnimplementedEventServiceServer) CreateEvent(context.Context, *CreateEventRequest) (*Event, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateEvent not implemented")
+}
+func (UnimplementedEventServiceServer) GetEvent(context.Context, *GetEventRequest) (*Event, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetEvent not implemented")
+}
+func (UnimplementedEventServiceServer) ListEvents(context.Context, *ListEventsRequest) (*ListEventsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListEvents not implemented")
+}
+func (UnimplementedEventServiceServer) UpdateEvent(context.Context, *UpdateEventRequest) (*Event, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateEvent not implemented")
+}
+func (UnimplementedEventServiceServer) DeleteEvent(context.Context, *DeleteEventRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteEvent not implemented")
+}
+func (UnimplementedEventServiceServer) RegisterForEvent(context.Context, *RegisterForEventRequest) (*EventRegistration, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterForEvent not implemented")
+}
+func (UnimplementedEventServiceServer) CancelEventRegistration(context.Context, *CancelEventRegistrationRequest) (*EventRegistration, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelEventRegistration not implemented")
+}
+func (UnimplementedEventServiceServer) ListEventRegistrations(context.Context, *ListEventRegistrationsRequest) (*ListEventRegistrationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListEventRegistrations not implemented")
+}
+func (UnimplementedEventServiceServer) MarkEventAttendance(context.Context, *MarkEventAttendanceRequest) (*EventRegistration, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MarkEventAttendance not implemented")
+}
+func (UnimplementedEventServiceServer) mustEmbedUnimplementedEventServiceServer() {}
+func (UnimplementedEventServiceServer) testEmbeddedByValue()                      {}
+
+// UnsafeEventServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to EventServiceServer will
+// result in compilation errors.
+type UnsafeEventServiceServer interface {
+	mustEmbedUnimplementedEventServiceServer()
+}
+
+func RegisterEventServiceServer(s grpc.ServiceRegistrar, srv EventServiceServer) {
+	// If the following call pancis, it indicates UnimplementedEventServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&EventService_ServiceDesc, srv)
+}
+
+func _EventService_CreateEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateEventRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceServer).CreateEvent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventService_CreateEvent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceServer).CreateEvent(ctx, req.(*CreateEventRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventService_GetEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetEventRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceServer).GetEvent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventService_GetEvent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceServer).GetEvent(ctx, req.(*GetEventRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventService_ListEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListEventsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceServer).ListEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventService_ListEvents_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceServer).ListEvents(ctx, req.(*ListEventsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventService_UpdateEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateEventRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceServer).UpdateEvent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventService_UpdateEvent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceServer).UpdateEvent(ctx, req.(*UpdateEventRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventService_DeleteEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteEventRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceServer).DeleteEvent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventService_DeleteEvent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceServer).DeleteEvent(ctx, req.(*DeleteEventRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventService_RegisterForEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RegisterForEventRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceServer).RegisterForEvent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventService_RegisterForEvent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceServer).RegisterForEvent(ctx, req.(*RegisterForEventRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventService_CancelEventRegistration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelEventRegistrationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceServer).CancelEventRegistration(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventService_CancelEventRegistration_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceServer).CancelEventRegistration(ctx, req.(*CancelEventRegistrationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventService_ListEventRegistrations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListEventRegistrationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceServer).ListEventRegistrations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventService_ListEventRegistrations_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceServer).ListEventRegistrations(ctx, req.(*ListEventRegistrationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventService_MarkEventAttendance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MarkEventAttendanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceServer).MarkEventAttendance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventService_MarkEventAttendance_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceServer).MarkEventAttendance(ctx, req.(*MarkEventAttendanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// EventService_ServiceDesc is the grpc.ServiceDesc for EventService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var EventService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "ourspace_backend.proto.EventService",
+	HandlerType: (*EventServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateEvent",
+			Handler:    _EventService_CreateEvent_Handler,
+		},
+		{
+			MethodName: "GetEvent",
+			Handler:    _EventService_GetEvent_Handler,
+		},
+		{
+			MethodName: "ListEvents",
+			Handler:    _EventService_ListEvents_Handler,
+		},
+		{
+			MethodName: "UpdateEvent",
+			Handler:    _EventService_UpdateEvent_Handler,
+		},
+		{
+			MethodName: "DeleteEvent",
+			Handler:    _EventService_DeleteEvent_Handler,
+		},
+		{
+			MethodName: "RegisterForEvent",
+			Handler:    _EventService_RegisterForEvent_Handler,
+		},
+		{
+			MethodName: "CancelEventRegistration",
+			Handler:    _EventService_CancelEventRegistration_Handler,
+		},
+		{
+			MethodName: "ListEventRegistrations",
+			Handler:    _EventService_ListEventRegistrations_Handler,
+		},
+		{
+			MethodName: "MarkEventAttendance",
+			Handler:    _EventService_MarkEventAttendance_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "ourspace-backend/proto/api.proto",
+}
+
+const (
+	LendingService_CreateItem_FullMethodName       = "/ourspace_backend.proto.LendingService/CreateItem"
+	LendingService_GetItem_FullMethodName          = "/ourspace_backend.proto.LendingService/GetItem"
+	LendingService_ListItems_FullMethodName        = "/ourspace_backend.proto.LendingService/ListItems"
+	LendingService_UpdateItem_FullMethodName       = "/ourspace_backend.proto.LendingService/UpdateItem"
+	LendingService_DeleteItem_FullMethodName       = "/ourspace_backend.proto.LendingService/DeleteItem"
+	LendingService_LendItem_FullMethodName         = "/ourspace_backend.proto.LendingService/LendItem"
+	LendingService_LendItemByScan_FullMethodName   = "/ourspace_backend.proto.LendingService/LendItemByScan"
+	LendingService_ReturnItem_FullMethodName       = "/ourspace_backend.proto.LendingService/ReturnItem"
+	LendingService_ReturnItemByScan_FullMethodName = "/ourspace_backend.proto.LendingService/ReturnItemByScan"
+	LendingService_GetLoan_FullMethodName          = "/ourspace_backend.proto.LendingService/GetLoan"
+	LendingService_ListLoans_FullMethodName        = "/ourspace_backend.proto.LendingService/ListLoans"
+)
+
+// LendingServiceClient is the client API for LendingService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type LendingServiceClient interface {
+	CreateItem(ctx context.Context, in *CreateItemRequest, opts ...grpc.CallOption) (*Item, error)
+	GetItem(ctx context.Context, in *GetItemRequest, opts ...grpc.CallOption) (*Item, error)
+	ListItems(ctx context.Context, in *ListItemsRequest, opts ...grpc.CallOption) (*ListItemsResponse, error)
+	UpdateItem(ctx context.Context, in *UpdateItemRequest, opts ...grpc.CallOption) (*Item, error)
+	DeleteItem(ctx context.Context, in *DeleteItemRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	LendItem(ctx context.Context, in *LendItemRequest, opts ...grpc.CallOption) (*Loan, error)
+	LendItemByScan(ctx context.Context, in *LendItemByScanRequest, opts ...grpc.CallOption) (*Loan, error)
+	ReturnItem(ctx context.Context, in *ReturnItemRequest, opts ...grpc.CallOption) (*Loan, error)
+	ReturnItemByScan(ctx context.Context, in *ReturnItemByScanRequest, opts ...grpc.CallOption) (*Loan, error)
+	GetLoan(ctx context.Context, in *GetLoanRequest, opts ...grpc.CallOption) (*Loan, error)
+	ListLoans(ctx context.Context, in *ListLoansRequest, opts ...grpc.CallOption) (*ListLoansResponse, error)
+}
+
+type lendingServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewLendingServiceClient(cc grpc.ClientConnInterface) LendingServiceClient {
+	return &lendingServiceClient{cc}
+}
+
+func (c *lendingServiceClient) CreateItem(ctx context.Context, in *CreateItemRequest, opts ...grpc.CallOption) (*Item, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Item)
+	err := c.cc.Invoke(ctx, LendingService_CreateItem_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lendingServiceClient) GetItem(ctx context.Context, in *GetItemRequest, opts ...grpc.CallOption) (*Item, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Item)
+	err := c.cc.Invoke(ctx, LendingService_GetItem_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lendingServiceClient) ListItems(ctx context.Context, in *ListItemsRequest, opts ...grpc.CallOption) (*ListItemsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListItemsResponse)
+	err := c.cc.Invoke(ctx, LendingService_ListItems_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lendingServiceClient) UpdateItem(ctx context.Context, in *UpdateItemRequest, opts ...grpc.CallOption) (*Item, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Item)
+	err := c.cc.Invoke(ctx, LendingService_UpdateItem_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lendingServiceClient) DeleteItem(ctx context.Context, in *DeleteItemRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, LendingService_DeleteItem_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lendingServiceClient) LendItem(ctx context.Context, in *LendItemRequest, opts ...grpc.CallOption) (*Loan, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Loan)
+	err := c.cc.Invoke(ctx, LendingService_LendItem_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lendingServiceClient) LendItemByScan(ctx context.Context, in *LendItemByScanRequest, opts ...grpc.CallOption) (*Loan, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Loan)
+	err := c.cc.Invoke(ctx, LendingService_LendItemByScan_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lendingServiceClient) ReturnItem(ctx context.Context, in *ReturnItemRequest, opts ...grpc.CallOption) (*Loan, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Loan)
+	err := c.cc.Invoke(ctx, LendingService_ReturnItem_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lendingServiceClient) ReturnItemByScan(ctx context.Context, in *ReturnItemByScanRequest, opts ...grpc.CallOption) (*Loan, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Loan)
+	err := c.cc.Invoke(ctx, LendingService_ReturnItemByScan_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lendingServiceClient) GetLoan(ctx context.Context, in *GetLoanRequest, opts ...grpc.CallOption) (*Loan, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Loan)
+	err := c.cc.Invoke(ctx, LendingService_GetLoan_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lendingServiceClient) ListLoans(ctx context.Context, in *ListLoansRequest, opts ...grpc.CallOption) (*ListLoansResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListLoansResponse)
+	err := c.cc.Invoke(ctx, LendingService_ListLoans_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// LendingServiceServer is the server API for LendingService service.
+// All implementations must embed UnimplementedLendingServiceServer
+// for forward compatibility.
+type LendingServiceServer interface {
+	CreateItem(context.Context, *CreateItemRequest) (*Item, error)
+	GetItem(context.Context, *GetItemRequest) (*Item, error)
+	ListItems(context.Context, *ListItemsRequest) (*ListItemsResponse, error)
+	UpdateItem(context.Context, *UpdateItemRequest) (*Item, error)
+	DeleteItem(context.Context, *DeleteItemRequest) (*emptypb.Empty, error)
+	LendItem(context.Context, *LendItemRequest) (*Loan, error)
+	LendItemByScan(context.Context, *LendItemByScanRequest) (*Loan, error)
+	ReturnItem(context.Context, *ReturnItemRequest) (*Loan, error)
+	ReturnItemByScan(context.Context, *ReturnItemByScanRequest) (*Loan, error)
+	GetLoan(context.Context, *GetLoanRequest) (*Loan, error)
+	ListLoans(context.Context, *ListLoansRequest) (*ListLoansResponse, error)
+	mustEmbedUnimplementedLendingServiceServer()
+}
+
+// UnimplementedLendingServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedLendingServiceServer struct{}
+
+func (UnimplementedLendingServiceServer) CreateItem(context.Context, *CreateItemRequest) (*Item, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateItem not implemented")
+}
+func (UnimplementedLendingServiceServer) GetItem(context.Context, *GetItemRequest) (*Item, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetItem not implemented")
+}
+func (UnimplementedLendingServiceServer) ListItems(context.Context, *ListItemsRequest) (*ListItemsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListItems not implemented")
+}
+func (UnimplementedLendingServiceServer) UpdateItem(context.Context, *UpdateItemRequest) (*Item, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateItem not implemented")
+}
+func (UnimplementedLendingServiceServer) DeleteItem(context.Context, *DeleteItemRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteItem not implemented")
+}
+func (UnimplementedLendingServiceServer) LendItem(context.Context, *LendItemRequest) (*Loan, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LendItem not implemented")
+}
+func (UnimplementedLendingServiceServer) LendItemByScan(context.Context, *LendItemByScanRequest) (*Loan, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LendItemByScan not implemented")
+}
+func (UnimplementedLendingServiceServer) ReturnItem(context.Context, *ReturnItemRequest) (*Loan, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReturnItem not implemented")
+}
+func (UnimplementedLendingServiceServer) ReturnItemByScan(context.Context, *ReturnItemByScanRequest) (*Loan, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReturnItemByScan not implemented")
+}
+func (UnimplementedLendingServiceServer) GetLoan(context.Context, *GetLoanRequest) (*Loan, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLoan not implemented")
+}
+func (UnimplementedLendingServiceServer) ListLoans(context.Context, *ListLoansRequest) (*ListLoansResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListLoans not implemented")
+}
+func (UnimplementedLendingServiceServer) mustEmbedUnimplementedLendingServiceServer() {}
+func (UnimplementedLendingServiceServer) testEmbeddedByValue()                        {}
+
+// UnsafeLendingServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to LendingServiceServer will
+// result in compilation errors.
+type UnsafeLendingServiceServer interface {
+	mustEmbedUnimplementedLendingServiceServer()
+}
+
+func RegisterLendingServiceServer(s grpc.ServiceRegistrar, srv LendingServiceServer) {
+	// If the following call pancis, it indicates UnimplementedLendingServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&LendingService_ServiceDesc, srv)
+}
+
+func _LendingService_CreateItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateItemRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LendingServiceServer).CreateItem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LendingService_CreateItem_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LendingServiceServer).CreateItem(ctx, req.(*CreateItemRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LendingService_GetItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetItemRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LendingServiceServer).GetItem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LendingService_GetItem_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LendingServiceServer).GetItem(ctx, req.(*GetItemRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LendingService_ListItems_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListItemsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LendingServiceServer).ListItems(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LendingService_ListItems_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LendingServiceServer).ListItems(ctx, req.(*ListItemsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LendingService_UpdateItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateItemRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LendingServiceServer).UpdateItem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LendingService_UpdateItem_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LendingServiceServer).UpdateItem(ctx, req.(*UpdateItemRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LendingService_DeleteItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteItemRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LendingServiceServer).DeleteItem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LendingService_DeleteItem_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LendingServiceServer).DeleteItem(ctx, req.(*DeleteItemRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LendingService_LendItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LendItemRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LendingServiceServer).LendItem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LendingService_LendItem_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LendingServiceServer).LendItem(ctx, req.(*LendItemRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LendingService_LendItemByScan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LendItemByScanRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LendingServiceServer).LendItemByScan(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LendingService_LendItemByScan_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LendingServiceServer).LendItemByScan(ctx, req.(*LendItemByScanRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LendingService_ReturnItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReturnItemRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LendingServiceServer).ReturnItem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LendingService_ReturnItem_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LendingServiceServer).ReturnItem(ctx, req.(*ReturnItemRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LendingService_ReturnItemByScan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReturnItemByScanRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LendingServiceServer).ReturnItemByScan(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LendingService_ReturnItemByScan_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LendingServiceServer).ReturnItemByScan(ctx, req.(*ReturnItemByScanRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LendingService_GetLoan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLoanRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LendingServiceServer).GetLoan(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LendingService_GetLoan_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LendingServiceServer).GetLoan(ctx, req.(*GetLoanRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LendingService_ListLoans_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListLoansRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LendingServiceServer).ListLoans(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LendingService_ListLoans_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LendingServiceServer).ListLoans(ctx, req.(*ListLoansRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// LendingService_ServiceDesc is the grpc.ServiceDesc for LendingService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var LendingService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "ourspace_backend.proto.LendingService",
+	HandlerType: (*LendingServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateItem",
+			Handler:    _LendingService_CreateItem_Handler,
+		},
+		{
+			MethodName: "GetItem",
+			Handler:    _LendingService_GetItem_Handler,
+		},
+		{
+			MethodName: "ListItems",
+			Handler:    _LendingService_ListItems_Handler,
+		},
+		{
+			MethodName: "UpdateItem",
+			Handler:    _LendingService_UpdateItem_Handler,
+		},
+		{
+			MethodName: "DeleteItem",
+			Handler:    _LendingService_DeleteItem_Handler,
+		},
+		{
+			MethodName: "LendItem",
+			Handler:    _LendingService_LendItem_Handler,
+		},
+		{
+			MethodName: "LendItemByScan",
+			Handler:    _LendingService_LendItemByScan_Handler,
+		},
+		{
+			MethodName: "ReturnItem",
+			Handler:    _LendingService_ReturnItem_Handler,
+		},
+		{
+			MethodName: "ReturnItemByScan",
+			Handler:    _LendingService_ReturnItemByScan_Handler,
+		},
+		{
+			MethodName: "GetLoan",
+			Handler:    _LendingService_GetLoan_Handler,
+		},
+		{
+			MethodName: "ListLoans",
+			Handler:    _LendingService_ListLoans_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "ourspace-backend/proto/api.proto",
+}
+
+const (
+	MachineService_CreateMachine_FullMethodName     = "/ourspace_backend.proto.MachineService/CreateMachine"
+	MachineService_GetMachine_FullMethodName        = "/ourspace_backend.proto.MachineService/GetMachine"
+	MachineService_ListMachines_FullMethodName      = "/ourspace_backend.proto.MachineService/ListMachines"
+	MachineService_UpdateMachine_FullMethodName     = "/ourspace_backend.proto.MachineService/UpdateMachine"
+	MachineService_DeleteMachine_FullMethodName     = "/ourspace_backend.proto.MachineService/DeleteMachine"
+	MachineService_StartUsageSession_FullMethodName = "/ourspace_backend.proto.MachineService/StartUsageSession"
+	MachineService_StopUsageSession_FullMethodName  = "/ourspace_backend.proto.MachineService/StopUsageSession"
+	MachineService_ListUsageSessions_FullMethodName = "/ourspace_backend.proto.MachineService/ListUsageSessions"
+)
+
+// MachineServiceClient is the client API for MachineService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type MachineServiceClient interface {
+	CreateMachine(ctx context.Context, in *CreateMachineRequest, opts ...grpc.CallOption) (*Machine, error)
+	GetMachine(ctx context.Context, in *GetMachineRequest, opts ...grpc.CallOption) (*Machine, error)
+	ListMachines(ctx context.Context, in *ListMachinesRequest, opts ...grpc.CallOption) (*ListMachinesResponse, error)
+	UpdateMachine(ctx context.Context, in *UpdateMachineRequest, opts ...grpc.CallOption) (*Machine, error)
+	DeleteMachine(ctx context.Context, in *DeleteMachineRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	StartUsageSession(ctx context.Context, in *StartUsageSessionRequest, opts ...grpc.CallOption) (*UsageSession, error)
+	StopUsageSession(ctx context.Context, in *StopUsageSessionRequest, opts ...grpc.CallOption) (*UsageSession, error)
+	ListUsageSessions(ctx context.Context, in *ListUsageSessionsRequest, opts ...grpc.CallOption) (*ListUsageSessionsResponse, error)
+}
+
+type machineServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewMachineServiceClient(cc grpc.ClientConnInterface) MachineServiceClient {
+	return &machineServiceClient{cc}
+}
+
+func (c *machineServiceClient) CreateMachine(ctx context.Context, in *CreateMachineRequest, opts ...grpc.CallOption) (*Machine, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Machine)
+	err := c.cc.Invoke(ctx, MachineService_CreateMachine_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *machineServiceClient) GetMachine(ctx context.Context, in *GetMachineRequest, opts ...grpc.CallOption) (*Machine, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Machine)
+	err := c.cc.Invoke(ctx, MachineService_GetMachine_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *machineServiceClient) ListMachines(ctx context.Context, in *ListMachinesRequest, opts ...grpc.CallOption) (*ListMachinesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListMachinesResponse)
+	err := c.cc.Invoke(ctx, MachineService_ListMachines_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *machineServiceClient) UpdateMachine(ctx context.Context, in *UpdateMachineRequest, opts ...grpc.CallOption) (*Machine, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Machine)
+	err := c.cc.Invoke(ctx, MachineService_UpdateMachine_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *machineServiceClient) DeleteMachine(ctx context.Context, in *DeleteMachineRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, MachineService_DeleteMachine_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *machineServiceClient) StartUsageSession(ctx context.Context, in *StartUsageSessionRequest, opts ...grpc.CallOption) (*UsageSession, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UsageSession)
+	err := c.cc.Invoke(ctx, MachineService_StartUsageSession_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *machineServiceClient) StopUsageSession(ctx context.Context, in *StopUsageSessionRequest, opts ...grpc.CallOption) (*UsageSession, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UsageSession)
+	err := c.cc.Invoke(ctx, MachineService_StopUsageSession_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *machineServiceClient) ListUsageSessions(ctx context.Context, in *ListUsageSessionsRequest, opts ...grpc.CallOption) (*ListUsageSessionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListUsageSessionsResponse)
+	err := c.cc.Invoke(ctx, MachineService_ListUsageSessions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MachineServiceServer is the server API for MachineService service.
+// All implementations must embed UnimplementedMachineServiceServer
+// for forward compatibility.
+type MachineServiceServer interface {
+	CreateMachine(context.Context, *CreateMachineRequest) (*Machine, error)
+	GetMachine(context.Context, *GetMachineRequest) (*Machine, error)
+	ListMachines(context.Context, *ListMachinesRequest) (*ListMachinesResponse, error)
+	UpdateMachine(context.Context, *UpdateMachineRequest) (*Machine, error)
+	DeleteMachine(context.Context, *DeleteMachineRequest) (*emptypb.Empty, error)
+	StartUsageSession(context.Context, *StartUsageSessionRequest) (*UsageSession, error)
+	StopUsageSession(context.Context, *StopUsageSessionRequest) (*UsageSession, error)
+	ListUsageSessions(context.Context, *ListUsageSessionsRequest) (*ListUsageSessionsResponse, error)
+	mustEmbedUnimplementedMachineServiceServer()
+}
+
+// UnimplementedMachineServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedMachineServiceServer struct{}
+
+func (UnimplementedMachineServiceServer) CreateMachine(context.Context, *CreateMachineRequest) (*Machine, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateMachine not implemented")
+}
+func (UnimplementedMachineServiceServer) GetMachine(context.Context, *GetMachineRequest) (*Machine, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMachine not implemented")
+}
+func (UnimplementedMachineServiceServer) ListMachines(context.Context, *ListMachinesRequest) (*ListMachinesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListMachines not implemented")
+}
+func (UnimplementedMachineServiceServer) UpdateMachine(context.Context, *UpdateMachineRequest) (*Machine, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateMachine not implemented")
+}
+func (UnimplementedMachineServiceServer) DeleteMachine(context.Context, *DeleteMachineRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteMachine not implemented")
+}
+func (UnimplementedMachineServiceServer) StartUsageSession(context.Context, *StartUsageSessionRequest) (*UsageSession, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StartUsageSession not implemented")
+}
+func (UnimplementedMachineServiceServer) StopUsageSession(context.Context, *StopUsageSessionRequest) (*UsageSession, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StopUsageSession not implemented")
+}
+func (UnimplementedMachineServiceServer) ListUsageSessions(context.Context, *ListUsageSessionsRequest) (*ListUsageSessionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListUsageSessions not implemented")
+}
+func (UnimplementedMachineServiceServer) mustEmbedUnimplementedMachineServiceServer() {}
+func (UnimplementedMachineServiceServer) testEmbeddedByValue()                        {}
+
+// UnsafeMachineServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to MachineServiceServer will
+// result in compilation errors.
+type UnsafeMachineServiceServer interface {
+	mustEmbedUnimplementedMachineServiceServer()
+}
+
+func RegisterMachineServiceServer(s grpc.ServiceRegistrar, srv MachineServiceServer) {
+	// If the following call pancis, it indicates UnimplementedMachineServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&MachineService_ServiceDesc, srv)
+}
+
+func _MachineService_CreateMachine_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateMachineRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MachineServiceServer).CreateMachine(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MachineService_CreateMachine_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MachineServiceServer).CreateMachine(ctx, req.(*CreateMachineRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MachineService_GetMachine_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMachineRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MachineServiceServer).GetMachine(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MachineService_GetMachine_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MachineServiceServer).GetMachine(ctx, req.(*GetMachineRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MachineService_ListMachines_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMachinesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MachineServiceServer).ListMachines(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MachineService_ListMachines_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MachineServiceServer).ListMachines(ctx, req.(*ListMachinesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MachineService_UpdateMachine_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateMachineRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MachineServiceServer).UpdateMachine(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MachineService_UpdateMachine_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MachineServiceServer).UpdateMachine(ctx, req.(*UpdateMachineRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MachineService_DeleteMachine_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteMachineRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MachineServiceServer).DeleteMachine(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MachineService_DeleteMachine_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MachineServiceServer).DeleteMachine(ctx, req.(*DeleteMachineRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MachineService_StartUsageSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StartUsageSessionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MachineServiceServer).StartUsageSession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MachineService_StartUsageSession_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MachineServiceServer).StartUsageSession(ctx, req.(*StartUsageSessionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MachineService_StopUsageSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StopUsageSessionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MachineServiceServer).StopUsageSession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MachineService_StopUsageSession_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MachineServiceServer).StopUsageSession(ctx, req.(*StopUsageSessionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MachineService_ListUsageSessions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListUsageSessionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MachineServiceServer).ListUsageSessions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MachineService_ListUsageSessions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MachineServiceServer).ListUsageSessions(ctx, req.(*ListUsageSessionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// MachineService_ServiceDesc is the grpc.ServiceDesc for MachineService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var MachineService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "ourspace_backend.proto.MachineService",
+	HandlerType: (*MachineServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateMachine",
+			Handler:    _MachineService_CreateMachine_Handler,
+		},
+		{
+			MethodName: "GetMachine",
+			Handler:    _MachineService_GetMachine_Handler,
+		},
+		{
+			MethodName: "ListMachines",
+			Handler:    _MachineService_ListMachines_Handler,
+		},
+		{
+			MethodName: "UpdateMachine",
+			Handler:    _MachineService_UpdateMachine_Handler,
+		},
+		{
+			MethodName: "DeleteMachine",
+			Handler:    _MachineService_DeleteMachine_Handler,
+		},
+		{
+			MethodName: "StartUsageSession",
+			Handler:    _MachineService_StartUsageSession_Handler,
+		},
+		{
+			MethodName: "StopUsageSession",
+			Handler:    _MachineService_StopUsageSession_Handler,
+		},
+		{
+			MethodName: "ListUsageSessions",
+			Handler:    _MachineService_ListUsageSessions_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "ourspace-backend/proto/api.proto",
+}
+
+const (
+	AccessService_CheckAccess_FullMethodName         = "/ourspace_backend.proto.AccessService/CheckAccess"
+	AccessService_ListAccessDecisions_FullMethodName = "/ourspace_backend.proto.AccessService/ListAccessDecisions"
+)
+
+// AccessServiceClient is the client API for AccessService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type AccessServiceClient interface {
+	CheckAccess(ctx context.Context, in *CheckAccessRequest, opts ...grpc.CallOption) (*AccessDecision, error)
+	ListAccessDecisions(ctx context.Context, in *ListAccessDecisionsRequest, opts ...grpc.CallOption) (*ListAccessDecisionsResponse, error)
+}
+
+type accessServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewAccessServiceClient(cc grpc.ClientConnInterface) AccessServiceClient {
+	return &accessServiceClient{cc}
+}
+
+func (c *accessServiceClient) CheckAccess(ctx context.Context, in *CheckAccessRequest, opts ...grpc.CallOption) (*AccessDecision, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AccessDecision)
+	err := c.cc.Invoke(ctx, AccessService_CheckAccess_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accessServiceClient) ListAccessDecisions(ctx context.Context, in *ListAccessDecisionsRequest, opts ...grpc.CallOption) (*ListAccessDecisionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListAccessDecisionsResponse)
+	err := c.cc.Invoke(ctx, AccessService_ListAccessDecisions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// AccessServiceServer is the server API for AccessService service.
+// All implementations must embed UnimplementedAccessServiceServer
+// for forward compatibility.
+type AccessServiceServer interface {
+	CheckAccess(context.Context, *CheckAccessRequest) (*AccessDecision, error)
+	ListAccessDecisions(context.Context, *ListAccessDecisionsRequest) (*ListAccessDecisionsResponse, error)
+	mustEmbedUnimplementedAccessServiceServer()
+}
+
+// UnimplementedAccessServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedAccessServiceServer struct{}
+
+func (UnimplementedAccessServiceServer) CheckAccess(context.Context, *CheckAccessRequest) (*AccessDecision, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckAccess not implemented")
+}
+func (UnimplementedAccessServiceServer) ListAccessDecisions(context.Context, *ListAccessDecisionsRequest) (*ListAccessDecisionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAccessDecisions not implemented")
+}
+func (UnimplementedAccessServiceServer) mustEmbedUnimplementedAccessServiceServer() {}
+func (UnimplementedAccessServiceServer) testEmbeddedByValue()                       {}
+
+// UnsafeAccessServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to AccessServiceServer will
+// result in compilation errors.
+type UnsafeAccessServiceServer interface {
+	mustEmbedUnimplementedAccessServiceServer()
+}
+
+func RegisterAccessServiceServer(s grpc.ServiceRegistrar, srv AccessServiceServer) {
+	// If the following call pancis, it indicates UnimplementedAccessServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&AccessService_ServiceDesc, srv)
+}
+
+func _AccessService_CheckAccess_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckAccessRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccessServiceServer).CheckAccess(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AccessService_CheckAccess_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccessServiceServer).CheckAccess(ctx, req.(*CheckAccessRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccessService_ListAccessDecisions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAccessDecisionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccessServiceServer).ListAccessDecisions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AccessService_ListAccessDecisions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccessServiceServer).ListAccessDecisions(ctx, req.(*ListAccessDecisionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// AccessService_ServiceDesc is the grpc.ServiceDesc for AccessService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var AccessService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "ourspace_backend.proto.AccessService",
+	HandlerType: (*AccessServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CheckAccess",
+			Handler:    _AccessService_CheckAccess_Handler,
+		},
+		{
+			MethodName: "ListAccessDecisions",
+			Handler:    _AccessService_ListAccessDecisions_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "ourspace-backend/proto/api.proto",
+}
+
+const (
+	FeeService_CreateMembershipPlan_FullMethodName  = "/ourspace_backend.proto.FeeService/CreateMembershipPlan"
+	FeeService_GetMembershipPlan_FullMethodName     = "/ourspace_backend.proto.FeeService/GetMembershipPlan"
+	FeeService_ListMembershipPlans_FullMethodName   = "/ourspace_backend.proto.FeeService/ListMembershipPlans"
+	FeeService_UpdateMembershipPlan_FullMethodName  = "/ourspace_backend.proto.FeeService/UpdateMembershipPlan"
+	FeeService_DeleteMembershipPlan_FullMethodName  = "/ourspace_backend.proto.FeeService/DeleteMembershipPlan"
+	FeeService_AssignMembershipPlan_FullMethodName  = "/ourspace_backend.proto.FeeService/AssignMembershipPlan"
+	FeeService_ListPlanAssignments_FullMethodName   = "/ourspace_backend.proto.FeeService/ListPlanAssignments"
+	FeeService_EndPlanAssignment_FullMethodName     = "/ourspace_backend.proto.FeeService/EndPlanAssignment"
+	FeeService_GenerateInvoices_FullMethodName      = "/ourspace_backend.proto.FeeService/GenerateInvoices"
+	FeeService_ListInvoices_FullMethodName          = "/ourspace_backend.proto.FeeService/ListInvoices"
+	FeeService_CancelInvoice_FullMethodName         = "/ourspace_backend.proto.FeeService/CancelInvoice"
+	FeeService_RecordPayment_FullMethodName         = "/ourspace_backend.proto.FeeService/RecordPayment"
+	FeeService_ListPayments_FullMethodName          = "/ourspace_backend.proto.FeeService/ListPayments"
+	FeeService_DeletePayment_FullMethodName         = "/ourspace_backend.proto.FeeService/DeletePayment"
+	FeeService_ListBalances_FullMethodName          = "/ourspace_backend.proto.FeeService/ListBalances"
+	FeeService_SetSepaMandate_FullMethodName        = "/ourspace_backend.proto.FeeService/SetSepaMandate"
+	FeeService_GetSepaMandate_FullMethodName        = "/ourspace_backend.proto.FeeService/GetSepaMandate"
+	FeeService_DeleteSepaMandate_FullMethodName     = "/ourspace_backend.proto.FeeService/DeleteSepaMandate"
+	FeeService_ExportSepaDirectDebit_FullMethodName = "/ourspace_backend.proto.FeeService/ExportSepaDirectDebit"
+)
+
+// FeeServiceClient is the client API for FeeService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type FeeServiceClient interface {
+	CreateMembershipPlan(ctx context.Context, in *CreateMembershipPlanRequest, opts ...grpc.CallOption) (*MembershipPlan, error)
+	GetMembershipPlan(ctx context.Context, in *GetMembershipPlanRequest, opts ...grpc.CallOption) (*MembershipPlan, error)
+	ListMembershipPlans(ctx context.Context, in *ListMembershipPlansRequest, opts ...grpc.CallOption) (*ListMembershipPlansResponse, error)
+	UpdateMembershipPlan(ctx context.Context, in *UpdateMembershipPlanRequest, opts ...grpc.CallOption) (*MembershipPlan, error)
+	DeleteMembershipPlan(ctx context.Context, in *DeleteMembershipPlanRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	AssignMembershipPlan(ctx context.Context, in *AssignMembershipPlanRequest, opts ...grpc.CallOption) (*PlanAssignment, error)
+	ListPlanAssignments(ctx context.Context, in *ListPlanAssignmentsRequest, opts ...grpc.CallOption) (*ListPlanAssignmentsResponse, error)
+	EndPlanAssignment(ctx context.Context, in *EndPlanAssignmentRequest, opts ...grpc.CallOption) (*PlanAssignment, error)
+	GenerateInvoices(ctx context.Context, in *GenerateInvoicesRequest, opts ...grpc.CallOption) (*GenerateInvoicesResponse, error)
+	ListInvoices(ctx context.Context, in *ListInvoicesRequest, opts ...grpc.CallOption) (*ListInvoicesResponse, error)
+	CancelInvoice(ctx context.Context, in *CancelInvoiceRequest, opts ...grpc.CallOption) (*Invoice, error)
+	RecordPayment(ctx context.Context, in *RecordPaymentRequest, opts ...grpc.CallOption) (*Payment, error)
+	ListPayments(ctx context.Context, in *ListPaymentsRequest, opts ...grpc.CallOption) (*ListPaymentsResponse, error)
+	DeletePayment(ctx context.Context, in *DeletePaymentRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	ListBalances(ctx context.Context, in *ListBalancesRequest, opts ...grpc.CallOption) (*ListBalancesResponse, error)
+	SetSepaMandate(ctx context.Context, in *SetSepaMandateRequest, opts ...grpc.CallOption) (*SepaMandate, error)
+	GetSepaMandate(ctx context.Context, in *GetSepaMandateRequest, opts ...grpc.CallOption) (*SepaMandate, error)
+	DeleteSepaMandate(ctx context.Context, in *DeleteSepaMandateRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	ExportSepaDirectDebit(ctx context.Context, in *ExportSepaDirectDebitRequest, opts ...grpc.CallOption) (*SepaDirectDebitExport, error)
+}
+
+type feeServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewFeeServiceClient(cc grpc.ClientConnInterface) FeeServiceClient {
+	return &feeServiceClient{cc}
+}
+
+func (c *feeServiceClient) CreateMembershipPlan(ctx context.Context, in *CreateMembershipPlanRequest, opts ...grpc.CallOption) (*MembershipPlan, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MembershipPlan)
+	err := c.cc.Invoke(ctx, FeeService_CreateMembershipPlan_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *feeServiceClient) GetMembershipPlan(ctx context.Context, in *GetMembershipPlanRequest, opts ...grpc.CallOption) (*MembershipPlan, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MembershipPlan)
+	err := c.cc.Invoke(ctx, FeeService_GetMembershipPlan_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *feeServiceClient) ListMembershipPlans(ctx context.Context, in *ListMembershipPlansRequest, opts ...grpc.CallOption) (*ListMembershipPlansResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListMembershipPlansResponse)
+	err := c.cc.Invoke(ctx, FeeService_ListMembershipPlans_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *feeServiceClient) UpdateMembershipPlan(ctx context.Context, in *UpdateMembershipPlanRequest, opts ...grpc.CallOption) (*MembershipPlan, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MembershipPlan)
+	err := c.cc.Invoke(ctx, FeeService_UpdateMembershipPlan_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *feeServiceClient) DeleteMembershipPlan(ctx context.Context, in *DeleteMembershipPlanRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, FeeService_DeleteMembershipPlan_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *feeServiceClient) AssignMembershipPlan(ctx context.Context, in *AssignMembershipPlanRequest, opts ...grpc.CallOption) (*PlanAssignment, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PlanAssignment)
+	err := c.cc.Invoke(ctx, FeeService_AssignMembershipPlan_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *feeServiceClient) ListPlanAssignments(ctx context.Context, in *ListPlanAssignmentsRequest, opts ...grpc.CallOption) (*ListPlanAssignmentsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListPlanAssignmentsResponse)
+	err := c.cc.Invoke(ctx, FeeService_ListPlanAssignments_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *feeServiceClient) EndPlanAssignment(ctx context.Context, in *EndPlanAssignmentRequest, opts ...grpc.CallOption) (*PlanAssignment, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PlanAssignment)
+	err := c.cc.Invoke(ctx, FeeService_EndPlanAssignment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *feeServiceClient) GenerateInvoices(ctx context.Context, in *GenerateInvoicesRequest, opts ...grpc.CallOption) (*GenerateInvoicesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GenerateInvoicesResponse)
+	err := c.cc.Invoke(ctx, FeeService_GenerateInvoices_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *feeServiceClient) ListInvoices(ctx context.Context, in *ListInvoicesRequest, opts ...grpc.CallOption) (*ListInvoicesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListInvoicesResponse)
+	err := c.cc.Invoke(ctx, FeeService_ListInvoices_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *feeServiceClient) CancelInvoice(ctx context.Context, in *CancelInvoiceRequest, opts ...grpc.CallOption) (*Invoice, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Invoice)
+	err := c.cc.Invoke(ctx, FeeService_CancelInvoice_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *feeServiceClient) RecordPayment(ctx context.Context, in *RecordPaymentRequest, opts ...grpc.CallOption) (*Payment, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Payment)
+	err := c.cc.Invoke(ctx, FeeService_RecordPayment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *feeServiceClient) ListPayments(ctx context.Context, in *ListPaymentsRequest, opts ...grpc.CallOption) (*ListPaymentsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListPaymentsResponse)
+	err := c.cc.Invoke(ctx, FeeService_ListPayments_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *feeServiceClient) DeletePayment(ctx context.Context, in *DeletePaymentRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, FeeService_DeletePayment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *feeServiceClient) ListBalances(ctx context.Context, in *ListBalancesRequest, opts ...grpc.CallOption) (*ListBalancesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListBalancesResponse)
+	err := c.cc.Invoke(ctx, FeeService_ListBalances_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *feeServiceClient) SetSepaMandate(ctx context.Context, in *SetSepaMandateRequest, opts ...grpc.CallOption) (*SepaMandate, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SepaMandate)
+	err := c.cc.Invoke(ctx, FeeService_SetSepaMandate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *feeServiceClient) GetSepaMandate(ctx context.Context, in *GetSepaMandateRequest, opts ...grpc.CallOption) (*SepaMandate, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SepaMandate)
+	err := c.cc.Invoke(ctx, FeeService_GetSepaMandate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *feeServiceClient) DeleteSepaMandate(ctx context.Context, in *DeleteSepaMandateRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, FeeService_DeleteSepaMandate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *feeServiceClient) ExportSepaDirectDebit(ctx context.Context, in *ExportSepaDirectDebitRequest, opts ...grpc.CallOption) (*SepaDirectDebitExport, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SepaDirectDebitExport)
+	err := c.cc.Invoke(ctx, FeeService_ExportSepaDirectDebit_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// FeeServiceServer is the server API for FeeService service.
+// All implementations must embed UnimplementedFeeServiceServer
+// for forward compatibility.
+type FeeServiceServer interface {
+	CreateMembershipPlan(context.Context, *CreateMembershipPlanRequest) (*MembershipPlan, error)
+	GetMembershipPlan(context.Context, *GetMembershipPlanRequest) (*MembershipPlan, error)
+	ListMembershipPlans(context.Context, *ListMembershipPlansRequest) (*ListMembershipPlansResponse, error)
+	UpdateMembershipPlan(context.Context, *UpdateMembershipPlanRequest) (*MembershipPlan, error)
+	DeleteMembershipPlan(context.Context, *DeleteMembershipPlanRequest) (*emptypb.Empty, error)
+	AssignMembershipPlan(context.Context, *AssignMembershipPlanRequest) (*PlanAssignment, error)
+	ListPlanAssignments(context.Context, *ListPlanAssignmentsRequest) (*ListPlanAssignmentsResponse, error)
+	EndPlanAssignment(context.Context, *EndPlanAssignmentRequest) (*PlanAssignment, error)
+	GenerateInvoices(context.Context, *GenerateInvoicesRequest) (*GenerateInvoicesResponse, error)
+	ListInvoices(context.Context, *ListInvoicesRequest) (*ListInvoicesResponse, error)
+	CancelInvoice(context.Context, *CancelInvoiceRequest) (*Invoice, error)
+	RecordPayment(context.Context, *RecordPaymentRequest) (*Payment, error)
+	ListPayments(context.Context, *ListPaymentsRequest) (*ListPaymentsResponse, error)
+	DeletePayment(context.Context, *DeletePaymentRequest) (*emptypb.Empty, error)
+	ListBalances(context.Context, *ListBalancesRequest) (*ListBalancesResponse, error)
+	SetSepaMandate(context.Context, *SetSepaMandateRequest) (*SepaMandate, error)
+	GetSepaMandate(context.Context, *GetSepaMandateRequest) (*SepaMandate, error)
+	DeleteSepaMandate(context.Context, *DeleteSepaMandateRequest) (*emptypb.Empty, error)
+	ExportSepaDirectDebit(context.Context, *ExportSepaDirectDebitRequest) (*SepaDirectDebitExport, error)
+	mustEmbedUnimplementedFeeServiceServer()
+}
+
+// UnimplementedFeeServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedFeeServiceServer struct{}
+
+func (UnimplementedFeeServiceServer) CreateMembershipPlan(context.Context, *CreateMembershipPlanRequest) (*MembershipPlan, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateMembershipPlan not implemented")
+}
+func (UnimplementedFeeServiceServer) GetMembershipPlan(context.Context, *GetMembershipPlanRequest) (*MembershipPlan, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMembershipPlan not implemented")
+}
+func (UnimplementedFeeServiceServer) ListMembershipPlans(context.Context, *ListMembershipPlansRequest) (*ListMembershipPlansResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListMembershipPlans not implemented")
+}
+func (UnimplementedFeeServiceServer) UpdateMembershipPlan(context.Context, *UpdateMembershipPlanRequest) (*MembershipPlan, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateMembershipPlan not implemented")
+}
+func (UnimplementedFeeServiceServer) DeleteMembershipPlan(context.Context, *DeleteMembershipPlanRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteMembershipPlan not implemented")
+}
+func (UnimplementedFeeServiceServer) AssignMembershipPlan(context.Context, *AssignMembershipPlanRequest) (*PlanAssignment, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AssignMembershipPlan not implemented")
+}
+func (UnimplementedFeeServiceServer) ListPlanAssignments(context.Context, *ListPlanAssignmentsRequest) (*ListPlanAssignmentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPlanAssignments not implemented")
+}
+func (UnimplementedFeeServiceServer) EndPlanAssignment(context.Context, *EndPlanAssignmentRequest) (*PlanAssignment, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EndPlanAssignment not implemented")
+}
+func (UnimplementedFeeServiceServer) GenerateInvoices(context.Context, *GenerateInvoicesRequest) (*GenerateInvoicesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GenerateInvoices not implemented")
+}
+func (UnimplementedFeeServiceServer) ListInvoices(context.Context, *ListInvoicesRequest) (*ListInvoicesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListInvoices not implemented")
+}
+func (UnimplementedFeeServiceServer) CancelInvoice(context.Context, *CancelInvoiceRequest) (*Invoice, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelInvoice not implemented")
+}
+func (UnimplementedFeeServiceServer) RecordPayment(context.Context, *RecordPaymentRequest) (*Payment, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RecordPayment not implemented")
+}
+func (UnimplementedFeeServiceServer) ListPayments(context.Context, *ListPaymentsRequest) (*ListPaymentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPayments not implemented")
+}
+func (UnimplementedFeeServiceServer) DeletePayment(context.Context, *DeletePaymentRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeletePayment not implemented")
+}
+func (UnimplementedFeeServiceServer) ListBalances(context.Context, *ListBalancesRequest) (*ListBalancesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListBalances not implemented")
+}
+func (UnimplementedFeeServiceServer) SetSepaMandate(context.Context, *SetSepaMandateRequest) (*SepaMandate, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetSepaMandate not implemented")
+}
+func (UnimplementedFeeServiceServer) GetSepaMandate(context.Context, *GetSepaMandateRequest) (*SepaMandate, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSepaMandate not implemented")
+}
+func (UnimplementedFeeServiceServer) DeleteSepaMandate(context.Context, *DeleteSepaMandateRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteSepaMandate not implemented")
+}
+func (UnimplementedFeeServiceServer) ExportSepaDirectDebit(context.Context, *ExportSepaDirectDebitRequest) (*SepaDirectDebitExport, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportSepaDirectDebit not implemented")
+}
+func (UnimplementedFeeServiceServer) mustEmbedUnimplementedFeeServiceServer() {}
+func (UnimplementedFeeServiceServer) testEmbeddedByValue()                    {}
+
+// UnsafeFeeServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to FeeServiceServer will
+// result in compilation errors.
+type UnsafeFeeServiceServer interface {
+	mustEmbedUnimplementedFeeServiceServer()
+}
+
+func RegisterFeeServiceServer(s grpc.ServiceRegistrar, srv FeeServiceServer) {
+	// If the following call pancis, it indicates UnimplementedFeeServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&FeeService_ServiceDesc, srv)
+}
+
+func _FeeService_CreateMembershipPlan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateMembershipPlanRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FeeServiceServer).CreateMembershipPlan(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FeeService_CreateMembershipPlan_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FeeServiceServer).CreateMembershipPlan(ctx, req.(*CreateMembershipPlanRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FeeService_GetMembershipPlan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMembershipPlanRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FeeServiceServer).GetMembershipPlan(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FeeService_GetMembershipPlan_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FeeServiceServer).GetMembershipPlan(ctx, req.(*GetMembershipPlanRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FeeService_ListMembershipPlans_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMembershipPlansRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FeeServiceServer).ListMembershipPlans(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FeeService_ListMembershipPlans_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FeeServiceServer).ListMembershipPlans(ctx, req.(*ListMembershipPlansRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FeeService_UpdateMembershipPlan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateMembershipPlanRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FeeServiceServer).UpdateMembershipPlan(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FeeService_UpdateMembershipPlan_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FeeServiceServer).UpdateMembershipPlan(ctx, req.(*UpdateMembershipPlanRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FeeService_DeleteMembershipPlan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteMembershipPlanRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FeeServiceServer).DeleteMembershipPlan(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FeeService_DeleteMembershipPlan_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FeeServiceServer).DeleteMembershipPlan(ctx, req.(*DeleteMembershipPlanRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FeeService_AssignMembershipPlan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AssignMembershipPlanRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FeeServiceServer).AssignMembershipPlan(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FeeService_AssignMembershipPlan_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FeeServiceServer).AssignMembershipPlan(ctx, req.(*AssignMembershipPlanRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FeeService_ListPlanAssignments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPlanAssignmentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FeeServiceServer).ListPlanAssignments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FeeService_ListPlanAssignments_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FeeServiceServer).ListPlanAssignments(ctx, req.(*ListPlanAssignmentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FeeService_EndPlanAssignment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EndPlanAssignmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FeeServiceServer).EndPlanAssignment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FeeService_EndPlanAssignment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FeeServiceServer).EndPlanAssignment(ctx, req.(*EndPlanAssignmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FeeService_GenerateInvoices_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GenerateInvoicesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FeeServiceServer).GenerateInvoices(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FeeService_GenerateInvoices_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FeeServiceServer).GenerateInvoices(ctx, req.(*GenerateInvoicesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FeeService_ListInvoices_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListInvoicesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FeeServiceServer).ListInvoices(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FeeService_ListInvoices_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FeeServiceServer).ListInvoices(ctx, req.(*ListInvoicesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FeeService_CancelInvoice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelInvoiceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FeeServiceServer).CancelInvoice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FeeService_CancelInvoice_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FeeServiceServer).CancelInvoice(ctx, req.(*CancelInvoiceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FeeService_RecordPayment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RecordPaymentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FeeServiceServer).RecordPayment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FeeService_RecordPayment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FeeServiceServer).RecordPayment(ctx, req.(*RecordPaymentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FeeService_ListPayments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPaymentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FeeServiceServer).ListPayments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FeeService_ListPayments_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FeeServiceServer).ListPayments(ctx, req.(*ListPaymentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FeeService_DeletePayment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeletePaymentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FeeServiceServer).DeletePayment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FeeService_DeletePayment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FeeServiceServer).DeletePayment(ctx, req.(*DeletePaymentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FeeService_ListBalances_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListBalancesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FeeServiceServer).ListBalances(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FeeService_ListBalances_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FeeServiceServer).ListBalances(ctx, req.(*ListBalancesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FeeService_SetSepaMandate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetSepaMandateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FeeServiceServer).SetSepaMandate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FeeService_SetSepaMandate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FeeServiceServer).SetSepaMandate(ctx, req.(*SetSepaMandateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FeeService_GetSepaMandate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSepaMandateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FeeServiceServer).GetSepaMandate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FeeService_GetSepaMandate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FeeServiceServer).GetSepaMandate(ctx, req.(*GetSepaMandateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FeeService_DeleteSepaMandate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteSepaMandateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FeeServiceServer).DeleteSepaMandate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FeeService_DeleteSepaMandate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FeeServiceServer).DeleteSepaMandate(ctx, req.(*DeleteSepaMandateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FeeService_ExportSepaDirectDebit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportSepaDirectDebitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FeeServiceServer).ExportSepaDirectDebit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FeeService_ExportSepaDirectDebit_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FeeServiceServer).ExportSepaDirectDebit(ctx, req.(*ExportSepaDirectDebitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// FeeService_ServiceDesc is the grpc.ServiceDesc for FeeService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var FeeService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "ourspace_backend.proto.FeeService",
+	HandlerType: (*FeeServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateMembershipPlan",
+			Handler:    _FeeService_CreateMembershipPlan_Handler,
+		},
+		{
+			MethodName: "GetMembershipPlan",
+			Handler:    _FeeService_GetMembershipPlan_Handler,
+		},
+		{
+			MethodName: "ListMembershipPlans",
+			Handler:    _FeeService_ListMembershipPlans_Handler,
+		},
+		{
+			MethodName: "UpdateMembershipPlan",
+			Handler:    _FeeService_UpdateMembershipPlan_Handler,
+		},
+		{
+			MethodName: "DeleteMembershipPlan",
+			Handler:    _FeeService_DeleteMembershipPlan_Handler,
+		},
+		{
+			MethodName: "AssignMembershipPlan",
+			Handler:    _FeeService_AssignMembershipPlan_Handler,
+		},
+		{
+			MethodName: "ListPlanAssignments",
+			Handler:    _FeeService_ListPlanAssignments_Handler,
+		},
+		{
+			MethodName: "EndPlanAssignment",
+			Handler:    _FeeService_EndPlanAssignment_Handler,
+		},
+		{
+			MethodName: "GenerateInvoices",
+			Handler:    _FeeService_GenerateInvoices_Handler,
+		},
+		{
+			MethodName: "ListInvoices",
+			Handler:    _FeeService_ListInvoices_Handler,
+		},
+		{
+			MethodName: "CancelInvoice",
+			Handler:    _FeeService_CancelInvoice_Handler,
+		},
+		{
+			MethodName: "RecordPayment",
+			Handler:    _FeeService_RecordPayment_Handler,
+		},
+		{
+			MethodName: "ListPayments",
+			Handler:    _FeeService_ListPayments_Handler,
+		},
+		{
+			MethodName: "DeletePayment",
+			Handler:    _FeeService_DeletePayment_Handler,
+		},
+		{
+			MethodName: "ListBalances",
+			Handler:    _FeeService_ListBalances_Handler,
+		},
+		{
+			MethodName: "SetSepaMandate",
+			Handler:    _FeeService_SetSepaMandate_Handler,
+		},
+		{
+			MethodName: "GetSepaMandate",
+			Handler:    _FeeService_GetSepaMandate_Handler,
+		},
+		{
+			MethodName: "DeleteSepaMandate",
+			Handler:    _FeeService_DeleteSepaMandate_Handler,
+		},
+		{
+			MethodName: "ExportSepaDirectDebit",
+			Handler:    _FeeService_ExportSepaDirectDebit_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "ourspace-backend/proto/api.proto",
+}
+
+const (
+	SelfService_GetMyProfile_FullMethodName          = "/ourspace_backend.proto.SelfService/GetMyProfile"
+	SelfService_UpdateMyProfile_FullMethodName       = "/ourspace_backend.proto.SelfService/UpdateMyProfile"
+	SelfService_ListMyPresences_FullMethodName       = "/ourspace_backend.proto.SelfService/ListMyPresences"
+	SelfService_ListMyCards_FullMethodName           = "/ourspace_backend.proto.SelfService/ListMyCards"
+	SelfService_ListMyBriefings_FullMethodName       = "/ourspace_backend.proto.SelfService/ListMyBriefings"
+	SelfService_CreateMyChangeRequest_FullMethodName = "/ourspace_backend.proto.SelfService/CreateMyChangeRequest"
+	SelfService_ListMyChangeRequests_FullMethodName  = "/ourspace_backend.proto.SelfService/ListMyChangeRequests"
+)
+
+// SelfServiceClient is the client API for SelfService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// SelfService is used by members to view and update their own data. The member is the one the access token was issued
+// for, API keys can't use it. It is the only service logins without roles can call, all other services require a role.
+type SelfServiceClient interface {
+	GetMyProfile(ctx context.Context, in *GetMyProfileRequest, opts ...grpc.CallOption) (*Member, error)
+	UpdateMyProfile(ctx context.Context, in *UpdateMyProfileRequest, opts ...grpc.CallOption) (*Member, error)
+	ListMyPresences(ctx context.Context, in *ListMyPresencesRequest, opts ...grpc.CallOption) (*ListPresencesResponse, error)
+	ListMyCards(ctx context.Context, in *ListMyCardsRequest, opts ...grpc.CallOption) (*ListCardsResponse, error)
+	ListMyBriefings(ctx context.Context, in *ListMyBriefingsRequest, opts ...grpc.CallOption) (*ListBriefingsResponse, error)
+	CreateMyChangeRequest(ctx context.Context, in *CreateMyChangeRequestRequest, opts ...grpc.CallOption) (*MemberChangeRequest, error)
+	ListMyChangeRequests(ctx context.Context, in *ListMyChangeRequestsRequest, opts ...grpc.CallOption) (*ListMemberChangeRequestsResponse, error)
+}
+
+type selfServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewSelfServiceClient(cc grpc.ClientConnInterface) SelfServiceClient {
+	return &selfServiceClient{cc}
+}
+
+func (c *selfServiceClient) GetMyProfile(ctx context.Context, in *GetMyProfileRequest, opts ...grpc.CallOption) (*Member, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Member)
+	err := c.cc.Invoke(ctx, SelfService_GetMyProfile_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *selfServiceClient) UpdateMyProfile(ctx context.Context, in *UpdateMyProfileRequest, opts ...grpc.CallOption) (*Member, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Member)
+	err := c.cc.Invoke(ctx, SelfService_UpdateMyProfile_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *selfServiceClient) ListMyPresences(ctx context.Context, in *ListMyPresencesRequest, opts ...grpc.CallOption) (*ListPresencesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListPresencesResponse)
+	err := c.cc.Invoke(ctx, SelfService_ListMyPresences_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *selfServiceClient) ListMyCards(ctx context.Context, in *ListMyCardsRequest, opts ...grpc.CallOption) (*ListCardsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListCardsResponse)
+	err := c.cc.Invoke(ctx, SelfService_ListMyCards_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *selfServiceClient) ListMyBriefings(ctx context.Context, in *ListMyBriefingsRequest, opts ...grpc.CallOption) (*ListBriefingsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListBriefingsResponse)
+	err := c.cc.Invoke(ctx, SelfService_ListMyBriefings_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *selfServiceClient) CreateMyChangeRequest(ctx context.Context, in *CreateMyChangeRequestRequest, opts ...grpc.CallOption) (*MemberChangeRequest, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MemberChangeRequest)
+	err := c.cc.Invoke(ctx, SelfService_CreateMyChangeRequest_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *selfServiceClient) ListMyChangeRequests(ctx context.Context, in *ListMyChangeRequestsRequest, opts ...grpc.CallOption) (*ListMemberChangeRequestsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListMemberChangeRequestsResponse)
+	err := c.cc.Invoke(ctx, SelfService_ListMyChangeRequests_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// SelfServiceServer is the server API for SelfService service.
+// All implementations must embed UnimplementedSelfServiceServer
+// for forward compatibility.
+//
+// SelfService is used by members to view and update their own data. The member is the one the access token was issued
+// for, API keys can't use it. It is the only service logins without roles can call, all other services require a role.
+type SelfServiceServer interface {
+	GetMyProfile(context.Context, *GetMyProfileRequest) (*Member, error)
+	UpdateMyProfile(context.Context, *UpdateMyProfileRequest) (*Member, error)
+	ListMyPresences(context.Context, *ListMyPresencesRequest) (*ListPresencesResponse, error)
+	ListMyCards(context.Context, *ListMyCardsRequest) (*ListCardsResponse, error)
+	ListMyBriefings(context.Context, *ListMyBriefingsRequest) (*ListBriefingsResponse, error)
+	CreateMyChangeRequest(context.Context, *CreateMyChangeRequestRequest) (*MemberChangeRequest, error)
+	ListMyChangeRequests(context.Context, *ListMyChangeRequestsRequest) (*ListMemberChangeRequestsResponse, error)
+	mustEmbedUnimplementedSelfServiceServer()
+}
+
+// UnimplementedSelfServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedSelfServiceServer struct{}
+
+func (UnimplementedSelfServiceServer) GetMyProfile(context.Context, *GetMyProfileRequest) (*Member, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMyProfile not implemented")
+}
+func (UnimplementedSelfServiceServer) UpdateMyProfile(context.Context, *UpdateMyProfileRequest) (*Member, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateMyProfile not implemented")
+}
+func (UnimplementedSelfServiceServer) ListMyPresences(context.Context, *ListMyPresencesRequest) (*ListPresencesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListMyPresences not implemented")
+}
+func (UnimplementedSelfServiceServer) ListMyCards(context.Context, *ListMyCardsRequest) (*ListCardsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListMyCards not implemented")
+}
+func (UnimplementedSelfServiceServer) ListMyBriefings(context.Context, *ListMyBriefingsRequest) (*ListBriefingsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListMyBriefings not implemented")
+}
+func (UnimplementedSelfServiceServer) CreateMyChangeRequest(context.Context, *CreateMyChangeRequestRequest) (*MemberChangeRequest, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateMyChangeRequest not implemented")
+}
+func (UnimplementedSelfServiceServer) ListMyChangeRequests(context.Context, *ListMyChangeRequestsRequest) (*ListMemberChangeRequestsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListMyChangeRequests not implemented")
+}
+func (UnimplementedSelfServiceServer) mustEmbedUnimplementedSelfServiceServer() {}
+func (UnimplementedSelfServiceServer) testEmbeddedByValue()                     {}
+
+// UnsafeSelfServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to SelfServiceServer will
+// result in compilation errors.
+type UnsafeSelfServiceServer interface {
+	mustEmbedUnimplementedSelfServiceServer()
+}
+
+func RegisterSelfServiceServer(s grpc.ServiceRegistrar, srv SelfServiceServer) {
+	// If the following call pancis, it indicates UnimplementedSelfServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&SelfService_ServiceDesc, srv)
+}
+
+func _SelfService_GetMyProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMyProfileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SelfServiceServer).GetMyProfile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SelfService_GetMyProfile_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SelfServiceServer).GetMyProfile(ctx, req.(*GetMyProfileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SelfService_UpdateMyProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateMyProfileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SelfServiceServer).UpdateMyProfile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SelfService_UpdateMyProfile_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SelfServiceServer).UpdateMyProfile(ctx, req.(*UpdateMyProfileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SelfService_ListMyPresences_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMyPresencesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SelfServiceServer).ListMyPresences(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SelfService_ListMyPresences_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SelfServiceServer).ListMyPresences(ctx, req.(*ListMyPresencesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SelfService_ListMyCards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMyCardsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SelfServiceServer).ListMyCards(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SelfService_ListMyCards_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SelfServiceServer).ListMyCards(ctx, req.(*ListMyCardsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SelfService_ListMyBriefings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMyBriefingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SelfServiceServer).ListMyBriefings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SelfService_ListMyBriefings_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SelfServiceServer).ListMyBriefings(ctx, req.(*ListMyBriefingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SelfService_CreateMyChangeRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateMyChangeRequestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SelfServiceServer).CreateMyChangeRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SelfService_CreateMyChangeRequest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SelfServiceServer).CreateMyChangeRequest(ctx, req.(*CreateMyChangeRequestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SelfService_ListMyChangeRequests_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMyChangeRequestsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SelfServiceServer).ListMyChangeRequests(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SelfService_ListMyChangeRequests_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SelfServiceServer).ListMyChangeRequests(ctx, req.(*ListMyChangeRequestsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// SelfService_ServiceDesc is the grpc.ServiceDesc for SelfService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var SelfService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "ourspace_backend.proto.SelfService",
+	HandlerType: (*SelfServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetMyProfile",
+			Handler:    _SelfService_GetMyProfile_Handler,
+		},
+		{
+			MethodName: "UpdateMyProfile",
+			Handler:    _SelfService_UpdateMyProfile_Handler,
+		},
+		{
+			MethodName: "ListMyPresences",
+			Handler:    _SelfService_ListMyPresences_Handler,
+		},
+		{
+			MethodName: "ListMyCards",
+			Handler:    _SelfService_ListMyCards_Handler,
+		},
+		{
+			MethodName: "ListMyBriefings",
+			Handler:    _SelfService_ListMyBriefings_Handler,
+		},
+		{
+			MethodName: "CreateMyChangeRequest",
+			Handler:    _SelfService_CreateMyChangeRequest_Handler,
+		},
+		{
+			MethodName: "ListMyChangeRequests",
+			Handler:    _SelfService_ListMyChangeRequests_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "ourspace-backend/proto/api.proto",
+}
+
+const (
+	ReportService_GetPresenceReport_FullMethodName        = "/ourspace_backend.proto.ReportService/GetPresenceReport"
+	ReportService_ExportPresenceReport_FullMethodName     = "/ourspace_backend.proto.ReportService/ExportPresenceReport"
+	ReportService_GetMachineUsageReport_FullMethodName    = "/ourspace_backend.proto.ReportService/GetMachineUsageReport"
+	ReportService_ExportMachineUsageReport_FullMethodName = "/ourspace_backend.proto.ReportService/ExportMachineUsageReport"
+)
+
+// ReportServiceClient is the client API for ReportService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type ReportServiceClient interface {
+	GetPresenceReport(ctx context.Context, in *GetPresenceReportRequest, opts ...grpc.CallOption) (*PresenceReport, error)
+	ExportPresenceReport(ctx context.Context, in *GetPresenceReportRequest, opts ...grpc.CallOption) (*httpbody.HttpBody, error)
+	GetMachineUsageReport(ctx context.Context, in *GetMachineUsageReportRequest, opts ...grpc.CallOption) (*MachineUsageReport, error)
+	ExportMachineUsageReport(ctx context.Context, in *GetMachineUsageReportRequest, opts ...grpc.CallOption) (*httpbody.HttpBody, error)
+}
+
+type reportServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewReportServiceClient(cc grpc.ClientConnInterface) ReportServiceClient {
+	return &reportServiceClient{cc}
+}
+
+func (c *reportServiceClient) GetPresenceReport(ctx context.Context, in *GetPresenceReportRequest, opts ...grpc.CallOption) (*PresenceReport, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PresenceReport)
+	err := c.cc.Invoke(ctx, ReportService_GetPresenceReport_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reportServiceClient) ExportPresenceReport(ctx context.Context, in *GetPresenceReportRequest, opts ...grpc.CallOption) (*httpbody.HttpBody, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(httpbody.HttpBody)
+	err := c.cc.Invoke(ctx, ReportService_ExportPresenceReport_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reportServiceClient) GetMachineUsageReport(ctx context.Context, in *GetMachineUsageReportRequest, opts ...grpc.CallOption) (*MachineUsageReport, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MachineUsageReport)
+	err := c.cc.Invoke(ctx, ReportService_GetMachineUsageReport_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reportServiceClient) ExportMachineUsageReport(ctx context.Context, in *GetMachineUsageReportRequest, opts ...grpc.CallOption) (*httpbody.HttpBody, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(httpbody.HttpBody)
+	err := c.cc.Invoke(ctx, ReportService_ExportMachineUsageReport_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ReportServiceServer is the server API for ReportService service.
+// All implementations must embed UnimplementedReportServiceServer
+// for forward compatibility.
+type ReportServiceServer interface {
+	GetPresenceReport(context.Context, *GetPresenceReportRequest) (*PresenceReport, error)
+	ExportPresenceReport(context.Context, *GetPresenceReportRequest) (*httpbody.HttpBody, error)
+	GetMachineUsageReport(context.Context, *GetMachineUsageReportRequest) (*MachineUsageReport, error)
+	ExportMachineUsageReport(context.Context, *GetMachineUsageReportRequest) (*httpbody.HttpBody, error)
+	mustEmbedUnimplementedReportServiceServer()
+}
+
+// UnimplementedReportServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedReportServiceServer struct{}
+
+func (UnimplementedReportServiceServer) GetPresenceReport(context.Context, *GetPresenceReportRequest) (*PresenceReport, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPresenceReport not implemented")
+}
+func (UnimplementedReportServiceServer) ExportPresenceReport(context.Context, *GetPresenceReportRequest) (*httpbody.HttpBody, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportPresenceReport not implemented")
+}
+func (UnimplementedReportServiceServer) GetMachineUsageReport(context.Context, *GetMachineUsageReportRequest) (*MachineUsageReport, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMachineUsageReport not implemented")
+}
+func (UnimplementedReportServiceServer) ExportMachineUsageReport(context.Context, *GetMachineUsageReportRequest) (*httpbody.HttpBody, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportMachineUsageReport not implemented")
+}
+func (UnimplementedReportServiceServer) mustEmbedUnimplementedReportServiceServer() {}
+func (UnimplementedReportServiceServer) testEmbeddedByValue()                       {}
+
+// UnsafeReportServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ReportServiceServer will
+// result in compilation errors.
+type UnsafeReportServiceServer interface {
+	mustEmbedUnimplementedReportServiceServer()
+}
+
+func RegisterReportServiceServer(s grpc.ServiceRegistrar, srv ReportServiceServer) {
+	// If the following call pancis, it indicates UnimplementedReportServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&ReportService_ServiceDesc, srv)
+}
+
+func _ReportService_GetPresenceReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPresenceReportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReportServiceServer).GetPresenceReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ReportService_GetPresenceReport_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReportServiceServer).GetPresenceReport(ctx, req.(*GetPresenceReportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ReportService_ExportPresenceReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPresenceReportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReportServiceServer).ExportPresenceReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ReportService_ExportPresenceReport_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReportServiceServer).ExportPresenceReport(ctx, req.(*GetPresenceReportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ReportService_GetMachineUsageReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMachineUsageReportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReportServiceServer).GetMachineUsageReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ReportService_GetMachineUsageReport_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReportServiceServer).GetMachineUsageReport(ctx, req.(*GetMachineUsageReportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ReportService_ExportMachineUsageReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMachineUsageReportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReportServiceServer).ExportMachineUsageReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ReportService_ExportMachineUsageReport_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReportServiceServer).ExportMachineUsageReport(ctx, req.(*GetMachineUsageReportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ReportService_ServiceDesc is the grpc.ServiceDesc for ReportService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ReportService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "ourspace_backend.proto.ReportService",
+	HandlerType: (*ReportServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetPresenceReport",
+			Handler:    _ReportService_GetPresenceReport_Handler,
+		},
+		{
+			MethodName: "ExportPresenceReport",
+			Handler:    _ReportService_ExportPresenceReport_Handler,
+		},
+		{
+			MethodName: "GetMachineUsageReport",
+			Handler:    _ReportService_GetMachineUsageReport_Handler,
+		},
+		{
+			MethodName: "ExportMachineUsageReport",
+			Handler:    _ReportService_ExportMachineUsageReport_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "ourspace-backend/proto/api.proto",
+}
+
+const (
+	ApplicationService_SubmitApplication_FullMethodName = "/ourspace_backend.proto.ApplicationService/SubmitApplication"
+	ApplicationService_ListApplications_FullMethodName  = "/ourspace_backend.proto.ApplicationService/ListApplications"
+	ApplicationService_AcceptApplication_FullMethodName = "/ourspace_backend.proto.ApplicationService/AcceptApplication"
+	ApplicationService_RejectApplication_FullMethodName = "/ourspace_backend.proto.ApplicationService/RejectApplication"
+)
+
+// ApplicationServiceClient is the client API for ApplicationService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type ApplicationServiceClient interface {
+	SubmitApplication(ctx context.Context, in *SubmitApplicationRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	ListApplications(ctx context.Context, in *ListApplicationsRequest, opts ...grpc.CallOption) (*ListApplicationsResponse, error)
+	AcceptApplication(ctx context.Context, in *AcceptApplicationRequest, opts ...grpc.CallOption) (*Application, error)
+	RejectApplication(ctx context.Context, in *RejectApplicationRequest, opts ...grpc.CallOption) (*Application, error)
+}
+
+type applicationServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewApplicationServiceClient(cc grpc.ClientConnInterface) ApplicationServiceClient {
+	return &applicationServiceClient{cc}
+}
+
+func (c *applicationServiceClient) SubmitApplication(ctx context.Context, in *SubmitApplicationRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, ApplicationService_SubmitApplication_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *applicationServiceClient) ListApplications(ctx context.Context, in *ListApplicationsRequest, opts ...grpc.CallOption) (*ListApplicationsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListApplicationsResponse)
+	err := c.cc.Invoke(ctx, ApplicationService_ListApplications_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *applicationServiceClient) AcceptApplication(ctx context.Context, in *AcceptApplicationRequest, opts ...grpc.CallOption) (*Application, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Application)
+	err := c.cc.Invoke(ctx, ApplicationService_AcceptApplication_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *applicationServiceClient) RejectApplication(ctx context.Context, in *RejectApplicationRequest, opts ...grpc.CallOption) (*Application, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Application)
+	err := c.cc.Invoke(ctx, ApplicationService_RejectApplication_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ApplicationServiceServer is the server API for ApplicationService service.
+// All implementations must embed UnimplementedApplicationServiceServer
+// for forward compatibility.
+type ApplicationServiceServer interface {
+	SubmitApplication(context.Context, *SubmitApplicationRequest) (*emptypb.Empty, error)
+	ListApplications(context.Context, *ListApplicationsRequest) (*ListApplicationsResponse, error)
+	AcceptApplication(context.Context, *AcceptApplicationRequest) (*Application, error)
+	RejectApplication(context.Context, *RejectApplicationRequest) (*Application, error)
+	mustEmbedUnimplementedApplicationServiceServer()
+}
+
+// UnimplementedApplicationServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedApplicationServiceServer struct{}
+
+func (UnimplementedApplicationServiceServer) SubmitApplication(context.Context, *SubmitApplicationRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitApplication not implemented")
+}
+func (UnimplementedApplicationServiceServer) ListApplications(context.Context, *ListApplicationsRequest) (*ListApplicationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListApplications not implemented")
+}
+func (UnimplementedApplicationServiceServer) AcceptApplication(context.Context, *AcceptApplicationRequest) (*Application, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcceptApplication not implemented")
+}
+func (UnimplementedApplicationServiceServer) RejectApplication(context.Context, *RejectApplicationRequest) (*Application, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RejectApplication not implemented")
+}
+func (UnimplementedApplicationServiceServer) mustEmbedUnimplementedApplicationServiceServer() {}
+func (UnimplementedApplicationServiceServer) testEmbeddedByValue()                            {}
+
+// UnsafeApplicationServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ApplicationServiceServer will
+// result in compilation errors.
+type UnsafeApplicationServiceServer interface {
+	mustEmbedUnimplementedApplicationServiceServer()
+}
+
+func RegisterApplicationServiceServer(s grpc.ServiceRegistrar, srv ApplicationServiceServer) {
+	// If the following call pancis, it indicates UnimplementedApplicationServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&ApplicationService_ServiceDesc, srv)
+}
+
+func _ApplicationService_SubmitApplication_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubmitApplicationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationServiceServer).SubmitApplication(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ApplicationService_SubmitApplication_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationServiceServer).SubmitApplication(ctx, req.(*SubmitApplicationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApplicationService_ListApplications_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListApplicationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationServiceServer).ListApplications(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ApplicationService_ListApplications_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationServiceServer).ListApplications(ctx, req.(*ListApplicationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApplicationService_AcceptApplication_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AcceptApplicationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationServiceServer).AcceptApplication(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ApplicationService_AcceptApplication_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationServiceServer).AcceptApplication(ctx, req.(*AcceptApplicationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApplicationService_RejectApplication_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RejectApplicationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationServiceServer).RejectApplication(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ApplicationService_RejectApplication_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationServiceServer).RejectApplication(ctx, req.(*RejectApplicationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ApplicationService_ServiceDesc is the grpc.ServiceDesc for ApplicationService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ApplicationService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "ourspace_backend.proto.ApplicationService",
+	HandlerType: (*ApplicationServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "SubmitApplication",
+			Handler:    _ApplicationService_SubmitApplication_Handler,
+		},
+		{
+			MethodName: "ListApplications",
+			Handler:    _ApplicationService_ListApplications_Handler,
+		},
+		{
+			MethodName: "AcceptApplication",
+			Handler:    _ApplicationService_AcceptApplication_Handler,
+		},
+		{
+			MethodName: "RejectApplication",
+			Handler:    _ApplicationService_RejectApplication_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "ourspace-backend/proto/api.proto",
+}
+
+const (
+	MemberNoteService_CreateMemberNote_FullMethodName   = "/ourspace_backend.proto.MemberNoteService/CreateMemberNote"
+	MemberNoteService_ListMemberNotes_FullMethodName    = "/ourspace_backend.proto.MemberNoteService/ListMemberNotes"
+	MemberNoteService_UpdateMemberNote_FullMethodName   = "/ourspace_backend.proto.MemberNoteService/UpdateMemberNote"
+	MemberNoteService_DeleteMemberNote_FullMethodName   = "/ourspace_backend.proto.MemberNoteService/DeleteMemberNote"
+	MemberNoteService_ListMemberTimeline_FullMethodName = "/ourspace_backend.proto.MemberNoteService/ListMemberTimeline"
+)
+
+// MemberNoteServiceClient is the client API for MemberNoteService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// MemberNoteService keeps notes of staff on members, e.g. about cash payments or warnings.
+type MemberNoteServiceClient interface {
+	CreateMemberNote(ctx context.Context, in *CreateMemberNoteRequest, opts ...grpc.CallOption) (*MemberNote, error)
+	ListMemberNotes(ctx context.Context, in *ListMemberNotesRequest, opts ...grpc.CallOption) (*ListMemberNotesResponse, error)
+	UpdateMemberNote(ctx context.Context, in *UpdateMemberNoteRequest, opts ...grpc.CallOption) (*MemberNote, error)
+	DeleteMemberNote(ctx context.Context, in *DeleteMemberNoteRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	ListMemberTimeline(ctx context.Context, in *ListMemberTimelineRequest, opts ...grpc.CallOption) (*ListMemberTimelineResponse, error)
+}
+
+type memberNoteServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewMemberNoteServiceClient(cc grpc.ClientConnInterface) MemberNoteServiceClient {
+	return &memberNoteServiceClient{cc}
+}
+
+func (c *memberNoteServiceClient) CreateMemberNote(ctx context.Context, in *CreateMemberNoteRequest, opts ...grpc.CallOption) (*MemberNote, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MemberNote)
+	err := c.cc.Invoke(ctx, MemberNoteService_CreateMemberNote_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *memberNoteServiceClient) ListMemberNotes(ctx context.Context, in *ListMemberNotesRequest, opts ...grpc.CallOption) (*ListMemberNotesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListMemberNotesResponse)
+	err := c.cc.Invoke(ctx, MemberNoteService_ListMemberNotes_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *memberNoteServiceClient) UpdateMemberNote(ctx context.Context, in *UpdateMemberNoteRequest, opts ...grpc.CallOption) (*MemberNote, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MemberNote)
+	err := c.cc.Invoke(ctx, MemberNoteService_UpdateMemberNote_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *memberNoteServiceClient) DeleteMemberNote(ctx context.Context, in *DeleteMemberNoteRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, MemberNoteService_DeleteMemberNote_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *memberNoteServiceClient) ListMemberTimeline(ctx context.Context, in *ListMemberTimelineRequest, opts ...grpc.CallOption) (*ListMemberTimelineResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListMemberTimelineResponse)
+	err := c.cc.Invoke(ctx, MemberNoteService_ListMemberTimeline_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MemberNoteServiceServer is the server API for MemberNoteService service.
+// All implementations must embed UnimplementedMemberNoteServiceServer
+// for forward compatibility.
+//
+// MemberNoteService keeps notes of staff on members, e.g. about cash payments or warnings.
+type MemberNoteServiceServer interface {
+	CreateMemberNote(context.Context, *CreateMemberNoteRequest) (*MemberNote, error)
+	ListMemberNotes(context.Context, *ListMemberNotesRequest) (*ListMemberNotesResponse, error)
+	UpdateMemberNote(context.Context, *UpdateMemberNoteRequest) (*MemberNote, error)
+	DeleteMemberNote(context.Context, *DeleteMemberNoteRequest) (*emptypb.Empty, error)
+	ListMemberTimeline(context.Context, *ListMemberTimelineRequest) (*ListMemberTimelineResponse, error)
+	mustEmbedUnimplementedMemberNoteServiceServer()
+}
+
+// UnimplementedMemberNoteServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedMemberNoteServiceServer struct{}
+
+func (UnimplementedMemberNoteServiceServer) CreateMemberNote(context.Context, *CreateMemberNoteRequest) (*MemberNote, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateMemberNote not implemented")
+}
+func (UnimplementedMemberNoteServiceServer) ListMemberNotes(context.Context, *ListMemberNotesRequest) (*ListMemberNotesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListMemberNotes not implemented")
+}
+func (UnimplementedMemberNoteServiceServer) UpdateMemberNote(context.Context, *UpdateMemberNoteRequest) (*MemberNote, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateMemberNote not implemented")
+}
+func (UnimplementedMemberNoteServiceServer) DeleteMemberNote(context.Context, *DeleteMemberNoteRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteMemberNote not implemented")
+}
+func (UnimplementedMemberNoteServiceServer) ListMemberTimeline(context.Context, *ListMemberTimelineRequest) (*ListMemberTimelineResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListMemberTimeline not implemented")
+}
+func (UnimplementedMemberNoteServiceServer) mustEmbedUnimplementedMemberNoteServiceServer() {}
+func (UnimplementedMemberNoteServiceServer) testEmbeddedByValue()                           {}
+
+// UnsafeMemberNoteServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to MemberNoteServiceServer will
+// result in compilation errors.
+type UnsafeMemberNoteServiceServer interface {
+	mustEmbedUnimplementedMemberNoteServiceServer()
+}
+
+func RegisterMemberNoteServiceServer(s grpc.ServiceRegistrar, srv MemberNoteServiceServer) {
+	// If the following call pancis, it indicates UnimplementedMemberNoteServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&MemberNoteService_ServiceDesc, srv)
+}
+
+func _MemberNoteService_CreateMemberNote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateMemberNoteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemberNoteServiceServer).CreateMemberNote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemberNoteService_CreateMemberNote_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemberNoteServiceServer).CreateMemberNote(ctx, req.(*CreateMemberNoteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MemberNoteService_ListMemberNotes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMemberNotesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemberNoteServiceServer).ListMemberNotes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemberNoteService_ListMemberNotes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemberNoteServiceServer).ListMemberNotes(ctx, req.(*ListMemberNotesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MemberNoteService_UpdateMemberNote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateMemberNoteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemberNoteServiceServer).UpdateMemberNote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemberNoteService_UpdateMemberNote_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemberNoteServiceServer).UpdateMemberNote(ctx, req.(*UpdateMemberNoteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MemberNoteService_DeleteMemberNote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteMemberNoteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemberNoteServiceServer).DeleteMemberNote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemberNoteService_DeleteMemberNote_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemberNoteServiceServer).DeleteMemberNote(ctx, req.(*DeleteMemberNoteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MemberNoteService_ListMemberTimeline_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMemberTimelineRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemberNoteServiceServer).ListMemberTimeline(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemberNoteService_ListMemberTimeline_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemberNoteServiceServer).ListMemberTimeline(ctx, req.(*ListMemberTimelineRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// MemberNoteService_ServiceDesc is the grpc.ServiceDesc for MemberNoteService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var MemberNoteService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "ourspace_backend.proto.MemberNoteService",
+	HandlerType: (*MemberNoteServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateMemberNote",
+			Handler:    _MemberNoteService_CreateMemberNote_Handler,
+		},
+		{
+			MethodName: "ListMemberNotes",
+			Handler:    _MemberNoteService_ListMemberNotes_Handler,
+		},
+		{
+			MethodName: "UpdateMemberNote",
+			Handler:    _MemberNoteService_UpdateMemberNote_Handler,
+		},
+		{
+			MethodName: "DeleteMemberNote",
+			Handler:    _MemberNoteService_DeleteMemberNote_Handler,
+		},
+		{
+			MethodName: "ListMemberTimeline",
+			Handler:    _MemberNoteService_ListMemberTimeline_Handler,
+		},
+	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "ourspace-backend/proto/api.proto",
 }
@@ -1,7 +1,7 @@
 // This file is auto-generated by @hey-api/openapi-ts
 
 import type { Options as ClientOptions, TDataShape, Client } from '@hey-api/client-fetch';
-import type { AuthServiceLoginData, AuthServiceLoginResponse, AuthServiceLoginError, AuthServiceLogoutData, AuthServiceLogoutResponse, AuthServiceLogoutError, AuthServiceRefreshData, AuthServiceRefreshResponse, AuthServiceRefreshError, BriefingServiceListBriefingTypesData, BriefingServiceListBriefingTypesResponse, BriefingServiceListBriefingTypesError, BriefingServiceCreateBriefingTypeData, BriefingServiceCreateBriefingTypeResponse, BriefingServiceCreateBriefingTypeError, BriefingServiceUpdateBriefingTypeData, BriefingServiceUpdateBriefingTypeResponse, BriefingServiceUpdateBriefingTypeError, BriefingServiceDeleteBriefingTypeData, BriefingServiceDeleteBriefingTypeError, BriefingServiceGetBriefingTypeData, BriefingServiceGetBriefingTypeResponse, BriefingServiceGetBriefingTypeError, BriefingServiceListBriefingsData, BriefingServiceListBriefingsResponse, BriefingServiceListBriefingsError, BriefingServiceCreateBriefingData, BriefingServiceCreateBriefingResponse, BriefingServiceCreateBriefingError, BriefingServiceUpdateBriefingData, BriefingServiceUpdateBriefingResponse, BriefingServiceUpdateBriefingError, BriefingServiceDeleteBriefingData, BriefingServiceDeleteBriefingError, BriefingServiceGetBriefingData, BriefingServiceGetBriefingResponse, BriefingServiceGetBriefingError, CardServiceListCardsData, CardServiceListCardsResponse, CardServiceListCardsError, CardServiceCreateCardData, CardServiceCreateCardResponse, CardServiceCreateCardError, CardServiceUpdateCardData, CardServiceUpdateCardResponse, CardServiceUpdateCardError, CardServiceDeleteCardData, CardServiceDeleteCardError, CardServiceGetCardData, CardServiceGetCardResponse, CardServiceGetCardError, MemberServiceListMemberAttributesData, MemberServiceListMemberAttributesResponse, MemberServiceListMemberAttributesError, MemberServiceCreateMemberAttributeData, MemberServiceCreateMemberAttributeResponse, MemberServiceCreateMemberAttributeError, MemberServiceUpdateMemberAttributeData, MemberServiceUpdateMemberAttributeResponse, MemberServiceUpdateMemberAttributeError, MemberServiceDeleteMemberAttributeData, MemberServiceDeleteMemberAttributeError, MemberServiceGetMemberAttributeData, MemberServiceGetMemberAttributeResponse, MemberServiceGetMemberAttributeError, MemberServiceListMemberTagsData, MemberServiceListMemberTagsResponse, MemberServiceListMemberTagsError, MemberServiceListMembersData, MemberServiceListMembersResponse, MemberServiceListMembersError, MemberServiceCreateMemberData, MemberServiceCreateMemberResponse, MemberServiceCreateMemberError, MemberServiceDeleteMemberData, MemberServiceDeleteMemberError, MemberServiceGetMemberData, MemberServiceGetMemberResponse, MemberServiceGetMemberError, MemberServiceUpdateMemberData, MemberServiceUpdateMemberResponse, MemberServiceUpdateMemberError, PresenceServiceListPresencesData, PresenceServiceListPresencesResponse, PresenceServiceListPresencesError, PresenceServiceDeletePresenceData, PresenceServiceDeletePresenceError, PresenceServiceUpdatePresenceData, PresenceServiceUpdatePresenceResponse, PresenceServiceUpdatePresenceError, PresenceServiceCheckinData, PresenceServiceCheckinResponse, PresenceServiceCheckinError, PresenceServiceCheckoutData, PresenceServiceCheckoutResponse, PresenceServiceCheckoutError } from './types.gen';
+import type { AccessServiceListAccessDecisionsData, AccessServiceListAccessDecisionsResponse, AccessServiceListAccessDecisionsError, AccessServiceCheckAccessData, AccessServiceCheckAccessResponse, AccessServiceCheckAccessError, ApplicationServiceListApplicationsData, ApplicationServiceListApplicationsResponse, ApplicationServiceListApplicationsError, ApplicationServiceSubmitApplicationData, ApplicationServiceSubmitApplicationError, ApplicationServiceAcceptApplicationData, ApplicationServiceAcceptApplicationResponse, ApplicationServiceAcceptApplicationError, ApplicationServiceRejectApplicationData, ApplicationServiceRejectApplicationResponse, ApplicationServiceRejectApplicationError, AuthServiceLoginData, AuthServiceLoginResponse, AuthServiceLoginError, AuthServiceLogoutData, AuthServiceLogoutResponse, AuthServiceLogoutError, AuthServiceRefreshData, AuthServiceRefreshResponse, AuthServiceRefreshError, FeeServiceListBalancesData, FeeServiceListBalancesResponse, FeeServiceListBalancesError, BriefingServiceListBriefingTypesData, BriefingServiceListBriefingTypesResponse, BriefingServiceListBriefingTypesError, BriefingServiceCreateBriefingTypeData, BriefingServiceCreateBriefingTypeResponse, BriefingServiceCreateBriefingTypeError, BriefingServiceUpdateBriefingTypeData, BriefingServiceUpdateBriefingTypeResponse, BriefingServiceUpdateBriefingTypeError, BriefingServiceDeleteBriefingTypeData, BriefingServiceDeleteBriefingTypeError, BriefingServiceGetBriefingTypeData, BriefingServiceGetBriefingTypeResponse, BriefingServiceGetBriefingTypeError, BriefingServiceListBriefingsData, BriefingServiceListBriefingsResponse, BriefingServiceListBriefingsError, BriefingServiceCreateBriefingData, BriefingServiceCreateBriefingResponse, BriefingServiceCreateBriefingError, BriefingServiceUpdateBriefingData, BriefingServiceUpdateBriefingResponse, BriefingServiceUpdateBriefingError, BriefingServiceDeleteBriefingData, BriefingServiceDeleteBriefingError, BriefingServiceGetBriefingData, BriefingServiceGetBriefingResponse, BriefingServiceGetBriefingError, CardServiceListCardsData, CardServiceListCardsResponse, CardServiceListCardsError, CardServiceCreateCardData, CardServiceCreateCardResponse, CardServiceCreateCardError, CardServiceUpdateCardData, CardServiceUpdateCardResponse, CardServiceUpdateCardError, CardServiceDeleteCardData, CardServiceDeleteCardError, CardServiceGetCardData, CardServiceGetCardResponse, CardServiceGetCardError, CardServiceExportCardsData, CardServiceExportCardsError, EventServiceListEventsData, EventServiceListEventsResponse, EventServiceListEventsError, EventServiceCreateEventData, EventServiceCreateEventResponse, EventServiceCreateEventError, EventServiceUpdateEventData, EventServiceUpdateEventResponse, EventServiceUpdateEventError, EventServiceListEventRegistrationsData, EventServiceListEventRegistrationsResponse, EventServiceListEventRegistrationsError, EventServiceRegisterForEventData, EventServiceRegisterForEventResponse, EventServiceRegisterForEventError, EventServiceMarkEventAttendanceData, EventServiceMarkEventAttendanceResponse, EventServiceMarkEventAttendanceError, EventServiceCancelEventRegistrationData, EventServiceCancelEventRegistrationResponse, EventServiceCancelEventRegistrationError, EventServiceDeleteEventData, EventServiceDeleteEventError, EventServiceGetEventData, EventServiceGetEventResponse, EventServiceGetEventError, FeeServiceListInvoicesData, FeeServiceListInvoicesResponse, FeeServiceListInvoicesError, FeeServiceCancelInvoiceData, FeeServiceCancelInvoiceResponse, FeeServiceCancelInvoiceError, FeeServiceExportSepaDirectDebitData, FeeServiceExportSepaDirectDebitResponse, FeeServiceExportSepaDirectDebitError, FeeServiceGenerateInvoicesData, FeeServiceGenerateInvoicesResponse, FeeServiceGenerateInvoicesError, LendingServiceListItemsData, LendingServiceListItemsResponse, LendingServiceListItemsError, LendingServiceCreateItemData, LendingServiceCreateItemResponse, LendingServiceCreateItemError, LendingServiceDeleteItemData, LendingServiceDeleteItemError, LendingServiceGetItemData, LendingServiceGetItemResponse, LendingServiceGetItemError, LendingServiceUpdateItemData, LendingServiceUpdateItemResponse, LendingServiceUpdateItemError, LendingServiceListLoansData, LendingServiceListLoansResponse, LendingServiceListLoansError, LendingServiceLendItemData, LendingServiceLendItemResponse, LendingServiceLendItemError, LendingServiceGetLoanData, LendingServiceGetLoanResponse, LendingServiceGetLoanError, LendingServiceReturnItemData, LendingServiceReturnItemResponse, LendingServiceReturnItemError, LendingServiceReturnItemByScanData, LendingServiceReturnItemByScanResponse, LendingServiceReturnItemByScanError, LendingServiceLendItemByScanData, LendingServiceLendItemByScanResponse, LendingServiceLendItemByScanError, MachineServiceListMachinesData, MachineServiceListMachinesResponse, MachineServiceListMachinesError, MachineServiceCreateMachineData, MachineServiceCreateMachineResponse, MachineServiceCreateMachineError, MachineServiceDeleteMachineData, MachineServiceDeleteMachineError, MachineServiceGetMachineData, MachineServiceGetMachineResponse, MachineServiceGetMachineError, MachineServiceUpdateMachineData, MachineServiceUpdateMachineResponse, MachineServiceUpdateMachineError, MachineServiceStartUsageSessionData, MachineServiceStartUsageSessionResponse, MachineServiceStartUsageSessionError, MachineServiceStopUsageSessionData, MachineServiceStopUsageSessionResponse, MachineServiceStopUsageSessionError, SelfServiceGetMyProfileData, SelfServiceGetMyProfileResponse, SelfServiceGetMyProfileError, SelfServiceUpdateMyProfileData, SelfServiceUpdateMyProfileResponse, SelfServiceUpdateMyProfileError, SelfServiceListMyBriefingsData, SelfServiceListMyBriefingsResponse, SelfServiceListMyBriefingsError, SelfServiceListMyCardsData, SelfServiceListMyCardsResponse, SelfServiceListMyCardsError, SelfServiceListMyChangeRequestsData, SelfServiceListMyChangeRequestsResponse, SelfServiceListMyChangeRequestsError, SelfServiceCreateMyChangeRequestData, SelfServiceCreateMyChangeRequestResponse, SelfServiceCreateMyChangeRequestError, SelfServiceListMyPresencesData, SelfServiceListMyPresencesResponse, SelfServiceListMyPresencesError, MemberServiceListMemberAttributesData, MemberServiceListMemberAttributesResponse, MemberServiceListMemberAttributesError, MemberServiceCreateMemberAttributeData, MemberServiceCreateMemberAttributeResponse, MemberServiceCreateMemberAttributeError, MemberServiceUpdateMemberAttributeData, MemberServiceUpdateMemberAttributeResponse, MemberServiceUpdateMemberAttributeError, MemberServiceDeleteMemberAttributeData, MemberServiceDeleteMemberAttributeError, MemberServiceGetMemberAttributeData, MemberServiceGetMemberAttributeResponse, MemberServiceGetMemberAttributeError, MemberServiceMigrateMemberAttributeData, MemberServiceMigrateMemberAttributeResponse, MemberServiceMigrateMemberAttributeError, MemberServiceListMemberChangeRequestsData, MemberServiceListMemberChangeRequestsResponse, MemberServiceListMemberChangeRequestsError, MemberServiceApproveMemberChangeRequestData, MemberServiceApproveMemberChangeRequestResponse, MemberServiceApproveMemberChangeRequestError, MemberServiceRejectMemberChangeRequestData, MemberServiceRejectMemberChangeRequestResponse, MemberServiceRejectMemberChangeRequestError, MemberServiceListMemberTagsData, MemberServiceListMemberTagsResponse, MemberServiceListMemberTagsError, MemberServiceCreateMemberTagData, MemberServiceCreateMemberTagResponse, MemberServiceCreateMemberTagError, MemberServiceDeleteMemberTagData, MemberServiceDeleteMemberTagError, MemberServiceMergeMemberTagsData, MemberServiceMergeMemberTagsResponse, MemberServiceMergeMemberTagsError, MemberServiceRenameMemberTagData, MemberServiceRenameMemberTagResponse, MemberServiceRenameMemberTagError, MemberServiceUpdateMemberTagData, MemberServiceUpdateMemberTagResponse, MemberServiceUpdateMemberTagError, MemberServiceListMembersData, MemberServiceListMembersResponse, MemberServiceListMembersError, MemberServiceCreateMemberData, MemberServiceCreateMemberResponse, MemberServiceCreateMemberError, MemberServiceCreateMemberConsentData, MemberServiceCreateMemberConsentResponse, MemberServiceCreateMemberConsentError, MemberServiceDeleteMemberData, MemberServiceDeleteMemberError, MemberServiceGetMemberData, MemberServiceGetMemberResponse, MemberServiceGetMemberError, MemberServiceUpdateMemberData, MemberServiceUpdateMemberResponse, MemberServiceUpdateMemberError, MemberServiceListMemberConsentsData, MemberServiceListMemberConsentsResponse, MemberServiceListMemberConsentsError, MemberServiceRevokeMemberConsentData, MemberServiceRevokeMemberConsentResponse, MemberServiceRevokeMemberConsentError, MemberNoteServiceListMemberNotesData, MemberNoteServiceListMemberNotesResponse, MemberNoteServiceListMemberNotesError, MemberNoteServiceDeleteMemberNoteData, MemberNoteServiceDeleteMemberNoteError, MemberServiceListMemberRelationshipsData, MemberServiceListMemberRelationshipsResponse, MemberServiceListMemberRelationshipsError, MemberServiceDeleteMemberRelationshipData, MemberServiceDeleteMemberRelationshipError, FeeServiceDeleteSepaMandateData, FeeServiceDeleteSepaMandateError, FeeServiceGetSepaMandateData, FeeServiceGetSepaMandateResponse, FeeServiceGetSepaMandateError, MemberNoteServiceListMemberTimelineData, MemberNoteServiceListMemberTimelineResponse, MemberNoteServiceListMemberTimelineError, MemberNoteServiceCreateMemberNoteData, MemberNoteServiceCreateMemberNoteResponse, MemberNoteServiceCreateMemberNoteError, MemberNoteServiceUpdateMemberNoteData, MemberNoteServiceUpdateMemberNoteResponse, MemberNoteServiceUpdateMemberNoteError, MemberServiceCreateMemberRelationshipData, MemberServiceCreateMemberRelationshipResponse, MemberServiceCreateMemberRelationshipError, MemberServiceUpdateMemberRelationshipData, MemberServiceUpdateMemberRelationshipResponse, MemberServiceUpdateMemberRelationshipError, FeeServiceSetSepaMandateData, FeeServiceSetSepaMandateResponse, FeeServiceSetSepaMandateError, MemberServiceExportMembersData, MemberServiceExportMembersError, MemberServiceImportMembersData, MemberServiceImportMembersResponse, MemberServiceImportMembersError, MemberServiceSearchMembersData, MemberServiceSearchMembersResponse, MemberServiceSearchMembersError, FeeServiceListMembershipPlansData, FeeServiceListMembershipPlansResponse, FeeServiceListMembershipPlansError, FeeServiceCreateMembershipPlanData, FeeServiceCreateMembershipPlanResponse, FeeServiceCreateMembershipPlanError, FeeServiceDeleteMembershipPlanData, FeeServiceDeleteMembershipPlanError, FeeServiceGetMembershipPlanData, FeeServiceGetMembershipPlanResponse, FeeServiceGetMembershipPlanError, FeeServiceUpdateMembershipPlanData, FeeServiceUpdateMembershipPlanResponse, FeeServiceUpdateMembershipPlanError, FeeServiceListPaymentsData, FeeServiceListPaymentsResponse, FeeServiceListPaymentsError, FeeServiceRecordPaymentData, FeeServiceRecordPaymentResponse, FeeServiceRecordPaymentError, FeeServiceDeletePaymentData, FeeServiceDeletePaymentError, FeeServiceListPlanAssignmentsData, FeeServiceListPlanAssignmentsResponse, FeeServiceListPlanAssignmentsError, FeeServiceAssignMembershipPlanData, FeeServiceAssignMembershipPlanResponse, FeeServiceAssignMembershipPlanError, FeeServiceEndPlanAssignmentData, FeeServiceEndPlanAssignmentResponse, FeeServiceEndPlanAssignmentError, PresenceServiceListPresencesData, PresenceServiceListPresencesResponse, PresenceServiceListPresencesError, PresenceServiceDeletePresenceData, PresenceServiceDeletePresenceError, PresenceServiceUpdatePresenceData, PresenceServiceUpdatePresenceResponse, PresenceServiceUpdatePresenceError, PresenceServiceCheckinData, PresenceServiceCheckinResponse, PresenceServiceCheckinError, PresenceServiceCheckinByCardData, PresenceServiceCheckinByCardResponse, PresenceServiceCheckinByCardError, PresenceServiceCheckoutData, PresenceServiceCheckoutResponse, PresenceServiceCheckoutError, PresenceServiceExportPresencesData, PresenceServiceExportPresencesError, PresenceServiceTogglePresenceData, PresenceServiceTogglePresenceResponse, PresenceServiceTogglePresenceError, ReportServiceGetMachineUsageReportData, ReportServiceGetMachineUsageReportResponse, ReportServiceGetMachineUsageReportError, ReportServiceExportMachineUsageReportData, ReportServiceExportMachineUsageReportError, ReportServiceGetPresenceReportData, ReportServiceGetPresenceReportResponse, ReportServiceGetPresenceReportError, ReportServiceExportPresenceReportData, ReportServiceExportPresenceReportError, MachineServiceListUsageSessionsData, MachineServiceListUsageSessionsResponse, MachineServiceListUsageSessionsError } from './types.gen';
 import { client as _heyApiClient } from './client.gen';
 
 export type Options<TData extends TDataShape = TDataShape, ThrowOnError extends boolean = boolean> = ClientOptions<TData, ThrowOnError> & {
@@ -18,6 +18,118 @@ export type Options<TData extends TDataShape = TDataShape, ThrowOnError extends
     meta?: Record<string, unknown>;
 };
 
+/**
+ * List access decisions
+ * List the log of access decisions, the most recent first
+ */
+export const accessServiceListAccessDecisions = <ThrowOnError extends boolean = false>(options?: Options<AccessServiceListAccessDecisionsData, ThrowOnError>) => {
+    return (options?.client ?? _heyApiClient).get<AccessServiceListAccessDecisionsResponse, AccessServiceListAccessDecisionsError, ThrowOnError>({
+        security: [
+            {
+                scheme: 'bearer',
+                type: 'http'
+            }
+        ],
+        url: '/v1/access/decisions',
+        ...options
+    });
+};
+
+/**
+ * Check access
+ * Decide whether the owner of a card may use a machine. Meant for machine-side controllers, every decision is logged.
+ */
+export const accessServiceCheckAccess = <ThrowOnError extends boolean = false>(options: Options<AccessServiceCheckAccessData, ThrowOnError>) => {
+    return (options.client ?? _heyApiClient).post<AccessServiceCheckAccessResponse, AccessServiceCheckAccessError, ThrowOnError>({
+        security: [
+            {
+                scheme: 'bearer',
+                type: 'http'
+            }
+        ],
+        url: '/v1/access:check',
+        ...options,
+        headers: {
+            'Content-Type': 'application/json',
+            ...options?.headers
+        }
+    });
+};
+
+/**
+ * List applications
+ * List membership applications, the oldest first
+ */
+export const applicationServiceListApplications = <ThrowOnError extends boolean = false>(options?: Options<ApplicationServiceListApplicationsData, ThrowOnError>) => {
+    return (options?.client ?? _heyApiClient).get<ApplicationServiceListApplicationsResponse, ApplicationServiceListApplicationsError, ThrowOnError>({
+        security: [
+            {
+                scheme: 'bearer',
+                type: 'http'
+            }
+        ],
+        url: '/v1/applications',
+        ...options
+    });
+};
+
+/**
+ * Apply for membership
+ * Submit an online membership application. The number of applications per client is limited.
+ */
+export const applicationServiceSubmitApplication = <ThrowOnError extends boolean = false>(options: Options<ApplicationServiceSubmitApplicationData, ThrowOnError>) => {
+    return (options.client ?? _heyApiClient).post<unknown, ApplicationServiceSubmitApplicationError, ThrowOnError>({
+        url: '/v1/applications',
+        ...options,
+        headers: {
+            'Content-Type': 'application/json',
+            ...options?.headers
+        }
+    });
+};
+
+/**
+ * Accept application
+ * Create a member from the application. The member has the same id as the application.
+ */
+export const applicationServiceAcceptApplication = <ThrowOnError extends boolean = false>(options: Options<ApplicationServiceAcceptApplicationData, ThrowOnError>) => {
+    return (options.client ?? _heyApiClient).post<ApplicationServiceAcceptApplicationResponse, ApplicationServiceAcceptApplicationError, ThrowOnError>({
+        security: [
+            {
+                scheme: 'bearer',
+                type: 'http'
+            }
+        ],
+        url: '/v1/applications/{id}:accept',
+        ...options,
+        headers: {
+            'Content-Type': 'application/json',
+            ...options?.headers
+        }
+    });
+};
+
+/**
+ * Reject application
+ * Reject the application, no member is created
+ */
+export const applicationServiceRejectApplication = <ThrowOnError extends boolean = false>(options: Options<ApplicationServiceRejectApplicationData, ThrowOnError>) => {
+    return (options.client ?? _heyApiClient).post<ApplicationServiceRejectApplicationResponse, ApplicationServiceRejectApplicationError, ThrowOnError>({
+        security: [
+            {
+                scheme: 'bearer',
+                type: 'http'
+            }
+        ],
+        url: '/v1/applications/{id}:reject',
+        ...options,
+        headers: {
+            'Content-Type': 'application/json',
+            ...options?.headers
+        }
+    });
+};
+
 /**
  * Login
  * Authenticate with our-space
@@ -63,6 +175,23 @@ export const authServiceRefresh = <ThrowOnError extends boolean = false>(options
     });
 };
 
+/**
+ * List balances
+ * List the balance of members, i.e. the difference between invoiced fees and payments
+ */
+export const feeServiceListBalances = <ThrowOnError extends boolean = false>(options?: Options<FeeServiceListBalancesData, ThrowOnError>) => {
+    return (options?.client ?? _heyApiClient).get<FeeServiceListBalancesResponse, FeeServiceListBalancesError, ThrowOnError>({
+        security: [
+            {
+                scheme: 'bearer',
+                type: 'http'
+            }
+        ],
+        url: '/v1/balances',
+        ...options
+    });
+};
+
 /**
  * List briefing types
  * List all registered briefing types
@@ -342,45 +471,53 @@ export const cardServiceGetCard = <ThrowOnError extends boolean = false>(options
     });
 };
 
-export const memberServiceListMemberAttributes = <ThrowOnError extends boolean = false>(options?: Options<MemberServiceListMemberAttributesData, ThrowOnError>) => {
-    return (options?.client ?? _heyApiClient).get<MemberServiceListMemberAttributesResponse, MemberServiceListMemberAttributesError, ThrowOnError>({
+/**
+ * Export cards
+ * Export all cards matching the filters as CSV or XLSX file
+ */
+export const cardServiceExportCards = <ThrowOnError extends boolean = false>(options?: Options<CardServiceExportCardsData, ThrowOnError>) => {
+    return (options?.client ?? _heyApiClient).get<unknown, CardServiceExportCardsError, ThrowOnError>({
         security: [
             {
                 scheme: 'bearer',
                 type: 'http'
             }
         ],
-        url: '/v1/member-attributes',
+        url: '/v1/cards:export',
         ...options
     });
 };
 
-export const memberServiceCreateMemberAttribute = <ThrowOnError extends boolean = false>(options: Options<MemberServiceCreateMemberAttributeData, ThrowOnError>) => {
-    return (options.client ?? _heyApiClient).post<MemberServiceCreateMemberAttributeResponse, MemberServiceCreateMemberAttributeError, ThrowOnError>({
+/**
+ * List events
+ * List events, optionally limited to a time range
+ */
+export const eventServiceListEvents = <ThrowOnError extends boolean = false>(options?: Options<EventServiceListEventsData, ThrowOnError>) => {
+    return (options?.client ?? _heyApiClient).get<EventServiceListEventsResponse, EventServiceListEventsError, ThrowOnError>({
         security: [
             {
                 scheme: 'bearer',
                 type: 'http'
             }
         ],
-        url: '/v1/member-attributes',
-        ...options,
-        headers: {
-            'Content-Type': 'application/json',
-            ...options?.headers
-        }
+        url: '/v1/events',
+        ...options
     });
 };
 
-export const memberServiceUpdateMemberAttribute = <ThrowOnError extends boolean = false>(options: Options<MemberServiceUpdateMemberAttributeData, ThrowOnError>) => {
-    return (options.client ?? _heyApiClient).patch<MemberServiceUpdateMemberAttributeResponse, MemberServiceUpdateMemberAttributeError, ThrowOnError>({
+/**
+ * Create event
+ * Create a workshop or event members can register for
+ */
+export const eventServiceCreateEvent = <ThrowOnError extends boolean = false>(options: Options<EventServiceCreateEventData, ThrowOnError>) => {
+    return (options.client ?? _heyApiClient).post<EventServiceCreateEventResponse, EventServiceCreateEventError, ThrowOnError>({
         security: [
             {
                 scheme: 'bearer',
                 type: 'http'
             }
         ],
-        url: '/v1/member-attributes/{attribute.id}',
+        url: '/v1/events',
         ...options,
         headers: {
             'Content-Type': 'application/json',
@@ -389,79 +526,99 @@ export const memberServiceUpdateMemberAttribute = <ThrowOnError extends boolean
     });
 };
 
-export const memberServiceDeleteMemberAttribute = <ThrowOnError extends boolean = false>(options: Options<MemberServiceDeleteMemberAttributeData, ThrowOnError>) => {
-    return (options.client ?? _heyApiClient).delete<unknown, MemberServiceDeleteMemberAttributeError, ThrowOnError>({
+/**
+ * Update event
+ * Update specified fields of an event. Raising the capacity moves members up from the waitlist.
+ */
+export const eventServiceUpdateEvent = <ThrowOnError extends boolean = false>(options: Options<EventServiceUpdateEventData, ThrowOnError>) => {
+    return (options.client ?? _heyApiClient).patch<EventServiceUpdateEventResponse, EventServiceUpdateEventError, ThrowOnError>({
         security: [
             {
                 scheme: 'bearer',
                 type: 'http'
             }
         ],
-        url: '/v1/member-attributes/{id}',
-        ...options
+        url: '/v1/events/{event.id}',
+        ...options,
+        headers: {
+            'Content-Type': 'application/json',
+            ...options?.headers
+        }
     });
 };
 
-export const memberServiceGetMemberAttribute = <ThrowOnError extends boolean = false>(options: Options<MemberServiceGetMemberAttributeData, ThrowOnError>) => {
-    return (options.client ?? _heyApiClient).get<MemberServiceGetMemberAttributeResponse, MemberServiceGetMemberAttributeError, ThrowOnError>({
+/**
+ * List registrations
+ * List the registrations of an event in the order they were made
+ */
+export const eventServiceListEventRegistrations = <ThrowOnError extends boolean = false>(options: Options<EventServiceListEventRegistrationsData, ThrowOnError>) => {
+    return (options.client ?? _heyApiClient).get<EventServiceListEventRegistrationsResponse, EventServiceListEventRegistrationsError, ThrowOnError>({
         security: [
             {
                 scheme: 'bearer',
                 type: 'http'
             }
         ],
-        url: '/v1/member-attributes/{id}',
+        url: '/v1/events/{event_id}/registrations',
         ...options
     });
 };
 
 /**
- * List member tags
- * List all possible tags that appear on members so far
+ * Register for event
+ * Register a member for an event. If the event is full, the member is put on the waitlist.
  */
-export const memberServiceListMemberTags = <ThrowOnError extends boolean = false>(options?: Options<MemberServiceListMemberTagsData, ThrowOnError>) => {
-    return (options?.client ?? _heyApiClient).get<MemberServiceListMemberTagsResponse, MemberServiceListMemberTagsError, ThrowOnError>({
+export const eventServiceRegisterForEvent = <ThrowOnError extends boolean = false>(options: Options<EventServiceRegisterForEventData, ThrowOnError>) => {
+    return (options.client ?? _heyApiClient).post<EventServiceRegisterForEventResponse, EventServiceRegisterForEventError, ThrowOnError>({
         security: [
             {
                 scheme: 'bearer',
                 type: 'http'
             }
         ],
-        url: '/v1/member-tags',
-        ...options
+        url: '/v1/events/{event_id}/registrations',
+        ...options,
+        headers: {
+            'Content-Type': 'application/json',
+            ...options?.headers
+        }
     });
 };
 
 /**
- * List members
- * List all registered members
+ * Mark attendance
+ * Manually mark whether a registered member attended. Attendance is also derived from presences automatically.
  */
-export const memberServiceListMembers = <ThrowOnError extends boolean = false>(options?: Options<MemberServiceListMembersData, ThrowOnError>) => {
-    return (options?.client ?? _heyApiClient).get<MemberServiceListMembersResponse, MemberServiceListMembersError, ThrowOnError>({
+export const eventServiceMarkEventAttendance = <ThrowOnError extends boolean = false>(options: Options<EventServiceMarkEventAttendanceData, ThrowOnError>) => {
+    return (options.client ?? _heyApiClient).post<EventServiceMarkEventAttendanceResponse, EventServiceMarkEventAttendanceError, ThrowOnError>({
         security: [
             {
                 scheme: 'bearer',
                 type: 'http'
             }
         ],
-        url: '/v1/members',
-        ...options
+        url: '/v1/events/{event_id}/registrations/{id}:attendance',
+        ...options,
+        headers: {
+            'Content-Type': 'application/json',
+            ...options?.headers
+        }
     });
 };
 
 /**
- * Create Member
- * Create Space Member
+ * Cancel registration
+ * Cancel a registration. The freed place goes to the first member on the waitlist.
  */
-export const memberServiceCreateMember = <ThrowOnError extends boolean = false>(options: Options<MemberServiceCreateMemberData, ThrowOnError>) => {
-    return (options.client ?? _heyApiClient).post<MemberServiceCreateMemberResponse, MemberServiceCreateMemberError, ThrowOnError>({
+export const eventServiceCancelEventRegistration = <ThrowOnError extends boolean = false>(options: Options<EventServiceCancelEventRegistrationData, ThrowOnError>) => {
+    return (options.client ?? _heyApiClient).post<EventServiceCancelEventRegistrationResponse, EventServiceCancelEventRegistrationError, ThrowOnError>({
         security: [
             {
                 scheme: 'bearer',
                 type: 'http'
             }
         ],
-        url: '/v1/members',
+        url: '/v1/events/{event_id}/registrations/{id}:cancel',
         ...options,
         headers: {
             'Content-Type': 'application/json',
@@ -471,52 +628,69 @@ export const memberServiceCreateMember = <ThrowOnError extends boolean = false>(
 };
 
 /**
- * Delete member
- * Delete the specified member
+ * Delete event
+ * Delete the event and all registrations for it
  */
-export const memberServiceDeleteMember = <ThrowOnError extends boolean = false>(options: Options<MemberServiceDeleteMemberData, ThrowOnError>) => {
-    return (options.client ?? _heyApiClient).delete<unknown, MemberServiceDeleteMemberError, ThrowOnError>({
+export const eventServiceDeleteEvent = <ThrowOnError extends boolean = false>(options: Options<EventServiceDeleteEventData, ThrowOnError>) => {
+    return (options.client ?? _heyApiClient).delete<unknown, EventServiceDeleteEventError, ThrowOnError>({
         security: [
             {
                 scheme: 'bearer',
                 type: 'http'
             }
         ],
-        url: '/v1/members/{id}',
+        url: '/v1/events/{id}',
         ...options
     });
 };
 
 /**
- * Get member
- * Get member information
+ * Get event
+ * Get event information
  */
-export const memberServiceGetMember = <ThrowOnError extends boolean = false>(options: Options<MemberServiceGetMemberData, ThrowOnError>) => {
-    return (options.client ?? _heyApiClient).get<MemberServiceGetMemberResponse, MemberServiceGetMemberError, ThrowOnError>({
+export const eventServiceGetEvent = <ThrowOnError extends boolean = false>(options: Options<EventServiceGetEventData, ThrowOnError>) => {
+    return (options.client ?? _heyApiClient).get<EventServiceGetEventResponse, EventServiceGetEventError, ThrowOnError>({
         security: [
             {
                 scheme: 'bearer',
                 type: 'http'
             }
         ],
-        url: '/v1/members/{id}',
+        url: '/v1/events/{id}',
         ...options
     });
 };
 
 /**
- * Update member
- * Update specified fields of members
+ * List invoices
+ * List invoices, the most recent billing period first
  */
-export const memberServiceUpdateMember = <ThrowOnError extends boolean = false>(options: Options<MemberServiceUpdateMemberData, ThrowOnError>) => {
-    return (options.client ?? _heyApiClient).patch<MemberServiceUpdateMemberResponse, MemberServiceUpdateMemberError, ThrowOnError>({
+export const feeServiceListInvoices = <ThrowOnError extends boolean = false>(options?: Options<FeeServiceListInvoicesData, ThrowOnError>) => {
+    return (options?.client ?? _heyApiClient).get<FeeServiceListInvoicesResponse, FeeServiceListInvoicesError, ThrowOnError>({
         security: [
             {
                 scheme: 'bearer',
                 type: 'http'
             }
         ],
-        url: '/v1/members/{member.id}',
+        url: '/v1/invoices',
+        ...options
+    });
+};
+
+/**
+ * Cancel invoice
+ * Cancel an invoice, e.g. if a fee was waived. Cancelled invoices do not count towards the balance.
+ */
+export const feeServiceCancelInvoice = <ThrowOnError extends boolean = false>(options: Options<FeeServiceCancelInvoiceData, ThrowOnError>) => {
+    return (options.client ?? _heyApiClient).post<FeeServiceCancelInvoiceResponse, FeeServiceCancelInvoiceError, ThrowOnError>({
+        security: [
+            {
+                scheme: 'bearer',
+                type: 'http'
+            }
+        ],
+        url: '/v1/invoices/{id}:cancel',
         ...options,
         headers: {
             'Content-Type': 'application/json',
@@ -526,52 +700,77 @@ export const memberServiceUpdateMember = <ThrowOnError extends boolean = false>(
 };
 
 /**
- * List presences
- * List precenses, where members have checked in/out
+ * Export SEPA direct debit
+ * Generate a pain.008 file collecting the unpaid amount of all open invoices of a period. Members with missing or invalid mandate data are reported and left out. Invoices of earlier exports are left out unless include_exported is set. No payments are recorded, record them once the bank confirmed the collection.
  */
-export const presenceServiceListPresences = <ThrowOnError extends boolean = false>(options?: Options<PresenceServiceListPresencesData, ThrowOnError>) => {
-    return (options?.client ?? _heyApiClient).get<PresenceServiceListPresencesResponse, PresenceServiceListPresencesError, ThrowOnError>({
+export const feeServiceExportSepaDirectDebit = <ThrowOnError extends boolean = false>(options: Options<FeeServiceExportSepaDirectDebitData, ThrowOnError>) => {
+    return (options.client ?? _heyApiClient).post<FeeServiceExportSepaDirectDebitResponse, FeeServiceExportSepaDirectDebitError, ThrowOnError>({
         security: [
             {
                 scheme: 'bearer',
                 type: 'http'
             }
         ],
-        url: '/v1/presences',
-        ...options
+        url: '/v1/invoices:export-sepa',
+        ...options,
+        headers: {
+            'Content-Type': 'application/json',
+            ...options?.headers
+        }
     });
 };
 
 /**
- * Delete Presence
- * Delete a presence record
+ * Generate invoices
+ * Generate the dues of all billing periods starting before the given time. Periods are only billed once, so this is safe to repeat.
  */
-export const presenceServiceDeletePresence = <ThrowOnError extends boolean = false>(options: Options<PresenceServiceDeletePresenceData, ThrowOnError>) => {
-    return (options.client ?? _heyApiClient).delete<unknown, PresenceServiceDeletePresenceError, ThrowOnError>({
+export const feeServiceGenerateInvoices = <ThrowOnError extends boolean = false>(options: Options<FeeServiceGenerateInvoicesData, ThrowOnError>) => {
+    return (options.client ?? _heyApiClient).post<FeeServiceGenerateInvoicesResponse, FeeServiceGenerateInvoicesError, ThrowOnError>({
         security: [
             {
                 scheme: 'bearer',
                 type: 'http'
             }
         ],
-        url: '/v1/presences/{id}',
+        url: '/v1/invoices:generate',
+        ...options,
+        headers: {
+            'Content-Type': 'application/json',
+            ...options?.headers
+        }
+    });
+};
+
+/**
+ * List items
+ * List the lendable items in the inventory
+ */
+export const lendingServiceListItems = <ThrowOnError extends boolean = false>(options?: Options<LendingServiceListItemsData, ThrowOnError>) => {
+    return (options?.client ?? _heyApiClient).get<LendingServiceListItemsResponse, LendingServiceListItemsError, ThrowOnError>({
+        security: [
+            {
+                scheme: 'bearer',
+                type: 'http'
+            }
+        ],
+        url: '/v1/items',
         ...options
     });
 };
 
 /**
- * Update presence
- * Updates a presence. Usual operation should be via checkin/checkout instead of update
+ * Create item
+ * Add a lendable item to the inventory
  */
-export const presenceServiceUpdatePresence = <ThrowOnError extends boolean = false>(options: Options<PresenceServiceUpdatePresenceData, ThrowOnError>) => {
-    return (options.client ?? _heyApiClient).post<PresenceServiceUpdatePresenceResponse, PresenceServiceUpdatePresenceError, ThrowOnError>({
+export const lendingServiceCreateItem = <ThrowOnError extends boolean = false>(options: Options<LendingServiceCreateItemData, ThrowOnError>) => {
+    return (options.client ?? _heyApiClient).post<LendingServiceCreateItemResponse, LendingServiceCreateItemError, ThrowOnError>({
         security: [
             {
                 scheme: 'bearer',
                 type: 'http'
             }
         ],
-        url: '/v1/presences/{presence.id}',
+        url: '/v1/items',
         ...options,
         headers: {
             'Content-Type': 'application/json',
@@ -581,43 +780,1586 @@ export const presenceServiceUpdatePresence = <ThrowOnError extends boolean = fal
 };
 
 /**
- * Check in
- * Check in a member, this creates a new presence
+ * Delete item
+ * Remove an item and its lending history from the inventory
  */
-export const presenceServiceCheckin = <ThrowOnError extends boolean = false>(options: Options<PresenceServiceCheckinData, ThrowOnError>) => {
-    return (options.client ?? _heyApiClient).post<PresenceServiceCheckinResponse, PresenceServiceCheckinError, ThrowOnError>({
+export const lendingServiceDeleteItem = <ThrowOnError extends boolean = false>(options: Options<LendingServiceDeleteItemData, ThrowOnError>) => {
+    return (options.client ?? _heyApiClient).delete<unknown, LendingServiceDeleteItemError, ThrowOnError>({
         security: [
             {
                 scheme: 'bearer',
                 type: 'http'
             }
         ],
-        url: '/v1/presences:checkin',
-        ...options,
-        headers: {
-            'Content-Type': 'application/json',
-            ...options?.headers
-        }
+        url: '/v1/items/{id}',
+        ...options
     });
 };
 
 /**
- * Check out
- * Check out a member, ends an open presence if there is one
+ * Get item
+ * Get item information
  */
-export const presenceServiceCheckout = <ThrowOnError extends boolean = false>(options: Options<PresenceServiceCheckoutData, ThrowOnError>) => {
-    return (options.client ?? _heyApiClient).post<PresenceServiceCheckoutResponse, PresenceServiceCheckoutError, ThrowOnError>({
+export const lendingServiceGetItem = <ThrowOnError extends boolean = false>(options: Options<LendingServiceGetItemData, ThrowOnError>) => {
+    return (options.client ?? _heyApiClient).get<LendingServiceGetItemResponse, LendingServiceGetItemError, ThrowOnError>({
         security: [
             {
                 scheme: 'bearer',
                 type: 'http'
             }
         ],
-        url: '/v1/presences:checkout',
+        url: '/v1/items/{id}',
+        ...options
+    });
+};
+
+/**
+ * Update item
+ * Update specified fields of an item
+ */
+export const lendingServiceUpdateItem = <ThrowOnError extends boolean = false>(options: Options<LendingServiceUpdateItemData, ThrowOnError>) => {
+    return (options.client ?? _heyApiClient).patch<LendingServiceUpdateItemResponse, LendingServiceUpdateItemError, ThrowOnError>({
+        security: [
+            {
+                scheme: 'bearer',
+                type: 'http'
+            }
+        ],
+        url: '/v1/items/{item.id}',
         ...options,
         headers: {
             'Content-Type': 'application/json',
             ...options?.headers
         }
     });
+};
+
+/**
+ * List loans
+ * List the lending ledger, e.g. all overdue items
+ */
+export const lendingServiceListLoans = <ThrowOnError extends boolean = false>(options?: Options<LendingServiceListLoansData, ThrowOnError>) => {
+    return (options?.client ?? _heyApiClient).get<LendingServiceListLoansResponse, LendingServiceListLoansError, ThrowOnError>({
+        security: [
+            {
+                scheme: 'bearer',
+                type: 'http'
+            }
+        ],
+        url: '/v1/loans',
+        ...options
+    });
+};
+
+/**
+ * Lend item
+ * Lend an item to a member
+ */
+export const lendingServiceLendItem = <ThrowOnError extends boolean = false>(options: Options<LendingServiceLendItemData, ThrowOnError>) => {
+    return (options.client ?? _heyApiClient).post<LendingServiceLendItemResponse, LendingServiceLendItemError, ThrowOnError>({
+        security: [
+            {
+                scheme: 'bearer',
+                type: 'http'
+            }
+        ],
+        url: '/v1/loans',
+        ...options,
+        headers: {
+            'Content-Type': 'application/json',
+            ...options?.headers
+        }
+    });
+};
+
+/**
+ * Get loan
+ * Get a single entry of the lending ledger
+ */
+export const lendingServiceGetLoan = <ThrowOnError extends boolean = false>(options: Options<LendingServiceGetLoanData, ThrowOnError>) => {
+    return (options.client ?? _heyApiClient).get<LendingServiceGetLoanResponse, LendingServiceGetLoanError, ThrowOnError>({
+        security: [
+            {
+                scheme: 'bearer',
+                type: 'http'
+            }
+        ],
+        url: '/v1/loans/{id}',
+        ...options
+    });
+};
+
+/**
+ * Return item
+ * Return a lent item and record its condition
+ */
+export const lendingServiceReturnItem = <ThrowOnError extends boolean = false>(options: Options<LendingServiceReturnItemData, ThrowOnError>) => {
+    return (options.client ?? _heyApiClient).post<LendingServiceReturnItemResponse, LendingServiceReturnItemError, ThrowOnError>({
+        security: [
+            {
+                scheme: 'bearer',
+                type: 'http'
+            }
+        ],
+        url: '/v1/loans/{id}:return',
+        ...options,
+        headers: {
+            'Content-Type': 'application/json',
+            ...options?.headers
+        }
+    });
+};
+
+/**
+ * Return item by scan
+ * Return the item with the scanned tag and record its condition
+ */
+export const lendingServiceReturnItemByScan = <ThrowOnError extends boolean = false>(options: Options<LendingServiceReturnItemByScanData, ThrowOnError>) => {
+    return (options.client ?? _heyApiClient).post<LendingServiceReturnItemByScanResponse, LendingServiceReturnItemByScanError, ThrowOnError>({
+        security: [
+            {
+                scheme: 'bearer',
+                type: 'http'
+            }
+        ],
+        url: '/v1/loans:return-scan',
+        ...options,
+        headers: {
+            'Content-Type': 'application/json',
+            ...options?.headers
+        }
+    });
+};
+
+/**
+ * Lend item by scan
+ * Lend an item on a terminal by scanning the member card and then the item tag
+ */
+export const lendingServiceLendItemByScan = <ThrowOnError extends boolean = false>(options: Options<LendingServiceLendItemByScanData, ThrowOnError>) => {
+    return (options.client ?? _heyApiClient).post<LendingServiceLendItemByScanResponse, LendingServiceLendItemByScanError, ThrowOnError>({
+        security: [
+            {
+                scheme: 'bearer',
+                type: 'http'
+            }
+        ],
+        url: '/v1/loans:scan',
+        ...options,
+        headers: {
+            'Content-Type': 'application/json',
+            ...options?.headers
+        }
+    });
+};
+
+/**
+ * List machines
+ * List all registered machines
+ */
+export const machineServiceListMachines = <ThrowOnError extends boolean = false>(options?: Options<MachineServiceListMachinesData, ThrowOnError>) => {
+    return (options?.client ?? _heyApiClient).get<MachineServiceListMachinesResponse, MachineServiceListMachinesError, ThrowOnError>({
+        security: [
+            {
+                scheme: 'bearer',
+                type: 'http'
+            }
+        ],
+        url: '/v1/machines',
+        ...options
+    });
+};
+
+/**
+ * Create machine
+ * Register a machine or resource that is gated behind briefings
+ */
+export const machineServiceCreateMachine = <ThrowOnError extends boolean = false>(options: Options<MachineServiceCreateMachineData, ThrowOnError>) => {
+    return (options.client ?? _heyApiClient).post<MachineServiceCreateMachineResponse, MachineServiceCreateMachineError, ThrowOnError>({
+        security: [
+            {
+                scheme: 'bearer',
+                type: 'http'
+            }
+        ],
+        url: '/v1/machines',
+        ...options,
+        headers: {
+            'Content-Type': 'application/json',
+            ...options?.headers
+        }
+    });
+};
+
+/**
+ * Delete machine
+ * Delete the specified machine
+ */
+export const machineServiceDeleteMachine = <ThrowOnError extends boolean = false>(options: Options<MachineServiceDeleteMachineData, ThrowOnError>) => {
+    return (options.client ?? _heyApiClient).delete<unknown, MachineServiceDeleteMachineError, ThrowOnError>({
+        security: [
+            {
+                scheme: 'bearer',
+                type: 'http'
+            }
+        ],
+        url: '/v1/machines/{id}',
+        ...options
+    });
+};
+
+/**
+ * Get machine
+ * Get machine information
+ */
+export const machineServiceGetMachine = <ThrowOnError extends boolean = false>(options: Options<MachineServiceGetMachineData, ThrowOnError>) => {
+    return (options.client ?? _heyApiClient).get<MachineServiceGetMachineResponse, MachineServiceGetMachineError, ThrowOnError>({
+        security: [
+            {
+                scheme: 'bearer',
+                type: 'http'
+            }
+        ],
+        url: '/v1/machines/{id}',
+        ...options
+    });
+};
+
+/**
+ * Update machine
+ * Update specified fields of a machine
+ */
+export const machineServiceUpdateMachine = <ThrowOnError extends boolean = false>(options: Options<MachineServiceUpdateMachineData, ThrowOnError>) => {
+    return (options.client ?? _heyApiClient).patch<MachineServiceUpdateMachineResponse, MachineServiceUpdateMachineError, ThrowOnError>({
+        security: [
+            {
+                scheme: 'bearer',
+                type: 'http'
+            }
+        ],
+        url: '/v1/machines/{machine.id}',
+        ...options,
+        headers: {
+            'Content-Type': 'application/json',
+            ...options?.headers
+        }
+    });
+};
+
+/**
+ * Start usage session
+ * Start using a machine. The member needs all briefings the machine requires and a machine can only be used by one member at a time.
+ */
+export const machineServiceStartUsageSession = <ThrowOnError extends boolean = false>(options: Options<MachineServiceStartUsageSessionData, ThrowOnError>) => {
+    return (options.client ?? _heyApiClient).post<MachineServiceStartUsageSessionResponse, MachineServiceStartUsageSessionError, ThrowOnError>({
+        security: [
+            {
+                scheme: 'bearer',
+                type: 'http'
+            }
+        ],
+        url: '/v1/machines/{machine_id}/sessions:start',
+        ...options,
+        headers: {
+            'Content-Type': 'application/json',
+            ...options?.headers
+        }
+    });
+};
+
+/**
+ * Stop usage session
+ * Stop the running usage session of a machine
+ */
+export const machineServiceStopUsageSession = <ThrowOnError extends boolean = false>(options: Options<MachineServiceStopUsageSessionData, ThrowOnError>) => {
+    return (options.client ?? _heyApiClient).post<MachineServiceStopUsageSessionResponse, MachineServiceStopUsageSessionError, ThrowOnError>({
+        security: [
+            {
+                scheme: 'bearer',
+                type: 'http'
+            }
+        ],
+        url: '/v1/machines/{machine_id}/sessions:stop',
+        ...options,
+        headers: {
+            'Content-Type': 'application/json',
+            ...options?.headers
+        }
+    });
+};
+
+/**
+ * Get my profile
+ * Get the member who is logged in
+ */
+export const selfServiceGetMyProfile = <ThrowOnError extends boolean = false>(options?: Options<SelfServiceGetMyProfileData, ThrowOnError>) => {
+    return (options?.client ?? _heyApiClient).get<SelfServiceGetMyProfileResponse, SelfServiceGetMyProfileError, ThrowOnError>({
+        security: [
+            {
+                scheme: 'bearer',
+                type: 'http'
+            }
+        ],
+        url: '/v1/me',
+        ...options
+    });
+};
+
+/**
+ * Update my profile
+ * Update the fields and attributes members may change themselves
+ */
+export const selfServiceUpdateMyProfile = <ThrowOnError extends boolean = false>(options: Options<SelfServiceUpdateMyProfileData, ThrowOnError>) => {
+    return (options.client ?? _heyApiClient).patch<SelfServiceUpdateMyProfileResponse, SelfServiceUpdateMyProfileError, ThrowOnError>({
+        security: [
+            {
+                scheme: 'bearer',
+                type: 'http'
+            }
+        ],
+        url: '/v1/me',
+        ...options,
+        headers: {
+            'Content-Type': 'application/json',
+            ...options?.headers
+        }
+    });
+};
+
+/**
+ * List my briefings
+ * List the briefings of the member who is logged in
+ */
+export const selfServiceListMyBriefings = <ThrowOnError extends boolean = false>(options?: Options<SelfServiceListMyBriefingsData, ThrowOnError>) => {
+    return (options?.client ?? _heyApiClient).get<SelfServiceListMyBriefingsResponse, SelfServiceListMyBriefingsError, ThrowOnError>({
+        security: [
+            {
+                scheme: 'bearer',
+                type: 'http'
+            }
+        ],
+        url: '/v1/me/briefings',
+        ...options
+    });
+};
+
+/**
+ * List my cards
+ * List the cards of the member who is logged in
+ */
+export const selfServiceListMyCards = <ThrowOnError extends boolean = false>(options?: Options<SelfServiceListMyCardsData, ThrowOnError>) => {
+    return (options?.client ?? _heyApiClient).get<SelfServiceListMyCardsResponse, SelfServiceListMyCardsError, ThrowOnError>({
+        security: [
+            {
+                scheme: 'bearer',
+                type: 'http'
+            }
+        ],
+        url: '/v1/me/cards',
+        ...options
+    });
+};
+
+/**
+ * List my change requests
+ * List the change requests of the member who is logged in, the latest first
+ */
+export const selfServiceListMyChangeRequests = <ThrowOnError extends boolean = false>(options?: Options<SelfServiceListMyChangeRequestsData, ThrowOnError>) => {
+    return (options?.client ?? _heyApiClient).get<SelfServiceListMyChangeRequestsResponse, SelfServiceListMyChangeRequestsError, ThrowOnError>({
+        security: [
+            {
+                scheme: 'bearer',
+                type: 'http'
+            }
+        ],
+        url: '/v1/me/change-requests',
+        ...options
+    });
+};
+
+/**
+ * Request change
+ * Request a change of fields and attributes that have to be approved by staff
+ */
+export const selfServiceCreateMyChangeRequest = <ThrowOnError extends boolean = false>(options: Options<SelfServiceCreateMyChangeRequestData, ThrowOnError>) => {
+    return (options.client ?? _heyApiClient).post<SelfServiceCreateMyChangeRequestResponse, SelfServiceCreateMyChangeRequestError, ThrowOnError>({
+        security: [
+            {
+                scheme: 'bearer',
+                type: 'http'
+            }
+        ],
+        url: '/v1/me/change-requests',
+        ...options,
+        headers: {
+            'Content-Type': 'application/json',
+            ...options?.headers
+        }
+    });
+};
+
+/**
+ * List my presences
+ * List the presences of the member who is logged in
+ */
+export const selfServiceListMyPresences = <ThrowOnError extends boolean = false>(options?: Options<SelfServiceListMyPresencesData, ThrowOnError>) => {
+    return (options?.client ?? _heyApiClient).get<SelfServiceListMyPresencesResponse, SelfServiceListMyPresencesError, ThrowOnError>({
+        security: [
+            {
+                scheme: 'bearer',
+                type: 'http'
+            }
+        ],
+        url: '/v1/me/presences',
+        ...options
+    });
+};
+
+export const memberServiceListMemberAttributes = <ThrowOnError extends boolean = false>(options?: Options<MemberServiceListMemberAttributesData, ThrowOnError>) => {
+    return (options?.client ?? _heyApiClient).get<MemberServiceListMemberAttributesResponse, MemberServiceListMemberAttributesError, ThrowOnError>({
+        security: [
+            {
+                scheme: 'bearer',
+                type: 'http'
+            }
+        ],
+        url: '/v1/member-attributes',
+        ...options
+    });
+};
+
+export const memberServiceCreateMemberAttribute = <ThrowOnError extends boolean = false>(options: Options<MemberServiceCreateMemberAttributeData, ThrowOnError>) => {
+    return (options.client ?? _heyApiClient).post<MemberServiceCreateMemberAttributeResponse, MemberServiceCreateMemberAttributeError, ThrowOnError>({
+        security: [
+            {
+                scheme: 'bearer',
+                type: 'http'
+            }
+        ],
+        url: '/v1/member-attributes',
+        ...options,
+        headers: {
+            'Content-Type': 'application/json',
+            ...options?.headers
+        }
+    });
+};
+
+export const memberServiceUpdateMemberAttribute = <ThrowOnError extends boolean = false>(options: Options<MemberServiceUpdateMemberAttributeData, ThrowOnError>) => {
+    return (options.client ?? _heyApiClient).patch<MemberServiceUpdateMemberAttributeResponse, MemberServiceUpdateMemberAttributeError, ThrowOnError>({
+        security: [
+            {
+                scheme: 'bearer',
+                type: 'http'
+            }
+        ],
+        url: '/v1/member-attributes/{attribute.id}',
+        ...options,
+        headers: {
+            'Content-Type': 'application/json',
+            ...options?.headers
+        }
+    });
+};
+
+export const memberServiceDeleteMemberAttribute = <ThrowOnError extends boolean = false>(options: Options<MemberServiceDeleteMemberAttributeData, ThrowOnError>) => {
+    return (options.client ?? _heyApiClient).delete<unknown, MemberServiceDeleteMemberAttributeError, ThrowOnError>({
+        security: [
+            {
+                scheme: 'bearer',
+                type: 'http'
+            }
+        ],
+        url: '/v1/member-attributes/{id}',
+        ...options
+    });
+};
+
+export const memberServiceGetMemberAttribute = <ThrowOnError extends boolean = false>(options: Options<MemberServiceGetMemberAttributeData, ThrowOnError>) => {
+    return (options.client ?? _heyApiClient).get<MemberServiceGetMemberAttributeResponse, MemberServiceGetMemberAttributeError, ThrowOnError>({
+        security: [
+            {
+                scheme: 'bearer',
+                type: 'http'
+            }
+        ],
+        url: '/v1/member-attributes/{id}',
+        ...options
+    });
+};
+
+/**
+ * Migrate Member Attribute
+ * Rename an attribute or change its type and convert the values of all members in one transaction
+ */
+export const memberServiceMigrateMemberAttribute = <ThrowOnError extends boolean = false>(options: Options<MemberServiceMigrateMemberAttributeData, ThrowOnError>) => {
+    return (options.client ?? _heyApiClient).post<MemberServiceMigrateMemberAttributeResponse, MemberServiceMigrateMemberAttributeError, ThrowOnError>({
+        security: [
+            {
+                scheme: 'bearer',
+                type: 'http'
+            }
+        ],
+        url: '/v1/member-attributes/{id}:migrate',
+        ...options,
+        headers: {
+            'Content-Type': 'application/json',
+            ...options?.headers
+        }
+    });
+};
+
+/**
+ * List change requests
+ * List the changes members requested to their own data, the oldest first. Requires the admin or approver role.
+ */
+export const memberServiceListMemberChangeRequests = <ThrowOnError extends boolean = false>(options?: Options<MemberServiceListMemberChangeRequestsData, ThrowOnError>) => {
+    return (options?.client ?? _heyApiClient).get<MemberServiceListMemberChangeRequestsResponse, MemberServiceListMemberChangeRequestsError, ThrowOnError>({
+        security: [
+            {
+                scheme: 'bearer',
+                type: 'http'
+            }
+        ],
+        url: '/v1/member-change-requests',
+        ...options
+    });
+};
+
+/**
+ * Approve change request
+ * Apply the requested changes to the member. The changes are validated like any update of the member. Requires the admin or approver role, staff can't approve their own requests.
+ */
+export const memberServiceApproveMemberChangeRequest = <ThrowOnError extends boolean = false>(options: Options<MemberServiceApproveMemberChangeRequestData, ThrowOnError>) => {
+    return (options.client ?? _heyApiClient).post<MemberServiceApproveMemberChangeRequestResponse, MemberServiceApproveMemberChangeRequestError, ThrowOnError>({
+        security: [
+            {
+                scheme: 'bearer',
+                type: 'http'
+            }
+        ],
+        url: '/v1/member-change-requests/{id}:approve',
+        ...options,
+        headers: {
+            'Content-Type': 'application/json',
+            ...options?.headers
+        }
+    });
+};
+
+/**
+ * Reject change request
+ * Reject the requested changes, the comment should tell the member why. Requires the admin or approver role.
+ */
+export const memberServiceRejectMemberChangeRequest = <ThrowOnError extends boolean = false>(options: Options<MemberServiceRejectMemberChangeRequestData, ThrowOnError>) => {
+    return (options.client ?? _heyApiClient).post<MemberServiceRejectMemberChangeRequestResponse, MemberServiceRejectMemberChangeRequestError, ThrowOnError>({
+        security: [
+            {
+                scheme: 'bearer',
+                type: 'http'
+            }
+        ],
+        url: '/v1/member-change-requests/{id}:reject',
+        ...options,
+        headers: {
+            'Content-Type': 'application/json',
+            ...options?.headers
+        }
+    });
+};
+
+/**
+ * List member tags
+ * List all defined tags and all tags that appear on members so far, with their usage counts
+ */
+export const memberServiceListMemberTags = <ThrowOnError extends boolean = false>(options?: Options<MemberServiceListMemberTagsData, ThrowOnError>) => {
+    return (options?.client ?? _heyApiClient).get<MemberServiceListMemberTagsResponse, MemberServiceListMemberTagsError, ThrowOnError>({
+        security: [
+            {
+                scheme: 'bearer',
+                type: 'http'
+            }
+        ],
+        url: '/v1/member-tags',
+        ...options
+    });
+};
+
+/**
+ * Create member tag
+ * Define a tag with description and color. The tag may already be used by members.
+ */
+export const memberServiceCreateMemberTag = <ThrowOnError extends boolean = false>(options: Options<MemberServiceCreateMemberTagData, ThrowOnError>) => {
+    return (options.client ?? _heyApiClient).post<MemberServiceCreateMemberTagResponse, MemberServiceCreateMemberTagError, ThrowOnError>({
+        security: [
+            {
+                scheme: 'bearer',
+                type: 'http'
+            }
+        ],
+        url: '/v1/member-tags',
+        ...options,
+        headers: {
+            'Content-Type': 'application/json',
+            ...options?.headers
+        }
+    });
+};
+
+/**
+ * Delete member tag
+ * Delete the definition of a tag and optionally remove the tag from all members
+ */
+export const memberServiceDeleteMemberTag = <ThrowOnError extends boolean = false>(options: Options<MemberServiceDeleteMemberTagData, ThrowOnError>) => {
+    return (options.client ?? _heyApiClient).delete<unknown, MemberServiceDeleteMemberTagError, ThrowOnError>({
+        security: [
+            {
+                scheme: 'bearer',
+                type: 'http'
+            }
+        ],
+        url: '/v1/member-tags/{name}',
+        ...options
+    });
+};
+
+/**
+ * Merge member tags
+ * Replace the source tags by the tag on all members at once and delete their definitions
+ */
+export const memberServiceMergeMemberTags = <ThrowOnError extends boolean = false>(options: Options<MemberServiceMergeMemberTagsData, ThrowOnError>) => {
+    return (options.client ?? _heyApiClient).post<MemberServiceMergeMemberTagsResponse, MemberServiceMergeMemberTagsError, ThrowOnError>({
+        security: [
+            {
+                scheme: 'bearer',
+                type: 'http'
+            }
+        ],
+        url: '/v1/member-tags/{name}:merge',
+        ...options,
+        headers: {
+            'Content-Type': 'application/json',
+            ...options?.headers
+        }
+    });
+};
+
+/**
+ * Rename member tag
+ * Rename a tag on all members at once. Use merge if the new name is already used.
+ */
+export const memberServiceRenameMemberTag = <ThrowOnError extends boolean = false>(options: Options<MemberServiceRenameMemberTagData, ThrowOnError>) => {
+    return (options.client ?? _heyApiClient).post<MemberServiceRenameMemberTagResponse, MemberServiceRenameMemberTagError, ThrowOnError>({
+        security: [
+            {
+                scheme: 'bearer',
+                type: 'http'
+            }
+        ],
+        url: '/v1/member-tags/{name}:rename',
+        ...options,
+        headers: {
+            'Content-Type': 'application/json',
+            ...options?.headers
+        }
+    });
+};
+
+/**
+ * Update member tag
+ * Update the description or color of a tag. Use rename to change the name.
+ */
+export const memberServiceUpdateMemberTag = <ThrowOnError extends boolean = false>(options: Options<MemberServiceUpdateMemberTagData, ThrowOnError>) => {
+    return (options.client ?? _heyApiClient).patch<MemberServiceUpdateMemberTagResponse, MemberServiceUpdateMemberTagError, ThrowOnError>({
+        security: [
+            {
+                scheme: 'bearer',
+                type: 'http'
+            }
+        ],
+        url: '/v1/member-tags/{tag.name}',
+        ...options,
+        headers: {
+            'Content-Type': 'application/json',
+            ...options?.headers
+        }
+    });
+};
+
+/**
+ * List members
+ * List all registered members
+ */
+export const memberServiceListMembers = <ThrowOnError extends boolean = false>(options?: Options<MemberServiceListMembersData, ThrowOnError>) => {
+    return (options?.client ?? _heyApiClient).get<MemberServiceListMembersResponse, MemberServiceListMembersError, ThrowOnError>({
+        security: [
+            {
+                scheme: 'bearer',
+                type: 'http'
+            }
+        ],
+        url: '/v1/members',
+        ...options
+    });
+};
+
+/**
+ * Create Member
+ * Create Space Member
+ */
+export const memberServiceCreateMember = <ThrowOnError extends boolean = false>(options: Options<MemberServiceCreateMemberData, ThrowOnError>) => {
+    return (options.client ?? _heyApiClient).post<MemberServiceCreateMemberResponse, MemberServiceCreateMemberError, ThrowOnError>({
+        security: [
+            {
+                scheme: 'bearer',
+                type: 'http'
+            }
+        ],
+        url: '/v1/members',
+        ...options,
+        headers: {
+            'Content-Type': 'application/json',
+            ...options?.headers
+        }
+    });
+};
+
+/**
+ * Record consent
+ * Record that a member or one of their guardians gave consent
+ */
+export const memberServiceCreateMemberConsent = <ThrowOnError extends boolean = false>(options: Options<MemberServiceCreateMemberConsentData, ThrowOnError>) => {
+    return (options.client ?? _heyApiClient).post<MemberServiceCreateMemberConsentResponse, MemberServiceCreateMemberConsentError, ThrowOnError>({
+        security: [
+            {
+                scheme: 'bearer',
+                type: 'http'
+            }
+        ],
+        url: '/v1/members/{consent.member_id}/consents',
+        ...options,
+        headers: {
+            'Content-Type': 'application/json',
+            ...options?.headers
+        }
+    });
+};
+
+/**
+ * Delete member
+ * Delete the specified member
+ */
+export const memberServiceDeleteMember = <ThrowOnError extends boolean = false>(options: Options<MemberServiceDeleteMemberData, ThrowOnError>) => {
+    return (options.client ?? _heyApiClient).delete<unknown, MemberServiceDeleteMemberError, ThrowOnError>({
+        security: [
+            {
+                scheme: 'bearer',
+                type: 'http'
+            }
+        ],
+        url: '/v1/members/{id}',
+        ...options
+    });
+};
+
+/**
+ * Get member
+ * Get member information
+ */
+export const memberServiceGetMember = <ThrowOnError extends boolean = false>(options: Options<MemberServiceGetMemberData, ThrowOnError>) => {
+    return (options.client ?? _heyApiClient).get<MemberServiceGetMemberResponse, MemberServiceGetMemberError, ThrowOnError>({
+        security: [
+            {
+                scheme: 'bearer',
+                type: 'http'
+            }
+        ],
+        url: '/v1/members/{id}',
+        ...options
+    });
+};
+
+/**
+ * Update member
+ * Update specified fields of members
+ */
+export const memberServiceUpdateMember = <ThrowOnError extends boolean = false>(options: Options<MemberServiceUpdateMemberData, ThrowOnError>) => {
+    return (options.client ?? _heyApiClient).patch<MemberServiceUpdateMemberResponse, MemberServiceUpdateMemberError, ThrowOnError>({
+        security: [
+            {
+                scheme: 'bearer',
+                type: 'http'
+            }
+        ],
+        url: '/v1/members/{member.id}',
+        ...options,
+        headers: {
+            'Content-Type': 'application/json',
+            ...options?.headers
+        }
+    });
+};
+
+/**
+ * List consents
+ * List all consents of a member including revoked ones, the latest first
+ */
+export const memberServiceListMemberConsents = <ThrowOnError extends boolean = false>(options: Options<MemberServiceListMemberConsentsData, ThrowOnError>) => {
+    return (options.client ?? _heyApiClient).get<MemberServiceListMemberConsentsResponse, MemberServiceListMemberConsentsError, ThrowOnError>({
+        security: [
+            {
+                scheme: 'bearer',
+                type: 'http'
+            }
+        ],
+        url: '/v1/members/{member_id}/consents',
+        ...options
+    });
+};
+
+/**
+ * Revoke consent
+ * Record that a consent was revoked. Consents are never deleted, so that they can be traced.
+ */
+export const memberServiceRevokeMemberConsent = <ThrowOnError extends boolean = false>(options: Options<MemberServiceRevokeMemberConsentData, ThrowOnError>) => {
+    return (options.client ?? _heyApiClient).post<MemberServiceRevokeMemberConsentResponse, MemberServiceRevokeMemberConsentError, ThrowOnError>({
+        security: [
+            {
+                scheme: 'bearer',
+                type: 'http'
+            }
+        ],
+        url: '/v1/members/{member_id}/consents/{id}:revoke',
+        ...options,
+        headers: {
+            'Content-Type': 'application/json',
+            ...options?.headers
+        }
+    });
+};
+
+/**
+ * List notes
+ * List the notes on a member the caller can see, pinned notes first and then the latest first
+ */
+export const memberNoteServiceListMemberNotes = <ThrowOnError extends boolean = false>(options: Options<MemberNoteServiceListMemberNotesData, ThrowOnError>) => {
+    return (options.client ?? _heyApiClient).get<MemberNoteServiceListMemberNotesResponse, MemberNoteServiceListMemberNotesError, ThrowOnError>({
+        security: [
+            {
+                scheme: 'bearer',
+                type: 'http'
+            }
+        ],
+        url: '/v1/members/{member_id}/notes',
+        ...options
+    });
+};
+
+/**
+ * Delete note
+ * Delete a note. Only the author and admins can delete notes.
+ */
+export const memberNoteServiceDeleteMemberNote = <ThrowOnError extends boolean = false>(options: Options<MemberNoteServiceDeleteMemberNoteData, ThrowOnError>) => {
+    return (options.client ?? _heyApiClient).delete<unknown, MemberNoteServiceDeleteMemberNoteError, ThrowOnError>({
+        security: [
+            {
+                scheme: 'bearer',
+                type: 'http'
+            }
+        ],
+        url: '/v1/members/{member_id}/notes/{id}',
+        ...options
+    });
+};
+
+/**
+ * List relationships
+ * List all relationships of a member
+ */
+export const memberServiceListMemberRelationships = <ThrowOnError extends boolean = false>(options: Options<MemberServiceListMemberRelationshipsData, ThrowOnError>) => {
+    return (options.client ?? _heyApiClient).get<MemberServiceListMemberRelationshipsResponse, MemberServiceListMemberRelationshipsError, ThrowOnError>({
+        security: [
+            {
+                scheme: 'bearer',
+                type: 'http'
+            }
+        ],
+        url: '/v1/members/{member_id}/relationships',
+        ...options
+    });
+};
+
+/**
+ * Delete relationship
+ * Delete a relationship. The last guardian of an underage member can't be deleted if guardians are required.
+ */
+export const memberServiceDeleteMemberRelationship = <ThrowOnError extends boolean = false>(options: Options<MemberServiceDeleteMemberRelationshipData, ThrowOnError>) => {
+    return (options.client ?? _heyApiClient).delete<unknown, MemberServiceDeleteMemberRelationshipError, ThrowOnError>({
+        security: [
+            {
+                scheme: 'bearer',
+                type: 'http'
+            }
+        ],
+        url: '/v1/members/{member_id}/relationships/{id}',
+        ...options
+    });
+};
+
+/**
+ * Delete SEPA mandate
+ * Delete the SEPA direct debit mandate of a member, e.g. after it was revoked
+ */
+export const feeServiceDeleteSepaMandate = <ThrowOnError extends boolean = false>(options: Options<FeeServiceDeleteSepaMandateData, ThrowOnError>) => {
+    return (options.client ?? _heyApiClient).delete<unknown, FeeServiceDeleteSepaMandateError, ThrowOnError>({
+        security: [
+            {
+                scheme: 'bearer',
+                type: 'http'
+            }
+        ],
+        url: '/v1/members/{member_id}/sepa-mandate',
+        ...options
+    });
+};
+
+/**
+ * Get SEPA mandate
+ * Get the SEPA direct debit mandate of a member
+ */
+export const feeServiceGetSepaMandate = <ThrowOnError extends boolean = false>(options: Options<FeeServiceGetSepaMandateData, ThrowOnError>) => {
+    return (options.client ?? _heyApiClient).get<FeeServiceGetSepaMandateResponse, FeeServiceGetSepaMandateError, ThrowOnError>({
+        security: [
+            {
+                scheme: 'bearer',
+                type: 'http'
+            }
+        ],
+        url: '/v1/members/{member_id}/sepa-mandate',
+        ...options
+    });
+};
+
+/**
+ * List timeline
+ * List the notes, card issuances and briefings of a member, the latest first
+ */
+export const memberNoteServiceListMemberTimeline = <ThrowOnError extends boolean = false>(options: Options<MemberNoteServiceListMemberTimelineData, ThrowOnError>) => {
+    return (options.client ?? _heyApiClient).get<MemberNoteServiceListMemberTimelineResponse, MemberNoteServiceListMemberTimelineError, ThrowOnError>({
+        security: [
+            {
+                scheme: 'bearer',
+                type: 'http'
+            }
+        ],
+        url: '/v1/members/{member_id}/timeline',
+        ...options
+    });
+};
+
+/**
+ * Create note
+ * Leave a note on a member, the author is taken from the access token
+ */
+export const memberNoteServiceCreateMemberNote = <ThrowOnError extends boolean = false>(options: Options<MemberNoteServiceCreateMemberNoteData, ThrowOnError>) => {
+    return (options.client ?? _heyApiClient).post<MemberNoteServiceCreateMemberNoteResponse, MemberNoteServiceCreateMemberNoteError, ThrowOnError>({
+        security: [
+            {
+                scheme: 'bearer',
+                type: 'http'
+            }
+        ],
+        url: '/v1/members/{note.member_id}/notes',
+        ...options,
+        headers: {
+            'Content-Type': 'application/json',
+            ...options?.headers
+        }
+    });
+};
+
+/**
+ * Update note
+ * Update the text, pinning or visibility of a note. Only the author and admins can update notes.
+ */
+export const memberNoteServiceUpdateMemberNote = <ThrowOnError extends boolean = false>(options: Options<MemberNoteServiceUpdateMemberNoteData, ThrowOnError>) => {
+    return (options.client ?? _heyApiClient).patch<MemberNoteServiceUpdateMemberNoteResponse, MemberNoteServiceUpdateMemberNoteError, ThrowOnError>({
+        security: [
+            {
+                scheme: 'bearer',
+                type: 'http'
+            }
+        ],
+        url: '/v1/members/{note.member_id}/notes/{note.id}',
+        ...options,
+        headers: {
+            'Content-Type': 'application/json',
+            ...options?.headers
+        }
+    });
+};
+
+/**
+ * Create relationship
+ * Add a guardian, emergency contact or family member to a member
+ */
+export const memberServiceCreateMemberRelationship = <ThrowOnError extends boolean = false>(options: Options<MemberServiceCreateMemberRelationshipData, ThrowOnError>) => {
+    return (options.client ?? _heyApiClient).post<MemberServiceCreateMemberRelationshipResponse, MemberServiceCreateMemberRelationshipError, ThrowOnError>({
+        security: [
+            {
+                scheme: 'bearer',
+                type: 'http'
+            }
+        ],
+        url: '/v1/members/{relationship.member_id}/relationships',
+        ...options,
+        headers: {
+            'Content-Type': 'application/json',
+            ...options?.headers
+        }
+    });
+};
+
+/**
+ * Update relationship
+ * Update specified fields of a relationship
+ */
+export const memberServiceUpdateMemberRelationship = <ThrowOnError extends boolean = false>(options: Options<MemberServiceUpdateMemberRelationshipData, ThrowOnError>) => {
+    return (options.client ?? _heyApiClient).patch<MemberServiceUpdateMemberRelationshipResponse, MemberServiceUpdateMemberRelationshipError, ThrowOnError>({
+        security: [
+            {
+                scheme: 'bearer',
+                type: 'http'
+            }
+        ],
+        url: '/v1/members/{relationship.member_id}/relationships/{relationship.id}',
+        ...options,
+        headers: {
+            'Content-Type': 'application/json',
+            ...options?.headers
+        }
+    });
+};
+
+/**
+ * Set SEPA mandate
+ * Store or replace the SEPA direct debit mandate of a member
+ */
+export const feeServiceSetSepaMandate = <ThrowOnError extends boolean = false>(options: Options<FeeServiceSetSepaMandateData, ThrowOnError>) => {
+    return (options.client ?? _heyApiClient).put<FeeServiceSetSepaMandateResponse, FeeServiceSetSepaMandateError, ThrowOnError>({
+        security: [
+            {
+                scheme: 'bearer',
+                type: 'http'
+            }
+        ],
+        url: '/v1/members/{sepa_mandate.member_id}/sepa-mandate',
+        ...options,
+        headers: {
+            'Content-Type': 'application/json',
+            ...options?.headers
+        }
+    });
+};
+
+/**
+ * Export members
+ * Export all members matching the filters as CSV or XLSX file, including their additional attributes
+ */
+export const memberServiceExportMembers = <ThrowOnError extends boolean = false>(options?: Options<MemberServiceExportMembersData, ThrowOnError>) => {
+    return (options?.client ?? _heyApiClient).get<unknown, MemberServiceExportMembersError, ThrowOnError>({
+        security: [
+            {
+                scheme: 'bearer',
+                type: 'http'
+            }
+        ],
+        url: '/v1/members:export',
+        ...options
+    });
+};
+
+/**
+ * Import members
+ * Create members from a CSV file, either all rows are imported or none
+ */
+export const memberServiceImportMembers = <ThrowOnError extends boolean = false>(options: Options<MemberServiceImportMembersData, ThrowOnError>) => {
+    return (options.client ?? _heyApiClient).post<MemberServiceImportMembersResponse, MemberServiceImportMembersError, ThrowOnError>({
+        security: [
+            {
+                scheme: 'bearer',
+                type: 'http'
+            }
+        ],
+        url: '/v1/members:import',
+        ...options,
+        headers: {
+            'Content-Type': 'application/json',
+            ...options?.headers
+        }
+    });
+};
+
+/**
+ * Search members
+ * Find members by name, username, tags and text attributes, tolerating typos and accents
+ */
+export const memberServiceSearchMembers = <ThrowOnError extends boolean = false>(options?: Options<MemberServiceSearchMembersData, ThrowOnError>) => {
+    return (options?.client ?? _heyApiClient).get<MemberServiceSearchMembersResponse, MemberServiceSearchMembersError, ThrowOnError>({
+        security: [
+            {
+                scheme: 'bearer',
+                type: 'http'
+            }
+        ],
+        url: '/v1/members:search',
+        ...options
+    });
+};
+
+/**
+ * List membership plans
+ * List all membership plans
+ */
+export const feeServiceListMembershipPlans = <ThrowOnError extends boolean = false>(options?: Options<FeeServiceListMembershipPlansData, ThrowOnError>) => {
+    return (options?.client ?? _heyApiClient).get<FeeServiceListMembershipPlansResponse, FeeServiceListMembershipPlansError, ThrowOnError>({
+        security: [
+            {
+                scheme: 'bearer',
+                type: 'http'
+            }
+        ],
+        url: '/v1/membership-plans',
+        ...options
+    });
+};
+
+/**
+ * Create membership plan
+ * Create a membership plan with its fee and billing interval
+ */
+export const feeServiceCreateMembershipPlan = <ThrowOnError extends boolean = false>(options: Options<FeeServiceCreateMembershipPlanData, ThrowOnError>) => {
+    return (options.client ?? _heyApiClient).post<FeeServiceCreateMembershipPlanResponse, FeeServiceCreateMembershipPlanError, ThrowOnError>({
+        security: [
+            {
+                scheme: 'bearer',
+                type: 'http'
+            }
+        ],
+        url: '/v1/membership-plans',
+        ...options,
+        headers: {
+            'Content-Type': 'application/json',
+            ...options?.headers
+        }
+    });
+};
+
+/**
+ * Delete membership plan
+ * Delete a membership plan, only possible while it is not assigned to any member
+ */
+export const feeServiceDeleteMembershipPlan = <ThrowOnError extends boolean = false>(options: Options<FeeServiceDeleteMembershipPlanData, ThrowOnError>) => {
+    return (options.client ?? _heyApiClient).delete<unknown, FeeServiceDeleteMembershipPlanError, ThrowOnError>({
+        security: [
+            {
+                scheme: 'bearer',
+                type: 'http'
+            }
+        ],
+        url: '/v1/membership-plans/{id}',
+        ...options
+    });
+};
+
+/**
+ * Get membership plan
+ * Get membership plan information
+ */
+export const feeServiceGetMembershipPlan = <ThrowOnError extends boolean = false>(options: Options<FeeServiceGetMembershipPlanData, ThrowOnError>) => {
+    return (options.client ?? _heyApiClient).get<FeeServiceGetMembershipPlanResponse, FeeServiceGetMembershipPlanError, ThrowOnError>({
+        security: [
+            {
+                scheme: 'bearer',
+                type: 'http'
+            }
+        ],
+        url: '/v1/membership-plans/{id}',
+        ...options
+    });
+};
+
+/**
+ * Update membership plan
+ * Update specified fields of a membership plan. Invoices that were already generated keep their amount.
+ */
+export const feeServiceUpdateMembershipPlan = <ThrowOnError extends boolean = false>(options: Options<FeeServiceUpdateMembershipPlanData, ThrowOnError>) => {
+    return (options.client ?? _heyApiClient).patch<FeeServiceUpdateMembershipPlanResponse, FeeServiceUpdateMembershipPlanError, ThrowOnError>({
+        security: [
+            {
+                scheme: 'bearer',
+                type: 'http'
+            }
+        ],
+        url: '/v1/membership-plans/{membership_plan.id}',
+        ...options,
+        headers: {
+            'Content-Type': 'application/json',
+            ...options?.headers
+        }
+    });
+};
+
+/**
+ * List payments
+ * List recorded payments, the most recent first
+ */
+export const feeServiceListPayments = <ThrowOnError extends boolean = false>(options?: Options<FeeServiceListPaymentsData, ThrowOnError>) => {
+    return (options?.client ?? _heyApiClient).get<FeeServiceListPaymentsResponse, FeeServiceListPaymentsError, ThrowOnError>({
+        security: [
+            {
+                scheme: 'bearer',
+                type: 'http'
+            }
+        ],
+        url: '/v1/payments',
+        ...options
+    });
+};
+
+/**
+ * Record payment
+ * Record a payment of a member, optionally for a specific invoice
+ */
+export const feeServiceRecordPayment = <ThrowOnError extends boolean = false>(options: Options<FeeServiceRecordPaymentData, ThrowOnError>) => {
+    return (options.client ?? _heyApiClient).post<FeeServiceRecordPaymentResponse, FeeServiceRecordPaymentError, ThrowOnError>({
+        security: [
+            {
+                scheme: 'bearer',
+                type: 'http'
+            }
+        ],
+        url: '/v1/payments',
+        ...options,
+        headers: {
+            'Content-Type': 'application/json',
+            ...options?.headers
+        }
+    });
+};
+
+/**
+ * Delete payment
+ * Delete a wrongly recorded payment
+ */
+export const feeServiceDeletePayment = <ThrowOnError extends boolean = false>(options: Options<FeeServiceDeletePaymentData, ThrowOnError>) => {
+    return (options.client ?? _heyApiClient).delete<unknown, FeeServiceDeletePaymentError, ThrowOnError>({
+        security: [
+            {
+                scheme: 'bearer',
+                type: 'http'
+            }
+        ],
+        url: '/v1/payments/{id}',
+        ...options
+    });
+};
+
+/**
+ * List plan assignments
+ * List plan assignments, optionally of a single member
+ */
+export const feeServiceListPlanAssignments = <ThrowOnError extends boolean = false>(options?: Options<FeeServiceListPlanAssignmentsData, ThrowOnError>) => {
+    return (options?.client ?? _heyApiClient).get<FeeServiceListPlanAssignmentsResponse, FeeServiceListPlanAssignmentsError, ThrowOnError>({
+        security: [
+            {
+                scheme: 'bearer',
+                type: 'http'
+            }
+        ],
+        url: '/v1/plan-assignments',
+        ...options
+    });
+};
+
+/**
+ * Assign membership plan
+ * Assign a membership plan to a member. Assignments of a member must not overlap.
+ */
+export const feeServiceAssignMembershipPlan = <ThrowOnError extends boolean = false>(options: Options<FeeServiceAssignMembershipPlanData, ThrowOnError>) => {
+    return (options.client ?? _heyApiClient).post<FeeServiceAssignMembershipPlanResponse, FeeServiceAssignMembershipPlanError, ThrowOnError>({
+        security: [
+            {
+                scheme: 'bearer',
+                type: 'http'
+            }
+        ],
+        url: '/v1/plan-assignments',
+        ...options,
+        headers: {
+            'Content-Type': 'application/json',
+            ...options?.headers
+        }
+    });
+};
+
+/**
+ * End plan assignment
+ * End a plan assignment, no invoices are generated for periods starting at or after the end time
+ */
+export const feeServiceEndPlanAssignment = <ThrowOnError extends boolean = false>(options: Options<FeeServiceEndPlanAssignmentData, ThrowOnError>) => {
+    return (options.client ?? _heyApiClient).post<FeeServiceEndPlanAssignmentResponse, FeeServiceEndPlanAssignmentError, ThrowOnError>({
+        security: [
+            {
+                scheme: 'bearer',
+                type: 'http'
+            }
+        ],
+        url: '/v1/plan-assignments/{id}:end',
+        ...options,
+        headers: {
+            'Content-Type': 'application/json',
+            ...options?.headers
+        }
+    });
+};
+
+/**
+ * List presences
+ * List precenses, where members have checked in/out
+ */
+export const presenceServiceListPresences = <ThrowOnError extends boolean = false>(options?: Options<PresenceServiceListPresencesData, ThrowOnError>) => {
+    return (options?.client ?? _heyApiClient).get<PresenceServiceListPresencesResponse, PresenceServiceListPresencesError, ThrowOnError>({
+        security: [
+            {
+                scheme: 'bearer',
+                type: 'http'
+            }
+        ],
+        url: '/v1/presences',
+        ...options
+    });
+};
+
+/**
+ * Delete Presence
+ * Delete a presence record
+ */
+export const presenceServiceDeletePresence = <ThrowOnError extends boolean = false>(options: Options<PresenceServiceDeletePresenceData, ThrowOnError>) => {
+    return (options.client ?? _heyApiClient).delete<unknown, PresenceServiceDeletePresenceError, ThrowOnError>({
+        security: [
+            {
+                scheme: 'bearer',
+                type: 'http'
+            }
+        ],
+        url: '/v1/presences/{id}',
+        ...options
+    });
+};
+
+/**
+ * Update presence
+ * Updates a presence. Usual operation should be via checkin/checkout instead of update
+ */
+export const presenceServiceUpdatePresence = <ThrowOnError extends boolean = false>(options: Options<PresenceServiceUpdatePresenceData, ThrowOnError>) => {
+    return (options.client ?? _heyApiClient).post<PresenceServiceUpdatePresenceResponse, PresenceServiceUpdatePresenceError, ThrowOnError>({
+        security: [
+            {
+                scheme: 'bearer',
+                type: 'http'
+            }
+        ],
+        url: '/v1/presences/{presence.id}',
+        ...options,
+        headers: {
+            'Content-Type': 'application/json',
+            ...options?.headers
+        }
+    });
+};
+
+/**
+ * Check in
+ * Check in a member, this creates a new presence
+ */
+export const presenceServiceCheckin = <ThrowOnError extends boolean = false>(options: Options<PresenceServiceCheckinData, ThrowOnError>) => {
+    return (options.client ?? _heyApiClient).post<PresenceServiceCheckinResponse, PresenceServiceCheckinError, ThrowOnError>({
+        security: [
+            {
+                scheme: 'bearer',
+                type: 'http'
+            }
+        ],
+        url: '/v1/presences:checkin',
+        ...options,
+        headers: {
+            'Content-Type': 'application/json',
+            ...options?.headers
+        }
+    });
+};
+
+/**
+ * Check in/out by card
+ * Resolves the currently valid card with the given RFID value and toggles the presence of its member. Meant for simple card readers.
+ */
+export const presenceServiceCheckinByCard = <ThrowOnError extends boolean = false>(options: Options<PresenceServiceCheckinByCardData, ThrowOnError>) => {
+    return (options.client ?? _heyApiClient).post<PresenceServiceCheckinByCardResponse, PresenceServiceCheckinByCardError, ThrowOnError>({
+        security: [
+            {
+                scheme: 'bearer',
+                type: 'http'
+            }
+        ],
+        url: '/v1/presences:checkin-by-card',
+        ...options,
+        headers: {
+            'Content-Type': 'application/json',
+            ...options?.headers
+        }
+    });
+};
+
+/**
+ * Check out
+ * Check out a member, ends an open presence if there is one
+ */
+export const presenceServiceCheckout = <ThrowOnError extends boolean = false>(options: Options<PresenceServiceCheckoutData, ThrowOnError>) => {
+    return (options.client ?? _heyApiClient).post<PresenceServiceCheckoutResponse, PresenceServiceCheckoutError, ThrowOnError>({
+        security: [
+            {
+                scheme: 'bearer',
+                type: 'http'
+            }
+        ],
+        url: '/v1/presences:checkout',
+        ...options,
+        headers: {
+            'Content-Type': 'application/json',
+            ...options?.headers
+        }
+    });
+};
+
+/**
+ * Export presences
+ * Export all presences matching the filters as CSV or XLSX file
+ */
+export const presenceServiceExportPresences = <ThrowOnError extends boolean = false>(options?: Options<PresenceServiceExportPresencesData, ThrowOnError>) => {
+    return (options?.client ?? _heyApiClient).get<unknown, PresenceServiceExportPresencesError, ThrowOnError>({
+        security: [
+            {
+                scheme: 'bearer',
+                type: 'http'
+            }
+        ],
+        url: '/v1/presences:export',
+        ...options
+    });
+};
+
+/**
+ * Toggle presence
+ * Checks a member out if they are checked in, otherwise checks them in. Meant for terminals.
+ */
+export const presenceServiceTogglePresence = <ThrowOnError extends boolean = false>(options: Options<PresenceServiceTogglePresenceData, ThrowOnError>) => {
+    return (options.client ?? _heyApiClient).post<PresenceServiceTogglePresenceResponse, PresenceServiceTogglePresenceError, ThrowOnError>({
+        security: [
+            {
+                scheme: 'bearer',
+                type: 'http'
+            }
+        ],
+        url: '/v1/presences:toggle',
+        ...options,
+        headers: {
+            'Content-Type': 'application/json',
+            ...options?.headers
+        }
+    });
+};
+
+/**
+ * Machine usage report
+ * Machine usage and prices per member or per machine for a time range, e.g. for billing
+ */
+export const reportServiceGetMachineUsageReport = <ThrowOnError extends boolean = false>(options?: Options<ReportServiceGetMachineUsageReportData, ThrowOnError>) => {
+    return (options?.client ?? _heyApiClient).get<ReportServiceGetMachineUsageReportResponse, ReportServiceGetMachineUsageReportError, ThrowOnError>({
+        security: [
+            {
+                scheme: 'bearer',
+                type: 'http'
+            }
+        ],
+        url: '/v1/reports/machine-usage',
+        ...options
+    });
+};
+
+/**
+ * Export machine usage report
+ * Same as the machine usage report, but returned as CSV file
+ */
+export const reportServiceExportMachineUsageReport = <ThrowOnError extends boolean = false>(options?: Options<ReportServiceExportMachineUsageReportData, ThrowOnError>) => {
+    return (options?.client ?? _heyApiClient).get<unknown, ReportServiceExportMachineUsageReportError, ThrowOnError>({
+        security: [
+            {
+                scheme: 'bearer',
+                type: 'http'
+            }
+        ],
+        url: '/v1/reports/machine-usage:export',
+        ...options
+    });
+};
+
+/**
+ * Presence report
+ * Aggregated presence statistics for a time range, e.g. for annual reports or funding applications
+ */
+export const reportServiceGetPresenceReport = <ThrowOnError extends boolean = false>(options?: Options<ReportServiceGetPresenceReportData, ThrowOnError>) => {
+    return (options?.client ?? _heyApiClient).get<ReportServiceGetPresenceReportResponse, ReportServiceGetPresenceReportError, ThrowOnError>({
+        security: [
+            {
+                scheme: 'bearer',
+                type: 'http'
+            }
+        ],
+        url: '/v1/reports/presences',
+        ...options
+    });
+};
+
+/**
+ * Export presence report
+ * Same as the presence report, but returned as CSV file
+ */
+export const reportServiceExportPresenceReport = <ThrowOnError extends boolean = false>(options?: Options<ReportServiceExportPresenceReportData, ThrowOnError>) => {
+    return (options?.client ?? _heyApiClient).get<unknown, ReportServiceExportPresenceReportError, ThrowOnError>({
+        security: [
+            {
+                scheme: 'bearer',
+                type: 'http'
+            }
+        ],
+        url: '/v1/reports/presences:export',
+        ...options
+    });
+};
+
+/**
+ * List usage sessions
+ * List usage sessions of machines, the most recent first
+ */
+export const machineServiceListUsageSessions = <ThrowOnError extends boolean = false>(options?: Options<MachineServiceListUsageSessionsData, ThrowOnError>) => {
+    return (options?.client ?? _heyApiClient).get<MachineServiceListUsageSessionsResponse, MachineServiceListUsageSessionsError, ThrowOnError>({
+        security: [
+            {
+                scheme: 'bearer',
+                type: 'http'
+            }
+        ],
+        url: '/v1/usage-sessions',
+        ...options
+    });
 };
\ No newline at end of file
@@ -1,17 +1,173 @@
 // This file is auto-generated by @hey-api/openapi-ts
 
-export type Briefing = {
+export type AcceptApplicationRequestReadable = {
+    id?: string;
+    membership_start: string;
+    /**
+     * age_category must be set if the applicant didn't give a birth date.
+     */
+    age_category?: 'AGE_CATEGORY_UNKNOWN' | 'AGE_CATEGORY_UNDERAGE' | 'AGE_CATEGORY_ADULT';
+    tags?: Array<string>;
+    /**
+     * additional_attributes of the member, e.g. the email address of the application.
+     */
+    additional_attributes?: {
+        [key: string]: string;
+    };
+    /**
+     * relationships are created with the member, e.g. the guardian of an underage applicant.
+     */
+    relationships?: Array<MemberRelationshipReadable>;
+    comment?: string;
+};
+
+export type AcceptApplicationRequestWritable = {
+    id?: string;
+    membership_start: string;
+    /**
+     * age_category must be set if the applicant didn't give a birth date.
+     */
+    age_category?: 'AGE_CATEGORY_UNKNOWN' | 'AGE_CATEGORY_UNDERAGE' | 'AGE_CATEGORY_ADULT';
+    tags?: Array<string>;
+    /**
+     * additional_attributes of the member, e.g. the email address of the application.
+     */
+    additional_attributes?: {
+        [key: string]: string;
+    };
+    /**
+     * relationships are created with the member, e.g. the guardian of an underage applicant.
+     */
+    relationships?: Array<MemberRelationshipWritable>;
+    comment?: string;
+};
+
+export type AccessDecision = {
+    id: string;
+    decision_time: string;
+    machine_id: string;
+    /**
+     * member_id is only set if the card could be resolved to a member.
+     */
+    member_id?: string;
+    allowed: boolean;
+    reason: 'ACCESS_REASON_UNKNOWN' | 'ACCESS_REASON_GRANTED' | 'ACCESS_REASON_UNKNOWN_CARD' | 'ACCESS_REASON_MEMBERSHIP_INACTIVE' | 'ACCESS_REASON_UNKNOWN_MACHINE' | 'ACCESS_REASON_MISSING_BRIEFING';
+    /**
+     * missing_briefing_types lists the briefing types the member still needs, if access was denied because of them.
+     */
+    missing_briefing_types: Array<string>;
+};
+
+export type AgeCategoryStatistics = {
+    age_category?: 'AGE_CATEGORY_UNKNOWN' | 'AGE_CATEGORY_UNDERAGE' | 'AGE_CATEGORY_ADULT';
+    unique_visitors?: string;
+    visits?: string;
+    total_hours?: number;
+};
+
+/**
+ * Application is an online membership application that has to be accepted by staff.
+ */
+export type ApplicationReadable = {
+    readonly id: string;
+    name: string;
+    email: string;
+    phone: string;
+    /**
+     * birth_date is the date of birth as YYYY-MM-DD.
+     */
+    birth_date?: string;
+    /**
+     * message of the applicant to the staff.
+     */
+    message: string;
+    readonly state: 'STATE_UNKNOWN' | 'STATE_PENDING' | 'STATE_ACCEPTED' | 'STATE_REJECTED';
+    readonly create_time: string;
+    readonly decide_time?: string;
+    /**
+     * decided_by is the username of the staff member who accepted or rejected the application.
+     */
+    readonly decided_by: string;
+    readonly comment: string;
+    /**
+     * member_id is set once the application is accepted.
+     */
+    readonly member_id?: string;
+};
+
+/**
+ * Application is an online membership application that has to be accepted by staff.
+ */
+export type ApplicationWritable = {
+    name: string;
+    email: string;
+    phone: string;
+    /**
+     * birth_date is the date of birth as YYYY-MM-DD.
+     */
+    birth_date?: string;
+    /**
+     * message of the applicant to the staff.
+     */
+    message: string;
+};
+
+export type Balance = {
+    member_id: string;
+    /**
+     * invoiced_cents is the sum of all invoices that are not cancelled.
+     */
+    invoiced_cents: string;
+    paid_cents: string;
+    /**
+     * outstanding_cents is negative if the member paid in advance.
+     */
+    outstanding_cents: string;
+};
+
+export type BriefingReadable = {
+    id: string;
+    briefing_type: string;
+    /**
+     * member_id is the member that received the briefing.
+     */
+    member_id: string;
+    briefing_time: string;
+    /**
+     * expiry_time is derived from the briefing type and not set if the briefing never expires.
+     */
+    readonly expiry_time?: string;
+};
+
+export type BriefingWritable = {
     id: string;
     briefing_type: string;
+    /**
+     * member_id is the member that received the briefing.
+     */
+    member_id: string;
+    briefing_time: string;
 };
 
 export type BriefingType = {
     id: string;
     display_name: string;
     description: string;
+    /**
+     * expires_after is how long a briefing of this type stays valid, zero means it never expires.
+     */
     expires_after: string;
 };
 
+export type CancelEventRegistrationRequest = {
+    event_id?: string;
+    id?: string;
+};
+
+export type CancelInvoiceRequest = {
+    id?: string;
+};
+
 export type CardReadable = {
     readonly id: string;
     member_id: string;
@@ -27,6 +183,19 @@ export type CardWritable = {
     valid_to: string;
 };
 
+export type CheckAccessRequest = {
+    rfid_value?: string;
+    machine_id?: string;
+};
+
+export type CheckinByCardRequest = {
+    rfid_value?: string;
+    /**
+     * terminal_id identifies the terminal the card was presented at.
+     */
+    terminal_id?: string;
+};
+
 export type CheckinRequest = {
     member_id?: string;
 };
@@ -35,6 +204,120 @@ export type CheckoutRequest = {
     member_id?: string;
 };
 
+export type CreateMyChangeRequestRequestReadable = {
+    member: MemberReadable;
+    /**
+     * field_mask may only contain the fields and additional_attributes.<technical_name> paths that are configured to
+     * need approval.
+     */
+    field_mask: string;
+};
+
+export type CreateMyChangeRequestRequestWritable = {
+    member: MemberWritable;
+    /**
+     * field_mask may only contain the fields and additional_attributes.<technical_name> paths that are configured to
+     * need approval.
+     */
+    field_mask: string;
+};
+
+export type DecideMemberChangeRequestRequest = {
+    id?: string;
+    comment?: string;
+};
+
+export type EndPlanAssignmentRequest = {
+    id?: string;
+    end_time?: string;
+};
+
+export type EventReadable = {
+    readonly id: string;
+    title: string;
+    description: string;
+    start_time: string;
+    end_time: string;
+    location: string;
+    /**
+     * capacity is the maximum number of registered members, 0 means unlimited.
+     */
+    capacity: number;
+    /**
+     * required_briefing_types are the IDs of the briefing types participants need.
+     */
+    required_briefing_types: Array<string>;
+    /**
+     * allowed_age_categories restricts who can register, an empty list allows all members.
+     */
+    allowed_age_categories: Array<'AGE_CATEGORY_UNKNOWN' | 'AGE_CATEGORY_UNDERAGE' | 'AGE_CATEGORY_ADULT'>;
+    readonly registered_count: number;
+    readonly waitlist_count: number;
+};
+
+export type EventWritable = {
+    title: string;
+    description: string;
+    start_time: string;
+    end_time: string;
+    location: string;
+    /**
+     * capacity is the maximum number of registered members, 0 means unlimited.
+     */
+    capacity: number;
+    /**
+     * required_briefing_types are the IDs of the briefing types participants need.
+     */
+    required_briefing_types: Array<string>;
+    /**
+     * allowed_age_categories restricts who can register, an empty list allows all members.
+     */
+    allowed_age_categories: Array<'AGE_CATEGORY_UNKNOWN' | 'AGE_CATEGORY_UNDERAGE' | 'AGE_CATEGORY_ADULT'>;
+};
+
+export type EventRegistrationReadable = {
+    readonly id: string;
+    event_id: string;
+    member_id: string;
+    readonly status: 'EVENT_REGISTRATION_STATUS_UNKNOWN' | 'EVENT_REGISTRATION_STATUS_REGISTERED' | 'EVENT_REGISTRATION_STATUS_WAITLISTED' | 'EVENT_REGISTRATION_STATUS_CANCELLED';
+    readonly registration_time: string;
+    readonly cancellation_time?: string;
+    readonly attended: boolean;
+};
+
+export type EventRegistrationWritable = {
+    event_id: string;
+    member_id: string;
+};
+
+export type ExportSepaDirectDebitRequest = {
+    /**
+     * Invoices with a billing period starting in [period_start, period_end) are collected.
+     */
+    period_start?: string;
+    period_end?: string;
+    /**
+     * collection_date is the requested day of the debit.
+     */
+    collection_date?: string;
+    /**
+     * include_exported collects invoices again that were part of an earlier export, e.g. because the bank rejected the
+     * file. Otherwise they are left out, so that members are not charged twice.
+     */
+    include_exported?: boolean;
+};
+
+export type GenerateInvoicesRequest = {
+    /**
+     * until defaults to now.
+     */
+    until?: string;
+};
+
+export type GenerateInvoicesResponse = {
+    invoices: Array<Invoice>;
+};
+
 /**
  * Contains an arbitrary serialized message along with a @type that describes the type of the serialized message.
  */
@@ -46,13 +329,161 @@ export type GoogleProtobufAny = {
     [key: string]: unknown | string | undefined;
 };
 
+export type ImportMembersRequest = {
+    /**
+     * csv is the content of the CSV file, the first row contains the column names. Exports prefix values that start
+     * with =, +, -, @, tab or carriage return with an apostrophe, which is removed again.
+     */
+    csv: string;
+    /**
+     * column_mapping maps column names to member fields: name, membership_start, membership_end, age_category,
+     * birth_date, tags or additional_attributes.<technical_name>. Columns that are not mapped are ignored. age_category
+     * doesn't need to be mapped if birth_date is.
+     */
+    column_mapping: {
+        [key: string]: string;
+    };
+    /**
+     * delimiter separates the columns, defaults to ",".
+     */
+    delimiter?: string;
+    /**
+     * tag_separator separates multiple tags in the tags column and the options of multi-select attributes, defaults to
+     * ";".
+     */
+    tag_separator?: string;
+    /**
+     * tags are added to every imported member.
+     */
+    tags?: Array<string>;
+    /**
+     * dry_run only validates the rows without creating any members.
+     */
+    dry_run?: boolean;
+};
+
+export type ImportMembersResponse = {
+    /**
+     * member_count is the number of members that were imported, or would be imported in a dry run.
+     */
+    member_count: number;
+    /**
+     * violations are the reasons why rows can't be imported. No members are imported if there are any.
+     */
+    violations: Array<MemberImportViolation>;
+};
+
+export type Invoice = {
+    id: string;
+    member_id: string;
+    plan_assignment_id?: string;
+    plan_id: string;
+    period_start: string;
+    period_end: string;
+    amount_cents: string;
+    /**
+     * paid_cents is the sum of all payments recorded for this invoice.
+     */
+    paid_cents: string;
+    issue_time: string;
+    status: 'INVOICE_STATUS_UNKNOWN' | 'INVOICE_STATUS_OPEN' | 'INVOICE_STATUS_PAID' | 'INVOICE_STATUS_CANCELLED';
+};
+
+export type ItemReadable = {
+    readonly id: string;
+    name: string;
+    description: string;
+    /**
+     * rfid_value and barcode identify the tag attached to the item, at least one of them is needed to lend the item
+     * by scanning it.
+     */
+    rfid_value: string;
+    barcode: string;
+    /**
+     * condition_notes describe the current condition of the item, they are updated when the item is returned.
+     */
+    condition_notes: string;
+    /**
+     * required_briefing_types are the IDs of the briefing types a member needs to borrow the item.
+     */
+    required_briefing_types: Array<string>;
+    /**
+     * available is false while the item is lent to a member.
+     */
+    readonly available: boolean;
+};
+
+export type ItemWritable = {
+    name: string;
+    description: string;
+    /**
+     * rfid_value and barcode identify the tag attached to the item, at least one of them is needed to lend the item
+     * by scanning it.
+     */
+    rfid_value: string;
+    barcode: string;
+    /**
+     * condition_notes describe the current condition of the item, they are updated when the item is returned.
+     */
+    condition_notes: string;
+    /**
+     * required_briefing_types are the IDs of the briefing types a member needs to borrow the item.
+     */
+    required_briefing_types: Array<string>;
+};
+
+export type LendItemByScanRequest = {
+    member_rfid_value?: string;
+    item_rfid_value?: string;
+    item_barcode?: string;
+    terminal_id?: string;
+    /**
+     * expected_return_time defaults to the configured lending period.
+     */
+    expected_return_time?: string;
+};
+
+export type LendItemRequest = {
+    item_id?: string;
+    member_id?: string;
+    /**
+     * expected_return_time defaults to the configured lending period.
+     */
+    expected_return_time?: string;
+};
+
+export type ListAccessDecisionsResponse = {
+    decisions: Array<AccessDecision>;
+    next_page_token: string;
+};
+
+export type ListApplicationsResponseReadable = {
+    applications: Array<ApplicationReadable>;
+    next_page_token: string;
+};
+
+export type ListApplicationsResponseWritable = {
+    applications: Array<ApplicationWritable>;
+    next_page_token: string;
+};
+
+export type ListBalancesResponse = {
+    balances: Array<Balance>;
+    next_page_token: string;
+};
+
 export type ListBriefingTypesResponse = {
     briefing_types: Array<BriefingType>;
     next_page_token: string;
 };
 
-export type ListBriefingsResponse = {
-    briefings?: Array<Briefing>;
+export type ListBriefingsResponseReadable = {
+    briefings?: Array<BriefingReadable>;
+    next_page_token?: string;
+};
+
+export type ListBriefingsResponseWritable = {
+    briefings?: Array<BriefingWritable>;
     next_page_token?: string;
 };
 
@@ -66,61 +497,233 @@ export type ListCardsResponseWritable = {
     next_page_token: string;
 };
 
-export type ListMemberAttributesResponse = {
-    attributes?: Array<MemberAttribute>;
-    next_page_token?: string;
+export type ListEventRegistrationsResponseReadable = {
+    registrations: Array<EventRegistrationReadable>;
+    next_page_token: string;
 };
 
-export type ListMemberTagsResponse = {
-    tags?: Array<string>;
-    next_page_token?: string;
+export type ListEventRegistrationsResponseWritable = {
+    registrations: Array<EventRegistrationWritable>;
+    next_page_token: string;
 };
 
-export type ListMembersResponseReadable = {
-    members: Array<MemberReadable>;
+export type ListEventsResponseReadable = {
+    events: Array<EventReadable>;
     next_page_token: string;
 };
 
-export type ListMembersResponseWritable = {
-    members: Array<MemberWritable>;
+export type ListEventsResponseWritable = {
+    events: Array<EventWritable>;
     next_page_token: string;
 };
 
-export type ListPresencesResponse = {
-    presence: Array<Presence>;
+export type ListInvoicesResponse = {
+    invoices: Array<Invoice>;
     next_page_token: string;
 };
 
-export type LoginApiKey = {
-    api_key?: string;
+export type ListItemsResponseReadable = {
+    items: Array<ItemReadable>;
+    next_page_token: string;
 };
 
-export type LoginOpenIdConnect = {
-    auth_code?: string;
-    client_id?: string;
-    code_verifier?: string;
+export type ListItemsResponseWritable = {
+    items: Array<ItemWritable>;
+    next_page_token: string;
 };
 
-export type LoginPassword = {
-    username?: string;
-    password?: string;
+export type ListLoansResponseReadable = {
+    loans: Array<LoanReadable>;
+    next_page_token: string;
 };
 
-export type LoginRequest = {
-    password?: LoginPassword;
-    oidc?: LoginOpenIdConnect;
-    api_key?: LoginApiKey;
+export type ListLoansResponseWritable = {
+    loans: Array<LoanWritable>;
+    next_page_token: string;
 };
 
-export type LoginResponse = {
-    success?: LoginSuccess;
+export type ListMachinesResponseReadable = {
+    machines: Array<MachineReadable>;
+    next_page_token: string;
 };
 
-export type LoginSuccess = {
-    access_token?: string;
-    refresh_token?: string;
-    access_token_expiry?: string;
-    refresh_token_expiry?: string;
+export type ListMachinesResponseWritable = {
+    machines: Array<MachineWritable>;
+    next_page_token: string;
+};
+
+export type ListMemberAttributesResponse = {
+    attributes?: Array<MemberAttribute>;
+    next_page_token?: string;
+};
+
+export type ListMemberChangeRequestsResponseReadable = {
+    change_requests: Array<MemberChangeRequestReadable>;
+    next_page_token: string;
+};
+
+export type ListMemberChangeRequestsResponseWritable = {
+    change_requests: Array<MemberChangeRequestWritable>;
+    next_page_token: string;
+};
+
+export type ListMemberConsentsResponseReadable = {
+    consents: Array<MemberConsentReadable>;
+};
+
+export type ListMemberConsentsResponseWritable = {
+    consents: Array<MemberConsentWritable>;
+};
+
+export type ListMemberNotesResponseReadable = {
+    notes: Array<MemberNoteReadable>;
+    next_page_token: string;
+};
+
+export type ListMemberNotesResponseWritable = {
+    notes: Array<MemberNoteWritable>;
+    next_page_token: string;
+};
+
+export type ListMemberRelationshipsResponseReadable = {
+    relationships: Array<MemberRelationshipReadable>;
+};
+
+export type ListMemberRelationshipsResponseWritable = {
+    relationships: Array<MemberRelationshipWritable>;
+};
+
+export type ListMemberTagsResponseReadable = {
+    tags?: Array<string>;
+    next_page_token?: string;
+    /**
+     * member_tags are the same tags with their definitions and usage counts.
+     */
+    member_tags?: Array<MemberTagReadable>;
+};
+
+export type ListMemberTagsResponseWritable = {
+    tags?: Array<string>;
+    next_page_token?: string;
+    /**
+     * member_tags are the same tags with their definitions and usage counts.
+     */
+    member_tags?: Array<MemberTagWritable>;
+};
+
+export type ListMemberTimelineResponseReadable = {
+    entries: Array<MemberTimelineEntryReadable>;
+    next_page_token: string;
+};
+
+export type ListMemberTimelineResponseWritable = {
+    entries: Array<MemberTimelineEntryWritable>;
+    next_page_token: string;
+};
+
+export type ListMembersResponseReadable = {
+    members: Array<MemberReadable>;
+    next_page_token: string;
+};
+
+export type ListMembersResponseWritable = {
+    members: Array<MemberWritable>;
+    next_page_token: string;
+};
+
+export type ListMembershipPlansResponseReadable = {
+    membership_plans: Array<MembershipPlanReadable>;
+    next_page_token: string;
+};
+
+export type ListMembershipPlansResponseWritable = {
+    membership_plans: Array<MembershipPlanWritable>;
+    next_page_token: string;
+};
+
+export type ListPaymentsResponseReadable = {
+    payments: Array<PaymentReadable>;
+    next_page_token: string;
+};
+
+export type ListPaymentsResponseWritable = {
+    payments: Array<PaymentWritable>;
+    next_page_token: string;
+};
+
+export type ListPlanAssignmentsResponseReadable = {
+    plan_assignments: Array<PlanAssignmentReadable>;
+    next_page_token: string;
+};
+
+export type ListPlanAssignmentsResponseWritable = {
+    plan_assignments: Array<PlanAssignmentWritable>;
+    next_page_token: string;
+};
+
+export type ListPresencesResponseReadable = {
+    presence: Array<PresenceReadable>;
+    next_page_token: string;
+};
+
+export type ListPresencesResponseWritable = {
+    presence: Array<PresenceWritable>;
+    next_page_token: string;
+};
+
+export type ListUsageSessionsResponse = {
+    sessions: Array<UsageSession>;
+    next_page_token: string;
+};
+
+export type LoanReadable = {
+    readonly id: string;
+    item_id: string;
+    member_id: string;
+    readonly lend_time: string;
+    expected_return_time: string;
+    readonly return_time?: string;
+    readonly return_condition_notes?: string;
+    readonly terminal_id?: string;
+    readonly overdue: boolean;
+};
+
+export type LoanWritable = {
+    item_id: string;
+    member_id: string;
+    expected_return_time: string;
+};
+
+export type LoginApiKey = {
+    api_key?: string;
+};
+
+export type LoginOpenIdConnect = {
+    auth_code?: string;
+    client_id?: string;
+    code_verifier?: string;
+};
+
+export type LoginPassword = {
+    username?: string;
+    password?: string;
+};
+
+export type LoginRequest = {
+    password?: LoginPassword;
+    oidc?: LoginOpenIdConnect;
+    api_key?: LoginApiKey;
+};
+
+export type LoginResponse = {
+    success?: LoginSuccess;
+};
+
+export type LoginSuccess = {
+    access_token?: string;
+    refresh_token?: string;
+    access_token_expiry?: string;
+    refresh_token_expiry?: string;
 };
 
 export type LogoutRequest = {
@@ -131,6 +734,63 @@ export type LogoutResponse = {
     [key: string]: unknown;
 };
 
+export type MachineReadable = {
+    readonly id: string;
+    name: string;
+    description: string;
+    location: string;
+    /**
+     * required_briefing_types are the IDs of the briefing types a member needs to use the machine.
+     */
+    required_briefing_types: Array<string>;
+    /**
+     * price_per_minute_cents is charged for every started minute of a usage session, 0 means usage is free.
+     */
+    price_per_minute_cents?: string;
+    /**
+     * session_timeout is the time after which a usage session that was not stopped ends automatically. If not set,
+     * the configured default is used.
+     */
+    session_timeout?: string;
+};
+
+export type MachineWritable = {
+    name: string;
+    description: string;
+    location: string;
+    /**
+     * required_briefing_types are the IDs of the briefing types a member needs to use the machine.
+     */
+    required_briefing_types: Array<string>;
+    /**
+     * price_per_minute_cents is charged for every started minute of a usage session, 0 means usage is free.
+     */
+    price_per_minute_cents?: string;
+    /**
+     * session_timeout is the time after which a usage session that was not stopped ends automatically. If not set,
+     * the configured default is used.
+     */
+    session_timeout?: string;
+};
+
+export type MachineUsageReport = {
+    start_time: string;
+    end_time: string;
+    group_by: 'USAGE_REPORT_GROUPING_UNKNOWN' | 'USAGE_REPORT_GROUPING_MEMBER' | 'USAGE_REPORT_GROUPING_MACHINE';
+    /**
+     * entries contain one entry per member or machine, depending on group_by. Sessions count towards the time range
+     * they started in.
+     */
+    entries: Array<UsageStatistics>;
+    total: UsageStatistics;
+};
+
+export type MarkEventAttendanceRequest = {
+    event_id?: string;
+    id?: string;
+    attended?: boolean;
+};
+
 export type MemberReadable = {
     readonly id: string;
     name: string;
@@ -142,6 +802,11 @@ export type MemberReadable = {
     additional_attributes?: {
         [key: string]: string;
     };
+    /**
+     * birth_date is the date of birth as YYYY-MM-DD. If it is known, age_category is derived from it and kept up to date
+     * by a daily job, otherwise age_category is set by hand. Only admins and the member can read it.
+     */
+    birth_date?: string;
 };
 
 export type MemberWritable = {
@@ -154,854 +819,3871 @@ export type MemberWritable = {
     additional_attributes?: {
         [key: string]: string;
     };
+    /**
+     * birth_date is the date of birth as YYYY-MM-DD. If it is known, age_category is derived from it and kept up to date
+     * by a daily job, otherwise age_category is set by hand. Only admins and the member can read it.
+     */
+    birth_date?: string;
 };
 
 export type MemberAttribute = {
     id: string;
     technical_name: string;
     display_name: string;
-    type: 'TYPE_UNKNOWN' | 'TYPE_TEXT_SINGLE_LINE' | 'TYPE_TEXT_MULI_LINE' | 'TYPE_NUMBER' | 'TYPE_DATE' | 'TYPE_DATETIME';
+    type: 'TYPE_UNKNOWN' | 'TYPE_TEXT_SINGLE_LINE' | 'TYPE_TEXT_MULI_LINE' | 'TYPE_NUMBER' | 'TYPE_DATE' | 'TYPE_DATETIME' | 'TYPE_SELECT' | 'TYPE_MULTI_SELECT' | 'TYPE_BOOLEAN' | 'TYPE_EMAIL' | 'TYPE_PHONE' | 'TYPE_URL' | 'TYPE_DECIMAL';
     description: string;
+    /**
+     * options are the values of select and multi-select attributes.
+     */
+    options: Array<string>;
+    /**
+     * required attributes must have a value when a member is created and can't be removed from members afterwards.
+     */
+    required: boolean;
+    /**
+     * unique attributes can't have the same value for two members.
+     */
+    unique: boolean;
+    /**
+     * pattern is a regular expression the whole value of text, email, phone and URL attributes has to match.
+     */
+    pattern: string;
+    /**
+     * min and max limit numbers and decimals by value, dates and date-times by time, multi-selects by the number of
+     * selected options and text, email, phone and URL attributes by their length in characters. Both are inclusive.
+     */
+    min?: string;
+    max?: string;
+    /**
+     * sensitivity controls who can read and write the values of the attribute. Attributes the caller can't read are
+     * removed from members in responses. Only admins can change it.
+     */
+    sensitivity: 'SENSITIVITY_UNKNOWN' | 'SENSITIVITY_PUBLIC' | 'SENSITIVITY_INTERNAL' | 'SENSITIVITY_RESTRICTED';
+    /**
+     * read_roles can read the values of restricted attributes, write_roles can read and write them. Admins always can.
+     */
+    read_roles: Array<string>;
+    write_roles: Array<string>;
 };
 
-export type MemberLoginReadable = {
-    username?: string;
-};
-
-export type MemberLoginWritable = {
-    username?: string;
-    password?: string;
-};
-
-export type Presence = {
-    id: string;
+export type MemberAttributeMigrationIssue = {
     member_id: string;
-    checkin_time: string;
-    checkout_time: string;
-};
-
-export type RefreshRequest = {
-    [key: string]: unknown;
+    member_name: string;
+    value: string;
+    description: string;
 };
 
-export type RefreshResponse = {
-    success?: LoginSuccess;
+/**
+ * MemberChangeRequest is a change members requested to their own data, which has to be approved by staff.
+ */
+export type MemberChangeRequestReadable = {
+    readonly id: string;
+    readonly member_id: string;
+    /**
+     * member contains the requested values of the fields in field_mask.
+     */
+    member: MemberReadable;
+    field_mask: string;
+    readonly state: 'STATE_UNKNOWN' | 'STATE_PENDING' | 'STATE_APPROVED' | 'STATE_REJECTED';
+    readonly create_time: string;
+    /**
+     * requested_by is the username of the member who requested the change.
+     */
+    readonly requested_by: string;
+    readonly decide_time?: string;
+    /**
+     * decided_by is the username of the staff member who approved or rejected the change.
+     */
+    readonly decided_by: string;
+    /**
+     * comment of the staff member who decided, e.g. why the change was rejected.
+     */
+    readonly comment: string;
 };
 
 /**
- * The `Status` type defines a logical error model that is suitable for different programming environments, including REST APIs and RPC APIs. It is used by [gRPC](https://github.com/grpc). Each `Status` message contains three pieces of data: error code, error message, and error details. You can find out more about this error model and how to work with it in the [API Design Guide](https://cloud.google.com/apis/design/errors).
+ * MemberChangeRequest is a change members requested to their own data, which has to be approved by staff.
  */
-export type Status = {
+export type MemberChangeRequestWritable = {
     /**
-     * The status code, which should be an enum value of [google.rpc.Code][google.rpc.Code].
+     * member contains the requested values of the fields in field_mask.
      */
-    code?: number;
+    member: MemberWritable;
+    field_mask: string;
+};
+
+export type MemberConsentReadable = {
+    readonly id: string;
+    member_id: string;
     /**
-     * A developer-facing error message, which should be in English. Any user-facing error message should be localized and sent in the [google.rpc.Status.details][google.rpc.Status.details] field, or localized by the client.
+     * purpose describes what was consented to, e.g. "membership" or "photos".
      */
-    message?: string;
+    purpose: string;
     /**
-     * A list of messages that carry the error details.  There is a common set of message types for APIs to use.
+     * relationship_id is the guardian who gave the consent. If it is not set, the member consented themselves.
      */
-    details?: Array<GoogleProtobufAny>;
+    relationship_id?: string;
+    /**
+     * given_by is the name of whoever gave the consent at that time, it is kept if the guardian is deleted later.
+     */
+    readonly given_by: string;
+    /**
+     * consent_time is when the consent was given, defaults to now.
+     */
+    consent_time: string;
+    readonly revoke_time?: string;
 };
 
-export type AuthServiceLoginData = {
-    body: LoginRequest;
-    path?: never;
-    query?: never;
-    url: '/v1/auth/login';
+export type MemberConsentWritable = {
+    member_id: string;
+    /**
+     * purpose describes what was consented to, e.g. "membership" or "photos".
+     */
+    purpose: string;
+    /**
+     * relationship_id is the guardian who gave the consent. If it is not set, the member consented themselves.
+     */
+    relationship_id?: string;
+    /**
+     * consent_time is when the consent was given, defaults to now.
+     */
+    consent_time: string;
 };
 
-export type AuthServiceLoginErrors = {
+export type MemberImportViolation = {
     /**
-     * Default error response
+     * row is the line of the CSV file, the header is row 1.
      */
-    default: Status;
+    row?: number;
+    column?: string;
+    field?: string;
+    description?: string;
 };
 
-export type AuthServiceLoginError = AuthServiceLoginErrors[keyof AuthServiceLoginErrors];
-
-export type AuthServiceLoginResponses = {
+export type MemberLoginReadable = {
+    username?: string;
     /**
-     * OK
+     * roles grant access to restricted member attributes, the admin role grants access to everything. Only admins can
+     * change them, by updating the path member_login.roles. Logins without roles belong to members, they can only use
+     * the SelfService.
      */
-    200: LoginResponse;
+    roles?: Array<string>;
 };
 
-export type AuthServiceLoginResponse = AuthServiceLoginResponses[keyof AuthServiceLoginResponses];
+export type MemberLoginWritable = {
+    username?: string;
+    password?: string;
+    /**
+     * roles grant access to restricted member attributes, the admin role grants access to everything. Only admins can
+     * change them, by updating the path member_login.roles. Logins without roles belong to members, they can only use
+     * the SelfService.
+     */
+    roles?: Array<string>;
+};
 
-export type AuthServiceLogoutData = {
-    body: LogoutRequest;
-    path?: never;
-    query?: never;
-    url: '/v1/auth/logout';
+export type MemberNoteReadable = {
+    readonly id: string;
+    member_id: string;
+    text: string;
+    /**
+     * author is the username of the staff member who wrote the note, author_name their full name at that time.
+     */
+    readonly author: string;
+    readonly author_name: string;
+    readonly create_time: string;
+    readonly update_time?: string;
+    /**
+     * pinned notes are listed before all other notes.
+     */
+    pinned: boolean;
+    /**
+     * visibility defaults to staff.
+     */
+    visibility: 'VISIBILITY_UNKNOWN' | 'VISIBILITY_STAFF' | 'VISIBILITY_ADMINS';
 };
 
-export type AuthServiceLogoutErrors = {
+export type MemberNoteWritable = {
+    member_id: string;
+    text: string;
+    /**
+     * pinned notes are listed before all other notes.
+     */
+    pinned: boolean;
+    /**
+     * visibility defaults to staff.
+     */
+    visibility: 'VISIBILITY_UNKNOWN' | 'VISIBILITY_STAFF' | 'VISIBILITY_ADMINS';
+};
+
+export type MemberRelationshipReadable = {
+    readonly id: string;
+    member_id: string;
+    type: 'TYPE_UNKNOWN' | 'TYPE_GUARDIAN' | 'TYPE_EMERGENCY_CONTACT' | 'TYPE_FAMILY';
+    /**
+     * related_member_id is set if the related person is a member as well. Otherwise it is an external contact described
+     * by name, email and phone.
+     */
+    related_member_id?: string;
+    /**
+     * name is the name of the external contact, for related members it is their name and can't be set.
+     */
+    name: string;
+    email: string;
+    phone: string;
+    /**
+     * description of the relationship, e.g. "mother".
+     */
+    description: string;
+};
+
+export type MemberRelationshipWritable = {
+    member_id: string;
+    type: 'TYPE_UNKNOWN' | 'TYPE_GUARDIAN' | 'TYPE_EMERGENCY_CONTACT' | 'TYPE_FAMILY';
+    /**
+     * related_member_id is set if the related person is a member as well. Otherwise it is an external contact described
+     * by name, email and phone.
+     */
+    related_member_id?: string;
+    /**
+     * name is the name of the external contact, for related members it is their name and can't be set.
+     */
+    name: string;
+    email: string;
+    phone: string;
+    /**
+     * description of the relationship, e.g. "mother".
+     */
+    description: string;
+};
+
+export type MemberSearchResultReadable = {
+    member: MemberReadable;
+    /**
+     * score is the similarity between 0 and 1 of the best matching field, results are ordered by it. Matches in tags
+     * and additional attributes are weighted lower than matches in name and username.
+     */
+    score: number;
+    /**
+     * matched_fields are the fields the query was found in: name, username, tags or additional_attributes.
+     */
+    matched_fields: Array<string>;
+};
+
+export type MemberSearchResultWritable = {
+    member: MemberWritable;
+    /**
+     * score is the similarity between 0 and 1 of the best matching field, results are ordered by it. Matches in tags
+     * and additional attributes are weighted lower than matches in name and username.
+     */
+    score: number;
+    /**
+     * matched_fields are the fields the query was found in: name, username, tags or additional_attributes.
+     */
+    matched_fields: Array<string>;
+};
+
+/**
+ * MemberTag is the definition of a tag. Tags can be used on members without being defined, they have no description
+ * and color then.
+ */
+export type MemberTagReadable = {
+    name: string;
+    description: string;
+    /**
+     * color as hex RGB, e.g. #1e90ff. It is empty if the tag has no color.
+     */
+    color: string;
+    /**
+     * member_count is the number of members with the tag.
+     */
+    readonly member_count: string;
+};
+
+/**
+ * MemberTag is the definition of a tag. Tags can be used on members without being defined, they have no description
+ * and color then.
+ */
+export type MemberTagWritable = {
+    name: string;
+    description: string;
+    /**
+     * color as hex RGB, e.g. #1e90ff. It is empty if the tag has no color.
+     */
+    color: string;
+};
+
+/**
+ * MemberTimelineEntry is one event of the timeline, exactly one of the entries is set.
+ */
+export type MemberTimelineEntryReadable = {
+    /**
+     * time is the creation time of notes, the start of validity of cards and the time of briefings.
+     */
+    time: string;
+    note?: MemberNoteReadable;
+    card?: CardReadable;
+    briefing?: BriefingReadable;
+};
+
+/**
+ * MemberTimelineEntry is one event of the timeline, exactly one of the entries is set.
+ */
+export type MemberTimelineEntryWritable = {
+    /**
+     * time is the creation time of notes, the start of validity of cards and the time of briefings.
+     */
+    time: string;
+    note?: MemberNoteWritable;
+    card?: CardWritable;
+    briefing?: BriefingWritable;
+};
+
+export type MembershipPlanReadable = {
+    readonly id: string;
+    display_name: string;
+    description: string;
+    /**
+     * price_cents is the fee per billing interval.
+     */
+    price_cents: string;
+    /**
+     * reduced_price_cents is charged instead of price_cents to members with AGE_CATEGORY_UNDERAGE.
+     */
+    reduced_price_cents?: string;
+    billing_interval: 'BILLING_INTERVAL_UNKNOWN' | 'BILLING_INTERVAL_MONTHLY' | 'BILLING_INTERVAL_QUARTERLY' | 'BILLING_INTERVAL_YEARLY';
+};
+
+export type MembershipPlanWritable = {
+    display_name: string;
+    description: string;
+    /**
+     * price_cents is the fee per billing interval.
+     */
+    price_cents: string;
+    /**
+     * reduced_price_cents is charged instead of price_cents to members with AGE_CATEGORY_UNDERAGE.
+     */
+    reduced_price_cents?: string;
+    billing_interval: 'BILLING_INTERVAL_UNKNOWN' | 'BILLING_INTERVAL_MONTHLY' | 'BILLING_INTERVAL_QUARTERLY' | 'BILLING_INTERVAL_YEARLY';
+};
+
+export type MergeMemberTagsRequest = {
+    /**
+     * name is the tag that replaces the source tags, it keeps its definition. If it has none, it takes the definition
+     * of the first source tag that has one.
+     */
+    name?: string;
+    source_names?: Array<string>;
+};
+
+export type MigrateMemberAttributeRequest = {
+    id?: string;
+    /**
+     * technical_name renames the attribute, the values of all members are moved to the new name. Unchanged if empty.
+     */
+    technical_name?: string;
+    /**
+     * type converts the values of all members to the new type. Unchanged if TYPE_UNKNOWN. Pattern, min and max are
+     * removed when the type changes, as they depend on the type.
+     */
+    type?: 'TYPE_UNKNOWN' | 'TYPE_TEXT_SINGLE_LINE' | 'TYPE_TEXT_MULI_LINE' | 'TYPE_NUMBER' | 'TYPE_DATE' | 'TYPE_DATETIME' | 'TYPE_SELECT' | 'TYPE_MULTI_SELECT' | 'TYPE_BOOLEAN' | 'TYPE_EMAIL' | 'TYPE_PHONE' | 'TYPE_URL' | 'TYPE_DECIMAL';
+    /**
+     * options replace the options of select and multi-select attributes. Values that are not one of the new options
+     * can't be converted.
+     */
+    options?: Array<string>;
+    /**
+     * drop_invalid_values removes values that can't be converted instead of aborting the migration.
+     */
+    drop_invalid_values?: boolean;
+    /**
+     * dry_run only reports the values that can't be converted without changing anything.
+     */
+    dry_run?: boolean;
+};
+
+export type MigrateMemberAttributeResponse = {
+    /**
+     * attribute is the migrated attribute, or the attribute as it would be after the migration if it was not applied.
+     */
+    attribute: MemberAttribute;
+    /**
+     * member_count is the number of members with a value for the attribute.
+     */
+    member_count: number;
+    /**
+     * invalid_values are the values that can't be converted to the new type.
+     */
+    invalid_values: Array<MemberAttributeMigrationIssue>;
+    /**
+     * applied is set if the migration was applied. It is not applied in a dry run or if there are invalid values and
+     * drop_invalid_values is not set.
+     */
+    applied: boolean;
+};
+
+export type PaymentReadable = {
+    readonly id: string;
+    member_id: string;
+    /**
+     * invoice_id is set if the payment was made for a specific invoice.
+     */
+    invoice_id?: string;
+    amount_cents: string;
+    payment_time: string;
+    /**
+     * reference is free text, e.g. the bank transfer reference or "cash".
+     */
+    reference: string;
+};
+
+export type PaymentWritable = {
+    member_id: string;
+    /**
+     * invoice_id is set if the payment was made for a specific invoice.
+     */
+    invoice_id?: string;
+    amount_cents: string;
+    payment_time: string;
+    /**
+     * reference is free text, e.g. the bank transfer reference or "cash".
+     */
+    reference: string;
+};
+
+export type PlanAssignmentReadable = {
+    readonly id: string;
+    member_id: string;
+    plan_id: string;
+    /**
+     * start_time is the start of the first billing period.
+     */
+    start_time: string;
+    end_time?: string;
+    /**
+     * billed_until is the end of the last billing period an invoice was generated for.
+     */
+    readonly billed_until: string;
+};
+
+export type PlanAssignmentWritable = {
+    member_id: string;
+    plan_id: string;
+    /**
+     * start_time is the start of the first billing period.
+     */
+    start_time: string;
+    end_time?: string;
+};
+
+export type PresenceReadable = {
+    id: string;
+    member_id: string;
+    checkin_time: string;
+    checkout_time: string;
+    /**
+     * auto_closed is set if the presence was not checked out by the member, but closed automatically by the backend,
+     * e.g. at closing time. The checkout time is then an estimate and not the time the member actually left.
+     */
+    readonly auto_closed: boolean;
+    /**
+     * terminal_id is the terminal the member checked in at, if the checkin was done with a card.
+     */
+    readonly terminal_id?: string;
+};
+
+export type PresenceWritable = {
+    id: string;
+    member_id: string;
+    checkin_time: string;
+    checkout_time: string;
+};
+
+export type PresenceReport = {
+    start_time: string;
+    end_time: string;
+    bucket: 'REPORT_BUCKET_UNKNOWN' | 'REPORT_BUCKET_DAY' | 'REPORT_BUCKET_WEEK' | 'REPORT_BUCKET_MONTH';
+    buckets: Array<PresenceStatistics>;
+    /**
+     * total contains the statistics over the whole time range.
+     */
+    total: PresenceStatistics;
+};
+
+export type PresenceStatistics = {
+    start_time: string;
+    end_time: string;
+    unique_visitors: string;
+    visits: string;
+    total_hours: number;
+    peak_occupancy: string;
+    age_categories: Array<AgeCategoryStatistics>;
+    tags: Array<TagStatistics>;
+};
+
+export type RefreshRequest = {
+    [key: string]: unknown;
+};
+
+export type RefreshResponse = {
+    success?: LoginSuccess;
+};
+
+export type RegisterForEventRequest = {
+    event_id?: string;
+    member_id?: string;
+};
+
+export type RejectApplicationRequest = {
+    id?: string;
+    comment?: string;
+};
+
+export type RenameMemberTagRequest = {
+    name?: string;
+    new_name?: string;
+};
+
+export type ReturnItemByScanRequest = {
+    item_rfid_value?: string;
+    item_barcode?: string;
+    condition_notes?: string;
+};
+
+export type ReturnItemRequest = {
+    id?: string;
+    condition_notes?: string;
+};
+
+export type RevokeMemberConsentRequest = {
+    member_id?: string;
+    id?: string;
+};
+
+export type SearchMembersResponseReadable = {
+    results: Array<MemberSearchResultReadable>;
+    next_page_token: string;
+};
+
+export type SearchMembersResponseWritable = {
+    results: Array<MemberSearchResultWritable>;
+    next_page_token: string;
+};
+
+export type SepaDirectDebitExport = {
+    /**
+     * document is the pain.008.001.08 XML file, empty if there is nothing to collect.
+     */
+    document: string;
+    transactions: string;
+    total_cents: string;
+    issues: Array<SepaExportIssue>;
+    /**
+     * export_id identifies the export the invoices were recorded with, empty if there is nothing to collect.
+     */
+    export_id?: string;
+};
+
+export type SepaExportIssue = {
+    member_id: string;
+    /**
+     * invoice_ids are the invoices of the member that were left out of the export.
+     */
+    invoice_ids: Array<string>;
+    field: string;
+    description: string;
+};
+
+export type SepaMandate = {
+    member_id: string;
+    account_holder: string;
+    iban: string;
+    /**
+     * bic is optional, SEPA payments within the EEA only require the IBAN.
+     */
+    bic?: string;
+    /**
+     * mandate_reference is the unique mandate ID, up to 35 characters without spaces.
+     */
+    mandate_reference: string;
+    /**
+     * mandate_date is the day the mandate was signed.
+     */
+    mandate_date: string;
+};
+
+export type StartUsageSessionRequest = {
+    machine_id?: string;
+    member_id?: string;
+    /**
+     * rfid_value of the card presented at the machine, only cards of members with an active membership are accepted.
+     */
+    rfid_value?: string;
+};
+
+/**
+ * The `Status` type defines a logical error model that is suitable for different programming environments, including REST APIs and RPC APIs. It is used by [gRPC](https://github.com/grpc). Each `Status` message contains three pieces of data: error code, error message, and error details. You can find out more about this error model and how to work with it in the [API Design Guide](https://cloud.google.com/apis/design/errors).
+ */
+export type Status = {
+    /**
+     * The status code, which should be an enum value of [google.rpc.Code][google.rpc.Code].
+     */
+    code?: number;
+    /**
+     * A developer-facing error message, which should be in English. Any user-facing error message should be localized and sent in the [google.rpc.Status.details][google.rpc.Status.details] field, or localized by the client.
+     */
+    message?: string;
+    /**
+     * A list of messages that carry the error details.  There is a common set of message types for APIs to use.
+     */
+    details?: Array<GoogleProtobufAny>;
+};
+
+export type StopUsageSessionRequest = {
+    machine_id?: string;
+};
+
+export type SubmitApplicationRequest = {
+    name: string;
+    email: string;
+    phone?: string;
+    birth_date?: string;
+    message?: string;
+    /**
+     * website is a honeypot, forms must hide it from humans and leave it empty. Applications with a website are
+     * silently dropped.
+     */
+    website?: string;
+};
+
+export type TagStatistics = {
+    tag?: string;
+    unique_visitors?: string;
+    visits?: string;
+    total_hours?: number;
+};
+
+export type TogglePresenceRequest = {
+    member_id?: string;
+};
+
+export type TogglePresenceResponseReadable = {
+    presence: PresenceReadable;
+    action: 'PRESENCE_ACTION_UNKNOWN' | 'PRESENCE_ACTION_CHECKIN' | 'PRESENCE_ACTION_CHECKOUT';
+};
+
+export type TogglePresenceResponseWritable = {
+    presence: PresenceWritable;
+    action: 'PRESENCE_ACTION_UNKNOWN' | 'PRESENCE_ACTION_CHECKIN' | 'PRESENCE_ACTION_CHECKOUT';
+};
+
+export type UsageSession = {
+    id: string;
+    machine_id: string;
+    member_id: string;
+    start_time: string;
+    /**
+     * end_time is not set while the session is running.
+     */
+    end_time?: string;
+    /**
+     * auto_stopped is set if the session was ended by the session timeout of the machine.
+     */
+    auto_stopped: boolean;
+    /**
+     * price_per_minute_cents is the price of the machine at the start of the session.
+     */
+    price_per_minute_cents: string;
+    /**
+     * price_cents is the price for all started minutes, for running sessions up to now.
+     */
+    price_cents: string;
+};
+
+export type UsageStatistics = {
+    /**
+     * member_id is set when grouping by member.
+     */
+    member_id?: string;
+    /**
+     * machine_id is set when grouping by machine.
+     */
+    machine_id?: string;
+    sessions: string;
+    /**
+     * total_minutes counts every started minute of a session.
+     */
+    total_minutes: string;
+    total_price_cents: string;
+};
+
+export type AccessServiceListAccessDecisionsData = {
+    body?: never;
+    path?: never;
+    query?: {
+        page_size?: number;
+        page_token?: string;
+        machine_id?: string;
+        member_id?: string;
+        allowed?: boolean;
+    };
+    url: '/v1/access/decisions';
+};
+
+export type AccessServiceListAccessDecisionsErrors = {
+    /**
+     * Default error response
+     */
+    default: Status;
+};
+
+export type AccessServiceListAccessDecisionsError = AccessServiceListAccessDecisionsErrors[keyof AccessServiceListAccessDecisionsErrors];
+
+export type AccessServiceListAccessDecisionsResponses = {
+    /**
+     * OK
+     */
+    200: ListAccessDecisionsResponse;
+};
+
+export type AccessServiceListAccessDecisionsResponse = AccessServiceListAccessDecisionsResponses[keyof AccessServiceListAccessDecisionsResponses];
+
+export type AccessServiceCheckAccessData = {
+    body: CheckAccessRequest;
+    path?: never;
+    query?: never;
+    url: '/v1/access:check';
+};
+
+export type AccessServiceCheckAccessErrors = {
+    /**
+     * Default error response
+     */
+    default: Status;
+};
+
+export type AccessServiceCheckAccessError = AccessServiceCheckAccessErrors[keyof AccessServiceCheckAccessErrors];
+
+export type AccessServiceCheckAccessResponses = {
+    /**
+     * OK
+     */
+    200: AccessDecision;
+};
+
+export type AccessServiceCheckAccessResponse = AccessServiceCheckAccessResponses[keyof AccessServiceCheckAccessResponses];
+
+export type ApplicationServiceListApplicationsData = {
+    body?: never;
+    path?: never;
+    query?: {
+        page_size?: number;
+        page_token?: string;
+        /**
+         * state defaults to pending applications.
+         */
+        state?: 'STATE_UNKNOWN' | 'STATE_PENDING' | 'STATE_ACCEPTED' | 'STATE_REJECTED';
+    };
+    url: '/v1/applications';
+};
+
+export type ApplicationServiceListApplicationsErrors = {
+    /**
+     * Default error response
+     */
+    default: Status;
+};
+
+export type ApplicationServiceListApplicationsError = ApplicationServiceListApplicationsErrors[keyof ApplicationServiceListApplicationsErrors];
+
+export type ApplicationServiceListApplicationsResponses = {
+    /**
+     * OK
+     */
+    200: ListApplicationsResponseReadable;
+};
+
+export type ApplicationServiceListApplicationsResponse = ApplicationServiceListApplicationsResponses[keyof ApplicationServiceListApplicationsResponses];
+
+export type ApplicationServiceSubmitApplicationData = {
+    body: SubmitApplicationRequest;
+    path?: never;
+    query?: never;
+    url: '/v1/applications';
+};
+
+export type ApplicationServiceSubmitApplicationErrors = {
+    /**
+     * Default error response
+     */
+    default: Status;
+};
+
+export type ApplicationServiceSubmitApplicationError = ApplicationServiceSubmitApplicationErrors[keyof ApplicationServiceSubmitApplicationErrors];
+
+export type ApplicationServiceSubmitApplicationResponses = {
+    /**
+     * OK
+     */
+    200: unknown;
+};
+
+export type ApplicationServiceAcceptApplicationData = {
+    body: AcceptApplicationRequestWritable;
+    path: {
+        id: string;
+    };
+    query?: never;
+    url: '/v1/applications/{id}:accept';
+};
+
+export type ApplicationServiceAcceptApplicationErrors = {
+    /**
+     * Default error response
+     */
+    default: Status;
+};
+
+export type ApplicationServiceAcceptApplicationError = ApplicationServiceAcceptApplicationErrors[keyof ApplicationServiceAcceptApplicationErrors];
+
+export type ApplicationServiceAcceptApplicationResponses = {
+    /**
+     * OK
+     */
+    200: ApplicationReadable;
+};
+
+export type ApplicationServiceAcceptApplicationResponse = ApplicationServiceAcceptApplicationResponses[keyof ApplicationServiceAcceptApplicationResponses];
+
+export type ApplicationServiceRejectApplicationData = {
+    body: RejectApplicationRequest;
+    path: {
+        id: string;
+    };
+    query?: never;
+    url: '/v1/applications/{id}:reject';
+};
+
+export type ApplicationServiceRejectApplicationErrors = {
+    /**
+     * Default error response
+     */
+    default: Status;
+};
+
+export type ApplicationServiceRejectApplicationError = ApplicationServiceRejectApplicationErrors[keyof ApplicationServiceRejectApplicationErrors];
+
+export type ApplicationServiceRejectApplicationResponses = {
+    /**
+     * OK
+     */
+    200: ApplicationReadable;
+};
+
+export type ApplicationServiceRejectApplicationResponse = ApplicationServiceRejectApplicationResponses[keyof ApplicationServiceRejectApplicationResponses];
+
+export type AuthServiceLoginData = {
+    body: LoginRequest;
+    path?: never;
+    query?: never;
+    url: '/v1/auth/login';
+};
+
+export type AuthServiceLoginErrors = {
+    /**
+     * Default error response
+     */
+    default: Status;
+};
+
+export type AuthServiceLoginError = AuthServiceLoginErrors[keyof AuthServiceLoginErrors];
+
+export type AuthServiceLoginResponses = {
+    /**
+     * OK
+     */
+    200: LoginResponse;
+};
+
+export type AuthServiceLoginResponse = AuthServiceLoginResponses[keyof AuthServiceLoginResponses];
+
+export type AuthServiceLogoutData = {
+    body: LogoutRequest;
+    path?: never;
+    query?: never;
+    url: '/v1/auth/logout';
+};
+
+export type AuthServiceLogoutErrors = {
+    /**
+     * Default error response
+     */
+    default: Status;
+};
+
+export type AuthServiceLogoutError = AuthServiceLogoutErrors[keyof AuthServiceLogoutErrors];
+
+export type AuthServiceLogoutResponses = {
+    /**
+     * OK
+     */
+    200: LogoutResponse;
+};
+
+export type AuthServiceLogoutResponse = AuthServiceLogoutResponses[keyof AuthServiceLogoutResponses];
+
+export type AuthServiceRefreshData = {
+    body: RefreshRequest;
+    path?: never;
+    query?: never;
+    url: '/v1/auth/refresh';
+};
+
+export type AuthServiceRefreshErrors = {
+    /**
+     * Default error response
+     */
+    default: Status;
+};
+
+export type AuthServiceRefreshError = AuthServiceRefreshErrors[keyof AuthServiceRefreshErrors];
+
+export type AuthServiceRefreshResponses = {
+    /**
+     * OK
+     */
+    200: RefreshResponse;
+};
+
+export type AuthServiceRefreshResponse = AuthServiceRefreshResponses[keyof AuthServiceRefreshResponses];
+
+export type FeeServiceListBalancesData = {
+    body?: never;
+    path?: never;
+    query?: {
+        page_size?: number;
+        page_token?: string;
+        member_id?: string;
+        /**
+         * outstanding_only only returns members that owe money.
+         */
+        outstanding_only?: boolean;
+    };
+    url: '/v1/balances';
+};
+
+export type FeeServiceListBalancesErrors = {
+    /**
+     * Default error response
+     */
+    default: Status;
+};
+
+export type FeeServiceListBalancesError = FeeServiceListBalancesErrors[keyof FeeServiceListBalancesErrors];
+
+export type FeeServiceListBalancesResponses = {
+    /**
+     * OK
+     */
+    200: ListBalancesResponse;
+};
+
+export type FeeServiceListBalancesResponse = FeeServiceListBalancesResponses[keyof FeeServiceListBalancesResponses];
+
+export type BriefingServiceListBriefingTypesData = {
+    body?: never;
+    path?: never;
+    query?: {
+        page_size?: number;
+        page_token?: string;
+    };
+    url: '/v1/briefing-types';
+};
+
+export type BriefingServiceListBriefingTypesErrors = {
+    /**
+     * Default error response
+     */
+    default: Status;
+};
+
+export type BriefingServiceListBriefingTypesError = BriefingServiceListBriefingTypesErrors[keyof BriefingServiceListBriefingTypesErrors];
+
+export type BriefingServiceListBriefingTypesResponses = {
+    /**
+     * OK
+     */
+    200: ListBriefingTypesResponse;
+};
+
+export type BriefingServiceListBriefingTypesResponse = BriefingServiceListBriefingTypesResponses[keyof BriefingServiceListBriefingTypesResponses];
+
+export type BriefingServiceCreateBriefingTypeData = {
+    body: BriefingType;
+    path?: never;
+    query?: {
+        briefing_type_id?: string;
+    };
+    url: '/v1/briefing-types';
+};
+
+export type BriefingServiceCreateBriefingTypeErrors = {
+    /**
+     * Default error response
+     */
+    default: Status;
+};
+
+export type BriefingServiceCreateBriefingTypeError = BriefingServiceCreateBriefingTypeErrors[keyof BriefingServiceCreateBriefingTypeErrors];
+
+export type BriefingServiceCreateBriefingTypeResponses = {
+    /**
+     * OK
+     */
+    200: BriefingType;
+};
+
+export type BriefingServiceCreateBriefingTypeResponse = BriefingServiceCreateBriefingTypeResponses[keyof BriefingServiceCreateBriefingTypeResponses];
+
+export type BriefingServiceUpdateBriefingTypeData = {
+    body: BriefingType;
+    path: {
+        'briefing_type.id': string;
+    };
+    query?: {
+        field_mask?: string;
+    };
+    url: '/v1/briefing-types/{briefing_type.id}';
+};
+
+export type BriefingServiceUpdateBriefingTypeErrors = {
+    /**
+     * Default error response
+     */
+    default: Status;
+};
+
+export type BriefingServiceUpdateBriefingTypeError = BriefingServiceUpdateBriefingTypeErrors[keyof BriefingServiceUpdateBriefingTypeErrors];
+
+export type BriefingServiceUpdateBriefingTypeResponses = {
+    /**
+     * OK
+     */
+    200: BriefingType;
+};
+
+export type BriefingServiceUpdateBriefingTypeResponse = BriefingServiceUpdateBriefingTypeResponses[keyof BriefingServiceUpdateBriefingTypeResponses];
+
+export type BriefingServiceDeleteBriefingTypeData = {
+    body?: never;
+    path: {
+        id: string;
+    };
+    query?: never;
+    url: '/v1/briefing-types/{id}';
+};
+
+export type BriefingServiceDeleteBriefingTypeErrors = {
+    /**
+     * Default error response
+     */
+    default: Status;
+};
+
+export type BriefingServiceDeleteBriefingTypeError = BriefingServiceDeleteBriefingTypeErrors[keyof BriefingServiceDeleteBriefingTypeErrors];
+
+export type BriefingServiceDeleteBriefingTypeResponses = {
+    /**
+     * OK
+     */
+    200: unknown;
+};
+
+export type BriefingServiceGetBriefingTypeData = {
+    body?: never;
+    path: {
+        id: string;
+    };
+    query?: never;
+    url: '/v1/briefing-types/{id}';
+};
+
+export type BriefingServiceGetBriefingTypeErrors = {
+    /**
+     * Default error response
+     */
+    default: Status;
+};
+
+export type BriefingServiceGetBriefingTypeError = BriefingServiceGetBriefingTypeErrors[keyof BriefingServiceGetBriefingTypeErrors];
+
+export type BriefingServiceGetBriefingTypeResponses = {
+    /**
+     * OK
+     */
+    200: BriefingType;
+};
+
+export type BriefingServiceGetBriefingTypeResponse = BriefingServiceGetBriefingTypeResponses[keyof BriefingServiceGetBriefingTypeResponses];
+
+export type BriefingServiceListBriefingsData = {
+    body?: never;
+    path?: never;
+    query?: {
+        page_size?: number;
+        page_token?: string;
+        member_id?: string;
+        briefing_type?: string;
+        /**
+         * valid_only limits the result to briefings that have not expired.
+         */
+        valid_only?: boolean;
+    };
+    url: '/v1/briefings';
+};
+
+export type BriefingServiceListBriefingsErrors = {
+    /**
+     * Default error response
+     */
+    default: Status;
+};
+
+export type BriefingServiceListBriefingsError = BriefingServiceListBriefingsErrors[keyof BriefingServiceListBriefingsErrors];
+
+export type BriefingServiceListBriefingsResponses = {
+    /**
+     * OK
+     */
+    200: ListBriefingsResponseReadable;
+};
+
+export type BriefingServiceListBriefingsResponse = BriefingServiceListBriefingsResponses[keyof BriefingServiceListBriefingsResponses];
+
+export type BriefingServiceCreateBriefingData = {
+    body: BriefingWritable;
+    path?: never;
+    query?: {
+        briefing_id?: string;
+    };
+    url: '/v1/briefings';
+};
+
+export type BriefingServiceCreateBriefingErrors = {
+    /**
+     * Default error response
+     */
+    default: Status;
+};
+
+export type BriefingServiceCreateBriefingError = BriefingServiceCreateBriefingErrors[keyof BriefingServiceCreateBriefingErrors];
+
+export type BriefingServiceCreateBriefingResponses = {
+    /**
+     * OK
+     */
+    200: BriefingReadable;
+};
+
+export type BriefingServiceCreateBriefingResponse = BriefingServiceCreateBriefingResponses[keyof BriefingServiceCreateBriefingResponses];
+
+export type BriefingServiceUpdateBriefingData = {
+    body: BriefingWritable;
+    path: {
+        'briefing.id': string;
+    };
+    query?: {
+        field_mask?: string;
+    };
+    url: '/v1/briefings/{briefing.id}';
+};
+
+export type BriefingServiceUpdateBriefingErrors = {
+    /**
+     * Default error response
+     */
+    default: Status;
+};
+
+export type BriefingServiceUpdateBriefingError = BriefingServiceUpdateBriefingErrors[keyof BriefingServiceUpdateBriefingErrors];
+
+export type BriefingServiceUpdateBriefingResponses = {
+    /**
+     * OK
+     */
+    200: BriefingReadable;
+};
+
+export type BriefingServiceUpdateBriefingResponse = BriefingServiceUpdateBriefingResponses[keyof BriefingServiceUpdateBriefingResponses];
+
+export type BriefingServiceDeleteBriefingData = {
+    body?: never;
+    path: {
+        id: string;
+    };
+    query?: never;
+    url: '/v1/briefings/{id}';
+};
+
+export type BriefingServiceDeleteBriefingErrors = {
+    /**
+     * Default error response
+     */
+    default: Status;
+};
+
+export type BriefingServiceDeleteBriefingError = BriefingServiceDeleteBriefingErrors[keyof BriefingServiceDeleteBriefingErrors];
+
+export type BriefingServiceDeleteBriefingResponses = {
+    /**
+     * OK
+     */
+    200: unknown;
+};
+
+export type BriefingServiceGetBriefingData = {
+    body?: never;
+    path: {
+        id: string;
+    };
+    query?: never;
+    url: '/v1/briefings/{id}';
+};
+
+export type BriefingServiceGetBriefingErrors = {
+    /**
+     * Default error response
+     */
+    default: Status;
+};
+
+export type BriefingServiceGetBriefingError = BriefingServiceGetBriefingErrors[keyof BriefingServiceGetBriefingErrors];
+
+export type BriefingServiceGetBriefingResponses = {
+    /**
+     * OK
+     */
+    200: BriefingReadable;
+};
+
+export type BriefingServiceGetBriefingResponse = BriefingServiceGetBriefingResponses[keyof BriefingServiceGetBriefingResponses];
+
+export type CardServiceListCardsData = {
+    body?: never;
+    path?: never;
+    query?: {
+        page_size?: number;
+        page_token?: string;
+        sort_by?: 'CARD_FIELD_UNKNOWN' | 'CARD_FIELD_ID' | 'CARD_FIELD_MEMBER_ID' | 'CARD_FIELD_VALID_FROM' | 'CARD_FIELD_VALID_TO';
+        sort_direction?: 'SORT_DIRECTION_DEFAULT' | 'SORT_DIRECTION_ASCENDING' | 'SORT_DIRECTION_DESCENDING';
+        member_id?: string;
+        valid_on?: string;
+        rfid_value?: string;
+    };
+    url: '/v1/cards';
+};
+
+export type CardServiceListCardsErrors = {
+    /**
+     * Default error response
+     */
+    default: Status;
+};
+
+export type CardServiceListCardsError = CardServiceListCardsErrors[keyof CardServiceListCardsErrors];
+
+export type CardServiceListCardsResponses = {
+    /**
+     * OK
+     */
+    200: ListCardsResponseReadable;
+};
+
+export type CardServiceListCardsResponse = CardServiceListCardsResponses[keyof CardServiceListCardsResponses];
+
+export type CardServiceCreateCardData = {
+    body: CardWritable;
+    path?: never;
+    query?: {
+        card_id?: string;
+    };
+    url: '/v1/cards';
+};
+
+export type CardServiceCreateCardErrors = {
+    /**
+     * Default error response
+     */
+    default: Status;
+};
+
+export type CardServiceCreateCardError = CardServiceCreateCardErrors[keyof CardServiceCreateCardErrors];
+
+export type CardServiceCreateCardResponses = {
+    /**
+     * OK
+     */
+    200: CardReadable;
+};
+
+export type CardServiceCreateCardResponse = CardServiceCreateCardResponses[keyof CardServiceCreateCardResponses];
+
+export type CardServiceUpdateCardData = {
+    body: CardWritable;
+    path: {
+        'card.id': string;
+    };
+    query?: {
+        field_mask?: string;
+    };
+    url: '/v1/cards/{card.id}';
+};
+
+export type CardServiceUpdateCardErrors = {
+    /**
+     * Default error response
+     */
+    default: Status;
+};
+
+export type CardServiceUpdateCardError = CardServiceUpdateCardErrors[keyof CardServiceUpdateCardErrors];
+
+export type CardServiceUpdateCardResponses = {
+    /**
+     * OK
+     */
+    200: CardReadable;
+};
+
+export type CardServiceUpdateCardResponse = CardServiceUpdateCardResponses[keyof CardServiceUpdateCardResponses];
+
+export type CardServiceDeleteCardData = {
+    body?: never;
+    path: {
+        id: string;
+    };
+    query?: never;
+    url: '/v1/cards/{id}';
+};
+
+export type CardServiceDeleteCardErrors = {
+    /**
+     * Default error response
+     */
+    default: Status;
+};
+
+export type CardServiceDeleteCardError = CardServiceDeleteCardErrors[keyof CardServiceDeleteCardErrors];
+
+export type CardServiceDeleteCardResponses = {
+    /**
+     * OK
+     */
+    200: unknown;
+};
+
+export type CardServiceGetCardData = {
+    body?: never;
+    path: {
+        id: string;
+    };
+    query?: never;
+    url: '/v1/cards/{id}';
+};
+
+export type CardServiceGetCardErrors = {
+    /**
+     * Default error response
+     */
+    default: Status;
+};
+
+export type CardServiceGetCardError = CardServiceGetCardErrors[keyof CardServiceGetCardErrors];
+
+export type CardServiceGetCardResponses = {
+    /**
+     * OK
+     */
+    200: CardReadable;
+};
+
+export type CardServiceGetCardResponse = CardServiceGetCardResponses[keyof CardServiceGetCardResponses];
+
+export type CardServiceExportCardsData = {
+    body?: never;
+    path?: never;
+    query?: {
+        'filter.page_size'?: number;
+        'filter.page_token'?: string;
+        'filter.sort_by'?: 'CARD_FIELD_UNKNOWN' | 'CARD_FIELD_ID' | 'CARD_FIELD_MEMBER_ID' | 'CARD_FIELD_VALID_FROM' | 'CARD_FIELD_VALID_TO';
+        'filter.sort_direction'?: 'SORT_DIRECTION_DEFAULT' | 'SORT_DIRECTION_ASCENDING' | 'SORT_DIRECTION_DESCENDING';
+        'filter.member_id'?: string;
+        'filter.valid_on'?: string;
+        'filter.rfid_value'?: string;
+        format?: 'EXPORT_FORMAT_UNKNOWN' | 'EXPORT_FORMAT_CSV' | 'EXPORT_FORMAT_XLSX';
+    };
+    url: '/v1/cards:export';
+};
+
+export type CardServiceExportCardsErrors = {
+    /**
+     * Default error response
+     */
+    default: Status;
+};
+
+export type CardServiceExportCardsError = CardServiceExportCardsErrors[keyof CardServiceExportCardsErrors];
+
+export type CardServiceExportCardsResponses = {
+    /**
+     * OK
+     */
+    200: unknown;
+};
+
+export type EventServiceListEventsData = {
+    body?: never;
+    path?: never;
+    query?: {
+        page_size?: number;
+        page_token?: string;
+        sort_by?: 'EVENT_FIELD_UNKNOWN' | 'EVENT_FIELD_ID' | 'EVENT_FIELD_TITLE' | 'EVENT_FIELD_START_TIME' | 'EVENT_FIELD_END_TIME';
+        sort_direction?: 'SORT_DIRECTION_DEFAULT' | 'SORT_DIRECTION_ASCENDING' | 'SORT_DIRECTION_DESCENDING';
+        start_time_after?: string;
+        start_time_before?: string;
+    };
+    url: '/v1/events';
+};
+
+export type EventServiceListEventsErrors = {
+    /**
+     * Default error response
+     */
+    default: Status;
+};
+
+export type EventServiceListEventsError = EventServiceListEventsErrors[keyof EventServiceListEventsErrors];
+
+export type EventServiceListEventsResponses = {
+    /**
+     * OK
+     */
+    200: ListEventsResponseReadable;
+};
+
+export type EventServiceListEventsResponse = EventServiceListEventsResponses[keyof EventServiceListEventsResponses];
+
+export type EventServiceCreateEventData = {
+    body: EventWritable;
+    path?: never;
+    query?: {
+        event_id?: string;
+    };
+    url: '/v1/events';
+};
+
+export type EventServiceCreateEventErrors = {
+    /**
+     * Default error response
+     */
+    default: Status;
+};
+
+export type EventServiceCreateEventError = EventServiceCreateEventErrors[keyof EventServiceCreateEventErrors];
+
+export type EventServiceCreateEventResponses = {
+    /**
+     * OK
+     */
+    200: EventReadable;
+};
+
+export type EventServiceCreateEventResponse = EventServiceCreateEventResponses[keyof EventServiceCreateEventResponses];
+
+export type EventServiceUpdateEventData = {
+    body: EventWritable;
+    path: {
+        'event.id': string;
+    };
+    query?: {
+        field_mask?: string;
+    };
+    url: '/v1/events/{event.id}';
+};
+
+export type EventServiceUpdateEventErrors = {
+    /**
+     * Default error response
+     */
+    default: Status;
+};
+
+export type EventServiceUpdateEventError = EventServiceUpdateEventErrors[keyof EventServiceUpdateEventErrors];
+
+export type EventServiceUpdateEventResponses = {
+    /**
+     * OK
+     */
+    200: EventReadable;
+};
+
+export type EventServiceUpdateEventResponse = EventServiceUpdateEventResponses[keyof EventServiceUpdateEventResponses];
+
+export type EventServiceListEventRegistrationsData = {
+    body?: never;
+    path: {
+        event_id: string;
+    };
+    query?: {
+        page_size?: number;
+        page_token?: string;
+        status?: 'EVENT_REGISTRATION_STATUS_UNKNOWN' | 'EVENT_REGISTRATION_STATUS_REGISTERED' | 'EVENT_REGISTRATION_STATUS_WAITLISTED' | 'EVENT_REGISTRATION_STATUS_CANCELLED';
+        member_id?: string;
+    };
+    url: '/v1/events/{event_id}/registrations';
+};
+
+export type EventServiceListEventRegistrationsErrors = {
+    /**
+     * Default error response
+     */
+    default: Status;
+};
+
+export type EventServiceListEventRegistrationsError = EventServiceListEventRegistrationsErrors[keyof EventServiceListEventRegistrationsErrors];
+
+export type EventServiceListEventRegistrationsResponses = {
+    /**
+     * OK
+     */
+    200: ListEventRegistrationsResponseReadable;
+};
+
+export type EventServiceListEventRegistrationsResponse = EventServiceListEventRegistrationsResponses[keyof EventServiceListEventRegistrationsResponses];
+
+export type EventServiceRegisterForEventData = {
+    body: RegisterForEventRequest;
+    path: {
+        event_id: string;
+    };
+    query?: never;
+    url: '/v1/events/{event_id}/registrations';
+};
+
+export type EventServiceRegisterForEventErrors = {
+    /**
+     * Default error response
+     */
+    default: Status;
+};
+
+export type EventServiceRegisterForEventError = EventServiceRegisterForEventErrors[keyof EventServiceRegisterForEventErrors];
+
+export type EventServiceRegisterForEventResponses = {
+    /**
+     * OK
+     */
+    200: EventRegistrationReadable;
+};
+
+export type EventServiceRegisterForEventResponse = EventServiceRegisterForEventResponses[keyof EventServiceRegisterForEventResponses];
+
+export type EventServiceMarkEventAttendanceData = {
+    body: MarkEventAttendanceRequest;
+    path: {
+        event_id: string;
+        id: string;
+    };
+    query?: never;
+    url: '/v1/events/{event_id}/registrations/{id}:attendance';
+};
+
+export type EventServiceMarkEventAttendanceErrors = {
+    /**
+     * Default error response
+     */
+    default: Status;
+};
+
+export type EventServiceMarkEventAttendanceError = EventServiceMarkEventAttendanceErrors[keyof EventServiceMarkEventAttendanceErrors];
+
+export type EventServiceMarkEventAttendanceResponses = {
+    /**
+     * OK
+     */
+    200: EventRegistrationReadable;
+};
+
+export type EventServiceMarkEventAttendanceResponse = EventServiceMarkEventAttendanceResponses[keyof EventServiceMarkEventAttendanceResponses];
+
+export type EventServiceCancelEventRegistrationData = {
+    body: CancelEventRegistrationRequest;
+    path: {
+        event_id: string;
+        id: string;
+    };
+    query?: never;
+    url: '/v1/events/{event_id}/registrations/{id}:cancel';
+};
+
+export type EventServiceCancelEventRegistrationErrors = {
+    /**
+     * Default error response
+     */
+    default: Status;
+};
+
+export type EventServiceCancelEventRegistrationError = EventServiceCancelEventRegistrationErrors[keyof EventServiceCancelEventRegistrationErrors];
+
+export type EventServiceCancelEventRegistrationResponses = {
+    /**
+     * OK
+     */
+    200: EventRegistrationReadable;
+};
+
+export type EventServiceCancelEventRegistrationResponse = EventServiceCancelEventRegistrationResponses[keyof EventServiceCancelEventRegistrationResponses];
+
+export type EventServiceDeleteEventData = {
+    body?: never;
+    path: {
+        id: string;
+    };
+    query?: never;
+    url: '/v1/events/{id}';
+};
+
+export type EventServiceDeleteEventErrors = {
+    /**
+     * Default error response
+     */
+    default: Status;
+};
+
+export type EventServiceDeleteEventError = EventServiceDeleteEventErrors[keyof EventServiceDeleteEventErrors];
+
+export type EventServiceDeleteEventResponses = {
+    /**
+     * OK
+     */
+    200: unknown;
+};
+
+export type EventServiceGetEventData = {
+    body?: never;
+    path: {
+        id: string;
+    };
+    query?: never;
+    url: '/v1/events/{id}';
+};
+
+export type EventServiceGetEventErrors = {
+    /**
+     * Default error response
+     */
+    default: Status;
+};
+
+export type EventServiceGetEventError = EventServiceGetEventErrors[keyof EventServiceGetEventErrors];
+
+export type EventServiceGetEventResponses = {
+    /**
+     * OK
+     */
+    200: EventReadable;
+};
+
+export type EventServiceGetEventResponse = EventServiceGetEventResponses[keyof EventServiceGetEventResponses];
+
+export type FeeServiceListInvoicesData = {
+    body?: never;
+    path?: never;
+    query?: {
+        page_size?: number;
+        page_token?: string;
+        member_id?: string;
+        status?: 'INVOICE_STATUS_UNKNOWN' | 'INVOICE_STATUS_OPEN' | 'INVOICE_STATUS_PAID' | 'INVOICE_STATUS_CANCELLED';
+    };
+    url: '/v1/invoices';
+};
+
+export type FeeServiceListInvoicesErrors = {
+    /**
+     * Default error response
+     */
+    default: Status;
+};
+
+export type FeeServiceListInvoicesError = FeeServiceListInvoicesErrors[keyof FeeServiceListInvoicesErrors];
+
+export type FeeServiceListInvoicesResponses = {
+    /**
+     * OK
+     */
+    200: ListInvoicesResponse;
+};
+
+export type FeeServiceListInvoicesResponse = FeeServiceListInvoicesResponses[keyof FeeServiceListInvoicesResponses];
+
+export type FeeServiceCancelInvoiceData = {
+    body: CancelInvoiceRequest;
+    path: {
+        id: string;
+    };
+    query?: never;
+    url: '/v1/invoices/{id}:cancel';
+};
+
+export type FeeServiceCancelInvoiceErrors = {
+    /**
+     * Default error response
+     */
+    default: Status;
+};
+
+export type FeeServiceCancelInvoiceError = FeeServiceCancelInvoiceErrors[keyof FeeServiceCancelInvoiceErrors];
+
+export type FeeServiceCancelInvoiceResponses = {
+    /**
+     * OK
+     */
+    200: Invoice;
+};
+
+export type FeeServiceCancelInvoiceResponse = FeeServiceCancelInvoiceResponses[keyof FeeServiceCancelInvoiceResponses];
+
+export type FeeServiceExportSepaDirectDebitData = {
+    body: ExportSepaDirectDebitRequest;
+    path?: never;
+    query?: never;
+    url: '/v1/invoices:export-sepa';
+};
+
+export type FeeServiceExportSepaDirectDebitErrors = {
+    /**
+     * Default error response
+     */
+    default: Status;
+};
+
+export type FeeServiceExportSepaDirectDebitError = FeeServiceExportSepaDirectDebitErrors[keyof FeeServiceExportSepaDirectDebitErrors];
+
+export type FeeServiceExportSepaDirectDebitResponses = {
+    /**
+     * OK
+     */
+    200: SepaDirectDebitExport;
+};
+
+export type FeeServiceExportSepaDirectDebitResponse = FeeServiceExportSepaDirectDebitResponses[keyof FeeServiceExportSepaDirectDebitResponses];
+
+export type FeeServiceGenerateInvoicesData = {
+    body: GenerateInvoicesRequest;
+    path?: never;
+    query?: never;
+    url: '/v1/invoices:generate';
+};
+
+export type FeeServiceGenerateInvoicesErrors = {
+    /**
+     * Default error response
+     */
+    default: Status;
+};
+
+export type FeeServiceGenerateInvoicesError = FeeServiceGenerateInvoicesErrors[keyof FeeServiceGenerateInvoicesErrors];
+
+export type FeeServiceGenerateInvoicesResponses = {
+    /**
+     * OK
+     */
+    200: GenerateInvoicesResponse;
+};
+
+export type FeeServiceGenerateInvoicesResponse = FeeServiceGenerateInvoicesResponses[keyof FeeServiceGenerateInvoicesResponses];
+
+export type LendingServiceListItemsData = {
+    body?: never;
+    path?: never;
+    query?: {
+        page_size?: number;
+        page_token?: string;
+        sort_by?: 'ITEM_FIELD_UNKNOWN' | 'ITEM_FIELD_ID' | 'ITEM_FIELD_NAME';
+        sort_direction?: 'SORT_DIRECTION_DEFAULT' | 'SORT_DIRECTION_ASCENDING' | 'SORT_DIRECTION_DESCENDING';
+        available?: boolean;
+    };
+    url: '/v1/items';
+};
+
+export type LendingServiceListItemsErrors = {
+    /**
+     * Default error response
+     */
+    default: Status;
+};
+
+export type LendingServiceListItemsError = LendingServiceListItemsErrors[keyof LendingServiceListItemsErrors];
+
+export type LendingServiceListItemsResponses = {
+    /**
+     * OK
+     */
+    200: ListItemsResponseReadable;
+};
+
+export type LendingServiceListItemsResponse = LendingServiceListItemsResponses[keyof LendingServiceListItemsResponses];
+
+export type LendingServiceCreateItemData = {
+    body: ItemWritable;
+    path?: never;
+    query?: {
+        item_id?: string;
+    };
+    url: '/v1/items';
+};
+
+export type LendingServiceCreateItemErrors = {
+    /**
+     * Default error response
+     */
+    default: Status;
+};
+
+export type LendingServiceCreateItemError = LendingServiceCreateItemErrors[keyof LendingServiceCreateItemErrors];
+
+export type LendingServiceCreateItemResponses = {
+    /**
+     * OK
+     */
+    200: ItemReadable;
+};
+
+export type LendingServiceCreateItemResponse = LendingServiceCreateItemResponses[keyof LendingServiceCreateItemResponses];
+
+export type LendingServiceDeleteItemData = {
+    body?: never;
+    path: {
+        id: string;
+    };
+    query?: never;
+    url: '/v1/items/{id}';
+};
+
+export type LendingServiceDeleteItemErrors = {
+    /**
+     * Default error response
+     */
+    default: Status;
+};
+
+export type LendingServiceDeleteItemError = LendingServiceDeleteItemErrors[keyof LendingServiceDeleteItemErrors];
+
+export type LendingServiceDeleteItemResponses = {
+    /**
+     * OK
+     */
+    200: unknown;
+};
+
+export type LendingServiceGetItemData = {
+    body?: never;
+    path: {
+        id: string;
+    };
+    query?: never;
+    url: '/v1/items/{id}';
+};
+
+export type LendingServiceGetItemErrors = {
+    /**
+     * Default error response
+     */
+    default: Status;
+};
+
+export type LendingServiceGetItemError = LendingServiceGetItemErrors[keyof LendingServiceGetItemErrors];
+
+export type LendingServiceGetItemResponses = {
+    /**
+     * OK
+     */
+    200: ItemReadable;
+};
+
+export type LendingServiceGetItemResponse = LendingServiceGetItemResponses[keyof LendingServiceGetItemResponses];
+
+export type LendingServiceUpdateItemData = {
+    body: ItemWritable;
+    path: {
+        'item.id': string;
+    };
+    query?: {
+        field_mask?: string;
+    };
+    url: '/v1/items/{item.id}';
+};
+
+export type LendingServiceUpdateItemErrors = {
+    /**
+     * Default error response
+     */
+    default: Status;
+};
+
+export type LendingServiceUpdateItemError = LendingServiceUpdateItemErrors[keyof LendingServiceUpdateItemErrors];
+
+export type LendingServiceUpdateItemResponses = {
+    /**
+     * OK
+     */
+    200: ItemReadable;
+};
+
+export type LendingServiceUpdateItemResponse = LendingServiceUpdateItemResponses[keyof LendingServiceUpdateItemResponses];
+
+export type LendingServiceListLoansData = {
+    body?: never;
+    path?: never;
+    query?: {
+        page_size?: number;
+        page_token?: string;
+        item_id?: string;
+        member_id?: string;
+        /**
+         * open limits the result to loans that have not been returned yet.
+         */
+        open?: boolean;
+        /**
+         * overdue limits the result to open loans past their expected return time.
+         */
+        overdue?: boolean;
+    };
+    url: '/v1/loans';
+};
+
+export type LendingServiceListLoansErrors = {
+    /**
+     * Default error response
+     */
+    default: Status;
+};
+
+export type LendingServiceListLoansError = LendingServiceListLoansErrors[keyof LendingServiceListLoansErrors];
+
+export type LendingServiceListLoansResponses = {
+    /**
+     * OK
+     */
+    200: ListLoansResponseReadable;
+};
+
+export type LendingServiceListLoansResponse = LendingServiceListLoansResponses[keyof LendingServiceListLoansResponses];
+
+export type LendingServiceLendItemData = {
+    body: LendItemRequest;
+    path?: never;
+    query?: never;
+    url: '/v1/loans';
+};
+
+export type LendingServiceLendItemErrors = {
+    /**
+     * Default error response
+     */
+    default: Status;
+};
+
+export type LendingServiceLendItemError = LendingServiceLendItemErrors[keyof LendingServiceLendItemErrors];
+
+export type LendingServiceLendItemResponses = {
+    /**
+     * OK
+     */
+    200: LoanReadable;
+};
+
+export type LendingServiceLendItemResponse = LendingServiceLendItemResponses[keyof LendingServiceLendItemResponses];
+
+export type LendingServiceGetLoanData = {
+    body?: never;
+    path: {
+        id: string;
+    };
+    query?: never;
+    url: '/v1/loans/{id}';
+};
+
+export type LendingServiceGetLoanErrors = {
+    /**
+     * Default error response
+     */
+    default: Status;
+};
+
+export type LendingServiceGetLoanError = LendingServiceGetLoanErrors[keyof LendingServiceGetLoanErrors];
+
+export type LendingServiceGetLoanResponses = {
+    /**
+     * OK
+     */
+    200: LoanReadable;
+};
+
+export type LendingServiceGetLoanResponse = LendingServiceGetLoanResponses[keyof LendingServiceGetLoanResponses];
+
+export type LendingServiceReturnItemData = {
+    body: ReturnItemRequest;
+    path: {
+        id: string;
+    };
+    query?: never;
+    url: '/v1/loans/{id}:return';
+};
+
+export type LendingServiceReturnItemErrors = {
+    /**
+     * Default error response
+     */
+    default: Status;
+};
+
+export type LendingServiceReturnItemError = LendingServiceReturnItemErrors[keyof LendingServiceReturnItemErrors];
+
+export type LendingServiceReturnItemResponses = {
+    /**
+     * OK
+     */
+    200: LoanReadable;
+};
+
+export type LendingServiceReturnItemResponse = LendingServiceReturnItemResponses[keyof LendingServiceReturnItemResponses];
+
+export type LendingServiceReturnItemByScanData = {
+    body: ReturnItemByScanRequest;
+    path?: never;
+    query?: never;
+    url: '/v1/loans:return-scan';
+};
+
+export type LendingServiceReturnItemByScanErrors = {
+    /**
+     * Default error response
+     */
+    default: Status;
+};
+
+export type LendingServiceReturnItemByScanError = LendingServiceReturnItemByScanErrors[keyof LendingServiceReturnItemByScanErrors];
+
+export type LendingServiceReturnItemByScanResponses = {
+    /**
+     * OK
+     */
+    200: LoanReadable;
+};
+
+export type LendingServiceReturnItemByScanResponse = LendingServiceReturnItemByScanResponses[keyof LendingServiceReturnItemByScanResponses];
+
+export type LendingServiceLendItemByScanData = {
+    body: LendItemByScanRequest;
+    path?: never;
+    query?: never;
+    url: '/v1/loans:scan';
+};
+
+export type LendingServiceLendItemByScanErrors = {
+    /**
+     * Default error response
+     */
+    default: Status;
+};
+
+export type LendingServiceLendItemByScanError = LendingServiceLendItemByScanErrors[keyof LendingServiceLendItemByScanErrors];
+
+export type LendingServiceLendItemByScanResponses = {
+    /**
+     * OK
+     */
+    200: LoanReadable;
+};
+
+export type LendingServiceLendItemByScanResponse = LendingServiceLendItemByScanResponses[keyof LendingServiceLendItemByScanResponses];
+
+export type MachineServiceListMachinesData = {
+    body?: never;
+    path?: never;
+    query?: {
+        page_size?: number;
+        page_token?: string;
+    };
+    url: '/v1/machines';
+};
+
+export type MachineServiceListMachinesErrors = {
+    /**
+     * Default error response
+     */
+    default: Status;
+};
+
+export type MachineServiceListMachinesError = MachineServiceListMachinesErrors[keyof MachineServiceListMachinesErrors];
+
+export type MachineServiceListMachinesResponses = {
+    /**
+     * OK
+     */
+    200: ListMachinesResponseReadable;
+};
+
+export type MachineServiceListMachinesResponse = MachineServiceListMachinesResponses[keyof MachineServiceListMachinesResponses];
+
+export type MachineServiceCreateMachineData = {
+    body: MachineWritable;
+    path?: never;
+    query?: {
+        machine_id?: string;
+    };
+    url: '/v1/machines';
+};
+
+export type MachineServiceCreateMachineErrors = {
+    /**
+     * Default error response
+     */
+    default: Status;
+};
+
+export type MachineServiceCreateMachineError = MachineServiceCreateMachineErrors[keyof MachineServiceCreateMachineErrors];
+
+export type MachineServiceCreateMachineResponses = {
+    /**
+     * OK
+     */
+    200: MachineReadable;
+};
+
+export type MachineServiceCreateMachineResponse = MachineServiceCreateMachineResponses[keyof MachineServiceCreateMachineResponses];
+
+export type MachineServiceDeleteMachineData = {
+    body?: never;
+    path: {
+        id: string;
+    };
+    query?: never;
+    url: '/v1/machines/{id}';
+};
+
+export type MachineServiceDeleteMachineErrors = {
+    /**
+     * Default error response
+     */
+    default: Status;
+};
+
+export type MachineServiceDeleteMachineError = MachineServiceDeleteMachineErrors[keyof MachineServiceDeleteMachineErrors];
+
+export type MachineServiceDeleteMachineResponses = {
+    /**
+     * OK
+     */
+    200: unknown;
+};
+
+export type MachineServiceGetMachineData = {
+    body?: never;
+    path: {
+        id: string;
+    };
+    query?: never;
+    url: '/v1/machines/{id}';
+};
+
+export type MachineServiceGetMachineErrors = {
+    /**
+     * Default error response
+     */
+    default: Status;
+};
+
+export type MachineServiceGetMachineError = MachineServiceGetMachineErrors[keyof MachineServiceGetMachineErrors];
+
+export type MachineServiceGetMachineResponses = {
+    /**
+     * OK
+     */
+    200: MachineReadable;
+};
+
+export type MachineServiceGetMachineResponse = MachineServiceGetMachineResponses[keyof MachineServiceGetMachineResponses];
+
+export type MachineServiceUpdateMachineData = {
+    body: MachineWritable;
+    path: {
+        'machine.id': string;
+    };
+    query?: {
+        field_mask?: string;
+    };
+    url: '/v1/machines/{machine.id}';
+};
+
+export type MachineServiceUpdateMachineErrors = {
+    /**
+     * Default error response
+     */
+    default: Status;
+};
+
+export type MachineServiceUpdateMachineError = MachineServiceUpdateMachineErrors[keyof MachineServiceUpdateMachineErrors];
+
+export type MachineServiceUpdateMachineResponses = {
+    /**
+     * OK
+     */
+    200: MachineReadable;
+};
+
+export type MachineServiceUpdateMachineResponse = MachineServiceUpdateMachineResponses[keyof MachineServiceUpdateMachineResponses];
+
+export type MachineServiceStartUsageSessionData = {
+    body: StartUsageSessionRequest;
+    path: {
+        machine_id: string;
+    };
+    query?: never;
+    url: '/v1/machines/{machine_id}/sessions:start';
+};
+
+export type MachineServiceStartUsageSessionErrors = {
+    /**
+     * Default error response
+     */
+    default: Status;
+};
+
+export type MachineServiceStartUsageSessionError = MachineServiceStartUsageSessionErrors[keyof MachineServiceStartUsageSessionErrors];
+
+export type MachineServiceStartUsageSessionResponses = {
+    /**
+     * OK
+     */
+    200: UsageSession;
+};
+
+export type MachineServiceStartUsageSessionResponse = MachineServiceStartUsageSessionResponses[keyof MachineServiceStartUsageSessionResponses];
+
+export type MachineServiceStopUsageSessionData = {
+    body: StopUsageSessionRequest;
+    path: {
+        machine_id: string;
+    };
+    query?: never;
+    url: '/v1/machines/{machine_id}/sessions:stop';
+};
+
+export type MachineServiceStopUsageSessionErrors = {
+    /**
+     * Default error response
+     */
+    default: Status;
+};
+
+export type MachineServiceStopUsageSessionError = MachineServiceStopUsageSessionErrors[keyof MachineServiceStopUsageSessionErrors];
+
+export type MachineServiceStopUsageSessionResponses = {
+    /**
+     * OK
+     */
+    200: UsageSession;
+};
+
+export type MachineServiceStopUsageSessionResponse = MachineServiceStopUsageSessionResponses[keyof MachineServiceStopUsageSessionResponses];
+
+export type SelfServiceGetMyProfileData = {
+    body?: never;
+    path?: never;
+    query?: never;
+    url: '/v1/me';
+};
+
+export type SelfServiceGetMyProfileErrors = {
+    /**
+     * Default error response
+     */
+    default: Status;
+};
+
+export type SelfServiceGetMyProfileError = SelfServiceGetMyProfileErrors[keyof SelfServiceGetMyProfileErrors];
+
+export type SelfServiceGetMyProfileResponses = {
+    /**
+     * OK
+     */
+    200: MemberReadable;
+};
+
+export type SelfServiceGetMyProfileResponse = SelfServiceGetMyProfileResponses[keyof SelfServiceGetMyProfileResponses];
+
+export type SelfServiceUpdateMyProfileData = {
+    body: MemberWritable;
+    path?: never;
+    query?: {
+        /**
+         * field_mask may only contain the fields and additional_attributes.<technical_name> paths that are configured for
+         * self service.
+         */
+        field_mask?: string;
+    };
+    url: '/v1/me';
+};
+
+export type SelfServiceUpdateMyProfileErrors = {
+    /**
+     * Default error response
+     */
+    default: Status;
+};
+
+export type SelfServiceUpdateMyProfileError = SelfServiceUpdateMyProfileErrors[keyof SelfServiceUpdateMyProfileErrors];
+
+export type SelfServiceUpdateMyProfileResponses = {
+    /**
+     * OK
+     */
+    200: MemberReadable;
+};
+
+export type SelfServiceUpdateMyProfileResponse = SelfServiceUpdateMyProfileResponses[keyof SelfServiceUpdateMyProfileResponses];
+
+export type SelfServiceListMyBriefingsData = {
+    body?: never;
+    path?: never;
+    query?: {
+        page_size?: number;
+        page_token?: string;
+        /**
+         * valid_only limits the result to briefings that have not expired.
+         */
+        valid_only?: boolean;
+    };
+    url: '/v1/me/briefings';
+};
+
+export type SelfServiceListMyBriefingsErrors = {
+    /**
+     * Default error response
+     */
+    default: Status;
+};
+
+export type SelfServiceListMyBriefingsError = SelfServiceListMyBriefingsErrors[keyof SelfServiceListMyBriefingsErrors];
+
+export type SelfServiceListMyBriefingsResponses = {
+    /**
+     * OK
+     */
+    200: ListBriefingsResponseReadable;
+};
+
+export type SelfServiceListMyBriefingsResponse = SelfServiceListMyBriefingsResponses[keyof SelfServiceListMyBriefingsResponses];
+
+export type SelfServiceListMyCardsData = {
+    body?: never;
+    path?: never;
+    query?: {
+        page_size?: number;
+        page_token?: string;
+    };
+    url: '/v1/me/cards';
+};
+
+export type SelfServiceListMyCardsErrors = {
+    /**
+     * Default error response
+     */
+    default: Status;
+};
+
+export type SelfServiceListMyCardsError = SelfServiceListMyCardsErrors[keyof SelfServiceListMyCardsErrors];
+
+export type SelfServiceListMyCardsResponses = {
+    /**
+     * OK
+     */
+    200: ListCardsResponseReadable;
+};
+
+export type SelfServiceListMyCardsResponse = SelfServiceListMyCardsResponses[keyof SelfServiceListMyCardsResponses];
+
+export type SelfServiceListMyChangeRequestsData = {
+    body?: never;
+    path?: never;
+    query?: {
+        page_size?: number;
+        page_token?: string;
+    };
+    url: '/v1/me/change-requests';
+};
+
+export type SelfServiceListMyChangeRequestsErrors = {
+    /**
+     * Default error response
+     */
+    default: Status;
+};
+
+export type SelfServiceListMyChangeRequestsError = SelfServiceListMyChangeRequestsErrors[keyof SelfServiceListMyChangeRequestsErrors];
+
+export type SelfServiceListMyChangeRequestsResponses = {
+    /**
+     * OK
+     */
+    200: ListMemberChangeRequestsResponseReadable;
+};
+
+export type SelfServiceListMyChangeRequestsResponse = SelfServiceListMyChangeRequestsResponses[keyof SelfServiceListMyChangeRequestsResponses];
+
+export type SelfServiceCreateMyChangeRequestData = {
+    body: CreateMyChangeRequestRequestWritable;
+    path?: never;
+    query?: never;
+    url: '/v1/me/change-requests';
+};
+
+export type SelfServiceCreateMyChangeRequestErrors = {
+    /**
+     * Default error response
+     */
+    default: Status;
+};
+
+export type SelfServiceCreateMyChangeRequestError = SelfServiceCreateMyChangeRequestErrors[keyof SelfServiceCreateMyChangeRequestErrors];
+
+export type SelfServiceCreateMyChangeRequestResponses = {
+    /**
+     * OK
+     */
+    200: MemberChangeRequestReadable;
+};
+
+export type SelfServiceCreateMyChangeRequestResponse = SelfServiceCreateMyChangeRequestResponses[keyof SelfServiceCreateMyChangeRequestResponses];
+
+export type SelfServiceListMyPresencesData = {
+    body?: never;
+    path?: never;
+    query?: {
+        page_size?: number;
+        page_token?: string;
+    };
+    url: '/v1/me/presences';
+};
+
+export type SelfServiceListMyPresencesErrors = {
+    /**
+     * Default error response
+     */
+    default: Status;
+};
+
+export type SelfServiceListMyPresencesError = SelfServiceListMyPresencesErrors[keyof SelfServiceListMyPresencesErrors];
+
+export type SelfServiceListMyPresencesResponses = {
+    /**
+     * OK
+     */
+    200: ListPresencesResponseReadable;
+};
+
+export type SelfServiceListMyPresencesResponse = SelfServiceListMyPresencesResponses[keyof SelfServiceListMyPresencesResponses];
+
+export type MemberServiceListMemberAttributesData = {
+    body?: never;
+    path?: never;
+    query?: {
+        page_size?: number;
+        page_token?: string;
+        sort_by?: 'MEMBER_ATTRIBUTE_FIELD_UNKNOWN' | 'MEMBER_ATTRIBUTE_FIELD_ID' | 'MEMBER_ATTRIBUTE_FIELD_TECHNICAL_NAME' | 'MEMBER_ATTRIBUTE_FIELD_DISPLAY_NAME' | 'MEMBER_ATTRIBUTE_FIELD_TYPE';
+        sort_direction?: 'SORT_DIRECTION_DEFAULT' | 'SORT_DIRECTION_ASCENDING' | 'SORT_DIRECTION_DESCENDING';
+    };
+    url: '/v1/member-attributes';
+};
+
+export type MemberServiceListMemberAttributesErrors = {
+    /**
+     * Default error response
+     */
+    default: Status;
+};
+
+export type MemberServiceListMemberAttributesError = MemberServiceListMemberAttributesErrors[keyof MemberServiceListMemberAttributesErrors];
+
+export type MemberServiceListMemberAttributesResponses = {
+    /**
+     * OK
+     */
+    200: ListMemberAttributesResponse;
+};
+
+export type MemberServiceListMemberAttributesResponse = MemberServiceListMemberAttributesResponses[keyof MemberServiceListMemberAttributesResponses];
+
+export type MemberServiceCreateMemberAttributeData = {
+    body: MemberAttribute;
+    path?: never;
+    query?: never;
+    url: '/v1/member-attributes';
+};
+
+export type MemberServiceCreateMemberAttributeErrors = {
+    /**
+     * Default error response
+     */
+    default: Status;
+};
+
+export type MemberServiceCreateMemberAttributeError = MemberServiceCreateMemberAttributeErrors[keyof MemberServiceCreateMemberAttributeErrors];
+
+export type MemberServiceCreateMemberAttributeResponses = {
+    /**
+     * OK
+     */
+    200: MemberAttribute;
+};
+
+export type MemberServiceCreateMemberAttributeResponse = MemberServiceCreateMemberAttributeResponses[keyof MemberServiceCreateMemberAttributeResponses];
+
+export type MemberServiceUpdateMemberAttributeData = {
+    body: MemberAttribute;
+    path: {
+        'attribute.id': string;
+    };
+    query?: {
+        field_mask?: string;
+    };
+    url: '/v1/member-attributes/{attribute.id}';
+};
+
+export type MemberServiceUpdateMemberAttributeErrors = {
+    /**
+     * Default error response
+     */
+    default: Status;
+};
+
+export type MemberServiceUpdateMemberAttributeError = MemberServiceUpdateMemberAttributeErrors[keyof MemberServiceUpdateMemberAttributeErrors];
+
+export type MemberServiceUpdateMemberAttributeResponses = {
+    /**
+     * OK
+     */
+    200: MemberAttribute;
+};
+
+export type MemberServiceUpdateMemberAttributeResponse = MemberServiceUpdateMemberAttributeResponses[keyof MemberServiceUpdateMemberAttributeResponses];
+
+export type MemberServiceDeleteMemberAttributeData = {
+    body?: never;
+    path: {
+        id: string;
+    };
+    query?: {
+        /**
+         * purge_values removes the values of the attribute from all members. Otherwise the values are kept and reappear if
+         * an attribute with the same technical name is created again.
+         */
+        purge_values?: boolean;
+    };
+    url: '/v1/member-attributes/{id}';
+};
+
+export type MemberServiceDeleteMemberAttributeErrors = {
+    /**
+     * Default error response
+     */
+    default: Status;
+};
+
+export type MemberServiceDeleteMemberAttributeError = MemberServiceDeleteMemberAttributeErrors[keyof MemberServiceDeleteMemberAttributeErrors];
+
+export type MemberServiceDeleteMemberAttributeResponses = {
+    /**
+     * OK
+     */
+    200: unknown;
+};
+
+export type MemberServiceGetMemberAttributeData = {
+    body?: never;
+    path: {
+        id: string;
+    };
+    query?: never;
+    url: '/v1/member-attributes/{id}';
+};
+
+export type MemberServiceGetMemberAttributeErrors = {
+    /**
+     * Default error response
+     */
+    default: Status;
+};
+
+export type MemberServiceGetMemberAttributeError = MemberServiceGetMemberAttributeErrors[keyof MemberServiceGetMemberAttributeErrors];
+
+export type MemberServiceGetMemberAttributeResponses = {
+    /**
+     * OK
+     */
+    200: MemberAttribute;
+};
+
+export type MemberServiceGetMemberAttributeResponse = MemberServiceGetMemberAttributeResponses[keyof MemberServiceGetMemberAttributeResponses];
+
+export type MemberServiceMigrateMemberAttributeData = {
+    body: MigrateMemberAttributeRequest;
+    path: {
+        id: string;
+    };
+    query?: never;
+    url: '/v1/member-attributes/{id}:migrate';
+};
+
+export type MemberServiceMigrateMemberAttributeErrors = {
+    /**
+     * Default error response
+     */
+    default: Status;
+};
+
+export type MemberServiceMigrateMemberAttributeError = MemberServiceMigrateMemberAttributeErrors[keyof MemberServiceMigrateMemberAttributeErrors];
+
+export type MemberServiceMigrateMemberAttributeResponses = {
+    /**
+     * OK
+     */
+    200: MigrateMemberAttributeResponse;
+};
+
+export type MemberServiceMigrateMemberAttributeResponse = MemberServiceMigrateMemberAttributeResponses[keyof MemberServiceMigrateMemberAttributeResponses];
+
+export type MemberServiceListMemberChangeRequestsData = {
+    body?: never;
+    path?: never;
+    query?: {
+        page_size?: number;
+        page_token?: string;
+        /**
+         * state defaults to pending requests, STATE_UNKNOWN can't be used to list all states.
+         */
+        state?: 'STATE_UNKNOWN' | 'STATE_PENDING' | 'STATE_APPROVED' | 'STATE_REJECTED';
+        member_id?: string;
+    };
+    url: '/v1/member-change-requests';
+};
+
+export type MemberServiceListMemberChangeRequestsErrors = {
+    /**
+     * Default error response
+     */
+    default: Status;
+};
+
+export type MemberServiceListMemberChangeRequestsError = MemberServiceListMemberChangeRequestsErrors[keyof MemberServiceListMemberChangeRequestsErrors];
+
+export type MemberServiceListMemberChangeRequestsResponses = {
+    /**
+     * OK
+     */
+    200: ListMemberChangeRequestsResponseReadable;
+};
+
+export type MemberServiceListMemberChangeRequestsResponse = MemberServiceListMemberChangeRequestsResponses[keyof MemberServiceListMemberChangeRequestsResponses];
+
+export type MemberServiceApproveMemberChangeRequestData = {
+    body: DecideMemberChangeRequestRequest;
+    path: {
+        id: string;
+    };
+    query?: never;
+    url: '/v1/member-change-requests/{id}:approve';
+};
+
+export type MemberServiceApproveMemberChangeRequestErrors = {
+    /**
+     * Default error response
+     */
+    default: Status;
+};
+
+export type MemberServiceApproveMemberChangeRequestError = MemberServiceApproveMemberChangeRequestErrors[keyof MemberServiceApproveMemberChangeRequestErrors];
+
+export type MemberServiceApproveMemberChangeRequestResponses = {
+    /**
+     * OK
+     */
+    200: MemberChangeRequestReadable;
+};
+
+export type MemberServiceApproveMemberChangeRequestResponse = MemberServiceApproveMemberChangeRequestResponses[keyof MemberServiceApproveMemberChangeRequestResponses];
+
+export type MemberServiceRejectMemberChangeRequestData = {
+    body: DecideMemberChangeRequestRequest;
+    path: {
+        id: string;
+    };
+    query?: never;
+    url: '/v1/member-change-requests/{id}:reject';
+};
+
+export type MemberServiceRejectMemberChangeRequestErrors = {
+    /**
+     * Default error response
+     */
+    default: Status;
+};
+
+export type MemberServiceRejectMemberChangeRequestError = MemberServiceRejectMemberChangeRequestErrors[keyof MemberServiceRejectMemberChangeRequestErrors];
+
+export type MemberServiceRejectMemberChangeRequestResponses = {
+    /**
+     * OK
+     */
+    200: MemberChangeRequestReadable;
+};
+
+export type MemberServiceRejectMemberChangeRequestResponse = MemberServiceRejectMemberChangeRequestResponses[keyof MemberServiceRejectMemberChangeRequestResponses];
+
+export type MemberServiceListMemberTagsData = {
+    body?: never;
+    path?: never;
+    query?: {
+        page_size?: number;
+        page_token?: string;
+    };
+    url: '/v1/member-tags';
+};
+
+export type MemberServiceListMemberTagsErrors = {
+    /**
+     * Default error response
+     */
+    default: Status;
+};
+
+export type MemberServiceListMemberTagsError = MemberServiceListMemberTagsErrors[keyof MemberServiceListMemberTagsErrors];
+
+export type MemberServiceListMemberTagsResponses = {
+    /**
+     * OK
+     */
+    200: ListMemberTagsResponseReadable;
+};
+
+export type MemberServiceListMemberTagsResponse = MemberServiceListMemberTagsResponses[keyof MemberServiceListMemberTagsResponses];
+
+export type MemberServiceCreateMemberTagData = {
+    body: MemberTagWritable;
+    path?: never;
+    query?: never;
+    url: '/v1/member-tags';
+};
+
+export type MemberServiceCreateMemberTagErrors = {
+    /**
+     * Default error response
+     */
+    default: Status;
+};
+
+export type MemberServiceCreateMemberTagError = MemberServiceCreateMemberTagErrors[keyof MemberServiceCreateMemberTagErrors];
+
+export type MemberServiceCreateMemberTagResponses = {
+    /**
+     * OK
+     */
+    200: MemberTagReadable;
+};
+
+export type MemberServiceCreateMemberTagResponse = MemberServiceCreateMemberTagResponses[keyof MemberServiceCreateMemberTagResponses];
+
+export type MemberServiceDeleteMemberTagData = {
+    body?: never;
+    path: {
+        name: string;
+    };
+    query?: {
+        /**
+         * remove_from_members removes the tag from all members as well, otherwise it stays on them without definition.
+         */
+        remove_from_members?: boolean;
+    };
+    url: '/v1/member-tags/{name}';
+};
+
+export type MemberServiceDeleteMemberTagErrors = {
+    /**
+     * Default error response
+     */
+    default: Status;
+};
+
+export type MemberServiceDeleteMemberTagError = MemberServiceDeleteMemberTagErrors[keyof MemberServiceDeleteMemberTagErrors];
+
+export type MemberServiceDeleteMemberTagResponses = {
+    /**
+     * OK
+     */
+    200: unknown;
+};
+
+export type MemberServiceMergeMemberTagsData = {
+    body: MergeMemberTagsRequest;
+    path: {
+        /**
+         * name is the tag that replaces the source tags, it keeps its definition. If it has none, it takes the definition
+         * of the first source tag that has one.
+         */
+        name: string;
+    };
+    query?: never;
+    url: '/v1/member-tags/{name}:merge';
+};
+
+export type MemberServiceMergeMemberTagsErrors = {
+    /**
+     * Default error response
+     */
+    default: Status;
+};
+
+export type MemberServiceMergeMemberTagsError = MemberServiceMergeMemberTagsErrors[keyof MemberServiceMergeMemberTagsErrors];
+
+export type MemberServiceMergeMemberTagsResponses = {
+    /**
+     * OK
+     */
+    200: MemberTagReadable;
+};
+
+export type MemberServiceMergeMemberTagsResponse = MemberServiceMergeMemberTagsResponses[keyof MemberServiceMergeMemberTagsResponses];
+
+export type MemberServiceRenameMemberTagData = {
+    body: RenameMemberTagRequest;
+    path: {
+        name: string;
+    };
+    query?: never;
+    url: '/v1/member-tags/{name}:rename';
+};
+
+export type MemberServiceRenameMemberTagErrors = {
+    /**
+     * Default error response
+     */
+    default: Status;
+};
+
+export type MemberServiceRenameMemberTagError = MemberServiceRenameMemberTagErrors[keyof MemberServiceRenameMemberTagErrors];
+
+export type MemberServiceRenameMemberTagResponses = {
+    /**
+     * OK
+     */
+    200: MemberTagReadable;
+};
+
+export type MemberServiceRenameMemberTagResponse = MemberServiceRenameMemberTagResponses[keyof MemberServiceRenameMemberTagResponses];
+
+export type MemberServiceUpdateMemberTagData = {
+    body: MemberTagWritable;
+    path: {
+        'tag.name': string;
+    };
+    query?: {
+        field_mask?: string;
+    };
+    url: '/v1/member-tags/{tag.name}';
+};
+
+export type MemberServiceUpdateMemberTagErrors = {
+    /**
+     * Default error response
+     */
+    default: Status;
+};
+
+export type MemberServiceUpdateMemberTagError = MemberServiceUpdateMemberTagErrors[keyof MemberServiceUpdateMemberTagErrors];
+
+export type MemberServiceUpdateMemberTagResponses = {
+    /**
+     * OK
+     */
+    200: MemberTagReadable;
+};
+
+export type MemberServiceUpdateMemberTagResponse = MemberServiceUpdateMemberTagResponses[keyof MemberServiceUpdateMemberTagResponses];
+
+export type MemberServiceListMembersData = {
+    body?: never;
+    path?: never;
+    query?: {
+        page_size?: number;
+        page_token?: string;
+        sort_by?: 'MEMBER_FIELD_UNKNOWN' | 'MEMBER_FIELD_ID' | 'MEMBER_FIELD_NAME' | 'MEMBER_FIELD_MEMBERSHIP_START' | 'MEMBER_FIELD_MEMBERSHIP_END';
+        sort_direction?: 'SORT_DIRECTION_DEFAULT' | 'SORT_DIRECTION_ASCENDING' | 'SORT_DIRECTION_DESCENDING';
+        name_contains?: string;
+        membership_start_after?: string;
+        membership_start_before?: string;
+        membership_end_after?: string;
+        membership_end_before?: string;
+        age_category_equals?: 'AGE_CATEGORY_UNKNOWN' | 'AGE_CATEGORY_UNDERAGE' | 'AGE_CATEGORY_ADULT';
+        tag_contains?: Array<string>;
+        /**
+         * sort_by_attribute sorts by the additional attribute with this technical name instead of sort_by. Members without
+         * a value come first in ascending order.
+         */
+        sort_by_attribute?: string;
+    };
+    url: '/v1/members';
+};
+
+export type MemberServiceListMembersErrors = {
+    /**
+     * Default error response
+     */
+    default: Status;
+};
+
+export type MemberServiceListMembersError = MemberServiceListMembersErrors[keyof MemberServiceListMembersErrors];
+
+export type MemberServiceListMembersResponses = {
+    /**
+     * OK
+     */
+    200: ListMembersResponseReadable;
+};
+
+export type MemberServiceListMembersResponse = MemberServiceListMembersResponses[keyof MemberServiceListMembersResponses];
+
+export type MemberServiceCreateMemberData = {
+    body: MemberWritable;
+    path?: never;
+    query?: {
+        member_id?: string;
+    };
+    url: '/v1/members';
+};
+
+export type MemberServiceCreateMemberErrors = {
+    /**
+     * Default error response
+     */
+    default: Status;
+};
+
+export type MemberServiceCreateMemberError = MemberServiceCreateMemberErrors[keyof MemberServiceCreateMemberErrors];
+
+export type MemberServiceCreateMemberResponses = {
+    /**
+     * OK
+     */
+    200: MemberReadable;
+};
+
+export type MemberServiceCreateMemberResponse = MemberServiceCreateMemberResponses[keyof MemberServiceCreateMemberResponses];
+
+export type MemberServiceCreateMemberConsentData = {
+    body: MemberConsentWritable;
+    path: {
+        'consent.member_id': string;
+    };
+    query?: never;
+    url: '/v1/members/{consent.member_id}/consents';
+};
+
+export type MemberServiceCreateMemberConsentErrors = {
+    /**
+     * Default error response
+     */
+    default: Status;
+};
+
+export type MemberServiceCreateMemberConsentError = MemberServiceCreateMemberConsentErrors[keyof MemberServiceCreateMemberConsentErrors];
+
+export type MemberServiceCreateMemberConsentResponses = {
+    /**
+     * OK
+     */
+    200: MemberConsentReadable;
+};
+
+export type MemberServiceCreateMemberConsentResponse = MemberServiceCreateMemberConsentResponses[keyof MemberServiceCreateMemberConsentResponses];
+
+export type MemberServiceDeleteMemberData = {
+    body?: never;
+    path: {
+        id: string;
+    };
+    query?: never;
+    url: '/v1/members/{id}';
+};
+
+export type MemberServiceDeleteMemberErrors = {
+    /**
+     * Default error response
+     */
+    default: Status;
+};
+
+export type MemberServiceDeleteMemberError = MemberServiceDeleteMemberErrors[keyof MemberServiceDeleteMemberErrors];
+
+export type MemberServiceDeleteMemberResponses = {
+    /**
+     * OK
+     */
+    200: unknown;
+};
+
+export type MemberServiceGetMemberData = {
+    body?: never;
+    path: {
+        id: string;
+    };
+    query?: never;
+    url: '/v1/members/{id}';
+};
+
+export type MemberServiceGetMemberErrors = {
     /**
      * Default error response
      */
     default: Status;
 };
 
-export type AuthServiceLogoutError = AuthServiceLogoutErrors[keyof AuthServiceLogoutErrors];
+export type MemberServiceGetMemberError = MemberServiceGetMemberErrors[keyof MemberServiceGetMemberErrors];
 
-export type AuthServiceLogoutResponses = {
+export type MemberServiceGetMemberResponses = {
     /**
      * OK
      */
-    200: LogoutResponse;
+    200: MemberReadable;
 };
 
-export type AuthServiceLogoutResponse = AuthServiceLogoutResponses[keyof AuthServiceLogoutResponses];
+export type MemberServiceGetMemberResponse = MemberServiceGetMemberResponses[keyof MemberServiceGetMemberResponses];
 
-export type AuthServiceRefreshData = {
-    body: RefreshRequest;
-    path?: never;
-    query?: never;
-    url: '/v1/auth/refresh';
+export type MemberServiceUpdateMemberData = {
+    body: MemberWritable;
+    path: {
+        'member.id': string;
+    };
+    query?: {
+        field_mask?: string;
+    };
+    url: '/v1/members/{member.id}';
 };
 
-export type AuthServiceRefreshErrors = {
+export type MemberServiceUpdateMemberErrors = {
     /**
      * Default error response
      */
     default: Status;
 };
 
-export type AuthServiceRefreshError = AuthServiceRefreshErrors[keyof AuthServiceRefreshErrors];
+export type MemberServiceUpdateMemberError = MemberServiceUpdateMemberErrors[keyof MemberServiceUpdateMemberErrors];
 
-export type AuthServiceRefreshResponses = {
+export type MemberServiceUpdateMemberResponses = {
     /**
      * OK
      */
-    200: RefreshResponse;
+    200: MemberReadable;
 };
 
-export type AuthServiceRefreshResponse = AuthServiceRefreshResponses[keyof AuthServiceRefreshResponses];
+export type MemberServiceUpdateMemberResponse = MemberServiceUpdateMemberResponses[keyof MemberServiceUpdateMemberResponses];
 
-export type BriefingServiceListBriefingTypesData = {
+export type MemberServiceListMemberConsentsData = {
     body?: never;
-    path?: never;
-    query?: {
-        page_size?: number;
-        page_token?: string;
+    path: {
+        member_id: string;
     };
-    url: '/v1/briefing-types';
+    query?: never;
+    url: '/v1/members/{member_id}/consents';
 };
 
-export type BriefingServiceListBriefingTypesErrors = {
+export type MemberServiceListMemberConsentsErrors = {
     /**
      * Default error response
      */
     default: Status;
 };
 
-export type BriefingServiceListBriefingTypesError = BriefingServiceListBriefingTypesErrors[keyof BriefingServiceListBriefingTypesErrors];
+export type MemberServiceListMemberConsentsError = MemberServiceListMemberConsentsErrors[keyof MemberServiceListMemberConsentsErrors];
 
-export type BriefingServiceListBriefingTypesResponses = {
+export type MemberServiceListMemberConsentsResponses = {
     /**
      * OK
      */
-    200: ListBriefingTypesResponse;
+    200: ListMemberConsentsResponseReadable;
 };
 
-export type BriefingServiceListBriefingTypesResponse = BriefingServiceListBriefingTypesResponses[keyof BriefingServiceListBriefingTypesResponses];
+export type MemberServiceListMemberConsentsResponse = MemberServiceListMemberConsentsResponses[keyof MemberServiceListMemberConsentsResponses];
 
-export type BriefingServiceCreateBriefingTypeData = {
-    body: BriefingType;
-    path?: never;
-    query?: {
-        briefing_type_id?: string;
+export type MemberServiceRevokeMemberConsentData = {
+    body: RevokeMemberConsentRequest;
+    path: {
+        member_id: string;
+        id: string;
     };
-    url: '/v1/briefing-types';
+    query?: never;
+    url: '/v1/members/{member_id}/consents/{id}:revoke';
 };
 
-export type BriefingServiceCreateBriefingTypeErrors = {
+export type MemberServiceRevokeMemberConsentErrors = {
     /**
      * Default error response
      */
     default: Status;
 };
 
-export type BriefingServiceCreateBriefingTypeError = BriefingServiceCreateBriefingTypeErrors[keyof BriefingServiceCreateBriefingTypeErrors];
+export type MemberServiceRevokeMemberConsentError = MemberServiceRevokeMemberConsentErrors[keyof MemberServiceRevokeMemberConsentErrors];
 
-export type BriefingServiceCreateBriefingTypeResponses = {
+export type MemberServiceRevokeMemberConsentResponses = {
     /**
      * OK
      */
-    200: BriefingType;
+    200: MemberConsentReadable;
 };
 
-export type BriefingServiceCreateBriefingTypeResponse = BriefingServiceCreateBriefingTypeResponses[keyof BriefingServiceCreateBriefingTypeResponses];
+export type MemberServiceRevokeMemberConsentResponse = MemberServiceRevokeMemberConsentResponses[keyof MemberServiceRevokeMemberConsentResponses];
 
-export type BriefingServiceUpdateBriefingTypeData = {
-    body: BriefingType;
+export type MemberNoteServiceListMemberNotesData = {
+    body?: never;
     path: {
-        'briefing_type.id': string;
+        member_id: string;
     };
     query?: {
-        field_mask?: string;
+        page_size?: number;
+        page_token?: string;
+        pinned_only?: boolean;
     };
-    url: '/v1/briefing-types/{briefing_type.id}';
+    url: '/v1/members/{member_id}/notes';
 };
 
-export type BriefingServiceUpdateBriefingTypeErrors = {
+export type MemberNoteServiceListMemberNotesErrors = {
     /**
      * Default error response
      */
     default: Status;
 };
 
-export type BriefingServiceUpdateBriefingTypeError = BriefingServiceUpdateBriefingTypeErrors[keyof BriefingServiceUpdateBriefingTypeErrors];
+export type MemberNoteServiceListMemberNotesError = MemberNoteServiceListMemberNotesErrors[keyof MemberNoteServiceListMemberNotesErrors];
 
-export type BriefingServiceUpdateBriefingTypeResponses = {
+export type MemberNoteServiceListMemberNotesResponses = {
     /**
      * OK
      */
-    200: BriefingType;
+    200: ListMemberNotesResponseReadable;
 };
 
-export type BriefingServiceUpdateBriefingTypeResponse = BriefingServiceUpdateBriefingTypeResponses[keyof BriefingServiceUpdateBriefingTypeResponses];
+export type MemberNoteServiceListMemberNotesResponse = MemberNoteServiceListMemberNotesResponses[keyof MemberNoteServiceListMemberNotesResponses];
 
-export type BriefingServiceDeleteBriefingTypeData = {
+export type MemberNoteServiceDeleteMemberNoteData = {
     body?: never;
     path: {
+        member_id: string;
         id: string;
     };
     query?: never;
-    url: '/v1/briefing-types/{id}';
+    url: '/v1/members/{member_id}/notes/{id}';
 };
 
-export type BriefingServiceDeleteBriefingTypeErrors = {
+export type MemberNoteServiceDeleteMemberNoteErrors = {
     /**
      * Default error response
      */
     default: Status;
 };
 
-export type BriefingServiceDeleteBriefingTypeError = BriefingServiceDeleteBriefingTypeErrors[keyof BriefingServiceDeleteBriefingTypeErrors];
+export type MemberNoteServiceDeleteMemberNoteError = MemberNoteServiceDeleteMemberNoteErrors[keyof MemberNoteServiceDeleteMemberNoteErrors];
 
-export type BriefingServiceDeleteBriefingTypeResponses = {
+export type MemberNoteServiceDeleteMemberNoteResponses = {
     /**
      * OK
      */
     200: unknown;
 };
 
-export type BriefingServiceGetBriefingTypeData = {
+export type MemberServiceListMemberRelationshipsData = {
     body?: never;
     path: {
-        id: string;
+        member_id: string;
     };
     query?: never;
-    url: '/v1/briefing-types/{id}';
+    url: '/v1/members/{member_id}/relationships';
 };
 
-export type BriefingServiceGetBriefingTypeErrors = {
+export type MemberServiceListMemberRelationshipsErrors = {
     /**
      * Default error response
      */
     default: Status;
 };
 
-export type BriefingServiceGetBriefingTypeError = BriefingServiceGetBriefingTypeErrors[keyof BriefingServiceGetBriefingTypeErrors];
+export type MemberServiceListMemberRelationshipsError = MemberServiceListMemberRelationshipsErrors[keyof MemberServiceListMemberRelationshipsErrors];
 
-export type BriefingServiceGetBriefingTypeResponses = {
+export type MemberServiceListMemberRelationshipsResponses = {
     /**
      * OK
      */
-    200: BriefingType;
+    200: ListMemberRelationshipsResponseReadable;
 };
 
-export type BriefingServiceGetBriefingTypeResponse = BriefingServiceGetBriefingTypeResponses[keyof BriefingServiceGetBriefingTypeResponses];
+export type MemberServiceListMemberRelationshipsResponse = MemberServiceListMemberRelationshipsResponses[keyof MemberServiceListMemberRelationshipsResponses];
 
-export type BriefingServiceListBriefingsData = {
+export type MemberServiceDeleteMemberRelationshipData = {
     body?: never;
-    path?: never;
-    query?: {
-        page_size?: number;
-        page_token?: string;
+    path: {
+        member_id: string;
+        id: string;
     };
-    url: '/v1/briefings';
+    query?: never;
+    url: '/v1/members/{member_id}/relationships/{id}';
 };
 
-export type BriefingServiceListBriefingsErrors = {
+export type MemberServiceDeleteMemberRelationshipErrors = {
     /**
      * Default error response
      */
     default: Status;
 };
 
-export type BriefingServiceListBriefingsError = BriefingServiceListBriefingsErrors[keyof BriefingServiceListBriefingsErrors];
+export type MemberServiceDeleteMemberRelationshipError = MemberServiceDeleteMemberRelationshipErrors[keyof MemberServiceDeleteMemberRelationshipErrors];
 
-export type BriefingServiceListBriefingsResponses = {
+export type MemberServiceDeleteMemberRelationshipResponses = {
     /**
      * OK
      */
-    200: ListBriefingsResponse;
+    200: unknown;
 };
 
-export type BriefingServiceListBriefingsResponse = BriefingServiceListBriefingsResponses[keyof BriefingServiceListBriefingsResponses];
-
-export type BriefingServiceCreateBriefingData = {
-    body: Briefing;
-    path?: never;
-    query?: {
-        briefing_id?: string;
+export type FeeServiceDeleteSepaMandateData = {
+    body?: never;
+    path: {
+        member_id: string;
     };
-    url: '/v1/briefings';
+    query?: never;
+    url: '/v1/members/{member_id}/sepa-mandate';
 };
 
-export type BriefingServiceCreateBriefingErrors = {
+export type FeeServiceDeleteSepaMandateErrors = {
     /**
      * Default error response
      */
     default: Status;
 };
 
-export type BriefingServiceCreateBriefingError = BriefingServiceCreateBriefingErrors[keyof BriefingServiceCreateBriefingErrors];
+export type FeeServiceDeleteSepaMandateError = FeeServiceDeleteSepaMandateErrors[keyof FeeServiceDeleteSepaMandateErrors];
 
-export type BriefingServiceCreateBriefingResponses = {
+export type FeeServiceDeleteSepaMandateResponses = {
     /**
      * OK
      */
-    200: Briefing;
+    200: unknown;
 };
 
-export type BriefingServiceCreateBriefingResponse = BriefingServiceCreateBriefingResponses[keyof BriefingServiceCreateBriefingResponses];
-
-export type BriefingServiceUpdateBriefingData = {
-    body: Briefing;
+export type FeeServiceGetSepaMandateData = {
+    body?: never;
     path: {
-        'briefing.id': string;
-    };
-    query?: {
-        field_mask?: string;
+        member_id: string;
     };
-    url: '/v1/briefings/{briefing.id}';
+    query?: never;
+    url: '/v1/members/{member_id}/sepa-mandate';
 };
 
-export type BriefingServiceUpdateBriefingErrors = {
+export type FeeServiceGetSepaMandateErrors = {
     /**
      * Default error response
      */
     default: Status;
 };
 
-export type BriefingServiceUpdateBriefingError = BriefingServiceUpdateBriefingErrors[keyof BriefingServiceUpdateBriefingErrors];
+export type FeeServiceGetSepaMandateError = FeeServiceGetSepaMandateErrors[keyof FeeServiceGetSepaMandateErrors];
 
-export type BriefingServiceUpdateBriefingResponses = {
+export type FeeServiceGetSepaMandateResponses = {
     /**
      * OK
      */
-    200: Briefing;
+    200: SepaMandate;
 };
 
-export type BriefingServiceUpdateBriefingResponse = BriefingServiceUpdateBriefingResponses[keyof BriefingServiceUpdateBriefingResponses];
+export type FeeServiceGetSepaMandateResponse = FeeServiceGetSepaMandateResponses[keyof FeeServiceGetSepaMandateResponses];
 
-export type BriefingServiceDeleteBriefingData = {
+export type MemberNoteServiceListMemberTimelineData = {
     body?: never;
     path: {
-        id: string;
+        member_id: string;
     };
-    query?: never;
-    url: '/v1/briefings/{id}';
+    query?: {
+        page_size?: number;
+        page_token?: string;
+    };
+    url: '/v1/members/{member_id}/timeline';
 };
 
-export type BriefingServiceDeleteBriefingErrors = {
+export type MemberNoteServiceListMemberTimelineErrors = {
     /**
      * Default error response
      */
     default: Status;
 };
 
-export type BriefingServiceDeleteBriefingError = BriefingServiceDeleteBriefingErrors[keyof BriefingServiceDeleteBriefingErrors];
+export type MemberNoteServiceListMemberTimelineError = MemberNoteServiceListMemberTimelineErrors[keyof MemberNoteServiceListMemberTimelineErrors];
 
-export type BriefingServiceDeleteBriefingResponses = {
+export type MemberNoteServiceListMemberTimelineResponses = {
     /**
      * OK
      */
-    200: unknown;
+    200: ListMemberTimelineResponseReadable;
 };
 
-export type BriefingServiceGetBriefingData = {
-    body?: never;
+export type MemberNoteServiceListMemberTimelineResponse = MemberNoteServiceListMemberTimelineResponses[keyof MemberNoteServiceListMemberTimelineResponses];
+
+export type MemberNoteServiceCreateMemberNoteData = {
+    body: MemberNoteWritable;
     path: {
-        id: string;
+        'note.member_id': string;
     };
     query?: never;
-    url: '/v1/briefings/{id}';
+    url: '/v1/members/{note.member_id}/notes';
 };
 
-export type BriefingServiceGetBriefingErrors = {
+export type MemberNoteServiceCreateMemberNoteErrors = {
     /**
      * Default error response
      */
     default: Status;
 };
 
-export type BriefingServiceGetBriefingError = BriefingServiceGetBriefingErrors[keyof BriefingServiceGetBriefingErrors];
+export type MemberNoteServiceCreateMemberNoteError = MemberNoteServiceCreateMemberNoteErrors[keyof MemberNoteServiceCreateMemberNoteErrors];
 
-export type BriefingServiceGetBriefingResponses = {
+export type MemberNoteServiceCreateMemberNoteResponses = {
     /**
      * OK
      */
-    200: Briefing;
+    200: MemberNoteReadable;
 };
 
-export type BriefingServiceGetBriefingResponse = BriefingServiceGetBriefingResponses[keyof BriefingServiceGetBriefingResponses];
+export type MemberNoteServiceCreateMemberNoteResponse = MemberNoteServiceCreateMemberNoteResponses[keyof MemberNoteServiceCreateMemberNoteResponses];
 
-export type CardServiceListCardsData = {
-    body?: never;
-    path?: never;
+export type MemberNoteServiceUpdateMemberNoteData = {
+    body: MemberNoteWritable;
+    path: {
+        'note.member_id': string;
+        'note.id': string;
+    };
     query?: {
-        page_size?: number;
-        page_token?: string;
-        sort_by?: 'CARD_FIELD_UNKNOWN' | 'CARD_FIELD_ID' | 'CARD_FIELD_MEMBER_ID' | 'CARD_FIELD_VALID_FROM' | 'CARD_FIELD_VALID_TO';
-        sort_direction?: 'SORT_DIRECTION_DEFAULT' | 'SORT_DIRECTION_ASCENDING' | 'SORT_DIRECTION_DESCENDING';
-        member_id?: string;
-        valid_on?: string;
-        rfid_value?: string;
+        field_mask?: string;
     };
-    url: '/v1/cards';
+    url: '/v1/members/{note.member_id}/notes/{note.id}';
 };
 
-export type CardServiceListCardsErrors = {
+export type MemberNoteServiceUpdateMemberNoteErrors = {
     /**
      * Default error response
      */
     default: Status;
 };
 
-export type CardServiceListCardsError = CardServiceListCardsErrors[keyof CardServiceListCardsErrors];
+export type MemberNoteServiceUpdateMemberNoteError = MemberNoteServiceUpdateMemberNoteErrors[keyof MemberNoteServiceUpdateMemberNoteErrors];
 
-export type CardServiceListCardsResponses = {
+export type MemberNoteServiceUpdateMemberNoteResponses = {
     /**
      * OK
      */
-    200: ListCardsResponseReadable;
+    200: MemberNoteReadable;
 };
 
-export type CardServiceListCardsResponse = CardServiceListCardsResponses[keyof CardServiceListCardsResponses];
+export type MemberNoteServiceUpdateMemberNoteResponse = MemberNoteServiceUpdateMemberNoteResponses[keyof MemberNoteServiceUpdateMemberNoteResponses];
 
-export type CardServiceCreateCardData = {
-    body: CardWritable;
-    path?: never;
-    query?: {
-        card_id?: string;
+export type MemberServiceCreateMemberRelationshipData = {
+    body: MemberRelationshipWritable;
+    path: {
+        'relationship.member_id': string;
     };
-    url: '/v1/cards';
+    query?: never;
+    url: '/v1/members/{relationship.member_id}/relationships';
 };
 
-export type CardServiceCreateCardErrors = {
+export type MemberServiceCreateMemberRelationshipErrors = {
     /**
      * Default error response
      */
     default: Status;
 };
 
-export type CardServiceCreateCardError = CardServiceCreateCardErrors[keyof CardServiceCreateCardErrors];
+export type MemberServiceCreateMemberRelationshipError = MemberServiceCreateMemberRelationshipErrors[keyof MemberServiceCreateMemberRelationshipErrors];
 
-export type CardServiceCreateCardResponses = {
+export type MemberServiceCreateMemberRelationshipResponses = {
     /**
      * OK
      */
-    200: CardReadable;
+    200: MemberRelationshipReadable;
 };
 
-export type CardServiceCreateCardResponse = CardServiceCreateCardResponses[keyof CardServiceCreateCardResponses];
+export type MemberServiceCreateMemberRelationshipResponse = MemberServiceCreateMemberRelationshipResponses[keyof MemberServiceCreateMemberRelationshipResponses];
 
-export type CardServiceUpdateCardData = {
-    body: CardWritable;
+export type MemberServiceUpdateMemberRelationshipData = {
+    body: MemberRelationshipWritable;
     path: {
-        'card.id': string;
+        'relationship.member_id': string;
+        'relationship.id': string;
     };
     query?: {
         field_mask?: string;
     };
-    url: '/v1/cards/{card.id}';
+    url: '/v1/members/{relationship.member_id}/relationships/{relationship.id}';
 };
 
-export type CardServiceUpdateCardErrors = {
+export type MemberServiceUpdateMemberRelationshipErrors = {
     /**
      * Default error response
      */
     default: Status;
 };
 
-export type CardServiceUpdateCardError = CardServiceUpdateCardErrors[keyof CardServiceUpdateCardErrors];
+export type MemberServiceUpdateMemberRelationshipError = MemberServiceUpdateMemberRelationshipErrors[keyof MemberServiceUpdateMemberRelationshipErrors];
 
-export type CardServiceUpdateCardResponses = {
+export type MemberServiceUpdateMemberRelationshipResponses = {
     /**
      * OK
      */
-    200: CardReadable;
+    200: MemberRelationshipReadable;
 };
 
-export type CardServiceUpdateCardResponse = CardServiceUpdateCardResponses[keyof CardServiceUpdateCardResponses];
+export type MemberServiceUpdateMemberRelationshipResponse = MemberServiceUpdateMemberRelationshipResponses[keyof MemberServiceUpdateMemberRelationshipResponses];
 
-export type CardServiceDeleteCardData = {
-    body?: never;
+export type FeeServiceSetSepaMandateData = {
+    body: SepaMandate;
     path: {
-        id: string;
+        'sepa_mandate.member_id': string;
     };
     query?: never;
-    url: '/v1/cards/{id}';
+    url: '/v1/members/{sepa_mandate.member_id}/sepa-mandate';
 };
 
-export type CardServiceDeleteCardErrors = {
+export type FeeServiceSetSepaMandateErrors = {
     /**
      * Default error response
      */
     default: Status;
 };
 
-export type CardServiceDeleteCardError = CardServiceDeleteCardErrors[keyof CardServiceDeleteCardErrors];
+export type FeeServiceSetSepaMandateError = FeeServiceSetSepaMandateErrors[keyof FeeServiceSetSepaMandateErrors];
 
-export type CardServiceDeleteCardResponses = {
+export type FeeServiceSetSepaMandateResponses = {
     /**
      * OK
      */
-    200: unknown;
+    200: SepaMandate;
 };
 
-export type CardServiceGetCardData = {
+export type FeeServiceSetSepaMandateResponse = FeeServiceSetSepaMandateResponses[keyof FeeServiceSetSepaMandateResponses];
+
+export type MemberServiceExportMembersData = {
     body?: never;
-    path: {
-        id: string;
+    path?: never;
+    query?: {
+        'filter.page_size'?: number;
+        'filter.page_token'?: string;
+        'filter.sort_by'?: 'MEMBER_FIELD_UNKNOWN' | 'MEMBER_FIELD_ID' | 'MEMBER_FIELD_NAME' | 'MEMBER_FIELD_MEMBERSHIP_START' | 'MEMBER_FIELD_MEMBERSHIP_END';
+        'filter.sort_direction'?: 'SORT_DIRECTION_DEFAULT' | 'SORT_DIRECTION_ASCENDING' | 'SORT_DIRECTION_DESCENDING';
+        'filter.name_contains'?: string;
+        'filter.membership_start_after'?: string;
+        'filter.membership_start_before'?: string;
+        'filter.membership_end_after'?: string;
+        'filter.membership_end_before'?: string;
+        'filter.age_category_equals'?: 'AGE_CATEGORY_UNKNOWN' | 'AGE_CATEGORY_UNDERAGE' | 'AGE_CATEGORY_ADULT';
+        'filter.tag_contains'?: Array<string>;
+        /**
+         * sort_by_attribute sorts by the additional attribute with this technical name instead of sort_by. Members without
+         * a value come first in ascending order.
+         */
+        'filter.sort_by_attribute'?: string;
+        format?: 'EXPORT_FORMAT_UNKNOWN' | 'EXPORT_FORMAT_CSV' | 'EXPORT_FORMAT_XLSX';
     };
+    url: '/v1/members:export';
+};
+
+export type MemberServiceExportMembersErrors = {
+    /**
+     * Default error response
+     */
+    default: Status;
+};
+
+export type MemberServiceExportMembersError = MemberServiceExportMembersErrors[keyof MemberServiceExportMembersErrors];
+
+export type MemberServiceExportMembersResponses = {
+    /**
+     * OK
+     */
+    200: unknown;
+};
+
+export type MemberServiceImportMembersData = {
+    body: ImportMembersRequest;
+    path?: never;
     query?: never;
-    url: '/v1/cards/{id}';
+    url: '/v1/members:import';
 };
 
-export type CardServiceGetCardErrors = {
+export type MemberServiceImportMembersErrors = {
     /**
      * Default error response
      */
     default: Status;
 };
 
-export type CardServiceGetCardError = CardServiceGetCardErrors[keyof CardServiceGetCardErrors];
+export type MemberServiceImportMembersError = MemberServiceImportMembersErrors[keyof MemberServiceImportMembersErrors];
 
-export type CardServiceGetCardResponses = {
+export type MemberServiceImportMembersResponses = {
     /**
      * OK
      */
-    200: CardReadable;
+    200: ImportMembersResponse;
 };
 
-export type CardServiceGetCardResponse = CardServiceGetCardResponses[keyof CardServiceGetCardResponses];
+export type MemberServiceImportMembersResponse = MemberServiceImportMembersResponses[keyof MemberServiceImportMembersResponses];
 
-export type MemberServiceListMemberAttributesData = {
+export type MemberServiceSearchMembersData = {
     body?: never;
     path?: never;
     query?: {
+        /**
+         * query is matched against name, username, tags and text additional attributes, ignoring case and accents.
+         */
+        query?: string;
         page_size?: number;
         page_token?: string;
-        sort_by?: 'MEMBER_ATTRIBUTE_FIELD_UNKNOWN' | 'MEMBER_ATTRIBUTE_FIELD_ID' | 'MEMBER_ATTRIBUTE_FIELD_TECHNICAL_NAME' | 'MEMBER_ATTRIBUTE_FIELD_DISPLAY_NAME' | 'MEMBER_ATTRIBUTE_FIELD_TYPE';
-        sort_direction?: 'SORT_DIRECTION_DEFAULT' | 'SORT_DIRECTION_ASCENDING' | 'SORT_DIRECTION_DESCENDING';
     };
-    url: '/v1/member-attributes';
+    url: '/v1/members:search';
 };
 
-export type MemberServiceListMemberAttributesErrors = {
+export type MemberServiceSearchMembersErrors = {
     /**
      * Default error response
      */
     default: Status;
 };
 
-export type MemberServiceListMemberAttributesError = MemberServiceListMemberAttributesErrors[keyof MemberServiceListMemberAttributesErrors];
+export type MemberServiceSearchMembersError = MemberServiceSearchMembersErrors[keyof MemberServiceSearchMembersErrors];
 
-export type MemberServiceListMemberAttributesResponses = {
+export type MemberServiceSearchMembersResponses = {
     /**
      * OK
      */
-    200: ListMemberAttributesResponse;
+    200: SearchMembersResponseReadable;
 };
 
-export type MemberServiceListMemberAttributesResponse = MemberServiceListMemberAttributesResponses[keyof MemberServiceListMemberAttributesResponses];
+export type MemberServiceSearchMembersResponse = MemberServiceSearchMembersResponses[keyof MemberServiceSearchMembersResponses];
 
-export type MemberServiceCreateMemberAttributeData = {
-    body: MemberAttribute;
+export type FeeServiceListMembershipPlansData = {
+    body?: never;
     path?: never;
-    query?: never;
-    url: '/v1/member-attributes';
+    query?: {
+        page_size?: number;
+        page_token?: string;
+    };
+    url: '/v1/membership-plans';
 };
 
-export type MemberServiceCreateMemberAttributeErrors = {
+export type FeeServiceListMembershipPlansErrors = {
     /**
      * Default error response
      */
     default: Status;
 };
 
-export type MemberServiceCreateMemberAttributeError = MemberServiceCreateMemberAttributeErrors[keyof MemberServiceCreateMemberAttributeErrors];
+export type FeeServiceListMembershipPlansError = FeeServiceListMembershipPlansErrors[keyof FeeServiceListMembershipPlansErrors];
 
-export type MemberServiceCreateMemberAttributeResponses = {
+export type FeeServiceListMembershipPlansResponses = {
     /**
      * OK
      */
-    200: MemberAttribute;
+    200: ListMembershipPlansResponseReadable;
 };
 
-export type MemberServiceCreateMemberAttributeResponse = MemberServiceCreateMemberAttributeResponses[keyof MemberServiceCreateMemberAttributeResponses];
+export type FeeServiceListMembershipPlansResponse = FeeServiceListMembershipPlansResponses[keyof FeeServiceListMembershipPlansResponses];
 
-export type MemberServiceUpdateMemberAttributeData = {
-    body: MemberAttribute;
-    path: {
-        'attribute.id': string;
-    };
+export type FeeServiceCreateMembershipPlanData = {
+    body: MembershipPlanWritable;
+    path?: never;
     query?: {
-        field_mask?: string;
+        membership_plan_id?: string;
     };
-    url: '/v1/member-attributes/{attribute.id}';
+    url: '/v1/membership-plans';
 };
 
-export type MemberServiceUpdateMemberAttributeErrors = {
+export type FeeServiceCreateMembershipPlanErrors = {
     /**
      * Default error response
      */
     default: Status;
 };
 
-export type MemberServiceUpdateMemberAttributeError = MemberServiceUpdateMemberAttributeErrors[keyof MemberServiceUpdateMemberAttributeErrors];
+export type FeeServiceCreateMembershipPlanError = FeeServiceCreateMembershipPlanErrors[keyof FeeServiceCreateMembershipPlanErrors];
 
-export type MemberServiceUpdateMemberAttributeResponses = {
+export type FeeServiceCreateMembershipPlanResponses = {
     /**
      * OK
      */
-    200: MemberAttribute;
+    200: MembershipPlanReadable;
 };
 
-export type MemberServiceUpdateMemberAttributeResponse = MemberServiceUpdateMemberAttributeResponses[keyof MemberServiceUpdateMemberAttributeResponses];
+export type FeeServiceCreateMembershipPlanResponse = FeeServiceCreateMembershipPlanResponses[keyof FeeServiceCreateMembershipPlanResponses];
 
-export type MemberServiceDeleteMemberAttributeData = {
+export type FeeServiceDeleteMembershipPlanData = {
     body?: never;
     path: {
         id: string;
     };
     query?: never;
-    url: '/v1/member-attributes/{id}';
+    url: '/v1/membership-plans/{id}';
 };
 
-export type MemberServiceDeleteMemberAttributeErrors = {
+export type FeeServiceDeleteMembershipPlanErrors = {
     /**
      * Default error response
      */
     default: Status;
 };
 
-export type MemberServiceDeleteMemberAttributeError = MemberServiceDeleteMemberAttributeErrors[keyof MemberServiceDeleteMemberAttributeErrors];
+export type FeeServiceDeleteMembershipPlanError = FeeServiceDeleteMembershipPlanErrors[keyof FeeServiceDeleteMembershipPlanErrors];
 
-export type MemberServiceDeleteMemberAttributeResponses = {
+export type FeeServiceDeleteMembershipPlanResponses = {
     /**
      * OK
      */
     200: unknown;
 };
 
-export type MemberServiceGetMemberAttributeData = {
+export type FeeServiceGetMembershipPlanData = {
     body?: never;
     path: {
         id: string;
     };
     query?: never;
-    url: '/v1/member-attributes/{id}';
+    url: '/v1/membership-plans/{id}';
 };
 
-export type MemberServiceGetMemberAttributeErrors = {
+export type FeeServiceGetMembershipPlanErrors = {
     /**
      * Default error response
      */
     default: Status;
 };
 
-export type MemberServiceGetMemberAttributeError = MemberServiceGetMemberAttributeErrors[keyof MemberServiceGetMemberAttributeErrors];
+export type FeeServiceGetMembershipPlanError = FeeServiceGetMembershipPlanErrors[keyof FeeServiceGetMembershipPlanErrors];
 
-export type MemberServiceGetMemberAttributeResponses = {
+export type FeeServiceGetMembershipPlanResponses = {
     /**
      * OK
      */
-    200: MemberAttribute;
+    200: MembershipPlanReadable;
 };
 
-export type MemberServiceGetMemberAttributeResponse = MemberServiceGetMemberAttributeResponses[keyof MemberServiceGetMemberAttributeResponses];
+export type FeeServiceGetMembershipPlanResponse = FeeServiceGetMembershipPlanResponses[keyof FeeServiceGetMembershipPlanResponses];
 
-export type MemberServiceListMemberTagsData = {
-    body?: never;
-    path?: never;
+export type FeeServiceUpdateMembershipPlanData = {
+    body: MembershipPlanWritable;
+    path: {
+        'membership_plan.id': string;
+    };
     query?: {
-        page_size?: number;
-        page_token?: string;
+        field_mask?: string;
     };
-    url: '/v1/member-tags';
+    url: '/v1/membership-plans/{membership_plan.id}';
 };
 
-export type MemberServiceListMemberTagsErrors = {
+export type FeeServiceUpdateMembershipPlanErrors = {
     /**
      * Default error response
      */
     default: Status;
 };
 
-export type MemberServiceListMemberTagsError = MemberServiceListMemberTagsErrors[keyof MemberServiceListMemberTagsErrors];
+export type FeeServiceUpdateMembershipPlanError = FeeServiceUpdateMembershipPlanErrors[keyof FeeServiceUpdateMembershipPlanErrors];
 
-export type MemberServiceListMemberTagsResponses = {
+export type FeeServiceUpdateMembershipPlanResponses = {
     /**
      * OK
      */
-    200: ListMemberTagsResponse;
+    200: MembershipPlanReadable;
 };
 
-export type MemberServiceListMemberTagsResponse = MemberServiceListMemberTagsResponses[keyof MemberServiceListMemberTagsResponses];
+export type FeeServiceUpdateMembershipPlanResponse = FeeServiceUpdateMembershipPlanResponses[keyof FeeServiceUpdateMembershipPlanResponses];
 
-export type MemberServiceListMembersData = {
+export type FeeServiceListPaymentsData = {
     body?: never;
     path?: never;
     query?: {
         page_size?: number;
         page_token?: string;
-        sort_by?: 'MEMBER_FIELD_UNKNOWN' | 'MEMBER_FIELD_ID' | 'MEMBER_FIELD_NAME' | 'MEMBER_FIELD_MEMBERSHIP_START' | 'MEMBER_FIELD_MEMBERSHIP_END';
-        sort_direction?: 'SORT_DIRECTION_DEFAULT' | 'SORT_DIRECTION_ASCENDING' | 'SORT_DIRECTION_DESCENDING';
-        name_contains?: string;
-        membership_start_after?: string;
-        membership_start_before?: string;
-        membership_end_after?: string;
-        membership_end_before?: string;
-        age_category_equals?: 'AGE_CATEGORY_UNKNOWN' | 'AGE_CATEGORY_UNDERAGE' | 'AGE_CATEGORY_ADULT';
-        tag_contains?: Array<string>;
+        member_id?: string;
+        invoice_id?: string;
     };
-    url: '/v1/members';
+    url: '/v1/payments';
 };
 
-export type MemberServiceListMembersErrors = {
+export type FeeServiceListPaymentsErrors = {
     /**
      * Default error response
      */
     default: Status;
 };
 
-export type MemberServiceListMembersError = MemberServiceListMembersErrors[keyof MemberServiceListMembersErrors];
+export type FeeServiceListPaymentsError = FeeServiceListPaymentsErrors[keyof FeeServiceListPaymentsErrors];
 
-export type MemberServiceListMembersResponses = {
+export type FeeServiceListPaymentsResponses = {
     /**
      * OK
      */
-    200: ListMembersResponseReadable;
+    200: ListPaymentsResponseReadable;
 };
 
-export type MemberServiceListMembersResponse = MemberServiceListMembersResponses[keyof MemberServiceListMembersResponses];
+export type FeeServiceListPaymentsResponse = FeeServiceListPaymentsResponses[keyof FeeServiceListPaymentsResponses];
 
-export type MemberServiceCreateMemberData = {
-    body: MemberWritable;
+export type FeeServiceRecordPaymentData = {
+    body: PaymentWritable;
     path?: never;
     query?: {
-        member_id?: string;
+        payment_id?: string;
     };
-    url: '/v1/members';
+    url: '/v1/payments';
 };
 
-export type MemberServiceCreateMemberErrors = {
+export type FeeServiceRecordPaymentErrors = {
     /**
      * Default error response
      */
     default: Status;
 };
 
-export type MemberServiceCreateMemberError = MemberServiceCreateMemberErrors[keyof MemberServiceCreateMemberErrors];
+export type FeeServiceRecordPaymentError = FeeServiceRecordPaymentErrors[keyof FeeServiceRecordPaymentErrors];
 
-export type MemberServiceCreateMemberResponses = {
+export type FeeServiceRecordPaymentResponses = {
     /**
      * OK
      */
-    200: MemberReadable;
+    200: PaymentReadable;
 };
 
-export type MemberServiceCreateMemberResponse = MemberServiceCreateMemberResponses[keyof MemberServiceCreateMemberResponses];
+export type FeeServiceRecordPaymentResponse = FeeServiceRecordPaymentResponses[keyof FeeServiceRecordPaymentResponses];
 
-export type MemberServiceDeleteMemberData = {
+export type FeeServiceDeletePaymentData = {
     body?: never;
     path: {
         id: string;
     };
     query?: never;
-    url: '/v1/members/{id}';
+    url: '/v1/payments/{id}';
 };
 
-export type MemberServiceDeleteMemberErrors = {
+export type FeeServiceDeletePaymentErrors = {
     /**
      * Default error response
      */
     default: Status;
 };
 
-export type MemberServiceDeleteMemberError = MemberServiceDeleteMemberErrors[keyof MemberServiceDeleteMemberErrors];
+export type FeeServiceDeletePaymentError = FeeServiceDeletePaymentErrors[keyof FeeServiceDeletePaymentErrors];
 
-export type MemberServiceDeleteMemberResponses = {
+export type FeeServiceDeletePaymentResponses = {
     /**
      * OK
      */
     200: unknown;
 };
 
-export type MemberServiceGetMemberData = {
+export type FeeServiceListPlanAssignmentsData = {
     body?: never;
-    path: {
-        id: string;
+    path?: never;
+    query?: {
+        page_size?: number;
+        page_token?: string;
+        member_id?: string;
+        plan_id?: string;
     };
+    url: '/v1/plan-assignments';
+};
+
+export type FeeServiceListPlanAssignmentsErrors = {
+    /**
+     * Default error response
+     */
+    default: Status;
+};
+
+export type FeeServiceListPlanAssignmentsError = FeeServiceListPlanAssignmentsErrors[keyof FeeServiceListPlanAssignmentsErrors];
+
+export type FeeServiceListPlanAssignmentsResponses = {
+    /**
+     * OK
+     */
+    200: ListPlanAssignmentsResponseReadable;
+};
+
+export type FeeServiceListPlanAssignmentsResponse = FeeServiceListPlanAssignmentsResponses[keyof FeeServiceListPlanAssignmentsResponses];
+
+export type FeeServiceAssignMembershipPlanData = {
+    body: PlanAssignmentWritable;
+    path?: never;
     query?: never;
-    url: '/v1/members/{id}';
+    url: '/v1/plan-assignments';
 };
 
-export type MemberServiceGetMemberErrors = {
+export type FeeServiceAssignMembershipPlanErrors = {
     /**
      * Default error response
      */
     default: Status;
 };
 
-export type MemberServiceGetMemberError = MemberServiceGetMemberErrors[keyof MemberServiceGetMemberErrors];
+export type FeeServiceAssignMembershipPlanError = FeeServiceAssignMembershipPlanErrors[keyof FeeServiceAssignMembershipPlanErrors];
 
-export type MemberServiceGetMemberResponses = {
+export type FeeServiceAssignMembershipPlanResponses = {
     /**
      * OK
      */
-    200: MemberReadable;
+    200: PlanAssignmentReadable;
 };
 
-export type MemberServiceGetMemberResponse = MemberServiceGetMemberResponses[keyof MemberServiceGetMemberResponses];
+export type FeeServiceAssignMembershipPlanResponse = FeeServiceAssignMembershipPlanResponses[keyof FeeServiceAssignMembershipPlanResponses];
 
-export type MemberServiceUpdateMemberData = {
-    body: MemberWritable;
+export type FeeServiceEndPlanAssignmentData = {
+    body: EndPlanAssignmentRequest;
     path: {
-        'member.id': string;
-    };
-    query?: {
-        field_mask?: string;
+        id: string;
     };
-    url: '/v1/members/{member.id}';
+    query?: never;
+    url: '/v1/plan-assignments/{id}:end';
 };
 
-export type MemberServiceUpdateMemberErrors = {
+export type FeeServiceEndPlanAssignmentErrors = {
     /**
      * Default error response
      */
     default: Status;
 };
 
-export type MemberServiceUpdateMemberError = MemberServiceUpdateMemberErrors[keyof MemberServiceUpdateMemberErrors];
+export type FeeServiceEndPlanAssignmentError = FeeServiceEndPlanAssignmentErrors[keyof FeeServiceEndPlanAssignmentErrors];
 
-export type MemberServiceUpdateMemberResponses = {
+export type FeeServiceEndPlanAssignmentResponses = {
     /**
      * OK
      */
-    200: MemberReadable;
+    200: PlanAssignmentReadable;
 };
 
-export type MemberServiceUpdateMemberResponse = MemberServiceUpdateMemberResponses[keyof MemberServiceUpdateMemberResponses];
+export type FeeServiceEndPlanAssignmentResponse = FeeServiceEndPlanAssignmentResponses[keyof FeeServiceEndPlanAssignmentResponses];
 
 export type PresenceServiceListPresencesData = {
     body?: never;
@@ -1033,7 +4715,7 @@ export type PresenceServiceListPresencesResponses = {
     /**
      * OK
      */
-    200: ListPresencesResponse;
+    200: ListPresencesResponseReadable;
 };
 
 export type PresenceServiceListPresencesResponse = PresenceServiceListPresencesResponses[keyof PresenceServiceListPresencesResponses];
@@ -1064,7 +4746,7 @@ export type PresenceServiceDeletePresenceResponses = {
 };
 
 export type PresenceServiceUpdatePresenceData = {
-    body: Presence;
+    body: PresenceWritable;
     path: {
         'presence.id': string;
     };
@@ -1087,7 +4769,7 @@ export type PresenceServiceUpdatePresenceResponses = {
     /**
      * OK
      */
-    200: Presence;
+    200: PresenceReadable;
 };
 
 export type PresenceServiceUpdatePresenceResponse = PresenceServiceUpdatePresenceResponses[keyof PresenceServiceUpdatePresenceResponses];
@@ -1112,11 +4794,36 @@ export type PresenceServiceCheckinResponses = {
     /**
      * OK
      */
-    200: Presence;
+    200: PresenceReadable;
 };
 
 export type PresenceServiceCheckinResponse = PresenceServiceCheckinResponses[keyof PresenceServiceCheckinResponses];
 
+export type PresenceServiceCheckinByCardData = {
+    body: CheckinByCardRequest;
+    path?: never;
+    query?: never;
+    url: '/v1/presences:checkin-by-card';
+};
+
+export type PresenceServiceCheckinByCardErrors = {
+    /**
+     * Default error response
+     */
+    default: Status;
+};
+
+export type PresenceServiceCheckinByCardError = PresenceServiceCheckinByCardErrors[keyof PresenceServiceCheckinByCardErrors];
+
+export type PresenceServiceCheckinByCardResponses = {
+    /**
+     * OK
+     */
+    200: TogglePresenceResponseReadable;
+};
+
+export type PresenceServiceCheckinByCardResponse = PresenceServiceCheckinByCardResponses[keyof PresenceServiceCheckinByCardResponses];
+
 export type PresenceServiceCheckoutData = {
     body: CheckoutRequest;
     path?: never;
@@ -1137,11 +4844,221 @@ export type PresenceServiceCheckoutResponses = {
     /**
      * OK
      */
-    200: Presence;
+    200: PresenceReadable;
 };
 
 export type PresenceServiceCheckoutResponse = PresenceServiceCheckoutResponses[keyof PresenceServiceCheckoutResponses];
 
+export type PresenceServiceExportPresencesData = {
+    body?: never;
+    path?: never;
+    query?: {
+        'filter.page_size'?: number;
+        'filter.page_token'?: string;
+        'filter.sort_by'?: 'PRESENCE_FIELD_UNKNOWN' | 'PRESENCE_FIELD_ID' | 'PRESENCE_FIELD_MEMBER_ID' | 'PRESENCE_FIELD_CHECKIN_TIME' | 'PRESENCE_FIELD_CHECKOUT_TIME';
+        'filter.sort_direction'?: 'SORT_DIRECTION_DEFAULT' | 'SORT_DIRECTION_ASCENDING' | 'SORT_DIRECTION_DESCENDING';
+        'filter.member_id'?: string;
+        'filter.checkin_time_after'?: string;
+        'filter.checkin_time_before'?: string;
+        'filter.checkout_time_after'?: string;
+        'filter.checkout_time_before'?: string;
+        format?: 'EXPORT_FORMAT_UNKNOWN' | 'EXPORT_FORMAT_CSV' | 'EXPORT_FORMAT_XLSX';
+    };
+    url: '/v1/presences:export';
+};
+
+export type PresenceServiceExportPresencesErrors = {
+    /**
+     * Default error response
+     */
+    default: Status;
+};
+
+export type PresenceServiceExportPresencesError = PresenceServiceExportPresencesErrors[keyof PresenceServiceExportPresencesErrors];
+
+export type PresenceServiceExportPresencesResponses = {
+    /**
+     * OK
+     */
+    200: unknown;
+};
+
+export type PresenceServiceTogglePresenceData = {
+    body: TogglePresenceRequest;
+    path?: never;
+    query?: never;
+    url: '/v1/presences:toggle';
+};
+
+export type PresenceServiceTogglePresenceErrors = {
+    /**
+     * Default error response
+     */
+    default: Status;
+};
+
+export type PresenceServiceTogglePresenceError = PresenceServiceTogglePresenceErrors[keyof PresenceServiceTogglePresenceErrors];
+
+export type PresenceServiceTogglePresenceResponses = {
+    /**
+     * OK
+     */
+    200: TogglePresenceResponseReadable;
+};
+
+export type PresenceServiceTogglePresenceResponse = PresenceServiceTogglePresenceResponses[keyof PresenceServiceTogglePresenceResponses];
+
+export type ReportServiceGetMachineUsageReportData = {
+    body?: never;
+    path?: never;
+    query?: {
+        start_time?: string;
+        end_time?: string;
+        group_by?: 'USAGE_REPORT_GROUPING_UNKNOWN' | 'USAGE_REPORT_GROUPING_MEMBER' | 'USAGE_REPORT_GROUPING_MACHINE';
+    };
+    url: '/v1/reports/machine-usage';
+};
+
+export type ReportServiceGetMachineUsageReportErrors = {
+    /**
+     * Default error response
+     */
+    default: Status;
+};
+
+export type ReportServiceGetMachineUsageReportError = ReportServiceGetMachineUsageReportErrors[keyof ReportServiceGetMachineUsageReportErrors];
+
+export type ReportServiceGetMachineUsageReportResponses = {
+    /**
+     * OK
+     */
+    200: MachineUsageReport;
+};
+
+export type ReportServiceGetMachineUsageReportResponse = ReportServiceGetMachineUsageReportResponses[keyof ReportServiceGetMachineUsageReportResponses];
+
+export type ReportServiceExportMachineUsageReportData = {
+    body?: never;
+    path?: never;
+    query?: {
+        start_time?: string;
+        end_time?: string;
+        group_by?: 'USAGE_REPORT_GROUPING_UNKNOWN' | 'USAGE_REPORT_GROUPING_MEMBER' | 'USAGE_REPORT_GROUPING_MACHINE';
+    };
+    url: '/v1/reports/machine-usage:export';
+};
+
+export type ReportServiceExportMachineUsageReportErrors = {
+    /**
+     * Default error response
+     */
+    default: Status;
+};
+
+export type ReportServiceExportMachineUsageReportError = ReportServiceExportMachineUsageReportErrors[keyof ReportServiceExportMachineUsageReportErrors];
+
+export type ReportServiceExportMachineUsageReportResponses = {
+    /**
+     * OK
+     */
+    200: unknown;
+};
+
+export type ReportServiceGetPresenceReportData = {
+    body?: never;
+    path?: never;
+    query?: {
+        start_time?: string;
+        end_time?: string;
+        bucket?: 'REPORT_BUCKET_UNKNOWN' | 'REPORT_BUCKET_DAY' | 'REPORT_BUCKET_WEEK' | 'REPORT_BUCKET_MONTH';
+        /**
+         * time_zone is the IANA time zone used to determine the bucket boundaries, defaults to UTC.
+         */
+        time_zone?: string;
+    };
+    url: '/v1/reports/presences';
+};
+
+export type ReportServiceGetPresenceReportErrors = {
+    /**
+     * Default error response
+     */
+    default: Status;
+};
+
+export type ReportServiceGetPresenceReportError = ReportServiceGetPresenceReportErrors[keyof ReportServiceGetPresenceReportErrors];
+
+export type ReportServiceGetPresenceReportResponses = {
+    /**
+     * OK
+     */
+    200: PresenceReport;
+};
+
+export type ReportServiceGetPresenceReportResponse = ReportServiceGetPresenceReportResponses[keyof ReportServiceGetPresenceReportResponses];
+
+export type ReportServiceExportPresenceReportData = {
+    body?: never;
+    path?: never;
+    query?: {
+        start_time?: string;
+        end_time?: string;
+        bucket?: 'REPORT_BUCKET_UNKNOWN' | 'REPORT_BUCKET_DAY' | 'REPORT_BUCKET_WEEK' | 'REPORT_BUCKET_MONTH';
+        /**
+         * time_zone is the IANA time zone used to determine the bucket boundaries, defaults to UTC.
+         */
+        time_zone?: string;
+    };
+    url: '/v1/reports/presences:export';
+};
+
+export type ReportServiceExportPresenceReportErrors = {
+    /**
+     * Default error response
+     */
+    default: Status;
+};
+
+export type ReportServiceExportPresenceReportError = ReportServiceExportPresenceReportErrors[keyof ReportServiceExportPresenceReportErrors];
+
+export type ReportServiceExportPresenceReportResponses = {
+    /**
+     * OK
+     */
+    200: unknown;
+};
+
+export type MachineServiceListUsageSessionsData = {
+    body?: never;
+    path?: never;
+    query?: {
+        page_size?: number;
+        page_token?: string;
+        machine_id?: string;
+        member_id?: string;
+        running_only?: boolean;
+    };
+    url: '/v1/usage-sessions';
+};
+
+export type MachineServiceListUsageSessionsErrors = {
+    /**
+     * Default error response
+     */
+    default: Status;
+};
+
+export type MachineServiceListUsageSessionsError = MachineServiceListUsageSessionsErrors[keyof MachineServiceListUsageSessionsErrors];
+
+export type MachineServiceListUsageSessionsResponses = {
+    /**
+     * OK
+     */
+    200: ListUsageSessionsResponse;
+};
+
+export type MachineServiceListUsageSessionsResponse = MachineServiceListUsageSessionsResponses[keyof MachineServiceListUsageSessionsResponses];
+
 export type ClientOptions = {
     baseUrl: 'http://localhost:8080' | (string & {});
 };
\ No newline at end of file
@@ -23,7 +23,9 @@
 // Host and path of backend
 #define CONFIG_BACKEND_HOST         "<<server>>"
 #define CONFIG_BACKEND_PORT         80
-#define CONFIG_BACKEND_PATH         "/scan"
+#define CONFIG_BACKEND_PATH         "/v1/presences:checkin-by-card"
+// API key the reader logs in with, the access token is renewed before it expires
+#define CONFIG_BACKEND_API_KEY      "<<api-key>>"
 // #define CONFIG_BACKEND_PATH_ALIVE   "/heartbeat" // not implemented yet
 
 // id to idendifiy data source
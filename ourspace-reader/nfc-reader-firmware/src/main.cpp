@@ -13,10 +13,29 @@ uint8_t macAddr[] = CONFIG_MAC;
 
 EthernetClient client;
 
+#ifndef CONFIG_ACCESS_TOKEN_SIZE
+#define CONFIG_ACCESS_TOKEN_SIZE 512
+#endif
+
+#ifndef CONFIG_ACCESS_TOKEN_LIFETIME
+// access tokens of API keys are valid for an hour, they are renewed a bit earlier
+#define CONFIG_ACCESS_TOKEN_LIFETIME (50UL * 60UL * 1000UL)
+#endif
+
+#define LOGIN_TIMEOUT 5000
+
 uint32_t requestSent = 0;
 char responseBuf[512];
 uint16_t responseBufIdx = 0;
 
+// access token received by logging in with the API key, empty if there is none
+char accessToken[CONFIG_ACCESS_TOKEN_SIZE] = {0};
+uint32_t accessTokenTime = 0;
+
+// UID of the last request, it is sent again once if the access token was rejected
+char lastUid[15] = {0};
+bool retried = false;
+
 // like client.write, but takes pointer to string in program space
 void client_write_P(const char *buffer_P, size_t size) {
     char buffer[size];
@@ -24,18 +43,143 @@ void client_write_P(const char *buffer_P, size_t size) {
     client.write(buffer, size);
 }
 
+static const char base64Chars[] PROGMEM = "ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz0123456789+/";
+
+uint8_t hexToNibble(char c) {
+    if (c >= '0' && c <= '9') return c - '0';
+    if (c >= 'a' && c <= 'f') return c - 'a' + 10;
+    if (c >= 'A' && c <= 'F') return c - 'A' + 10;
+    return 0;
+}
+
+// converts the hex encoded UID into the base64 encoding the backend expects for bytes fields
+void hexToBase64(const char *hex, char *out, size_t outSize) {
+    uint8_t bytes[16];
+    size_t len = 0;
+    while (hex[len*2] && hex[len*2 + 1] && len < sizeof(bytes)) {
+        bytes[len] = (hexToNibble(hex[len*2]) << 4) | hexToNibble(hex[len*2 + 1]);
+        len++;
+    }
+
+    size_t outIdx = 0;
+    for (size_t i = 0; i < len && outIdx + 4 < outSize; i += 3) {
+        uint32_t chunk = (uint32_t)bytes[i] << 16;
+        if (i + 1 < len) chunk |= (uint32_t)bytes[i + 1] << 8;
+        if (i + 2 < len) chunk |= bytes[i + 2];
+
+        out[outIdx++] = pgm_read_byte(&base64Chars[(chunk >> 18) & 0x3F]);
+        out[outIdx++] = pgm_read_byte(&base64Chars[(chunk >> 12) & 0x3F]);
+        out[outIdx++] = i + 1 < len ? pgm_read_byte(&base64Chars[(chunk >> 6) & 0x3F]) : '=';
+        out[outIdx++] = i + 2 < len ? pgm_read_byte(&base64Chars[chunk & 0x3F]) : '=';
+    }
+    out[outIdx] = 0;
+}
+
+// reads the login response and copies the access token into accessToken. The response contains the refresh token as
+// well and is too large to be buffered, so the token is picked from the stream.
+bool readAccessToken() {
+    static const char marker[] PROGMEM = "\"access_token\":";
+    size_t matched = 0;
+    size_t tokenLen = 0;
+    bool markerFound = false;
+    bool inToken = false;
+    uint32_t start = millis();
+
+    while ((client.connected() || client.available()) && millis() - start < LOGIN_TIMEOUT) {
+        if (!client.available()) {
+            continue;
+        }
+        char c = client.read();
+
+        if (inToken) {
+            if (c == '"') {
+                accessToken[tokenLen] = 0;
+                client.stop();
+                return tokenLen > 0;
+            }
+            if (tokenLen + 1 >= sizeof(accessToken)) {
+                break;  // token too large, CONFIG_ACCESS_TOKEN_SIZE has to be increased
+            }
+            accessToken[tokenLen++] = c;
+        }
+        else if (markerFound) {
+            // the backend may put whitespace after the colon
+            if (c == '"') {
+                inToken = true;
+            }
+        }
+        else if (c == (char)pgm_read_byte(&marker[matched])) {
+            matched++;
+            markerFound = matched == sizeof(marker) - 1;
+        }
+        else {
+            matched = c == (char)pgm_read_byte(&marker[0]) ? 1 : 0;
+        }
+    }
+
+    client.stop();
+    accessToken[0] = 0;
+    return false;
+}
+
+// logs in with the API key through /v1/auth/login, the access token is kept until it expires or is rejected
+bool login() {
+    accessToken[0] = 0;
+
+    if (!client.connect(CONFIG_BACKEND_HOST, CONFIG_BACKEND_PORT)) {
+        return false;
+    }
+
+    static const char body[] PROGMEM = "{\"api_key\": {\"api_key\": \"" CONFIG_BACKEND_API_KEY "\"}}\r\n";
+    static const char header[] PROGMEM =
+        "POST /v1/auth/login HTTP/1.1\r\n"
+        "Host: " CONFIG_BACKEND_HOST "\r\n"
+        "User-Agent: arduino-ethernet\r\n"
+        "Content-Type: application/json\r\n"
+        "Connection: close\r\n"
+        "Content-Length: ";
+    client_write_P(header, sizeof(header) - 1);
+
+    char headerDynamic[16];
+    int headerDynamicLen = snprintf(headerDynamic, sizeof(headerDynamic), "%d\r\n\r\n", (int)(sizeof(body) - 1));
+    headerDynamicLen = min(sizeof(headerDynamic), headerDynamicLen);
+    client.write(headerDynamic, headerDynamicLen);
+    client_write_P(body, sizeof(body) - 1);
+
+    if (!readAccessToken()) {
+        return false;
+    }
+
+    accessTokenTime = millis();
+    return true;
+}
+
+bool hasAccessToken() {
+    return accessToken[0] != 0 && millis() - accessTokenTime < CONFIG_ACCESS_TOKEN_LIFETIME;
+}
+
 void sendUidToServer(const char *uid) {
     setAnimation(ANIM_CARD_PROCESSING);
     animationLoop(true);
 
+    strncpy(lastUid, uid, sizeof(lastUid) - 1);
+
+    if (!hasAccessToken() && !login()) {
+        setAnimation(ANIM_ERROR, 3000, true);
+        return;
+    }
+
     requestSent = millis();
     responseBufIdx = 0;
     memset(responseBuf, 0, sizeof(responseBuf));
     
     if (client.connect(CONFIG_BACKEND_HOST, CONFIG_BACKEND_PORT)) {
         // Build body
+        char rfidValue[24] = {0};
+        hexToBase64(uid, rfidValue, sizeof(rfidValue));
+
         char body[128] = {0};
-        int bodyLen = snprintf_P(body, sizeof(body), PSTR("{\"card_serial\": \"%s\", \"terminalId\": \"%s\"}\r\n"), uid, CONFIG_TERMINAL_ID);
+        int bodyLen = snprintf_P(body, sizeof(body), PSTR("{\"rfid_value\": \"%s\", \"terminal_id\": \"%s\"}\r\n"), rfidValue, CONFIG_TERMINAL_ID);
         bodyLen = min(sizeof(body), bodyLen);
         
         // "Build" and send static part of header
@@ -45,8 +189,12 @@ void sendUidToServer(const char *uid) {
             "User-Agent: arduino-ethernet\r\n"
             "Content-Type: application/json\r\n"
             "Connection: close\r\n"
-            "Content-Length: ";
+            "Authorization: Bearer ";
         client_write_P(headerStatic, sizeof(headerStatic) - 1);
+        client.write(accessToken, strlen(accessToken));
+
+        static const char headerContentLength[] PROGMEM = "\r\nContent-Length: ";
+        client_write_P(headerContentLength, sizeof(headerContentLength) - 1);
         
         // Build dynamic part of header (Content Length)
         char headerDynamic[16];
@@ -76,18 +224,31 @@ void checkHttpResponse() {
 
     
     if (!client.connected() && requestSent != 0) {  // If client got disconnected and a response is expected
+        requestSent = 0;    // mark handling done
+
+        // the access token was rejected, e.g. because the backend restarted with a new signing key
+        int status = strncmp(responseBuf, "HTTP/1.", 7) == 0 ? atoi(responseBuf + 9) : 0;
+        if (status == 401 || status == 403) {
+            accessToken[0] = 0;
+            if (!retried) {
+                retried = true;
+                sendUidToServer(lastUid);
+                return;
+            }
+        }
+        retried = false;
+
         // handle response
-        if (strstr(responseBuf,         "checkin"           ) != NULL)  { setAnimation(ANIM_CHECK_IN); }
-        else if (strstr(responseBuf,    "checkout"          ) != NULL)  { setAnimation(ANIM_CHECK_OUT); }
-        else if (strstr(responseBuf,    "member-not-found"  ) != NULL)  { setAnimation(ANIM_UNKNOWN_CARD); }
-        else if (strstr(responseBuf,    "card-not-found"    ) != NULL)  { setAnimation(ANIM_UNKNOWN_CARD); }
+        if (strstr(responseBuf,         "PRESENCE_ACTION_CHECKIN"   ) != NULL)  { setAnimation(ANIM_CHECK_IN); }
+        else if (strstr(responseBuf,    "PRESENCE_ACTION_CHECKOUT"  ) != NULL)  { setAnimation(ANIM_CHECK_OUT); }
+        else if (strstr(responseBuf,    "not found"                 ) != NULL)  { setAnimation(ANIM_UNKNOWN_CARD); }
+        else if (strstr(responseBuf,    "membership is not active"  ) != NULL)  { setAnimation(ANIM_UNKNOWN_CARD); }
         else {
             setAnimation(ANIM_ERROR, 3000, true);
             // Serial.println("Response:");
             // Serial.write(responseBuf);
             // Serial.println();
         }
-        requestSent = 0;    // mark handling done
     }
 }
 
@@ -185,6 +346,7 @@ void loop() {
                     uid[12 - i*2] = rxBuf[i*2];
                     uid[12 - i*2 + 1] = rxBuf[i*2 + 1];
                 } 
+                retried = false;
                 sendUidToServer(uid);
             }
             lastSerialRx = 0;   // force buffer clear (discards spurious data, probably)
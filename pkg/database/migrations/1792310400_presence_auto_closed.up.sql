@@ -0,0 +1,2 @@
+alter table presences
+    add column auto_closed boolean not null default false;
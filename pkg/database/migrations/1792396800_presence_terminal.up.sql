@@ -0,0 +1 @@
+alter table presences add column terminal_id text;
@@ -0,0 +1,27 @@
+create extension if not exists btree_gist;
+
+-- presences recorded before the constraints can violate them, they are repaired instead of failing the migration.
+-- checkouts before the checkin are moved to the checkin.
+update presences
+set checkout_time = checkin_time, auto_closed = true
+where checkout_time < checkin_time;
+
+-- presences that last into the next presence of the member, or are still open, end at the next checkin.
+update presences
+set checkout_time = next.checkin_time, auto_closed = true
+from (
+    select id, lead(checkin_time) over (partition by member_id order by checkin_time, id) as checkin_time
+    from presences
+) next
+where presences.id = next.id
+    and next.checkin_time is not null
+    and (presences.checkout_time is null or presences.checkout_time > next.checkin_time);
+
+alter table presences
+    add constraint presences_checkout_after_checkin
+        check (checkout_time is null or checkout_time >= checkin_time);
+
+-- open presences are treated as lasting forever, so they conflict with every later presence of the same member
+alter table presences
+    add constraint presences_no_overlap
+        exclude using gist (member_id with =, tstzrange(checkin_time, checkout_time, '[)') with &&);
@@ -0,0 +1,33 @@
+create table events
+(
+    id                      uuid PRIMARY KEY     DEFAULT gen_random_uuid(),
+    title                   text        NOT NULL,
+    description             text        NOT NULL DEFAULT '',
+    start_time              timestamptz NOT NULL,
+    end_time                timestamptz NOT NULL,
+    location                text        NOT NULL DEFAULT '',
+    capacity                integer     NOT NULL DEFAULT 0,
+    required_briefing_types text[]      NOT NULL DEFAULT ARRAY []::text[],
+    allowed_age_categories  text[]      NOT NULL DEFAULT ARRAY []::text[],
+    constraint events_end_after_start check (end_time > start_time),
+    constraint events_capacity_positive check (capacity >= 0)
+);
+
+create index idx_events_start_time on events (start_time);
+
+create table event_registrations
+(
+    id                uuid PRIMARY KEY     DEFAULT gen_random_uuid(),
+    event_id          uuid        NOT NULL REFERENCES events (id) ON DELETE CASCADE,
+    member_id         uuid        NOT NULL REFERENCES members (id) ON DELETE CASCADE,
+    status            text        NOT NULL,
+    registration_time timestamptz NOT NULL,
+    cancellation_time timestamptz,
+    attended          boolean     NOT NULL DEFAULT false
+);
+
+create unique index single_active_event_registration
+    ON event_registrations (event_id, member_id)
+    WHERE (status <> 'EVENT_REGISTRATION_STATUS_CANCELLED');
+create index idx_event_registrations_event_id on event_registrations (event_id, registration_time);
+create index idx_event_registrations_member_id on event_registrations (member_id);
@@ -0,0 +1,31 @@
+create table items
+(
+    id                      uuid PRIMARY KEY DEFAULT gen_random_uuid(),
+    name                    text   NOT NULL,
+    description             text   NOT NULL DEFAULT '',
+    rfid_value              bytea,
+    barcode                 text,
+    condition_notes         text   NOT NULL DEFAULT '',
+    required_briefing_types text[] NOT NULL DEFAULT ARRAY []::text[]
+);
+
+create unique index idx_items_rfid_value on items (rfid_value);
+create unique index idx_items_barcode on items (barcode);
+
+create table loans
+(
+    id                     uuid PRIMARY KEY     DEFAULT gen_random_uuid(),
+    item_id                uuid        NOT NULL REFERENCES items (id) ON DELETE CASCADE,
+    member_id              uuid        NOT NULL REFERENCES members (id) ON DELETE CASCADE,
+    lend_time              timestamptz NOT NULL,
+    expected_return_time   timestamptz NOT NULL,
+    return_time            timestamptz,
+    return_condition_notes text        NOT NULL DEFAULT '',
+    terminal_id            text
+);
+
+create unique index single_open_loan
+    ON loans (item_id)
+    WHERE (return_time is null);
+create index idx_loans_member_id on loans (member_id);
+create index idx_loans_lend_time on loans (lend_time);
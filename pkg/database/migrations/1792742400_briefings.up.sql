@@ -0,0 +1,17 @@
+create table briefing_types
+(
+    id            uuid PRIMARY KEY DEFAULT gen_random_uuid(),
+    display_name  text     NOT NULL,
+    description   text     NOT NULL DEFAULT '',
+    expires_after interval
+);
+
+create table briefings
+(
+    id               uuid PRIMARY KEY     DEFAULT gen_random_uuid(),
+    briefing_type_id uuid        NOT NULL REFERENCES briefing_types (id) ON DELETE CASCADE,
+    member_id        uuid        NOT NULL REFERENCES members (id) ON DELETE CASCADE,
+    briefing_time    timestamptz NOT NULL
+);
+
+create index idx_briefings_member_id on briefings (member_id, briefing_type_id);
@@ -0,0 +1,24 @@
+create table machines
+(
+    id                      uuid PRIMARY KEY DEFAULT gen_random_uuid(),
+    name                    text   NOT NULL,
+    description             text   NOT NULL DEFAULT '',
+    location                text   NOT NULL DEFAULT '',
+    required_briefing_types text[] NOT NULL DEFAULT ARRAY []::text[]
+);
+
+-- machine_id is not a foreign key, so that checks for unknown or deleted machines are logged as well
+create table access_decisions
+(
+    id                     uuid PRIMARY KEY     DEFAULT gen_random_uuid(),
+    decision_time          timestamptz NOT NULL,
+    machine_id             text        NOT NULL,
+    member_id              uuid REFERENCES members (id) ON DELETE SET NULL,
+    rfid_value             bytea       NOT NULL,
+    allowed                boolean     NOT NULL,
+    reason                 text        NOT NULL,
+    missing_briefing_types text[]      NOT NULL DEFAULT ARRAY []::text[]
+);
+
+create index idx_access_decisions_decision_time on access_decisions (decision_time);
+create index idx_access_decisions_machine_id on access_decisions (machine_id, decision_time);
@@ -0,0 +1,25 @@
+alter table machines
+    add column price_per_minute_cents bigint   NOT NULL DEFAULT 0,
+    add column session_timeout        interval,
+    add constraint machines_price_not_negative check (price_per_minute_cents >= 0);
+
+-- machine_id is not a foreign key, so that usage of deleted machines can still be billed.
+-- price_per_minute_cents and session_timeout are copied from the machine when the session starts.
+create table usage_sessions
+(
+    id                     uuid PRIMARY KEY     DEFAULT gen_random_uuid(),
+    machine_id             uuid        NOT NULL,
+    member_id              uuid        NOT NULL REFERENCES members (id) ON DELETE CASCADE,
+    start_time             timestamptz NOT NULL,
+    end_time               timestamptz,
+    auto_stopped           boolean     NOT NULL DEFAULT false,
+    price_per_minute_cents bigint      NOT NULL DEFAULT 0,
+    session_timeout        interval    NOT NULL,
+    constraint usage_sessions_end_after_start check (end_time is null or end_time >= start_time)
+);
+
+create unique index single_running_usage_session
+    ON usage_sessions (machine_id)
+    WHERE (end_time is null);
+create index idx_usage_sessions_start_time on usage_sessions (start_time);
+create index idx_usage_sessions_member_id on usage_sessions (member_id, start_time);
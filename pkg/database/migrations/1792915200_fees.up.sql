@@ -0,0 +1,58 @@
+create table membership_plans
+(
+    id                  uuid PRIMARY KEY DEFAULT gen_random_uuid(),
+    display_name        text   NOT NULL,
+    description         text   NOT NULL DEFAULT '',
+    price_cents         bigint NOT NULL,
+    reduced_price_cents bigint,
+    billing_interval    text   NOT NULL,
+    constraint membership_plans_price_not_negative check (price_cents >= 0 and coalesce(reduced_price_cents, 0) >= 0)
+);
+
+create table plan_assignments
+(
+    id           uuid PRIMARY KEY     DEFAULT gen_random_uuid(),
+    member_id    uuid        NOT NULL REFERENCES members (id) ON DELETE CASCADE,
+    plan_id      uuid        NOT NULL REFERENCES membership_plans (id),
+    start_time   timestamptz NOT NULL,
+    end_time     timestamptz,
+    billed_until timestamptz NOT NULL,
+    constraint plan_assignments_end_after_start check (end_time is null or end_time > start_time),
+    constraint plan_assignments_no_overlap exclude using gist (
+        member_id with =,
+        tstzrange(start_time, end_time, '[)') with &&
+    )
+);
+
+create index idx_plan_assignments_billing on plan_assignments (billed_until);
+
+-- invoices keep the amount they were generated with, later changes of the plan do not affect them.
+create table invoices
+(
+    id                 uuid PRIMARY KEY     DEFAULT gen_random_uuid(),
+    member_id          uuid        NOT NULL REFERENCES members (id) ON DELETE CASCADE,
+    plan_assignment_id uuid        NOT NULL REFERENCES plan_assignments (id) ON DELETE CASCADE,
+    plan_id            uuid        NOT NULL REFERENCES membership_plans (id),
+    period_start       timestamptz NOT NULL,
+    period_end         timestamptz NOT NULL,
+    amount_cents       bigint      NOT NULL,
+    issue_time         timestamptz NOT NULL,
+    cancelled          boolean     NOT NULL DEFAULT false,
+    constraint invoices_single_period unique (plan_assignment_id, period_start)
+);
+
+create index idx_invoices_member_id on invoices (member_id, period_start);
+
+create table payments
+(
+    id           uuid PRIMARY KEY     DEFAULT gen_random_uuid(),
+    member_id    uuid        NOT NULL REFERENCES members (id) ON DELETE CASCADE,
+    invoice_id   uuid REFERENCES invoices (id) ON DELETE SET NULL,
+    amount_cents bigint      NOT NULL,
+    payment_time timestamptz NOT NULL,
+    reference    text        NOT NULL DEFAULT '',
+    constraint payments_amount_positive check (amount_cents > 0)
+);
+
+create index idx_payments_member_id on payments (member_id, payment_time);
+create index idx_payments_invoice_id on payments (invoice_id);
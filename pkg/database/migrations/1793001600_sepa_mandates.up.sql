@@ -0,0 +1,9 @@
+create table sepa_mandates
+(
+    member_id         uuid PRIMARY KEY REFERENCES members (id) ON DELETE CASCADE,
+    account_holder    text NOT NULL,
+    iban              text NOT NULL,
+    bic               text NOT NULL DEFAULT '',
+    mandate_reference text NOT NULL UNIQUE,
+    mandate_date      date NOT NULL
+);
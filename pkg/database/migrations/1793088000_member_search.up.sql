@@ -0,0 +1,30 @@
+create extension if not exists pg_trgm;
+create extension if not exists unaccent;
+
+-- member_search_text normalizes text for searching: lower case without accents, so "muller" finds "Müller".
+-- unaccent is only stable because its dictionary could change, indexes need the dictionary to be fixed.
+create function member_search_text(value text) returns text
+    language sql
+    immutable
+    parallel safe
+    strict
+return lower(public.unaccent('public.unaccent'::regdictionary, value));
+
+create function member_search_text(tags text[]) returns text
+    language sql
+    immutable
+    parallel safe
+    strict
+return member_search_text(array_to_string(tags, ' '));
+
+create function member_search_text(attributes jsonb) returns text
+    language sql
+    immutable
+    parallel safe
+    strict
+return member_search_text((select coalesce(string_agg(attribute.value, ' '), '') from jsonb_each_text(attributes) attribute));
+
+create index idx_members_search_name on members using gin (member_search_text(name) gin_trgm_ops);
+create index idx_members_search_tags on members using gin (member_search_text(tags) gin_trgm_ops);
+create index idx_members_search_attributes on members using gin (member_search_text(additional_attributes) gin_trgm_ops);
+create index idx_members_auth_search_username on members_auth using gin (member_search_text(username) gin_trgm_ops);
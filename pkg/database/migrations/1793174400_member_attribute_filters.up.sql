@@ -0,0 +1,24 @@
+create index idx_members_additional_attributes on members using gin (additional_attributes jsonb_path_ops);
+
+-- the member_attribute_* functions convert attribute values for filtering and sorting, values that can't be converted
+-- are null instead of failing the whole query.
+create function member_attribute_numeric(value text) returns numeric
+    language sql
+    stable
+    parallel safe
+    strict
+return case when pg_input_is_valid(value, 'numeric') then value::numeric end;
+
+create function member_attribute_date(value text) returns date
+    language sql
+    stable
+    parallel safe
+    strict
+return case when pg_input_is_valid(value, 'date') then value::date end;
+
+create function member_attribute_timestamptz(value text) returns timestamptz
+    language sql
+    stable
+    parallel safe
+    strict
+return case when pg_input_is_valid(value, 'timestamptz') then value::timestamptz end;
@@ -0,0 +1,7 @@
+alter table member_attributes
+    add column options     text[]  not null default array []::text[],
+    add column is_required boolean not null default false,
+    add column is_unique   boolean not null default false,
+    add column pattern     text    not null default '',
+    add column min_value   text,
+    add column max_value   text;
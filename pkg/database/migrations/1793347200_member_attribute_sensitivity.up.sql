@@ -0,0 +1,11 @@
+alter table members_auth
+    add column roles text[] not null default array []::text[];
+
+-- Existing logins could access everything before roles existed, they keep that until an admin narrows it down.
+update members_auth
+set roles = array ['admin'];
+
+alter table member_attributes
+    add column sensitivity text   not null default 'SENSITIVITY_PUBLIC',
+    add column read_roles  text[] not null default array []::text[],
+    add column write_roles text[] not null default array []::text[];
@@ -0,0 +1,2 @@
+alter table members
+    add column birth_date date;
@@ -0,0 +1,27 @@
+create table member_relationships
+(
+    id                uuid PRIMARY KEY DEFAULT gen_random_uuid(),
+    member_id         uuid NOT NULL REFERENCES members (id) ON DELETE CASCADE,
+    type              text NOT NULL,
+    related_member_id uuid REFERENCES members (id) ON DELETE CASCADE,
+    name              text NOT NULL DEFAULT '',
+    email             text NOT NULL DEFAULT '',
+    phone             text NOT NULL DEFAULT '',
+    description       text NOT NULL DEFAULT ''
+);
+
+create index idx_member_relationships_member_id on member_relationships (member_id);
+
+create table member_consents
+(
+    id              uuid PRIMARY KEY DEFAULT gen_random_uuid(),
+    member_id       uuid        NOT NULL REFERENCES members (id) ON DELETE CASCADE,
+    purpose         text        NOT NULL,
+    -- the guardian who consented, given_by keeps their name if the relationship is deleted
+    relationship_id uuid REFERENCES member_relationships (id) ON DELETE SET NULL,
+    given_by        text        NOT NULL,
+    consent_time    timestamptz NOT NULL,
+    revoke_time     timestamptz
+);
+
+create index idx_member_consents_member_id on member_consents (member_id);
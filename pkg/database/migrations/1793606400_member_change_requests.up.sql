@@ -0,0 +1,17 @@
+create table member_change_requests
+(
+    id           uuid PRIMARY KEY     DEFAULT gen_random_uuid(),
+    member_id    uuid        NOT NULL REFERENCES members (id) ON DELETE CASCADE,
+    -- the requested values as protojson of a member, only the fields in field_mask are applied
+    member       jsonb       NOT NULL,
+    field_mask   text[]      NOT NULL,
+    state        text        NOT NULL DEFAULT 'STATE_PENDING',
+    create_time  timestamptz NOT NULL DEFAULT now(),
+    requested_by text        NOT NULL,
+    decide_time  timestamptz,
+    decided_by   text        NOT NULL DEFAULT '',
+    comment      text        NOT NULL DEFAULT ''
+);
+
+create index idx_member_change_requests_state on member_change_requests (state, create_time);
+create index idx_member_change_requests_member_id on member_change_requests (member_id, create_time);
@@ -0,0 +1,18 @@
+create table applications
+(
+    id          uuid PRIMARY KEY     DEFAULT gen_random_uuid(),
+    name        text        NOT NULL,
+    email       text        NOT NULL,
+    phone       text        NOT NULL DEFAULT '',
+    birth_date  date,
+    message     text        NOT NULL DEFAULT '',
+    state       text        NOT NULL DEFAULT 'STATE_PENDING',
+    create_time timestamptz NOT NULL DEFAULT now(),
+    decide_time timestamptz,
+    decided_by  text        NOT NULL DEFAULT '',
+    comment     text        NOT NULL DEFAULT '',
+    -- accepted applications become members with the same id, the member may be deleted later
+    member_id   uuid REFERENCES members (id) ON DELETE SET NULL
+);
+
+create index idx_applications_state on applications (state, create_time);
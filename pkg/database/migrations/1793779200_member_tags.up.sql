@@ -0,0 +1,9 @@
+-- members.tags stays the source of truth for which members have a tag, definitions are optional
+create table member_tags
+(
+    name        text PRIMARY KEY,
+    description text NOT NULL DEFAULT '',
+    color       text NOT NULL DEFAULT ''
+);
+
+create index idx_members_tags on members using gin (tags);
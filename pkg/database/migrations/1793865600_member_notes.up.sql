@@ -0,0 +1,15 @@
+create table member_notes
+(
+    id          uuid PRIMARY KEY     DEFAULT gen_random_uuid(),
+    member_id   uuid        NOT NULL REFERENCES members (id) ON DELETE CASCADE,
+    text        text        NOT NULL,
+    -- username and full name of the author, the login may be removed later
+    author      text        NOT NULL DEFAULT '',
+    author_name text        NOT NULL DEFAULT '',
+    create_time timestamptz NOT NULL DEFAULT now(),
+    update_time timestamptz,
+    pinned      boolean     NOT NULL DEFAULT false,
+    visibility  text        NOT NULL DEFAULT 'VISIBILITY_STAFF'
+);
+
+create index idx_member_notes_member_id on member_notes (member_id, create_time);
@@ -0,0 +1,18 @@
+create table sepa_exports
+(
+    id              uuid PRIMARY KEY     DEFAULT gen_random_uuid(),
+    -- message_id is the MsgId of the pain.008 file, it identifies the file at the bank
+    message_id      text        NOT NULL,
+    create_time     timestamptz NOT NULL DEFAULT now(),
+    collection_date date        NOT NULL
+);
+
+-- invoices that were collected by an export, they are left out of later exports unless re-exported explicitly
+create table sepa_export_invoices
+(
+    export_id  uuid NOT NULL REFERENCES sepa_exports (id) ON DELETE CASCADE,
+    invoice_id uuid NOT NULL REFERENCES invoices (id) ON DELETE CASCADE,
+    PRIMARY KEY (export_id, invoice_id)
+);
+
+create index idx_sepa_export_invoices_invoice_id on sepa_export_invoices (invoice_id);
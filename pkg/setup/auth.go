@@ -21,8 +21,11 @@ import (
 
 type AccessTokenClaims struct {
 	jwt.RegisteredClaims
-	Type     string `json:"type"`
-	FullName string `json:"full_name"`
+	Type     string   `json:"type"`
+	FullName string   `json:"full_name"`
+	Roles    []string `json:"roles,omitempty"`
+	// APIKey is set for tokens of API keys, e.g. the ones used by terminals.
+	APIKey bool `json:"api_key,omitempty"`
 }
 
 type RefreshTokenClaims struct {
@@ -37,48 +40,84 @@ func AuthInterceptor(keyFunc func(kid string) *ecdsa.PublicKey) grpc.UnaryServer
 	return func(
 		ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler,
 	) (resp any, err error) {
-		if !shouldAuthenticate(fullMethodToMethodName(info.FullMethod)) {
-			return handler(ctx, req)
+		reqCtx, err := authenticate(ctx, info.FullMethod, keyFunc)
+		if err != nil {
+			return nil, err
 		}
 
-		md, ok := metadata.FromIncomingContext(ctx)
-		if !ok {
-			return nil, status.Unauthenticated()
-		}
+		return handler(reqCtx, req)
+	}
+}
 
-		authorizationHeaders := md.Get("authorization")
-		if len(authorizationHeaders) != 1 {
-			return nil, status.Unauthenticated()
+// StreamAuthInterceptor authenticates streaming calls the same way AuthInterceptor does for unary calls.
+func StreamAuthInterceptor(keyFunc func(kid string) *ecdsa.PublicKey) grpc.StreamServerInterceptor {
+	return func(srv any, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx, err := authenticate(stream.Context(), info.FullMethod, keyFunc)
+		if err != nil {
+			return err
 		}
 
-		authorizationHeader := authorizationHeaders[0]
+		return handler(srv, &authenticatedStream{ServerStream: stream, ctx: ctx})
+	}
+}
 
-		token, ok := strings.CutPrefix(authorizationHeader, "Bearer ")
-		if !ok {
-			return nil, status.Unauthenticated()
-		}
+type authenticatedStream struct {
+	grpc.ServerStream
+	ctx context.Context //nolint:containedctx // the context of the stream carries the claims
+}
 
-		var accessTokenClaims AccessTokenClaims
-		_, err = jwt.ParseWithClaims(token, &accessTokenClaims, func(token *jwt.Token) (any, error) {
-			kid, ok := token.Header["kid"].(string)
-			if !ok {
-				return nil, nil
-			}
+func (s *authenticatedStream) Context() context.Context {
+	return s.ctx
+}
 
-			return keyFunc(kid), nil
-		}, jwt.WithExpirationRequired(), jwt.WithValidMethods([]string{jwt.SigningMethodES256.Name}))
-		if err != nil {
-			return nil, status.PermissionDenied()
-		}
+func authenticate(
+	ctx context.Context, fullMethod string, keyFunc func(kid string) *ecdsa.PublicKey,
+) (context.Context, error) {
+	authOptions := methodAuthOptions(fullMethodToMethodName(fullMethod))
+	if authOptions.GetAllowUnauthenticated() {
+		return ctx, nil
+	}
 
-		if accessTokenClaims.Type != "access" {
-			return nil, status.PermissionDenied()
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return nil, status.Unauthenticated()
+	}
+
+	authorizationHeaders := md.Get("authorization")
+	if len(authorizationHeaders) != 1 {
+		return nil, status.Unauthenticated()
+	}
+
+	authorizationHeader := authorizationHeaders[0]
+
+	token, ok := strings.CutPrefix(authorizationHeader, "Bearer ")
+	if !ok {
+		return nil, status.Unauthenticated()
+	}
+
+	var accessTokenClaims AccessTokenClaims
+	_, err := jwt.ParseWithClaims(token, &accessTokenClaims, func(token *jwt.Token) (any, error) {
+		kid, ok := token.Header["kid"].(string)
+		if !ok {
+			return nil, nil
 		}
 
-		reqCtx := context.WithValue(ctx, accessTokenClaimsKey{}, accessTokenClaims)
+		return keyFunc(kid), nil
+	}, jwt.WithExpirationRequired(), jwt.WithValidMethods([]string{jwt.SigningMethodES256.Name}))
+	if err != nil {
+		return nil, status.PermissionDenied()
+	}
 
-		return handler(reqCtx, req)
+	if accessTokenClaims.Type != "access" {
+		return nil, status.PermissionDenied()
+	}
+
+	// logins without roles belong to members, they can only use the self service
+	if !accessTokenClaims.APIKey && len(accessTokenClaims.Roles) == 0 && !authOptions.GetAllowMembers() {
+		return nil, status.PermissionDenied()
 	}
+
+	return context.WithValue(ctx, accessTokenClaimsKey{}, accessTokenClaims), nil
 }
 
 func GetAccessTokenClaims(ctx context.Context) (*AccessTokenClaims, bool) {
@@ -99,20 +138,17 @@ func fullMethodToMethodName(fullMethod string) string {
 	return strings.Replace(strings.TrimPrefix(fullMethod, "/"), "/", ".", 1)
 }
 
-func shouldAuthenticate(methodName string) bool {
+// methodAuthOptions returns the auth options of the method, nil if it has none. Methods without options require an
+// access token with a role.
+func methodAuthOptions(methodName string) *pb.AuthOptions {
 	desc, err := protoregistry.GlobalFiles.FindDescriptorByName(protoreflect.FullName(methodName))
 	if err != nil {
-		return true
+		return nil
 	}
 
 	methodOptions := desc.Options().(*descriptorpb.MethodOptions)
-	authOptions := proto.GetExtension(methodOptions, pb.E_AuthOptions).(*pb.AuthOptions)
-
-	if authOptions == nil {
-		return true
-	}
 
-	return !authOptions.AllowUnauthenticated
+	return proto.GetExtension(methodOptions, pb.E_AuthOptions).(*pb.AuthOptions)
 }
 
 type BearerTokenAuth struct {
@@ -1,19 +1,18 @@
 // Code generated by protoc-gen-go. DO NOT EDIT.
 // versions:
-// 	protoc-gen-go v1.36.6
+// 	protoc-gen-go v1.36.10
 // 	protoc        (unknown)
 // source: pkg/setup/proto/auth.proto
 
 package pb
 
 import (
-	reflect "reflect"
-	sync "sync"
-	unsafe "unsafe"
-
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	descriptorpb "google.golang.org/protobuf/types/descriptorpb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
 )
 
 const (
@@ -26,8 +25,11 @@ const (
 type AuthOptions struct {
 	state                protoimpl.MessageState `protogen:"open.v1"`
 	AllowUnauthenticated bool                   `protobuf:"varint,1,opt,name=allow_unauthenticated,json=allowUnauthenticated,proto3" json:"allow_unauthenticated,omitempty"`
-	unknownFields        protoimpl.UnknownFields
-	sizeCache            protoimpl.SizeCache
+	// allow_members lets logins without roles call the method. These logins belong to members using the self service,
+	// all other methods require a role.
+	AllowMembers  bool `protobuf:"varint,2,opt,name=allow_members,json=allowMembers,proto3" json:"allow_members,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *AuthOptions) Reset() {
@@ -67,6 +69,13 @@ func (x *AuthOptions) GetAllowUnauthenticated() bool {
 	return false
 }
 
+func (x *AuthOptions) GetAllowMembers() bool {
+	if x != nil {
+		return x.AllowMembers
+	}
+	return false
+}
+
 var file_pkg_setup_proto_auth_proto_extTypes = []protoimpl.ExtensionInfo{
 	{
 		ExtendedType:  (*descriptorpb.MethodOptions)(nil),
@@ -88,9 +97,10 @@ var File_pkg_setup_proto_auth_proto protoreflect.FileDescriptor
 
 const file_pkg_setup_proto_auth_proto_rawDesc = "" +
 	"\n" +
-	"\x1apkg/setup/proto/auth.proto\x12\tpkg.setup\x1a google/protobuf/descriptor.proto\"B\n" +
+	"\x1apkg/setup/proto/auth.proto\x12\tpkg.setup\x1a google/protobuf/descriptor.proto\"g\n" +
 	"\vAuthOptions\x123\n" +
-	"\x15allow_unauthenticated\x18\x01 \x01(\bR\x14allowUnauthenticated:c\n" +
+	"\x15allow_unauthenticated\x18\x01 \x01(\bR\x14allowUnauthenticated\x12#\n" +
+	"\rallow_members\x18\x02 \x01(\bR\fallowMembers:c\n" +
 	"\fauth_options\x12\x1e.google.protobuf.MethodOptions\x18\xb0\x9e\x03 \x01(\v2\x16.pkg.setup.AuthOptionsB\x03\x88\x01\x01R\vauthOptions\x88\x01\x01B.Z,github.com/cfhn/our-space/pkg/setup/proto;pbb\x06proto3"
 
 var (
@@ -59,6 +59,8 @@ func (m *AuthOptions) validate(all bool) error {
 
 	// no validation rules for AllowUnauthenticated
 
+	// no validation rules for AllowMembers
+
 	if len(errors) > 0 {
 		return AuthOptionsMultiError(errors)
 	}
@@ -89,13 +89,16 @@ func (s *Server) Run() error {
 	}
 
 	interceptors := []grpc.UnaryServerInterceptor{}
+	streamInterceptors := []grpc.StreamServerInterceptor{}
 
 	if !s.DisableAuthentication {
 		interceptors = append(interceptors, AuthInterceptor(s.KeyFunc))
+		streamInterceptors = append(streamInterceptors, StreamAuthInterceptor(s.KeyFunc))
 	}
 
 	server := grpc.NewServer(
 		grpc.ChainUnaryInterceptor(interceptors...),
+		grpc.ChainStreamInterceptor(streamInterceptors...),
 	)
 	grpcClient, err := grpc.NewClient(fmt.Sprintf("localhost:%d", s.GRPCPort), grpc.WithTransportCredentials(insecure.NewCredentials()))
 	if err != nil {
@@ -30,6 +30,14 @@ func NotFound() error {
 	return status.Error(codes.NotFound, "not found")
 }
 
+func AlreadyExists() error {
+	return status.Error(codes.AlreadyExists, "already exists")
+}
+
+func FailedPrecondition(description string) error {
+	return status.Error(codes.FailedPrecondition, description)
+}
+
 func FromError(err error) *status.Status {
 	if err == nil {
 		return status.New(codes.OK, "")
@@ -50,3 +58,7 @@ func Unauthenticated() error {
 func PermissionDenied() error {
 	return status.Error(codes.PermissionDenied, "permission denied")
 }
+
+func ResourceExhausted(description string) error {
+	return status.Error(codes.ResourceExhausted, description)
+}
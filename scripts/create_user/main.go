@@ -60,7 +60,7 @@ func run() error {
 		return err
 	}
 
-	_, err = db.Exec(`insert into members_auth (id, username, password_hash) values ($1, $2, $3)`, userID, username, hash)
+	_, err = db.Exec(`insert into members_auth (id, username, password_hash, roles) values ($1, $2, $3, array ['admin'])`, userID, username, hash)
 	if err != nil {
 		return err
 	}